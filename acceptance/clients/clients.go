@@ -123,6 +123,17 @@ func NewCTSV1Client() (*golangsdk.ServiceClient, error) {
 	})
 }
 
+func NewCTSV3Client() (*golangsdk.ServiceClient, error) {
+	cc, err := CloudAndClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return openstack.NewCTSV3(cc.ProviderClient, golangsdk.EndpointOpts{
+		Region: cc.RegionName,
+	})
+}
+
 // NewDNSV2Client returns a *ServiceClient for making calls
 // to the OpenStack Compute v2 API. An error will be returned
 // if authentication or client creation was not possible.
@@ -322,17 +333,9 @@ func NewOBSClient() (*obs.ObsClient, error) {
 		return nil, fmt.Errorf("failed to construct OBS client without AK/SK: %s", err)
 	}
 
-	client, err := openstack.NewOBSService(cc.ProviderClient, golangsdk.EndpointOpts{
+	return openstack.NewOBSClient(cc.ProviderClient, golangsdk.EndpointOpts{
 		Region: cc.RegionName,
-	})
-	if err != nil {
-		return nil, err
-	}
-	opts := cc.AKSKAuthOptions
-	return obs.New(
-		opts.AccessKey, opts.SecretKey, client.Endpoint,
-		obs.WithSecurityToken(opts.SecurityToken), obs.WithSignature(obs.SignatureObs),
-	)
+	}, obs.WithSignature(obs.SignatureObs))
 }
 
 func NewOBSClientWithoutHeader() (*obs.ObsClient, error) {
@@ -345,17 +348,9 @@ func NewOBSClientWithoutHeader() (*obs.ObsClient, error) {
 		return nil, fmt.Errorf("failed to construct OBS client without AK/SK: %s", err)
 	}
 
-	client, err := openstack.NewOBSService(cc.ProviderClient, golangsdk.EndpointOpts{
+	return openstack.NewOBSClient(cc.ProviderClient, golangsdk.EndpointOpts{
 		Region: cc.RegionName,
 	})
-	if err != nil {
-		return nil, err
-	}
-	opts := cc.AKSKAuthOptions
-	return obs.New(
-		opts.AccessKey, opts.SecretKey, client.Endpoint,
-		obs.WithSecurityToken(opts.SecurityToken),
-	)
 }
 
 // NewSharedFileSystemV2Client returns a *ServiceClient for making calls
@@ -381,6 +376,26 @@ func NewKMSV1Client() (*golangsdk.ServiceClient, error) {
 	})
 }
 
+func NewHSSV5Client() (*golangsdk.ServiceClient, error) {
+	cc, err := CloudAndClient()
+	if err != nil {
+		return nil, err
+	}
+	return openstack.NewHSSV5(cc.ProviderClient, golangsdk.EndpointOpts{
+		Region: cc.RegionName,
+	})
+}
+
+func NewCSMSV1Client() (*golangsdk.ServiceClient, error) {
+	cc, err := CloudAndClient()
+	if err != nil {
+		return nil, err
+	}
+	return openstack.NewCSMSV1(cc.ProviderClient, golangsdk.EndpointOpts{
+		Region: cc.RegionName,
+	})
+}
+
 // NewSharedFileSystemTurboV1Client returns a *ServiceClient for making calls
 // to the OpenStack Shared File System Turbo v1 API. An error will be returned
 // if authentication or client creation was not possible.
@@ -416,6 +431,17 @@ func NewMrsV1() (*golangsdk.ServiceClient, error) {
 	})
 }
 
+// NewMrsV2 returns authenticated MRS v2 client
+func NewMrsV2() (*golangsdk.ServiceClient, error) {
+	cc, err := CloudAndClient()
+	if err != nil {
+		return nil, err
+	}
+	return openstack.NewMapReduceV2(cc.ProviderClient, golangsdk.EndpointOpts{
+		Region: cc.RegionName,
+	})
+}
+
 // NewSDRSV1 returns authenticated SDRS v3 client
 func NewSDRSV1() (*golangsdk.ServiceClient, error) {
 	cc, err := CloudAndClient()
@@ -427,6 +453,28 @@ func NewSDRSV1() (*golangsdk.ServiceClient, error) {
 	})
 }
 
+// NewDSSV1 returns authenticated DSS v1 client
+func NewDSSV1() (*golangsdk.ServiceClient, error) {
+	cc, err := CloudAndClient()
+	if err != nil {
+		return nil, err
+	}
+	return openstack.NewDSSV1(cc.ProviderClient, golangsdk.EndpointOpts{
+		Region: cc.RegionName,
+	})
+}
+
+// NewGaussDBV3 returns authenticated GaussDB(for openGauss) v3 client
+func NewGaussDBV3() (*golangsdk.ServiceClient, error) {
+	cc, err := CloudAndClient()
+	if err != nil {
+		return nil, err
+	}
+	return openstack.NewGaussDBV3(cc.ProviderClient, golangsdk.EndpointOpts{
+		Region: cc.RegionName,
+	})
+}
+
 // NewWafV1Client returns authenticated WAF v1 client
 func NewWafV1Client() (*golangsdk.ServiceClient, error) {
 	cc, err := CloudAndClient()
@@ -436,6 +484,15 @@ func NewWafV1Client() (*golangsdk.ServiceClient, error) {
 	return openstack.NewWAFV1(cc.ProviderClient, golangsdk.EndpointOpts{Region: cc.RegionName})
 }
 
+// NewWafDedicatedV1Client returns authenticated dedicated WAF v1 client
+func NewWafDedicatedV1Client() (*golangsdk.ServiceClient, error) {
+	cc, err := CloudAndClient()
+	if err != nil {
+		return nil, err
+	}
+	return openstack.NewWAFDedicatedV1(cc.ProviderClient, golangsdk.EndpointOpts{Region: cc.RegionName})
+}
+
 // NewCsbsV1Client returns authenticated CSBS v1 client
 func NewCsbsV1Client() (*golangsdk.ServiceClient, error) {
 	cc, err := CloudAndClient()
@@ -531,6 +588,28 @@ func NewDcsV1Client() (*golangsdk.ServiceClient, error) {
 	})
 }
 
+// NewDcsV2Client returns authenticated DCS v2 client
+func NewDcsV2Client() (*golangsdk.ServiceClient, error) {
+	cc, err := CloudAndClient()
+	if err != nil {
+		return nil, err
+	}
+	return openstack.NewDCSServiceV2(cc.ProviderClient, golangsdk.EndpointOpts{
+		Region: cc.RegionName,
+	})
+}
+
+// NewDwsV1Client returns authenticated DWS v1 client
+func NewDwsV1Client() (*golangsdk.ServiceClient, error) {
+	cc, err := CloudAndClient()
+	if err != nil {
+		return nil, err
+	}
+	return openstack.NewDWSV1(cc.ProviderClient, golangsdk.EndpointOpts{
+		Region: cc.RegionName,
+	})
+}
+
 // NewDmsV1Client returns authenticated DMS v1 client
 func NewDmsV1Client() (*golangsdk.ServiceClient, error) {
 	cc, err := CloudAndClient()
@@ -542,6 +621,17 @@ func NewDmsV1Client() (*golangsdk.ServiceClient, error) {
 	})
 }
 
+// NewDmsV2Client returns authenticated DMS v2 client
+func NewDmsV2Client() (*golangsdk.ServiceClient, error) {
+	cc, err := CloudAndClient()
+	if err != nil {
+		return nil, err
+	}
+	return openstack.NewDMSServiceV2(cc.ProviderClient, golangsdk.EndpointOpts{
+		Region: cc.RegionName,
+	})
+}
+
 // NewSwrV2Client returns authenticated SWR v2 client
 func NewSwrV2Client() (client *golangsdk.ServiceClient, err error) {
 	cc, err := CloudAndClient()
@@ -564,6 +654,116 @@ func NewSmnV2Client() (client *golangsdk.ServiceClient, err error) {
 	})
 }
 
+// NewEPSV1Client returns authenticated EPS v1 client
+func NewEPSV1Client() (*golangsdk.ServiceClient, error) {
+	cc, err := CloudAndClient()
+	if err != nil {
+		return nil, err
+	}
+	return openstack.NewEPSV1(cc.ProviderClient, golangsdk.EndpointOpts{
+		Region: cc.RegionName,
+	})
+}
+
+// NewAPIGV2Client returns authenticated APIG v2 client
+func NewAPIGV2Client() (*golangsdk.ServiceClient, error) {
+	cc, err := CloudAndClient()
+	if err != nil {
+		return nil, err
+	}
+	return openstack.NewAPIGV2(cc.ProviderClient, golangsdk.EndpointOpts{
+		Region: cc.RegionName,
+	})
+}
+
+// NewDISV2Client returns authenticated DIS v2 client
+func NewDISV2Client() (*golangsdk.ServiceClient, error) {
+	cc, err := CloudAndClient()
+	if err != nil {
+		return nil, err
+	}
+	return openstack.NewDISV2(cc.ProviderClient, golangsdk.EndpointOpts{
+		Region: cc.RegionName,
+	})
+}
+
+// NewDLIV1Client returns authenticated DLI v1 client
+func NewDLIV1Client() (*golangsdk.ServiceClient, error) {
+	cc, err := CloudAndClient()
+	if err != nil {
+		return nil, err
+	}
+	return openstack.NewDLIV1(cc.ProviderClient, golangsdk.EndpointOpts{
+		Region: cc.RegionName,
+	})
+}
+
+// NewFGSV2Client returns authenticated FunctionGraph v2 client
+func NewFGSV2Client() (*golangsdk.ServiceClient, error) {
+	cc, err := CloudAndClient()
+	if err != nil {
+		return nil, err
+	}
+	return openstack.NewFGSV2(cc.ProviderClient, golangsdk.EndpointOpts{
+		Region: cc.RegionName,
+	})
+}
+
+// NewTMSV1Client returns authenticated TMS v1 client
+func NewTMSV1Client() (*golangsdk.ServiceClient, error) {
+	cc, err := CloudAndClient()
+	if err != nil {
+		return nil, err
+	}
+	return openstack.NewTMSV1(cc.ProviderClient, golangsdk.EndpointOpts{
+		Region: cc.RegionName,
+	})
+}
+
+// NewCDNV1Client returns authenticated CDN v1 client
+func NewCDNV1Client() (*golangsdk.ServiceClient, error) {
+	cc, err := CloudAndClient()
+	if err != nil {
+		return nil, err
+	}
+	return openstack.NewCDNV1(cc.ProviderClient, golangsdk.EndpointOpts{
+		Region: cc.RegionName,
+	})
+}
+
+// NewRMSV1Client returns authenticated RMS v1 client
+func NewRMSV1Client() (*golangsdk.ServiceClient, error) {
+	cc, err := CloudAndClient()
+	if err != nil {
+		return nil, err
+	}
+	return openstack.NewRMSV1(cc.ProviderClient, golangsdk.EndpointOpts{
+		Region: cc.RegionName,
+	})
+}
+
+// NewLTSV2Client returns authenticated LTS v2 client
+func NewLTSV2Client() (*golangsdk.ServiceClient, error) {
+	cc, err := CloudAndClient()
+	if err != nil {
+		return nil, err
+	}
+	return openstack.NewLTSV2(cc.ProviderClient, golangsdk.EndpointOpts{
+		Region: cc.RegionName,
+	})
+}
+
+// NewBSSV2Client returns authenticated BSS v2 client
+func NewBSSV2Client() (*golangsdk.ServiceClient, error) {
+	cc, err := CloudAndClient()
+	if err != nil {
+		return nil, err
+	}
+	return openstack.NewBSSV2(cc.ProviderClient, golangsdk.EndpointOpts{
+		Region: cc.RegionName,
+	})
+}
+
 func UpdatePeerTenantDetails(cloud *openstack.Cloud) error {
 	if id := EnvOS.GetEnv("Peer_Tenant_ID"); id != "" {
 		cloud.AuthInfo.ProjectID = id
@@ -0,0 +1,125 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/apig/v2/apis"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/apig/v2/apps"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/apig/v2/environments"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/apig/v2/groups"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/apig/v2/throttles"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestAPILifecycle(t *testing.T) {
+	instanceID := clients.EnvOS.GetEnv("APIG_INSTANCE_ID")
+	if instanceID == "" {
+		t.Skip("OS_APIG_INSTANCE_ID env var is missing but APIG test requires")
+	}
+
+	client, err := clients.NewAPIGV2Client()
+	th.AssertNoErr(t, err)
+
+	group, err := groups.Create(client, instanceID, groups.GroupOpts{
+		Name: tools.RandomString("group_", 4),
+	}).Extract()
+	th.AssertNoErr(t, err)
+	defer func() {
+		th.AssertNoErr(t, groups.Delete(client, instanceID, group.ID).ExtractErr())
+	}()
+
+	env, err := environments.Create(client, instanceID, environments.EnvironmentOpts{
+		Name: tools.RandomString("env_", 4),
+	}).Extract()
+	th.AssertNoErr(t, err)
+	defer func() {
+		th.AssertNoErr(t, environments.Delete(client, instanceID, env.ID).ExtractErr())
+	}()
+
+	api, err := apis.Create(client, instanceID, apis.APIOpts{
+		GroupID:     group.ID,
+		Name:        tools.RandomString("api_", 4),
+		Type:        apis.TypePublic,
+		ReqProtocol: "HTTPS",
+		ReqMethod:   "GET",
+		ReqURI:      "/mock",
+		AuthType:    apis.AuthApp,
+		BackendType: apis.BackendMock,
+		MockInfo:    &apis.MockInfo{ResultContent: "ok"},
+	}).Extract()
+	th.AssertNoErr(t, err)
+	defer func() {
+		th.AssertNoErr(t, apis.Delete(client, instanceID, api.ID).ExtractErr())
+	}()
+
+	publication, err := apis.Publish(client, instanceID, apis.PublishOpts{
+		Action: apis.ActionOnline,
+		EnvID:  env.ID,
+		ApiID:  api.ID,
+	}).Extract()
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, publication)
+	defer func() {
+		_, err := apis.Publish(client, instanceID, apis.PublishOpts{
+			Action: apis.ActionOffline,
+			EnvID:  env.ID,
+			ApiID:  api.ID,
+		}).Extract()
+		th.AssertNoErr(t, err)
+	}()
+
+	throttle, err := throttles.Create(client, instanceID, throttles.ThrottleOpts{
+		Name:          tools.RandomString("throttle_", 4),
+		ApiCallLimits: 100,
+		TimeInterval:  1,
+		TimeUnit:      throttles.TimeUnitMinute,
+	}).Extract()
+	th.AssertNoErr(t, err)
+	defer func() {
+		th.AssertNoErr(t, throttles.Delete(client, instanceID, throttle.ID).ExtractErr())
+	}()
+
+	bindings, err := throttles.Bind(client, instanceID, throttles.BindOpts{
+		PolicyID:   throttle.ID,
+		PublishIDs: []string{publication.PublishID},
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(bindings))
+	defer func() {
+		th.AssertNoErr(t, throttles.Unbind(client, instanceID, bindings[0].ID).ExtractErr())
+	}()
+
+	bound, err := throttles.ListBoundAPIs(client, instanceID, throttles.ListBoundAPIsOpts{
+		PolicyID: throttle.ID,
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(bound))
+	th.AssertEquals(t, api.ID, bound[0].ID)
+
+	app, err := apps.Create(client, instanceID, apps.AppOpts{
+		Name: tools.RandomString("app_", 4),
+	}).Extract()
+	th.AssertNoErr(t, err)
+	defer func() {
+		th.AssertNoErr(t, apps.Delete(client, instanceID, app.ID).ExtractErr())
+	}()
+
+	auths, err := apps.Authorize(client, instanceID, apps.AuthorizeOpts{
+		EnvID:  env.ID,
+		AppIDs: []string{app.ID},
+		ApiIDs: []string{api.ID},
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(auths))
+	defer func() {
+		th.AssertNoErr(t, apps.Unauthorize(client, instanceID, auths[0].ID).ExtractErr())
+	}()
+
+	authorized, err := apps.ListAuthorizedAPIs(client, instanceID, apps.ListAuthorizedAPIsOpts{
+		AppID: app.ID,
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(authorized))
+}
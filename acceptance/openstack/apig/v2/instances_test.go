@@ -0,0 +1,62 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/openstack"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/apig/v2/instances"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestInstanceLifecycle(t *testing.T) {
+	vpcID := clients.EnvOS.GetEnv("VPC_ID")
+	subnetID := clients.EnvOS.GetEnv("NETWORK_ID")
+	if vpcID == "" || subnetID == "" {
+		t.Skip("One of OS_VPC_ID or OS_NETWORK_ID env vars is missing but APIG test requires using existing network")
+	}
+
+	az := clients.EnvOS.GetEnv("AVAILABILITY_ZONE")
+	if az == "" {
+		az = "eu-de-01"
+	}
+
+	client, err := clients.NewAPIGV2Client()
+	th.AssertNoErr(t, err)
+
+	t.Logf("Attempting to create APIG gateway")
+	instanceID, err := instances.Create(client, instances.CreateOpts{
+		Name:             tools.RandomString("apig-acc-", 4),
+		SpecID:           "BASIC",
+		VpcID:            vpcID,
+		SubnetID:         subnetID,
+		SecurityGroupID:  openstack.DefaultSecurityGroup(t),
+		AvailableZoneIDs: []string{az},
+	}).Extract()
+	th.AssertNoErr(t, err)
+	defer func() {
+		t.Logf("Attempting to delete APIG gateway: %s", instanceID)
+		th.AssertNoErr(t, instances.Delete(client, instanceID).ExtractErr())
+		th.AssertNoErr(t, instances.WaitForDeleted(client, instanceID, 1200))
+		t.Logf("Deleted APIG gateway: %s", instanceID)
+	}()
+
+	th.AssertNoErr(t, instances.WaitForStatus(client, instanceID, instances.StatusRunning, 1800))
+	t.Logf("APIG gateway successfully created: %s", instanceID)
+
+	instance, err := instances.Get(client, instanceID).Extract()
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, instance)
+
+	description := "updated APIG gateway"
+	updated, err := instances.Update(client, instanceID, instances.UpdateOpts{
+		Description: &description,
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, description, updated.Description)
+
+	list, err := instances.List(client, instances.ListOpts{ID: instanceID}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(list))
+}
@@ -40,5 +40,7 @@ func DeleteAutoScalingGroup(t *testing.T, client *golangsdk.ServiceClient, group
 	t.Logf("Attempting to delete AutoScaling Group")
 	err := groups.Delete(client, groupID).ExtractErr()
 	th.AssertNoErr(t, err)
+	err = groups.WaitForDeleted(client, groupID, 600)
+	th.AssertNoErr(t, err)
 	t.Logf("Deleted AutoScaling Group: %s", groupID)
 }
@@ -82,3 +82,102 @@ func TestConfigurationsLifecycle(t *testing.T) {
 	tools.PrintResource(t, config)
 	th.AssertEquals(t, 2, len(config.InstanceConfig.SecurityGroups))
 }
+
+func TestConfigurationsBatchDelete(t *testing.T) {
+	client, err := clients.NewAutoscalingV1Client()
+	th.AssertNoErr(t, err)
+
+	keyPairName := clients.EnvOS.GetEnv("KEYPAIR_NAME")
+	imageID := clients.EnvOS.GetEnv("IMAGE_ID")
+	if keyPairName == "" || imageID == "" {
+		t.Skip("OS_KEYPAIR_NAME or OS_IMAGE_ID env vars is missing but AS Configuration test requires")
+	}
+
+	createOpts := configurations.CreateOpts{
+		InstanceConfig: configurations.InstanceConfigOpts{
+			FlavorRef: "s3.xlarge.4",
+			ImageRef:  imageID,
+			Disk: []configurations.DiskOpts{
+				{
+					Size:       40,
+					VolumeType: "SATA",
+					DiskType:   "SYS",
+				},
+				{
+					Size:       10,
+					VolumeType: "SAS",
+					DiskType:   "DATA",
+				},
+			},
+			SSHKey:   keyPairName,
+			UserData: []byte("#!/bin/bash\necho 'hello' > /tmp/hello"),
+			Personality: []configurations.PersonalityOpts{
+				{
+					Path:    "/etc/motd",
+					Content: "V2VsY29tZQ==",
+				},
+			},
+			PubicIp: &configurations.PublicIpOpts{
+				Eip: configurations.EipOpts{
+					IpType: "5_bgp",
+					Bandwidth: configurations.BandwidthOpts{
+						Size:         5,
+						ShareType:    "PER",
+						ChargingMode: "traffic",
+					},
+				},
+			},
+		},
+	}
+
+	var configIDs []string
+	for i := 0; i < 2; i++ {
+		createOpts.Name = tools.RandomString("as-batch-", 3)
+		configID, err := configurations.Create(client, createOpts).Extract()
+		th.AssertNoErr(t, err)
+		configIDs = append(configIDs, configID)
+	}
+
+	config, err := configurations.Get(client, configIDs[0]).Extract()
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, config)
+	th.AssertEquals(t, 2, len(config.InstanceConfig.Disk))
+	th.AssertEquals(t, 5, config.InstanceConfig.PublicIp.Eip.Bandwidth.Size)
+
+	t.Logf("Attempting to delete AutoScaling Configurations: %v", configIDs)
+	err = configurations.BatchDelete(client, configurations.BatchDeleteOpts{IDs: configIDs}).ExtractErr()
+	th.AssertNoErr(t, err)
+
+	_, err = configurations.Get(client, configIDs[0]).Extract()
+	th.AssertEquals(t, true, err != nil)
+}
+
+func TestConfigurationsCloneFromInstance(t *testing.T) {
+	client, err := clients.NewAutoscalingV1Client()
+	th.AssertNoErr(t, err)
+
+	keyPairName := clients.EnvOS.GetEnv("KEYPAIR_NAME")
+	instanceID := clients.EnvOS.GetEnv("ECS_ID")
+	if keyPairName == "" || instanceID == "" {
+		t.Skip("OS_KEYPAIR_NAME or OS_ECS_ID env vars is missing but AS Configuration clone test requires")
+	}
+
+	createOpts := configurations.CreateOpts{
+		Name: tools.RandomString("as-clone-", 3),
+		InstanceConfig: configurations.InstanceConfigOpts{
+			ID:     instanceID,
+			SSHKey: keyPairName,
+		},
+	}
+	configID, err := configurations.Create(client, createOpts).Extract()
+	th.AssertNoErr(t, err)
+	defer func() {
+		err := configurations.Delete(client, configID).ExtractErr()
+		th.AssertNoErr(t, err)
+	}()
+
+	config, err := configurations.Get(client, configID).Extract()
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, config)
+	th.AssertEquals(t, instanceID, config.InstanceConfig.InstanceID)
+}
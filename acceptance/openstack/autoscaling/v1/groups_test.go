@@ -78,4 +78,18 @@ func TestGroupLifecycle(t *testing.T) {
 	th.AssertEquals(t, asGroupUpdateName, group.Name)
 	th.AssertEquals(t, secGroupID, group.SecurityGroups[0].ID)
 	th.AssertEquals(t, false, group.DeletePublicIP)
+
+	t.Logf("Attempting to update AutoScaling Group capacity")
+	maxNumber := 3
+	capacityOpts := groups.CapacityOpts{
+		MaxInstanceNumber: &maxNumber,
+	}
+	_, err = groups.UpdateCapacity(client, groupID, capacityOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertNoErr(t, groups.WaitForScalingFinished(client, groupID, 600))
+
+	group, err = groups.Get(client, groupID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, maxNumber, group.MaxInstanceNumber)
+	th.AssertEquals(t, asGroupUpdateName, group.Name)
 }
@@ -0,0 +1,54 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/autoscaling/v1/groups"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/autoscaling/v1/instances"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestInstancesManagement(t *testing.T) {
+	client, err := clients.NewAutoscalingV1Client()
+	th.AssertNoErr(t, err)
+
+	groupID := clients.EnvOS.GetEnv("AS_GROUP_ID")
+	if groupID == "" {
+		t.Skip("OS_AS_GROUP_ID env var is missing but AS Instances test requires a group with instances")
+	}
+
+	allPages, err := instances.List(client, groupID, instances.ListOpts{LifeCycleStatus: "INSERVICE"}).AllPages()
+	th.AssertNoErr(t, err)
+	groupInstances, err := allPages.(instances.InstancePage).Extract()
+	th.AssertNoErr(t, err)
+	if len(groupInstances) == 0 {
+		t.Skip("AS group has no instances in service")
+	}
+	tools.PrintResource(t, groupInstances)
+	instanceIDs := []string{groupInstances[0].ID}
+
+	t.Logf("Attempting to protect AS instance: %s", instanceIDs[0])
+	th.AssertNoErr(t, instances.BatchProtect(client, groupID, instanceIDs).ExtractErr())
+	allPages, err = instances.List(client, groupID, instances.ListOpts{ProtectFromScalingDown: "true"}).AllPages()
+	th.AssertNoErr(t, err)
+	protected, err := allPages.(instances.InstancePage).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(protected))
+	th.AssertEquals(t, true, protected[0].ProtectFromScalingDown)
+	th.AssertNoErr(t, instances.BatchUnprotect(client, groupID, instanceIDs).ExtractErr())
+
+	t.Logf("Attempting to move AS instance to standby: %s", instanceIDs[0])
+	th.AssertNoErr(t, instances.BatchEnterStandby(client, groupID, instanceIDs, "no").ExtractErr())
+	th.AssertNoErr(t, groups.WaitForScalingFinished(client, groupID, 600))
+	allPages, err = instances.List(client, groupID, instances.ListOpts{LifeCycleStatus: "STANDBY"}).AllPages()
+	th.AssertNoErr(t, err)
+	standby, err := allPages.(instances.InstancePage).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(standby))
+
+	t.Logf("Attempting to return AS instance to service: %s", instanceIDs[0])
+	th.AssertNoErr(t, instances.BatchExitStandby(client, groupID, instanceIDs).ExtractErr())
+	th.AssertNoErr(t, groups.WaitForScalingFinished(client, groupID, 600))
+}
@@ -0,0 +1,77 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/openstack/autoscaling"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/autoscaling/v1/lifecyclehooks"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/smn/v2/topics"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestLifecycleHookLifecycle(t *testing.T) {
+	client, err := clients.NewAutoscalingV1Client()
+	th.AssertNoErr(t, err)
+
+	networkID := clients.EnvOS.GetEnv("NETWORK_ID")
+	vpcID := clients.EnvOS.GetEnv("VPC_ID")
+	if networkID == "" || vpcID == "" {
+		t.Skip("OS_NETWORK_ID or OS_VPC_ID env vars are missing but AS Lifecycle Hook test requires")
+	}
+
+	smnClient, err := clients.NewSmnV2Client()
+	th.AssertNoErr(t, err)
+	topic, err := topics.Create(smnClient, topics.CreateOps{
+		Name: tools.RandomString("as-hook-topic-", 3),
+	}).Extract()
+	th.AssertNoErr(t, err)
+	defer func() {
+		th.AssertNoErr(t, topics.Delete(smnClient, topic.TopicUrn).ExtractErr())
+	}()
+
+	groupID := autoscaling.CreateAutoScalingGroup(t, client, networkID, vpcID, tools.RandomString("as-group-", 3))
+	defer autoscaling.DeleteAutoScalingGroup(t, client, groupID)
+
+	hookName := tools.RandomString("as-hook-", 3)
+	createOpts := lifecyclehooks.CreateOpts{
+		Name:                 hookName,
+		Type:                 "INSTANCE_TERMINATING",
+		DefaultResult:        "CONTINUE",
+		DefaultTimeout:       600,
+		NotificationTopicURN: topic.TopicUrn,
+		NotificationMetadata: "drain",
+	}
+	t.Logf("Attempting to create AutoScaling Lifecycle Hook")
+	hook, err := lifecyclehooks.Create(client, groupID, createOpts).Extract()
+	th.AssertNoErr(t, err)
+	t.Logf("Created AutoScaling Lifecycle Hook: %s", hook.Name)
+	defer func() {
+		th.AssertNoErr(t, lifecyclehooks.Delete(client, groupID, hookName).ExtractErr())
+		t.Logf("Deleted AutoScaling Lifecycle Hook: %s", hookName)
+	}()
+
+	updateOpts := lifecyclehooks.UpdateOpts{
+		DefaultResult:  "ABANDON",
+		DefaultTimeout: 900,
+	}
+	_, err = lifecyclehooks.Update(client, groupID, hookName, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+
+	hook, err = lifecyclehooks.Get(client, groupID, hookName).Extract()
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, hook)
+	th.AssertEquals(t, "ABANDON", hook.DefaultResult)
+	th.AssertEquals(t, 900, hook.DefaultTimeout)
+
+	hooks, err := lifecyclehooks.List(client, groupID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(hooks))
+
+	instanceHooks, err := lifecyclehooks.ListInstanceHooks(client, groupID, nil).Extract()
+	th.AssertNoErr(t, err)
+	for _, instanceHook := range instanceHooks {
+		tools.PrintResource(t, instanceHook)
+	}
+}
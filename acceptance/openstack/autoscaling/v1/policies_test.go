@@ -0,0 +1,95 @@
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/openstack/autoscaling"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/autoscaling/v1/policies"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestPolicyLifecycle(t *testing.T) {
+	client, err := clients.NewAutoscalingV1Client()
+	th.AssertNoErr(t, err)
+
+	networkID := clients.EnvOS.GetEnv("NETWORK_ID")
+	vpcID := clients.EnvOS.GetEnv("VPC_ID")
+	if networkID == "" || vpcID == "" {
+		t.Skip("OS_NETWORK_ID or OS_VPC_ID env vars are missing but AS Policy test requires")
+	}
+
+	groupID := autoscaling.CreateAutoScalingGroup(t, client, networkID, vpcID, tools.RandomString("as-group-", 3))
+	defer autoscaling.DeleteAutoScalingGroup(t, client, groupID)
+
+	startTime := time.Now().UTC().Add(time.Hour)
+	createOpts := policies.CreateOpts{
+		Name: tools.RandomString("as-policy-", 3),
+		ID:   groupID,
+		Type: "RECURRENCE",
+		SchedulePolicy: policies.SchedulePolicyOpts{
+			LaunchTime:      "10:30",
+			RecurrenceType:  "Weekly",
+			RecurrenceValue: "1,3,5",
+			StartTime:       startTime.Format("2006-01-02T15:04Z"),
+			EndTime:         startTime.AddDate(0, 1, 0).Format("2006-01-02T15:04Z"),
+		},
+		Action: policies.ActionOpts{
+			Operation:   "ADD",
+			InstanceNum: 1,
+		},
+		CoolDownTime: 300,
+	}
+	t.Logf("Attempting to create AutoScaling Policy")
+	policyID, err := policies.Create(client, createOpts).Extract()
+	th.AssertNoErr(t, err)
+	t.Logf("Created AutoScaling Policy: %s", policyID)
+	defer func() {
+		t.Logf("Attempting to delete AutoScaling Policy")
+		th.AssertNoErr(t, policies.Delete(client, policyID).ExtractErr())
+		t.Logf("Deleted AutoScaling Policy: %s", policyID)
+	}()
+
+	policy, err := policies.Get(client, policyID).Extract()
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, policy)
+	th.AssertEquals(t, 300, policy.CoolDownTime)
+
+	allPages, err := policies.List(client, groupID, policies.ListOpts{}).AllPages()
+	th.AssertNoErr(t, err)
+	policyList, err := policies.ExtractPolicies(allPages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(policyList))
+	th.AssertEquals(t, policyID, policyList[0].PolicyID)
+
+	t.Logf("Attempting to pause AutoScaling Policy")
+	th.AssertNoErr(t, policies.Disable(client, policyID).ExtractErr())
+	policy, err = policies.Get(client, policyID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "PAUSED", policy.Status)
+
+	t.Logf("Attempting to resume AutoScaling Policy")
+	th.AssertNoErr(t, policies.Enable(client, policyID).ExtractErr())
+	policy, err = policies.Get(client, policyID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "INSERVICE", policy.Status)
+
+	updateOpts := policies.UpdateOpts{
+		Type:           createOpts.Type,
+		SchedulePolicy: createOpts.SchedulePolicy,
+		Action:         createOpts.Action,
+		CoolDownTime:   600,
+	}
+	_, err = policies.Update(client, policyID, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+
+	logPages, err := policies.ListLogs(client, policyID, policies.ListLogsOpts{}).AllPages()
+	th.AssertNoErr(t, err)
+	logs, err := policies.ExtractLogs(logPages)
+	th.AssertNoErr(t, err)
+	for _, log := range logs {
+		tools.PrintResource(t, log)
+	}
+}
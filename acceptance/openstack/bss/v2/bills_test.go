@@ -0,0 +1,46 @@
+package v2
+
+import (
+	"testing"
+	"time"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/bss/v2/accounts"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/bss/v2/bills"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestBillsQuery(t *testing.T) {
+	client, err := clients.NewBSSV2Client()
+	th.AssertNoErr(t, err)
+
+	cycle := time.Now().AddDate(0, -1, 0).Format("2006-01")
+
+	sum, err := bills.GetMonthlySum(client, bills.MonthlySumOpts{
+		BillCycle: cycle,
+	}).Extract()
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, sum)
+
+	pages, err := bills.ListResourceRecords(client, bills.ResourceRecordsOpts{
+		Cycle: cycle,
+		Limit: 100,
+	}).AllPages()
+	th.AssertNoErr(t, err)
+
+	records, err := bills.ExtractResourceRecords(pages)
+	th.AssertNoErr(t, err)
+	for _, record := range records {
+		th.AssertEquals(t, cycle, record.BillDate[:len(cycle)])
+	}
+}
+
+func TestAccountBalances(t *testing.T) {
+	client, err := clients.NewBSSV2Client()
+	th.AssertNoErr(t, err)
+
+	balances, err := accounts.GetBalances(client).Extract()
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, balances)
+}
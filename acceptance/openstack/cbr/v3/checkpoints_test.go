@@ -0,0 +1,59 @@
+package v3
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/openstack"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/cbr/v3/checkpoints"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/cbr/v3/vaults"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestCheckpointLifecycle(t *testing.T) {
+	client, err := clients.NewCbrV3Client()
+	th.AssertNoErr(t, err)
+
+	volume := openstack.CreateVolume(t)
+	defer openstack.DeleteVolume(t, volume.ID)
+
+	opts := vaults.CreateOpts{
+		Billing: &vaults.BillingCreate{
+			ConsistentLevel: "crash_consistent",
+			ObjectType:      "disk",
+			ProtectType:     "backup",
+			Size:            100,
+		},
+		Description: "gophertelemocloud testing vault",
+		Name:        tools.RandomString("cbr-test-", 5),
+		Resources: []vaults.ResourceCreate{
+			{
+				ID:   volume.ID,
+				Type: "OS::Cinder::Volume",
+			},
+		},
+	}
+	vault, err := vaults.Create(client, opts).Extract()
+	th.AssertNoErr(t, err)
+
+	defer func() {
+		th.AssertNoErr(t, vaults.Delete(client, vault.ID).ExtractErr())
+	}()
+
+	checkpoint, err := checkpoints.Create(client, checkpoints.CreateOpts{
+		VaultID: vault.ID,
+		Parameters: &checkpoints.CheckpointParam{
+			Name:        tools.RandomString("cbr-checkpoint-", 5),
+			Description: "gophertelemocloud testing checkpoint",
+		},
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, vault.ID, checkpoint.Vault.ID)
+
+	th.AssertNoErr(t, checkpoints.WaitForCheckpointAvailable(client, checkpoint.ID, 600))
+
+	current, err := checkpoints.Get(client, checkpoint.ID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, checkpoints.StatusAvailable, current.Status)
+}
@@ -4,7 +4,6 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/opentelekomcloud/gophertelekomcloud"
 	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
 	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/cce/v3/clusters"
@@ -41,44 +40,18 @@ func CreateCluster(t *testing.T, vpcID, subnetID string) string {
 	}).Extract()
 	th.AssertNoErr(t, err)
 
-	th.AssertNoErr(t, waitForClusterToActivate(client, cluster.Metadata.Id, 30*60))
+	th.AssertNoErr(t, clusters.WaitForStatus(client, cluster.Metadata.Id, "Available", 30*60))
 	return cluster.Metadata.Id
 }
 
 func DeleteCluster(t *testing.T, clusterID string) {
 	client, err := clients.NewCceV3Client()
 	th.AssertNoErr(t, err)
-	clusters.Delete(client, clusterID)
-	th.AssertNoErr(t, waitForClusterToDelete(client, clusterID, 20*60))
-}
-
-func waitForClusterToActivate(client *golangsdk.ServiceClient, id string, secs int) error {
-	return golangsdk.WaitFor(secs, func() (bool, error) {
-		cluster, err := clusters.Get(client, id).Extract()
-		if err != nil {
-			return false, err
-		}
-		if cluster == nil {
-			return false, nil
-		}
-		if cluster.Status.Phase == "Available" {
-			return true, nil
-		}
-		return false, nil
-	})
-}
-
-func waitForClusterToDelete(client *golangsdk.ServiceClient, id string, secs int) error {
-	return golangsdk.WaitFor(secs, func() (bool, error) {
-		_, err := clusters.Get(client, id).Extract()
-		if err != nil {
-			if _, ok := err.(golangsdk.ErrDefault404); ok {
-				return true, nil
-			}
-			return false, err
-		}
-		return false, nil
-	})
+	th.AssertNoErr(t, clusters.DeleteWithOpts(client, clusterID, clusters.DeleteOpts{
+		DeleteEvs: "try",
+		DeleteNet: "try",
+	}).ExtractErr())
+	th.AssertNoErr(t, clusters.WaitForDeleted(client, clusterID, 20*60))
 }
 
 func CreateKeypair(t *testing.T) string {
@@ -0,0 +1,48 @@
+package v3
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/openstack/cce"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/cce/v3/clusters"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestClusterLifecycle(t *testing.T) {
+	vpcID := clients.EnvOS.GetEnv("VPC_ID")
+	subnetID := clients.EnvOS.GetEnv("NETWORK_ID")
+	if vpcID == "" || subnetID == "" {
+		t.Skip("OS_VPC_ID and OS_NETWORK_ID are required for this test")
+	}
+
+	client, err := clients.NewCceV3Client()
+	th.AssertNoErr(t, err)
+
+	clusterID := cce.CreateCluster(t, vpcID, subnetID)
+	t.Cleanup(func() {
+		cce.DeleteCluster(t, clusterID)
+	})
+
+	cluster, err := clusters.Update(client, clusterID, clusters.UpdateOpts{
+		Spec: clusters.UpdateSpec{
+			Description: "updated by gophertelekomcloud",
+		},
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "updated by gophertelekomcloud", cluster.Spec.Description)
+	tools.PrintResource(t, cluster)
+
+	kubeConfig, err := clusters.GetCertWithExpiration(client, clusterID, clusters.GetCertOpts{
+		Duration: 1,
+	}).ExtractKubeConfig()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, len(kubeConfig) > 0)
+
+	th.AssertNoErr(t, clusters.Hibernate(client, clusterID).ExtractErr())
+	th.AssertNoErr(t, clusters.WaitForStatus(client, clusterID, "Hibernation", 10*60))
+
+	th.AssertNoErr(t, clusters.Awake(client, clusterID).ExtractErr())
+	th.AssertNoErr(t, clusters.WaitForStatus(client, clusterID, "Available", 10*60))
+}
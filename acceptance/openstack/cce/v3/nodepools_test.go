@@ -0,0 +1,82 @@
+package v3
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/openstack/cce"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/cce/v3/nodepools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/cce/v3/nodes"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func (s *testNodes) TestNodePoolLifecycle() {
+	t := s.T()
+	client, err := clients.NewCceV3Client()
+	th.AssertNoErr(t, err)
+
+	kp := cce.CreateKeypair(t)
+	defer cce.DeleteKeypair(t, kp)
+
+	pool, err := nodepools.Create(client, s.clusterID, nodepools.CreateOpts{
+		Kind:       "NodePool",
+		ApiVersion: "v3",
+		Metadata: nodepools.CreateMetaData{
+			Name: "nodepool-test",
+		},
+		Spec: nodepools.CreateSpec{
+			Type: "vm",
+			NodeTemplate: nodes.Spec{
+				Flavor: "s2.xlarge.2",
+				Az:     "eu-de-01",
+				Os:     "EulerOS 2.5",
+				Login: nodes.LoginSpec{
+					SshKey: kp,
+				},
+				RootVolume: nodes.VolumeSpec{
+					Size:       40,
+					VolumeType: "SSD",
+				},
+				DataVolumes: []nodes.VolumeSpec{
+					{
+						Size:       100,
+						VolumeType: "SSD",
+					},
+				},
+				Count:   1,
+				K8sTags: map[string]string{"pool": "gopher"},
+				Taints: []nodes.TaintSpec{
+					{
+						Key:    "dedicated",
+						Value:  "gopher",
+						Effect: "NoSchedule",
+					},
+				},
+			},
+			InitialNodeCount: 1,
+			Autoscaling: nodepools.AutoscalingSpec{
+				Enable:       true,
+				MinNodeCount: 1,
+				MaxNodeCount: 3,
+			},
+		},
+	}).Extract()
+	th.AssertNoErr(t, err)
+	poolID := pool.Metadata.Id
+
+	defer func() {
+		th.AssertNoErr(t, nodepools.Delete(client, s.clusterID, poolID).ExtractErr())
+		th.AssertNoErr(t, nodepools.WaitForDeleted(client, s.clusterID, poolID, 1800))
+	}()
+
+	th.AssertNoErr(t, nodepools.WaitForNodeCount(client, s.clusterID, poolID, 1, 1800))
+
+	err = nodepools.Scale(client, s.clusterID, poolID, nodepools.ScaleOpts{
+		Kind:       "NodePool",
+		ApiVersion: "v3",
+		Spec: nodepools.ScaleSpec{
+			DesiredNodeCount: 2,
+			ScaleGroups:      []string{"default"},
+		},
+	}).ExtractErr()
+	th.AssertNoErr(t, err)
+	th.AssertNoErr(t, nodepools.WaitForNodeCount(client, s.clusterID, poolID, 2, 1800))
+}
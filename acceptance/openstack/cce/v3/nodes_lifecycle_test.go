@@ -0,0 +1,79 @@
+package v3
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/openstack/cce"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/cce/v3/nodes"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func (s *testNodes) TestNodeRemoveAndAdd() {
+	t := s.T()
+	client, err := clients.NewCceV3Client()
+	th.AssertNoErr(t, err)
+
+	kp := cce.CreateKeypair(t)
+	defer cce.DeleteKeypair(t, kp)
+
+	node, err := nodes.Create(client, s.clusterID, nodes.CreateOpts{
+		Kind:       "Node",
+		ApiVersion: "v3",
+		Metadata: nodes.CreateMetaData{
+			Name: "nodes-lifecycle-test",
+		},
+		Spec: nodes.Spec{
+			Flavor: "s2.xlarge.2",
+			Az:     "eu-de-01",
+			Os:     "EulerOS 2.5",
+			Login: nodes.LoginSpec{
+				SshKey: kp,
+			},
+			RootVolume: nodes.VolumeSpec{
+				Size:       40,
+				VolumeType: "SSD",
+			},
+			DataVolumes: []nodes.VolumeSpec{
+				{
+					Size:       100,
+					VolumeType: "SSD",
+				},
+			},
+			Count: 1,
+		},
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertNoErr(t, nodes.WaitForJobSuccess(client, node.Status.JobID, 1800))
+
+	state, err := nodes.Get(client, s.clusterID, node.Metadata.Id).Extract()
+	th.AssertNoErr(t, err)
+	serverID := state.Status.ServerID
+
+	jobID, err := nodes.Remove(client, s.clusterID, nodes.RemoveOpts{
+		Login: nodes.LoginSpec{SshKey: kp},
+		Nodes: []nodes.NodeRef{{ID: node.Metadata.Id}},
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertNoErr(t, nodes.WaitForJobSuccess(client, jobID, 1800))
+
+	jobID, err = nodes.Add(client, s.clusterID, nodes.AddOpts{
+		NodeList: []nodes.AddNode{
+			{
+				ServerID: serverID,
+				Spec: nodes.ReinstallSpec{
+					Os:    "EulerOS 2.5",
+					Login: nodes.LoginSpec{SshKey: kp},
+				},
+			},
+		},
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertNoErr(t, nodes.WaitForJobSuccess(client, jobID, 1800))
+
+	list, err := nodes.List(client, s.clusterID, nodes.ListOpts{})
+	th.AssertNoErr(t, err)
+	for _, n := range list {
+		if n.Status.ServerID == serverID {
+			th.AssertNoErr(t, nodes.Delete(client, s.clusterID, n.Metadata.Id).ExtractErr())
+		}
+	}
+}
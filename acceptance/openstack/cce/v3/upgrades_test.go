@@ -0,0 +1,40 @@
+package v3
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/cce/v3/clusters"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/cce/v3/upgrades"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestClusterUpgradePreCheck(t *testing.T) {
+	clusterID := clients.EnvOS.GetEnv("CCE_CLUSTER_ID")
+	targetVersion := clients.EnvOS.GetEnv("CCE_TARGET_VERSION")
+	if clusterID == "" || targetVersion == "" {
+		t.Skip("OS_CCE_CLUSTER_ID and OS_CCE_TARGET_VERSION are required for this test")
+	}
+
+	client, err := clients.NewCceV3Client()
+	th.AssertNoErr(t, err)
+
+	cluster, err := clusters.Get(client, clusterID).Extract()
+	th.AssertNoErr(t, err)
+
+	check, err := upgrades.PreCheck(client, clusterID, upgrades.PreCheckOpts{
+		ClusterVersion: cluster.Spec.Version,
+		TargetVersion:  targetVersion,
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertNoErr(t, upgrades.WaitForPreCheck(client, clusterID, check.Metadata.UID, 10*60))
+
+	result, err := upgrades.GetPreCheck(client, clusterID, check.Metadata.UID).Extract()
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, result)
+
+	tasks, err := upgrades.ListTasks(client, clusterID).Extract()
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, tasks)
+}
@@ -0,0 +1,83 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/cdn/v1/domains"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/cdn/v1/tasks"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestDomainLifecycle(t *testing.T) {
+	domainName := clients.EnvOS.GetEnv("CDN_DOMAIN_NAME")
+	originName := clients.EnvOS.GetEnv("CDN_ORIGIN_NAME")
+	if domainName == "" || originName == "" {
+		t.Skip("OS_CDN_DOMAIN_NAME or OS_CDN_ORIGIN_NAME env vars are missing but CDN test requires")
+	}
+
+	client, err := clients.NewCDNV1Client()
+	th.AssertNoErr(t, err)
+
+	domain, err := domains.Create(client, domains.CreateOpts{
+		DomainName:   domainName,
+		BusinessType: domains.BusinessTypeWeb,
+		Sources: []domains.Source{
+			{
+				IPOrDomain:    originName,
+				OriginType:    domains.OriginTypeDomain,
+				ActiveStandby: 1,
+			},
+		},
+	}).Extract()
+	th.AssertNoErr(t, err)
+	defer func() {
+		_, err := domains.Disable(client, domain.ID).Extract()
+		th.AssertNoErr(t, err)
+		th.AssertNoErr(t, domains.WaitForStatus(client, domain.ID, domains.StatusOffline, 600))
+		_, err = domains.Delete(client, domain.ID, nil).Extract()
+		th.AssertNoErr(t, err)
+		th.AssertNoErr(t, domains.WaitForDeleted(client, domain.ID, 600))
+	}()
+
+	th.AssertNoErr(t, domains.WaitForStatus(client, domain.ID, domains.StatusOnline, 1200))
+
+	domain, err = domains.Get(client, domain.ID, nil).Extract()
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, domain)
+	th.AssertEquals(t, domainName, domain.DomainName)
+
+	cacheOpts := domains.CacheConfig{
+		Rules: []domains.CacheRule{
+			{
+				RuleType: domains.CacheRuleExtension,
+				Content:  ".jpg;.png",
+				TTL:      1,
+				TTLType:  domains.TTLDays,
+				Priority: 2,
+			},
+		},
+	}
+	_, err = domains.UpdateCache(client, domain.ID, cacheOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertNoErr(t, domains.WaitForStatus(client, domain.ID, domains.StatusOnline, 600))
+
+	cache, err := domains.GetCache(client, domain.ID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(cache.Rules))
+
+	_, err = domains.UpdateIPACL(client, domain.ID, domains.IPACL{
+		Type:   domains.ACLBlacklist,
+		IPList: []string{"192.0.2.1"},
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertNoErr(t, domains.WaitForStatus(client, domain.ID, domains.StatusOnline, 600))
+
+	task, err := tasks.Refresh(client, tasks.RefreshOpts{
+		Type: tasks.RefreshDirectory,
+		URLs: []string{"http://" + domainName + "/"},
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertNoErr(t, tasks.WaitForTask(client, task.ID, 600))
+}
@@ -0,0 +1,71 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/csms/v1/secrets"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestSecretLifecycle(t *testing.T) {
+	client, err := clients.NewCSMSV1Client()
+	th.AssertNoErr(t, err)
+	kmsClient, err := clients.NewKMSV1Client()
+	th.AssertNoErr(t, err)
+
+	password, err := secrets.GeneratePassword(kmsClient, secrets.PasswordOpts{Length: 16})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 16, len(password))
+
+	createOpts := secrets.CreateOpts{
+		Name:         tools.RandomString("csms-secret-", 5),
+		Description:  "some description",
+		SecretString: password,
+	}
+	secret, err := secrets.Create(client, createOpts).Extract()
+	th.AssertNoErr(t, err)
+
+	defer func() {
+		th.AssertNoErr(t, secrets.Delete(client, secret.Name).ExtractErr())
+	}()
+
+	description := "updated description"
+	_, err = secrets.Update(client, secret.Name, secrets.UpdateOpts{Description: &description}).Extract()
+	th.AssertNoErr(t, err)
+
+	secret, err = secrets.Get(client, secret.Name).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, description, secret.Description)
+
+	allPages, err := secrets.List(client, secrets.ListOpts{}).AllPages()
+	th.AssertNoErr(t, err)
+	secretList, err := secrets.ExtractSecrets(allPages)
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, secretList)
+
+	latest, err := secrets.GetVersion(client, secret.Name, secrets.VersionLatest).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, password, latest.SecretString)
+
+	version, err := secrets.CreateVersion(client, secret.Name, secrets.CreateVersionOpts{
+		SecretString: "new-secret-value",
+	}).Extract()
+	th.AssertNoErr(t, err)
+
+	allPages, err = secrets.ListVersions(client, secret.Name, secrets.ListOpts{}).AllPages()
+	th.AssertNoErr(t, err)
+	versions, err := secrets.ExtractVersions(allPages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, len(versions))
+
+	stage, err := secrets.UpdateStage(client, secret.Name, "test-stage", version.ID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, version.ID, stage.VersionID)
+
+	stage, err = secrets.GetStage(client, secret.Name, "test-stage").Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, version.ID, stage.VersionID)
+	th.AssertNoErr(t, secrets.DeleteStage(client, secret.Name, "test-stage").ExtractErr())
+}
@@ -87,4 +87,7 @@ func TestClusterWorkflow(t *testing.T) {
 	th.AssertNoErr(t, err)
 
 	th.AssertNoErr(t, clusters.WaitForClusterToExtend(client, created.ID, timeout))
+
+	th.AssertNoErr(t, clusters.Restart(client, created.ID).ExtractErr())
+	th.AssertNoErr(t, clusters.WaitForClusterOperationSucces(client, created.ID, timeout))
 }
@@ -51,9 +51,38 @@ func TestSnapshotWorkflow(t *testing.T) {
 	th.AssertEquals(t, policyOpts.Prefix, policy.Prefix)
 	tools.PrintResource(t, policy)
 
+	snapshot, err := snapshots.Create(client, snapshots.CreateOpts{
+		Name: tools.RandomString("snap-", 4),
+	}, clusterID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertNoErr(t, waitForSnapshot(client, clusterID, snapshot.ID, 600))
+
+	err = snapshots.Restore(client, clusterID, snapshot.ID, snapshots.RestoreOpts{
+		TargetCluster: clusterID,
+	}).ExtractErr()
+	th.AssertNoErr(t, err)
+	th.AssertNoErr(t, clusters.WaitForClusterOperationSucces(client, clusterID, 1200))
+
+	th.AssertNoErr(t, snapshots.Delete(client, clusterID, snapshot.ID).ExtractErr())
+
 	th.AssertNoErr(t, snapshots.Disable(client, clusterID).ExtractErr())
 }
 
+func waitForSnapshot(client *golangsdk.ServiceClient, clusterID, snapshotID string, secs int) error {
+	return golangsdk.WaitFor(secs, func() (bool, error) {
+		list, err := snapshots.List(client, clusterID).Extract()
+		if err != nil {
+			return false, err
+		}
+		for _, snapshot := range list {
+			if snapshot.ID == snapshotID {
+				return snapshot.Status == "COMPLETED", nil
+			}
+		}
+		return false, nil
+	})
+}
+
 func createBucket(t *testing.T) string {
 	bucketName := "snapshot-sdk-test-bucket"
 	createOpts := &obs.CreateBucketInput{
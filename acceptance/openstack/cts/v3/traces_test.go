@@ -0,0 +1,40 @@
+package v3
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/cts/v3/traces"
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestTracesList(t *testing.T) {
+	client, err := clients.NewCTSV3Client()
+	th.AssertNoErr(t, err)
+
+	now := time.Now()
+	opts := traces.ListOpts{
+		TraceType: "system",
+		Limit:     50,
+		From:      strconv.FormatInt(now.Add(-24*time.Hour).UnixNano()/int64(time.Millisecond), 10),
+		To:        strconv.FormatInt(now.UnixNano()/int64(time.Millisecond), 10),
+	}
+	pages := 0
+	err = traces.List(client, opts).EachPage(func(page pagination.Page) (bool, error) {
+		pageTraces, err := traces.ExtractTraces(page)
+		if err != nil {
+			return false, err
+		}
+		for _, trace := range pageTraces {
+			tools.PrintResource(t, trace)
+		}
+		pages++
+		// checking the marker pagination is enough
+		return pages < 2, nil
+	})
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,89 @@
+package v3
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/cts/v3/tracker"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/obs"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func createOBSBucket(t *testing.T) string {
+	client, err := clients.NewOBSClientWithoutHeader()
+	th.AssertNoErr(t, err)
+	bucketName := strings.ToLower(tools.RandomString("obs-cts-test", 5))
+
+	_, err = client.CreateBucket(&obs.CreateBucketInput{
+		Bucket: bucketName,
+	})
+	th.AssertNoErr(t, err)
+	t.Logf("Created OBS Bucket: %s", bucketName)
+	return bucketName
+}
+
+func deleteOBSBucket(t *testing.T, bucketName string) {
+	client, err := clients.NewOBSClientWithoutHeader()
+	th.AssertNoErr(t, err)
+
+	_, err = client.DeleteBucket(bucketName)
+	th.AssertNoErr(t, err)
+	t.Logf("Deleted OBS Bucket: %s", bucketName)
+}
+
+func TestDataTrackerLifecycle(t *testing.T) {
+	client, err := clients.NewCTSV3Client()
+	th.AssertNoErr(t, err)
+
+	trackedBucket := createOBSBucket(t)
+	defer deleteOBSBucket(t, trackedBucket)
+	transferBucket := createOBSBucket(t)
+	defer deleteOBSBucket(t, transferBucket)
+
+	trackerName := tools.RandomString("cts-data-", 3)
+	enabled := true
+	createOpts := tracker.CreateOpts{
+		TrackerType:       tracker.TypeData,
+		TrackerName:       trackerName,
+		IsSupportValidate: &enabled,
+		ObsInfo: &tracker.ObsInfo{
+			BucketName:     transferBucket,
+			FilePrefixName: "audit",
+		},
+		DataBucket: &tracker.DataBucket{
+			DataBucketName: trackedBucket,
+			DataEvent:      []string{"READ", "WRITE"},
+		},
+	}
+	t.Logf("Attempting to create CTSv3 Tracker")
+	ctsTracker, err := tracker.Create(client, createOpts).Extract()
+	th.AssertNoErr(t, err)
+	defer func() {
+		err := tracker.Delete(client, tracker.DeleteOpts{
+			TrackerName: trackerName,
+			TrackerType: tracker.TypeData,
+		}).ExtractErr()
+		th.AssertNoErr(t, err)
+		t.Logf("Deleted CTSv3 Tracker: %s", trackerName)
+	}()
+	th.AssertEquals(t, trackerName, ctsTracker.TrackerName)
+	th.AssertEquals(t, true, ctsTracker.IsSupportValidate)
+
+	updateOpts := tracker.UpdateOpts{
+		TrackerType: tracker.TypeData,
+		TrackerName: trackerName,
+		Status:      "disabled",
+	}
+	th.AssertNoErr(t, tracker.Update(client, updateOpts).ExtractErr())
+
+	trackers, err := tracker.List(client, tracker.ListOpts{
+		TrackerName: trackerName,
+		TrackerType: tracker.TypeData,
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(trackers))
+	th.AssertEquals(t, "disabled", trackers[0].Status)
+	th.AssertEquals(t, trackedBucket, trackers[0].DataBucket.DataBucketName)
+}
@@ -0,0 +1,135 @@
+package v2
+
+import (
+	"fmt"
+	"testing"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dcs/v2/backups"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dcs/v2/instances"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dcs/v2/whitelists"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestDcsBackupLifeCycle(t *testing.T) {
+	client, err := clients.NewDcsV2Client()
+	th.AssertNoErr(t, err)
+
+	dcsInstance := createDCSInstance(t, client)
+	defer deleteDCSInstance(t, client, dcsInstance.InstanceID)
+
+	t.Logf("Attempting to update backup policy of DCSv2 instance")
+	err = instances.Update(client, dcsInstance.InstanceID, instances.UpdateOpts{
+		InstanceBackupPolicy: &instances.InstanceBackupPolicy{
+			SaveDays:   2,
+			BackupType: "auto",
+			PeriodicalBackupPlan: instances.PeriodicalBackupPlan{
+				BeginAt:    "00:00-01:00",
+				PeriodType: "weekly",
+				BackupAt:   []int{1, 4},
+			},
+		},
+	}).ExtractErr()
+	th.AssertNoErr(t, err)
+
+	t.Logf("Attempting to create DCSv2 backup")
+	backupID, err := backups.Create(client, dcsInstance.InstanceID, backups.CreateOpts{
+		Remark:       "acceptance backup",
+		BackupFormat: "rdb",
+	}).Extract()
+	th.AssertNoErr(t, err)
+	defer func() {
+		t.Logf("Attempting to delete DCSv2 backup: %s", backupID)
+		th.AssertNoErr(t, backups.Delete(client, dcsInstance.InstanceID, backupID).ExtractErr())
+	}()
+	waitForBackupSucceed(t, client, dcsInstance.InstanceID, backupID)
+
+	t.Logf("Attempting to restore DCSv2 backup: %s", backupID)
+	restoreID, err := backups.Restore(client, dcsInstance.InstanceID, backups.RestoreOpts{
+		BackupID: backupID,
+		Remark:   "acceptance restore",
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertNoErr(t, instances.WaitForStatus(client, dcsInstance.InstanceID, "RUNNING", 600))
+
+	pages, err := backups.ListRestores(client, dcsInstance.InstanceID, nil).AllPages()
+	th.AssertNoErr(t, err)
+	restores, err := backups.ExtractRestores(pages)
+	th.AssertNoErr(t, err)
+	found := false
+	for _, restore := range restores {
+		if restore.RestoreID == restoreID {
+			found = true
+			tools.PrintResource(t, restore)
+		}
+	}
+	th.AssertEquals(t, true, found)
+}
+
+func TestDcsWhitelistLifeCycle(t *testing.T) {
+	client, err := clients.NewDcsV2Client()
+	th.AssertNoErr(t, err)
+
+	dcsInstance := createDCSInstance(t, client)
+	defer deleteDCSInstance(t, client, dcsInstance.InstanceID)
+
+	t.Logf("Attempting to set whitelist of DCSv2 instance")
+	enable := true
+	opts := whitelists.WhitelistOpts{
+		Enable: &enable,
+		Groups: []whitelists.WhitelistGroupOpts{
+			{
+				GroupName: "test-group-1",
+				IPList:    []string{"10.10.10.1", "10.10.10.2"},
+			},
+			{
+				GroupName: "test-group-2",
+				IPList:    []string{"10.10.20.0/24"},
+			},
+		},
+	}
+	th.AssertNoErr(t, whitelists.Put(client, dcsInstance.InstanceID, opts).ExtractErr())
+	th.AssertNoErr(t, instances.WaitForStatus(client, dcsInstance.InstanceID, "RUNNING", 300))
+
+	whitelist, err := whitelists.Get(client, dcsInstance.InstanceID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, whitelist.Enable)
+	th.AssertEquals(t, 2, len(whitelist.Groups))
+
+	t.Logf("Attempting to remove whitelist group of DCSv2 instance")
+	opts.Groups = opts.Groups[:1]
+	th.AssertNoErr(t, whitelists.Put(client, dcsInstance.InstanceID, opts).ExtractErr())
+	th.AssertNoErr(t, instances.WaitForStatus(client, dcsInstance.InstanceID, "RUNNING", 300))
+
+	whitelist, err = whitelists.Get(client, dcsInstance.InstanceID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(whitelist.Groups))
+	tools.PrintResource(t, whitelist)
+}
+
+func waitForBackupSucceed(t *testing.T, client *golangsdk.ServiceClient, instanceID, backupID string) {
+	t.Logf("Waiting for DCSv2 backup to succeed: %s", backupID)
+	err := golangsdk.WaitFor(600, func() (bool, error) {
+		pages, err := backups.List(client, instanceID, nil).AllPages()
+		if err != nil {
+			return false, err
+		}
+		list, err := backups.ExtractBackups(pages)
+		if err != nil {
+			return false, err
+		}
+		for _, backup := range list {
+			if backup.BackupID != backupID {
+				continue
+			}
+			if backup.Status == "failed" {
+				return false, fmt.Errorf("backup %s failed: %s", backupID, backup.ErrorCode)
+			}
+			return backup.Status == "succeed", nil
+		}
+		return false, nil
+	})
+	th.AssertNoErr(t, err)
+}
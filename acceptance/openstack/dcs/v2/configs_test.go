@@ -0,0 +1,72 @@
+package v2
+
+import (
+	"testing"
+	"time"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dcs/v2/configs"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dcs/v2/instances"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestDcsConfigsAndResize(t *testing.T) {
+	client, err := clients.NewDcsV2Client()
+	th.AssertNoErr(t, err)
+
+	dcsInstance := createDCSInstance(t, client)
+	defer deleteDCSInstance(t, client, dcsInstance.InstanceID)
+
+	t.Logf("Attempting to update maintenance window of DCSv2 instance")
+	err = instances.Update(client, dcsInstance.InstanceID, instances.UpdateOpts{
+		MaintainBegin: "02:00:00",
+		MaintainEnd:   "06:00:00",
+	}).ExtractErr()
+	th.AssertNoErr(t, err)
+
+	updated, err := instances.Get(client, dcsInstance.InstanceID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "02:00:00", updated.MaintainBegin)
+
+	params, err := configs.List(client, dcsInstance.InstanceID).Extract()
+	th.AssertNoErr(t, err)
+	var timeout configs.ResultRedisConfig
+	for _, param := range params.RedisConfigs {
+		if param.ParamName == "timeout" {
+			timeout = param
+		}
+	}
+	th.AssertEquals(t, "timeout", timeout.ParamName)
+
+	t.Logf("Attempting to update configuration parameters of DCSv2 instance")
+	err = configs.Update(client, dcsInstance.InstanceID, configs.UpdateOpts{
+		RedisConfigs: []configs.RedisConfig{
+			{
+				ParamID:    timeout.ParamID,
+				ParamName:  timeout.ParamName,
+				ParamValue: "100",
+			},
+		},
+	}).ExtractErr()
+	th.AssertNoErr(t, err)
+	th.AssertNoErr(t, instances.WaitForStatus(client, dcsInstance.InstanceID, "RUNNING", 300))
+
+	params, err = configs.List(client, dcsInstance.InstanceID).Extract()
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, params)
+
+	t.Logf("Attempting to resize DCSv2 instance")
+	resizeStart := time.Now()
+	err = instances.Resize(client, dcsInstance.InstanceID, instances.ResizeOpts{
+		SpecCode:    "redis.ha.xu1.tiny.r2.256",
+		NewCapacity: 0.25,
+	}).ExtractErr()
+	th.AssertNoErr(t, err)
+	th.AssertNoErr(t, instances.WaitForTaskCompleted(client, dcsInstance.InstanceID, instances.TaskModify, resizeStart, 1200))
+	th.AssertNoErr(t, instances.WaitForStatus(client, dcsInstance.InstanceID, "RUNNING", 600))
+
+	resized, err := instances.Get(client, dcsInstance.InstanceID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "redis.ha.xu1.tiny.r2.256", resized.SpecCode)
+}
@@ -0,0 +1,63 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dcs/v2/instances"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func createDCSInstance(t *testing.T, client *golangsdk.ServiceClient) *instances.Instance {
+	t.Logf("Attempting to create DCSv2 instance")
+
+	vpcID := clients.EnvOS.GetEnv("VPC_ID")
+	networkID := clients.EnvOS.GetEnv("NETWORK_ID")
+	if vpcID == "" || networkID == "" {
+		t.Skip("OS_VPC_ID or OS_NETWORK_ID is missing but test requires using existing network")
+	}
+
+	az := clients.EnvOS.GetEnv("AVAILABILITY_ZONE")
+	if az == "" {
+		az = "eu-de-01"
+	}
+
+	createOpts := instances.CreateOpts{
+		Name:          tools.RandomString("dcs-instance-", 3),
+		Description:   "some test DCSv2 instance",
+		Engine:        "Redis",
+		EngineVersion: "5.0",
+		Capacity:      0.125,
+		SpecCode:      "redis.ha.xu1.tiny.r2.128",
+		AzCodes:       []string{az},
+		Password:      "Qwerty123!",
+		VpcID:         vpcID,
+		SubnetID:      networkID,
+	}
+
+	created, err := instances.Create(client, createOpts).Extract()
+	th.AssertNoErr(t, err)
+	instanceID := created.Instances[0].InstanceID
+
+	err = instances.WaitForStatus(client, instanceID, "RUNNING", 900)
+	th.AssertNoErr(t, err)
+	t.Logf("DCSv2 instance successfully created: %s", instanceID)
+
+	dcsInstance, err := instances.Get(client, instanceID).Extract()
+	th.AssertNoErr(t, err)
+
+	return dcsInstance
+}
+
+func deleteDCSInstance(t *testing.T, client *golangsdk.ServiceClient, instanceID string) {
+	t.Logf("Attempting to delete DCSv2 instance: %s", instanceID)
+
+	err := instances.Delete(client, instanceID).ExtractErr()
+	th.AssertNoErr(t, err)
+
+	err = instances.WaitForDeleted(client, instanceID, 600)
+	th.AssertNoErr(t, err)
+	t.Logf("Deleted DCSv2 instance: %s", instanceID)
+}
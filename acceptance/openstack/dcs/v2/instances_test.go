@@ -0,0 +1,37 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dcs/v2/instances"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestDcsInstanceLifeCycle(t *testing.T) {
+	client, err := clients.NewDcsV2Client()
+	th.AssertNoErr(t, err)
+
+	dcsInstance := createDCSInstance(t, client)
+	defer deleteDCSInstance(t, client, dcsInstance.InstanceID)
+	tools.PrintResource(t, dcsInstance)
+
+	t.Logf("Attempting to flush DCSv2 instance")
+	result, err := instances.Flush(client, dcsInstance.InstanceID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "success", result.Results[0].Result)
+
+	t.Logf("Attempting to restart DCSv2 instance")
+	_, err = instances.Restart(client, dcsInstance.InstanceID).Extract()
+	th.AssertNoErr(t, err)
+	err = instances.WaitForStatus(client, dcsInstance.InstanceID, "RUNNING", 600)
+	th.AssertNoErr(t, err)
+
+	password, err := instances.UpdatePassword(client, dcsInstance.InstanceID, instances.UpdatePasswordOpts{
+		OldPassword: "Qwerty123!",
+		NewPassword: "Qwerty456!",
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "Success", password.Result)
+}
@@ -0,0 +1,69 @@
+package v3
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dds/v3/backups"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dds/v3/instances"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestDdsBackupLifeCycle(t *testing.T) {
+	client, err := clients.NewDdsV3Client()
+	th.AssertNoErr(t, err)
+
+	ddsInstance := createDdsInstance(t, client)
+	defer deleteDdsInstance(t, client, ddsInstance.Id)
+
+	keepDays := 7
+	err = backups.UpdatePolicy(client, ddsInstance.Id, backups.PolicyOpts{
+		BackupPolicy: &backups.BackupPolicy{
+			KeepDays:  &keepDays,
+			StartTime: "23:00-00:00",
+			Period:    "1,3,5",
+		},
+	}).ExtractErr()
+	th.AssertNoErr(t, err)
+
+	policy, err := backups.GetPolicy(client, ddsInstance.Id).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, keepDays, policy.KeepDays)
+
+	t.Logf("Attempting to create DDSv3 backup")
+	backup, err := backups.Create(client, backups.CreateOpts{
+		Backup: &backups.Backup{
+			InstanceID: ddsInstance.Id,
+			Name:       tools.RandomString("dds-backup-", 4),
+		},
+	}).Extract()
+	th.AssertNoErr(t, err)
+	err = instances.WaitForJobCompleted(client, 1200, backup.JobID)
+	th.AssertNoErr(t, err)
+
+	pages, err := backups.List(client, backups.ListOpts{BackupID: backup.BackupID}).AllPages()
+	th.AssertNoErr(t, err)
+	backupList, err := backups.ExtractBackups(pages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(backupList))
+	tools.PrintResource(t, backupList[0])
+
+	t.Logf("Attempting to restore DDSv3 backup to the instance")
+	job, err := backups.Restore(client, backups.RestoreOpts{
+		Source: backups.Source{
+			InstanceID: ddsInstance.Id,
+			Type:       "backup",
+			BackupID:   backup.BackupID,
+		},
+		Target: backups.Target{InstanceID: ddsInstance.Id},
+	}).Extract()
+	th.AssertNoErr(t, err)
+	err = instances.WaitForJobCompleted(client, 1200, job.JobID)
+	th.AssertNoErr(t, err)
+
+	job, err = backups.Delete(client, backup.BackupID).Extract()
+	th.AssertNoErr(t, err)
+	err = instances.WaitForJobCompleted(client, 600, job.JobID)
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,49 @@
+package v3
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dds/v3/configurations"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestDdsConfigurationsLifeCycle(t *testing.T) {
+	client, err := clients.NewDdsV3Client()
+	th.AssertNoErr(t, err)
+
+	configList, err := configurations.List(client).Extract()
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, configList)
+
+	config, err := configurations.Create(client, configurations.CreateOpts{
+		Name: tools.RandomString("dds-config-", 4),
+		Values: map[string]string{
+			"net.maxIncomingConnections": "500",
+		},
+		DataStore: configurations.DataStore{
+			Type:    "replica",
+			Version: "3.4",
+		},
+	}).Extract()
+	th.AssertNoErr(t, err)
+	defer func() {
+		th.AssertNoErr(t, configurations.Delete(client, config.ID).ExtractErr())
+	}()
+
+	err = configurations.Update(client, config.ID, configurations.UpdateOpts{
+		Values: map[string]string{
+			"net.maxIncomingConnections": "600",
+		},
+	}).ExtractErr()
+	th.AssertNoErr(t, err)
+
+	updated, err := configurations.Get(client, config.ID).Extract()
+	th.AssertNoErr(t, err)
+	for _, param := range updated.Parameters {
+		if param.Name == "net.maxIncomingConnections" {
+			th.AssertEquals(t, "600", param.Value)
+		}
+	}
+}
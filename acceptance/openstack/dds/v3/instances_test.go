@@ -42,6 +42,18 @@ func TestDdsLifeCycle(t *testing.T) {
 		t.Fatalf("No DDSv3 instance was found: %s", err)
 	}
 	tools.PrintResource(t, newDdsInstance.Instances[0])
+
+	t.Logf("Attempting to restart DDSv3 instance: %s", ddsInstance.Id)
+	job, err := instances.Restart(client, ddsInstance.Id, instances.RestartOpts{
+		TargetId: ddsInstance.Id,
+	}).Extract()
+	th.AssertNoErr(t, err)
+	err = instances.WaitForJobCompleted(client, 600, job.JobId)
+	th.AssertNoErr(t, err)
+
+	stats, err := instances.GetConnectionStatistics(client, ddsInstance.Id).Extract()
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, stats)
 }
 
 func createDdsInstance(t *testing.T, client *golangsdk.ServiceClient) *instances.Instance {
@@ -74,7 +86,7 @@ func createDdsInstance(t *testing.T, client *golangsdk.ServiceClient) *instances
 		SubnetId:         subnetID,
 		SecurityGroupId:  openstack.DefaultSecurityGroup(t),
 		Password:         "5ecurePa55w0rd@",
-		Mode:             "ReplicaSet",
+		Mode:             instances.ModeReplicaSet,
 		Flavor: []instances.Flavor{
 			{
 				Type:     "replica",
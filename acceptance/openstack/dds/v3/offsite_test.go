@@ -0,0 +1,48 @@
+package v3
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dds/v3/backups"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestDdsOffsiteBackupPolicy(t *testing.T) {
+	destRegion := clients.EnvOS.GetEnv("DESTINATION_REGION")
+	destProjectID := clients.EnvOS.GetEnv("DESTINATION_PROJECT_ID")
+	if destRegion == "" || destProjectID == "" {
+		t.Skip("OS_DESTINATION_REGION and OS_DESTINATION_PROJECT_ID are required for this test")
+	}
+
+	client, err := clients.NewDdsV3Client()
+	th.AssertNoErr(t, err)
+
+	ddsInstance := createDdsInstance(t, client)
+	defer deleteDdsInstance(t, client, ddsInstance.Id)
+
+	keepDays := 3
+	err = backups.UpdateOffsitePolicy(client, ddsInstance.Id, backups.OffsitePolicyOpts{
+		KeepDays:             &keepDays,
+		DestinationRegion:    destRegion,
+		DestinationProjectID: destProjectID,
+	}).ExtractErr()
+	th.AssertNoErr(t, err)
+
+	policy, err := backups.GetOffsitePolicy(client, ddsInstance.Id).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, keepDays, policy.KeepDays)
+
+	pages, err := backups.ListOffsite(client, backups.ListOffsiteOpts{InstanceID: ddsInstance.Id}).AllPages()
+	th.AssertNoErr(t, err)
+	offsite, err := backups.ExtractBackups(pages)
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, offsite)
+
+	disabled := 0
+	err = backups.UpdateOffsitePolicy(client, ddsInstance.Id, backups.OffsitePolicyOpts{
+		KeepDays: &disabled,
+	}).ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,43 @@
+package v3
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dds/v3/instances"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestDdsScaling(t *testing.T) {
+	client, err := clients.NewDdsV3Client()
+	th.AssertNoErr(t, err)
+
+	ddsInstance := createDdsInstance(t, client)
+	defer deleteDdsInstance(t, client, ddsInstance.Id)
+
+	t.Logf("Attempting to enlarge storage of DDSv3 instance: %s", ddsInstance.Id)
+	job, err := instances.EnlargeStorage(client, ddsInstance.Id, instances.EnlargeStorageOpts{
+		Volume: &instances.EnlargeStorageVolume{Size: "30"},
+	}).Extract()
+	th.AssertNoErr(t, err)
+	err = instances.WaitForJobCompleted(client, 1200, job.JobId)
+	th.AssertNoErr(t, err)
+
+	t.Logf("Attempting to resize DDSv3 instance: %s", ddsInstance.Id)
+	job, err = instances.Resize(client, ddsInstance.Id, instances.ResizeOpts{
+		Resize: &instances.ResizeTarget{
+			TargetId:       ddsInstance.Id,
+			TargetSpecCode: "dds.mongodb.s2.large.4.repset",
+		},
+	}).Extract()
+	th.AssertNoErr(t, err)
+	err = instances.WaitForJobCompleted(client, 1800, job.JobId)
+	th.AssertNoErr(t, err)
+
+	allPages, err := instances.List(client, instances.ListInstanceOpts{Id: ddsInstance.Id}).AllPages()
+	th.AssertNoErr(t, err)
+	list, err := instances.ExtractInstances(allPages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "30", list.Instances[0].Groups[0].Volume.Size)
+	th.AssertEquals(t, "dds.mongodb.s2.large.4.repset", list.Instances[0].Groups[0].Nodes[0].SpecCode)
+}
@@ -0,0 +1,83 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dis/v2/apps"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dis/v2/checkpoints"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dis/v2/records"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dis/v2/streams"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestStreamLifecycle(t *testing.T) {
+	client, err := clients.NewDISV2Client()
+	th.AssertNoErr(t, err)
+
+	name := tools.RandomString("dis-", 4)
+	err = streams.Create(client, streams.CreateOpts{
+		Name:            name,
+		PartitionCount:  1,
+		DataType:        streams.DataTypeBlob,
+		RetentionPeriod: 24,
+	}).ExtractErr()
+	th.AssertNoErr(t, err)
+	defer func() {
+		th.AssertNoErr(t, streams.Delete(client, name).ExtractErr())
+	}()
+
+	stream, err := streams.Get(client, name, streams.GetOpts{}).Extract()
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, stream)
+	th.AssertEquals(t, 1, len(stream.Partitions))
+	partitionID := stream.Partitions[0].ID
+
+	put, err := records.Put(client, records.PutOpts{
+		StreamName: name,
+		Records: []records.Record{
+			{Data: []byte("first"), PartitionID: partitionID},
+			{Data: []byte("second"), PartitionID: partitionID},
+		},
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 0, put.FailedRecordCount)
+
+	cursor, err := records.GetCursor(client, records.CursorOpts{
+		StreamName:  name,
+		PartitionID: partitionID,
+		CursorType:  records.CursorTrimHorizon,
+	}).Extract()
+	th.AssertNoErr(t, err)
+
+	got, err := records.Get(client, records.GetOpts{PartitionCursor: cursor}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, len(got.Records))
+	th.AssertEquals(t, "first", string(got.Records[0].Data))
+
+	appName := tools.RandomString("dis-app-", 4)
+	th.AssertNoErr(t, apps.Create(client, appName).ExtractErr())
+	defer func() {
+		th.AssertNoErr(t, apps.Delete(client, appName).ExtractErr())
+	}()
+
+	lastSequence := got.Records[1].SequenceNumber
+	err = checkpoints.Commit(client, checkpoints.CommitOpts{
+		AppName:        appName,
+		StreamName:     name,
+		PartitionID:    partitionID,
+		SequenceNumber: lastSequence,
+	}).ExtractErr()
+	th.AssertNoErr(t, err)
+
+	checkpoint, err := checkpoints.Get(client, checkpoints.GetOpts{
+		AppName:     appName,
+		StreamName:  name,
+		PartitionID: partitionID,
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, lastSequence, checkpoint.SequenceNumber)
+
+	th.AssertNoErr(t, streams.ScalePartitions(client, name, 2).ExtractErr())
+}
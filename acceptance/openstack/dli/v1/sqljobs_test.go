@@ -0,0 +1,65 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dli/v1/databases"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dli/v1/queues"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dli/v1/sqljobs"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dli/v1/tables"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestQueuesList(t *testing.T) {
+	client, err := clients.NewDLIV1Client()
+	th.AssertNoErr(t, err)
+
+	list, err := queues.List(client, queues.ListOpts{Type: "all"}).Extract()
+	th.AssertNoErr(t, err)
+	for _, queue := range list {
+		tools.PrintResource(t, queue)
+	}
+}
+
+func TestSQLJobLifecycle(t *testing.T) {
+	client, err := clients.NewDLIV1Client()
+	th.AssertNoErr(t, err)
+
+	dbName := tools.RandomString("db_", 4)
+	th.AssertNoErr(t, databases.Create(client, databases.CreateOpts{Name: dbName}).ExtractErr())
+	defer func() {
+		th.AssertNoErr(t, databases.Delete(client, dbName, databases.DeleteOpts{Cascade: true}).ExtractErr())
+	}()
+
+	err = tables.Create(client, dbName, tables.CreateOpts{
+		Name:         "numbers",
+		DataLocation: tables.LocationDLI,
+		Columns: []tables.Column{
+			{Name: "value", Type: "int"},
+		},
+	}).ExtractErr()
+	th.AssertNoErr(t, err)
+
+	job, err := sqljobs.Submit(client, sqljobs.SubmitOpts{
+		SQL:       "INSERT INTO numbers VALUES (1), (2), (3)",
+		CurrentDB: dbName,
+	}).Extract()
+	th.AssertNoErr(t, err)
+	_, err = sqljobs.WaitForJob(client, job.JobID, 600)
+	th.AssertNoErr(t, err)
+
+	job, err = sqljobs.Submit(client, sqljobs.SubmitOpts{
+		SQL:       "SELECT value FROM numbers",
+		CurrentDB: dbName,
+	}).Extract()
+	th.AssertNoErr(t, err)
+	status, err := sqljobs.WaitForJob(client, job.JobID, 600)
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, status)
+
+	result, err := sqljobs.Preview(client, job.JobID, sqljobs.PreviewOpts{}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 3, len(result.Rows))
+}
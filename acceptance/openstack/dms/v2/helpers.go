@@ -0,0 +1,72 @@
+package v2
+
+import (
+	"testing"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/openstack"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dms/v2/instances"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func createKafkaInstance(t *testing.T, client *golangsdk.ServiceClient) *instances.Instance {
+	t.Logf("Attempting to create DMSv2 Kafka instance")
+
+	vpcID := clients.EnvOS.GetEnv("VPC_ID")
+	subnetID := clients.EnvOS.GetEnv("NETWORK_ID")
+	if vpcID == "" || subnetID == "" {
+		t.Skip("One of OS_VPC_ID or OS_NETWORK_ID env vars is missing but DMS test requires using existing network")
+	}
+
+	az := clients.EnvOS.GetEnv("AVAILABILITY_ZONE")
+	if az == "" {
+		az = "eu-de-01"
+	}
+
+	createOpts := instances.CreateOpts{
+		Name:                  tools.RandomString("kafka-acc-", 8),
+		Description:           "some test DMSv2 Kafka instance",
+		Engine:                "kafka",
+		EngineVersion:         "2.7",
+		Specification:         "100MB",
+		StorageSpace:          600,
+		PartitionNum:          300,
+		AccessUser:            "root",
+		Password:              "Qwerty123!",
+		VpcID:                 vpcID,
+		SecurityGroupID:       openstack.DefaultSecurityGroup(t),
+		SubnetID:              subnetID,
+		AvailableZones:        []string{az},
+		ProductID:             "00300-30308-0--0",
+		KafkaManagerUser:      "manager",
+		KafkaManagerPassword:  "Qwerty123!",
+		SslEnable:             true,
+		SaslEnabledMechanisms: []string{"SCRAM-SHA-512"},
+		StorageSpecCode:       "dms.physical.storage.high",
+	}
+
+	instanceID, err := instances.Create(client, createOpts).Extract()
+	th.AssertNoErr(t, err)
+
+	err = instances.WaitForStatus(client, instanceID, "RUNNING", 1800)
+	th.AssertNoErr(t, err)
+	t.Logf("DMSv2 Kafka instance successfully created: %s", instanceID)
+
+	instance, err := instances.Get(client, instanceID).Extract()
+	th.AssertNoErr(t, err)
+
+	return instance
+}
+
+func deleteKafkaInstance(t *testing.T, client *golangsdk.ServiceClient, instanceID string) {
+	t.Logf("Attempting to delete DMSv2 Kafka instance: %s", instanceID)
+
+	err := instances.Delete(client, instanceID).ExtractErr()
+	th.AssertNoErr(t, err)
+
+	err = instances.WaitForDeleted(client, instanceID, 900)
+	th.AssertNoErr(t, err)
+	t.Logf("DMSv2 Kafka instance deleted successfully: %s", instanceID)
+}
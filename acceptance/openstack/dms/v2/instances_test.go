@@ -0,0 +1,51 @@
+package v2
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dms/v2/instances"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestKafkaInstanceList(t *testing.T) {
+	client, err := clients.NewDmsV2Client()
+	th.AssertNoErr(t, err)
+
+	pages, err := instances.List(client, instances.ListOpts{Engine: "kafka"}).AllPages()
+	th.AssertNoErr(t, err)
+	list, err := instances.ExtractInstances(pages)
+	th.AssertNoErr(t, err)
+	for _, instance := range list.Instances {
+		tools.PrintResource(t, instance)
+	}
+}
+
+func TestKafkaInstanceLifeCycle(t *testing.T) {
+	client, err := clients.NewDmsV2Client()
+	th.AssertNoErr(t, err)
+
+	instance := createKafkaInstance(t, client)
+	defer deleteKafkaInstance(t, client, instance.InstanceID)
+	tools.PrintResource(t, instance)
+	th.AssertEquals(t, true, instance.SslEnable)
+
+	t.Logf("Attempting to reset password of DMSv2 Kafka instance")
+	err = instances.ResetPassword(client, instance.InstanceID, instances.ResetPasswordOpts{
+		NewPassword: "Qwerty456!",
+	}).ExtractErr()
+	th.AssertNoErr(t, err)
+
+	t.Logf("Attempting to modify cross-VPC access of DMSv2 Kafka instance")
+	contents := make(map[string]string)
+	for _, address := range strings.Split(instance.ConnectAddress, ",") {
+		contents[address] = address
+	}
+	result, err := instances.UpdateCrossVpc(client, instance.InstanceID, instances.CrossVpcOpts{
+		Contents: contents,
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, result.Success)
+}
@@ -0,0 +1,19 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dms/v2/quotas"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestDmsQuotas(t *testing.T) {
+	client, err := clients.NewDmsV2Client()
+	th.AssertNoErr(t, err)
+
+	resources, err := quotas.Get(client).Extract()
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, resources)
+}
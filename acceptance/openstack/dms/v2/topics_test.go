@@ -0,0 +1,93 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dms/v2/groups"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dms/v2/topics"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dms/v2/users"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestKafkaTenancyLifeCycle(t *testing.T) {
+	client, err := clients.NewDmsV2Client()
+	th.AssertNoErr(t, err)
+
+	instance := createKafkaInstance(t, client)
+	defer deleteKafkaInstance(t, client, instance.InstanceID)
+
+	topicName := tools.RandomString("topic-acc-", 4)
+	t.Logf("Attempting to create DMSv2 Kafka topic: %s", topicName)
+	_, err = topics.Create(client, instance.InstanceID, topics.CreateOpts{
+		Name:          topicName,
+		Replication:   1,
+		Partition:     3,
+		RetentionTime: 48,
+	}).Extract()
+	th.AssertNoErr(t, err)
+	defer func() {
+		t.Logf("Attempting to delete DMSv2 Kafka topic: %s", topicName)
+		result, err := topics.Delete(client, instance.InstanceID, []string{topicName}).Extract()
+		th.AssertNoErr(t, err)
+		th.AssertEquals(t, true, result[0].Success)
+	}()
+
+	retention := 24
+	err = topics.Update(client, instance.InstanceID, topics.UpdateOpts{
+		Topics: []topics.UpdateItem{
+			{
+				Name:                topicName,
+				RetentionTime:       &retention,
+				NewPartitionNumbers: 6,
+			},
+		},
+	}).ExtractErr()
+	th.AssertNoErr(t, err)
+
+	topic, err := topics.Get(client, instance.InstanceID, topicName).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 6, len(topic.Partitions))
+
+	userName := tools.RandomString("user-acc-", 4)
+	t.Logf("Attempting to create DMSv2 Kafka user: %s", userName)
+	err = users.Create(client, instance.InstanceID, users.CreateOpts{
+		Name:     userName,
+		Password: "Qwerty123!",
+	}).ExtractErr()
+	th.AssertNoErr(t, err)
+	defer func() {
+		t.Logf("Attempting to delete DMSv2 Kafka user: %s", userName)
+		th.AssertNoErr(t, users.Delete(client, instance.InstanceID, []string{userName}).ExtractErr())
+	}()
+
+	err = users.UpdatePolicies(client, instance.InstanceID, users.PoliciesOpts{
+		Topics: []users.TopicPolicies{
+			{
+				Name: topicName,
+				Policies: []users.Policy{
+					{UserName: userName, AccessPolicy: users.PolicySubscribe},
+				},
+			},
+		},
+	}).ExtractErr()
+	th.AssertNoErr(t, err)
+
+	policies, err := users.GetPolicies(client, instance.InstanceID, topicName).Extract()
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, policies)
+
+	err = users.ResetPassword(client, instance.InstanceID, userName, users.ResetPasswordOpts{
+		NewPassword: "Qwerty456!",
+	}).ExtractErr()
+	th.AssertNoErr(t, err)
+
+	pages, err := groups.List(client, instance.InstanceID, nil).AllPages()
+	th.AssertNoErr(t, err)
+	consumerGroups, err := groups.ExtractGroups(pages)
+	th.AssertNoErr(t, err)
+	for _, group := range consumerGroups {
+		tools.PrintResource(t, group)
+	}
+}
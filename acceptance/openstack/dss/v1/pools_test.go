@@ -0,0 +1,37 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dss/v1/pools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/evs/v3/volumes"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestDSSPoolList(t *testing.T) {
+	client, err := clients.NewDSSV1()
+	th.AssertNoErr(t, err)
+
+	evsClient, err := clients.NewBlockStorageV3Client()
+	th.AssertNoErr(t, err)
+
+	allPages, err := pools.List(client, pools.ListOpts{}).AllPages()
+	th.AssertNoErr(t, err)
+
+	dssPools, err := pools.ExtractPools(allPages)
+	th.AssertNoErr(t, err)
+
+	for _, pool := range dssPools {
+		tools.PrintResource(t, pool)
+
+		volumePages, err := volumes.List(evsClient, volumes.ListOpts{DedicatedStorageID: pool.ID}).AllPages()
+		th.AssertNoErr(t, err)
+		poolVolumes, err := volumes.ExtractVolumes(volumePages)
+		th.AssertNoErr(t, err)
+		for _, volume := range poolVolumes {
+			th.AssertEquals(t, pool.ID, volume.DedicatedStorageID)
+		}
+	}
+}
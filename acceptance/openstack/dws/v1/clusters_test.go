@@ -0,0 +1,80 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/openstack"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dws/v1/clusters"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dws/v1/flavors"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestFlavorsList(t *testing.T) {
+	client, err := clients.NewDwsV1Client()
+	th.AssertNoErr(t, err)
+
+	nodeTypes, err := flavors.List(client).Extract()
+	th.AssertNoErr(t, err)
+	for _, nodeType := range nodeTypes {
+		tools.PrintResource(t, nodeType)
+	}
+}
+
+func TestClusterLifeCycle(t *testing.T) {
+	vpcID := clients.EnvOS.GetEnv("VPC_ID")
+	subnetID := clients.EnvOS.GetEnv("NETWORK_ID")
+	if vpcID == "" || subnetID == "" {
+		t.Skip("One of OS_VPC_ID or OS_NETWORK_ID env vars is missing but DWS test requires using existing network")
+	}
+
+	client, err := clients.NewDwsV1Client()
+	th.AssertNoErr(t, err)
+
+	az := clients.EnvOS.GetEnv("AVAILABILITY_ZONE")
+	if az == "" {
+		az = "eu-de-01"
+	}
+
+	t.Logf("Attempting to create DWSv1 cluster")
+	clusterID, err := clusters.Create(client, clusters.CreateOpts{
+		Name:             tools.RandomString("dws-acc-", 4),
+		NodeType:         "dws.m3.xlarge",
+		NumberOfNode:     3,
+		SubnetID:         subnetID,
+		SecurityGroupID:  openstack.DefaultSecurityGroup(t),
+		VpcID:            vpcID,
+		AvailabilityZone: az,
+		UserName:         "dbadmin",
+		UserPwd:          "Qwerty123!",
+		PublicIp: &clusters.PublicIp{
+			PublicBindType: "auto_assign",
+		},
+	}).Extract()
+	th.AssertNoErr(t, err)
+	defer func() {
+		t.Logf("Attempting to delete DWSv1 cluster: %s", clusterID)
+		keep := 0
+		err := clusters.Delete(client, clusterID, clusters.DeleteOpts{KeepLastManualSnapshot: &keep}).ExtractErr()
+		th.AssertNoErr(t, err)
+		th.AssertNoErr(t, clusters.WaitForDeleted(client, clusterID, 1200))
+	}()
+	th.AssertNoErr(t, clusters.WaitForStatus(client, clusterID, "AVAILABLE", 2400))
+
+	cluster, err := clusters.Get(client, clusterID).Extract()
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, cluster)
+
+	t.Logf("Attempting to restart DWSv1 cluster")
+	th.AssertNoErr(t, clusters.Restart(client, clusterID).ExtractErr())
+	th.AssertNoErr(t, clusters.WaitForAvailable(client, clusterID, 1200))
+
+	t.Logf("Attempting to resize DWSv1 cluster")
+	th.AssertNoErr(t, clusters.Resize(client, clusterID, clusters.ResizeOpts{Count: 3}).ExtractErr())
+	th.AssertNoErr(t, clusters.WaitForAvailable(client, clusterID, 3600))
+
+	cluster, err = clusters.Get(client, clusterID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 6, cluster.NumberOfNode)
+}
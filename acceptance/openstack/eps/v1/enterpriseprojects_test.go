@@ -0,0 +1,63 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/eps/v1/enterpriseprojects"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestEnterpriseProjectsList(t *testing.T) {
+	client, err := clients.NewEPSV1Client()
+	th.AssertNoErr(t, err)
+
+	projects, err := enterpriseprojects.List(client, enterpriseprojects.ListOpts{}).Extract()
+	th.AssertNoErr(t, err)
+	for _, project := range projects {
+		tools.PrintResource(t, project)
+	}
+
+	quotas, err := enterpriseprojects.GetQuotas(client).Extract()
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, quotas)
+}
+
+func TestEnterpriseProjectLifecycle(t *testing.T) {
+	client, err := clients.NewEPSV1Client()
+	th.AssertNoErr(t, err)
+
+	createOpts := enterpriseprojects.CreateOpts{
+		Name:        tools.RandomString("eps-", 4),
+		Description: "acceptance test",
+	}
+	project, err := enterpriseprojects.Create(client, createOpts).Extract()
+	th.AssertNoErr(t, err)
+	defer func() {
+		th.AssertNoErr(t, enterpriseprojects.Disable(client, project.ID).ExtractErr())
+	}()
+	th.AssertEquals(t, createOpts.Name, project.Name)
+
+	description := "updated"
+	updateOpts := enterpriseprojects.UpdateOpts{
+		Name:        tools.RandomString("eps-upd-", 4),
+		Description: &description,
+	}
+	_, err = enterpriseprojects.Update(client, project.ID, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+
+	project, err = enterpriseprojects.Get(client, project.ID).Extract()
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, project)
+	th.AssertEquals(t, updateOpts.Name, project.Name)
+	th.AssertEquals(t, description, project.Description)
+	th.AssertEquals(t, enterpriseprojects.StatusEnabled, project.Status)
+
+	resources, err := enterpriseprojects.FilterResources(client, project.ID, enterpriseprojects.FilterResourcesOpts{
+		Projects:      []string{client.ProjectID},
+		ResourceTypes: []string{"ecs", "vpc"},
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 0, resources.TotalCount)
+}
@@ -0,0 +1,19 @@
+package v3
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/evs/v3/quotas"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestEvsQuotas(t *testing.T) {
+	client, err := clients.NewBlockStorageV3Client()
+	th.AssertNoErr(t, err)
+
+	quota, err := quotas.Get(client).Extract()
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, quota)
+}
@@ -0,0 +1,89 @@
+package v2
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/fgs/v2/functions"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+const handlerCode = `def handler(event, context):
+    return {"statusCode": 200, "body": "ok"}
+`
+
+func createFunction(t *testing.T) *functions.Function {
+	client, err := clients.NewFGSV2Client()
+	th.AssertNoErr(t, err)
+
+	function, err := functions.Create(client, functions.CreateOpts{
+		FuncName:    tools.RandomString("fgs-", 4),
+		Package:     "default",
+		Runtime:     functions.RuntimePython39,
+		Timeout:     30,
+		Handler:     "index.handler",
+		MemorySize:  128,
+		CodeType:    functions.CodeTypeInline,
+		FuncCode:    &functions.FuncCode{File: base64.StdEncoding.EncodeToString([]byte(handlerCode))},
+		Environment: map[string]string{"STAGE": "test"},
+	}).Extract()
+	th.AssertNoErr(t, err)
+	return function
+}
+
+func deleteFunction(t *testing.T, urn string) {
+	client, err := clients.NewFGSV2Client()
+	th.AssertNoErr(t, err)
+	th.AssertNoErr(t, functions.Delete(client, urn).ExtractErr())
+}
+
+func TestFunctionLifecycle(t *testing.T) {
+	client, err := clients.NewFGSV2Client()
+	th.AssertNoErr(t, err)
+
+	function := createFunction(t)
+	defer deleteFunction(t, function.FuncURN)
+
+	description := "updated"
+	updated, err := functions.UpdateConfig(client, function.FuncURN, functions.UpdateConfigOpts{
+		FuncName:    function.FuncName,
+		Runtime:     function.Runtime,
+		Timeout:     60,
+		Handler:     function.Handler,
+		MemorySize:  256,
+		Description: &description,
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 256, updated.MemorySize)
+
+	got, err := functions.GetConfig(client, function.FuncURN).Extract()
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, got)
+	th.AssertEquals(t, description, got.Description)
+
+	version, err := functions.PublishVersion(client, function.FuncURN, functions.PublishVersionOpts{
+		Version: "v1",
+	}).Extract()
+	th.AssertNoErr(t, err)
+
+	pages, err := functions.ListVersions(client, function.FuncURN).AllPages()
+	th.AssertNoErr(t, err)
+	versions, err := functions.ExtractVersions(pages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, len(versions) >= 2)
+
+	alias, err := functions.CreateAlias(client, function.FuncURN, functions.AliasOpts{
+		Name:    "test",
+		Version: version.Version,
+	}).Extract()
+	th.AssertNoErr(t, err)
+	defer func() {
+		th.AssertNoErr(t, functions.DeleteAlias(client, function.FuncURN, alias.Name).ExtractErr())
+	}()
+
+	aliases, err := functions.ListAliases(client, function.FuncURN).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(aliases))
+}
@@ -0,0 +1,70 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/fgs/v2/functions"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/fgs/v2/triggers"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestTriggerLifecycle(t *testing.T) {
+	client, err := clients.NewFGSV2Client()
+	th.AssertNoErr(t, err)
+
+	function := createFunction(t)
+	defer deleteFunction(t, function.FuncURN)
+
+	trigger, err := triggers.Create(client, function.FuncURN, triggers.CreateOpts{
+		TriggerTypeCode: triggers.TypeTimer,
+		TriggerStatus:   triggers.StatusActive,
+		EventData: triggers.TimerEventData{
+			Name:         tools.RandomString("timer-", 4),
+			ScheduleType: "Rate",
+			Schedule:     "10m",
+		},
+	}).Extract()
+	th.AssertNoErr(t, err)
+	defer func() {
+		th.AssertNoErr(t, triggers.Delete(client, function.FuncURN, trigger.TriggerTypeCode, trigger.TriggerID).ExtractErr())
+	}()
+
+	updated, err := triggers.Update(client, function.FuncURN, trigger.TriggerTypeCode, trigger.TriggerID, triggers.UpdateOpts{
+		TriggerStatus: triggers.StatusDisabled,
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, triggers.StatusDisabled, updated.TriggerStatus)
+
+	list, err := triggers.List(client, function.FuncURN).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(list))
+}
+
+func TestFunctionInvocation(t *testing.T) {
+	client, err := clients.NewFGSV2Client()
+	th.AssertNoErr(t, err)
+
+	function := createFunction(t)
+	defer deleteFunction(t, function.FuncURN)
+
+	invocation, err := functions.Invoke(client, function.FuncURN, functions.InvokeOpts{
+		Event:   map[string]string{"key": "value"},
+		TailLog: true,
+	}).Extract()
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, invocation)
+	th.AssertEquals(t, 0, invocation.Status)
+
+	requestID, err := functions.InvokeAsync(client, function.FuncURN, nil).Extract()
+	th.AssertNoErr(t, err)
+
+	asyncInvocation, err := functions.WaitForAsyncInvocation(client, function.FuncURN, requestID, 300)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, functions.InvocationStatusSuccess, asyncInvocation.Status)
+
+	statistics, err := functions.GetStatistics(client, function.FuncURN, "60").Extract()
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, statistics)
+}
@@ -0,0 +1,25 @@
+package v3
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/gaussdb/v3/flavors"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestGaussDBFlavorsList(t *testing.T) {
+	client, err := clients.NewGaussDBV3()
+	th.AssertNoErr(t, err)
+
+	datastores, err := flavors.ListDatastores(client).Extract()
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, datastores)
+
+	allPages, err := flavors.List(client, flavors.ListOpts{}).AllPages()
+	th.AssertNoErr(t, err)
+	flavorList, err := flavors.ExtractFlavors(allPages)
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, flavorList)
+}
@@ -0,0 +1,34 @@
+package v3
+
+import (
+	"fmt"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/gaussdb/v3/backups"
+)
+
+func waitForBackupCompleted(client *golangsdk.ServiceClient, instanceID, backupID string) error {
+	return golangsdk.WaitFor(1200, func() (bool, error) {
+		allPages, err := backups.List(client, backups.ListOpts{
+			InstanceID: instanceID,
+			BackupID:   backupID,
+		}).AllPages()
+		if err != nil {
+			return false, err
+		}
+		backupList, err := backups.ExtractBackups(allPages)
+		if err != nil {
+			return false, err
+		}
+		if len(backupList) == 0 {
+			return false, nil
+		}
+		switch backupList[0].Status {
+		case "COMPLETED":
+			return true, nil
+		case "FAILED":
+			return false, fmt.Errorf("backup %s failed", backupID)
+		}
+		return false, nil
+	})
+}
@@ -0,0 +1,81 @@
+package v3
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/openstack"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/gaussdb/v3/backups"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/gaussdb/v3/instances"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestGaussDBLifecycle(t *testing.T) {
+	client, err := clients.NewGaussDBV3()
+	th.AssertNoErr(t, err)
+
+	cc, err := clients.CloudAndClient()
+	th.AssertNoErr(t, err)
+
+	vpcID := clients.EnvOS.GetEnv("VPC_ID")
+	subnetID := clients.EnvOS.GetEnv("NETWORK_ID")
+	if vpcID == "" || subnetID == "" {
+		t.Skip("One of OS_VPC_ID or OS_NETWORK_ID env vars is missing but GaussDB test requires using existing network")
+	}
+
+	t.Logf("Attempting to create GaussDB instance")
+	created, err := instances.Create(client, instances.CreateOpts{
+		Name:      tools.RandomString("gauss-acc-", 4),
+		FlavorRef: "gaussdb.opengauss.ee.dn.m6.2xlarge.8.in",
+		Ha: &instances.Ha{
+			Mode:            instances.ModeDistributed,
+			ReplicationMode: "sync",
+			Consistency:     "strong",
+		},
+		Password:         "Gauss@12345678",
+		Region:           cc.RegionName,
+		AvailabilityZone: "eu-de-01,eu-de-02,eu-de-03",
+		VpcId:            vpcID,
+		SubnetId:         subnetID,
+		SecurityGroupId:  openstack.DefaultSecurityGroup(t),
+		Volume: &instances.Volume{
+			Type: "ULTRAHIGH",
+			Size: 160,
+		},
+		ShardingNum:    1,
+		CoordinatorNum: 1,
+	}).Extract()
+	th.AssertNoErr(t, err)
+	err = instances.WaitForJobCompleted(client, 3600, created.JobId)
+	th.AssertNoErr(t, err)
+	instanceID := created.Instance.Id
+
+	defer func() {
+		t.Logf("Attempting to delete GaussDB instance: %s", instanceID)
+		job, err := instances.Delete(client, instanceID).Extract()
+		th.AssertNoErr(t, err)
+		err = instances.WaitForJobCompleted(client, 1200, job.JobId)
+		th.AssertNoErr(t, err)
+	}()
+
+	allPages, err := instances.List(client, instances.ListOpts{Id: instanceID}).AllPages()
+	th.AssertNoErr(t, err)
+	list, err := instances.ExtractInstances(allPages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, list.TotalCount)
+	tools.PrintResource(t, list.Instances[0])
+
+	t.Logf("Attempting to create GaussDB backup")
+	backup, err := backups.Create(client, backups.CreateOpts{
+		InstanceID: instanceID,
+		Name:       tools.RandomString("gauss-backup-", 4),
+	}).Extract()
+	th.AssertNoErr(t, err)
+
+	err = waitForBackupCompleted(client, instanceID, backup.ID)
+	th.AssertNoErr(t, err)
+
+	err = backups.Delete(client, backup.ID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,100 @@
+package v5
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/hss/v5/baseline"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/hss/v5/hostgroups"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/hss/v5/hosts"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/hss/v5/policies"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/hss/v5/vulnerabilities"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestHostGroupLifecycle(t *testing.T) {
+	hostID := clients.EnvOS.GetEnv("HSS_HOST_ID")
+	if hostID == "" {
+		t.Skip("OS_HSS_HOST_ID env var is missing but HSS host group test requires")
+	}
+
+	client, err := clients.NewHSSV5Client()
+	th.AssertNoErr(t, err)
+
+	name := tools.RandomString("hss-group-", 3)
+	err = hostgroups.Create(client, hostgroups.CreateOpts{
+		Name:    name,
+		HostIDs: []string{hostID},
+	}).ExtractErr()
+	th.AssertNoErr(t, err)
+
+	groups, err := hostgroups.List(client, hostgroups.ListOpts{Name: name}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(groups))
+	group := groups[0]
+
+	defer func() {
+		th.AssertNoErr(t, hostgroups.Delete(client, group.ID).ExtractErr())
+	}()
+
+	err = hostgroups.Update(client, hostgroups.UpdateOpts{
+		ID:      group.ID,
+		Name:    name + "-upd",
+		HostIDs: []string{hostID},
+	}).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestHostProtection(t *testing.T) {
+	hostID := clients.EnvOS.GetEnv("HSS_HOST_ID")
+	if hostID == "" {
+		t.Skip("OS_HSS_HOST_ID env var is missing but HSS protection test requires")
+	}
+
+	client, err := clients.NewHSSV5Client()
+	th.AssertNoErr(t, err)
+
+	err = hosts.EnableProtection(client, hosts.VersionBasic, []string{hostID}).ExtractErr()
+	th.AssertNoErr(t, err)
+	defer func() {
+		th.AssertNoErr(t, hosts.DisableProtection(client, []string{hostID}).ExtractErr())
+	}()
+
+	hostList, err := hosts.List(client, hosts.ListOpts{HostID: hostID}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(hostList))
+	th.AssertEquals(t, hosts.VersionBasic, hostList[0].Version)
+
+	groups, err := policies.ListGroups(client, policies.ListOpts{}).Extract()
+	th.AssertNoErr(t, err)
+	for _, group := range groups {
+		if group.DefaultGroup && group.SupportVersion == hosts.VersionBasic && group.SupportOs == hostList[0].OsType {
+			err = policies.Deploy(client, policies.DeployOpts{
+				TargetPolicyGroupID: group.ID,
+				HostIDs:             []string{hostID},
+			}).ExtractErr()
+			th.AssertNoErr(t, err)
+			break
+		}
+	}
+}
+
+func TestSecurityResults(t *testing.T) {
+	client, err := clients.NewHSSV5Client()
+	th.AssertNoErr(t, err)
+
+	vuls, err := vulnerabilities.List(client, vulnerabilities.ListOpts{
+		Type: vulnerabilities.TypeLinux,
+	}).Extract()
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, vuls)
+
+	risks, err := baseline.ListRiskConfigs(client, baseline.ListRiskConfigsOpts{}).Extract()
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, risks)
+
+	weak, err := baseline.ListWeakPasswordUsers(client, baseline.ListHostsOpts{}).Extract()
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, weak)
+}
@@ -7,7 +7,9 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/identity/v3/agency"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/identity/v3/credentials"
 )
 
@@ -37,3 +39,57 @@ func TestTemporaryCredential(t *testing.T) {
 	assert.NotEmpty(t, cred.SecurityToken)
 	assert.NotEmpty(t, cred.ExpiresAt)
 }
+
+func TestAgencyLifecycle(t *testing.T) {
+	delegatedDomain := clients.EnvOS.GetEnv("DELEGATED_DOMAIN")
+	if delegatedDomain == "" {
+		t.Skip("OS_DELEGATED_DOMAIN env var is missing but agency test requires it")
+	}
+
+	client, err := clients.NewIdentityV3Client()
+	require.NoError(t, err)
+
+	createOpts := agency.CreateOpts{
+		Name:            tools.RandomString("agency-", 4),
+		DomainID:        client.DomainID,
+		DelegatedDomain: delegatedDomain,
+		Description:     "test agency",
+		Duration:        agency.DurationOneDay,
+	}
+	ag, err := agency.Create(client, createOpts).Extract()
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, agency.Delete(client, ag.ID).ExtractErr())
+	}()
+	assert.Equal(t, createOpts.Name, ag.Name)
+
+	ag, err = agency.Update(client, ag.ID, agency.UpdateOpts{
+		Description: "updated agency",
+		Duration:    agency.DurationForever,
+	}).Extract()
+	require.NoError(t, err)
+	assert.Equal(t, "updated agency", ag.Description)
+
+	agencies, err := agency.List(client, agency.ListOpts{
+		DomainID: client.DomainID,
+		Name:     createOpts.Name,
+	}).ExtractAgencies()
+	require.NoError(t, err)
+	require.Len(t, agencies, 1)
+	assert.Equal(t, ag.ID, agencies[0].ID)
+
+	role, err := FindRole(t, client)
+	require.NoError(t, err)
+
+	require.NoError(t, agency.AttachRoleByProject(client, ag.ID, client.ProjectID, role.ID).ExtractErr())
+	attached, err := agency.CheckRoleOnProject(client, ag.ID, client.ProjectID, role.ID)
+	require.NoError(t, err)
+	assert.True(t, attached)
+	require.NoError(t, agency.DetachRoleByProject(client, ag.ID, client.ProjectID, role.ID).ExtractErr())
+
+	require.NoError(t, agency.AttachRoleToAllProjects(client, ag.ID, client.DomainID, role.ID).ExtractErr())
+	inherited, err := agency.ListRolesAttachedOnAllProjects(client, ag.ID, client.DomainID).ExtractRoles()
+	require.NoError(t, err)
+	assert.Len(t, inherited, 1)
+	require.NoError(t, agency.DetachRoleFromAllProjects(client, ag.ID, client.DomainID, role.ID).ExtractErr())
+}
@@ -0,0 +1,51 @@
+package v3
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/identity/v3/credentials"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/identity/v3/users"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestCredentialLifecycle(t *testing.T) {
+	client, err := clients.NewIdentityV3Client()
+	th.AssertNoErr(t, err)
+
+	user, err := users.Create(client, users.CreateOpts{
+		Name:     tools.RandomString("user-cred-", 4),
+		DomainID: client.DomainID,
+	}).Extract()
+	th.AssertNoErr(t, err)
+	defer func() {
+		th.AssertNoErr(t, users.Delete(client, user.ID).ExtractErr())
+	}()
+
+	credential, err := credentials.Create(client, credentials.CreateOpts{
+		UserID:      user.ID,
+		Description: "first key",
+	}).Extract()
+	th.AssertNoErr(t, err)
+	defer func() {
+		th.AssertNoErr(t, credentials.Delete(client, credential.AccessKey).ExtractErr())
+	}()
+	th.AssertEquals(t, true, credential.SecretKey != "")
+
+	credential, err = credentials.Update(client, credential.AccessKey, credentials.UpdateOpts{
+		Status:      string(credentials.StatusInactive),
+		Description: "deactivated key",
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, credentials.StatusInactive, credential.Status)
+
+	got, err := credentials.Get(client, credential.AccessKey).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "deactivated key", got.Description)
+
+	list, err := credentials.List(client, credentials.ListOpts{UserID: user.ID}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(list))
+	th.AssertEquals(t, credential.AccessKey, list[0].AccessKey)
+}
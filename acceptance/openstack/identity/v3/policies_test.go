@@ -0,0 +1,64 @@
+package v3
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/identity/v3/policies"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestCustomPolicyLifecycle(t *testing.T) {
+	client, err := clients.NewIdentityV3Client()
+	th.AssertNoErr(t, err)
+
+	document, err := policies.NewBuilder().
+		Allow("obs:bucket:ListAllMyBuckets", "obs:bucket:ListBucket").
+		Build()
+	th.AssertNoErr(t, err)
+
+	createOpts := policies.CreateOpts{
+		DisplayName: tools.RandomString("policy-", 4),
+		Type:        policies.TypeGlobal,
+		Description: "acceptance test policy",
+		Policy:      document,
+	}
+	policy, err := policies.Create(client, createOpts).Extract()
+	th.AssertNoErr(t, err)
+	defer func() {
+		th.AssertNoErr(t, policies.Delete(client, policy.ID).ExtractErr())
+	}()
+	th.AssertEquals(t, createOpts.DisplayName, policy.DisplayName)
+
+	updated, err := policies.NewBuilder().
+		Allow("obs:bucket:ListAllMyBuckets").
+		Allow("obs:object:GetObject").
+		OnResources("OBS:*:*:object:*").
+		Build()
+	th.AssertNoErr(t, err)
+
+	updateOpts := policies.UpdateOpts(createOpts)
+	updateOpts.Description = "updated acceptance test policy"
+	updateOpts.Policy = updated
+	policy, err = policies.Update(client, policy.ID, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, updateOpts.Description, policy.Description)
+
+	policy, err = policies.Get(client, policy.ID).Extract()
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, policy)
+	th.AssertEquals(t, 2, len(policy.Policy.Statement))
+
+	allPages, err := policies.List(client, nil).AllPages()
+	th.AssertNoErr(t, err)
+	allPolicies, err := policies.ExtractPolicies(allPages)
+	th.AssertNoErr(t, err)
+	found := false
+	for _, p := range allPolicies {
+		if p.ID == policy.ID {
+			found = true
+		}
+	}
+	th.AssertEquals(t, true, found)
+}
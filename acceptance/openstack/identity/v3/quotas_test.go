@@ -0,0 +1,37 @@
+package v3
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/identity/v3/projects"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/identity/v3/quotas"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestProjectQuotaAndDetails(t *testing.T) {
+	cc, err := clients.CloudAndClient()
+	th.AssertNoErr(t, err)
+	client, err := clients.NewIdentityV3Client()
+	th.AssertNoErr(t, err)
+
+	resources, err := quotas.GetProjectQuota(client, client.ProjectID).Extract()
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, resources)
+
+	resources, err = quotas.GetDomainQuota(client, client.DomainID, quotas.GetDomainQuotaOpts{}).Extract()
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, resources)
+
+	project, err := projects.GetDetails(client, client.ProjectID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, projects.StatusNormal, project.Status)
+
+	allPages, err := projects.ListAvailable(client).AllPages()
+	th.AssertNoErr(t, err)
+	allProjects, err := projects.ExtractProjects(allPages)
+	th.AssertNoErr(t, err)
+	regionProjects := projects.FilterByRegion(allProjects, cc.RegionName)
+	th.AssertEquals(t, true, len(regionProjects) > 0)
+}
@@ -0,0 +1,57 @@
+package v3
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/identity/v3/mfa"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/identity/v3/security"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestSecurityPoliciesGet(t *testing.T) {
+	client, err := clients.NewIdentityV3Client()
+	th.AssertNoErr(t, err)
+
+	passwordPolicy, err := security.GetPasswordPolicy(client, client.DomainID).Extract()
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, passwordPolicy)
+
+	protectPolicy, err := security.GetProtectPolicy(client, client.DomainID).Extract()
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, protectPolicy)
+
+	consoleACL, err := security.GetConsoleACLPolicy(client, client.DomainID).Extract()
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, consoleACL)
+
+	apiACL, err := security.GetAPIACLPolicy(client, client.DomainID).Extract()
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, apiACL)
+
+	devices, err := mfa.List(client).Extract()
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, devices)
+}
+
+func TestLoginPolicyUpdate(t *testing.T) {
+	client, err := clients.NewIdentityV3Client()
+	th.AssertNoErr(t, err)
+
+	original, err := security.GetLoginPolicy(client, client.DomainID).Extract()
+	th.AssertNoErr(t, err)
+	defer func() {
+		_, err := security.UpdateLoginPolicy(client, client.DomainID, security.LoginPolicyOpts{
+			SessionTimeout: &original.SessionTimeout,
+		}).Extract()
+		th.AssertNoErr(t, err)
+	}()
+
+	timeout := 60
+	updated, err := security.UpdateLoginPolicy(client, client.DomainID, security.LoginPolicyOpts{
+		SessionTimeout: &timeout,
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, timeout, updated.SessionTimeout)
+}
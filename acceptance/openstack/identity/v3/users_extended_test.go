@@ -0,0 +1,81 @@
+package v3
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/identity/v3/groups"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/identity/v3/roles"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/identity/v3/users"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestUserExtendedLifecycle(t *testing.T) {
+	client, err := clients.NewIdentityV3Client()
+	th.AssertNoErr(t, err)
+
+	iTrue := true
+	createOpts := users.CreateExtendedOpts{
+		Name:             tools.RandomString("user-ext-", 4),
+		DomainID:         client.DomainID,
+		Password:         tools.RandomString("Pa$$", 12),
+		Email:            "test@example.com",
+		PwdResetRequired: &iTrue,
+		AccessMode:       users.AccessModeConsole,
+	}
+	user, err := users.CreateExtended(client, createOpts).Extract()
+	th.AssertNoErr(t, err)
+	defer func() {
+		th.AssertNoErr(t, users.Delete(client, user.ID).ExtractErr())
+	}()
+
+	user, err = users.GetExtended(client, user.ID).Extract()
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, user)
+	th.AssertEquals(t, createOpts.Email, user.Email)
+	th.AssertEquals(t, users.AccessModeConsole, user.AccessMode)
+
+	err = users.UpdateLoginProtection(client, user.ID, users.LoginProtectionOpts{
+		Enabled:            &iTrue,
+		VerificationMethod: users.VerificationMethodEmail,
+	}).ExtractErr()
+	th.AssertNoErr(t, err)
+
+	protection, err := users.GetLoginProtection(client, user.ID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, protection.Enabled)
+	th.AssertEquals(t, users.VerificationMethodEmail, protection.VerificationMethod)
+
+	group, err := groups.Create(client, groups.CreateOpts{
+		Name:     tools.RandomString("group-ext-", 4),
+		DomainID: client.DomainID,
+	}).Extract()
+	th.AssertNoErr(t, err)
+	defer func() {
+		th.AssertNoErr(t, groups.Delete(client, group.ID).ExtractErr())
+	}()
+
+	th.AssertNoErr(t, users.AddToGroup(client, group.ID, user.ID).ExtractErr())
+	inGroup, err := users.IsInGroup(client, group.ID, user.ID)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, inGroup)
+
+	role, err := FindRole(t, client)
+	th.AssertNoErr(t, err)
+
+	inheritedOpts := roles.InheritedAssignOpts{
+		DomainID: client.DomainID,
+		GroupID:  group.ID,
+	}
+	th.AssertNoErr(t, roles.AssignInherited(client, role.ID, inheritedOpts).ExtractErr())
+	assigned, err := roles.CheckInherited(client, role.ID, inheritedOpts)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, assigned)
+	th.AssertNoErr(t, roles.UnassignInherited(client, role.ID, inheritedOpts).ExtractErr())
+
+	th.AssertNoErr(t, users.RemoveFromGroup(client, group.ID, user.ID).ExtractErr())
+	inGroup, err = users.IsInGroup(client, group.ID, user.ID)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, false, inGroup)
+}
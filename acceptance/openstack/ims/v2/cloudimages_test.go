@@ -0,0 +1,187 @@
+package v2
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/openstack"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/imageservice/v2/images"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/ims/v2/cloudimages"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/obs"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestCloudImagesCreateByServer(t *testing.T) {
+	computeClient, err := clients.NewComputeV1Client()
+	th.AssertNoErr(t, err)
+
+	createOpts := openstack.GetCloudServerCreateOpts(t)
+	ecs := openstack.CreateCloudServer(t, computeClient, createOpts)
+	defer openstack.DeleteCloudServer(t, computeClient, ecs.ID)
+
+	client, err := clients.NewImageServiceV2Client()
+	th.AssertNoErr(t, err)
+
+	job, err := cloudimages.CreateImageByServer(client, cloudimages.CreateByServerOpts{
+		Name:        tools.RandomString("ims-test-", 3),
+		Description: "image created by acceptance test",
+		InstanceId:  ecs.ID,
+		ImageTags: []cloudimages.ImageTag{
+			{Key: "muh", Value: "kuh"},
+		},
+	}).ExtractJobResponse()
+	th.AssertNoErr(t, err)
+
+	th.AssertNoErr(t, cloudimages.WaitForJobSuccess(client, 1800, job.JobID))
+
+	status, err := cloudimages.GetJob(client, job.JobID).ExtractJobStatus()
+	th.AssertNoErr(t, err)
+	imageID := status.Entities.ImageID
+	th.AssertEquals(t, true, imageID != "")
+
+	defer func() {
+		th.AssertNoErr(t, images.Delete(client, imageID).ExtractErr())
+	}()
+
+	image, err := images.Get(client, imageID).Extract()
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, image)
+}
+
+func TestCloudImagesQuickImport(t *testing.T) {
+	imageURL := clients.EnvOS.GetEnv("IMS_IMAGE_URL")
+	if imageURL == "" {
+		t.Skip("OS_IMS_IMAGE_URL env var is missing but IMS quick import test requires it")
+	}
+
+	client, err := clients.NewImageServiceV2Client()
+	th.AssertNoErr(t, err)
+
+	job, err := cloudimages.ImportImageQuick(client, cloudimages.QuickImportOpts{
+		Name:         tools.RandomString("ims-test-", 3),
+		OsVersion:    "Ubuntu 20.04 server 64bit",
+		ImageUrl:     imageURL,
+		MinDisk:      40,
+		Type:         "ECS",
+		Architecture: "x86",
+	}).ExtractJobResponse()
+	th.AssertNoErr(t, err)
+
+	th.AssertNoErr(t, cloudimages.WaitForJobSuccess(client, 1800, job.JobID))
+
+	imageID, err := cloudimages.GetJobEntity(client, job.JobID, "image_id")
+	th.AssertNoErr(t, err)
+
+	th.AssertNoErr(t, images.Delete(client, imageID.(string)).ExtractErr())
+}
+
+func TestCloudImagesBatchMembers(t *testing.T) {
+	shareProjectID := clients.EnvOS.GetEnv("PROJECT_ID_2")
+	privateImageID := clients.EnvOS.GetEnv("PRIVATE_IMAGE_ID")
+	if shareProjectID == "" || privateImageID == "" {
+		t.Skip("OS_PROJECT_ID_2 or OS_PRIVATE_IMAGE_ID env vars are missing but IMS batch members test requires it")
+	}
+
+	client, err := clients.NewImageServiceV2Client()
+	th.AssertNoErr(t, err)
+
+	opts := cloudimages.MembersOpts{
+		Images:   []string{privateImageID},
+		Projects: []string{shareProjectID},
+	}
+	job, err := cloudimages.AddMembers(client, opts).ExtractJobResponse()
+	th.AssertNoErr(t, err)
+	th.AssertNoErr(t, cloudimages.WaitForJobSuccess(client, 300, job.JobID))
+
+	job, err = cloudimages.DeleteMembers(client, opts).ExtractJobResponse()
+	th.AssertNoErr(t, err)
+	th.AssertNoErr(t, cloudimages.WaitForJobSuccess(client, 300, job.JobID))
+}
+
+func TestCloudImagesExport(t *testing.T) {
+	privateImageID := clients.EnvOS.GetEnv("PRIVATE_IMAGE_ID")
+	if privateImageID == "" {
+		t.Skip("OS_PRIVATE_IMAGE_ID env var is missing but IMS export test requires it")
+	}
+
+	client, err := clients.NewImageServiceV2Client()
+	th.AssertNoErr(t, err)
+
+	obsClient, err := clients.NewOBSClient()
+	th.AssertNoErr(t, err)
+
+	bucketName := strings.ToLower(tools.RandomString("ims-export-", 5))
+	_, err = obsClient.CreateBucket(&obs.CreateBucketInput{Bucket: bucketName})
+	th.AssertNoErr(t, err)
+
+	objectName := "image.qcow2"
+	defer func() {
+		_, err = obsClient.DeleteObject(&obs.DeleteObjectInput{Bucket: bucketName, Key: objectName})
+		th.AssertNoErr(t, err)
+		_, err = obsClient.DeleteBucket(bucketName)
+		th.AssertNoErr(t, err)
+	}()
+
+	job, err := cloudimages.Export(client, privateImageID, cloudimages.ExportOpts{
+		BucketURL:  bucketName + ":" + objectName,
+		FileFormat: cloudimages.FileFormatQCOW2,
+	}).ExtractJobResponse()
+	th.AssertNoErr(t, err)
+	th.AssertNoErr(t, cloudimages.WaitForJobSuccess(client, 1800, job.JobID))
+}
+
+func TestCloudImagesCopy(t *testing.T) {
+	privateImageID := clients.EnvOS.GetEnv("PRIVATE_IMAGE_ID")
+	if privateImageID == "" {
+		t.Skip("OS_PRIVATE_IMAGE_ID env var is missing but IMS copy test requires it")
+	}
+
+	client, err := clients.NewImageServiceV2Client()
+	th.AssertNoErr(t, err)
+
+	job, err := cloudimages.Copy(client, privateImageID, cloudimages.CopyOpts{
+		Name:        tools.RandomString("ims-copy-", 3),
+		Description: "copy created by acceptance test",
+	}).ExtractJobResponse()
+	th.AssertNoErr(t, err)
+	th.AssertNoErr(t, cloudimages.WaitForJobSuccess(client, 1800, job.JobID))
+
+	imageID, err := cloudimages.GetJobEntity(client, job.JobID, "image_id")
+	th.AssertNoErr(t, err)
+
+	th.AssertNoErr(t, images.Delete(client, imageID.(string)).ExtractErr())
+}
+
+func TestCloudImagesCreateWholeImageFromBackup(t *testing.T) {
+	backupID := clients.EnvOS.GetEnv("CBR_BACKUP_ID")
+	if backupID == "" {
+		t.Skip("OS_CBR_BACKUP_ID env var is missing but IMS whole image test requires it")
+	}
+
+	client, err := clients.NewImageServiceV2Client()
+	th.AssertNoErr(t, err)
+
+	job, err := cloudimages.CreateWholeImage(client, cloudimages.CreateWholeImageOpts{
+		Name:           tools.RandomString("ims-whole-", 3),
+		BackupID:       backupID,
+		WholeImageType: "CBR",
+	}).ExtractJobResponse()
+	th.AssertNoErr(t, err)
+	th.AssertNoErr(t, cloudimages.WaitForJobSuccess(client, 1800, job.JobID))
+
+	imageID, err := cloudimages.GetJobEntity(client, job.JobID, "image_id")
+	th.AssertNoErr(t, err)
+
+	th.AssertNoErr(t, images.Delete(client, imageID.(string)).ExtractErr())
+}
+
+func TestCloudImagesQuota(t *testing.T) {
+	client, err := clients.NewImageServiceV2Client()
+	th.AssertNoErr(t, err)
+
+	quotas, err := cloudimages.GetQuota(client).Extract()
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, quotas)
+}
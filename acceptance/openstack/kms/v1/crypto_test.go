@@ -0,0 +1,67 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/kms/v1/keys"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestKmsEncryptDecryptData(t *testing.T) {
+	client, err := clients.NewKMSV1Client()
+	th.AssertNoErr(t, err)
+
+	kmsID := clients.EnvOS.GetEnv("KMS_ID")
+	if kmsID == "" {
+		t.Skip("OS_KMS_ID env var is missing but KMSv1 crypto test requires")
+	}
+
+	encryptOpts := keys.EncryptDataOpts{
+		KeyID:     kmsID,
+		PlainText: "some secret data",
+	}
+	encrypted, err := keys.EncryptData(client, encryptOpts).ExtractEncryptData()
+	th.AssertNoErr(t, err)
+
+	decryptOpts := keys.DecryptDataOpts{
+		CipherText: encrypted.CipherText,
+	}
+	decrypted, err := keys.DecryptData(client, decryptOpts).ExtractDecryptData()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, encryptOpts.PlainText, decrypted.PlainText)
+}
+
+func TestKmsDataKeys(t *testing.T) {
+	client, err := clients.NewKMSV1Client()
+	th.AssertNoErr(t, err)
+
+	kmsID := clients.EnvOS.GetEnv("KMS_ID")
+	if kmsID == "" {
+		t.Skip("OS_KMS_ID env var is missing but KMSv1 crypto test requires")
+	}
+
+	dataKeyOpts := keys.DataEncryptOpts{
+		KeyID:         kmsID,
+		DatakeyLength: "512",
+	}
+	dataKey, err := keys.DataEncryptGet(client, dataKeyOpts).ExtractDataKey()
+	th.AssertNoErr(t, err)
+
+	decryptOpts := keys.DecryptDEKOpts{
+		KeyID:               kmsID,
+		CipherText:          dataKey.CipherText,
+		DataKeyCipherLength: "64",
+	}
+	decrypted, err := keys.DecryptDEKGet(client, decryptOpts).ExtractDecryptDEK()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, dataKey.PlainText, decrypted.DataKey)
+
+	cipherOnly, err := keys.DataEncryptGetWithoutPlaintext(client, dataKeyOpts).ExtractDataKey()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "", cipherOnly.PlainText)
+
+	random, err := keys.GenRandom(client, keys.GenRandomOpts{RandomDataLength: "512"}).ExtractRandom()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 128, len(random.RandomData))
+}
@@ -20,8 +20,12 @@ func TestKmsGrantsLifecycle(t *testing.T) {
 	createOpts := grants.CreateOpts{
 		KeyID:            kmsID,
 		GranteePrincipal: client.UserID,
-		Operations:       []string{"describe-key", "create-datakey", "encrypt-datakey"},
-		Name:             "my_grant",
+		Operations: []string{
+			grants.OperationDescribeKey,
+			grants.OperationCreateDatakey,
+			grants.OperationEncryptDatakey,
+		},
+		Name: "my_grant",
 	}
 	createGrant, err := grants.Create(client, createOpts).Extract()
 	th.AssertNoErr(t, err)
@@ -52,4 +56,9 @@ func TestKmsGrantsLifecycle(t *testing.T) {
 	}
 	th.AssertEquals(t, createOpts.Name, found.Name)
 	th.AssertEquals(t, len(createOpts.Operations), len(found.Operations))
+
+	listOpts.Limit = "1"
+	allGrants, err := grants.ListAll(client, listOpts)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, len(grantList.Grants), len(allGrants))
 }
@@ -0,0 +1,92 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/tags"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/kms/v1/keys"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestKmsKeyLifecycle(t *testing.T) {
+	client, err := clients.NewKMSV1Client()
+	th.AssertNoErr(t, err)
+
+	createOpts := keys.CreateOpts{
+		KeyAlias:       tools.RandomString("kms-key-", 5),
+		KeyDescription: "some description",
+	}
+	key, err := keys.Create(client, createOpts).ExtractKeyInfo()
+	th.AssertNoErr(t, err)
+
+	defer func() {
+		deleteOpts := keys.DeleteOpts{
+			KeyID:       key.KeyID,
+			PendingDays: "7",
+		}
+		_, err := keys.Delete(client, deleteOpts).ExtractKeyState()
+		th.AssertNoErr(t, err)
+	}()
+
+	updateAliasOpts := keys.UpdateAliasOpts{
+		KeyID:    key.KeyID,
+		KeyAlias: tools.RandomString("kms-key-upd-", 5),
+	}
+	_, err = keys.UpdateAlias(client, updateAliasOpts).ExtractKeyInfo()
+	th.AssertNoErr(t, err)
+
+	updateDesOpts := keys.UpdateDesOpts{
+		KeyID:          key.KeyID,
+		KeyDescription: "updated description",
+	}
+	_, err = keys.UpdateDes(client, updateDesOpts).ExtractKeyInfo()
+	th.AssertNoErr(t, err)
+
+	keyGet, err := keys.Get(client, key.KeyID).ExtractKeyInfo()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, updateAliasOpts.KeyAlias, keyGet.KeyAlias)
+	th.AssertEquals(t, updateDesOpts.KeyDescription, keyGet.KeyDescription)
+
+	_, err = keys.DisableKey(client, key.KeyID).ExtractKeyInfo()
+	th.AssertNoErr(t, err)
+	_, err = keys.EnableKey(client, key.KeyID).ExtractKeyInfo()
+	th.AssertNoErr(t, err)
+
+	th.AssertNoErr(t, keys.EnableKeyRotation(client, key.KeyID).ExtractErr())
+	intervalOpts := keys.RotationIntervalOpts{
+		KeyID:    key.KeyID,
+		Interval: 90,
+	}
+	th.AssertNoErr(t, keys.UpdateKeyRotationInterval(client, intervalOpts).ExtractErr())
+
+	rotation, err := keys.GetKeyRotationStatus(client, key.KeyID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, rotation.Enabled)
+	th.AssertEquals(t, intervalOpts.Interval, rotation.Interval)
+	th.AssertNoErr(t, keys.DisableKeyRotation(client, key.KeyID).ExtractErr())
+
+	keyTags := []tags.ResourceTag{
+		{
+			Key:   "muh",
+			Value: "kuh",
+		},
+	}
+	th.AssertNoErr(t, tags.Create(client, "kms", key.KeyID, keyTags).ExtractErr())
+	tagList, err := tags.Get(client, "kms", key.KeyID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, keyTags, tagList)
+	th.AssertNoErr(t, tags.Delete(client, "kms", key.KeyID, keyTags).ExtractErr())
+
+	deleteOpts := keys.DeleteOpts{
+		KeyID:       key.KeyID,
+		PendingDays: "7",
+	}
+	_, err = keys.Delete(client, deleteOpts).ExtractKeyState()
+	th.AssertNoErr(t, err)
+
+	state, err := keys.CancelDelete(client, key.KeyID).ExtractKeyState()
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, state)
+}
@@ -0,0 +1,59 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/tags"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/lts/v2/loggroups"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/lts/v2/logtopics"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestLogGroupLifecycle(t *testing.T) {
+	client, err := clients.NewLTSV2Client()
+	th.AssertNoErr(t, err)
+
+	name := tools.RandomString("lts-group-", 4)
+	created, err := loggroups.Create(client, loggroups.CreateOpts{
+		LogGroupName: name,
+		TTL:          7,
+	}).Extract()
+	th.AssertNoErr(t, err)
+	defer func() {
+		th.AssertNoErr(t, loggroups.Delete(client, created.ID).ExtractErr())
+	}()
+
+	groups, err := loggroups.List(client).Extract()
+	th.AssertNoErr(t, err)
+	found := false
+	for _, group := range groups {
+		if group.ID == created.ID {
+			found = true
+			th.AssertEquals(t, name, group.Name)
+		}
+	}
+	th.AssertEquals(t, true, found)
+
+	updated, err := loggroups.Update(client, created.ID, loggroups.UpdateOpts{
+		TTL:  14,
+		Tags: []tags.ResourceTag{{Key: "muh", Value: "kuh"}},
+	}).Extract()
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, updated)
+	th.AssertEquals(t, 14, updated.TTLinDays)
+
+	topic, err := logtopics.Create(client, created.ID, logtopics.CreateOpts{
+		LogTopicName: tools.RandomString("lts-topic-", 4),
+	}).Extract()
+	th.AssertNoErr(t, err)
+	defer func() {
+		th.AssertNoErr(t, logtopics.Delete(client, created.ID, topic.ID).ExtractErr())
+	}()
+
+	topics, err := logtopics.List(client, created.ID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(topics))
+	th.AssertEquals(t, topic.ID, topics[0].ID)
+}
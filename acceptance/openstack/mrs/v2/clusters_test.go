@@ -0,0 +1,117 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/mrs/v2/clusters"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/mrs/v2/jobs"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v1/subnets"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v1/vpcs"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestMrsClusterV2Lifecycle(t *testing.T) {
+	client, err := clients.NewMrsV2()
+	th.AssertNoErr(t, err)
+
+	cc, err := clients.CloudAndClient()
+	th.AssertNoErr(t, err)
+
+	az := clients.EnvOS.GetEnv("AVAILABILITY_ZONE")
+	if az == "" {
+		az = "eu-de-02"
+	}
+
+	networkID := clients.EnvOS.GetEnv("NETWORK_ID")
+	vpcID := clients.EnvOS.GetEnv("VPC_ID", "ROUTER_ID")
+	keyPairName := clients.EnvOS.GetEnv("KEYPAIR_NAME")
+	if networkID == "" || vpcID == "" || keyPairName == "" {
+		t.Skip("OS_NETWORK_ID, OS_VPC_ID or OS_KEYPAIR_NAME env vars are missing but MRS Cluster test requires")
+	}
+
+	nwV1Client, err := clients.NewNetworkV1Client()
+	th.AssertNoErr(t, err)
+
+	vpc, err := vpcs.Get(nwV1Client, vpcID).Extract()
+	th.AssertNoErr(t, err)
+	subnet, err := subnets.Get(nwV1Client, networkID).Extract()
+	th.AssertNoErr(t, err)
+
+	rootVolume := &clusters.Volume{Type: "SAS", Size: 480}
+	createOpts := clusters.CreateOpts{
+		ClusterVersion:       "MRS 3.1.0",
+		ClusterName:          tools.RandomString("mrs-v2-", 3),
+		ClusterType:          clusters.TypeAnalysis,
+		Region:               cc.RegionName,
+		VpcName:              vpc.Name,
+		SubnetID:             subnet.NetworkID,
+		SubnetName:           subnet.Name,
+		Components:           []string{"Hadoop", "Spark2x", "Hive", "Tez"},
+		AvailabilityZone:     az,
+		SafeMode:             "SIMPLE",
+		ManagerAdminPassword: "Qwerty123!",
+		LoginMode:            "KEYPAIR",
+		NodeKeypairName:      keyPairName,
+		NodeGroups: []clusters.NodeGroupOpts{
+			{
+				GroupName:  clusters.NodeGroupMaster,
+				NodeNum:    2,
+				NodeSize:   "c4.2xlarge.4.linux.mrs",
+				RootVolume: rootVolume,
+			},
+			{
+				GroupName:  clusters.NodeGroupCore,
+				NodeNum:    3,
+				NodeSize:   "c4.2xlarge.4.linux.mrs",
+				RootVolume: rootVolume,
+			},
+		},
+	}
+
+	clusterID, err := clusters.Create(client, createOpts).Extract()
+	th.AssertNoErr(t, err)
+	defer func() {
+		th.AssertNoErr(t, clusters.Delete(client, clusterID).ExtractErr())
+		th.AssertNoErr(t, clusters.WaitForDeleted(client, clusterID, 3000))
+	}()
+
+	th.AssertNoErr(t, clusters.WaitForStatus(client, clusterID, clusters.StatusRunning, 3600))
+
+	cluster, err := clusters.Get(client, clusterID).Extract()
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, cluster)
+	th.AssertEquals(t, createOpts.ClusterName, cluster.ClusterName)
+
+	err = clusters.ScaleOut(client, clusterID, clusters.ScaleOutOpts{
+		NodeGroupName: clusters.NodeGroupCore,
+		NodeCount:     1,
+	}).ExtractErr()
+	th.AssertNoErr(t, err)
+	th.AssertNoErr(t, clusters.WaitForStatus(client, clusterID, clusters.StatusRunning, 3600))
+
+	err = clusters.ScaleIn(client, clusterID, clusters.ScaleInOpts{
+		NodeGroupName: clusters.NodeGroupCore,
+		NodeCount:     1,
+	}).ExtractErr()
+	th.AssertNoErr(t, err)
+	th.AssertNoErr(t, clusters.WaitForStatus(client, clusterID, clusters.StatusRunning, 3600))
+
+	job, err := jobs.Submit(client, clusterID, jobs.SubmitOpts{
+		JobType:   jobs.TypeHiveSql,
+		JobName:   tools.RandomString("hive-", 3),
+		Arguments: []string{"SELECT 1"},
+	}).Extract()
+	th.AssertNoErr(t, err)
+	defer func() {
+		th.AssertNoErr(t, jobs.Delete(client, clusterID, []string{job.JobID}).ExtractErr())
+	}()
+
+	th.AssertNoErr(t, jobs.WaitForJob(client, clusterID, job.JobID, 1800))
+
+	jobList, err := jobs.List(client, clusterID, jobs.ListOpts{JobID: job.JobID}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(jobList))
+	th.AssertEquals(t, jobs.ResultSucceeded, jobList[0].JobResult)
+}
@@ -1,7 +1,6 @@
 package v1
 
 import (
-	"fmt"
 	"testing"
 
 	"github.com/opentelekomcloud/gophertelekomcloud"
@@ -35,7 +34,7 @@ func CreateNetwork(t *testing.T, prefix, az string) *subnets.Subnet {
 	}).Extract()
 	th.AssertNoErr(t, err)
 
-	err = waitForSubnetToBeActive(client, subnet.ID, 300)
+	err = subnets.WaitForActive(client, subnet.ID, 300)
 	th.AssertNoErr(t, err)
 
 	return subnet
@@ -48,7 +47,7 @@ func DeleteNetwork(t *testing.T, subnet *subnets.Subnet) {
 
 	err = subnets.Delete(client, subnet.VpcID, subnet.ID).ExtractErr()
 	th.AssertNoErr(t, err)
-	err = waitForSubnetToBeDeleted(client, subnet.ID, 300)
+	err = subnets.WaitForDeleted(client, subnet.ID, 300)
 	th.AssertNoErr(t, err)
 
 	err = vpcs.Delete(client, subnet.VpcID).ExtractErr()
@@ -73,7 +72,7 @@ func createEip(t *testing.T, client *golangsdk.ServiceClient, bandwidthSize int)
 
 	// wait to be DOWN
 	t.Logf("Waiting for eip %s to be active", eip.ID)
-	err = waitForEipToActive(client, eip.ID, 600)
+	err = eips.WaitForStatus(client, eip.ID, "DOWN", 600)
 	th.AssertNoErr(t, err)
 
 	newEip, err := eips.Get(client, eip.ID).Extract()
@@ -93,40 +92,12 @@ func deleteEip(t *testing.T, client *golangsdk.ServiceClient, eipID string) {
 	// wait to be deleted
 	t.Logf("Waitting for eip %s to be deleted", eipID)
 
-	err = waitForEipToDelete(client, eipID, 600)
+	err = eips.WaitForDeleted(client, eipID, 600)
 	th.AssertNoErr(t, err)
 
 	t.Logf("Deleted eip/bandwidth: %s", eipID)
 }
 
-func waitForEipToActive(client *golangsdk.ServiceClient, eipID string, secs int) error {
-	return golangsdk.WaitFor(secs, func() (bool, error) {
-		eip, err := eips.Get(client, eipID).Extract()
-		if err != nil {
-			return false, err
-		}
-		if eip.Status == "DOWN" {
-			return true, nil
-		}
-
-		return false, nil
-	})
-}
-
-func waitForEipToDelete(client *golangsdk.ServiceClient, eipID string, secs int) error {
-	return golangsdk.WaitFor(secs, func() (bool, error) {
-		_, err := eips.Get(client, eipID).Extract()
-		if err != nil {
-			if _, ok := err.(golangsdk.ErrDefault404); ok {
-				return true, nil
-			}
-			return false, err
-		}
-
-		return false, nil
-	})
-}
-
 func createSubnet(t *testing.T, client *golangsdk.ServiceClient, vpcID string) *subnets.Subnet {
 	enableDHCP := true
 	createSubnetOpts := subnets.CreateOpts{
@@ -143,7 +114,7 @@ func createSubnet(t *testing.T, client *golangsdk.ServiceClient, vpcID string) *
 
 	// wait to be active
 	t.Logf("Waitting for subnet %s to be active", subnet.ID)
-	err = waitForSubnetToBeActive(client, subnet.ID, 600)
+	err = subnets.WaitForActive(client, subnet.ID, 600)
 	th.AssertNoErr(t, err)
 	t.Logf("Created subnet: %v", subnet.ID)
 
@@ -157,46 +128,12 @@ func deleteSubnet(t *testing.T, client *golangsdk.ServiceClient, vpcID string, i
 	th.AssertNoErr(t, err)
 
 	t.Logf("Waiting for subnet %s to be deleted", id)
-	err = waitForSubnetToBeDeleted(client, id, 60)
+	err = subnets.WaitForDeleted(client, id, 60)
 	th.AssertNoErr(t, err)
 
 	t.Logf("Deleted subnet: %s", id)
 }
 
-func waitForSubnetToBeDeleted(client *golangsdk.ServiceClient, subnetID string, secs int) error {
-	return golangsdk.WaitFor(secs, func() (bool, error) {
-		_, err := subnets.Get(client, subnetID).Extract()
-		if err != nil {
-			if _, ok := err.(golangsdk.ErrDefault404); ok {
-				return true, nil
-			}
-			return false, err
-		}
-
-		return false, nil
-	})
-}
-
-func waitForSubnetToBeActive(client *golangsdk.ServiceClient, subnetID string, secs int) error {
-	return golangsdk.WaitFor(secs, func() (bool, error) {
-		n, err := subnets.Get(client, subnetID).Extract()
-		if err != nil {
-			return false, err
-		}
-
-		if n.Status == "ACTIVE" {
-			return true, nil
-		}
-
-		// If subnet status is other than Active, send error
-		if n.Status == "DOWN" || n.Status == "ERROR" {
-			return false, fmt.Errorf("subnet status: '%s'", n.Status)
-		}
-
-		return false, nil
-	})
-}
-
 func createVpc(t *testing.T, client *golangsdk.ServiceClient) *vpcs.Vpc {
 	createOpts := vpcs.CreateOpts{
 		Name: tools.RandomString("acc-vpc-", 3),
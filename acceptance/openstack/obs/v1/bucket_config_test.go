@@ -0,0 +1,118 @@
+package v1
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/obs"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestObsBucketConfiguration(t *testing.T) {
+	client, err := clients.NewOBSClient()
+	th.AssertNoErr(t, err)
+
+	bucketName := strings.ToLower(tools.RandomString("obs-sdk-test", 5))
+
+	_, err = client.CreateBucket(&obs.CreateBucketInput{
+		Bucket: bucketName,
+	})
+	th.AssertNoErr(t, err)
+
+	defer func() {
+		_, err = client.DeleteBucket(bucketName)
+		th.AssertNoErr(t, err)
+	}()
+
+	t.Log("Versioning")
+	_, err = client.SetBucketVersioning(&obs.SetBucketVersioningInput{
+		Bucket: bucketName,
+		BucketVersioningConfiguration: obs.BucketVersioningConfiguration{
+			Status: obs.VersioningStatusEnabled,
+		},
+	})
+	th.AssertNoErr(t, err)
+
+	versioning, err := client.GetBucketVersioning(bucketName)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, obs.VersioningStatusEnabled, versioning.Status)
+
+	t.Log("Lifecycle")
+	_, err = client.SetBucketLifecycleConfiguration(&obs.SetBucketLifecycleConfigurationInput{
+		Bucket: bucketName,
+		BucketLifecyleConfiguration: obs.BucketLifecyleConfiguration{
+			LifecycleRules: []obs.LifecycleRule{
+				{
+					ID:                          "expire-logs",
+					Prefix:                      "logs/",
+					Status:                      obs.RuleStatusEnabled,
+					Expiration:                  obs.Expiration{Days: 30},
+					NoncurrentVersionExpiration: obs.NoncurrentVersionExpiration{NoncurrentDays: 10},
+				},
+			},
+		},
+	})
+	th.AssertNoErr(t, err)
+
+	lifecycle, err := client.GetBucketLifecycleConfiguration(bucketName)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(lifecycle.LifecycleRules))
+	th.AssertEquals(t, 30, lifecycle.LifecycleRules[0].Expiration.Days)
+
+	_, err = client.DeleteBucketLifecycleConfiguration(bucketName)
+	th.AssertNoErr(t, err)
+
+	t.Log("CORS")
+	_, err = client.SetBucketCors(&obs.SetBucketCorsInput{
+		Bucket: bucketName,
+		BucketCors: obs.BucketCors{
+			CorsRules: []obs.CorsRule{
+				{
+					AllowedOrigin: []string{"https://example.com"},
+					AllowedMethod: []string{"GET", "PUT"},
+					MaxAgeSeconds: 100,
+				},
+			},
+		},
+	})
+	th.AssertNoErr(t, err)
+
+	cors, err := client.GetBucketCors(bucketName)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(cors.CorsRules))
+
+	_, err = client.DeleteBucketCors(bucketName)
+	th.AssertNoErr(t, err)
+
+	t.Log("Website")
+	_, err = client.SetBucketWebsiteConfiguration(&obs.SetBucketWebsiteConfigurationInput{
+		Bucket: bucketName,
+		BucketWebsiteConfiguration: obs.BucketWebsiteConfiguration{
+			IndexDocument: obs.IndexDocument{Suffix: "index.html"},
+		},
+	})
+	th.AssertNoErr(t, err)
+
+	website, err := client.GetBucketWebsiteConfiguration(bucketName)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "index.html", website.IndexDocument.Suffix)
+
+	_, err = client.DeleteBucketWebsiteConfiguration(bucketName)
+	th.AssertNoErr(t, err)
+
+	t.Log("Policy")
+	policy := `{"Statement":[{"Sid":"read","Effect":"Allow","Principal":{"ID":["*"]},"Action":["GetObject"],"Resource":["` + bucketName + `/*"]}]}`
+	_, err = client.SetBucketPolicy(&obs.SetBucketPolicyInput{
+		Bucket: bucketName,
+		Policy: policy,
+	})
+	th.AssertNoErr(t, err)
+
+	_, err = client.GetBucketPolicy(bucketName)
+	th.AssertNoErr(t, err)
+
+	_, err = client.DeleteBucketPolicy(bucketName)
+	th.AssertNoErr(t, err)
+}
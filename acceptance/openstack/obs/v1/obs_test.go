@@ -1,6 +1,10 @@
 package v1
 
 import (
+	"bytes"
+	"encoding/base64"
+	"io/ioutil"
+	"os"
 	"strings"
 	"testing"
 
@@ -73,3 +77,159 @@ func TestObsObjectLifecycle(t *testing.T) {
 		Key:    objectName,
 	})
 }
+
+func TestObsUploadFile(t *testing.T) {
+	client, err := clients.NewOBSClient()
+	th.AssertNoErr(t, err)
+
+	bucketName := strings.ToLower(tools.RandomString("obs-sdk-test", 5))
+
+	_, err = client.CreateBucket(&obs.CreateBucketInput{
+		Bucket: bucketName,
+	})
+	th.AssertNoErr(t, err)
+
+	defer func() {
+		_, err = client.DeleteBucket(bucketName)
+		th.AssertNoErr(t, err)
+	}()
+
+	file, err := ioutil.TempFile("", "obs-upload")
+	th.AssertNoErr(t, err)
+	defer func() { _ = os.Remove(file.Name()) }()
+
+	_, err = file.Write(bytes.Repeat([]byte("a"), 1024*1024))
+	th.AssertNoErr(t, err)
+	th.AssertNoErr(t, file.Close())
+
+	objectName := tools.RandomString("test-obs-", 5)
+
+	_, err = client.UploadFile(&obs.UploadFileInput{
+		ObjectOperationInput: obs.ObjectOperationInput{
+			Bucket: bucketName,
+			Key:    objectName,
+		},
+		UploadFile:       file.Name(),
+		PartSize:         obs.MIN_PART_SIZE,
+		TaskNum:          4,
+		EnableCheckpoint: true,
+	})
+	th.AssertNoErr(t, err)
+
+	defer func() {
+		_, err = client.DeleteObject(&obs.DeleteObjectInput{
+			Bucket: bucketName,
+			Key:    objectName,
+		})
+		th.AssertNoErr(t, err)
+	}()
+
+	metadata, err := client.GetObjectMetadata(&obs.GetObjectMetadataInput{
+		Bucket: bucketName,
+		Key:    objectName,
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, int64(1024*1024), metadata.ContentLength)
+}
+
+func TestObsObjectSseC(t *testing.T) {
+	client, err := clients.NewOBSClient()
+	th.AssertNoErr(t, err)
+
+	bucketName := strings.ToLower(tools.RandomString("obs-sdk-test", 5))
+
+	_, err = client.CreateBucket(&obs.CreateBucketInput{
+		Bucket: bucketName,
+	})
+	th.AssertNoErr(t, err)
+
+	defer func() {
+		_, err = client.DeleteBucket(bucketName)
+		th.AssertNoErr(t, err)
+	}()
+
+	objectName := tools.RandomString("test-obs-", 5)
+	sseHeader := obs.SseCHeader{
+		Key: base64.StdEncoding.EncodeToString([]byte(tools.RandomString("", 32))),
+	}
+
+	_, err = client.PutObject(&obs.PutObjectInput{
+		PutObjectBasicInput: obs.PutObjectBasicInput{
+			ObjectOperationInput: obs.ObjectOperationInput{
+				Bucket:    bucketName,
+				Key:       objectName,
+				SseHeader: sseHeader,
+			},
+		},
+		Body: strings.NewReader("encrypted content"),
+	})
+	th.AssertNoErr(t, err)
+
+	defer func() {
+		_, err = client.DeleteObject(&obs.DeleteObjectInput{
+			Bucket: bucketName,
+			Key:    objectName,
+		})
+		th.AssertNoErr(t, err)
+	}()
+
+	metadata, err := client.GetObjectMetadata(&obs.GetObjectMetadataInput{
+		Bucket:    bucketName,
+		Key:       objectName,
+		SseHeader: sseHeader,
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, sseHeader.GetKeyMD5(), metadata.SseHeader.(obs.SseCHeader).KeyMD5)
+}
+
+func TestObsDownloadObject(t *testing.T) {
+	client, err := clients.NewOBSClient()
+	th.AssertNoErr(t, err)
+
+	bucketName := strings.ToLower(tools.RandomString("obs-sdk-test", 5))
+
+	_, err = client.CreateBucket(&obs.CreateBucketInput{
+		Bucket: bucketName,
+	})
+	th.AssertNoErr(t, err)
+
+	defer func() {
+		_, err = client.DeleteBucket(bucketName)
+		th.AssertNoErr(t, err)
+	}()
+
+	objectName := tools.RandomString("test-obs-", 5)
+	content := bytes.Repeat([]byte("0123456789"), 50*1024)
+
+	_, err = client.PutObject(&obs.PutObjectInput{
+		PutObjectBasicInput: obs.PutObjectBasicInput{
+			ObjectOperationInput: obs.ObjectOperationInput{
+				Bucket: bucketName,
+				Key:    objectName,
+			},
+		},
+		Body: bytes.NewReader(content),
+	})
+	th.AssertNoErr(t, err)
+
+	defer func() {
+		_, err = client.DeleteObject(&obs.DeleteObjectInput{
+			Bucket: bucketName,
+			Key:    objectName,
+		})
+		th.AssertNoErr(t, err)
+	}()
+
+	buf := &bytes.Buffer{}
+	_, err = client.DownloadObject(&obs.DownloadObjectInput{
+		GetObjectMetadataInput: obs.GetObjectMetadataInput{
+			Bucket: bucketName,
+			Key:    objectName,
+		},
+		PartSize:       100 * 1024,
+		TaskNum:        3,
+		VerifyChecksum: true,
+	}, buf)
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, content, buf.Bytes())
+}
@@ -0,0 +1,30 @@
+package quotacenter
+
+import (
+	"testing"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/quotacenter"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestQuotaCenterList(t *testing.T) {
+	cc, err := clients.CloudAndClient()
+	th.AssertNoErr(t, err)
+
+	serviceClients, err := quotacenter.NewClients(cc.ProviderClient, golangsdk.EndpointOpts{
+		Region: cc.RegionName,
+	})
+	th.AssertNoErr(t, err)
+
+	quotas, err := quotacenter.List(*serviceClients)
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, quotas)
+
+	_, ok := quotacenter.Find(quotas, quotacenter.ServiceECS, "instances")
+	th.AssertEquals(t, true, ok)
+	_, ok = quotacenter.Find(quotas, quotacenter.ServiceEIP, "publicIp")
+	th.AssertEquals(t, true, ok)
+}
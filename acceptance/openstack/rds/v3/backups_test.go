@@ -6,6 +6,7 @@ import (
 	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
 	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/rds/v3/backups"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/rds/v3/instances"
 	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
 )
 
@@ -37,4 +38,33 @@ func TestBackupWorkflow(t *testing.T) {
 	err = backups.WaitForBackup(client, rds.Id, b.ID, backups.StatusCompleted)
 	th.AssertNoErr(t, err)
 	t.Log("Backup creation complete")
+
+	keepDays := 2
+	err = backups.Update(client, rds.Id, backups.UpdateOpts{
+		KeepDays:  &keepDays,
+		StartTime: "19:00-20:00",
+		Period:    "1,2,3,4,5,6,7",
+	}).ExtractErr()
+	th.AssertNoErr(t, err)
+
+	policy, err := backups.GetPolicy(client, rds.Id).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, keepDays, policy.KeepDays)
+
+	restoreTimes, err := backups.ListRestoreTimes(client, rds.Id, nil).Extract()
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, restoreTimes)
+
+	jobID, err := backups.RestoreToExisting(client, backups.RestoreToExistingOpts{
+		Source: backups.RestorePoint{
+			InstanceID: rds.Id,
+			Type:       backups.TypeBackup,
+			BackupID:   b.ID,
+		},
+		Target: backups.Target{InstanceID: rds.Id},
+	}).Extract()
+	th.AssertNoErr(t, err)
+	err = instances.WaitForJobCompleted(client, 1200, jobID)
+	th.AssertNoErr(t, err)
+	t.Log("Backup restored to the source instance")
 }
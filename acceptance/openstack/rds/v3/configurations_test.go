@@ -70,3 +70,31 @@ func TestConfigurationsApply(t *testing.T) {
 		t.Errorf("instance config has empty parameter list")
 	}
 }
+
+func TestConfigurationsCompare(t *testing.T) {
+	client, err := clients.NewRdsV3()
+	th.AssertNoErr(t, err)
+
+	source := createRDSConfiguration(t, client)
+	defer deleteRDSConfiguration(t, client, source.ID)
+
+	target := createRDSConfiguration(t, client)
+	defer deleteRDSConfiguration(t, client, target.ID)
+
+	err = configurations.Update(client, target.ID, configurations.UpdateOpts{
+		Values: map[string]string{
+			"max_connections": "20",
+		},
+	}).ExtractErr()
+	th.AssertNoErr(t, err)
+
+	differences, err := configurations.Compare(client, configurations.CompareOpts{
+		SourceID: source.ID,
+		TargetID: target.ID,
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(differences))
+	th.AssertEquals(t, "max_connections", differences[0].Name)
+	th.AssertEquals(t, "10", differences[0].SourceValue)
+	th.AssertEquals(t, "20", differences[0].TargetValue)
+}
@@ -0,0 +1,77 @@
+package v3
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/rds/v3/databases"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/rds/v3/users"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestRdsDatabasesAndUsers(t *testing.T) {
+	client, err := clients.NewRdsV3()
+	th.AssertNoErr(t, err)
+
+	cc, err := clients.CloudAndClient()
+	th.AssertNoErr(t, err)
+
+	// Create RDSv3 instance
+	rds := createRDS(t, client, cc.RegionName)
+	defer deleteRDS(t, client, rds.Id)
+
+	dbName := tools.RandomString("rds_db_", 4)
+	err = databases.Create(client, rds.Id, databases.CreateOpts{
+		Name:         dbName,
+		CharacterSet: "UTF8",
+	}).ExtractErr()
+	th.AssertNoErr(t, err)
+	defer func() {
+		th.AssertNoErr(t, databases.Delete(client, rds.Id, dbName).ExtractErr())
+	}()
+
+	dbPages, err := databases.List(client, rds.Id, databases.ListOpts{Page: 1, Limit: 100}).AllPages()
+	th.AssertNoErr(t, err)
+	dbList, err := databases.ExtractDatabases(dbPages)
+	th.AssertNoErr(t, err)
+	found := false
+	for _, db := range dbList {
+		if db.Name == dbName {
+			found = true
+		}
+	}
+	th.AssertEquals(t, true, found)
+
+	userName := tools.RandomString("rds_user_", 4)
+	err = users.Create(client, rds.Id, users.CreateOpts{
+		Name:     userName,
+		Password: "acc-test-password1!",
+	}).ExtractErr()
+	th.AssertNoErr(t, err)
+	defer func() {
+		th.AssertNoErr(t, users.Delete(client, rds.Id, userName).ExtractErr())
+	}()
+
+	privilegeOpts := databases.PrivilegeOpts{
+		DbName: dbName,
+		Users: []databases.UserPrivilege{
+			{Name: userName, Readonly: true, SchemaName: "public"},
+		},
+	}
+	th.AssertNoErr(t, databases.GrantPrivileges(client, rds.Id, privilegeOpts).ExtractErr())
+
+	err = users.ResetPassword(client, rds.Id, users.ResetPasswordOpts{
+		Name:     userName,
+		Password: "acc-test-password2!",
+	}).ExtractErr()
+	th.AssertNoErr(t, err)
+
+	userPages, err := users.List(client, rds.Id, users.ListOpts{Page: 1, Limit: 100}).AllPages()
+	th.AssertNoErr(t, err)
+	userList, err := users.ExtractUsers(userPages)
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, userList)
+
+	th.AssertNoErr(t, databases.RevokePrivileges(client, rds.Id, privilegeOpts).ExtractErr())
+}
@@ -0,0 +1,24 @@
+package v3
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/rds/v3/datastores"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestDatastoresList(t *testing.T) {
+	client, err := clients.NewRdsV3()
+	th.AssertNoErr(t, err)
+
+	for _, engine := range []string{"MySQL", "PostgreSQL", "SQLServer"} {
+		allPages, err := datastores.List(client, engine).AllPages()
+		th.AssertNoErr(t, err)
+
+		versions, err := datastores.ExtractDataStores(allPages)
+		th.AssertNoErr(t, err)
+		tools.PrintResource(t, versions)
+	}
+}
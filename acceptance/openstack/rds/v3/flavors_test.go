@@ -26,3 +26,18 @@ func TestFlavorsList(t *testing.T) {
 		tools.PrintResource(t, rds)
 	}
 }
+
+func TestStorageTypesList(t *testing.T) {
+	client, err := clients.NewRdsV3()
+	th.AssertNoErr(t, err)
+
+	storageTypes, err := flavors.ListStorageTypes(client, flavors.StorageTypeOpts{
+		VersionName: "10",
+		HaMode:      "ha",
+	}, "PostgreSQL").Extract()
+	th.AssertNoErr(t, err)
+
+	for _, storageType := range storageTypes {
+		tools.PrintResource(t, storageType)
+	}
+}
@@ -70,6 +70,9 @@ func deleteRDS(t *testing.T, client *golangsdk.ServiceClient, rdsID string) {
 	_, err := instances.Delete(client, rdsID).ExtractJobResponse()
 	th.AssertNoErr(t, err)
 
+	err = instances.WaitForStateDeleted(client, 1200, rdsID)
+	th.AssertNoErr(t, err)
+
 	t.Logf("RDSv3 instance deleted: %s", rdsID)
 }
 
@@ -0,0 +1,46 @@
+package v3
+
+import (
+	"testing"
+	"time"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/rds/v3/instances"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestRdsLogs(t *testing.T) {
+	client, err := clients.NewRdsV3()
+	th.AssertNoErr(t, err)
+
+	cc, err := clients.CloudAndClient()
+	th.AssertNoErr(t, err)
+
+	// Create RDSv3 instance
+	rds := createRDS(t, client, cc.RegionName)
+	defer deleteRDS(t, client, rds.Id)
+
+	const layout = "2006-01-02T15:04:05-0700"
+	now := time.Now()
+	startDate := now.Add(-24 * time.Hour).Format(layout)
+	endDate := now.Format(layout)
+
+	errorLogPages, err := instances.ListErrorLog(client, instances.DbErrorlogOpts{
+		StartDate: startDate,
+		EndDate:   endDate,
+	}, rds.Id).AllPages()
+	th.AssertNoErr(t, err)
+	errorLogs, err := instances.ExtractErrorLog(errorLogPages)
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, errorLogs)
+
+	slowLogPages, err := instances.ListSlowLog(client, instances.DbSlowLogOpts{
+		StartDate: startDate,
+		EndDate:   endDate,
+	}, rds.Id).AllPages()
+	th.AssertNoErr(t, err)
+	slowLogs, err := instances.ExtractSlowLog(slowLogPages)
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, slowLogs)
+}
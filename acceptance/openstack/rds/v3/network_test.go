@@ -0,0 +1,50 @@
+package v3
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/openstack"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/rds/v3/instances"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestRdsNetworkSettings(t *testing.T) {
+	client, err := clients.NewRdsV3()
+	th.AssertNoErr(t, err)
+
+	cc, err := clients.CloudAndClient()
+	th.AssertNoErr(t, err)
+
+	// Create RDSv3 instance
+	rds := createRDS(t, client, cc.RegionName)
+	defer deleteRDS(t, client, rds.Id)
+
+	t.Logf("Attempting to change port of RDSv3: %s", rds.Id)
+	_, err = instances.UpdatePort(client, instances.UpdatePortOpts{Port: 8636}, rds.Id).Extract()
+	th.AssertNoErr(t, err)
+	err = instances.WaitForStateAvailable(client, 600, rds.Id)
+	th.AssertNoErr(t, err)
+
+	t.Logf("Attempting to change security group of RDSv3: %s", rds.Id)
+	_, err = instances.UpdateSecurityGroup(client, instances.UpdateSecurityGroupOpts{
+		SecurityGroupId: openstack.DefaultSecurityGroup(t),
+	}, rds.Id).Extract()
+	th.AssertNoErr(t, err)
+	err = instances.WaitForStateAvailable(client, 600, rds.Id)
+	th.AssertNoErr(t, err)
+
+	t.Logf("Attempting to change maintenance window of RDSv3: %s", rds.Id)
+	err = instances.UpdateOpsWindow(client, instances.UpdateOpsWindowOpts{
+		StartTime: "22:00",
+		EndTime:   "02:00",
+	}, rds.Id).ExtractErr()
+	th.AssertNoErr(t, err)
+
+	newRds, err := instances.List(client, instances.ListRdsInstanceOpts{Id: rds.Id}).AllPages()
+	th.AssertNoErr(t, err)
+	rdsList, err := instances.ExtractRdsInstances(newRds)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 8636, rdsList.Instances[0].Port)
+	th.AssertEquals(t, "22:00-02:00", rdsList.Instances[0].MaintenanceWindow)
+}
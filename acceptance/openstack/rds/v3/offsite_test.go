@@ -0,0 +1,55 @@
+package v3
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/rds/v3/backups"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestOffsiteBackupPolicy(t *testing.T) {
+	destRegion := clients.EnvOS.GetEnv("DESTINATION_REGION")
+	destProjectID := clients.EnvOS.GetEnv("DESTINATION_PROJECT_ID")
+	if destRegion == "" || destProjectID == "" {
+		t.Skip("OS_DESTINATION_REGION and OS_DESTINATION_PROJECT_ID are required for this test")
+	}
+
+	client, err := clients.NewRdsV3()
+	th.AssertNoErr(t, err)
+
+	cc, err := clients.CloudAndClient()
+	th.AssertNoErr(t, err)
+
+	rds := createRDS(t, client, cc.RegionName)
+	t.Cleanup(func() {
+		deleteRDS(t, client, rds.Id)
+	})
+
+	keepDays := 3
+	err = backups.UpdateOffsitePolicy(client, rds.Id, backups.OffsitePolicyOpts{
+		BackupType:           "auto",
+		KeepDays:             &keepDays,
+		DestinationRegion:    destRegion,
+		DestinationProjectID: destProjectID,
+	}).ExtractErr()
+	th.AssertNoErr(t, err)
+
+	policies, err := backups.GetOffsitePolicy(client, rds.Id).Extract()
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, policies)
+
+	pages, err := backups.ListOffsite(client, backups.ListOffsiteOpts{InstanceID: rds.Id}).AllPages()
+	th.AssertNoErr(t, err)
+	offsite, err := backups.ExtractBackups(pages)
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, offsite)
+
+	disabled := 0
+	err = backups.UpdateOffsitePolicy(client, rds.Id, backups.OffsitePolicyOpts{
+		BackupType: "all",
+		KeepDays:   &disabled,
+	}).ExtractErr()
+	th.AssertNoErr(t, err)
+}
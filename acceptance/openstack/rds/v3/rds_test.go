@@ -139,6 +139,23 @@ func TestRdsReadReplicaLifecycle(t *testing.T) {
 		t.Logf("Attempting to delete RDSv3 Read Replica: %s", rdsReadReplica.Instance.Id)
 		_, err := instances.Delete(client, rdsReadReplica.Instance.Id).ExtractJobResponse()
 		th.AssertNoErr(t, err)
+		err = instances.WaitForStateDeleted(client, 1200, rdsReadReplica.Instance.Id)
+		th.AssertNoErr(t, err)
 		t.Logf("RDSv3 Read Replica instance deleted: %s", rdsReadReplica.Instance.Id)
 	}()
+
+	t.Logf("Attempting to promote RDSv3 Read Replica to standalone instance")
+	promoteOpts := instances.PromoteReplicaOpts{
+		PromoteToPrimary: &instances.PromoteToPrimary{},
+	}
+	promote, err := instances.PromoteReplica(client, promoteOpts, rdsReadReplica.Instance.Id).Extract()
+	th.AssertNoErr(t, err)
+	err = instances.WaitForJobCompleted(client, 1200, promote.JobId)
+	th.AssertNoErr(t, err)
+
+	allPages, err := instances.List(client, instances.ListRdsInstanceOpts{Id: rdsReadReplica.Instance.Id}).AllPages()
+	th.AssertNoErr(t, err)
+	promoted, err := instances.ExtractRdsInstances(allPages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "Single", promoted.Instances[0].Type)
 }
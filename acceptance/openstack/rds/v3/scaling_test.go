@@ -0,0 +1,48 @@
+package v3
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/rds/v3/instances"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestRdsScaling(t *testing.T) {
+	client, err := clients.NewRdsV3()
+	th.AssertNoErr(t, err)
+
+	cc, err := clients.CloudAndClient()
+	th.AssertNoErr(t, err)
+
+	// Create RDSv3 instance
+	rds := createRDS(t, client, cc.RegionName)
+	defer deleteRDS(t, client, rds.Id)
+
+	t.Logf("Attempting to resize flavor of RDSv3: %s", rds.Id)
+	job, err := instances.Resize(client, instances.ResizeFlavorOpts{
+		ResizeFlavor: &instances.SpecCode{Speccode: "rds.pg.c2.large"},
+	}, rds.Id).Extract()
+	th.AssertNoErr(t, err)
+	err = instances.WaitForJobCompleted(client, 1200, job.JobId)
+	th.AssertNoErr(t, err)
+
+	err = updateRDS(t, client, rds.Id)
+	th.AssertNoErr(t, err)
+
+	t.Logf("Attempting to enable storage autoscaling of RDSv3: %s", rds.Id)
+	enabled := true
+	err = instances.UpdateAutoExpansion(client, instances.AutoExpansionOpts{
+		SwitchOption:     &enabled,
+		LimitSize:        400,
+		TriggerThreshold: 10,
+	}, rds.Id).ExtractErr()
+	th.AssertNoErr(t, err)
+
+	policy, err := instances.GetAutoExpansion(client, rds.Id).Extract()
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, policy)
+	th.AssertEquals(t, true, policy.SwitchOption)
+	th.AssertEquals(t, 400, policy.LimitSize)
+}
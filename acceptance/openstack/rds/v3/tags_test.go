@@ -0,0 +1,46 @@
+package v3
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	common "github.com/opentelekomcloud/gophertelekomcloud/openstack/common/tags"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/rds/v3/tags"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestRdsTags(t *testing.T) {
+	client, err := clients.NewRdsV3()
+	th.AssertNoErr(t, err)
+
+	cc, err := clients.CloudAndClient()
+	th.AssertNoErr(t, err)
+
+	// Create RDSv3 instance
+	rds := createRDS(t, client, cc.RegionName)
+	defer deleteRDS(t, client, rds.Id)
+
+	tagList := []common.ResourceTag{{Key: "muh", Value: "kuh"}}
+	err = tags.Create(client, rds.Id, tagList).ExtractErr()
+	th.AssertNoErr(t, err)
+
+	instanceTags, err := tags.Get(client, rds.Id).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, tagList, instanceTags)
+
+	projectTags, err := tags.List(client).Extract()
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, projectTags)
+
+	resources, err := tags.ListInstances(client, tags.ListInstancesOpts{
+		Action: "filter",
+		Tags:   []tags.Tags{{Key: "muh", Values: []string{"kuh"}}},
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, resources.TotalCount)
+	th.AssertEquals(t, rds.Id, resources.Resources[0].ResourceID)
+
+	err = tags.Delete(client, rds.Id, tagList).ExtractErr()
+	th.AssertNoErr(t, err)
+}
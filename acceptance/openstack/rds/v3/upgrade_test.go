@@ -0,0 +1,78 @@
+package v3
+
+import (
+	"testing"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/rds/v3/instances"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestRdsVersionUpgrade(t *testing.T) {
+	client, err := clients.NewRdsV3()
+	th.AssertNoErr(t, err)
+
+	cc, err := clients.CloudAndClient()
+	th.AssertNoErr(t, err)
+
+	// Create RDSv3 instance
+	rds := createRDS(t, client, cc.RegionName)
+	defer deleteRDS(t, client, rds.Id)
+
+	t.Logf("Attempting to upgrade minor version of RDSv3: %s", rds.Id)
+	delayed := false
+	job, err := instances.UpgradeMinorVersion(client, instances.UpgradeMinorVersionOpts{
+		IsDelayed: &delayed,
+	}, rds.Id).Extract()
+	if err != nil {
+		// the instance is already running the latest minor version
+		if _, ok := err.(golangsdk.ErrDefault400); !ok {
+			th.AssertNoErr(t, err)
+		}
+	} else {
+		th.AssertNoErr(t, instances.WaitForJobCompleted(client, 1200, job.JobId))
+	}
+
+	versions, err := instances.ListAvailableVersions(client, rds.Id).Extract()
+	th.AssertNoErr(t, err)
+	if len(versions) == 0 {
+		t.Skip("No major version available for the upgrade")
+	}
+	target := versions[len(versions)-1]
+
+	t.Logf("Attempting to pre-check major version upgrade of RDSv3 %s to %s", rds.Id, target)
+	_, err = instances.CheckMajorVersionUpgrade(client, instances.MajorVersionOpts{
+		TargetVersion: target,
+	}, rds.Id).Extract()
+	th.AssertNoErr(t, err)
+
+	var report instances.UpgradeReport
+	err = golangsdk.WaitFor(600, func() (bool, error) {
+		reports, err := instances.ListUpgradeReports(client, instances.ListUpgradeReportsOpts{
+			ReportType: "upgrade_pre_check",
+		}, rds.Id).Extract()
+		if err != nil {
+			return false, err
+		}
+		if len(reports.Reports) == 0 {
+			return false, nil
+		}
+		report = reports.Reports[0]
+		return report.Status != "running", nil
+	})
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, report)
+	th.AssertEquals(t, "success", report.Status)
+
+	t.Logf("Attempting to upgrade major version of RDSv3 %s to %s", rds.Id, target)
+	changeIp := true
+	job, err = instances.UpgradeMajorVersion(client, instances.MajorVersionOpts{
+		TargetVersion:            target,
+		IsChangePrivateIp:        &changeIp,
+		StatisticsCollectionMode: "before_change_private_ip",
+	}, rds.Id).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertNoErr(t, instances.WaitForJobCompleted(client, 3600, job.JobId))
+}
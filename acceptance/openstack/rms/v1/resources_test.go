@@ -0,0 +1,83 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/rms/v1/policies"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/rms/v1/resources"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestResourcesList(t *testing.T) {
+	client, err := clients.NewRMSV1Client()
+	th.AssertNoErr(t, err)
+
+	pages, err := resources.List(client, resources.ListOpts{
+		Type:  "vpc.vpcs",
+		Limit: 50,
+	}).AllPages()
+	th.AssertNoErr(t, err)
+
+	vpcs, err := resources.ExtractResources(pages)
+	th.AssertNoErr(t, err)
+	for _, vpc := range vpcs {
+		tools.PrintResource(t, vpc)
+	}
+	if len(vpcs) == 0 {
+		return
+	}
+
+	relationPages, err := resources.ListRelations(client, vpcs[0].ID, resources.RelationsOpts{
+		Direction: resources.DirectionIn,
+	}).AllPages()
+	th.AssertNoErr(t, err)
+
+	relations, err := resources.ExtractRelations(relationPages)
+	th.AssertNoErr(t, err)
+	for _, relation := range relations {
+		th.AssertEquals(t, vpcs[0].ID, relation.ToResourceID)
+	}
+}
+
+func TestPolicyAssignmentLifecycle(t *testing.T) {
+	client, err := clients.NewRMSV1Client()
+	th.AssertNoErr(t, err)
+
+	definitions, err := policies.ListDefinitions(client).Extract()
+	th.AssertNoErr(t, err)
+
+	var definitionID string
+	for _, definition := range definitions {
+		if len(definition.Parameters) == 0 {
+			definitionID = definition.ID
+			break
+		}
+	}
+	if definitionID == "" {
+		t.Skip("no policy definition without parameters found")
+	}
+
+	assignment, err := policies.CreateAssignment(client, policies.AssignmentOpts{
+		Name:               tools.RandomString("rms-policy-", 4),
+		PolicyDefinitionID: definitionID,
+	}).Extract()
+	th.AssertNoErr(t, err)
+	defer func() {
+		th.AssertNoErr(t, policies.DisableAssignment(client, assignment.ID).ExtractErr())
+		th.AssertNoErr(t, policies.DeleteAssignment(client, assignment.ID).ExtractErr())
+	}()
+
+	th.AssertNoErr(t, policies.Evaluate(client, assignment.ID).ExtractErr())
+	th.AssertNoErr(t, policies.WaitForEvaluation(client, assignment.ID, 600))
+
+	pages, err := policies.ListAssignmentStates(client, assignment.ID, nil).AllPages()
+	th.AssertNoErr(t, err)
+
+	states, err := policies.ExtractStates(pages)
+	th.AssertNoErr(t, err)
+	for _, state := range states {
+		th.AssertEquals(t, assignment.ID, state.PolicyAssignmentID)
+	}
+}
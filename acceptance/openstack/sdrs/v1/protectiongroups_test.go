@@ -0,0 +1,71 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/sdrs/v1/drill"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/sdrs/v1/protectiongroups"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/sdrs/v1/replications"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestSDRSGroupList(t *testing.T) {
+	client, err := clients.NewSDRSV1()
+	th.AssertNoErr(t, err)
+
+	allPages, err := protectiongroups.List(client, protectiongroups.ListOpts{}).AllPages()
+	th.AssertNoErr(t, err)
+
+	groups, err := protectiongroups.ExtractGroups(allPages)
+	th.AssertNoErr(t, err)
+
+	for _, group := range groups {
+		tools.PrintResource(t, group)
+
+		replicationPages, err := replications.List(client, replications.ListOpts{GroupID: group.Id}).AllPages()
+		th.AssertNoErr(t, err)
+		groupReplications, err := replications.ExtractReplications(replicationPages)
+		th.AssertNoErr(t, err)
+		th.AssertEquals(t, group.ReplicationNum, len(groupReplications))
+
+		drillPages, err := drill.List(client, drill.ListOpts{GroupID: group.Id}).AllPages()
+		th.AssertNoErr(t, err)
+		drills, err := drill.ExtractDrills(drillPages)
+		th.AssertNoErr(t, err)
+		th.AssertEquals(t, group.DrillNum, len(drills))
+	}
+}
+
+func TestSDRSGroupSwitchover(t *testing.T) {
+	groupID := clients.EnvOS.GetEnv("SDRS_GROUP_ID")
+	if groupID == "" {
+		t.Skip("OS_SDRS_GROUP_ID env var is missing but SDRS switchover test requires an enabled protection group")
+	}
+
+	client, err := clients.NewSDRSV1()
+	th.AssertNoErr(t, err)
+
+	t.Logf("Attempting to switch over SDRS protection group: %s", groupID)
+	job, err := protectiongroups.Reverse(client, groupID, protectiongroups.ReverseOpts{
+		PriorityStation: protectiongroups.PriorityStationTarget,
+	}).ExtractJobResponse()
+	th.AssertNoErr(t, err)
+	th.AssertNoErr(t, protectiongroups.WaitForJobSuccess(client, 1200, job.JobID))
+
+	group, err := protectiongroups.Get(client, groupID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, protectiongroups.PriorityStationTarget, group.PriorityStation)
+
+	t.Logf("Attempting to switch back SDRS protection group: %s", groupID)
+	job, err = protectiongroups.Reverse(client, groupID, protectiongroups.ReverseOpts{
+		PriorityStation: protectiongroups.PriorityStationSource,
+	}).ExtractJobResponse()
+	th.AssertNoErr(t, err)
+	th.AssertNoErr(t, protectiongroups.WaitForJobSuccess(client, 1200, job.JobID))
+
+	group, err = protectiongroups.Get(client, groupID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, protectiongroups.PriorityStationSource, group.PriorityStation)
+}
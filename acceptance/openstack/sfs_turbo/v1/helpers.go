@@ -37,7 +37,7 @@ env vars is missing but SFS Turbo test requires`)
 	th.AssertNoErr(t, err)
 
 	t.Logf("Waiting for SFS Turbo %s to be active", share.ID)
-	err = waitForShareToActive(client, share.ID, 600)
+	err = shares.WaitForShareAvailable(client, share.ID, 600)
 	th.AssertNoErr(t, err)
 
 	newShare, err := shares.Get(client, share.ID).Extract()
@@ -54,7 +54,7 @@ func deleteShare(t *testing.T, client *golangsdk.ServiceClient, shareID string)
 	err := shares.Delete(client, shareID).ExtractErr()
 	th.AssertNoErr(t, err)
 
-	err = waitForShareToDelete(client, shareID, 600)
+	err = shares.WaitForShareDeleted(client, shareID, 600)
 	th.AssertNoErr(t, err)
 
 	t.Logf("Deleted SFS Turbo: %s", shareID)
@@ -72,7 +72,7 @@ func expandShare(t *testing.T, client *golangsdk.ServiceClient, shareID string)
 	err := shares.Expand(client, shareID, expandOpts).ExtractErr()
 	th.AssertNoErr(t, err)
 
-	err = waitForShareSubStatusSuccess(client, shareID, 600)
+	err = shares.WaitForExpandSuccess(client, shareID, 600)
 	th.AssertNoErr(t, err)
 
 	newShare, err := shares.Get(client, shareID).Extract()
@@ -95,7 +95,7 @@ func changeShareSG(t *testing.T, client *golangsdk.ServiceClient, shareID string
 	err := shares.ChangeSG(client, shareID, changeSGOpts).ExtractErr()
 	th.AssertNoErr(t, err)
 
-	err = waitForShareSubStatusSuccess(client, shareID, 600)
+	err = shares.WaitForChangeSGSuccess(client, shareID, 600)
 	th.AssertNoErr(t, err)
 
 	newShare, err := shares.Get(client, shareID).Extract()
@@ -105,45 +105,3 @@ func changeShareSG(t *testing.T, client *golangsdk.ServiceClient, shareID string
 
 	return newShare
 }
-
-func waitForShareToActive(client *golangsdk.ServiceClient, shareID string, secs int) error {
-	return golangsdk.WaitFor(secs, func() (bool, error) {
-		share, err := shares.Get(client, shareID).Extract()
-		if err != nil {
-			return false, err
-		}
-		if share.Status == "200" {
-			return true, nil
-		}
-
-		return false, nil
-	})
-}
-
-func waitForShareToDelete(client *golangsdk.ServiceClient, shareID string, secs int) error {
-	return golangsdk.WaitFor(secs, func() (bool, error) {
-		_, err := shares.Get(client, shareID).Extract()
-		if err != nil {
-			if _, ok := err.(golangsdk.ErrDefault404); ok {
-				return true, nil
-			}
-			return false, err
-		}
-		return false, nil
-	})
-}
-
-func waitForShareSubStatusSuccess(client *golangsdk.ServiceClient, shareID string, secs int) error {
-	return golangsdk.WaitFor(secs, func() (bool, error) {
-		share, err := shares.Get(client, shareID).Extract()
-		if err != nil {
-			return false, err
-		}
-
-		if share.SubStatus == "221" || share.SubStatus == "232" {
-			return true, nil
-		}
-
-		return false, nil
-	})
-}
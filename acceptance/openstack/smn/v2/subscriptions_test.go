@@ -34,4 +34,16 @@ func TestTopicSubscriptionWorkflow(t *testing.T) {
 	subscriptionList, err := subscriptions.List(client).Extract()
 	th.AssertNoErr(t, err)
 	th.AssertEquals(t, 1, len(subscriptionList))
+
+	t.Logf("Attempting to update SMN subscription: %s", subscription.SubscriptionUrn)
+	updateOpts := subscriptions.UpdateOpts{
+		Remark: "updated remark",
+	}
+	_, err = subscriptions.Update(client, updateOpts, topic, subscription.SubscriptionUrn).Extract()
+	th.AssertNoErr(t, err)
+
+	subscriptionList, err = subscriptions.ListFromTopic(client, topic).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(subscriptionList))
+	th.AssertEquals(t, updateOpts.Remark, subscriptionList[0].Remark)
 }
@@ -0,0 +1,54 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/smn/v2/templates"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/smn/v2/topics"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestTemplateWorkflow(t *testing.T) {
+	client, err := clients.NewSmnV2Client()
+	th.AssertNoErr(t, err)
+
+	topic := createTopic(t, client)
+	defer deleteTopic(t, client, topic)
+
+	templateName := tools.RandomString("template_", 3)
+	t.Logf("Attempting to create SMN message template: %s", templateName)
+	templateID, err := templates.Create(client, templates.CreateOpts{
+		Name:     templateName,
+		Protocol: "default",
+		Content:  "Instance {instance_id} is {state}",
+	}).Extract()
+	th.AssertNoErr(t, err)
+	defer func() {
+		th.AssertNoErr(t, templates.Delete(client, templateID).ExtractErr())
+		t.Logf("Deleted SMN message template: %s", templateID)
+	}()
+
+	template, err := templates.Get(client, templateID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, templateName, template.Name)
+	th.AssertEquals(t, 2, len(template.TagNames))
+
+	updateOpts := templates.UpdateOpts{
+		Content: "Instance {instance_id} changed state to {state}",
+	}
+	th.AssertNoErr(t, templates.Update(client, updateOpts, templateID).ExtractErr())
+
+	templateList, err := templates.List(client, templates.ListOpts{Name: templateName}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(templateList))
+
+	t.Logf("Attempting to publish templated message to SMN topic: %s", topic)
+	message, err := topics.PublishTemplate(client, topic, "state change", templateName, map[string]string{
+		"instance_id": "ecs-123",
+		"state":       "running",
+	}).Extract()
+	th.AssertNoErr(t, err)
+	t.Logf("Published message: %s", message.MessageId)
+}
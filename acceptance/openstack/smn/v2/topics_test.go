@@ -0,0 +1,51 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/smn/v2/subscriptions"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/smn/v2/topics"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestTopicPublish(t *testing.T) {
+	client, err := clients.NewSmnV2Client()
+	th.AssertNoErr(t, err)
+
+	topic := createTopic(t, client)
+	defer deleteTopic(t, client, topic)
+
+	subscription, err := subscriptions.Create(client, subscriptions.CreateOpts{
+		Endpoint: "example@email.com",
+		Protocol: subscriptions.ProtocolEmail,
+	}, topic).Extract()
+	th.AssertNoErr(t, err)
+	defer func() {
+		th.AssertNoErr(t, subscriptions.Delete(client, subscription.SubscriptionUrn).ExtractErr())
+	}()
+
+	t.Logf("Attempting to publish plain message to SMN topic: %s", topic)
+	message, err := topics.Publish(client, topics.PublishOpts{
+		Subject: "test",
+		Message: "plain message",
+	}, topic).Extract()
+	th.AssertNoErr(t, err)
+	t.Logf("Published message: %s", message.MessageId)
+
+	structure, err := topics.BuildMessageStructure(map[string]string{
+		"default": "default message",
+		"email":   "email message",
+		"sms":     "sms message",
+	})
+	th.AssertNoErr(t, err)
+
+	t.Logf("Attempting to publish structured message to SMN topic: %s", topic)
+	message, err = topics.Publish(client, topics.PublishOpts{
+		Subject:          "test",
+		MessageStructure: structure,
+		TimeToLive:       "600",
+	}, topic).Extract()
+	th.AssertNoErr(t, err)
+	t.Logf("Published message: %s", message.MessageId)
+}
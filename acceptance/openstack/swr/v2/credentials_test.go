@@ -0,0 +1,28 @@
+package v2
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/swr/v2/credentials"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestTemporaryCredentials(t *testing.T) {
+	client, err := clients.NewSwrV2Client()
+	th.AssertNoErr(t, err)
+
+	cc, err := clients.CloudAndClient()
+	th.AssertNoErr(t, err)
+
+	result := credentials.CreateTemporary(client, credentials.CreateOpts{ProjectName: cc.RegionName})
+	auths, err := result.Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(auths))
+
+	registry := fmt.Sprintf("swr.%s.otc.t-systems.com", cc.RegionName)
+	credential, err := result.ExtractCredential(registry)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, credential.Password != "")
+}
@@ -0,0 +1,69 @@
+package v2
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/swr/v2/retentions"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestRetentionWorkflow(t *testing.T) {
+	client, err := clients.NewSwrV2Client()
+	th.AssertNoErr(t, err)
+
+	// setup deps
+	orgName := fmt.Sprintf("retention-test-%d", tools.RandomInt(0, 0xf))
+	dep := dependencies{t: t, client: client}
+	dep.createOrganization(orgName)
+	defer dep.deleteOrganization(orgName)
+
+	repoName := "retention-test-repo"
+	dep.createRepository(orgName, repoName)
+	defer dep.deleteRepository(orgName, repoName)
+	//
+
+	id, err := retentions.Create(client, orgName, repoName, retentions.CreateOpts{
+		Algorithm: "or",
+		Rules: []retentions.Rule{
+			{
+				Template:     "date_rule",
+				Params:       map[string]string{"days": "30"},
+				TagSelectors: []retentions.TagSelector{{Kind: "label", Pattern: "latest"}},
+			},
+		},
+	}).Extract()
+	th.AssertNoErr(t, err)
+
+	defer func() {
+		th.AssertNoErr(t, retentions.Delete(client, orgName, repoName, id).ExtractErr())
+	}()
+
+	updateOpts := retentions.UpdateOpts{
+		Algorithm: "or",
+		Rules: []retentions.Rule{
+			{
+				Template:     "tag_rule",
+				Params:       map[string]string{"num": "10"},
+				TagSelectors: []retentions.TagSelector{{Kind: "regexp", Pattern: "^v.*"}},
+			},
+		},
+	}
+	err = retentions.Update(client, orgName, repoName, id, updateOpts).ExtractErr()
+	th.AssertNoErr(t, err)
+
+	retention, err := retentions.Get(client, orgName, repoName, id).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "tag_rule", retention.Rules[0].Template)
+
+	pages, err := retentions.List(client, orgName, repoName).AllPages()
+	th.AssertNoErr(t, err)
+	list, err := retentions.ExtractRetentions(pages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(list))
+
+	_, _, err = retentions.ListHistories(client, orgName, repoName, nil).Extract()
+	th.AssertNoErr(t, err)
+}
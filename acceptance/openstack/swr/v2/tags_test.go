@@ -0,0 +1,39 @@
+package v2
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/swr/v2/tags"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestTagsList(t *testing.T) {
+	client, err := clients.NewSwrV2Client()
+	th.AssertNoErr(t, err)
+
+	// setup deps
+	orgName := fmt.Sprintf("tags-test-%d", tools.RandomInt(0, 0xf))
+	dep := dependencies{t: t, client: client}
+	dep.createOrganization(orgName)
+	defer dep.deleteOrganization(orgName)
+
+	repoName := "tags-test-repo"
+	dep.createRepository(orgName, repoName)
+	defer dep.deleteRepository(orgName, repoName)
+	//
+
+	zero := 0
+	pages, err := tags.List(client, orgName, repoName, tags.ListOpts{
+		Offset:      &zero,
+		Limit:       10,
+		OrderColumn: "updated_time",
+		OrderType:   "desc",
+	}).AllPages()
+	th.AssertNoErr(t, err)
+	list, err := tags.ExtractTags(pages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 0, len(list))
+}
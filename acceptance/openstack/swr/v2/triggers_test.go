@@ -0,0 +1,64 @@
+package v2
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/swr/v2/triggers"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestTriggerWorkflow(t *testing.T) {
+	clusterID := clients.EnvOS.GetEnv("CCE_CLUSTER_ID")
+	if clusterID == "" {
+		t.Skip("OS_CCE_CLUSTER_ID is required for this test")
+	}
+
+	client, err := clients.NewSwrV2Client()
+	th.AssertNoErr(t, err)
+
+	// setup deps
+	orgName := fmt.Sprintf("trigger-test-%d", tools.RandomInt(0, 0xf))
+	dep := dependencies{t: t, client: client}
+	dep.createOrganization(orgName)
+	defer dep.deleteOrganization(orgName)
+
+	repoName := "trigger-test-repo"
+	dep.createRepository(orgName, repoName)
+	defer dep.deleteRepository(orgName, repoName)
+	//
+
+	triggerName := "trigger-test"
+	err = triggers.Create(client, orgName, repoName, triggers.CreateOpts{
+		Name:             triggerName,
+		Enable:           "true",
+		Action:           "update",
+		ConditionType:    "all",
+		ConditionValue:   ".*",
+		AppType:          "deployments",
+		Application:      "nginx",
+		ClusterID:        clusterID,
+		ClusterNamespace: "default",
+		TriggerMode:      "cce",
+	}).ExtractErr()
+	th.AssertNoErr(t, err)
+
+	defer func() {
+		th.AssertNoErr(t, triggers.Delete(client, orgName, repoName, triggerName).ExtractErr())
+	}()
+
+	err = triggers.Update(client, orgName, repoName, triggerName, triggers.UpdateOpts{Enable: "false"}).ExtractErr()
+	th.AssertNoErr(t, err)
+
+	trigger, err := triggers.Get(client, orgName, repoName, triggerName).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "false", trigger.Enable)
+
+	pages, err := triggers.List(client, orgName, repoName).AllPages()
+	th.AssertNoErr(t, err)
+	list, err := triggers.ExtractTriggers(pages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(list))
+}
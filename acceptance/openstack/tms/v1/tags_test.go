@@ -0,0 +1,43 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/tms/v1/tags"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestPredefinedTagsLifecycle(t *testing.T) {
+	client, err := clients.NewTMSV1Client()
+	th.AssertNoErr(t, err)
+
+	key := tools.RandomString("tms-key-", 4)
+	predefined := []tags.Tag{
+		{Key: key, Value: "one"},
+		{Key: key, Value: "two"},
+	}
+	th.AssertNoErr(t, tags.Create(client, predefined).ExtractErr())
+	defer func() {
+		th.AssertNoErr(t, tags.Delete(client, []tags.Tag{
+			{Key: key, Value: "one"},
+			{Key: key, Value: "three"},
+		}).ExtractErr())
+	}()
+
+	list, err := tags.List(client, tags.ListOpts{Key: key}).Extract()
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, list)
+	th.AssertEquals(t, 2, list.TotalCount)
+
+	err = tags.Update(client, tags.UpdateOpts{
+		OldTag: tags.Tag{Key: key, Value: "two"},
+		NewTag: tags.Tag{Key: key, Value: "three"},
+	}).ExtractErr()
+	th.AssertNoErr(t, err)
+
+	list, err = tags.List(client, tags.ListOpts{Key: key, Value: "three"}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(list.Tags))
+}
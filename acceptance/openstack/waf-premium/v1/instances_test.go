@@ -0,0 +1,96 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/openstack"
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/tools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/waf-premium/v1/hosts"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/waf-premium/v1/instances"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestWafPremiumInstanceLifecycle(t *testing.T) {
+	vpcID := clients.EnvOS.GetEnv("VPC_ID")
+	subnetID := clients.EnvOS.GetEnv("NETWORK_ID")
+	az := clients.EnvOS.GetEnv("AVAILABILITY_ZONE")
+	if vpcID == "" || subnetID == "" || az == "" {
+		t.Skip("OS_VPC_ID, OS_NETWORK_ID or OS_AVAILABILITY_ZONE env vars are missing but dedicated WAF test requires")
+	}
+
+	secGroupID := openstack.CreateSecurityGroup(t)
+	defer openstack.DeleteSecurityGroup(t, secGroupID)
+
+	client, err := clients.NewWafDedicatedV1Client()
+	th.AssertNoErr(t, err)
+	cc, err := clients.CloudAndClient()
+	th.AssertNoErr(t, err)
+
+	createOpts := instances.CreateOpts{
+		Region:           cc.RegionName,
+		AvailabilityZone: az,
+		Architecture:     "x86",
+		NamePrefix:       tools.RandomString("waf-instance-", 3),
+		Specification:    "waf.instance.professional",
+		Flavor:           "s3.2xlarge.2",
+		VpcID:            vpcID,
+		SubnetID:         subnetID,
+		SecurityGroupsID: []string{secGroupID},
+		Count:            1,
+	}
+	created, err := instances.Create(client, createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(created))
+	instanceID := created[0].Id
+
+	defer func() {
+		th.AssertNoErr(t, instances.Delete(client, instanceID).ExtractErr())
+		th.AssertNoErr(t, instances.WaitForDeleted(client, instanceID, 1200))
+	}()
+	th.AssertNoErr(t, instances.WaitForStatus(client, instanceID, instances.StatusRunning, 1200))
+
+	updateOpts := instances.UpdateOpts{
+		Name: tools.RandomString("waf-instance-upd-", 3),
+	}
+	_, err = instances.Update(client, instanceID, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+
+	instance, err := instances.Get(client, instanceID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, updateOpts.Name, instance.Name)
+
+	iFalse := false
+	hostOpts := hosts.CreateOpts{
+		Hostname: tools.RandomString("www.waf-", 3) + ".com",
+		Proxy:    &iFalse,
+		Server: []hosts.ServerOpts{
+			{
+				FrontProtocol: "HTTP",
+				BackProtocol:  "HTTP",
+				Address:       instance.ServiceIp,
+				Port:          80,
+				Type:          "ipv4",
+				VpcId:         vpcID,
+			},
+		},
+	}
+	host, err := hosts.Create(client, hostOpts).Extract()
+	th.AssertNoErr(t, err)
+	defer func() {
+		th.AssertNoErr(t, hosts.Delete(client, host.Id).ExtractErr())
+	}()
+
+	description := "updated host"
+	_, err = hosts.Update(client, host.Id, hosts.UpdateOpts{Description: &description}).Extract()
+	th.AssertNoErr(t, err)
+
+	status, err := hosts.UpdateProtectStatus(client, host.Id, 0).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 0, status)
+
+	host, err = hosts.Get(client, host.Id).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, description, host.Description)
+	th.AssertEquals(t, 0, host.ProtectStatus)
+}
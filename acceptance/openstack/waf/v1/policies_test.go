@@ -0,0 +1,123 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/acceptance/clients"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/waf/v1/antileakage_rules"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/waf/v1/ccattackprotection_rules"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/waf/v1/geoip_rules"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/waf/v1/policies"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/waf/v1/whiteblackip_rules"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestPolicyRulesLifecycle(t *testing.T) {
+	client, err := clients.NewWafV1Client()
+	th.AssertNoErr(t, err)
+
+	policy := preparePolicy(t, client)
+	defer cleanupPolicy(t, client, policy.Id)
+
+	policyList, err := policies.List(client, policies.ListOpts{Name: policy.Name}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(policyList))
+
+	iTrue := true
+	updateOpts := policies.UpdateOpts{
+		Options: &policies.Options{
+			Cc:           &iTrue,
+			WhiteblackIp: &iTrue,
+			GeoIP:        &iTrue,
+			AntiLeakage:  &iTrue,
+		},
+	}
+	_, err = policies.Update(client, policy.Id, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+
+	limitNum := 10
+	limitPeriod := 60
+	cc, err := ccattackprotection_rules.Create(client, policy.Id, ccattackprotection_rules.CreateOpts{
+		Url:         "/cc",
+		LimitNum:    &limitNum,
+		LimitPeriod: &limitPeriod,
+		TagType:     "ip",
+		Action: ccattackprotection_rules.Action{
+			Category: "block",
+		},
+	}).Extract()
+	th.AssertNoErr(t, err)
+	defer func() {
+		th.AssertNoErr(t, ccattackprotection_rules.Delete(client, policy.Id, cc.Id).ExtractErr())
+	}()
+
+	ccList, err := ccattackprotection_rules.List(client, policy.Id).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(ccList))
+
+	ip, err := whiteblackip_rules.Create(client, policy.Id, whiteblackip_rules.CreateOpts{
+		Addr: "192.168.1.0/24",
+	}).Extract()
+	th.AssertNoErr(t, err)
+	defer func() {
+		th.AssertNoErr(t, whiteblackip_rules.Delete(client, policy.Id, ip.Id).ExtractErr())
+	}()
+
+	geo, err := geoip_rules.Create(client, policy.Id, geoip_rules.CreateOpts{
+		Name:  "block-br",
+		GeoIP: "BR",
+		White: 0,
+	}).Extract()
+	th.AssertNoErr(t, err)
+	defer func() {
+		th.AssertNoErr(t, geoip_rules.Delete(client, policy.Id, geo.Id).ExtractErr())
+	}()
+
+	white := 2
+	_, err = geoip_rules.Update(client, policy.Id, geo.Id, geoip_rules.UpdateOpts{
+		GeoIP: "BR|CN",
+		White: &white,
+	}).Extract()
+	th.AssertNoErr(t, err)
+
+	geoList, err := geoip_rules.List(client, policy.Id).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(geoList))
+	th.AssertEquals(t, geo.Id, geoList[0].Id)
+	th.AssertEquals(t, "BR|CN", geoList[0].GeoIP)
+
+	th.AssertNoErr(t, policies.UpdateRuleStatus(client, policy.Id, policies.RuleTypeGeoIP, geo.Id, 0).ExtractErr())
+	geo, err = geoip_rules.Get(client, policy.Id, geo.Id).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 0, geo.Status)
+
+	th.AssertNoErr(t, policies.UpdateRuleStatus(client, policy.Id, policies.RuleTypeGeoIP, geo.Id, 1).ExtractErr())
+	geo, err = geoip_rules.Get(client, policy.Id, geo.Id).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, geo.Status)
+
+	leakage, err := antileakage_rules.Create(client, policy.Id, antileakage_rules.CreateOpts{
+		Url:      "/admin/*",
+		Category: "code",
+		Contents: []string{"404", "500"},
+	}).Extract()
+	th.AssertNoErr(t, err)
+	defer func() {
+		th.AssertNoErr(t, antileakage_rules.Delete(client, policy.Id, leakage.Id).ExtractErr())
+	}()
+
+	leakageList, err := antileakage_rules.List(client, policy.Id).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(leakageList))
+	th.AssertEquals(t, leakage.Id, leakageList[0].Id)
+
+	th.AssertNoErr(t, policies.UpdateRuleStatus(client, policy.Id, policies.RuleTypeAntiLeakage, leakage.Id, 0).ExtractErr())
+	leakage, err = antileakage_rules.Get(client, policy.Id, leakage.Id).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 0, leakage.Status)
+
+	th.AssertNoErr(t, policies.UpdateRuleStatus(client, policy.Id, policies.RuleTypeAntiLeakage, leakage.Id, 1).ExtractErr())
+	leakage, err = antileakage_rules.Get(client, policy.Id, leakage.Id).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, leakage.Status)
+}
@@ -226,6 +226,19 @@ func (e ErrTimeOut) Error() string {
 	return e.choseErrString()
 }
 
+// ErrUnexpectedState is the error type returned by StateChangeConf when a resource
+// reaches a state which is neither pending nor expected.
+type ErrUnexpectedState struct {
+	BaseError
+	State    string
+	Expected []string
+}
+
+func (e ErrUnexpectedState) Error() string {
+	e.DefaultErrString = fmt.Sprintf("Unexpected state '%s', wanted target %v", e.State, e.Expected)
+	return e.choseErrString()
+}
+
 // ErrUnableToReauthenticate is the error type returned when reauthentication fails.
 type ErrUnableToReauthenticate struct {
 	BaseError
@@ -0,0 +1,101 @@
+package golangsdk
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Logger is used by ProviderClient to dump the requests it issues and the responses it
+// receives. *log.Logger satisfies this interface.
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
+// sensitiveHeaders are the headers whose values are never written to the log.
+var sensitiveHeaders = map[string]bool{
+	"Authorization":    true,
+	"X-Auth-Token":     true,
+	"X-Subject-Token":  true,
+	"X-Security-Token": true,
+}
+
+// sseCustomerKeyHeader is contained in the names of the OBS (and S3) headers carrying
+// SSE-C encryption keys, including the ones of the copy source.
+const sseCustomerKeyHeader = "Server-Side-Encryption-Customer-Key"
+
+// sensitiveFields matches the string values of the JSON body fields which are never written
+// to the log: passwords, tokens, secrets and decrypted data keys.
+var sensitiveFields = regexp.MustCompile(`(?i)("(?:\w*password|adminpass|secret_string|plain_text|token)"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+
+const maskedValue = "***"
+
+func isSensitiveHeader(key string) bool {
+	key = http.CanonicalHeaderKey(key)
+	return sensitiveHeaders[key] || strings.Contains(key, sseCustomerKeyHeader)
+}
+
+// SanitizeBody masks the values of the password, token and secret fields of a JSON body.
+func SanitizeBody(body string) string {
+	return sensitiveFields.ReplaceAllString(body, `$1"`+maskedValue+`"`)
+}
+
+// SanitizeHeaders formats the headers for logging, masking auth tokens, request signatures
+// and SSE-C encryption keys.
+func SanitizeHeaders(headers http.Header) string {
+	keys := make([]string, 0, len(headers))
+	for k := range headers {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	for _, k := range keys {
+		value := strings.Join(headers[k], ", ")
+		if isSensitiveHeader(k) {
+			value = maskedValue
+		}
+		_, _ = fmt.Fprintf(&b, "%s: %s\n", k, value)
+	}
+	return b.String()
+}
+
+func (client *ProviderClient) logRequest(req *http.Request) {
+	if client.Logger == nil {
+		return
+	}
+	client.Logger.Printf("[DEBUG] Request: %s %s\n%s", req.Method, req.URL, SanitizeHeaders(req.Header))
+}
+
+// logResponse dumps the response. Textual bodies are read and logged with their
+// secrets masked, then replaced, so the response can still be consumed by the caller.
+func (client *ProviderClient) logResponse(resp *http.Response) error {
+	if client.Logger == nil {
+		return nil
+	}
+
+	body := ""
+	if resp.Body != nil && isTextual(resp.Header.Get("Content-Type")) {
+		data, err := ioutil.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		if err != nil {
+			return err
+		}
+		resp.Body = ioutil.NopCloser(bytes.NewReader(data))
+		body = SanitizeBody(string(data))
+	}
+
+	client.Logger.Printf("[DEBUG] Response: %s %s: %s\n%s%s",
+		resp.Request.Method, resp.Request.URL, resp.Status, SanitizeHeaders(resp.Header), body)
+	return nil
+}
+
+func isTextual(contentType string) bool {
+	return strings.Contains(contentType, "json") ||
+		strings.Contains(contentType, "xml") ||
+		strings.HasPrefix(contentType, "text/")
+}
@@ -1,6 +1,7 @@
 package antiddos
 
 import (
+	"fmt"
 	"reflect"
 	"strconv"
 	"time"
@@ -313,3 +314,52 @@ func WeeklyReport(client *golangsdk.ServiceClient, opts WeeklyReportOptsBuilder)
 	})
 	return
 }
+
+// SetDefaultConfig sets the default Anti-DDoS defense policy applied to the newly purchased EIPs.
+func SetDefaultConfig(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r SetDefaultConfigResult) {
+	b, err := opts.ToCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Post(DefaultConfigURL(client), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// GetDefaultConfig returns the default Anti-DDoS defense policy.
+func GetDefaultConfig(client *golangsdk.ServiceClient) (r GetDefaultConfigResult) {
+	_, r.Err = client.Get(DefaultConfigURL(client), &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// DeleteDefaultConfig deletes the default Anti-DDoS defense policy.
+func DeleteDefaultConfig(client *golangsdk.ServiceClient) (r DeleteDefaultConfigResult) {
+	_, r.Err = client.Delete(DefaultConfigURL(client), &golangsdk.RequestOpts{
+		OkCodes:      []int{200},
+		JSONResponse: &r.Body,
+	})
+	return
+}
+
+// WaitForTask waits until the task returned by Create, Update or Delete is finished.
+func WaitForTask(client *golangsdk.ServiceClient, taskId string, secs int) error {
+	return golangsdk.WaitFor(secs, func() (bool, error) {
+		task, err := GetTask(client, GetTaskOpts{TaskId: taskId}).Extract()
+		if err != nil {
+			return false, err
+		}
+		switch task.TaskStatus {
+		case "success":
+			return true, nil
+		case "failed":
+			return false, fmt.Errorf("Anti-DDoS task %s failed: %s", taskId, task.TaskMsg)
+		default:
+			return false, nil
+		}
+	})
+}
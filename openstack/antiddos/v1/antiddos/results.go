@@ -381,3 +381,61 @@ func (r *WeeklyReportResponse) UnmarshalJSON(b []byte) error {
 
 	return nil
 }
+
+type SetDefaultConfigResult struct {
+	commonResult
+}
+
+func (r SetDefaultConfigResult) Extract() (*DefaultConfigResponse, error) {
+	var response DefaultConfigResponse
+	err := r.ExtractInto(&response)
+	return &response, err
+}
+
+type DeleteDefaultConfigResult struct {
+	commonResult
+}
+
+func (r DeleteDefaultConfigResult) Extract() (*DefaultConfigResponse, error) {
+	var response DefaultConfigResponse
+	err := r.ExtractInto(&response)
+	return &response, err
+}
+
+type DefaultConfigResponse struct {
+	// Internal error code
+	ErrorCode string `json:"error_code,"`
+
+	// Internal error description
+	ErrorMsg string `json:"error_msg,"`
+}
+
+type GetDefaultConfigResult struct {
+	commonResult
+}
+
+func (r GetDefaultConfigResult) Extract() (*GetDefaultConfigResponse, error) {
+	var response GetDefaultConfigResponse
+	err := r.ExtractInto(&response)
+	return &response, err
+}
+
+type GetDefaultConfigResponse struct {
+	// Whether the default defense policy is configured
+	IsDefaultConfigExist bool `json:"is_default_config_exist,"`
+
+	// Whether L7 defense has been enabled
+	EnableL7 bool `json:"enable_L7,"`
+
+	// Position ID of traffic
+	TrafficPosId int `json:"traffic_pos_id,"`
+
+	// Position ID of number of HTTP requests
+	HttpRequestPosId int `json:"http_request_pos_id,"`
+
+	// Position ID of access limit during cleaning
+	CleaningAccessPosId int `json:"cleaning_access_pos_id,"`
+
+	// Application type ID
+	AppTypeId int `json:"app_type_id,"`
+}
@@ -752,3 +752,64 @@ func HandleGetTaskSuccessfully(t *testing.T) {
 		_, _ = fmt.Fprint(w, GetTaskOutput)
 	})
 }
+
+const SetDefaultConfigRequest string = `
+{
+  "app_type_id": 0,
+  "cleaning_access_pos_id": 1,
+  "enable_L7": false,
+  "http_request_pos_id": 1,
+  "traffic_pos_id": 1
+}
+`
+
+const DefaultConfigOutput string = `
+{
+  "error_code": "10000000",
+  "error_msg": "Ok"
+}
+`
+
+var DefaultConfigResponse = antiddos.DefaultConfigResponse{
+	ErrorCode: "10000000",
+	ErrorMsg:  "Ok",
+}
+
+const GetDefaultConfigOutput string = `
+{
+  "is_default_config_exist": true,
+  "enable_L7": false,
+  "traffic_pos_id": 1,
+  "http_request_pos_id": 1,
+  "cleaning_access_pos_id": 1,
+  "app_type_id": 0
+}
+`
+
+var GetDefaultConfigResponse = antiddos.GetDefaultConfigResponse{
+	IsDefaultConfigExist: true,
+	EnableL7:             false,
+	TrafficPosId:         1,
+	HttpRequestPosId:     1,
+	CleaningAccessPosId:  1,
+	AppTypeId:            0,
+}
+
+func HandleDefaultConfigSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/antiddos/default-config", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+
+		switch r.Method {
+		case "POST":
+			th.TestJSONRequest(t, r, SetDefaultConfigRequest)
+			_, _ = fmt.Fprint(w, DefaultConfigOutput)
+		case "GET":
+			_, _ = fmt.Fprint(w, GetDefaultConfigOutput)
+		case "DELETE":
+			_, _ = fmt.Fprint(w, DefaultConfigOutput)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+}
@@ -153,3 +153,26 @@ func TestGetTask(t *testing.T) {
 	th.AssertNoErr(t, err)
 	th.CheckDeepEquals(t, &GetTaskResponse, actual)
 }
+
+func TestDefaultConfig(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDefaultConfigSuccessfully(t)
+
+	setOpts := antiddos.CreateOpts{
+		TrafficPosId:        1,
+		HttpRequestPosId:    1,
+		CleaningAccessPosId: 1,
+	}
+	setResp, err := antiddos.SetDefaultConfig(client.ServiceClient(), setOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &DefaultConfigResponse, setResp)
+
+	config, err := antiddos.GetDefaultConfig(client.ServiceClient()).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &GetDefaultConfigResponse, config)
+
+	deleteResp, err := antiddos.DeleteDefaultConfig(client.ServiceClient()).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &DefaultConfigResponse, deleteResp)
+}
@@ -45,3 +45,7 @@ func UpdateURL(c *golangsdk.ServiceClient, floatingIpId string) string {
 func WeeklyReportURL(c *golangsdk.ServiceClient) string {
 	return c.ServiceURL("antiddos", "weekly")
 }
+
+func DefaultConfigURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("antiddos", "default-config")
+}
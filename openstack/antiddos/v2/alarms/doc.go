@@ -0,0 +1,18 @@
+/*
+Package alarms enables management of the Anti-DDoS alarm notifications
+sent to the SMN topic.
+
+Example to subscribe to the Anti-DDoS alarms
+
+	updateOpts := alarms.UpdateOpts{
+		TopicUrn: "urn:smn:eu-de:0c4d7d1aa1b94d6a8f5f8b2c3a8e9f00:ddos",
+		WarnConfig: &alarms.WarnConfig{
+			AntiDDoS: true,
+		},
+	}
+	err := alarms.Update(client, updateOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package alarms
@@ -0,0 +1,63 @@
+package alarms
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// Get returns the alarm notification configuration.
+func Get(client *golangsdk.ServiceClient) (r GetResult) {
+	_, r.Err = client.Get(AlarmConfigURL(client), &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+type WarnConfig struct {
+	// DDoS attacks
+	AntiDDoS bool `json:"antiDDoS"`
+	// Brute force cracking (system logins, FTP, and DB)
+	BruceForce bool `json:"bruce_force"`
+	// Alarms about remote logins
+	RemoteLogin bool `json:"remote_login"`
+	// Weak passwords (system and database)
+	WeakPassword bool `json:"weak_password"`
+	// Overly high rights of a database process
+	HighPrivilege bool `json:"high_privilege"`
+	// Webshells
+	BackDoors bool `json:"back_doors"`
+	// Reserved
+	Waf bool `json:"waf"`
+	// Alarm sending frequency in minutes
+	SendFrequency int `json:"send_frequency,omitempty"`
+}
+
+type UpdateOpts struct {
+	// ID of the SMN topic receiving the alarms, empty value disables the notifications
+	TopicUrn string `json:"topic_urn"`
+	// Description of the topic
+	DisplayName string `json:"display_name,omitempty"`
+	// Alarm configuration
+	WarnConfig *WarnConfig `json:"warn_config" required:"true"`
+}
+
+type UpdateOptsBuilder interface {
+	ToUpdateMap() (map[string]interface{}, error)
+}
+
+func (opts UpdateOpts) ToUpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Update changes the alarm notification configuration.
+func Update(client *golangsdk.ServiceClient, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Put(AlarmConfigURL(client), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
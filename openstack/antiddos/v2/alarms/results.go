@@ -0,0 +1,28 @@
+package alarms
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+type GetResult struct {
+	golangsdk.Result
+}
+
+func (r GetResult) Extract() (*AlarmConfig, error) {
+	var response AlarmConfig
+	err := r.ExtractInto(&response)
+	return &response, err
+}
+
+type AlarmConfig struct {
+	// ID of the SMN topic receiving the alarms
+	TopicUrn string `json:"topic_urn"`
+	// Description of the topic
+	DisplayName string `json:"display_name"`
+	// Alarm configuration
+	WarnConfig WarnConfig `json:"warn_config"`
+}
+
+type UpdateResult struct {
+	golangsdk.ErrResult
+}
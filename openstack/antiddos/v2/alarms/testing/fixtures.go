@@ -0,0 +1,73 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/antiddos/v2/alarms"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	"github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const GetOutput string = `
+{
+  "topic_urn": "urn:smn:eu-de:0c4d7d1aa1b94d6a8f5f8b2c3a8e9f00:ddos",
+  "display_name": "ddos",
+  "warn_config": {
+    "antiDDoS": true,
+    "bruce_force": false,
+    "remote_login": false,
+    "weak_password": false,
+    "high_privilege": false,
+    "back_doors": false,
+    "waf": false,
+    "send_frequency": 15
+  }
+}
+`
+
+var GetResponse = alarms.AlarmConfig{
+	TopicUrn:    "urn:smn:eu-de:0c4d7d1aa1b94d6a8f5f8b2c3a8e9f00:ddos",
+	DisplayName: "ddos",
+	WarnConfig: alarms.WarnConfig{
+		AntiDDoS:      true,
+		SendFrequency: 15,
+	},
+}
+
+const UpdateRequest string = `
+{
+  "topic_urn": "urn:smn:eu-de:0c4d7d1aa1b94d6a8f5f8b2c3a8e9f00:ddos",
+  "warn_config": {
+    "antiDDoS": true,
+    "bruce_force": false,
+    "remote_login": false,
+    "weak_password": false,
+    "high_privilege": false,
+    "back_doors": false,
+    "waf": false
+  }
+}
+`
+
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/warnalert/alertconfig", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, GetOutput)
+	})
+}
+
+func HandleUpdateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/warnalert/alertconfig", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, `{}`)
+	})
+}
@@ -0,0 +1,33 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/antiddos/v2/alarms"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	"github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	actual, err := alarms.Get(client.ServiceClient()).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &GetResponse, actual)
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateSuccessfully(t)
+
+	updateOpts := alarms.UpdateOpts{
+		TopicUrn: "urn:smn:eu-de:0c4d7d1aa1b94d6a8f5f8b2c3a8e9f00:ddos",
+		WarnConfig: &alarms.WarnConfig{
+			AntiDDoS: true,
+		},
+	}
+	th.AssertNoErr(t, alarms.Update(client.ServiceClient(), updateOpts).ExtractErr())
+}
@@ -0,0 +1,7 @@
+package alarms
+
+import golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+func AlarmConfigURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("warnalert", "alertconfig")
+}
@@ -0,0 +1,53 @@
+/*
+Package apis manages the API definitions of APIG dedicated gateways and their publishing.
+
+Example to Create an API With an HTTP Backend and Publish It
+
+	api, err := apis.Create(apigClient, instanceID, apis.APIOpts{
+		GroupID:     groupID,
+		Name:        "get_user",
+		Type:        apis.TypePublic,
+		ReqProtocol: "HTTPS",
+		ReqMethod:   "GET",
+		ReqURI:      "/users/{id}",
+		AuthType:    apis.AuthApp,
+		BackendType: apis.BackendHTTP,
+		RequestParameters: []apis.RequestParameter{
+			{Name: "id", Type: "STRING", Location: apis.LocationPath, Required: 1},
+		},
+		BackendParameters: []apis.BackendParameter{
+			{Name: "id", Location: apis.LocationPath, Origin: apis.OriginRequest, Value: "id"},
+		},
+		BackendAPI: &apis.BackendAPI{
+			ReqProtocol: "HTTPS",
+			ReqMethod:   "GET",
+			ReqURI:      "/api/users/{id}",
+			Timeout:     5000,
+			UrlDomain:   "backend.example.com",
+		},
+	}).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	_, err = apis.Publish(apigClient, instanceID, apis.PublishOpts{
+		Action: apis.ActionOnline,
+		EnvID:  environments.DefaultEnvironmentID,
+		ApiID:  api.ID,
+	}).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Take an API Offline
+
+	_, err := apis.Publish(apigClient, instanceID, apis.PublishOpts{
+		Action: apis.ActionOffline,
+		EnvID:  environments.DefaultEnvironmentID,
+		ApiID:  api.ID,
+	}).Extract()
+	if err != nil {
+		panic(err)
+	}
+*/
+package apis
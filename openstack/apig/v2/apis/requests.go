@@ -0,0 +1,287 @@
+package apis
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// API types.
+const (
+	TypePublic  = 1
+	TypePrivate = 2
+)
+
+// Authentication types.
+const (
+	AuthNone       = "NONE"
+	AuthApp        = "APP"
+	AuthIAM        = "IAM"
+	AuthAuthorizer = "AUTHORIZER"
+)
+
+// Backend types.
+const (
+	BackendHTTP     = "HTTP"
+	BackendFunction = "FUNCTION"
+	BackendMock     = "MOCK"
+)
+
+// Request parameter locations.
+const (
+	LocationPath   = "PATH"
+	LocationQuery  = "QUERY"
+	LocationHeader = "HEADER"
+)
+
+// Backend parameter origins.
+const (
+	OriginRequest  = "REQUEST"
+	OriginConstant = "CONSTANT"
+	OriginSystem   = "SYSTEM"
+)
+
+// Publishing actions.
+const (
+	ActionOnline  = "online"
+	ActionOffline = "offline"
+)
+
+// RequestParameter is a frontend request parameter.
+type RequestParameter struct {
+	Name string `json:"name" required:"true"`
+	// Type is either "STRING" or "NUMBER".
+	Type string `json:"type" required:"true"`
+	// Location is one of the Location* constants.
+	Location string `json:"location" required:"true"`
+	// Required is 1 for required parameters and 2 for optional ones.
+	Required     int    `json:"required,omitempty"`
+	DefaultValue string `json:"default_value,omitempty"`
+	SampleValue  string `json:"sample_value,omitempty"`
+	Description  string `json:"remark,omitempty"`
+	Enumerations string `json:"enumerations,omitempty"`
+	MinNum       int    `json:"min_num,omitempty"`
+	MaxNum       int    `json:"max_num,omitempty"`
+	MinSize      int    `json:"min_size,omitempty"`
+	MaxSize      int    `json:"max_size,omitempty"`
+	// PassThrough is 1 to pass the parameter to the backend and 2 to drop it.
+	PassThrough int `json:"pass_through,omitempty"`
+}
+
+// BackendParameter maps a value onto a backend request parameter.
+type BackendParameter struct {
+	Name string `json:"name" required:"true"`
+	// Location is one of the Location* constants.
+	Location string `json:"location" required:"true"`
+	// Origin is one of the Origin* constants.
+	Origin string `json:"origin" required:"true"`
+	// Value is the request parameter name, the constant value or the system parameter name.
+	Value       string `json:"value" required:"true"`
+	Description string `json:"remark,omitempty"`
+}
+
+// VpcChannelInfo routes an HTTP backend through a VPC channel.
+type VpcChannelInfo struct {
+	VpcChannelID        string `json:"vpc_channel_id" required:"true"`
+	VpcChannelProxyHost string `json:"vpc_channel_proxy_host,omitempty"`
+}
+
+// BackendAPI is an HTTP backend.
+type BackendAPI struct {
+	// ReqProtocol is "HTTP", "HTTPS" or "GRPCS".
+	ReqProtocol string `json:"req_protocol" required:"true"`
+	ReqMethod   string `json:"req_method" required:"true"`
+	ReqURI      string `json:"req_uri" required:"true"`
+	// Timeout is the backend timeout in milliseconds.
+	Timeout int `json:"timeout" required:"true"`
+	// UrlDomain is the backend address, not used with a VPC channel.
+	UrlDomain string `json:"url_domain,omitempty"`
+	// VpcChannelStatus is 1 if a VPC channel is used, 2 otherwise.
+	VpcChannelStatus int             `json:"vpc_channel_status,omitempty"`
+	VpcChannelInfo   *VpcChannelInfo `json:"vpc_channel_info,omitempty"`
+	RetryCount       string          `json:"retry_count,omitempty"`
+	Description      string          `json:"remark,omitempty"`
+}
+
+// FuncInfo is a FunctionGraph backend.
+type FuncInfo struct {
+	FunctionURN string `json:"function_urn" required:"true"`
+	// InvocationType is either "sync" or "async".
+	InvocationType string `json:"invocation_type" required:"true"`
+	// Timeout is the backend timeout in milliseconds.
+	Timeout     int    `json:"timeout" required:"true"`
+	Version     string `json:"version,omitempty"`
+	AliasURN    string `json:"alias_urn,omitempty"`
+	Description string `json:"remark,omitempty"`
+}
+
+// MockInfo is a mock backend.
+type MockInfo struct {
+	ResultContent string `json:"result_content,omitempty"`
+	Description   string `json:"remark,omitempty"`
+}
+
+// APIOptsBuilder allows extensions to add additional parameters to the Create and Update requests.
+type APIOptsBuilder interface {
+	ToAPIMap() (map[string]interface{}, error)
+}
+
+// APIOpts contains the options to create or update an API definition.
+type APIOpts struct {
+	GroupID string `json:"group_id" required:"true"`
+	Name    string `json:"name" required:"true"`
+	// Type is either TypePublic or TypePrivate.
+	Type int `json:"type" required:"true"`
+	// ReqProtocol is "HTTP", "HTTPS" or "BOTH".
+	ReqProtocol string `json:"req_protocol" required:"true"`
+	ReqMethod   string `json:"req_method" required:"true"`
+	// ReqURI is the request path, it can contain path parameters like "/users/{id}".
+	ReqURI string `json:"req_uri" required:"true"`
+	// AuthType is one of the Auth* constants.
+	AuthType string `json:"auth_type" required:"true"`
+	// BackendType is one of the Backend* constants.
+	BackendType string `json:"backend_type" required:"true"`
+	// MatchMode is either "SWA" (prefix) or "NORMAL" (exact).
+	MatchMode    string   `json:"match_mode,omitempty"`
+	Version      string   `json:"version,omitempty"`
+	Cors         *bool    `json:"cors,omitempty"`
+	AuthorizerID string   `json:"authorizer_id,omitempty"`
+	Tags         []string `json:"tags,omitempty"`
+	Description  string   `json:"remark,omitempty"`
+	// ResultNormalSample is an example response of a successful request.
+	ResultNormalSample string `json:"result_normal_sample,omitempty"`
+	// ResultFailureSample is an example response of a failed request.
+	ResultFailureSample string             `json:"result_failure_sample,omitempty"`
+	RequestParameters   []RequestParameter `json:"req_params,omitempty"`
+	BackendParameters   []BackendParameter `json:"backend_params,omitempty"`
+	// BackendAPI is required if BackendType is BackendHTTP.
+	BackendAPI *BackendAPI `json:"backend_api,omitempty"`
+	// FuncInfo is required if BackendType is BackendFunction.
+	FuncInfo *FuncInfo `json:"func_info,omitempty"`
+	// MockInfo is required if BackendType is BackendMock.
+	MockInfo *MockInfo `json:"mock_info,omitempty"`
+}
+
+// ToAPIMap builds a request body from APIOpts.
+func (opts APIOpts) ToAPIMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Create creates an API definition.
+func Create(client *golangsdk.ServiceClient, instanceID string, opts APIOptsBuilder) (r CreateResult) {
+	b, err := opts.ToAPIMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(rootURL(client, instanceID), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+// Get retrieves an API definition.
+func Get(client *golangsdk.ServiceClient, instanceID, id string) (r GetResult) {
+	_, r.Err = client.Get(resourceURL(client, instanceID, id), &r.Body, nil)
+	return
+}
+
+// ListOpts allows to filter the listed API definitions.
+type ListOpts struct {
+	ID          string `q:"id"`
+	Name        string `q:"name"`
+	GroupID     string `q:"group_id"`
+	ReqProtocol string `q:"req_protocol"`
+	ReqMethod   string `q:"req_method"`
+	ReqURI      string `q:"req_uri"`
+	AuthType    string `q:"auth_type"`
+	EnvID       string `q:"env_id"`
+	Type        int    `q:"type"`
+	Offset      int    `q:"offset"`
+	Limit       int    `q:"limit"`
+}
+
+// List retrieves the API definitions of the gateway.
+func List(client *golangsdk.ServiceClient, instanceID string, opts ListOpts) (r ListResult) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Get(rootURL(client, instanceID)+q.String(), &r.Body, nil)
+	return
+}
+
+// Update updates an API definition. Published APIs must be republished for the changes to take effect.
+func Update(client *golangsdk.ServiceClient, instanceID, id string, opts APIOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToAPIMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(resourceURL(client, instanceID, id), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete deletes an API definition.
+func Delete(client *golangsdk.ServiceClient, instanceID, id string) (r DeleteResult) {
+	_, r.Err = client.Delete(resourceURL(client, instanceID, id), &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// PublishOptsBuilder allows extensions to add additional parameters to the Publish request.
+type PublishOptsBuilder interface {
+	ToPublishMap() (map[string]interface{}, error)
+}
+
+// PublishOpts contains the options to publish an API or to take it offline.
+type PublishOpts struct {
+	// Action is either ActionOnline or ActionOffline.
+	Action      string `json:"action" required:"true"`
+	EnvID       string `json:"env_id" required:"true"`
+	ApiID       string `json:"api_id" required:"true"`
+	Description string `json:"remark,omitempty"`
+}
+
+// ToPublishMap builds a request body from PublishOpts.
+func (opts PublishOpts) ToPublishMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Publish publishes an API in an environment or takes it offline.
+func Publish(client *golangsdk.ServiceClient, instanceID string, opts PublishOptsBuilder) (r PublishResult) {
+	b, err := opts.ToPublishMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(actionURL(client, instanceID), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	return
+}
+
+// BatchPublishOpts contains the options to publish APIs or to take them offline in bulk.
+type BatchPublishOpts struct {
+	// Action is either ActionOnline or ActionOffline.
+	Action      string   `json:"-" required:"true"`
+	EnvID       string   `json:"env_id" required:"true"`
+	ApiIDs      []string `json:"apis" required:"true"`
+	Description string   `json:"remark,omitempty"`
+}
+
+// BatchPublish publishes APIs in an environment or takes them offline in bulk.
+func BatchPublish(client *golangsdk.ServiceClient, instanceID string, opts BatchPublishOpts) (r BatchPublishResult) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		r.Err = err
+		return
+	}
+	url := publishURL(client, instanceID) + "?action=" + opts.Action
+	_, r.Err = client.Post(url, b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	return
+}
@@ -0,0 +1,130 @@
+package apis
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// API represents an API definition.
+type API struct {
+	ID                  string             `json:"id"`
+	Name                string             `json:"name"`
+	GroupID             string             `json:"group_id"`
+	GroupName           string             `json:"group_name"`
+	Type                int                `json:"type"`
+	Version             string             `json:"version"`
+	ReqProtocol         string             `json:"req_protocol"`
+	ReqMethod           string             `json:"req_method"`
+	ReqURI              string             `json:"req_uri"`
+	AuthType            string             `json:"auth_type"`
+	MatchMode           string             `json:"match_mode"`
+	BackendType         string             `json:"backend_type"`
+	Cors                bool               `json:"cors"`
+	AuthorizerID        string             `json:"authorizer_id"`
+	Tags                []string           `json:"tags"`
+	Description         string             `json:"remark"`
+	ResultNormalSample  string             `json:"result_normal_sample"`
+	ResultFailureSample string             `json:"result_failure_sample"`
+	RequestParameters   []RequestParameter `json:"req_params"`
+	BackendParameters   []BackendParameter `json:"backend_params"`
+	BackendAPI          *BackendAPI        `json:"backend_api"`
+	FuncInfo            *FuncInfo          `json:"func_info"`
+	MockInfo            *MockInfo          `json:"mock_info"`
+	// Status is 1 if the API is valid.
+	Status int `json:"status"`
+	// PublishID is the publication record ID in the environment the API was queried in.
+	PublishID    string `json:"publish_id"`
+	RunEnvID     string `json:"run_env_id"`
+	RunEnvName   string `json:"run_env_name"`
+	RegisterTime string `json:"register_time"`
+	UpdateTime   string `json:"update_time"`
+}
+
+type commonResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as an API.
+func (r commonResult) Extract() (*API, error) {
+	var s API
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// CreateResult is the result of a Create request.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult is the result of a Get request.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult is the result of an Update request.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult is the result of a Delete request.
+// Call its ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	golangsdk.ErrResult
+}
+
+// ListResult is the result of a List request.
+type ListResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as a slice of API.
+func (r ListResult) Extract() ([]API, error) {
+	var s []API
+	err := r.ExtractIntoSlicePtr(&s, "apis")
+	return s, err
+}
+
+// Publication is a publication record of an API.
+type Publication struct {
+	PublishID   string `json:"publish_id"`
+	ApiID       string `json:"api_id"`
+	ApiName     string `json:"api_name"`
+	EnvID       string `json:"env_id"`
+	PublishTime string `json:"publish_time"`
+	VersionID   string `json:"version_id"`
+	Description string `json:"remark"`
+}
+
+// PublishResult is the result of a Publish request.
+type PublishResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as a Publication.
+func (r PublishResult) Extract() (*Publication, error) {
+	var s Publication
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// BatchPublication is the result of a bulk publishing operation.
+type BatchPublication struct {
+	Success []Publication `json:"success"`
+	Failure []struct {
+		ApiID     string `json:"api_id"`
+		ApiName   string `json:"api_name"`
+		ErrorCode string `json:"error_code"`
+		ErrorMsg  string `json:"error_msg"`
+	} `json:"failure"`
+}
+
+// BatchPublishResult is the result of a BatchPublish request.
+type BatchPublishResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as a BatchPublication.
+func (r BatchPublishResult) Extract() (*BatchPublication, error) {
+	var s BatchPublication
+	err := r.ExtractInto(&s)
+	return &s, err
+}
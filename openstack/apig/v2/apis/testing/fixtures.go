@@ -0,0 +1,132 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const (
+	instanceID = "eddc4d25480b4cd6b512f270a1b8b341"
+	groupID    = "c77f5e81d9cb4424bf704ef2b0ac7600"
+	apiID      = "5f918d104dc84480a75166ba99efff21"
+	envID      = "DEFAULT_ENVIRONMENT_RELEASE_ID"
+	publishID  = "9ac4d4ab9b6a4b8b8e2f9c1b3e04c1d4"
+)
+
+var expectedCreateRequest = fmt.Sprintf(`
+{
+  "group_id": "%s",
+  "name": "api_test",
+  "type": 1,
+  "req_protocol": "HTTPS",
+  "req_method": "GET",
+  "req_uri": "/test",
+  "auth_type": "APP",
+  "backend_type": "MOCK",
+  "mock_info": {
+    "result_content": "{\"message\": \"mock\"}"
+  }
+}`, groupID)
+
+var createResponse = fmt.Sprintf(`
+{
+  "id": "%s",
+  "name": "api_test",
+  "group_id": "%s",
+  "group_name": "group_test",
+  "type": 1,
+  "req_protocol": "HTTPS",
+  "req_method": "GET",
+  "req_uri": "/test",
+  "auth_type": "APP",
+  "match_mode": "NORMAL",
+  "backend_type": "MOCK",
+  "cors": false,
+  "status": 1,
+  "mock_info": {
+    "result_content": "{\"message\": \"mock\"}"
+  },
+  "register_time": "2021-10-08T06:49:01.3619527Z",
+  "update_time": "2021-10-08T06:49:01.3619527Z"
+}`, apiID, groupID)
+
+var expectedPublishRequest = fmt.Sprintf(`
+{
+  "action": "online",
+  "env_id": "%s",
+  "api_id": "%s"
+}`, envID, apiID)
+
+var publishResponse = fmt.Sprintf(`
+{
+  "publish_id": "%s",
+  "api_id": "%s",
+  "api_name": "api_test",
+  "env_id": "%s",
+  "publish_time": "2021-10-08T06:49:30.0146383Z",
+  "version_id": "3e6a8f0d3a5e4f1c8b6c1e2d9f7a0b4c"
+}`, publishID, apiID, envID)
+
+var expectedBatchPublishRequest = fmt.Sprintf(`
+{
+  "env_id": "%s",
+  "apis": ["%s"]
+}`, envID, apiID)
+
+var batchPublishResponse = fmt.Sprintf(`
+{
+  "success": [
+    {
+      "publish_id": "%s",
+      "api_id": "%s",
+      "api_name": "api_test",
+      "env_id": "%s"
+    }
+  ],
+  "failure": []
+}`, publishID, apiID, envID)
+
+// HandleCreateSuccessfully creates an HTTP handler at `/apigw/instances/{instance_id}/apis` on the
+// test handler mux that responds to a POST request with createResponse.
+func HandleCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/apigw/instances/%s/apis", instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, expectedCreateRequest)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprint(w, createResponse)
+	})
+}
+
+// HandlePublishSuccessfully creates an HTTP handler at `/apigw/instances/{instance_id}/apis/action`
+// on the test handler mux that responds to a POST request with publishResponse.
+func HandlePublishSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/apigw/instances/%s/apis/action", instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, expectedPublishRequest)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprint(w, publishResponse)
+	})
+}
+
+// HandleBatchPublishSuccessfully creates an HTTP handler at
+// `/apigw/instances/{instance_id}/apis/publish` on the test handler mux that responds to a POST
+// request with batchPublishResponse.
+func HandleBatchPublishSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/apigw/instances/%s/apis/publish", instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"action": "online"})
+		th.TestJSONRequest(t, r, expectedBatchPublishRequest)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, batchPublishResponse)
+	})
+}
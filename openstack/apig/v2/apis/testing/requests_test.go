@@ -0,0 +1,64 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/apig/v2/apis"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSuccessfully(t)
+
+	opts := apis.APIOpts{
+		GroupID:     groupID,
+		Name:        "api_test",
+		Type:        apis.TypePublic,
+		ReqProtocol: "HTTPS",
+		ReqMethod:   "GET",
+		ReqURI:      "/test",
+		AuthType:    apis.AuthApp,
+		BackendType: apis.BackendMock,
+		MockInfo: &apis.MockInfo{
+			ResultContent: `{"message": "mock"}`,
+		},
+	}
+	api, err := apis.Create(fake.ServiceClient(), instanceID, opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, apiID, api.ID)
+	th.AssertEquals(t, "group_test", api.GroupName)
+	th.AssertEquals(t, `{"message": "mock"}`, api.MockInfo.ResultContent)
+}
+
+func TestPublish(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandlePublishSuccessfully(t)
+
+	publication, err := apis.Publish(fake.ServiceClient(), instanceID, apis.PublishOpts{
+		Action: apis.ActionOnline,
+		EnvID:  envID,
+		ApiID:  apiID,
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, publishID, publication.PublishID)
+}
+
+func TestBatchPublish(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleBatchPublishSuccessfully(t)
+
+	publication, err := apis.BatchPublish(fake.ServiceClient(), instanceID, apis.BatchPublishOpts{
+		Action: apis.ActionOnline,
+		EnvID:  envID,
+		ApiIDs: []string{apiID},
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(publication.Success))
+	th.AssertEquals(t, 0, len(publication.Failure))
+	th.AssertEquals(t, publishID, publication.Success[0].PublishID)
+}
@@ -0,0 +1,19 @@
+package apis
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+func rootURL(client *golangsdk.ServiceClient, instanceID string) string {
+	return client.ServiceURL("apigw", "instances", instanceID, "apis")
+}
+
+func resourceURL(client *golangsdk.ServiceClient, instanceID, id string) string {
+	return client.ServiceURL("apigw", "instances", instanceID, "apis", id)
+}
+
+func actionURL(client *golangsdk.ServiceClient, instanceID string) string {
+	return client.ServiceURL("apigw", "instances", instanceID, "apis", "action")
+}
+
+func publishURL(client *golangsdk.ServiceClient, instanceID string) string {
+	return client.ServiceURL("apigw", "instances", instanceID, "apis", "publish")
+}
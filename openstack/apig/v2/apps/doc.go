@@ -0,0 +1,22 @@
+/*
+Package apps manages the apps of APIG dedicated gateways and their authorization to call APIs.
+
+Example to Create an App and Authorize It
+
+	app, err := apps.Create(apigClient, instanceID, apps.AppOpts{
+		Name: "my_app",
+	}).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	auths, err := apps.Authorize(apigClient, instanceID, apps.AuthorizeOpts{
+		EnvID:  environments.DefaultEnvironmentID,
+		AppIDs: []string{app.ID},
+		ApiIDs: []string{api.ID},
+	}).Extract()
+	if err != nil {
+		panic(err)
+	}
+*/
+package apps
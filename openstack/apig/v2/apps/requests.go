@@ -0,0 +1,156 @@
+package apps
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// AppOptsBuilder allows extensions to add additional parameters to the Create and Update requests.
+type AppOptsBuilder interface {
+	ToAppMap() (map[string]interface{}, error)
+}
+
+// AppOpts contains the options to create or update an app.
+type AppOpts struct {
+	Name        string  `json:"name" required:"true"`
+	Description *string `json:"remark,omitempty"`
+	// AppKey is generated if it's empty.
+	AppKey string `json:"app_key,omitempty"`
+	// AppSecret is generated if it's empty.
+	AppSecret string `json:"app_secret,omitempty"`
+}
+
+// ToAppMap builds a request body from AppOpts.
+func (opts AppOpts) ToAppMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Create creates an app, the identity used to call APIs with APP authentication.
+func Create(client *golangsdk.ServiceClient, instanceID string, opts AppOptsBuilder) (r CreateResult) {
+	b, err := opts.ToAppMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(rootURL(client, instanceID), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+// Get retrieves details of an app.
+func Get(client *golangsdk.ServiceClient, instanceID, id string) (r GetResult) {
+	_, r.Err = client.Get(resourceURL(client, instanceID, id), &r.Body, nil)
+	return
+}
+
+// ListOpts allows to filter the listed apps.
+type ListOpts struct {
+	ID     string `q:"id"`
+	Name   string `q:"name"`
+	AppKey string `q:"app_key"`
+	Offset int    `q:"offset"`
+	Limit  int    `q:"limit"`
+}
+
+// List retrieves the apps of the gateway.
+func List(client *golangsdk.ServiceClient, instanceID string, opts ListOpts) (r ListResult) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Get(rootURL(client, instanceID)+q.String(), &r.Body, nil)
+	return
+}
+
+// Update updates an app.
+func Update(client *golangsdk.ServiceClient, instanceID, id string, opts AppOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToAppMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(resourceURL(client, instanceID, id), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// ResetSecret generates a new secret of an app, or sets the given one.
+func ResetSecret(client *golangsdk.ServiceClient, instanceID, id, secret string) (r UpdateResult) {
+	b := map[string]interface{}{}
+	if secret != "" {
+		b["app_secret"] = secret
+	}
+	_, r.Err = client.Put(resetSecretURL(client, instanceID, id), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete deletes an app.
+func Delete(client *golangsdk.ServiceClient, instanceID, id string) (r DeleteResult) {
+	_, r.Err = client.Delete(resourceURL(client, instanceID, id), &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// AuthorizeOptsBuilder allows extensions to add additional parameters to the Authorize request.
+type AuthorizeOptsBuilder interface {
+	ToAuthorizeMap() (map[string]interface{}, error)
+}
+
+// AuthorizeOpts contains the options to authorize apps to call APIs.
+type AuthorizeOpts struct {
+	// EnvID is the environment the APIs are published in.
+	EnvID  string   `json:"env_id" required:"true"`
+	AppIDs []string `json:"app_ids" required:"true"`
+	ApiIDs []string `json:"api_ids" required:"true"`
+}
+
+// ToAuthorizeMap builds a request body from AuthorizeOpts.
+func (opts AuthorizeOpts) ToAuthorizeMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Authorize authorizes apps to call APIs published in an environment.
+func Authorize(client *golangsdk.ServiceClient, instanceID string, opts AuthorizeOptsBuilder) (r AuthorizeResult) {
+	b, err := opts.ToAuthorizeMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(authsURL(client, instanceID), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+// Unauthorize revokes an app authorization.
+func Unauthorize(client *golangsdk.ServiceClient, instanceID, authID string) (r DeleteResult) {
+	_, r.Err = client.Delete(authURL(client, instanceID, authID), &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// ListAuthorizedAPIsOpts allows to filter the APIs an app is authorized to call.
+type ListAuthorizedAPIsOpts struct {
+	AppID   string `q:"app_id,required"`
+	EnvID   string `q:"env_id"`
+	GroupID string `q:"group_id"`
+	Offset  int    `q:"offset"`
+	Limit   int    `q:"limit"`
+}
+
+// ListAuthorizedAPIs retrieves the APIs an app is authorized to call.
+func ListAuthorizedAPIs(client *golangsdk.ServiceClient, instanceID string, opts ListAuthorizedAPIsOpts) (r AuthorizedAPIsResult) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Get(authorizedAPIsURL(client, instanceID)+q.String(), &r.Body, nil)
+	return
+}
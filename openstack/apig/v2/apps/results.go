@@ -0,0 +1,118 @@
+package apps
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// App represents an app calling APIs with APP authentication.
+type App struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	Status       int    `json:"status"`
+	AppKey       string `json:"app_key"`
+	AppSecret    string `json:"app_secret"`
+	Creator      string `json:"creator"`
+	AppType      string `json:"app_type"`
+	RegisterTime string `json:"register_time"`
+	UpdateTime   string `json:"update_time"`
+	Description  string `json:"remark"`
+}
+
+type commonResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as an App.
+func (r commonResult) Extract() (*App, error) {
+	var s App
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// CreateResult is the result of a Create request.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult is the result of a Get request.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult is the result of Update and ResetSecret requests.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult is the result of Delete and Unauthorize requests.
+// Call its ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	golangsdk.ErrResult
+}
+
+// ListResult is the result of a List request.
+type ListResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as a slice of App.
+func (r ListResult) Extract() ([]App, error) {
+	var s []App
+	err := r.ExtractIntoSlicePtr(&s, "apps")
+	return s, err
+}
+
+// Authorization is an authorization of an app to call an API.
+type Authorization struct {
+	ID         string `json:"id"`
+	ApiID      string `json:"api_id"`
+	AppID      string `json:"app_id"`
+	AuthTime   string `json:"auth_time"`
+	AuthResult struct {
+		Status    string `json:"status"`
+		ErrorMsg  string `json:"error_msg"`
+		ErrorCode string `json:"error_code"`
+		ApiName   string `json:"api_name"`
+		AppName   string `json:"app_name"`
+	} `json:"auth_result"`
+}
+
+// AuthorizeResult is the result of an Authorize request.
+type AuthorizeResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as a slice of Authorization.
+func (r AuthorizeResult) Extract() ([]Authorization, error) {
+	var s []Authorization
+	err := r.ExtractIntoSlicePtr(&s, "auths")
+	return s, err
+}
+
+// AuthorizedAPI is an API an app is authorized to call.
+type AuthorizedAPI struct {
+	ID        string `json:"id"`
+	ApiID     string `json:"api_id"`
+	ApiName   string `json:"api_name"`
+	GroupID   string `json:"group_id"`
+	GroupName string `json:"group_name"`
+	EnvID     string `json:"env_id"`
+	EnvName   string `json:"env_name"`
+	AppID     string `json:"app_id"`
+	AppName   string `json:"app_name"`
+	AuthTime  string `json:"auth_time"`
+	ReqURI    string `json:"req_uri"`
+	ReqMethod string `json:"req_method"`
+}
+
+// AuthorizedAPIsResult is the result of a ListAuthorizedAPIs request.
+type AuthorizedAPIsResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as a slice of AuthorizedAPI.
+func (r AuthorizedAPIsResult) Extract() ([]AuthorizedAPI, error) {
+	var s []AuthorizedAPI
+	err := r.ExtractIntoSlicePtr(&s, "auths")
+	return s, err
+}
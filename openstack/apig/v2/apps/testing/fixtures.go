@@ -0,0 +1,133 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const (
+	instanceID = "eddc4d25480b4cd6b512f270a1b8b341"
+	appID      = "9ed8b7c5a5a04e1a8cbd8f3d9a2a2f2c"
+	apiID      = "5f918d104dc84480a75166ba99efff21"
+	authID     = "a2e1b7f8c3d94c4b9b8b0a1f2d3e4c5b"
+	envID      = "DEFAULT_ENVIRONMENT_RELEASE_ID"
+)
+
+const expectedCreateRequest = `
+{
+  "name": "app_test",
+  "remark": "test app"
+}`
+
+var createResponse = fmt.Sprintf(`
+{
+  "id": "%s",
+  "name": "app_test",
+  "status": 1,
+  "app_key": "ac9a4c7ba5fb4d3d8f0e2e3b9a1c0a1f",
+  "app_secret": "5d3b****",
+  "creator": "USER",
+  "app_type": "apig",
+  "register_time": "2021-10-08T06:50:12.8794437Z",
+  "update_time": "2021-10-08T06:50:12.8794437Z",
+  "remark": "test app"
+}`, appID)
+
+var expectedAuthorizeRequest = fmt.Sprintf(`
+{
+  "env_id": "%s",
+  "app_ids": ["%s"],
+  "api_ids": ["%s"]
+}`, envID, appID, apiID)
+
+var authorizeResponse = fmt.Sprintf(`
+{
+  "auths": [
+    {
+      "id": "%s",
+      "api_id": "%s",
+      "app_id": "%s",
+      "auth_time": "2021-10-08T06:51:40.1187382Z",
+      "auth_result": {
+        "status": "SUCCESS",
+        "api_name": "api_test",
+        "app_name": "app_test"
+      }
+    }
+  ]
+}`, authID, apiID, appID)
+
+var authorizedAPIsResponse = fmt.Sprintf(`
+{
+  "total": 1,
+  "size": 1,
+  "auths": [
+    {
+      "id": "%s",
+      "api_id": "%s",
+      "api_name": "api_test",
+      "group_id": "c77f5e81d9cb4424bf704ef2b0ac7600",
+      "env_id": "%s",
+      "env_name": "RELEASE",
+      "app_id": "%s",
+      "app_name": "app_test",
+      "req_uri": "/test",
+      "req_method": "GET"
+    }
+  ]
+}`, authID, apiID, envID, appID)
+
+// HandleCreateSuccessfully creates an HTTP handler at `/apigw/instances/{instance_id}/apps` on the
+// test handler mux that responds to a POST request with createResponse.
+func HandleCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/apigw/instances/%s/apps", instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, expectedCreateRequest)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprint(w, createResponse)
+	})
+}
+
+// HandleAuthorizeSuccessfully creates an HTTP handler at `/apigw/instances/{instance_id}/app-auths`
+// on the test handler mux that responds to a POST request with authorizeResponse.
+func HandleAuthorizeSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/apigw/instances/%s/app-auths", instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, expectedAuthorizeRequest)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprint(w, authorizeResponse)
+	})
+}
+
+// HandleUnauthorizeSuccessfully creates an HTTP handler at
+// `/apigw/instances/{instance_id}/app-auths/{auth_id}` on the test handler mux that responds to a
+// DELETE request.
+func HandleUnauthorizeSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/apigw/instances/%s/app-auths/%s", instanceID, authID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandleListAuthorizedAPIsSuccessfully creates an HTTP handler at
+// `/apigw/instances/{instance_id}/app-auths/binded-apis` on the test handler mux that responds to a
+// GET request with authorizedAPIsResponse.
+func HandleListAuthorizedAPIsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/apigw/instances/%s/app-auths/binded-apis", instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"app_id": appID, "env_id": envID})
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, authorizedAPIsResponse)
+	})
+}
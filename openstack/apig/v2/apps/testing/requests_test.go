@@ -0,0 +1,65 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/apig/v2/apps"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSuccessfully(t)
+
+	description := "test app"
+	app, err := apps.Create(fake.ServiceClient(), instanceID, apps.AppOpts{
+		Name:        "app_test",
+		Description: &description,
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, appID, app.ID)
+	th.AssertEquals(t, "ac9a4c7ba5fb4d3d8f0e2e3b9a1c0a1f", app.AppKey)
+	th.AssertEquals(t, description, app.Description)
+}
+
+func TestAuthorize(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleAuthorizeSuccessfully(t)
+
+	opts := apps.AuthorizeOpts{
+		EnvID:  envID,
+		AppIDs: []string{appID},
+		ApiIDs: []string{apiID},
+	}
+	auths, err := apps.Authorize(fake.ServiceClient(), instanceID, opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(auths))
+	th.AssertEquals(t, authID, auths[0].ID)
+	th.AssertEquals(t, "SUCCESS", auths[0].AuthResult.Status)
+}
+
+func TestListAuthorizedAPIs(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListAuthorizedAPIsSuccessfully(t)
+
+	apis, err := apps.ListAuthorizedAPIs(fake.ServiceClient(), instanceID, apps.ListAuthorizedAPIsOpts{
+		AppID: appID,
+		EnvID: envID,
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(apis))
+	th.AssertEquals(t, apiID, apis[0].ApiID)
+	th.AssertEquals(t, "RELEASE", apis[0].EnvName)
+}
+
+func TestUnauthorize(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUnauthorizeSuccessfully(t)
+
+	th.AssertNoErr(t, apps.Unauthorize(fake.ServiceClient(), instanceID, authID).ExtractErr())
+}
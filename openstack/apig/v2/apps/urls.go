@@ -0,0 +1,27 @@
+package apps
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+func rootURL(client *golangsdk.ServiceClient, instanceID string) string {
+	return client.ServiceURL("apigw", "instances", instanceID, "apps")
+}
+
+func resourceURL(client *golangsdk.ServiceClient, instanceID, id string) string {
+	return client.ServiceURL("apigw", "instances", instanceID, "apps", id)
+}
+
+func resetSecretURL(client *golangsdk.ServiceClient, instanceID, id string) string {
+	return client.ServiceURL("apigw", "instances", instanceID, "apps", "secret", id)
+}
+
+func authsURL(client *golangsdk.ServiceClient, instanceID string) string {
+	return client.ServiceURL("apigw", "instances", instanceID, "app-auths")
+}
+
+func authURL(client *golangsdk.ServiceClient, instanceID, id string) string {
+	return client.ServiceURL("apigw", "instances", instanceID, "app-auths", id)
+}
+
+func authorizedAPIsURL(client *golangsdk.ServiceClient, instanceID string) string {
+	return client.ServiceURL("apigw", "instances", instanceID, "app-auths", "binded-apis")
+}
@@ -0,0 +1,13 @@
+/*
+Package environments manages the environments of APIG dedicated gateways.
+
+Example to Create an Environment
+
+	env, err := environments.Create(apigClient, instanceID, environments.EnvironmentOpts{
+		Name: "staging",
+	}).Extract()
+	if err != nil {
+		panic(err)
+	}
+*/
+package environments
@@ -0,0 +1,78 @@
+package environments
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// DefaultEnvironmentID is the ID of the built-in "RELEASE" environment.
+const DefaultEnvironmentID = "DEFAULT_ENVIRONMENT_RELEASE_ID"
+
+// EnvironmentOptsBuilder allows extensions to add additional parameters to the Create and Update requests.
+type EnvironmentOptsBuilder interface {
+	ToEnvironmentMap() (map[string]interface{}, error)
+}
+
+// EnvironmentOpts contains the options to create or update an environment.
+type EnvironmentOpts struct {
+	// Name of the environment.
+	Name string `json:"name" required:"true"`
+	// Description of the environment.
+	Description *string `json:"remark,omitempty"`
+}
+
+// ToEnvironmentMap builds a request body from EnvironmentOpts.
+func (opts EnvironmentOpts) ToEnvironmentMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Create creates an environment in the gateway.
+func Create(client *golangsdk.ServiceClient, instanceID string, opts EnvironmentOptsBuilder) (r CreateResult) {
+	b, err := opts.ToEnvironmentMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(rootURL(client, instanceID), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+// ListOpts allows to filter the listed environments.
+type ListOpts struct {
+	Name   string `q:"name"`
+	Offset int    `q:"offset"`
+	Limit  int    `q:"limit"`
+}
+
+// List retrieves the environments of the gateway.
+func List(client *golangsdk.ServiceClient, instanceID string, opts ListOpts) (r ListResult) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Get(rootURL(client, instanceID)+q.String(), &r.Body, nil)
+	return
+}
+
+// Update updates an environment.
+func Update(client *golangsdk.ServiceClient, instanceID, id string, opts EnvironmentOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToEnvironmentMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(resourceURL(client, instanceID, id), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete deletes an environment, no API may be published in it.
+func Delete(client *golangsdk.ServiceClient, instanceID, id string) (r DeleteResult) {
+	_, r.Err = client.Delete(resourceURL(client, instanceID, id), &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
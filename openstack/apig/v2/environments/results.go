@@ -0,0 +1,52 @@
+package environments
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// Environment represents an environment APIs are published in.
+type Environment struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	CreateTime  string `json:"create_time"`
+	Description string `json:"remark"`
+}
+
+type commonResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as an Environment.
+func (r commonResult) Extract() (*Environment, error) {
+	var s Environment
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// CreateResult is the result of a Create request.
+type CreateResult struct {
+	commonResult
+}
+
+// UpdateResult is the result of an Update request.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult is the result of a Delete request.
+// Call its ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	golangsdk.ErrResult
+}
+
+// ListResult is the result of a List request.
+type ListResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as a slice of Environment.
+func (r ListResult) Extract() ([]Environment, error) {
+	var s []Environment
+	err := r.ExtractIntoSlicePtr(&s, "envs")
+	return s, err
+}
@@ -0,0 +1,72 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const (
+	instanceID = "eddc4d25480b4cd6b512f270a1b8b341"
+	envID      = "7a1ad0c350844ee69479b47df9a881cb"
+)
+
+const expectedCreateRequest = `
+{
+  "name": "env_test"
+}`
+
+var envResponse = fmt.Sprintf(`
+{
+  "id": "%s",
+  "name": "env_test",
+  "create_time": "2021-10-08T06:48:11.0316431Z"
+}`, envID)
+
+var listResponse = fmt.Sprintf(`
+{
+  "total": 2,
+  "size": 2,
+  "envs": [
+    {
+      "id": "DEFAULT_ENVIRONMENT_RELEASE_ID",
+      "name": "RELEASE",
+      "remark": "Default environment"
+    },
+    %s
+  ]
+}`, envResponse)
+
+// HandleEnvironmentsSuccessfully creates an HTTP handler at `/apigw/instances/{instance_id}/envs`
+// on the test handler mux that responds to POST and GET requests.
+func HandleEnvironmentsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/apigw/instances/%s/envs", instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+		switch r.Method {
+		case "POST":
+			th.TestJSONRequest(t, r, expectedCreateRequest)
+			w.WriteHeader(http.StatusCreated)
+			_, _ = fmt.Fprint(w, envResponse)
+		case "GET":
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprint(w, listResponse)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+}
+
+// HandleDeleteSuccessfully creates an HTTP handler at
+// `/apigw/instances/{instance_id}/envs/{env_id}` on the test handler mux that responds to a DELETE
+// request.
+func HandleDeleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/apigw/instances/%s/envs/%s", instanceID, envID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,41 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/apig/v2/environments"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleEnvironmentsSuccessfully(t)
+
+	env, err := environments.Create(fake.ServiceClient(), instanceID, environments.EnvironmentOpts{
+		Name: "env_test",
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, envID, env.ID)
+}
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleEnvironmentsSuccessfully(t)
+
+	list, err := environments.List(fake.ServiceClient(), instanceID, environments.ListOpts{}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, len(list))
+	th.AssertEquals(t, environments.DefaultEnvironmentID, list[0].ID)
+	th.AssertEquals(t, "env_test", list[1].Name)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteSuccessfully(t)
+
+	th.AssertNoErr(t, environments.Delete(fake.ServiceClient(), instanceID, envID).ExtractErr())
+}
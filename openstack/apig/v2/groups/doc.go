@@ -0,0 +1,20 @@
+/*
+Package groups manages the API groups of APIG dedicated gateways.
+
+Example to Create an API Group
+
+	group, err := groups.Create(apigClient, instanceID, groups.GroupOpts{
+		Name: "my_group",
+	}).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete an API Group
+
+	err := groups.Delete(apigClient, instanceID, group.ID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package groups
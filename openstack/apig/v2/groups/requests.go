@@ -0,0 +1,82 @@
+package groups
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// GroupOptsBuilder allows extensions to add additional parameters to the Create and Update requests.
+type GroupOptsBuilder interface {
+	ToGroupMap() (map[string]interface{}, error)
+}
+
+// GroupOpts contains the options to create or update an API group.
+type GroupOpts struct {
+	// Name of the API group.
+	Name string `json:"name" required:"true"`
+	// Description of the API group.
+	Description *string `json:"remark,omitempty"`
+}
+
+// ToGroupMap builds a request body from GroupOpts.
+func (opts GroupOpts) ToGroupMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Create creates an API group in the gateway.
+func Create(client *golangsdk.ServiceClient, instanceID string, opts GroupOptsBuilder) (r CreateResult) {
+	b, err := opts.ToGroupMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(rootURL(client, instanceID), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+// Get retrieves details of an API group.
+func Get(client *golangsdk.ServiceClient, instanceID, id string) (r GetResult) {
+	_, r.Err = client.Get(resourceURL(client, instanceID, id), &r.Body, nil)
+	return
+}
+
+// ListOpts allows to filter the listed API groups.
+type ListOpts struct {
+	ID     string `q:"id"`
+	Name   string `q:"name"`
+	Offset int    `q:"offset"`
+	Limit  int    `q:"limit"`
+}
+
+// List retrieves the API groups of the gateway.
+func List(client *golangsdk.ServiceClient, instanceID string, opts ListOpts) (r ListResult) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Get(rootURL(client, instanceID)+q.String(), &r.Body, nil)
+	return
+}
+
+// Update updates an API group.
+func Update(client *golangsdk.ServiceClient, instanceID, id string, opts GroupOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToGroupMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(resourceURL(client, instanceID, id), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete deletes an API group, the group must not contain any published API.
+func Delete(client *golangsdk.ServiceClient, instanceID, id string) (r DeleteResult) {
+	_, r.Err = client.Delete(resourceURL(client, instanceID, id), &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
@@ -0,0 +1,79 @@
+package groups
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// UrlDomain is a custom domain bound to an API group.
+type UrlDomain struct {
+	ID                  string `json:"id"`
+	Domain              string `json:"domain"`
+	CnameStatus         int    `json:"cname_status"`
+	SslID               string `json:"ssl_id"`
+	SslName             string `json:"ssl_name"`
+	MinSslVersion       string `json:"min_ssl_version"`
+	VerifiedClientCert  bool   `json:"verified_client_certificate_enabled"`
+	IsHasTrustedRootCA  bool   `json:"is_has_trusted_root_ca"`
+	IngressHTTPPort     int    `json:"ingress_http_port"`
+	IngressHTTPSPort    int    `json:"ingress_https_port"`
+	SslCertificateNames string `json:"ssl_certificate_names"`
+}
+
+// Group represents an API group.
+type Group struct {
+	ID           string      `json:"id"`
+	Name         string      `json:"name"`
+	Status       int         `json:"status"`
+	SlDomain     string      `json:"sl_domain"`
+	SlDomains    []string    `json:"sl_domains"`
+	RegisterTime string      `json:"register_time"`
+	UpdateTime   string      `json:"update_time"`
+	OnSellStatus int         `json:"on_sell_status"`
+	UrlDomains   []UrlDomain `json:"url_domains"`
+	Description  string      `json:"remark"`
+	IsDefault    int         `json:"is_default"`
+}
+
+type commonResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as a Group.
+func (r commonResult) Extract() (*Group, error) {
+	var s Group
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// CreateResult is the result of a Create request.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult is the result of a Get request.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult is the result of an Update request.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult is the result of a Delete request.
+// Call its ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	golangsdk.ErrResult
+}
+
+// ListResult is the result of a List request.
+type ListResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as a slice of Group.
+func (r ListResult) Extract() ([]Group, error) {
+	var s []Group
+	err := r.ExtractIntoSlicePtr(&s, "groups")
+	return s, err
+}
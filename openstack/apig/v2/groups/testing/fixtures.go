@@ -0,0 +1,82 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const (
+	instanceID = "eddc4d25480b4cd6b512f270a1b8b341"
+	groupID    = "c77f5e81d9cb4424bf704ef2b0ac7600"
+)
+
+const expectedCreateRequest = `
+{
+  "name": "group_test",
+  "remark": "test group"
+}`
+
+var groupResponse = fmt.Sprintf(`
+{
+  "id": "%s",
+  "name": "group_test",
+  "status": 1,
+  "sl_domain": "c77f5e81d9cb4424bf704ef2b0ac7600.apic.eu-de.otc.t-systems.com",
+  "sl_domains": ["c77f5e81d9cb4424bf704ef2b0ac7600.apic.eu-de.otc.t-systems.com"],
+  "register_time": "2021-10-08T06:47:20.4376305Z",
+  "update_time": "2021-10-08T06:47:20.4376305Z",
+  "on_sell_status": 2,
+  "remark": "test group",
+  "is_default": 2
+}`, groupID)
+
+var listResponse = fmt.Sprintf(`
+{
+  "total": 1,
+  "size": 1,
+  "groups": [%s]
+}`, groupResponse)
+
+// HandleGroupsSuccessfully creates an HTTP handler at `/apigw/instances/{instance_id}/api-groups`
+// on the test handler mux that responds to POST and GET requests.
+func HandleGroupsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/apigw/instances/%s/api-groups", instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+		switch r.Method {
+		case "POST":
+			th.TestJSONRequest(t, r, expectedCreateRequest)
+			w.WriteHeader(http.StatusCreated)
+			_, _ = fmt.Fprint(w, groupResponse)
+		case "GET":
+			th.TestFormValues(t, r, map[string]string{"name": "group_test"})
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprint(w, listResponse)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+}
+
+// HandleGroupSuccessfully creates an HTTP handler at
+// `/apigw/instances/{instance_id}/api-groups/{group_id}` on the test handler mux that responds to
+// GET and DELETE requests.
+func HandleGroupSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/apigw/instances/%s/api-groups/%s", instanceID, groupID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		switch r.Method {
+		case "GET":
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprint(w, groupResponse)
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+}
@@ -0,0 +1,54 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/apig/v2/groups"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGroupsSuccessfully(t)
+
+	description := "test group"
+	group, err := groups.Create(fake.ServiceClient(), instanceID, groups.GroupOpts{
+		Name:        "group_test",
+		Description: &description,
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, groupID, group.ID)
+	th.AssertEquals(t, description, group.Description)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGroupSuccessfully(t)
+
+	group, err := groups.Get(fake.ServiceClient(), instanceID, groupID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "group_test", group.Name)
+	th.AssertEquals(t, 1, len(group.SlDomains))
+}
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGroupsSuccessfully(t)
+
+	list, err := groups.List(fake.ServiceClient(), instanceID, groups.ListOpts{Name: "group_test"}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(list))
+	th.AssertEquals(t, groupID, list[0].ID)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGroupSuccessfully(t)
+
+	th.AssertNoErr(t, groups.Delete(fake.ServiceClient(), instanceID, groupID).ExtractErr())
+}
@@ -0,0 +1,11 @@
+package groups
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+func rootURL(client *golangsdk.ServiceClient, instanceID string) string {
+	return client.ServiceURL("apigw", "instances", instanceID, "api-groups")
+}
+
+func resourceURL(client *golangsdk.ServiceClient, instanceID, id string) string {
+	return client.ServiceURL("apigw", "instances", instanceID, "api-groups", id)
+}
@@ -0,0 +1,30 @@
+/*
+Package instances manages APIG dedicated gateways.
+
+Example to Create a Dedicated Gateway
+
+	id, err := instances.Create(apigClient, instances.CreateOpts{
+		Name:             "my-gateway",
+		SpecID:           "BASIC",
+		VpcID:            vpcID,
+		SubnetID:         subnetID,
+		SecurityGroupID:  secGroupID,
+		AvailableZoneIDs: []string{"eu-de-01"},
+	}).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	err = instances.WaitForStatus(apigClient, id, instances.StatusRunning, 1800)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Dedicated Gateway
+
+	err := instances.Delete(apigClient, id).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package instances
@@ -0,0 +1,154 @@
+package instances
+
+import (
+	"fmt"
+
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// CreateOptsBuilder allows extensions to add additional parameters to the Create request.
+type CreateOptsBuilder interface {
+	ToInstanceCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts contains the options to create a dedicated gateway.
+type CreateOpts struct {
+	// Name of the gateway.
+	Name string `json:"instance_name" required:"true"`
+	// SpecID is the gateway edition, e.g. "BASIC", "PROFESSIONAL", "ENTERPRISE" or "PLATINUM".
+	SpecID string `json:"spec_id" required:"true"`
+	// VpcID is the ID of the VPC the gateway is deployed in.
+	VpcID string `json:"vpc_id" required:"true"`
+	// SubnetID is the ID of the subnet the gateway is deployed in.
+	SubnetID string `json:"subnet_id" required:"true"`
+	// SecurityGroupID is the ID of the security group of the gateway.
+	SecurityGroupID string `json:"security_group_id" required:"true"`
+	// AvailableZoneIDs are the availability zones the gateway is deployed in.
+	AvailableZoneIDs []string `json:"available_zone_ids" required:"true"`
+	Description      string   `json:"description,omitempty"`
+	// MaintainBegin is the start of the maintenance window, e.g. "22:00:00".
+	MaintainBegin string `json:"maintain_begin,omitempty"`
+	// MaintainEnd is the end of the maintenance window, 4 hours after MaintainBegin.
+	MaintainEnd string `json:"maintain_end,omitempty"`
+	// BandwidthSize is the outbound access bandwidth in Mbit/s, public outbound access is disabled if it's 0.
+	BandwidthSize int `json:"bandwidth_size,omitempty"`
+	// EipID is the ID of the EIP bound for public inbound access.
+	EipID               string `json:"eip_id,omitempty"`
+	EnterpriseProjectID string `json:"enterprise_project_id,omitempty"`
+}
+
+// ToInstanceCreateMap builds a create request body from CreateOpts.
+func (opts CreateOpts) ToInstanceCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Create creates a dedicated gateway. Creation is asynchronous,
+// use WaitForStatus to wait until the gateway is running.
+func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToInstanceCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(rootURL(client), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+// Get retrieves details of a dedicated gateway.
+func Get(client *golangsdk.ServiceClient, id string) (r GetResult) {
+	_, r.Err = client.Get(resourceURL(client, id), &r.Body, nil)
+	return
+}
+
+// ListOpts allows to filter the listed gateways.
+type ListOpts struct {
+	ID     string `q:"instance_id"`
+	Name   string `q:"instance_name"`
+	Status string `q:"status"`
+	Offset int    `q:"offset"`
+	Limit  int    `q:"limit"`
+}
+
+// List retrieves the dedicated gateways of the project.
+func List(client *golangsdk.ServiceClient, opts ListOpts) (r ListResult) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Get(rootURL(client)+q.String(), &r.Body, nil)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the Update request.
+type UpdateOptsBuilder interface {
+	ToInstanceUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts contains the options to update a dedicated gateway.
+type UpdateOpts struct {
+	Name            string  `json:"instance_name,omitempty"`
+	Description     *string `json:"description,omitempty"`
+	MaintainBegin   string  `json:"maintain_begin,omitempty"`
+	MaintainEnd     string  `json:"maintain_end,omitempty"`
+	SecurityGroupID string  `json:"security_group_id,omitempty"`
+}
+
+// ToInstanceUpdateMap builds an update request body from UpdateOpts.
+func (opts UpdateOpts) ToInstanceUpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Update updates a dedicated gateway.
+func Update(client *golangsdk.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToInstanceUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(resourceURL(client, id), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete deletes a dedicated gateway.
+func Delete(client *golangsdk.ServiceClient, id string) (r DeleteResult) {
+	_, r.Err = client.Delete(resourceURL(client, id), &golangsdk.RequestOpts{
+		OkCodes: []int{202, 204},
+	})
+	return
+}
+
+// WaitForStatus waits for the gateway to reach the given status, e.g. StatusRunning.
+func WaitForStatus(client *golangsdk.ServiceClient, id, status string, secs int) error {
+	return golangsdk.WaitFor(secs, func() (bool, error) {
+		instance, err := Get(client, id).Extract()
+		if err != nil {
+			return false, err
+		}
+		if instance.Status == status {
+			return true, nil
+		}
+		if instance.Status == StatusCreateFail {
+			return false, fmt.Errorf("gateway %s creation failed", id)
+		}
+		return false, nil
+	})
+}
+
+// WaitForDeleted waits for the gateway to be deleted.
+func WaitForDeleted(client *golangsdk.ServiceClient, id string, secs int) error {
+	return golangsdk.WaitFor(secs, func() (bool, error) {
+		_, err := Get(client, id).Extract()
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return true, nil
+			}
+			return false, err
+		}
+		return false, nil
+	})
+}
@@ -0,0 +1,90 @@
+package instances
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// Gateway statuses.
+const (
+	StatusCreating   = "Creating"
+	StatusRunning    = "Running"
+	StatusCreateFail = "CreateFail"
+	StatusDeleting   = "Deleting"
+)
+
+// Instance represents a dedicated gateway.
+type Instance struct {
+	ID                  string   `json:"id"`
+	Name                string   `json:"instance_name"`
+	Status              string   `json:"status"`
+	InstanceStatus      int      `json:"instance_status"`
+	Type                string   `json:"type"`
+	Spec                string   `json:"spec"`
+	Description         string   `json:"description"`
+	CreateTime          int64    `json:"create_time"`
+	EnterpriseProjectID string   `json:"enterprise_project_id"`
+	EipAddress          string   `json:"eip_address"`
+	VpcID               string   `json:"vpc_id"`
+	SubnetID            string   `json:"subnet_id"`
+	SecurityGroupID     string   `json:"security_group_id"`
+	MaintainBegin       string   `json:"maintain_begin"`
+	MaintainEnd         string   `json:"maintain_end"`
+	IngressIP           string   `json:"ingress_ip"`
+	EgressIP            string   `json:"nat_eip_address"`
+	BandwidthSize       int      `json:"bandwidth_size"`
+	AvailableZoneIDs    string   `json:"available_zone_ids"`
+	SupportedFeatures   []string `json:"supported_features"`
+}
+
+// CreateResult is the result of a Create request.
+type CreateResult struct {
+	golangsdk.Result
+}
+
+// Extract returns the ID of the created gateway.
+func (r CreateResult) Extract() (string, error) {
+	var s struct {
+		InstanceID string `json:"instance_id"`
+	}
+	err := r.ExtractInto(&s)
+	return s.InstanceID, err
+}
+
+type commonResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as an Instance.
+func (r commonResult) Extract() (*Instance, error) {
+	var s Instance
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// GetResult is the result of a Get request.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult is the result of an Update request.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult is the result of a Delete request.
+// Call its ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	golangsdk.ErrResult
+}
+
+// ListResult is the result of a List request.
+type ListResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as a slice of Instance.
+func (r ListResult) Extract() ([]Instance, error) {
+	var s []Instance
+	err := r.ExtractIntoSlicePtr(&s, "instances")
+	return s, err
+}
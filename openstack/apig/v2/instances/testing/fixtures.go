@@ -0,0 +1,105 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const instanceID = "eddc4d25480b4cd6b512f270a1b8b341"
+
+const expectedCreateRequest = `
+{
+  "instance_name": "apig-test",
+  "spec_id": "BASIC",
+  "vpc_id": "3305eb40-2707-4940-921c-9f335f84a2ca",
+  "subnet_id": "a938121c-8e4d-4a5c-9f22-4e4f4d8e0b1e",
+  "security_group_id": "36e3c9c9-7a9d-4b0a-b1f5-1b1a5c8d8c7e",
+  "available_zone_ids": ["eu-de-01"],
+  "maintain_begin": "22:00:00",
+  "maintain_end": "02:00:00"
+}`
+
+var createResponse = fmt.Sprintf(`
+{
+  "instance_id": "%s"
+}`, instanceID)
+
+var getResponse = fmt.Sprintf(`
+{
+  "id": "%s",
+  "instance_name": "apig-test",
+  "status": "Running",
+  "instance_status": 6,
+  "spec": "BASIC",
+  "vpc_id": "3305eb40-2707-4940-921c-9f335f84a2ca",
+  "subnet_id": "a938121c-8e4d-4a5c-9f22-4e4f4d8e0b1e",
+  "security_group_id": "36e3c9c9-7a9d-4b0a-b1f5-1b1a5c8d8c7e",
+  "maintain_begin": "22:00:00",
+  "maintain_end": "02:00:00",
+  "ingress_ip": "192.168.0.137",
+  "available_zone_ids": "[eu-de-01]",
+  "create_time": 1633667585000,
+  "supported_features": ["gateway_responses", "ratelimit"]
+}`, instanceID)
+
+var listResponse = fmt.Sprintf(`
+{
+  "total": 1,
+  "size": 1,
+  "instances": [%s]
+}`, getResponse)
+
+// HandleInstancesSuccessfully creates an HTTP handler at `/apigw/instances` on the test handler mux
+// that responds to POST and GET requests.
+func HandleInstancesSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/apigw/instances", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+		switch r.Method {
+		case "POST":
+			th.TestJSONRequest(t, r, expectedCreateRequest)
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = fmt.Fprint(w, createResponse)
+		case "GET":
+			th.TestFormValues(t, r, map[string]string{"instance_name": "apig-test"})
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprint(w, listResponse)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+}
+
+// HandleInstanceSuccessfully creates an HTTP handler at `/apigw/instances/{instance_id}` on the
+// test handler mux that responds to GET and DELETE requests.
+func HandleInstanceSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/apigw/instances/%s", instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		switch r.Method {
+		case "GET":
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprint(w, getResponse)
+		case "DELETE":
+			w.WriteHeader(http.StatusAccepted)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+}
+
+// HandleCreateFailed creates an HTTP handler at `/apigw/instances/{instance_id}` on the test
+// handler mux that responds to a GET request with an instance in `CreateFail` status.
+func HandleCreateFailed(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/apigw/instances/%s", instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, `{"id": "%s", "status": "CreateFail"}`, instanceID)
+	})
+}
@@ -0,0 +1,74 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/apig/v2/instances"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleInstancesSuccessfully(t)
+
+	opts := instances.CreateOpts{
+		Name:             "apig-test",
+		SpecID:           "BASIC",
+		VpcID:            "3305eb40-2707-4940-921c-9f335f84a2ca",
+		SubnetID:         "a938121c-8e4d-4a5c-9f22-4e4f4d8e0b1e",
+		SecurityGroupID:  "36e3c9c9-7a9d-4b0a-b1f5-1b1a5c8d8c7e",
+		AvailableZoneIDs: []string{"eu-de-01"},
+		MaintainBegin:    "22:00:00",
+		MaintainEnd:      "02:00:00",
+	}
+	id, err := instances.Create(fake.ServiceClient(), opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, instanceID, id)
+}
+
+func TestGetAndWait(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleInstanceSuccessfully(t)
+
+	instance, err := instances.Get(fake.ServiceClient(), instanceID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "apig-test", instance.Name)
+	th.AssertEquals(t, instances.StatusRunning, instance.Status)
+	th.AssertEquals(t, "192.168.0.137", instance.IngressIP)
+	th.AssertEquals(t, int64(1633667585000), instance.CreateTime)
+
+	th.AssertNoErr(t, instances.WaitForStatus(fake.ServiceClient(), instanceID, instances.StatusRunning, 10))
+}
+
+func TestWaitForStatusCreateFailed(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateFailed(t)
+
+	err := instances.WaitForStatus(fake.ServiceClient(), instanceID, instances.StatusRunning, 10)
+	if err == nil {
+		t.Fatal("expected an error for a failed gateway creation")
+	}
+}
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleInstancesSuccessfully(t)
+
+	list, err := instances.List(fake.ServiceClient(), instances.ListOpts{Name: "apig-test"}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(list))
+	th.AssertEquals(t, instanceID, list[0].ID)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleInstanceSuccessfully(t)
+
+	th.AssertNoErr(t, instances.Delete(fake.ServiceClient(), instanceID).ExtractErr())
+}
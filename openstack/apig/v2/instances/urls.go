@@ -0,0 +1,16 @@
+package instances
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+const (
+	rootPath     = "apigw"
+	instancePath = "instances"
+)
+
+func rootURL(client *golangsdk.ServiceClient) string {
+	return client.ServiceURL(rootPath, instancePath)
+}
+
+func resourceURL(client *golangsdk.ServiceClient, id string) string {
+	return client.ServiceURL(rootPath, instancePath, id)
+}
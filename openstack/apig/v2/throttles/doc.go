@@ -0,0 +1,24 @@
+/*
+Package throttles manages the throttling policies of APIG dedicated gateways.
+
+Example to Create a Throttling Policy and Bind It to a Published API
+
+	throttle, err := throttles.Create(apigClient, instanceID, throttles.ThrottleOpts{
+		Name:          "per_minute",
+		ApiCallLimits: 100,
+		TimeInterval:  1,
+		TimeUnit:      throttles.TimeUnitMinute,
+	}).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	_, err = throttles.Bind(apigClient, instanceID, throttles.BindOpts{
+		PolicyID:   throttle.ID,
+		PublishIDs: []string{publishID},
+	}).Extract()
+	if err != nil {
+		panic(err)
+	}
+*/
+package throttles
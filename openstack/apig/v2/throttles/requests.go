@@ -0,0 +1,169 @@
+package throttles
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// Time units of the throttling interval.
+const (
+	TimeUnitSecond = "SECOND"
+	TimeUnitMinute = "MINUTE"
+	TimeUnitHour   = "HOUR"
+	TimeUnitDay    = "DAY"
+)
+
+// Throttling policy types.
+const (
+	// TypeExclusive limits each bound API separately.
+	TypeExclusive = 1
+	// TypeShared limits all bound APIs together.
+	TypeShared = 2
+)
+
+// ThrottleOptsBuilder allows extensions to add additional parameters to the Create and Update requests.
+type ThrottleOptsBuilder interface {
+	ToThrottleMap() (map[string]interface{}, error)
+}
+
+// ThrottleOpts contains the options to create or update a throttling policy.
+type ThrottleOpts struct {
+	Name string `json:"name" required:"true"`
+	// ApiCallLimits is the maximum number of times an API can be called within the interval.
+	ApiCallLimits int `json:"api_call_limits" required:"true"`
+	// TimeInterval is the throttling interval length.
+	TimeInterval int `json:"time_interval" required:"true"`
+	// TimeUnit is one of the TimeUnit* constants.
+	TimeUnit string `json:"time_unit" required:"true"`
+	// AppCallLimits must not exceed ApiCallLimits.
+	AppCallLimits int `json:"app_call_limits,omitempty"`
+	// UserCallLimits must not exceed ApiCallLimits.
+	UserCallLimits int `json:"user_call_limits,omitempty"`
+	// IpCallLimits must not exceed ApiCallLimits.
+	IpCallLimits int `json:"ip_call_limits,omitempty"`
+	// Type is either TypeExclusive or TypeShared.
+	Type        int     `json:"type,omitempty"`
+	Description *string `json:"remark,omitempty"`
+}
+
+// ToThrottleMap builds a request body from ThrottleOpts.
+func (opts ThrottleOpts) ToThrottleMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Create creates a throttling policy.
+func Create(client *golangsdk.ServiceClient, instanceID string, opts ThrottleOptsBuilder) (r CreateResult) {
+	b, err := opts.ToThrottleMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(rootURL(client, instanceID), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+// Get retrieves details of a throttling policy.
+func Get(client *golangsdk.ServiceClient, instanceID, id string) (r GetResult) {
+	_, r.Err = client.Get(resourceURL(client, instanceID, id), &r.Body, nil)
+	return
+}
+
+// ListOpts allows to filter the listed throttling policies.
+type ListOpts struct {
+	ID     string `q:"id"`
+	Name   string `q:"name"`
+	Offset int    `q:"offset"`
+	Limit  int    `q:"limit"`
+}
+
+// List retrieves the throttling policies of the gateway.
+func List(client *golangsdk.ServiceClient, instanceID string, opts ListOpts) (r ListResult) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Get(rootURL(client, instanceID)+q.String(), &r.Body, nil)
+	return
+}
+
+// Update updates a throttling policy.
+func Update(client *golangsdk.ServiceClient, instanceID, id string, opts ThrottleOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToThrottleMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(resourceURL(client, instanceID, id), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete deletes a throttling policy.
+func Delete(client *golangsdk.ServiceClient, instanceID, id string) (r DeleteResult) {
+	_, r.Err = client.Delete(resourceURL(client, instanceID, id), &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// BindOptsBuilder allows extensions to add additional parameters to the Bind request.
+type BindOptsBuilder interface {
+	ToBindMap() (map[string]interface{}, error)
+}
+
+// BindOpts contains the options to bind a throttling policy to published APIs.
+type BindOpts struct {
+	// PolicyID is the ID of the throttling policy.
+	PolicyID string `json:"strategy_id" required:"true"`
+	// PublishIDs are the publication record IDs of the APIs.
+	PublishIDs []string `json:"publish_ids" required:"true"`
+}
+
+// ToBindMap builds a request body from BindOpts.
+func (opts BindOpts) ToBindMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Bind binds a throttling policy to published APIs.
+func Bind(client *golangsdk.ServiceClient, instanceID string, opts BindOptsBuilder) (r BindResult) {
+	b, err := opts.ToBindMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(bindingsURL(client, instanceID), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+// Unbind removes a throttling policy binding.
+func Unbind(client *golangsdk.ServiceClient, instanceID, bindingID string) (r DeleteResult) {
+	_, r.Err = client.Delete(bindingURL(client, instanceID, bindingID), &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// ListBoundAPIsOpts allows to filter the APIs bound to a throttling policy.
+type ListBoundAPIsOpts struct {
+	PolicyID string `q:"throttle_id,required"`
+	EnvID    string `q:"env_id"`
+	GroupID  string `q:"group_id"`
+	Offset   int    `q:"offset"`
+	Limit    int    `q:"limit"`
+}
+
+// ListBoundAPIs retrieves the APIs bound to a throttling policy.
+func ListBoundAPIs(client *golangsdk.ServiceClient, instanceID string, opts ListBoundAPIsOpts) (r BoundAPIsResult) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Get(boundAPIsURL(client, instanceID)+q.String(), &r.Body, nil)
+	return
+}
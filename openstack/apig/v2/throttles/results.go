@@ -0,0 +1,114 @@
+package throttles
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// Throttle represents a throttling policy.
+type Throttle struct {
+	ID                       string `json:"id"`
+	Name                     string `json:"name"`
+	ApiCallLimits            int    `json:"api_call_limits"`
+	AppCallLimits            int    `json:"app_call_limits"`
+	UserCallLimits           int    `json:"user_call_limits"`
+	IpCallLimits             int    `json:"ip_call_limits"`
+	TimeInterval             int    `json:"time_interval"`
+	TimeUnit                 string `json:"time_unit"`
+	Type                     int    `json:"type"`
+	BindNum                  int    `json:"bind_num"`
+	IsIncludeSpecialThrottle int    `json:"is_inclu_special_throttle"`
+	CreateTime               string `json:"create_time"`
+	Description              string `json:"remark"`
+}
+
+type commonResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as a Throttle.
+func (r commonResult) Extract() (*Throttle, error) {
+	var s Throttle
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// CreateResult is the result of a Create request.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult is the result of a Get request.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult is the result of an Update request.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult is the result of Delete and Unbind requests.
+// Call its ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	golangsdk.ErrResult
+}
+
+// ListResult is the result of a List request.
+type ListResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as a slice of Throttle.
+func (r ListResult) Extract() ([]Throttle, error) {
+	var s []Throttle
+	err := r.ExtractIntoSlicePtr(&s, "throttles")
+	return s, err
+}
+
+// Binding is a binding of a throttling policy to a published API.
+type Binding struct {
+	ID        string `json:"id"`
+	PolicyID  string `json:"strategy_id"`
+	PublishID string `json:"publish_id"`
+	Scope     int    `json:"scope"`
+	ApplyTime string `json:"apply_time"`
+}
+
+// BindResult is the result of a Bind request.
+type BindResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as a slice of Binding.
+func (r BindResult) Extract() ([]Binding, error) {
+	var s []Binding
+	err := r.ExtractIntoSlicePtr(&s, "throttle_applys")
+	return s, err
+}
+
+// BoundAPI is an API bound to a throttling policy.
+type BoundAPI struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	GroupID   string `json:"group_id"`
+	GroupName string `json:"group_name"`
+	EnvID     string `json:"run_env_id"`
+	EnvName   string `json:"run_env_name"`
+	PublishID string `json:"publish_id"`
+	BindingID string `json:"throttle_apply_id"`
+	ApplyTime string `json:"apply_time"`
+	ReqURI    string `json:"req_uri"`
+	ReqMethod string `json:"req_method"`
+}
+
+// BoundAPIsResult is the result of a ListBoundAPIs request.
+type BoundAPIsResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as a slice of BoundAPI.
+func (r BoundAPIsResult) Extract() ([]BoundAPI, error) {
+	var s []BoundAPI
+	err := r.ExtractIntoSlicePtr(&s, "apis")
+	return s, err
+}
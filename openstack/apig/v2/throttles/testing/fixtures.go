@@ -0,0 +1,128 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const (
+	instanceID = "eddc4d25480b4cd6b512f270a1b8b341"
+	throttleID = "3437448ad06f4e0c91a224183116e965"
+	publishID  = "9ac4d4ab9b6a4b8b8e2f9c1b3e04c1d4"
+	bindingID  = "507c6a9f8b4d4f0f9f2f53a6e0a8b0c1"
+)
+
+const expectedCreateRequest = `
+{
+  "name": "throttle_test",
+  "api_call_limits": 100,
+  "time_interval": 1,
+  "time_unit": "MINUTE",
+  "type": 1
+}`
+
+var createResponse = fmt.Sprintf(`
+{
+  "id": "%s",
+  "name": "throttle_test",
+  "api_call_limits": 100,
+  "time_interval": 1,
+  "time_unit": "MINUTE",
+  "type": 1,
+  "bind_num": 0,
+  "create_time": "2021-10-08T06:44:31.8796282Z"
+}`, throttleID)
+
+var expectedBindRequest = fmt.Sprintf(`
+{
+  "strategy_id": "%s",
+  "publish_ids": ["%s"]
+}`, throttleID, publishID)
+
+var bindResponse = fmt.Sprintf(`
+{
+  "throttle_applys": [
+    {
+      "id": "%s",
+      "strategy_id": "%s",
+      "publish_id": "%s",
+      "scope": 1,
+      "apply_time": "2021-10-08T06:45:02.3419716Z"
+    }
+  ]
+}`, bindingID, throttleID, publishID)
+
+var boundAPIsResponse = fmt.Sprintf(`
+{
+  "total": 1,
+  "size": 1,
+  "apis": [
+    {
+      "id": "5f918d104dc84480a75166ba99efff21",
+      "name": "api_test",
+      "group_id": "c77f5e81d9cb4424bf704ef2b0ac7600",
+      "run_env_id": "DEFAULT_ENVIRONMENT_RELEASE_ID",
+      "run_env_name": "RELEASE",
+      "publish_id": "%s",
+      "throttle_apply_id": "%s",
+      "req_uri": "/test",
+      "req_method": "GET"
+    }
+  ]
+}`, publishID, bindingID)
+
+// HandleCreateSuccessfully creates an HTTP handler at `/apigw/instances/{instance_id}/throttles` on
+// the test handler mux that responds to a POST request with createResponse.
+func HandleCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/apigw/instances/%s/throttles", instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, expectedCreateRequest)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprint(w, createResponse)
+	})
+}
+
+// HandleBindSuccessfully creates an HTTP handler at
+// `/apigw/instances/{instance_id}/throttle-bindings` on the test handler mux that responds to a
+// POST request with bindResponse.
+func HandleBindSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/apigw/instances/%s/throttle-bindings", instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, expectedBindRequest)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprint(w, bindResponse)
+	})
+}
+
+// HandleUnbindSuccessfully creates an HTTP handler at
+// `/apigw/instances/{instance_id}/throttle-bindings/{binding_id}` on the test handler mux that
+// responds to a DELETE request.
+func HandleUnbindSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/apigw/instances/%s/throttle-bindings/%s", instanceID, bindingID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandleListBoundAPIsSuccessfully creates an HTTP handler at
+// `/apigw/instances/{instance_id}/throttle-bindings/binded-apis` on the test handler mux that
+// responds to a GET request with boundAPIsResponse.
+func HandleListBoundAPIsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/apigw/instances/%s/throttle-bindings/binded-apis", instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"throttle_id": throttleID})
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, boundAPIsResponse)
+	})
+}
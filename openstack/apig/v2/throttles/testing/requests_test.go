@@ -0,0 +1,66 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/apig/v2/throttles"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSuccessfully(t)
+
+	opts := throttles.ThrottleOpts{
+		Name:          "throttle_test",
+		ApiCallLimits: 100,
+		TimeInterval:  1,
+		TimeUnit:      throttles.TimeUnitMinute,
+		Type:          throttles.TypeExclusive,
+	}
+	throttle, err := throttles.Create(fake.ServiceClient(), instanceID, opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, throttleID, throttle.ID)
+	th.AssertEquals(t, 100, throttle.ApiCallLimits)
+	th.AssertEquals(t, throttles.TimeUnitMinute, throttle.TimeUnit)
+}
+
+func TestBind(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleBindSuccessfully(t)
+
+	opts := throttles.BindOpts{
+		PolicyID:   throttleID,
+		PublishIDs: []string{publishID},
+	}
+	bindings, err := throttles.Bind(fake.ServiceClient(), instanceID, opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(bindings))
+	th.AssertEquals(t, bindingID, bindings[0].ID)
+	th.AssertEquals(t, publishID, bindings[0].PublishID)
+}
+
+func TestListBoundAPIs(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListBoundAPIsSuccessfully(t)
+
+	apis, err := throttles.ListBoundAPIs(fake.ServiceClient(), instanceID, throttles.ListBoundAPIsOpts{
+		PolicyID: throttleID,
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(apis))
+	th.AssertEquals(t, bindingID, apis[0].BindingID)
+	th.AssertEquals(t, "RELEASE", apis[0].EnvName)
+}
+
+func TestUnbind(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUnbindSuccessfully(t)
+
+	th.AssertNoErr(t, throttles.Unbind(fake.ServiceClient(), instanceID, bindingID).ExtractErr())
+}
@@ -0,0 +1,23 @@
+package throttles
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+func rootURL(client *golangsdk.ServiceClient, instanceID string) string {
+	return client.ServiceURL("apigw", "instances", instanceID, "throttles")
+}
+
+func resourceURL(client *golangsdk.ServiceClient, instanceID, id string) string {
+	return client.ServiceURL("apigw", "instances", instanceID, "throttles", id)
+}
+
+func bindingsURL(client *golangsdk.ServiceClient, instanceID string) string {
+	return client.ServiceURL("apigw", "instances", instanceID, "throttle-bindings")
+}
+
+func bindingURL(client *golangsdk.ServiceClient, instanceID, id string) string {
+	return client.ServiceURL("apigw", "instances", instanceID, "throttle-bindings", id)
+}
+
+func boundAPIsURL(client *golangsdk.ServiceClient, instanceID string) string {
+	return client.ServiceURL("apigw", "instances", instanceID, "throttle-bindings", "binded-apis")
+}
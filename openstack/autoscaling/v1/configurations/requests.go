@@ -18,6 +18,11 @@ type CreateOpts struct {
 }
 
 func (opts CreateOpts) ToConfigurationCreateMap() (map[string]interface{}, error) {
+	if opts.InstanceConfig.SSHKey == "" && opts.InstanceConfig.AdminPass == "" {
+		err := golangsdk.ErrMissingInput{}
+		err.Argument = "InstanceConfig.SSHKey/InstanceConfig.AdminPass"
+		return nil, err
+	}
 	b, err := golangsdk.BuildRequestBody(opts, "")
 	if err != nil {
 		return nil, err
@@ -40,19 +45,29 @@ func (opts CreateOpts) ToConfigurationCreateMap() (map[string]interface{}, error
 
 // InstanceConfigOpts is an inner struct of CreateOpts
 type InstanceConfigOpts struct {
-	ID          string            `json:"instance_id,omitempty"`
-	FlavorRef   string            `json:"flavorRef,omitempty"`
-	ImageRef    string            `json:"imageRef,omitempty"`
-	Disk        []DiskOpts        `json:"disk,omitempty"`
-	SSHKey      string            `json:"key_name" required:"true"`
+	// ID of the ECS to clone the configuration from, when set only SSHKey, AdminPass,
+	// UserData, Personality, PubicIp and Metadata are taken from the options
+	ID        string     `json:"instance_id,omitempty"`
+	FlavorRef string     `json:"flavorRef,omitempty"`
+	ImageRef  string     `json:"imageRef,omitempty"`
+	Disk      []DiskOpts `json:"disk,omitempty"`
+	// SSHKey is the name of the key pair used to log in, either SSHKey or AdminPass must be set
+	SSHKey string `json:"key_name,omitempty"`
+	// AdminPass is the initial password of the instance administrator
+	AdminPass string `json:"adminPass,omitempty"`
+	// Personality contains files injected into the instance, the content must be base64-encoded
 	Personality []PersonalityOpts `json:"personality,omitempty"`
 	PubicIp     *PublicIpOpts     `json:"public_ip,omitempty"`
 	// UserData contains configuration information or scripts to use upon launch.
 	// Create will base64-encode it for you, if it isn't already.
-	UserData       []byte                 `json:"-"`
-	Metadata       map[string]interface{} `json:"metadata,omitempty"`
-	SecurityGroups []SecurityGroupOpts    `json:"security_groups,omitempty"`
-	MarketType     string                 `json:"market_type,omitempty"`
+	UserData                  []byte                 `json:"-"`
+	Metadata                  map[string]interface{} `json:"metadata,omitempty"`
+	SecurityGroups            []SecurityGroupOpts    `json:"security_groups,omitempty"`
+	MarketType                string                 `json:"market_type,omitempty"`
+	ServerGroupID             string                 `json:"server_group_id,omitempty"`
+	Tenancy                   string                 `json:"tenancy,omitempty"`
+	DedicatedHostID           string                 `json:"dedicated_host_id,omitempty"`
+	MultiFlavorPriorityPolicy string                 `json:"multi_flavor_priority_policy,omitempty"`
 }
 
 // DiskOpts is an inner struct of InstanceConfigOpts
@@ -81,9 +96,14 @@ type EipOpts struct {
 }
 
 type BandwidthOpts struct {
-	Size         int    `json:"size" required:"true"`
-	ShareType    string `json:"share_type" required:"true"`
-	ChargingMode string `json:"charging_mode" required:"true"`
+	// Size is required for the dedicated (`PER`) bandwidth
+	Size int `json:"size,omitempty"`
+	// ShareType is either `PER` for dedicated or `WHOLE` for shared bandwidth
+	ShareType string `json:"share_type" required:"true"`
+	// ChargingMode is required for the dedicated (`PER`) bandwidth, e.g. `traffic` or `bandwidth`
+	ChargingMode string `json:"charging_mode,omitempty"`
+	// ID of the shared bandwidth, required for the shared (`WHOLE`) bandwidth
+	ID string `json:"id,omitempty"`
 }
 
 type SecurityGroupOpts struct {
@@ -117,6 +137,33 @@ func Delete(client *golangsdk.ServiceClient, id string) (r DeleteResult) {
 	return
 }
 
+// BatchDeleteOptsBuilder is an interface which can build the map parameter of BatchDelete function
+type BatchDeleteOptsBuilder interface {
+	ToConfigurationBatchDeleteMap() (map[string]interface{}, error)
+}
+
+// BatchDeleteOpts contains IDs of configurations to be deleted, configurations used by groups can't be deleted
+type BatchDeleteOpts struct {
+	IDs []string `json:"scaling_configuration_id" required:"true"`
+}
+
+func (opts BatchDeleteOpts) ToConfigurationBatchDeleteMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// BatchDelete is a method of deleting several configurations at once
+func BatchDelete(client *golangsdk.ServiceClient, opts BatchDeleteOptsBuilder) (r DeleteResult) {
+	b, err := opts.ToConfigurationBatchDeleteMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(batchDeleteURL(client), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
 type ListOptsBuilder interface {
 	ToConfigurationListQuery() (string, error)
 }
@@ -86,6 +86,7 @@ type Bandwidth struct {
 	Size         int    `json:"size"`
 	ShareType    string `json:"share_type"`
 	ChargingMode string `json:"charging_mode"`
+	ID           string `json:"id"`
 }
 
 type SecurityGroup struct {
@@ -118,6 +119,6 @@ func ExtractConfigurations(r pagination.Page) ([]Configuration, error) {
 
 func (r ConfigurationPage) Extract() ([]Configuration, error) {
 	var cs []Configuration
-	err := r.Result.ExtractIntoSlicePtr(&cs, "scaling_groups")
+	err := r.Result.ExtractIntoSlicePtr(&cs, "scaling_configurations")
 	return cs, err
 }
@@ -0,0 +1,88 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const configurationID = "f8327883-6a07-4497-9a61-68c03e8e72a2"
+
+const expectedCreateRequest = `
+{
+  "scaling_configuration_name": "as-config-test",
+  "instance_config": {
+    "flavorRef": "s2.large.2",
+    "imageRef": "e2d7f7f6-1b0d-4b47-9a4d-f0c2a3e5c9b1",
+    "disk": [
+      {
+        "size": 40,
+        "volume_type": "SATA",
+        "disk_type": "SYS"
+      }
+    ],
+    "key_name": "KeyPair-test",
+    "user_data": "IyEvYmluL2Jhc2g="
+  }
+}`
+
+const createResponse = `
+{
+  "scaling_configuration_id": "f8327883-6a07-4497-9a61-68c03e8e72a2"
+}`
+
+const listResponse = `
+{
+  "total_number": 1,
+  "start_number": 0,
+  "limit": 20,
+  "scaling_configurations": [
+    {
+      "scaling_configuration_id": "f8327883-6a07-4497-9a61-68c03e8e72a2",
+      "tenant": "ce061a2e6e8d4b9ba1a1f4a3b7e4c1d2",
+      "scaling_configuration_name": "as-config-test",
+      "instance_config": {
+        "flavorRef": "s2.large.2",
+        "imageRef": "e2d7f7f6-1b0d-4b47-9a4d-f0c2a3e5c9b1",
+        "disk": [
+          {
+            "size": 40,
+            "volume_type": "SATA",
+            "disk_type": "SYS"
+          }
+        ],
+        "key_name": "KeyPair-test"
+      },
+      "create_time": "2020-01-20T08:00:00Z"
+    }
+  ]
+}`
+
+// HandleCreateSuccessfully creates an HTTP handler at `/scaling_configuration` on the test
+// handler mux that responds to a POST request with createResponse.
+func HandleCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/scaling_configuration", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, expectedCreateRequest)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, createResponse)
+	})
+}
+
+// HandleListSuccessfully creates an HTTP handler at `/scaling_configuration` on the test
+// handler mux that responds to a GET request with listResponse.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/scaling_configuration", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"scaling_configuration_name": "as-config-test"})
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, listResponse)
+	})
+}
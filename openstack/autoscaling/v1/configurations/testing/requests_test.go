@@ -0,0 +1,74 @@
+package testing
+
+import (
+	"testing"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/autoscaling/v1/configurations"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSuccessfully(t)
+
+	opts := configurations.CreateOpts{
+		Name: "as-config-test",
+		InstanceConfig: configurations.InstanceConfigOpts{
+			FlavorRef: "s2.large.2",
+			ImageRef:  "e2d7f7f6-1b0d-4b47-9a4d-f0c2a3e5c9b1",
+			Disk: []configurations.DiskOpts{
+				{Size: 40, VolumeType: "SATA", DiskType: "SYS"},
+			},
+			SSHKey:   "KeyPair-test",
+			UserData: []byte("#!/bin/bash"),
+		},
+	}
+	id, err := configurations.Create(fake.ServiceClient(), opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, configurationID, id)
+}
+
+func TestCreateRequiresSSHKeyOrAdminPass(t *testing.T) {
+	opts := configurations.CreateOpts{
+		Name: "as-config-test",
+		InstanceConfig: configurations.InstanceConfigOpts{
+			FlavorRef: "s2.large.2",
+			ImageRef:  "e2d7f7f6-1b0d-4b47-9a4d-f0c2a3e5c9b1",
+		},
+	}
+	_, err := opts.ToConfigurationCreateMap()
+	if _, ok := err.(golangsdk.ErrMissingInput); !ok {
+		t.Fatalf("expected ErrMissingInput, got %v", err)
+	}
+
+	opts.InstanceConfig.AdminPass = "Qwerty123!"
+	b, err := opts.ToConfigurationCreateMap()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "Qwerty123!", b["instance_config"].(map[string]interface{})["adminPass"])
+}
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	pages, err := configurations.List(fake.ServiceClient(), configurations.ListOpts{
+		Name: "as-config-test",
+	}).AllPages()
+	th.AssertNoErr(t, err)
+
+	configs, err := configurations.ExtractConfigurations(pages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(configs))
+	th.AssertEquals(t, configurationID, configs[0].ID)
+	th.AssertEquals(t, "as-config-test", configs[0].Name)
+	th.AssertEquals(t, "KeyPair-test", configs[0].InstanceConfig.SSHKey)
+	th.AssertEquals(t, 40, configs[0].InstanceConfig.Disk[0].Size)
+
+	extracted, err := pages.(configurations.ConfigurationPage).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, configs, extracted)
+}
@@ -21,3 +21,7 @@ func deleteURL(client *golangsdk.ServiceClient, id string) string {
 func listURL(client *golangsdk.ServiceClient) string {
 	return client.ServiceURL(resourcePath)
 }
+
+func batchDeleteURL(client *golangsdk.ServiceClient) string {
+	return client.ServiceURL("scaling_configurations")
+}
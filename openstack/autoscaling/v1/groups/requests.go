@@ -1,7 +1,10 @@
 package groups
 
 import (
+	"fmt"
+
 	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/autoscaling/v1/instances"
 	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
 )
 
@@ -36,6 +39,12 @@ type CreateOpts struct {
 }
 
 type NetworkOpts struct {
+	ID            string             `json:"id" required:"true"`
+	IPv6Enable    bool               `json:"ipv6_enable,omitempty"`
+	IPv6Bandwidth *IPv6BandwidthOpts `json:"ipv6_bandwidth,omitempty"`
+}
+
+type IPv6BandwidthOpts struct {
 	ID string `json:"id" required:"true"`
 }
 
@@ -72,6 +81,40 @@ func Delete(client *golangsdk.ServiceClient, id string) (r DeleteResult) {
 	return
 }
 
+// DeleteOptsBuilder is an interface which can build the query string of group deletion
+type DeleteOptsBuilder interface {
+	ToGroupDeleteQuery() (string, error)
+}
+
+type DeleteOpts struct {
+	// ForceDelete - `yes` deletes the group together with its instances even if
+	// the group is scaling, `no` is the default
+	ForceDelete string `q:"force_delete"`
+}
+
+func (opts DeleteOpts) ToGroupDeleteQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), err
+}
+
+// DeleteWithOpts is a method of deleting a group by group id with extra deletion options
+func DeleteWithOpts(client *golangsdk.ServiceClient, id string, opts DeleteOptsBuilder) (r DeleteResult) {
+	url := deleteURL(client, id)
+	if opts != nil {
+		q, err := opts.ToGroupDeleteQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += q
+	}
+	_, r.Err = client.Delete(url, nil)
+	return
+}
+
 // Get is a method of getting the detailed information of the group by id
 func Get(client *golangsdk.ServiceClient, id string) (r GetResult) {
 	_, r.Err = client.Get(getURL(client, id), &r.Body, nil)
@@ -163,6 +206,37 @@ func Update(client *golangsdk.ServiceClient, id string, opts UpdateOptsBuilder)
 	return
 }
 
+// CapacityOptsBuilder is an interface which can build the map parameter of UpdateCapacity function
+type CapacityOptsBuilder interface {
+	ToGroupCapacityMap() (map[string]interface{}, error)
+}
+
+// CapacityOpts contains the instance numbers of the group, only the set values are changed
+type CapacityOpts struct {
+	DesireInstanceNumber *int `json:"desire_instance_number,omitempty"`
+	MinInstanceNumber    *int `json:"min_instance_number,omitempty"`
+	MaxInstanceNumber    *int `json:"max_instance_number,omitempty"`
+}
+
+func (opts CapacityOpts) ToGroupCapacityMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// UpdateCapacity is a method which changes desired, minimum and maximum instance numbers
+// of the group without touching other group settings
+func UpdateCapacity(client *golangsdk.ServiceClient, id string, opts CapacityOptsBuilder) (r UpdateResult) {
+	body, err := opts.ToGroupCapacityMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Put(updateURL(client, id), body, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
 type ActionOptsBuilder interface {
 	ToActionMap() (map[string]interface{}, error)
 }
@@ -202,3 +276,163 @@ func Disable(client *golangsdk.ServiceClient, id string) (r ActionResult) {
 	}
 	return doAction(client, id, opts)
 }
+
+// RefreshOpts is a struct which represents parameters of the RefreshInstances function
+type RefreshOpts struct {
+	// Force - replace all instances of the group, not only the ones
+	// created from an outdated scaling configuration
+	Force bool
+	// BatchSize - number of instances replaced at once, all at once if not set
+	BatchSize int
+}
+
+// RefreshInstances replaces group instances, in batches of opts.BatchSize, so they are
+// recreated from the current scaling configuration of the group.
+//
+// Each batch is removed from the group and its ECS instances are deleted, which lowers the
+// desired instance number of the group. The desired instance number is then restored, so the
+// group creates new instances from its current configuration. Every scaling is waited for,
+// started and finished, for at most timeoutSeconds, before the next batch is replaced.
+// A batch can't take the group below its minimum instance number.
+// IDs of the replaced instances are returned.
+func RefreshInstances(client *golangsdk.ServiceClient, id string, opts RefreshOpts, timeoutSeconds int) ([]string, error) {
+	group, err := Get(client, id).Extract()
+	if err != nil {
+		return nil, err
+	}
+	groupInstances, err := listInServiceInstances(client, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for _, instance := range groupInstances {
+		if opts.Force || instance.ConfigurationID != group.ConfigurationID {
+			ids = append(ids, instance.ID)
+		}
+	}
+
+	desired := group.DesireInstanceNumber
+	batchSize := opts.BatchSize
+	if batchSize <= 0 {
+		batchSize = len(ids)
+	}
+	for start := 0; start < len(ids); start += batchSize {
+		end := start + batchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+
+		before, err := Get(client, id).Extract()
+		if err != nil {
+			return nil, err
+		}
+		if err := instances.BatchDelete(client, id, ids[start:end], "yes").ExtractErr(); err != nil {
+			return nil, err
+		}
+		if err := waitForScaling(client, id, before.ActualInstanceNumber, timeoutSeconds); err != nil {
+			return nil, err
+		}
+
+		removed, err := Get(client, id).Extract()
+		if err != nil {
+			return nil, err
+		}
+		err = UpdateCapacity(client, id, CapacityOpts{DesireInstanceNumber: &desired}).Err
+		if err != nil {
+			return nil, fmt.Errorf("error restoring desired instance number: %w", err)
+		}
+		if err := waitForScaling(client, id, removed.ActualInstanceNumber, timeoutSeconds); err != nil {
+			return nil, err
+		}
+	}
+	return ids, nil
+}
+
+// instanceListLimit is the maximum number of instances returned by a single instance list request
+const instanceListLimit = 100
+
+// listInServiceInstances lists all in-service instances of the group, page by page.
+func listInServiceInstances(client *golangsdk.ServiceClient, id string) ([]instances.Instance, error) {
+	var all []instances.Instance
+	for {
+		opts := instances.ListOpts{
+			LifeCycleStatus: "INSERVICE",
+			StartNumber:     len(all),
+			Limit:           instanceListLimit,
+		}
+		pages, err := instances.List(client, id, opts).AllPages()
+		if err != nil {
+			return nil, err
+		}
+		page, err := pages.(instances.InstancePage).Extract()
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, page...)
+		if len(page) < instanceListLimit {
+			return all, nil
+		}
+	}
+}
+
+// waitForScaling waits until the scaling of the group has started, i.e. the group is scaling or
+// its instance number changed from `before`, and then until the scaling is finished.
+func waitForScaling(client *golangsdk.ServiceClient, id string, before int, timeoutSeconds int) error {
+	err := golangsdk.WaitFor(timeoutSeconds, func() (bool, error) {
+		group, err := Get(client, id).Extract()
+		if err != nil {
+			return false, fmt.Errorf("error retrieving group status: %w", err)
+		}
+		return group.IsScaling || group.ActualInstanceNumber != before, nil
+	})
+	if err != nil {
+		return fmt.Errorf("error waiting for scaling to start: %w", err)
+	}
+	return WaitForScalingFinished(client, id, timeoutSeconds)
+}
+
+// WaitForStatus waits until the group reaches the given status, e.g. `INSERVICE` or `PAUSED`
+func WaitForStatus(client *golangsdk.ServiceClient, id, status string, timeoutSeconds int) error {
+	return golangsdk.WaitFor(timeoutSeconds, func() (bool, error) {
+		group, err := Get(client, id).Extract()
+		if err != nil {
+			return false, fmt.Errorf("error retrieving group status: %w", err)
+		}
+		if group.Status == status {
+			return true, nil
+		}
+		if group.Status == "ERROR" {
+			return false, fmt.Errorf("group is in error state: %s", group.Detail)
+		}
+		return false, nil
+	})
+}
+
+// WaitForScalingFinished waits until the group is not scaling and has the desired number of instances
+func WaitForScalingFinished(client *golangsdk.ServiceClient, id string, timeoutSeconds int) error {
+	return golangsdk.WaitFor(timeoutSeconds, func() (bool, error) {
+		group, err := Get(client, id).Extract()
+		if err != nil {
+			return false, fmt.Errorf("error retrieving group status: %w", err)
+		}
+		if !group.IsScaling && group.ActualInstanceNumber == group.DesireInstanceNumber {
+			return true, nil
+		}
+		return false, nil
+	})
+}
+
+// WaitForDeleted waits until the group is deleted
+func WaitForDeleted(client *golangsdk.ServiceClient, id string, timeoutSeconds int) error {
+	return golangsdk.WaitFor(timeoutSeconds, func() (bool, error) {
+		_, err := Get(client, id).Extract()
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return true, nil
+			}
+			return false, fmt.Errorf("error retrieving group status: %w", err)
+		}
+		return false, nil
+	})
+}
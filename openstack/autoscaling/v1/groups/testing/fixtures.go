@@ -0,0 +1,181 @@
+package testing
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const (
+	groupID         = "37e310f5-db9d-446e-9135-c625f9c2bbfc"
+	configurationID = "f8327883-6a04-4a0e-8f33-a9b1d1f4ffc6"
+	outdatedID      = "a2f2fd68-8b7d-4b72-9e5a-52e1a1a2b0c1"
+)
+
+var groupResponse = fmt.Sprintf(`
+{
+  "scaling_group": {
+    "scaling_group_id": "%s",
+    "scaling_group_name": "as-group",
+    "scaling_group_status": "%%s",
+    "scaling_configuration_id": "%s",
+    "current_instance_number": %%d,
+    "desire_instance_number": %%d,
+    "min_instance_number": 0,
+    "max_instance_number": 200,
+    "is_scaling": false,
+    "detail": "%%s"
+  }
+}`, groupID, configurationID)
+
+// HandleGetSuccessfully creates an HTTP handler at `/scaling_group/{id}` on the
+// test handler mux that responds with a group in the given status.
+func HandleGetSuccessfully(t *testing.T, status, detail string) {
+	th.Mux.HandleFunc(fmt.Sprintf("/scaling_group/%s", groupID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, groupResponse, status, 1, 1, detail)
+	})
+}
+
+// HandleDeleteSuccessfully creates an HTTP handler at `/scaling_group/{id}` on the
+// test handler mux that tests forced group deletion, the group is not found afterwards.
+func HandleDeleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/scaling_group/%s", groupID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		switch r.Method {
+		case http.MethodDelete:
+			th.TestFormValues(t, r, map[string]string{"force_delete": "yes"})
+			w.WriteHeader(http.StatusNoContent)
+		case http.MethodGet:
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+		}
+	})
+}
+
+// FakeGroup is a group of instances which scales immediately: removed instances
+// lower its desired instance number and capacity updates create new instances.
+type FakeGroup struct {
+	sync.Mutex
+	Instances []string
+	Outdated  map[string]bool
+	Desired   int
+	Removed   []string
+	// Restored are the desired instance numbers set by capacity updates
+	Restored []int
+}
+
+// HandleRefreshSuccessfully creates HTTP handlers for the group, its instance list and
+// instance actions on the test handler mux, backed by a FakeGroup of `count` instances,
+// every second of them created from an outdated configuration.
+func HandleRefreshSuccessfully(t *testing.T, count int) *FakeGroup {
+	group := &FakeGroup{Outdated: make(map[string]bool), Desired: count}
+	for i := 0; i < count; i++ {
+		id := fmt.Sprintf("instance-%03d", i)
+		group.Instances = append(group.Instances, id)
+		if i%2 == 1 {
+			group.Outdated[id] = true
+		}
+	}
+
+	th.Mux.HandleFunc(fmt.Sprintf("/scaling_group/%s", groupID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		group.Lock()
+		defer group.Unlock()
+
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPut:
+			var body struct {
+				Desired int `json:"desire_instance_number"`
+			}
+			th.AssertNoErr(t, json.NewDecoder(r.Body).Decode(&body))
+			group.Restored = append(group.Restored, body.Desired)
+			for len(group.Instances) < body.Desired {
+				group.Instances = append(group.Instances, fmt.Sprintf("new-instance-%03d", len(group.Instances)))
+			}
+			group.Desired = body.Desired
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+			return
+		}
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, groupResponse, "INSERVICE", len(group.Instances), group.Desired, "")
+	})
+
+	th.Mux.HandleFunc(fmt.Sprintf("/scaling_group_instance/%s/list", groupID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.AssertEquals(t, "INSERVICE", r.URL.Query().Get("life_cycle_state"))
+		start, _ := strconv.Atoi(r.URL.Query().Get("start_number"))
+		limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+		th.AssertNoErr(t, err)
+
+		group.Lock()
+		defer group.Unlock()
+		var page []map[string]string
+		for i := start; i < len(group.Instances) && i < start+limit; i++ {
+			id := group.Instances[i]
+			config := configurationID
+			if group.Outdated[id] {
+				config = outdatedID
+			}
+			page = append(page, map[string]string{
+				"instance_id":              id,
+				"life_cycle_state":         "INSERVICE",
+				"scaling_configuration_id": config,
+			})
+		}
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		th.AssertNoErr(t, json.NewEncoder(w).Encode(map[string]interface{}{
+			"total_number":            len(group.Instances),
+			"start_number":            start,
+			"limit":                   limit,
+			"scaling_group_instances": page,
+		}))
+	})
+
+	th.Mux.HandleFunc(fmt.Sprintf("/scaling_group_instance/%s/action", groupID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		var body struct {
+			Instances []string `json:"instances_id"`
+			DeleteEcs string   `json:"instance_delete"`
+			Action    string   `json:"action"`
+		}
+		th.AssertNoErr(t, json.NewDecoder(r.Body).Decode(&body))
+		th.AssertEquals(t, "REMOVE", body.Action)
+		th.AssertEquals(t, "yes", body.DeleteEcs)
+
+		group.Lock()
+		defer group.Unlock()
+		removed := make(map[string]bool)
+		for _, id := range body.Instances {
+			removed[id] = true
+		}
+		var remaining []string
+		for _, id := range group.Instances {
+			if !removed[id] {
+				remaining = append(remaining, id)
+			}
+		}
+		group.Instances = remaining
+		group.Desired = len(remaining)
+		group.Removed = append(group.Removed, body.Instances...)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	return group
+}
@@ -0,0 +1,71 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/autoscaling/v1/groups"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestWaitForStatus(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t, "INSERVICE", "")
+
+	th.AssertNoErr(t, groups.WaitForStatus(fake.ServiceClient(), groupID, "INSERVICE", 10))
+	th.AssertNoErr(t, groups.WaitForScalingFinished(fake.ServiceClient(), groupID, 10))
+}
+
+func TestWaitForStatusError(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t, "ERROR", "quota exceeded")
+
+	err := groups.WaitForStatus(fake.ServiceClient(), groupID, "INSERVICE", 10)
+	if err == nil {
+		t.Fatal("expected an error for the group in ERROR status")
+	}
+	th.AssertEquals(t, "group is in error state: quota exceeded", err.Error())
+}
+
+func TestDeleteWithOpts(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteSuccessfully(t)
+
+	err := groups.DeleteWithOpts(fake.ServiceClient(), groupID, groups.DeleteOpts{ForceDelete: "yes"}).ExtractErr()
+	th.AssertNoErr(t, err)
+	th.AssertNoErr(t, groups.WaitForDeleted(fake.ServiceClient(), groupID, 10))
+}
+
+func TestRefreshInstances(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	// more instances than returned by a single list request
+	group := HandleRefreshSuccessfully(t, 130)
+
+	ids, err := groups.RefreshInstances(fake.ServiceClient(), groupID, groups.RefreshOpts{}, 10)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 65, len(ids))
+	th.AssertEquals(t, "instance-001", ids[0])
+	th.AssertEquals(t, "instance-129", ids[64])
+	th.AssertDeepEquals(t, ids, group.Removed)
+	th.AssertDeepEquals(t, []int{130}, group.Restored)
+	th.AssertEquals(t, 130, len(group.Instances))
+}
+
+func TestRefreshInstancesInBatches(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	group := HandleRefreshSuccessfully(t, 4)
+
+	ids, err := groups.RefreshInstances(fake.ServiceClient(), groupID, groups.RefreshOpts{Force: true, BatchSize: 3}, 10)
+	th.AssertNoErr(t, err)
+
+	th.AssertDeepEquals(t, []string{"instance-000", "instance-001", "instance-002", "instance-003"}, ids)
+	th.AssertDeepEquals(t, ids, group.Removed)
+	// the desired instance number is restored after every batch
+	th.AssertDeepEquals(t, []int{4, 4}, group.Restored)
+}
@@ -12,8 +12,14 @@ type ListOptsBuilder interface {
 }
 
 type ListOpts struct {
+	// LifeCycleStatus is one of `INSERVICE`, `PENDING`, `REMOVING`, `PENDING_WAIT`,
+	// `REMOVING_WAIT`, `STANDBY` or `ENTERING_STANDBY`
 	LifeCycleStatus string `q:"life_cycle_state"`
 	HealthStatus    string `q:"health_status"`
+	// ProtectFromScalingDown is either `true` or `false`
+	ProtectFromScalingDown string `q:"protect_from_scaling_down"`
+	StartNumber            int    `q:"start_number"`
+	Limit                  int    `q:"limit"`
 }
 
 func (opts ListOpts) ToInstancesListQuery() (string, error) {
@@ -47,11 +53,19 @@ type DeleteOptsBuilder interface {
 }
 
 type DeleteOpts struct {
-	DeleteInstance bool `q:"instance_delete"`
+	// DeleteInstance - whether the instance is deleted after its removal from the group
+	DeleteInstance bool
 }
 
 func (opts DeleteOpts) ToInstanceDeleteQuery() (string, error) {
-	q, err := golangsdk.BuildQueryString(opts)
+	// the service expects `yes` or `no` instead of a boolean value
+	deleteOpts := struct {
+		DeleteInstance string `q:"instance_delete"`
+	}{DeleteInstance: "no"}
+	if opts.DeleteInstance {
+		deleteOpts.DeleteInstance = "yes"
+	}
+	q, err := golangsdk.BuildQueryString(deleteOpts)
 	if err != nil {
 		return "", err
 	}
@@ -83,6 +97,9 @@ type BatchOpts struct {
 	Instances   []string `json:"instances_id" required:"true"`
 	IsDeleteEcs string   `json:"instance_delete,omitempty"`
 	Action      string   `json:"action,omitempty"`
+	// InstanceAppend - whether a new instance is added to the group when an instance
+	// enters standby, either `yes` or `no`
+	InstanceAppend string `json:"instance_append,omitempty"`
 }
 
 func (opts BatchOpts) ToInstanceBatchMap() (map[string]interface{}, error) {
@@ -120,3 +137,41 @@ func BatchDelete(client *golangsdk.ServiceClient, groupID string, instances []st
 	}
 	return batch(client, groupID, opts)
 }
+
+// BatchProtect is a method by which can protect numbers of instances of a group from scale-in
+func BatchProtect(client *golangsdk.ServiceClient, groupID string, instances []string) (r BatchResult) {
+	var opts = BatchOpts{
+		Instances: instances,
+		Action:    "PROTECT",
+	}
+	return batch(client, groupID, opts)
+}
+
+// BatchUnprotect is a method by which can remove scale-in protection of numbers of instances of a group
+func BatchUnprotect(client *golangsdk.ServiceClient, groupID string, instances []string) (r BatchResult) {
+	var opts = BatchOpts{
+		Instances: instances,
+		Action:    "UNPROTECT",
+	}
+	return batch(client, groupID, opts)
+}
+
+// BatchEnterStandby is a method by which can move numbers of instances of a group to standby,
+// standby instances are kept in the group but don't serve the load balancer traffic
+func BatchEnterStandby(client *golangsdk.ServiceClient, groupID string, instances []string, instanceAppend string) (r BatchResult) {
+	var opts = BatchOpts{
+		Instances:      instances,
+		Action:         "ENTER_STANDBY",
+		InstanceAppend: instanceAppend,
+	}
+	return batch(client, groupID, opts)
+}
+
+// BatchExitStandby is a method by which can return numbers of standby instances of a group to service
+func BatchExitStandby(client *golangsdk.ServiceClient, groupID string, instances []string) (r BatchResult) {
+	var opts = BatchOpts{
+		Instances: instances,
+		Action:    "EXIT_STANDBY",
+	}
+	return batch(client, groupID, opts)
+}
@@ -16,6 +16,8 @@ type Instance struct {
 	ConfigurationName string `json:"scaling_configuration_name"`
 	ConfigurationID   string `json:"scaling_configuration_id"`
 	CreateTime        string `json:"create_time"`
+	// ProtectFromScalingDown shows whether the instance is protected from scale-in
+	ProtectFromScalingDown bool `json:"protect_from_scaling_down"`
 }
 
 // InstancePage is a struct which can do the page function
@@ -0,0 +1,158 @@
+package lifecyclehooks
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// CreateOptsBuilder is an interface by which can serialize the create parameters
+type CreateOptsBuilder interface {
+	ToLifecycleHookCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts is a struct which will be used to create a lifecycle hook
+type CreateOpts struct {
+	Name string `json:"lifecycle_hook_name" required:"true"`
+	// Type is either `INSTANCE_LAUNCHING` or `INSTANCE_TERMINATING`
+	Type string `json:"lifecycle_hook_type" required:"true"`
+	// DefaultResult is either `ABANDON` (default) or `CONTINUE`
+	DefaultResult string `json:"default_result,omitempty"`
+	// DefaultTimeout is the hook timeout in seconds, from 300 to 86400, 3600 by default
+	DefaultTimeout       int    `json:"default_timeout,omitempty"`
+	NotificationTopicURN string `json:"notification_topic_urn" required:"true"`
+	NotificationMetadata string `json:"notification_metadata,omitempty"`
+}
+
+func (opts CreateOpts) ToLifecycleHookCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Create is a method which can be able to create the lifecycle hook of the group
+func Create(client *golangsdk.ServiceClient, groupID string, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToLifecycleHookCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Post(createURL(client, groupID), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// List is a method which can be able to list all lifecycle hooks of the group
+func List(client *golangsdk.ServiceClient, groupID string) (r ListResult) {
+	_, r.Err = client.Get(listURL(client, groupID), &r.Body, nil)
+	return
+}
+
+// Get is a method which can be able to get the lifecycle hook detailed information
+func Get(client *golangsdk.ServiceClient, groupID, name string) (r GetResult) {
+	_, r.Err = client.Get(singleURL(client, groupID, name), &r.Body, nil)
+	return
+}
+
+// UpdateOptsBuilder is an interface which can build the map parameter of update function
+type UpdateOptsBuilder interface {
+	ToLifecycleHookUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts is a struct which represents the parameters of update function
+type UpdateOpts struct {
+	Type                 string `json:"lifecycle_hook_type,omitempty"`
+	DefaultResult        string `json:"default_result,omitempty"`
+	DefaultTimeout       int    `json:"default_timeout,omitempty"`
+	NotificationTopicURN string `json:"notification_topic_urn,omitempty"`
+	NotificationMetadata string `json:"notification_metadata,omitempty"`
+}
+
+func (opts UpdateOpts) ToLifecycleHookUpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Update is a method which can be able to update the lifecycle hook
+func Update(client *golangsdk.ServiceClient, groupID, name string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToLifecycleHookUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Put(singleURL(client, groupID, name), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete is a method which can be able to delete the lifecycle hook
+func Delete(client *golangsdk.ServiceClient, groupID, name string) (r DeleteResult) {
+	_, r.Err = client.Delete(singleURL(client, groupID, name), nil)
+	return
+}
+
+// CallbackOptsBuilder is an interface which can build the map parameter of Callback function
+type CallbackOptsBuilder interface {
+	ToLifecycleHookCallbackMap() (map[string]interface{}, error)
+}
+
+// CallbackOpts is a struct which represents the parameters of the instance hook callback.
+// The hanging instance is identified either by ActionKey or by InstanceID and HookName.
+type CallbackOpts struct {
+	ActionKey  string `json:"lifecycle_action_key,omitempty"`
+	InstanceID string `json:"instance_id,omitempty"`
+	HookName   string `json:"lifecycle_hook_name,omitempty"`
+	// ActionResult is one of `CONTINUE`, `ABANDON` or `EXTEND`,
+	// `EXTEND` prolongs the hook timeout by its default timeout
+	ActionResult string `json:"lifecycle_action_result" required:"true"`
+}
+
+func (opts CallbackOpts) ToLifecycleHookCallbackMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Callback is a method which can be able to finish or extend the instance lifecycle action
+func Callback(client *golangsdk.ServiceClient, groupID string, opts CallbackOptsBuilder) (r CallbackResult) {
+	b, err := opts.ToLifecycleHookCallbackMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Put(callbackURL(client, groupID), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// ListInstanceHooksOptsBuilder is an interface which can build the query string of ListInstanceHooks function
+type ListInstanceHooksOptsBuilder interface {
+	ToInstanceHookListQuery() (string, error)
+}
+
+// ListInstanceHooksOpts is a struct which represents the filters of ListInstanceHooks function
+type ListInstanceHooksOpts struct {
+	InstanceID string `q:"instance_id"`
+}
+
+func (opts ListInstanceHooksOpts) ToInstanceHookListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), err
+}
+
+// ListInstanceHooks is a method which can be able to list instances suspended by lifecycle hooks
+func ListInstanceHooks(client *golangsdk.ServiceClient, groupID string, opts ListInstanceHooksOptsBuilder) (r ListInstanceHooksResult) {
+	url := listInstanceHooksURL(client, groupID)
+	if opts != nil {
+		q, err := opts.ToInstanceHookListQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += q
+	}
+	_, r.Err = client.Get(url, &r.Body, nil)
+	return
+}
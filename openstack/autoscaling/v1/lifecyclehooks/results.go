@@ -0,0 +1,86 @@
+package lifecyclehooks
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// Hook is a struct that represents the lifecycle hook
+type Hook struct {
+	Name                  string `json:"lifecycle_hook_name"`
+	Type                  string `json:"lifecycle_hook_type"`
+	DefaultResult         string `json:"default_result"`
+	DefaultTimeout        int    `json:"default_timeout"`
+	NotificationTopicURN  string `json:"notification_topic_urn"`
+	NotificationTopicName string `json:"notification_topic_name"`
+	NotificationMetadata  string `json:"notification_metadata"`
+	CreateTime            string `json:"create_time"`
+}
+
+type commonResult struct {
+	golangsdk.Result
+}
+
+// Extract will deserialize the result to Hook
+func (r commonResult) Extract() (*Hook, error) {
+	var h Hook
+	err := r.ExtractInto(&h)
+	return &h, err
+}
+
+// CreateResult is a struct which represents the create result of lifecycle hook
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult is a struct which represents the get result of lifecycle hook
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult is a struct which represents the update result of lifecycle hook
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult is a struct which represents the delete result
+type DeleteResult struct {
+	golangsdk.ErrResult
+}
+
+// ListResult is a struct which represents the list result of lifecycle hooks
+type ListResult struct {
+	golangsdk.Result
+}
+
+func (r ListResult) Extract() ([]Hook, error) {
+	var s []Hook
+	err := r.ExtractIntoSlicePtr(&s, "lifecycle_hooks")
+	return s, err
+}
+
+// CallbackResult is a struct which represents the result of the instance hook callback
+type CallbackResult struct {
+	golangsdk.ErrResult
+}
+
+// InstanceHook is a struct that represents the instance suspended by the lifecycle hook
+type InstanceHook struct {
+	HookName      string `json:"lifecycle_hook_name"`
+	ActionKey     string `json:"lifecycle_action_key"`
+	InstanceID    string `json:"instance_id"`
+	GroupID       string `json:"scaling_group_id"`
+	HookStatus    string `json:"lifecycle_hook_status"`
+	Timeout       string `json:"timeout"`
+	DefaultResult string `json:"default_result"`
+}
+
+// ListInstanceHooksResult is a struct which represents the list result of instance hooks
+type ListInstanceHooksResult struct {
+	golangsdk.Result
+}
+
+func (r ListInstanceHooksResult) Extract() ([]InstanceHook, error) {
+	var s []InstanceHook
+	err := r.ExtractIntoSlicePtr(&s, "instance_hanging_info")
+	return s, err
+}
@@ -0,0 +1,34 @@
+package lifecyclehooks
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+const (
+	hookPath         = "scaling_lifecycle_hook"
+	instanceHookPath = "scaling_instance_hook"
+)
+
+// createURL will build the url of creation
+// its pattern is endpoint/scaling_lifecycle_hook/<group-id>
+func createURL(c *golangsdk.ServiceClient, groupID string) string {
+	return c.ServiceURL(hookPath, groupID)
+}
+
+func listURL(c *golangsdk.ServiceClient, groupID string) string {
+	return c.ServiceURL(hookPath, groupID, "list")
+}
+
+// singleURL will build the url of get, update and delete
+// its pattern is endpoint/scaling_lifecycle_hook/<group-id>/<hook-name>
+func singleURL(c *golangsdk.ServiceClient, groupID, name string) string {
+	return c.ServiceURL(hookPath, groupID, name)
+}
+
+func callbackURL(c *golangsdk.ServiceClient, groupID string) string {
+	return c.ServiceURL(instanceHookPath, groupID, "callback")
+}
+
+func listInstanceHooksURL(c *golangsdk.ServiceClient, groupID string) string {
+	return c.ServiceURL(instanceHookPath, groupID, "list")
+}
@@ -2,6 +2,7 @@ package policies
 
 import (
 	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
 )
 
 // CreateOptsBuilder is an interface by which can serialize the create parameters
@@ -29,8 +30,12 @@ type SchedulePolicyOpts struct {
 }
 
 type ActionOpts struct {
+	// Operation is one of `ADD`, `REMOVE` or `SET`
 	Operation   string `json:"operation,omitempty"`
 	InstanceNum int    `json:"instance_number,omitempty"`
+	// InstancePercentage is the percentage of the current instance number to operate on,
+	// can't be used together with InstanceNum
+	InstancePercentage int `json:"instance_percentage,omitempty"`
 }
 
 func (opts CreateOpts) ToPolicyCreateMap() (map[string]interface{}, error) {
@@ -97,3 +102,111 @@ func Get(client *golangsdk.ServiceClient, id string) (r GetResult) {
 	_, r.Err = client.Get(getURL(client, id), &r.Body, nil)
 	return
 }
+
+// ListOptsBuilder is an interface which can build the query string of list function
+type ListOptsBuilder interface {
+	ToPolicyListQuery() (string, error)
+}
+
+// ListOpts is a struct which represents the filters of list function
+type ListOpts struct {
+	Name        string `q:"scaling_policy_name"`
+	Type        string `q:"scaling_policy_type"`
+	ID          string `q:"scaling_policy_id"`
+	StartNumber int    `q:"start_number"`
+	Limit       int    `q:"limit"`
+}
+
+func (opts ListOpts) ToPolicyListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), err
+}
+
+// List is a method which can be able to list all policies of the autoscaling group
+func List(client *golangsdk.ServiceClient, groupID string, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client, groupID)
+	if opts != nil {
+		q, err := opts.ToPolicyListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += q
+	}
+
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return PolicyPage{pagination.SinglePageBase(r)}
+	})
+}
+
+func doAction(client *golangsdk.ServiceClient, id, action string) (r ActionResult) {
+	b := map[string]interface{}{
+		"action": action,
+	}
+	_, r.Err = client.Post(actionURL(client, id), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// Execute is an operation by which the policy is executed immediately
+func Execute(client *golangsdk.ServiceClient, id string) (r ActionResult) {
+	return doAction(client, id, "execute")
+}
+
+// Enable is an operation by which can make the policy enable service
+func Enable(client *golangsdk.ServiceClient, id string) (r ActionResult) {
+	return doAction(client, id, "resume")
+}
+
+// Disable is an operation by which can be able to pause the policy
+func Disable(client *golangsdk.ServiceClient, id string) (r ActionResult) {
+	return doAction(client, id, "pause")
+}
+
+// ListLogsOptsBuilder is an interface which can build the query string of ListLogs function
+type ListLogsOptsBuilder interface {
+	ToPolicyListLogsQuery() (string, error)
+}
+
+// ListLogsOpts is a struct which represents the filters of policy execution logs
+type ListLogsOpts struct {
+	LogID        string `q:"log_id"`
+	ResourceType string `q:"scaling_resource_type"`
+	ResourceID   string `q:"scaling_resource_id"`
+	// ExecuteType is one of `SCHEDULED`, `RECURRENCE`, `ALARM` or `MANUAL`
+	ExecuteType string `q:"execute_type"`
+	// StartTime and EndTime are in the format of `YYYY-MM-DDThh:mm:ssZ`
+	StartTime string `q:"start_time"`
+	EndTime   string `q:"end_time"`
+	// Status is one of `SUCCESS`, `FAIL` or `EXECUTING`
+	Status      string `q:"status"`
+	StartNumber int    `q:"start_number"`
+	Limit       int    `q:"limit"`
+}
+
+func (opts ListLogsOpts) ToPolicyListLogsQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), err
+}
+
+// ListLogs is a method which can be able to list the execution logs of the policy
+func ListLogs(client *golangsdk.ServiceClient, id string, opts ListLogsOptsBuilder) pagination.Pager {
+	url := listLogsURL(client, id)
+	if opts != nil {
+		q, err := opts.ToPolicyListLogsQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += q
+	}
+
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return LogPage{pagination.SinglePageBase(r)}
+	})
+}
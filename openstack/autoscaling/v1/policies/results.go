@@ -2,6 +2,7 @@ package policies
 
 import (
 	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
 )
 
 // Create Result is a struct which represents the create result of policy
@@ -26,6 +27,7 @@ type DeleteResult struct {
 // Policy is a struct that represents the result of get policy
 type Policy struct {
 	ID             string         `json:"scaling_group_id"`
+	PolicyID       string         `json:"scaling_policy_id"`
 	Name           string         `json:"scaling_policy_name"`
 	Status         string         `json:"policy_status"`
 	Type           string         `json:"scaling_policy_type"`
@@ -45,8 +47,9 @@ type SchedulePolicy struct {
 }
 
 type Action struct {
-	Operation   string `json:"operation"`
-	InstanceNum int    `json:"instance_number"`
+	Operation          string `json:"operation"`
+	InstanceNum        int    `json:"instance_number"`
+	InstancePercentage int    `json:"instance_percentage"`
 }
 
 // GetResult is a struct which represents the get result
@@ -73,3 +76,74 @@ func (r UpdateResult) Extract() (string, error) {
 	err := r.Result.ExtractInto(&a)
 	return a.ID, err
 }
+
+// ActionResult is a struct which represents the result of policy action
+type ActionResult struct {
+	golangsdk.ErrResult
+}
+
+// PolicyPage is a struct which can do the page function
+type PolicyPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty returns true if a PolicyPage contains no policies
+func (r PolicyPage) IsEmpty() (bool, error) {
+	policies, err := ExtractPolicies(r)
+	return len(policies) == 0, err
+}
+
+// ExtractPolicies returns a slice of AS Policies contained in a single page of results
+func ExtractPolicies(r pagination.Page) ([]Policy, error) {
+	var s []Policy
+	err := (r.(PolicyPage)).ExtractIntoSlicePtr(&s, "scaling_policies")
+	return s, err
+}
+
+// ExecutionLog is a struct that represents a single execution of the policy
+type ExecutionLog struct {
+	ID           string            `json:"id"`
+	Status       string            `json:"status"`
+	FailedReason string            `json:"failed_reason"`
+	ExecuteType  string            `json:"execute_type"`
+	ExecuteTime  string            `json:"execute_time"`
+	TenantID     string            `json:"tenant_id"`
+	PolicyID     string            `json:"scaling_policy_id"`
+	ResourceType string            `json:"scaling_resource_type"`
+	ResourceID   string            `json:"scaling_resource_id"`
+	OldValue     string            `json:"old_value"`
+	DesireValue  string            `json:"desire_value"`
+	LimitValue   string            `json:"limit_value"`
+	Type         string            `json:"type"`
+	JobRecords   []JobRecord       `json:"job_records"`
+	Metadata     map[string]string `json:"metadata"`
+}
+
+type JobRecord struct {
+	JobName    string `json:"job_name"`
+	RecordType string `json:"record_type"`
+	RecordTime string `json:"record_time"`
+	Request    string `json:"request"`
+	Response   string `json:"response"`
+	Code       string `json:"code"`
+	Message    string `json:"message"`
+	JobStatus  string `json:"job_status"`
+}
+
+// LogPage is a struct which can do the page function
+type LogPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty returns true if a LogPage contains no logs
+func (r LogPage) IsEmpty() (bool, error) {
+	logs, err := ExtractLogs(r)
+	return len(logs) == 0, err
+}
+
+// ExtractLogs returns a slice of policy execution logs contained in a single page of results
+func ExtractLogs(r pagination.Page) ([]ExecutionLog, error) {
+	var s []ExecutionLog
+	err := (r.(LogPage)).ExtractIntoSlicePtr(&s, "scaling_policy_execute_log")
+	return s, err
+}
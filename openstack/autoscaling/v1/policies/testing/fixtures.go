@@ -0,0 +1,58 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const policyID = "803ae4e4-7b4e-4d4b-b7b1-a7e1d5cf1e4b"
+
+var listLogsResponse = fmt.Sprintf(`
+{
+  "total_number": 1,
+  "start_number": 0,
+  "limit": 20,
+  "scaling_policy_execute_log": [
+    {
+      "id": "b4c3a8e2-0c44-4a3e-9c72-8f1b3c2d1e0f",
+      "status": "SUCCESS",
+      "failed_reason": "",
+      "execute_type": "MANUAL",
+      "execute_time": "2020-01-20T08:00:00Z",
+      "scaling_policy_id": "%s",
+      "scaling_resource_type": "SCALING_GROUP",
+      "scaling_resource_id": "e5d27f5c-dd76-4a61-b4bc-a67c5686719a",
+      "old_value": "0",
+      "desire_value": "1",
+      "limit_value": "10",
+      "type": "ADD",
+      "job_records": [
+        {
+          "job_name": "ADD",
+          "record_type": "API",
+          "record_time": "2020-01-20T08:00:01Z",
+          "job_status": "SUCCESS"
+        }
+      ],
+      "metadata": {}
+    }
+  ]
+}`, policyID)
+
+// HandleListLogsSuccessfully creates an HTTP handler at `/scaling_policy_execute_log/{id}` on the
+// test handler mux that responds with a single execution log.
+func HandleListLogsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/scaling_policy_execute_log/%s", policyID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"status": "SUCCESS", "limit": "20"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, listLogsResponse)
+	})
+}
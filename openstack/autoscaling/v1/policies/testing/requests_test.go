@@ -0,0 +1,26 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/autoscaling/v1/policies"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestListLogs(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListLogsSuccessfully(t)
+
+	pages, err := policies.ListLogs(fake.ServiceClient(), policyID, policies.ListLogsOpts{Status: "SUCCESS", Limit: 20}).AllPages()
+	th.AssertNoErr(t, err)
+	logs, err := policies.ExtractLogs(pages)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 1, len(logs))
+	th.AssertEquals(t, policyID, logs[0].PolicyID)
+	th.AssertEquals(t, "MANUAL", logs[0].ExecuteType)
+	th.AssertEquals(t, "1", logs[0].DesireValue)
+	th.AssertEquals(t, 1, len(logs[0].JobRecords))
+}
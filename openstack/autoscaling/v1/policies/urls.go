@@ -26,3 +26,17 @@ func getURL(client *golangsdk.ServiceClient, id string) string {
 func updateURL(c *golangsdk.ServiceClient, id string) string {
 	return c.ServiceURL(resourcePath, id)
 }
+
+// listURL will build the url of policies of the group
+// its pattern is endpoint/scaling_policy/<group-id>/list
+func listURL(c *golangsdk.ServiceClient, groupID string) string {
+	return c.ServiceURL(resourcePath, groupID, "list")
+}
+
+func actionURL(c *golangsdk.ServiceClient, id string) string {
+	return c.ServiceURL(resourcePath, id, "action")
+}
+
+func listLogsURL(c *golangsdk.ServiceClient, id string) string {
+	return c.ServiceURL("scaling_policy_execute_log", id)
+}
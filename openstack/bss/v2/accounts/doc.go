@@ -0,0 +1,29 @@
+/*
+Package accounts queries the account balances and the quotas of the resource
+packages of the customer.
+
+Example to Get the Account Balances
+
+	balances, err := accounts.GetBalances(bssClient).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Get the Remaining Quotas of the Resource Packages
+
+	packages, err := accounts.ListFreeResources(bssClient, accounts.FreeResourcesOpts{}).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	var ids []string
+	for _, p := range packages.Packages {
+		ids = append(ids, p.FreeResourceID)
+	}
+
+	usages, err := accounts.ListFreeResourceUsages(bssClient, ids).Extract()
+	if err != nil {
+		panic(err)
+	}
+*/
+package accounts
@@ -0,0 +1,53 @@
+package accounts
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// GetBalances retrieves the balances and the debt of the customer account.
+func GetBalances(client *golangsdk.ServiceClient) (r BalancesResult) {
+	_, r.Err = client.Get(balancesURL(client), &r.Body, nil)
+	return
+}
+
+// FreeResourcesOptsBuilder allows extensions to add additional parameters to the ListFreeResources request.
+type FreeResourcesOptsBuilder interface {
+	ToFreeResourcesMap() (map[string]interface{}, error)
+}
+
+// FreeResourcesOpts allows to filter the resource packages of the customer.
+type FreeResourcesOpts struct {
+	OrderID             string `json:"order_id,omitempty"`
+	ProductID           string `json:"product_id,omitempty"`
+	RegionCode          string `json:"region_code,omitempty"`
+	EnterpriseProjectID string `json:"enterprise_project_id,omitempty"`
+	Offset              int    `json:"offset,omitempty"`
+	Limit               int    `json:"limit,omitempty"`
+}
+
+// ToFreeResourcesMap builds a request body from FreeResourcesOpts.
+func (opts FreeResourcesOpts) ToFreeResourcesMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// ListFreeResources returns the resource packages, e.g. prepaid traffic or storage quotas, of the customer.
+func ListFreeResources(client *golangsdk.ServiceClient, opts FreeResourcesOptsBuilder) (r FreeResourcesResult) {
+	b, err := opts.ToFreeResourcesMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(freeResourcesURL(client), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// ListFreeResourceUsages returns the used and remaining quotas of the given resource packages.
+func ListFreeResourceUsages(client *golangsdk.ServiceClient, ids []string) (r FreeResourceUsagesResult) {
+	b := map[string]interface{}{"free_resource_ids": ids}
+	_, r.Err = client.Post(freeResourceUsagesURL(client), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
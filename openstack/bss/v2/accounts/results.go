@@ -0,0 +1,123 @@
+package accounts
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// Account types.
+const (
+	AccountTypeCash   = 1
+	AccountTypeCredit = 2
+	AccountTypeBonus  = 5
+)
+
+// AccountBalance is the balance of an account of the customer.
+type AccountBalance struct {
+	AccountID        string  `json:"account_id"`
+	AccountType      int     `json:"account_type"`
+	Amount           float64 `json:"amount"`
+	Currency         string  `json:"currency"`
+	DesignatedAmount float64 `json:"designated_amount"`
+	CreditAmount     float64 `json:"credit_amount"`
+	MeasureID        int     `json:"measure_id"`
+	Memo             string  `json:"memo"`
+}
+
+// Balances are the balances and the debt of the customer.
+type Balances struct {
+	AccountBalances []AccountBalance `json:"account_balances"`
+	DebtAmount      float64          `json:"debt_amount"`
+	MeasureID       int              `json:"measure_id"`
+	Currency        string           `json:"currency"`
+}
+
+// BalancesResult is the result of a GetBalances request.
+type BalancesResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as Balances.
+func (r BalancesResult) Extract() (*Balances, error) {
+	s := new(Balances)
+	err := r.ExtractInto(s)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// FreeResourceQuota is a quota of a resource package.
+type FreeResourceQuota struct {
+	FreeResourceID       string  `json:"free_resource_id"`
+	FreeResourceTypeName string  `json:"free_resource_type_name"`
+	QuotaReuseCycle      int     `json:"quota_reuse_cycle"`
+	QuotaReuseCycleType  int     `json:"quota_reuse_cycle_type"`
+	UsageType            string  `json:"usage_type"`
+	StartTime            string  `json:"start_time"`
+	EndTime              string  `json:"end_time"`
+	Amount               float64 `json:"amount"`
+	OriginalAmount       float64 `json:"original_amount"`
+	MeasureID            int     `json:"measure_id"`
+}
+
+// FreeResource is a resource package of the customer.
+type FreeResource struct {
+	FreeResourceID       string              `json:"free_resource_id"`
+	FreeResourceTypeName string              `json:"free_resource_type_name"`
+	CloudServiceTypeName string              `json:"cloud_service_type_name"`
+	ResourceTypeName     string              `json:"resource_type_name"`
+	ProductID            string              `json:"product_id"`
+	ProductName          string              `json:"product_name"`
+	OrderID              string              `json:"order_id"`
+	RegionCode           string              `json:"region_code"`
+	Status               int                 `json:"status"`
+	EffectiveTime        string              `json:"effective_time"`
+	ExpireTime           string              `json:"expire_time"`
+	Quotas               []FreeResourceQuota `json:"quota_infos"`
+}
+
+// FreeResources is the response of a ListFreeResources request.
+type FreeResources struct {
+	Packages   []FreeResource `json:"free_resource_packages"`
+	TotalCount int            `json:"total_count"`
+}
+
+// FreeResourcesResult is the result of a ListFreeResources request.
+type FreeResourcesResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as FreeResources.
+func (r FreeResourcesResult) Extract() (*FreeResources, error) {
+	s := new(FreeResources)
+	err := r.ExtractInto(s)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// FreeResourceUsage is the usage of a resource package quota.
+type FreeResourceUsage struct {
+	FreeResourceID       string  `json:"free_resource_id"`
+	FreeResourceTypeName string  `json:"free_resource_type_name"`
+	UsageType            string  `json:"usage_type"`
+	UsedAmount           float64 `json:"used_amount"`
+	RemainingAmount      float64 `json:"remaining_amount"`
+	OriginalAmount       float64 `json:"original_amount"`
+	MeasureID            int     `json:"measure_id"`
+	StartTime            string  `json:"start_time"`
+	EndTime              string  `json:"end_time"`
+}
+
+// FreeResourceUsagesResult is the result of a ListFreeResourceUsages request.
+type FreeResourceUsagesResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as a slice of FreeResourceUsage.
+func (r FreeResourceUsagesResult) Extract() ([]FreeResourceUsage, error) {
+	var s []FreeResourceUsage
+	err := r.ExtractIntoSlicePtr(&s, "free_resources")
+	return s, err
+}
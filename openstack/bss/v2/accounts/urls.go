@@ -0,0 +1,15 @@
+package accounts
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+func balancesURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("accounts", "customer-accounts", "balances")
+}
+
+func freeResourcesURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("payments", "free-resources", "query")
+}
+
+func freeResourceUsagesURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("payments", "free-resources", "usages", "details", "query")
+}
@@ -0,0 +1,29 @@
+/*
+Package bills queries the expenditures of the customer.
+
+Example to Get the Bill of a Month
+
+	sum, err := bills.GetMonthlySum(bssClient, bills.MonthlySumOpts{
+		BillCycle: "2021-06",
+	}).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to List the Pay-per-Use Usage Records of a Month
+
+	pages, err := bills.ListResourceRecords(bssClient, bills.ResourceRecordsOpts{
+		Cycle:      "2021-06",
+		ChargeMode: bills.ChargeModePayPerUse,
+		Limit:      100,
+	}).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	records, err := bills.ExtractResourceRecords(pages)
+	if err != nil {
+		panic(err)
+	}
+*/
+package bills
@@ -0,0 +1,93 @@
+package bills
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
+)
+
+// Charge modes.
+const (
+	ChargeModeYearlyMonthly = "1"
+	ChargeModePayPerUse     = "3"
+	ChargeModeReserved      = "10"
+)
+
+// ResourceRecordsOptsBuilder allows extensions to add additional parameters to the ListResourceRecords request.
+type ResourceRecordsOptsBuilder interface {
+	ToResourceRecordsQuery() (string, error)
+}
+
+// ResourceRecordsOpts allows to filter the resource usage records.
+type ResourceRecordsOpts struct {
+	// Cycle is the billing cycle in the "YYYY-MM" format.
+	Cycle string `q:"cycle,required"`
+	// CloudServiceType is e.g. "hws.service.type.ebs".
+	CloudServiceType string `q:"cloud_service_type"`
+	Region           string `q:"region"`
+	// ChargeMode is one of the ChargeMode* constants.
+	ChargeMode          string `q:"charge_mode"`
+	BillType            int    `q:"bill_type"`
+	ResourceID          string `q:"resource_id"`
+	EnterpriseProjectID string `q:"enterprise_project_id"`
+	IncludeZeroRecord   *bool  `q:"include_zero_record"`
+	// BillDateBegin and BillDateEnd are dates in the "YYYY-MM-DD" format within the cycle.
+	BillDateBegin string `q:"bill_date_begin"`
+	BillDateEnd   string `q:"bill_date_end"`
+	Offset        int    `q:"offset"`
+	Limit         int    `q:"limit"`
+}
+
+// ToResourceRecordsQuery formats a ResourceRecordsOpts into a query string.
+func (opts ResourceRecordsOpts) ToResourceRecordsQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), err
+}
+
+// ListResourceRecords returns a Pager over the usage records of the resources in a billing cycle.
+func ListResourceRecords(client *golangsdk.ServiceClient, opts ResourceRecordsOptsBuilder) pagination.Pager {
+	query, err := opts.ToResourceRecordsQuery()
+	if err != nil {
+		return pagination.Pager{Err: err}
+	}
+	return pagination.NewPager(client, resourceRecordsURL(client)+query, func(r pagination.PageResult) pagination.Page {
+		return ResourceRecordPage{OffsetPageBase: pagination.OffsetPageBase{PageResult: r}}
+	})
+}
+
+// MonthlySumOptsBuilder allows extensions to add additional parameters to the GetMonthlySum request.
+type MonthlySumOptsBuilder interface {
+	ToMonthlySumQuery() (string, error)
+}
+
+// MonthlySumOpts allows to filter the monthly bill.
+type MonthlySumOpts struct {
+	// BillCycle is the billing cycle in the "YYYY-MM" format.
+	BillCycle           string `q:"bill_cycle,required"`
+	ServiceTypeCode     string `q:"service_type_code"`
+	EnterpriseProjectID string `q:"enterprise_project_id"`
+	Offset              int    `q:"offset"`
+	Limit               int    `q:"limit"`
+}
+
+// ToMonthlySumQuery formats a MonthlySumOpts into a query string.
+func (opts MonthlySumOpts) ToMonthlySumQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), err
+}
+
+// GetMonthlySum retrieves the expenditure summary of a billing cycle.
+func GetMonthlySum(client *golangsdk.ServiceClient, opts MonthlySumOptsBuilder) (r MonthlySumResult) {
+	query, err := opts.ToMonthlySumQuery()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Get(monthlySumURL(client)+query, &r.Body, nil)
+	return
+}
@@ -0,0 +1,105 @@
+package bills
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
+)
+
+// ResourceRecord is a usage record of a resource.
+type ResourceRecord struct {
+	BillDate             string  `json:"bill_date"`
+	BillType             int     `json:"bill_type"`
+	CustomerID           string  `json:"customer_id"`
+	Region               string  `json:"region"`
+	RegionName           string  `json:"region_name"`
+	CloudServiceType     string  `json:"cloud_service_type"`
+	ResourceTypeCode     string  `json:"resource_type_code"`
+	CloudServiceTypeName string  `json:"cloud_service_type_name"`
+	ResourceTypeName     string  `json:"resource_type_name"`
+	ResourceID           string  `json:"resource_id"`
+	ResourceName         string  `json:"resource_name"`
+	ResourceTag          string  `json:"resource_tag"`
+	ProductSpecDesc      string  `json:"product_spec_desc"`
+	EnterpriseProjectID  string  `json:"enterprise_project_id"`
+	ChargeMode           string  `json:"charge_mode"`
+	OfficialAmount       float64 `json:"official_amount"`
+	DiscountAmount       float64 `json:"discount_amount"`
+	Amount               float64 `json:"amount"`
+	CashAmount           float64 `json:"cash_amount"`
+	CreditAmount         float64 `json:"credit_amount"`
+	CouponAmount         float64 `json:"coupon_amount"`
+	DebtAmount           float64 `json:"debt_amount"`
+	AdjustmentAmount     float64 `json:"adjustment_amount"`
+	MeasureID            int     `json:"measure_id"`
+	TradeID              string  `json:"trade_id"`
+	ID                   string  `json:"id"`
+}
+
+// ResourceRecordPage is a single page of resource usage records.
+type ResourceRecordPage struct {
+	pagination.OffsetPageBase
+}
+
+// IsEmpty checks whether a ResourceRecordPage is empty.
+func (p ResourceRecordPage) IsEmpty() (bool, error) {
+	records, err := ExtractResourceRecords(p)
+	return len(records) == 0, err
+}
+
+// ExtractResourceRecords interprets a page of results as a slice of ResourceRecord.
+func ExtractResourceRecords(p pagination.Page) ([]ResourceRecord, error) {
+	var s []ResourceRecord
+	err := p.(ResourceRecordPage).ExtractIntoSlicePtr(&s, "fee_records")
+	return s, err
+}
+
+// BillSum is the expenditure of a service in a billing cycle.
+type BillSum struct {
+	BillCycle           string  `json:"bill_cycle"`
+	BillType            int     `json:"bill_type"`
+	CustomerID          string  `json:"customer_id"`
+	ServiceTypeCode     string  `json:"service_type_code"`
+	ServiceTypeName     string  `json:"service_type_name"`
+	ResourceTypeCode    string  `json:"resource_type_code"`
+	ResourceTypeName    string  `json:"resource_type_name"`
+	ChargingMode        int     `json:"charging_mode"`
+	OfficialAmount      float64 `json:"official_amount"`
+	OfficialDiscount    float64 `json:"official_discount_amount"`
+	ConsumeAmount       float64 `json:"consume_amount"`
+	CashAmount          float64 `json:"cash_amount"`
+	CreditAmount        float64 `json:"credit_amount"`
+	CouponAmount        float64 `json:"coupon_amount"`
+	DebtAmount          float64 `json:"debt_amount"`
+	WriteoffAmount      float64 `json:"writeoff_amount"`
+	EnterpriseProjectID string  `json:"enterprise_project_id"`
+	MeasureID           int     `json:"measure_id"`
+}
+
+// MonthlySum is the expenditure summary of a billing cycle.
+type MonthlySum struct {
+	BillSums       []BillSum `json:"bill_sums"`
+	TotalCount     int       `json:"total_count"`
+	ConsumeAmount  float64   `json:"consume_amount"`
+	DebtAmount     float64   `json:"debt_amount"`
+	CouponAmount   float64   `json:"coupon_amount"`
+	CashAmount     float64   `json:"cash_amount"`
+	CreditAmount   float64   `json:"credit_amount"`
+	WriteoffAmount float64   `json:"writeoff_amount"`
+	MeasureID      int       `json:"measure_id"`
+	Currency       string    `json:"currency"`
+}
+
+// MonthlySumResult is the result of a GetMonthlySum request.
+type MonthlySumResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as a MonthlySum.
+func (r MonthlySumResult) Extract() (*MonthlySum, error) {
+	s := new(MonthlySum)
+	err := r.ExtractInto(s)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
@@ -0,0 +1,11 @@
+package bills
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+func resourceRecordsURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("bills", "customer-bills", "res-records")
+}
+
+func monthlySumURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("bills", "customer-bills", "monthly-sum")
+}
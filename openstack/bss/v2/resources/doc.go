@@ -0,0 +1,15 @@
+/*
+Package resources queries the billing details of the customer resources.
+
+Example to List the Billed Primary Resources
+
+	resp, err := resources.List(bssClient, resources.ListOpts{
+		OnlyMainResource: 1,
+		StatusList:       []int{resources.StatusNormal},
+		Limit:            100,
+	}).Extract()
+	if err != nil {
+		panic(err)
+	}
+*/
+package resources
@@ -0,0 +1,50 @@
+package resources
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// Resource statuses.
+const (
+	StatusNormal   = 1
+	StatusGrace    = 2
+	StatusFrozen   = 3
+	StatusChanging = 4
+	StatusClosed   = 5
+	StatusExpired  = 6
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the List request.
+type ListOptsBuilder interface {
+	ToResourceListMap() (map[string]interface{}, error)
+}
+
+// ListOpts allows to filter the resources of the customer.
+type ListOpts struct {
+	ResourceIDs []string `json:"resource_ids,omitempty"`
+	OrderID     string   `json:"order_id,omitempty"`
+	// OnlyMainResource returns only the primary resources, e.g. the servers without their volumes.
+	OnlyMainResource int `json:"only_main_resource,omitempty"`
+	// StatusList contains the Status* constants.
+	StatusList []int `json:"status_list,omitempty"`
+	Offset     int   `json:"offset,omitempty"`
+	Limit      int   `json:"limit,omitempty"`
+}
+
+// ToResourceListMap builds a request body from ListOpts.
+func (opts ListOpts) ToResourceListMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// List returns the resources of the customer with their billing details.
+func List(client *golangsdk.ServiceClient, opts ListOptsBuilder) (r ListResult) {
+	b, err := opts.ToResourceListMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(queryURL(client), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
@@ -0,0 +1,45 @@
+package resources
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// Resource is a billed resource.
+type Resource struct {
+	ID                   string `json:"id"`
+	ResourceID           string `json:"resource_id"`
+	ResourceName         string `json:"resource_name"`
+	RegionCode           string `json:"region_code"`
+	CloudServiceTypeCode string `json:"cloud_service_type_code"`
+	ResourceTypeCode     string `json:"resource_type_code"`
+	ResourceSpecCode     string `json:"resource_spec_code"`
+	ProjectCode          string `json:"project_code"`
+	ProductID            string `json:"product_id"`
+	MainResourceID       string `json:"main_resource_id"`
+	IsMainResource       int    `json:"is_main_resource"`
+	Status               int    `json:"status"`
+	EffectiveTime        string `json:"effective_time"`
+	ExpireTime           string `json:"expire_time"`
+	ExpirePolicy         int    `json:"expire_policy"`
+}
+
+// ListResources is the response of a List request.
+type ListResources struct {
+	Data       []Resource `json:"data"`
+	TotalCount int        `json:"total_count"`
+}
+
+// ListResult is the result of a List request.
+type ListResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as ListResources.
+func (r ListResult) Extract() (*ListResources, error) {
+	s := new(ListResources)
+	err := r.ExtractInto(s)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
@@ -0,0 +1,7 @@
+package resources
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+func queryURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("orders", "suscriptions", "resources", "query")
+}
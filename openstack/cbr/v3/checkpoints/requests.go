@@ -0,0 +1,83 @@
+package checkpoints
+
+import (
+	"fmt"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+type CreateOptsBuilder interface {
+	ToCheckpointCreateMap() (map[string]interface{}, error)
+}
+
+type ResourceDetail struct {
+	// ID of the resource to be backed up
+	ID string `json:"id"`
+	// Type of the resource to be backed up.
+	// Possible values are `OS::Nova::Server` and `OS::Cinder::Volume`
+	Type string `json:"type"`
+	// Resource name
+	Name string `json:"name,omitempty"`
+}
+
+type CheckpointParam struct {
+	// AutoTrigger - whether automatic triggering is enabled
+	AutoTrigger bool `json:"auto_trigger,omitempty"`
+	// Description - backup description
+	Description string `json:"description,omitempty"`
+	// Incremental - whether the backup is an incremental backup
+	Incremental *bool `json:"incremental,omitempty"`
+	// Name - backup name
+	Name string `json:"name,omitempty"`
+	// Resources - UUID list of resources to be backed up.
+	// All vault resources are backed up if the list is missing.
+	Resources []string `json:"resources,omitempty"`
+	// ResourceDetails - resource details
+	ResourceDetails []ResourceDetail `json:"resource_details,omitempty"`
+}
+
+type CreateOpts struct {
+	// VaultID - ID of the vault which resources should be backed up
+	VaultID string `json:"vault_id"`
+	// Parameters - backup parameters
+	Parameters *CheckpointParam `json:"parameters,omitempty"`
+}
+
+func (opts CreateOpts) ToCheckpointCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "checkpoint")
+}
+
+// Create triggers an immediate backup of the vault resources.
+func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	reqBody, err := opts.ToCheckpointCreateMap()
+	if err != nil {
+		r.Err = fmt.Errorf("failed to create checkpoint create map: %s", err)
+		return
+	}
+	_, r.Err = client.Post(createURL(client), reqBody, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+func Get(client *golangsdk.ServiceClient, id string) (r GetResult) {
+	_, r.Err = client.Get(singleURL(client, id), &r.Body, nil)
+	return
+}
+
+// WaitForCheckpointAvailable - wait until checkpoint status is `available`
+func WaitForCheckpointAvailable(client *golangsdk.ServiceClient, id string, timeoutSeconds int) error {
+	return golangsdk.WaitFor(timeoutSeconds, func() (bool, error) {
+		checkpoint, err := Get(client, id).Extract()
+		if err != nil {
+			return false, fmt.Errorf("error retrieving checkpoint status: %w", err)
+		}
+		switch checkpoint.Status {
+		case StatusAvailable:
+			return true, nil
+		case StatusError:
+			return false, fmt.Errorf("checkpoint %s is in error status", id)
+		}
+		return false, nil
+	})
+}
@@ -0,0 +1,86 @@
+package checkpoints
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+const (
+	StatusAvailable  = "available"
+	StatusProtecting = "protecting"
+	StatusDeleting   = "deleting"
+	StatusError      = "error"
+)
+
+type checkpointResult struct {
+	golangsdk.Result
+}
+
+type CreateResult struct {
+	checkpointResult
+}
+
+type GetResult struct {
+	checkpointResult
+}
+
+type ExtraInfo struct {
+	Name              string `json:"name"`
+	Description       string `json:"description"`
+	RetentionDuration int    `json:"retention_duration"`
+}
+
+type ResourceExtraInfoIncludeVolumes struct {
+	ID        string `json:"id"`
+	OSVersion string `json:"os_version"`
+}
+
+type ResourceExtraInfo struct {
+	ExcludeVolumes []string                          `json:"exclude_volumes"`
+	IncludeVolumes []ResourceExtraInfoIncludeVolumes `json:"include_volumes"`
+}
+
+type CheckpointResource struct {
+	ExtraInfo     ResourceExtraInfo `json:"extra_info"`
+	ID            string            `json:"id"`
+	Name          string            `json:"name"`
+	ProtectStatus string            `json:"protect_status"`
+	ResourceSize  string            `json:"resource_size"`
+	Type          string            `json:"type"`
+	BackupSize    string            `json:"backup_size"`
+	BackupCount   string            `json:"backup_count"`
+}
+
+type SkippedResource struct {
+	ID     string `json:"id"`
+	Type   string `json:"type"`
+	Name   string `json:"name"`
+	Code   string `json:"code"`
+	Reason string `json:"reason"`
+}
+
+type CheckpointVault struct {
+	ID               string               `json:"id"`
+	Name             string               `json:"name"`
+	Resources        []CheckpointResource `json:"resources"`
+	SkippedResources []SkippedResource    `json:"skipped_resources"`
+}
+
+type Checkpoint struct {
+	ID        string          `json:"id"`
+	CreatedAt string          `json:"created_at"`
+	ProjectID string          `json:"project_id"`
+	Status    string          `json:"status"`
+	Vault     CheckpointVault `json:"vault"`
+	ExtraInfo ExtraInfo       `json:"extra_info"`
+}
+
+func (r checkpointResult) Extract() (*Checkpoint, error) {
+	var s struct {
+		Checkpoint *Checkpoint `json:"checkpoint"`
+	}
+	if r.Err != nil {
+		return nil, r.Err
+	}
+	err := r.ExtractInto(&s)
+	return s.Checkpoint, err
+}
@@ -0,0 +1,76 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const checkpointID = "8b0851a8-adf3-4f4c-a914-dead08bf9664"
+
+const expectedCreateRequest = `
+{
+  "checkpoint" : {
+    "parameters" : {
+      "description" : "backup_description",
+      "incremental" : true,
+      "name" : "backup_name",
+      "resources" : [ "94eba8b2-acc9-4d82-badc-127144cc5526" ]
+    },
+    "vault_id" : "3b5816b5-f29c-4172-9d9a-76c719a659ce"
+  }
+}`
+
+const expectedCheckpointResponse = `
+{
+  "checkpoint" : {
+    "status" : "protecting",
+    "created_at" : "2019-05-10T07:59:12.733+00:00",
+    "vault" : {
+      "skipped_resources" : [ ],
+      "id" : "3b5816b5-f29c-4172-9d9a-76c719a659ce",
+      "resources" : [ {
+        "name" : "ecs-1",
+        "resource_size" : "40",
+        "protect_status" : "available",
+        "type" : "OS::Nova::Server",
+        "id" : "94eba8b2-acc9-4d82-badc-127144cc5526"
+      } ],
+      "name" : "vault-be94"
+    },
+    "project_id" : "4229d7a45436489f8c3dc2b1d35d4987",
+    "id" : "8b0851a8-adf3-4f4c-a914-dead08bf9664",
+    "extra_info" : {
+      "name" : "backup_name",
+      "description" : "backup_description"
+    }
+  }
+}`
+
+// HandleCheckpointCreationSuccessfully creates an HTTP handler at `/checkpoints` on the test
+// handler mux that responds to a POST request with expectedCheckpointResponse.
+func HandleCheckpointCreationSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/checkpoints", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, expectedCreateRequest)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, expectedCheckpointResponse)
+	})
+}
+
+// HandleCheckpointGetSuccessfully creates an HTTP handler at `/checkpoints/{checkpoint_id}` on the
+// test handler mux that responds to a GET request with expectedCheckpointResponse.
+func HandleCheckpointGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/checkpoints/%s", checkpointID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, expectedCheckpointResponse)
+	})
+}
@@ -0,0 +1,45 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/cbr/v3/checkpoints"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestCreateCheckpoint(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCheckpointCreationSuccessfully(t)
+
+	incremental := true
+	opts := checkpoints.CreateOpts{
+		VaultID: "3b5816b5-f29c-4172-9d9a-76c719a659ce",
+		Parameters: &checkpoints.CheckpointParam{
+			Description: "backup_description",
+			Incremental: &incremental,
+			Name:        "backup_name",
+			Resources:   []string{"94eba8b2-acc9-4d82-badc-127144cc5526"},
+		},
+	}
+	checkpoint, err := checkpoints.Create(fake.ServiceClient(), opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, checkpointID, checkpoint.ID)
+	th.AssertEquals(t, checkpoints.StatusProtecting, checkpoint.Status)
+	th.AssertEquals(t, "vault-be94", checkpoint.Vault.Name)
+	th.AssertEquals(t, 1, len(checkpoint.Vault.Resources))
+	th.AssertEquals(t, "OS::Nova::Server", checkpoint.Vault.Resources[0].Type)
+	th.AssertEquals(t, "backup_name", checkpoint.ExtraInfo.Name)
+}
+
+func TestGetCheckpoint(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCheckpointGetSuccessfully(t)
+
+	checkpoint, err := checkpoints.Get(fake.ServiceClient(), checkpointID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, checkpointID, checkpoint.ID)
+	th.AssertEquals(t, "4229d7a45436489f8c3dc2b1d35d4987", checkpoint.ProjectID)
+}
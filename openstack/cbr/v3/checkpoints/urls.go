@@ -0,0 +1,13 @@
+package checkpoints
+
+import golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+const rootURL = "checkpoints"
+
+func createURL(client *golangsdk.ServiceClient) string {
+	return client.ServiceURL(rootURL)
+}
+
+func singleURL(client *golangsdk.ServiceClient, id string) string {
+	return client.ServiceURL(rootURL, id)
+}
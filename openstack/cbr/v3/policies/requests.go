@@ -19,6 +19,15 @@ type PolicyODCreate struct {
 	MaxBackups            int    `json:"max_backups,omitempty"`
 	RetentionDurationDays int    `json:"retention_duration_days,omitempty"`
 	Timezone              string `json:"timezone,omitempty"`
+	// DestinationProjectID - ID of the replication destination project.
+	// Mandatory for the `replication` policies.
+	DestinationProjectID string `json:"destination_project_id,omitempty"`
+	// DestinationRegion - ID of the replication destination region.
+	// Mandatory for the `replication` policies.
+	DestinationRegion string `json:"destination_region,omitempty"`
+	// EnableAcceleration - whether to enable the acceleration function to shorten
+	// replication time for cross-region replication.
+	EnableAcceleration bool `json:"enable_acceleration,omitempty"`
 }
 
 type TriggerProperties struct {
@@ -34,6 +43,11 @@ type Trigger struct {
 // One of `backup` and `replication`.
 type OperationType string
 
+const (
+	OperationTypeBackup      OperationType = "backup"
+	OperationTypeReplication OperationType = "replication"
+)
+
 type CreateOpts struct {
 	// Name specifies the policy name. The value consists of 1 to 64 characters
 	// and can contain only letters, digits, underscores (_), and hyphens (-).
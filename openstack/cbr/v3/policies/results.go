@@ -32,6 +32,9 @@ type PolicyTriggerPropertiesResp struct {
 	StartTime string   `json:"start_time"`
 }
 type PolicyTriggerResp struct {
+	ID         string                      `json:"id"`
+	Name       string                      `json:"name"`
+	Type       string                      `json:"type"`
 	Properties PolicyTriggerPropertiesResp `json:"properties"`
 }
 type PolicyAssociateVault struct {
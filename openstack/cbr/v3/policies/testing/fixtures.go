@@ -123,6 +123,9 @@ var (
 		},
 		OperationType: "backup",
 		Trigger: &policies.PolicyTriggerResp{
+			ID:   "d67269a6-5369-42d7-8150-5254bd446328",
+			Name: "default",
+			Type: "time",
 			Properties: policies.PolicyTriggerPropertiesResp{
 				Pattern:   []string{"FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR,SA,SU;BYHOUR=14;BYMINUTE=00"},
 				StartTime: "2019-05-08 06:57:05",
@@ -180,3 +183,42 @@ func handlePolicyUpdate(t *testing.T) {
 		_, _ = fmt.Fprint(w, expectedUpdateResponse)
 	})
 }
+
+const expectedReplicationRequest = `
+{
+  "policy" : {
+    "name" : "replication001",
+    "operation_definition" : {
+      "day_backups" : 0,
+      "month_backups" : 0,
+      "week_backups" : 0,
+      "year_backups" : 0,
+      "max_backups" : 5,
+      "timezone" : "UTC+01:00",
+      "destination_project_id" : "0503dda878000fed2f78c00e19cd5a1e",
+      "destination_region" : "eu-nl"
+    },
+    "operation_type" : "replication",
+    "trigger" : {
+      "properties" : {
+        "pattern" : [ "FREQ=DAILY;INTERVAL=1;BYHOUR=3;BYMINUTE=00" ]
+      }
+    }
+  }
+}`
+
+var replicationCreateOpts = policies.CreateOpts{
+	Name: "replication001",
+	OperationDefinition: &policies.PolicyODCreate{
+		MaxBackups:           5,
+		Timezone:             "UTC+01:00",
+		DestinationProjectID: "0503dda878000fed2f78c00e19cd5a1e",
+		DestinationRegion:    "eu-nl",
+	},
+	OperationType: policies.OperationTypeReplication,
+	Trigger: &policies.Trigger{
+		Properties: policies.TriggerProperties{
+			Pattern: []string{"FREQ=DAILY;INTERVAL=1;BYHOUR=3;BYMINUTE=00"},
+		},
+	},
+}
@@ -43,3 +43,9 @@ func TestUpdateV3Policy(t *testing.T) {
 	th.AssertNoErr(t, err)
 	th.AssertDeepEquals(t, expectedCreateResponseData, actual)
 }
+
+func TestCreateV3ReplicationPolicyMarshall(t *testing.T) {
+	res, err := replicationCreateOpts.ToPolicyCreateMap()
+	th.AssertNoErr(t, err)
+	th.AssertJSONEquals(t, expectedReplicationRequest, res)
+}
@@ -1,6 +1,8 @@
 package tasks
 
 import (
+	"fmt"
+
 	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
 	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
 )
@@ -51,3 +53,20 @@ func Get(client *golangsdk.ServiceClient, id string) (r GetResult) {
 	_, r.Err = client.Get(singleURL(client, id), &r.Body, nil)
 	return
 }
+
+// WaitForTaskSuccess - wait until operation log status is `success`
+func WaitForTaskSuccess(client *golangsdk.ServiceClient, id string, timeoutSeconds int) error {
+	return golangsdk.WaitFor(timeoutSeconds, func() (bool, error) {
+		task, err := Get(client, id).Extract()
+		if err != nil {
+			return false, fmt.Errorf("error retrieving task status: %w", err)
+		}
+		switch task.Status {
+		case "success":
+			return true, nil
+		case "failed", "timeout":
+			return false, fmt.Errorf("task %s finished with status %s: %s", id, task.Status, task.ErrorInfo.Message)
+		}
+		return false, nil
+	})
+}
@@ -185,7 +185,11 @@ type BindPolicyOptsBuilder interface {
 }
 
 type BindPolicyOpts struct {
+	// PolicyID - ID of the backup or replication policy
 	PolicyID string `json:"policy_id"`
+	// DestinationVaultID - ID of the destination vault.
+	// Mandatory when binding a `replication` policy.
+	DestinationVaultID string `json:"destination_vault_id,omitempty"`
 }
 
 func (opts BindPolicyOpts) ToBindPolicyMap() (map[string]interface{}, error) {
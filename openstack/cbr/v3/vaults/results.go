@@ -118,8 +118,9 @@ type BindPolicyResult struct {
 }
 
 type PolicyBinding struct {
-	VaultID  string `json:"vault_id"`
-	PolicyID string `json:"policy_id"`
+	VaultID            string `json:"vault_id"`
+	PolicyID           string `json:"policy_id"`
+	DestinationVaultID string `json:"destination_vault_id"`
 }
 
 func (r BindPolicyResult) Extract() (*PolicyBinding, error) {
@@ -0,0 +1,64 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const (
+	vaultID            = "ad7627ae-5b0b-492e-b6bd-cd809b745197"
+	policyID           = "7075c397-25a0-43e2-a83a-bb16eaca3ee5"
+	destinationVaultID = "3b5816b5-f29c-4172-9d9a-76c719a659ce"
+)
+
+const expectedBindPolicyRequest = `
+{
+  "policy_id" : "7075c397-25a0-43e2-a83a-bb16eaca3ee5",
+  "destination_vault_id" : "3b5816b5-f29c-4172-9d9a-76c719a659ce"
+}`
+
+const expectedBindPolicyResponse = `
+{
+  "associate_policy" : {
+    "vault_id" : "ad7627ae-5b0b-492e-b6bd-cd809b745197",
+    "policy_id" : "7075c397-25a0-43e2-a83a-bb16eaca3ee5",
+    "destination_vault_id" : "3b5816b5-f29c-4172-9d9a-76c719a659ce"
+  }
+}`
+
+const expectedUnbindPolicyResponse = `
+{
+  "dissociate_policy" : {
+    "vault_id" : "ad7627ae-5b0b-492e-b6bd-cd809b745197",
+    "policy_id" : "7075c397-25a0-43e2-a83a-bb16eaca3ee5"
+  }
+}`
+
+// HandleBindPolicySuccessfully creates an HTTP handler at `/vaults/{vault_id}/associatepolicy` on
+// the test handler mux that responds to a POST request with expectedBindPolicyResponse.
+func HandleBindPolicySuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/vaults/%s/associatepolicy", vaultID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, expectedBindPolicyRequest)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, expectedBindPolicyResponse)
+	})
+}
+
+// HandleUnbindPolicySuccessfully creates an HTTP handler at `/vaults/{vault_id}/dissociatepolicy`
+// on the test handler mux that responds to a POST request with expectedUnbindPolicyResponse.
+func HandleUnbindPolicySuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/vaults/%s/dissociatepolicy", vaultID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, expectedUnbindPolicyResponse)
+	})
+}
@@ -0,0 +1,36 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/cbr/v3/vaults"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestBindReplicationPolicy(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleBindPolicySuccessfully(t)
+
+	opts := vaults.BindPolicyOpts{
+		PolicyID:           policyID,
+		DestinationVaultID: destinationVaultID,
+	}
+	binding, err := vaults.BindPolicy(fake.ServiceClient(), vaultID, opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, vaultID, binding.VaultID)
+	th.AssertEquals(t, policyID, binding.PolicyID)
+	th.AssertEquals(t, destinationVaultID, binding.DestinationVaultID)
+}
+
+func TestUnbindPolicy(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUnbindPolicySuccessfully(t)
+
+	binding, err := vaults.UnbindPolicy(fake.ServiceClient(), vaultID, vaults.BindPolicyOpts{PolicyID: policyID}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, vaultID, binding.VaultID)
+	th.AssertEquals(t, policyID, binding.PolicyID)
+}
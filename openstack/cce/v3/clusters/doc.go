@@ -43,6 +43,13 @@ Example to Update a cluster
 		panic(err)
 	}
 
+Example to Retrieve a kubeconfig valid for 30 days
+
+	kubeConfig, err := clusters.GetCertWithExpiration(client,clusterID,clusters.GetCertOpts{Duration:30}).ExtractKubeConfig()
+	if err != nil {
+		panic(err)
+	}
+
 Example to Delete a cluster
 
 	clusterID := "4e8e5957-649f-477b-9e5b-f1f75b21c03c"
@@ -51,5 +58,38 @@ Example to Delete a cluster
 	if err != nil {
 		panic(err)
 	}
+
+Example to Delete a cluster together with its EVS volumes
+
+	deleteOpts := clusters.DeleteOpts{DeleteEvs: "true"}
+
+	err := clusters.DeleteWithOpts(client,clusterID,deleteOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Hibernate a cluster and wait for it
+
+	err := clusters.Hibernate(client,clusterID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+	err = clusters.WaitForStatus(client,clusterID,"Hibernation",600)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Awake a hibernated cluster and wait for it
+
+	err := clusters.Awake(client,clusterID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+	err = clusters.WaitForStatus(client,clusterID,"Available",600)
+	if err != nil {
+		panic(err)
+	}
 */
 package clusters
@@ -1,6 +1,7 @@
 package clusters
 
 import (
+	"fmt"
 	"reflect"
 
 	"github.com/opentelekomcloud/gophertelekomcloud"
@@ -157,6 +158,38 @@ func GetCert(c *golangsdk.ServiceClient, id string) (r GetCertResult) {
 	return
 }
 
+// GetCertOptsBuilder allows extensions to add additional parameters to the
+// GetCertWithExpiration request.
+type GetCertOptsBuilder interface {
+	ToCertificateGetMap() (map[string]interface{}, error)
+}
+
+// GetCertOpts contains the validity of the requested cluster certificate
+type GetCertOpts struct {
+	// Validity period of the certificate in days, from 1 to 1825. -1 means the maximum of 5 years
+	Duration int `json:"duration" required:"true"`
+}
+
+// ToCertificateGetMap builds a request body from GetCertOpts.
+func (opts GetCertOpts) ToCertificateGetMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// GetCertWithExpiration retrieves a cluster certificate with the given validity period.
+// The result is a kubeconfig which can be used by kubectl or client-go directly.
+func GetCertWithExpiration(c *golangsdk.ServiceClient, id string, opts GetCertOptsBuilder) (r GetCertResult) {
+	b, err := opts.ToCertificateGetMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Post(certificateURL(c, id), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes:     []int{200},
+		MoreHeaders: RequestOpts.MoreHeaders,
+	})
+	return
+}
+
 // UpdateOpts contains all the values needed to update a new cluster
 type UpdateOpts struct {
 	Spec UpdateSpec `json:"spec" required:"true"`
@@ -165,6 +198,17 @@ type UpdateOpts struct {
 type UpdateSpec struct {
 	// Cluster description
 	Description string `json:"description,omitempty"`
+	// Custom SAN field of the API server certificate
+	CustomSan []string `json:"customSan,omitempty"`
+	// Node network parameters
+	HostNetwork *UpdateHostNetworkSpec `json:"hostNetwork,omitempty"`
+	// Eni network parameters
+	EniNetwork *EniNetworkSpec `json:"eniNetwork,omitempty"`
+}
+
+type UpdateHostNetworkSpec struct {
+	// The ID of the default Security Group used by the nodes
+	SecurityGroup string `json:"SecurityGroup,omitempty"`
 }
 
 // UpdateOptsBuilder allows extensions to add additional parameters to the
@@ -178,7 +222,7 @@ func (opts UpdateOpts) ToClusterUpdateMap() (map[string]interface{}, error) {
 	return golangsdk.BuildRequestBody(opts, "")
 }
 
-// Update allows clusters to update description.
+// Update allows clusters to update description, certificate SAN and network settings.
 func Update(c *golangsdk.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
 	b, err := opts.ToClusterUpdateMap()
 	if err != nil {
@@ -200,6 +244,105 @@ func Delete(c *golangsdk.ServiceClient, id string) (r DeleteResult) {
 	return
 }
 
+// DeleteOptsBuilder allows extensions to add additional parameters to the
+// DeleteWithOpts request.
+type DeleteOptsBuilder interface {
+	ToClusterDeleteQuery() (string, error)
+}
+
+// DeleteOpts specifies which associated resources are deleted together with the cluster.
+// Each value is one of `true`, `try` (delete, ignoring failures) or `false` (keep, default).
+type DeleteOpts struct {
+	// Whether to delete SFS Turbo volumes
+	DeleteEfs string `q:"delete_efs"`
+	// Whether to delete ENI ports
+	DeleteEni string `q:"delete_eni"`
+	// Whether to delete EVS volumes
+	DeleteEvs string `q:"delete_evs"`
+	// Whether to delete cluster Service/ingress-related resources, such as ELB
+	DeleteNet string `q:"delete_net"`
+	// Whether to delete OBS buckets
+	DeleteObs string `q:"delete_obs"`
+	// Whether to delete SFS volumes
+	DeleteSfs string `q:"delete_sfs"`
+}
+
+// ToClusterDeleteQuery formats a DeleteOpts into a query string.
+func (opts DeleteOpts) ToClusterDeleteQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), nil
+}
+
+// DeleteWithOpts will permanently delete a particular cluster together with the associated resources selected in opts.
+func DeleteWithOpts(c *golangsdk.ServiceClient, id string, opts DeleteOptsBuilder) (r DeleteResult) {
+	url := resourceURL(c, id)
+	if opts != nil {
+		q, err := opts.ToClusterDeleteQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += q
+	}
+	_, r.Err = c.Delete(url, &golangsdk.RequestOpts{
+		OkCodes:     []int{200},
+		MoreHeaders: RequestOpts.MoreHeaders, JSONBody: nil,
+	})
+	return
+}
+
+// Hibernate stops a cluster. The cluster nodes are not stopped.
+func Hibernate(c *golangsdk.ServiceClient, id string) (r OperationResult) {
+	_, r.Err = c.Post(operationURL(c, id, "hibernate"), nil, nil, &golangsdk.RequestOpts{
+		OkCodes:     []int{200},
+		MoreHeaders: RequestOpts.MoreHeaders,
+	})
+	return
+}
+
+// Awake starts a hibernated cluster.
+func Awake(c *golangsdk.ServiceClient, id string) (r OperationResult) {
+	_, r.Err = c.Post(operationURL(c, id, "awake"), nil, nil, &golangsdk.RequestOpts{
+		OkCodes:     []int{200},
+		MoreHeaders: RequestOpts.MoreHeaders,
+	})
+	return
+}
+
+// WaitForStatus waits until the cluster reaches the given phase, e.g. `Available` or `Hibernation`.
+func WaitForStatus(c *golangsdk.ServiceClient, id, phase string, timeoutSeconds int) error {
+	return golangsdk.WaitFor(timeoutSeconds, func() (bool, error) {
+		cluster, err := Get(c, id).Extract()
+		if err != nil {
+			return false, fmt.Errorf("error retrieving cluster status: %w", err)
+		}
+		if cluster.Status.Phase == phase {
+			return true, nil
+		}
+		if cluster.Status.Phase == "Error" {
+			return false, fmt.Errorf("cluster is in error state: %s", cluster.Status.Reason)
+		}
+		return false, nil
+	})
+}
+
+// WaitForDeleted waits until the cluster is deleted.
+func WaitForDeleted(c *golangsdk.ServiceClient, id string, timeoutSeconds int) error {
+	return golangsdk.WaitFor(timeoutSeconds, func() (bool, error) {
+		_, err := Get(c, id).Extract()
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return true, nil
+			}
+			return false, fmt.Errorf("error retrieving cluster status: %w", err)
+		}
+		return false, nil
+	})
+}
+
 type UpdateIpOpts struct {
 	Action    string `json:"action" required:"true"`
 	Spec      IpSpec `json:"spec,omitempty"`
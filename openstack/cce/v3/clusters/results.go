@@ -60,13 +60,33 @@ type Spec struct {
 	Authentication AuthenticationSpec `json:"authentication,omitempty"`
 	// Charging mode of the cluster, which is 0 (on demand)
 	BillingMode int `json:"billingMode,omitempty"`
-	// Extended parameter for a cluster
+	// Extended parameter for a cluster, e.g. `enterpriseProjectId` to create the cluster in an enterprise project
 	ExtendParam map[string]string `json:"extendParam,omitempty"`
 	// KubernetesSvcIpRange Service CIDR block or the IP address range which the kubernetes clusterIp must fall within.
 	// This parameter is available only for clusters of v1.11.7 and later.
 	KubernetesSvcIpRange string `json:"kubernetesSvcIpRange,omitempty"`
 	// KubeProxyMode Service forwarding mode. One of `iptables`, `ipvs`
 	KubeProxyMode string `json:"kubeProxyMode,omitempty"`
+	// Cluster category: `CCE` or `Turbo`
+	Category string `json:"category,omitempty"`
+	// Eni network parameters, required for `eni` container network mode
+	EniNetwork *EniNetworkSpec `json:"eniNetwork,omitempty"`
+	// Availability zones of the master nodes. Three masters in different zones make the cluster multi-AZ
+	Masters []MasterSpec `json:"masters,omitempty"`
+}
+
+// Eni network parameters
+type EniNetworkSpec struct {
+	// The ID of the IPv4 subnet used to create the ENIs
+	SubnetId string `json:"eniSubnetId" required:"true"`
+	// The CIDR of the IPv4 subnet used to create the ENIs
+	Cidr string `json:"eniSubnetCIDR" required:"true"`
+}
+
+// Master node parameters
+type MasterSpec struct {
+	// Availability zone of the master node
+	MasterAZ string `json:"availabilityZone,omitempty"`
 }
 
 // Node network parameters
@@ -84,7 +104,7 @@ type HostNetworkSpec struct {
 
 // Container network parameters
 type ContainerNetworkSpec struct {
-	// Container network type: overlay_l2 , underlay_ipvlan or vpc-router
+	// Container network type: overlay_l2 , underlay_ipvlan, vpc-router or eni
 	Mode string `json:"mode" required:"true"`
 	// Container network segment: 172.16.0.0/16 ~ 172.31.0.0/16. If there is a network segment conflict, it will be automatically reselected.
 	Cidr string `json:"cidr,omitempty"`
@@ -159,6 +179,8 @@ type CertCluster struct {
 	Server string `json:"server"`
 	// Certificate data
 	CertAuthorityData string `json:"certificate-authority-data"`
+	// Whether the server certificate is not verified
+	InsecureSkipTLSVerify bool `json:"insecure-skip-tls-verify"`
 }
 
 type CertUsers struct {
@@ -286,13 +308,28 @@ type GetCertResult struct {
 	golangsdk.Result
 }
 
-// Extract is a function that accepts a result and extracts a cluster.
+// Extract is a function that accepts a result and extracts a cluster certificate.
 func (r GetCertResult) Extract() (*Certificate, error) {
 	var s Certificate
 	err := r.ExtractInto(&s)
 	return &s, err
 }
 
+// ExtractKubeConfig returns the certificate as a kubeconfig document in JSON format,
+// which can be written to a file or passed to client-go as is.
+func (r GetCertResult) ExtractKubeConfig() ([]byte, error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
+	return json.Marshal(r.Body)
+}
+
+// OperationResult represents the result of a hibernate or awake operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type OperationResult struct {
+	golangsdk.ErrResult
+}
+
 // UpdateIpResult represents the result of an update operation. Call its Extract
 // method to interpret it as a Cluster.
 type UpdateIpResult struct {
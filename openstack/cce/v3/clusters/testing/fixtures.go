@@ -235,3 +235,39 @@ var ListExpectedOTC = []clusters.Clusters{
 		},
 	},
 }
+
+const CertOutput = `
+{
+    "kind": "Config",
+    "apiVersion": "v1",
+    "preferences": {},
+    "clusters": [
+        {
+            "name": "internalCluster",
+            "cluster": {
+                "server": "https://192.168.0.68:5443",
+                "certificate-authority-data": "Y2VydGlmaWNhdGU="
+            }
+        }
+    ],
+    "users": [
+        {
+            "name": "user",
+            "user": {
+                "client-certificate-data": "Y2xpZW50",
+                "client-key-data": "a2V5"
+            }
+        }
+    ],
+    "contexts": [
+        {
+            "name": "internal",
+            "context": {
+                "cluster": "internalCluster",
+                "user": "user"
+            }
+        }
+    ],
+    "current-context": "internal"
+}
+`
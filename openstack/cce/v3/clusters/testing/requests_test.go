@@ -1,6 +1,7 @@
 package testing
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"testing"
@@ -208,3 +209,159 @@ func TestDeleteV3Cluster(t *testing.T) {
 	th.AssertNoErr(t, err)
 
 }
+
+func TestCreateV3ClusterMultiAZ(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/api/v3/projects/c59fd21fd2a94963b822d8985b884673/clusters", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `
+{
+    "kind": "Cluster",
+    "apiversion": "v3",
+    "metadata": {
+        "name": "test-cluster"
+    },
+    "spec": {
+        "type": "VirtualMachine",
+        "flavor": "cce.s2.small",
+        "category": "Turbo",
+        "hostNetwork": {
+            "vpc": "3305eb40-2707-4940-921c-9f335f84a2ca",
+            "subnet": "00e41db7-e56b-4946-bf91-27bb9effd664"
+        },
+        "containerNetwork": {
+            "mode": "eni"
+        },
+        "eniNetwork": {
+            "eniSubnetId": "f4ddb6d2-29c1-4d1f-9a54-8a4d8e4ab2b4",
+            "eniSubnetCIDR": "192.168.2.0/24"
+        },
+        "authentication": {
+            "mode": "rbac",
+            "authenticatingProxy": {}
+        },
+        "masters": [
+            {
+                "availabilityZone": "eu-de-01"
+            },
+            {
+                "availabilityZone": "eu-de-02"
+            },
+            {
+                "availabilityZone": "eu-de-03"
+            }
+        ]
+    }
+}
+`)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprint(w, Output)
+	})
+	options := clusters.CreateOpts{Kind: "Cluster",
+		ApiVersion: "v3",
+		Metadata:   clusters.CreateMetaData{Name: "test-cluster"},
+		Spec: clusters.Spec{Type: "VirtualMachine",
+			Flavor:   "cce.s2.small",
+			Category: "Turbo",
+			HostNetwork: clusters.HostNetworkSpec{
+				VpcId:    "3305eb40-2707-4940-921c-9f335f84a2ca",
+				SubnetId: "00e41db7-e56b-4946-bf91-27bb9effd664"},
+			ContainerNetwork: clusters.ContainerNetworkSpec{Mode: "eni"},
+			EniNetwork: &clusters.EniNetworkSpec{
+				SubnetId: "f4ddb6d2-29c1-4d1f-9a54-8a4d8e4ab2b4",
+				Cidr:     "192.168.2.0/24",
+			},
+			Authentication: clusters.AuthenticationSpec{
+				Mode:                "rbac",
+				AuthenticatingProxy: make(map[string]string)},
+			Masters: []clusters.MasterSpec{
+				{MasterAZ: "eu-de-01"},
+				{MasterAZ: "eu-de-02"},
+				{MasterAZ: "eu-de-03"},
+			},
+		},
+	}
+	_, err := clusters.Create(fake.ServiceClient(), options).Extract()
+	th.AssertNoErr(t, err)
+}
+
+func TestDeleteV3ClusterWithOpts(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/api/v3/projects/c59fd21fd2a94963b822d8985b884673/clusters/daa97872-59d7-11e8-a787-0255ac101f54", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"delete_evs": "true", "delete_net": "try"})
+		w.WriteHeader(http.StatusOK)
+	})
+
+	err := clusters.DeleteWithOpts(fake.ServiceClient(), "daa97872-59d7-11e8-a787-0255ac101f54", clusters.DeleteOpts{
+		DeleteEvs: "true",
+		DeleteNet: "try",
+	}).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestHibernateAwakeV3Cluster(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	for _, action := range []string{"hibernate", "awake"} {
+		th.Mux.HandleFunc("/api/v3/projects/c59fd21fd2a94963b822d8985b884673/clusters/daa97872-59d7-11e8-a787-0255ac101f54/operation/"+action, func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "POST")
+			th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+			w.WriteHeader(http.StatusOK)
+		})
+	}
+
+	err := clusters.Hibernate(fake.ServiceClient(), "daa97872-59d7-11e8-a787-0255ac101f54").ExtractErr()
+	th.AssertNoErr(t, err)
+	err = clusters.Awake(fake.ServiceClient(), "daa97872-59d7-11e8-a787-0255ac101f54").ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestWaitForStatusV3Cluster(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/api/v3/projects/c59fd21fd2a94963b822d8985b884673/clusters/daa97872-59d7-11e8-a787-0255ac101f54", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, Output)
+	})
+
+	err := clusters.WaitForStatus(fake.ServiceClient(), "daa97872-59d7-11e8-a787-0255ac101f54", "Available", 5)
+	th.AssertNoErr(t, err)
+}
+
+func TestGetCertWithExpirationV3Cluster(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/api/v3/projects/c59fd21fd2a94963b822d8985b884673/clusters/daa97872-59d7-11e8-a787-0255ac101f54/clustercert", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `{"duration": 30}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, CertOutput)
+	})
+
+	result := clusters.GetCertWithExpiration(fake.ServiceClient(), "daa97872-59d7-11e8-a787-0255ac101f54", clusters.GetCertOpts{Duration: 30})
+	cert, err := result.Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "internal", cert.CurrentContext)
+	th.AssertEquals(t, "https://192.168.0.68:5443", cert.Clusters[0].Cluster.Server)
+
+	kubeConfig, err := result.ExtractKubeConfig()
+	th.AssertNoErr(t, err)
+	th.AssertJSONEquals(t, CertOutput, json.RawMessage(kubeConfig))
+}
@@ -3,9 +3,10 @@ package clusters
 import "github.com/opentelekomcloud/gophertelekomcloud"
 
 const (
-	rootPath     = "clusters"
-	certPath     = "clustercert"
-	masterIpPath = "mastereip"
+	rootPath      = "clusters"
+	certPath      = "clustercert"
+	masterIpPath  = "mastereip"
+	operationPath = "operation"
 )
 
 func rootURL(client *golangsdk.ServiceClient) string {
@@ -23,3 +24,7 @@ func certificateURL(c *golangsdk.ServiceClient, id string) string {
 func masterIpURL(c *golangsdk.ServiceClient, id string) string {
 	return c.ServiceURL(rootPath, id, masterIpPath)
 }
+
+func operationURL(c *golangsdk.ServiceClient, id, action string) string {
+	return c.ServiceURL(rootPath, id, operationPath, action)
+}
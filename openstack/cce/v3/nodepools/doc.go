@@ -69,6 +69,23 @@ Example to Delete a node pool
 	if err != nil {
 		panic(err)
 	}
+
+Example to Scale a node pool and wait for the nodes
+
+	scaleOpts := nodepools.ScaleOpts{
+		Kind:       "NodePool",
+		ApiVersion: "v3",
+		Spec: nodepools.ScaleSpec{DesiredNodeCount:3,ScaleGroups:[]string{"default"}},
+	}
+	err := nodepools.Scale(client,clusterID,nodePoolID,scaleOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+	err = nodepools.WaitForNodeCount(client,clusterID,nodePoolID,3,1800)
+	if err != nil {
+		panic(err)
+	}
 */
 
 package nodepools
@@ -1,10 +1,12 @@
 package nodepools
 
 import (
+	"fmt"
 	"reflect"
 
 	"github.com/opentelekomcloud/gophertelekomcloud"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/cce/v3/nodes"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/tags"
 )
 
 var RequestOpts = golangsdk.RequestOpts{
@@ -195,6 +197,8 @@ type UpdateNodeTemplate struct {
 	K8sTags map[string]string `json:"k8sTags,omitempty"`
 	// taints to created nodes to configure anti-affinity
 	Taints []nodes.TaintSpec `json:"taints,omitempty"`
+	// Tag of a VM, key value pair format
+	UserTags []tags.ResourceTag `json:"userTags,omitempty"`
 }
 
 type UpdateMetadata struct {
@@ -227,3 +231,91 @@ func Delete(c *golangsdk.ServiceClient, clusterid, nodepoolid string) (r DeleteR
 	})
 	return
 }
+
+// ScaleOptsBuilder allows extensions to add additional parameters to the
+// Scale request.
+type ScaleOptsBuilder interface {
+	ToNodePoolScaleMap() (map[string]interface{}, error)
+}
+
+// ScaleOpts contains all the values needed to scale a node pool
+type ScaleOpts struct {
+	// API type, fixed value NodePool
+	Kind string `json:"kind" required:"true"`
+	// API version, fixed value v3
+	ApiVersion string `json:"apiVersion" required:"true"`
+	// specifications to scale a Node Pool
+	Spec ScaleSpec `json:"spec" required:"true"`
+}
+
+type ScaleSpec struct {
+	// Expected number of nodes in the node pool after scaling
+	DesiredNodeCount int `json:"desiredNodeCount"`
+	// Scale groups to scale, `default` is the default scale group of the node pool
+	ScaleGroups []string `json:"scaleGroups" required:"true"`
+}
+
+// ToNodePoolScaleMap builds a scale body based on ScaleOpts.
+func (opts ScaleOpts) ToNodePoolScaleMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Scale changes the number of nodes in the node pool.
+func Scale(c *golangsdk.ServiceClient, clusterid, nodepoolid string, opts ScaleOptsBuilder) (r ScaleResult) {
+	b, err := opts.ToNodePoolScaleMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Post(scaleURL(c, clusterid, nodepoolid), b, nil, &golangsdk.RequestOpts{
+		OkCodes:     []int{200},
+		MoreHeaders: RequestOpts.MoreHeaders,
+	})
+	return
+}
+
+// WaitForStatus waits until the node pool reaches the given phase. An empty phase
+// means the node pool is available.
+func WaitForStatus(c *golangsdk.ServiceClient, clusterid, nodepoolid, phase string, timeoutSeconds int) error {
+	return golangsdk.WaitFor(timeoutSeconds, func() (bool, error) {
+		pool, err := Get(c, clusterid, nodepoolid).Extract()
+		if err != nil {
+			return false, fmt.Errorf("error retrieving node pool status: %w", err)
+		}
+		if pool.Status.Phase == phase {
+			return true, nil
+		}
+		if pool.Status.Phase == "Error" {
+			return false, fmt.Errorf("node pool is in error state: %s", pool.Status.Message)
+		}
+		return false, nil
+	})
+}
+
+// WaitForNodeCount waits until the node pool has the given number of nodes and no nodes are being created or deleted.
+func WaitForNodeCount(c *golangsdk.ServiceClient, clusterid, nodepoolid string, count, timeoutSeconds int) error {
+	return golangsdk.WaitFor(timeoutSeconds, func() (bool, error) {
+		pool, err := Get(c, clusterid, nodepoolid).Extract()
+		if err != nil {
+			return false, fmt.Errorf("error retrieving node pool status: %w", err)
+		}
+		if pool.Status.CurrentNode == count && pool.Status.CreatingNode == 0 && pool.Status.DeletingNode == 0 {
+			return true, nil
+		}
+		return false, nil
+	})
+}
+
+// WaitForDeleted waits until the node pool is deleted.
+func WaitForDeleted(c *golangsdk.ServiceClient, clusterid, nodepoolid string, timeoutSeconds int) error {
+	return golangsdk.WaitFor(timeoutSeconds, func() (bool, error) {
+		_, err := Get(c, clusterid, nodepoolid).Extract()
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return true, nil
+			}
+			return false, fmt.Errorf("error retrieving node pool status: %w", err)
+		}
+		return false, nil
+	})
+}
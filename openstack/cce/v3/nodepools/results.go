@@ -45,6 +45,12 @@ type Status struct {
 	Phase string `json:"phase"`
 	// Number of nodes in the node pool
 	CurrentNode int `json:"currentNode"`
+	// Number of nodes being created in the node pool
+	CreatingNode int `json:"creatingNode"`
+	// Number of nodes being deleted in the node pool
+	DeletingNode int `json:"deletingNode"`
+	// Details of the node pool transitioning to the current state
+	Message string `json:"message"`
 }
 
 // Spec describes Node pools specification
@@ -130,3 +136,9 @@ type UpdateResult struct {
 type DeleteResult struct {
 	golangsdk.ErrResult
 }
+
+// ScaleResult represents the result of a scale operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type ScaleResult struct {
+	golangsdk.ErrResult
+}
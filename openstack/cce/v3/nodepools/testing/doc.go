@@ -0,0 +1,2 @@
+// node pools unit tests
+package testing
@@ -0,0 +1,221 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/opentelekomcloud/gophertelekomcloud/openstack/cce/v3/common"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+const (
+	clusterID  = "cec124c2-58f1-11e8-ad73-0255ac101926"
+	nodePoolID = "cec124c2-58f1-11e8-ad73-0255ac101927"
+)
+
+const expectedCreateRequest = `
+{
+  "kind": "NodePool",
+  "apiversion": "v3",
+  "metadata": {
+    "name": "nodepool-test"
+  },
+  "spec": {
+    "type": "vm",
+    "initialNodeCount": 1,
+    "autoscaling": {
+      "enable": true,
+      "minNodeCount": 1,
+      "maxNodeCount": 3,
+      "scaleDownCooldownTime": 10,
+      "priority": 1
+    },
+    "nodeManagement": {
+      "serverGroupReference": ""
+    },
+    "nodeTemplate": {
+      "flavor": "s2.large.2",
+      "az": "eu-de-01",
+      "os": "EulerOS 2.9",
+      "login": {
+        "sshKey": "my-keypair",
+        "userPassword": {
+          "username": "",
+          "password": ""
+        }
+      },
+      "rootVolume": {
+        "size": 40,
+        "volumetype": "SSD"
+      },
+      "dataVolumes": [
+        {
+          "size": 100,
+          "volumetype": "SSD"
+        }
+      ],
+      "publicIP": {
+        "eip": {
+          "bandwidth": {}
+        }
+      },
+      "count": 1,
+      "nodeNicSpec": {
+        "primaryNic": {}
+      },
+      "extendParam": {},
+      "k8sTags": {
+        "app": "web"
+      },
+      "taints": [
+        {
+          "key": "dedicated",
+          "value": "web",
+          "effect": "NoSchedule"
+        }
+      ],
+      "storage": {
+        "storageSelectors": [
+          {
+            "name": "cceUse",
+            "storageType": "evs",
+            "matchLabels": {
+              "size": "100",
+              "volumeType": "SSD",
+              "count": "1"
+            }
+          }
+        ],
+        "storageGroups": [
+          {
+            "name": "vgpaas",
+            "cceManaged": true,
+            "selectorNames": [
+              "cceUse"
+            ],
+            "virtualSpaces": [
+              {
+                "name": "kubernetes",
+                "size": "10%",
+                "lvmConfig": {
+                  "lvType": "linear"
+                }
+              },
+              {
+                "name": "runtime",
+                "size": "90%",
+                "runtimeConfig": {
+                  "lvType": "linear"
+                }
+              }
+            ]
+          }
+        ]
+      }
+    }
+  }
+}
+`
+
+const nodePoolResponse = `
+{
+  "kind": "NodePool",
+  "apiVersion": "v3",
+  "metadata": {
+    "name": "nodepool-test",
+    "uid": "cec124c2-58f1-11e8-ad73-0255ac101927"
+  },
+  "spec": {
+    "type": "vm",
+    "initialNodeCount": 1,
+    "nodeTemplate": {
+      "flavor": "s2.large.2",
+      "az": "eu-de-01",
+      "os": "EulerOS 2.9",
+      "login": {
+        "sshKey": "my-keypair"
+      },
+      "rootVolume": {
+        "size": 40,
+        "volumetype": "SSD"
+      },
+      "dataVolumes": [
+        {
+          "size": 100,
+          "volumetype": "SSD"
+        }
+      ],
+      "count": 1
+    },
+    "autoscaling": {
+      "enable": true,
+      "minNodeCount": 1,
+      "maxNodeCount": 3,
+      "scaleDownCooldownTime": 10,
+      "priority": 1
+    }
+  },
+  "status": {
+    "currentNode": 2,
+    "creatingNode": 0,
+    "deletingNode": 0,
+    "phase": ""
+  }
+}
+`
+
+const expectedScaleRequest = `
+{
+  "kind": "NodePool",
+  "apiVersion": "v3",
+  "spec": {
+    "desiredNodeCount": 2,
+    "scaleGroups": [
+      "default"
+    ]
+  }
+}
+`
+
+// HandleCreateSuccessfully creates an HTTP handler at
+// `/api/v3/projects/{project_id}/clusters/{cluster_id}/nodepools` on the test handler mux that
+// responds to a POST request with nodePoolResponse.
+func HandleCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/api/v3/projects/c59fd21fd2a94963b822d8985b884673/clusters/%s/nodepools", clusterID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, expectedCreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprint(w, nodePoolResponse)
+	})
+}
+
+// HandleGetSuccessfully creates an HTTP handler at
+// `/api/v3/projects/{project_id}/clusters/{cluster_id}/nodepools/{node_pool_id}` on the test
+// handler mux that responds to a GET request with nodePoolResponse.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/api/v3/projects/c59fd21fd2a94963b822d8985b884673/clusters/%s/nodepools/%s", clusterID, nodePoolID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, nodePoolResponse)
+	})
+}
+
+// HandleScaleSuccessfully creates an HTTP handler at
+// `/api/v3/projects/{project_id}/clusters/{cluster_id}/nodepools/{node_pool_id}/operation/scale` on
+// the test handler mux that responds to a POST request.
+func HandleScaleSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/api/v3/projects/c59fd21fd2a94963b822d8985b884673/clusters/%s/nodepools/%s/operation/scale", clusterID, nodePoolID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, expectedScaleRequest)
+
+		w.WriteHeader(http.StatusOK)
+	})
+}
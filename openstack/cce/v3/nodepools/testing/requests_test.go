@@ -0,0 +1,101 @@
+package testing
+
+import (
+	"testing"
+
+	fake "github.com/opentelekomcloud/gophertelekomcloud/openstack/cce/v3/common"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/cce/v3/nodepools"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/cce/v3/nodes"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSuccessfully(t)
+
+	opts := nodepools.CreateOpts{
+		Kind:       "NodePool",
+		ApiVersion: "v3",
+		Metadata:   nodepools.CreateMetaData{Name: "nodepool-test"},
+		Spec: nodepools.CreateSpec{
+			Type: "vm",
+			NodeTemplate: nodes.Spec{
+				Flavor:      "s2.large.2",
+				Az:          "eu-de-01",
+				Os:          "EulerOS 2.9",
+				Login:       nodes.LoginSpec{SshKey: "my-keypair"},
+				RootVolume:  nodes.VolumeSpec{Size: 40, VolumeType: "SSD"},
+				DataVolumes: []nodes.VolumeSpec{{Size: 100, VolumeType: "SSD"}},
+				Count:       1,
+				K8sTags:     map[string]string{"app": "web"},
+				Taints: []nodes.TaintSpec{
+					{Key: "dedicated", Value: "web", Effect: "NoSchedule"},
+				},
+				Storage: &nodes.StorageSpec{
+					StorageSelectors: []nodes.StorageSelector{
+						{
+							Name:        "cceUse",
+							StorageType: "evs",
+							MatchLabels: &nodes.StorageMatchLabels{
+								Size:       "100",
+								VolumeType: "SSD",
+								Count:      "1",
+							},
+						},
+					},
+					StorageGroups: []nodes.StorageGroup{
+						{
+							Name:          "vgpaas",
+							CceManaged:    true,
+							SelectorNames: []string{"cceUse"},
+							VirtualSpaces: []nodes.VirtualSpace{
+								{Name: "kubernetes", Size: "10%", LVMConfig: &nodes.LVMConfig{LvType: "linear"}},
+								{Name: "runtime", Size: "90%", RuntimeConfig: &nodes.RuntimeConfig{LvType: "linear"}},
+							},
+						},
+					},
+				},
+			},
+			InitialNodeCount: 1,
+			Autoscaling: nodepools.AutoscalingSpec{
+				Enable:                true,
+				MinNodeCount:          1,
+				MaxNodeCount:          3,
+				ScaleDownCooldownTime: 10,
+				Priority:              1,
+			},
+		},
+	}
+	pool, err := nodepools.Create(fake.ServiceClient(), clusterID, opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, nodePoolID, pool.Metadata.Id)
+	th.AssertEquals(t, 3, pool.Spec.Autoscaling.MaxNodeCount)
+}
+
+func TestScale(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleScaleSuccessfully(t)
+
+	err := nodepools.Scale(fake.ServiceClient(), clusterID, nodePoolID, nodepools.ScaleOpts{
+		Kind:       "NodePool",
+		ApiVersion: "v3",
+		Spec: nodepools.ScaleSpec{
+			DesiredNodeCount: 2,
+			ScaleGroups:      []string{"default"},
+		},
+	}).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestWaitForNodeCount(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	err := nodepools.WaitForNodeCount(fake.ServiceClient(), clusterID, nodePoolID, 2, 5)
+	th.AssertNoErr(t, err)
+	err = nodepools.WaitForStatus(fake.ServiceClient(), clusterID, nodePoolID, "", 5)
+	th.AssertNoErr(t, err)
+}
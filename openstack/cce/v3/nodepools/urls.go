@@ -14,3 +14,7 @@ func rootURL(c *golangsdk.ServiceClient, clusterid string) string {
 func resourceURL(c *golangsdk.ServiceClient, clusterid, nodepoolid string) string {
 	return c.ServiceURL(rootPath, clusterid, resourcePath, nodepoolid)
 }
+
+func scaleURL(c *golangsdk.ServiceClient, clusterid, nodepoolid string) string {
+	return c.ServiceURL(rootPath, clusterid, resourcePath, nodepoolid, "operation", "scale")
+}
@@ -1,9 +1,11 @@
 package nodes
 
 import (
+	"fmt"
 	"reflect"
 
 	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/tags"
 )
 
 var RequestOpts = golangsdk.RequestOpts{
@@ -191,3 +193,239 @@ func GetJobDetails(c *golangsdk.ServiceClient, jobID string) (r GetResult) {
 	})
 	return
 }
+
+// ReinstallSpec describes how an existing ECS is (re)installed when it is added to a cluster, reset or migrated.
+type ReinstallSpec struct {
+	// The OS of the node
+	Os string `json:"os" required:"true"`
+	// Node login parameters
+	Login LoginSpec `json:"login" required:"true"`
+	// Node name
+	Name string `json:"name,omitempty"`
+	// Server configuration
+	ServerConfig *ServerConfig `json:"serverConfig,omitempty"`
+	// Volume management configuration
+	VolumeConfig *VolumeConfig `json:"volumeConfig,omitempty"`
+	// Kubernetes node configuration
+	K8sOptions *K8sOptions `json:"k8sOptions,omitempty"`
+	// Custom lifecycle scripts of the node
+	Lifecycle *Lifecycle `json:"lifecycle,omitempty"`
+}
+
+type ServerConfig struct {
+	// Tag of a VM, key value pair format
+	UserTags []tags.ResourceTag `json:"userTags,omitempty"`
+	// System disk parameter of the node
+	RootVolume *VolumeSpec `json:"rootVolume,omitempty"`
+}
+
+type VolumeConfig struct {
+	// Docker data disk configuration
+	LvmConfig string `json:"lvmConfig,omitempty"`
+	// Disk initialization configuration
+	Storage *StorageSpec `json:"storage,omitempty"`
+}
+
+type K8sOptions struct {
+	// Kubernetes node labels, key value pair format
+	Labels map[string]string `json:"labels,omitempty"`
+	// Taints of the node
+	Taints []TaintSpec `json:"taints,omitempty"`
+	// Maximum number of pods on the node
+	MaxPods int `json:"maxPods,omitempty"`
+}
+
+type Lifecycle struct {
+	// Script executed before the Kubernetes installation, base64 encoded
+	PreInstall string `json:"preInstall,omitempty"`
+	// Script executed after the Kubernetes installation, base64 encoded
+	PostInstall string `json:"postInstall,omitempty"`
+}
+
+// AddOptsBuilder allows extensions to add additional parameters to the
+// Add request.
+type AddOptsBuilder interface {
+	ToNodeAddMap() (map[string]interface{}, error)
+}
+
+// AddOpts contains the existing ECSs to be added to a cluster
+type AddOpts struct {
+	NodeList []AddNode `json:"nodeList" required:"true"`
+}
+
+type AddNode struct {
+	// ID of the ECS to add
+	ServerID string `json:"serverID" required:"true"`
+	// Installation parameters of the node
+	Spec ReinstallSpec `json:"spec" required:"true"`
+}
+
+// ToNodeAddMap builds a request body from AddOpts.
+func (opts AddOpts) ToNodeAddMap() (map[string]interface{}, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+	b["kind"] = "List"
+	b["apiVersion"] = "v3"
+	return b, nil
+}
+
+// Add accepts existing ECSs as nodes of the cluster. The ECSs are reinstalled.
+func Add(c *golangsdk.ServiceClient, clusterID string, opts AddOptsBuilder) (r JobResult) {
+	b, err := opts.ToNodeAddMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Post(addURL(c, clusterID), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes:     []int{200},
+		MoreHeaders: RequestOpts.MoreHeaders,
+	})
+	return
+}
+
+// ResetOptsBuilder allows extensions to add additional parameters to the
+// Reset request.
+type ResetOptsBuilder interface {
+	ToNodeResetMap() (map[string]interface{}, error)
+}
+
+// ResetOpts contains the cluster nodes to be reset
+type ResetOpts struct {
+	NodeList []ResetNode `json:"nodeList" required:"true"`
+}
+
+type ResetNode struct {
+	// ID of the node to reset
+	NodeID string `json:"nodeID" required:"true"`
+	// Installation parameters of the node
+	Spec ReinstallSpec `json:"spec" required:"true"`
+}
+
+// ToNodeResetMap builds a request body from ResetOpts.
+func (opts ResetOpts) ToNodeResetMap() (map[string]interface{}, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+	b["kind"] = "List"
+	b["apiVersion"] = "v3"
+	return b, nil
+}
+
+// Reset reinstalls the OS of the cluster nodes.
+func Reset(c *golangsdk.ServiceClient, clusterID string, opts ResetOptsBuilder) (r JobResult) {
+	b, err := opts.ToNodeResetMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Post(resetURL(c, clusterID), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes:     []int{200},
+		MoreHeaders: RequestOpts.MoreHeaders,
+	})
+	return
+}
+
+// RemoveOptsBuilder allows extensions to add additional parameters to the
+// Remove request.
+type RemoveOptsBuilder interface {
+	ToNodeRemoveMap() (map[string]interface{}, error)
+}
+
+// RemoveOpts contains the nodes to be removed from a cluster. The ECSs are kept and reinstalled.
+type RemoveOpts struct {
+	// Login parameters of the reinstalled ECSs
+	Login LoginSpec `json:"login" required:"true"`
+	// IDs of the nodes to remove
+	Nodes []NodeRef `json:"nodes" required:"true"`
+}
+
+type NodeRef struct {
+	// Node ID
+	ID string `json:"uid" required:"true"`
+}
+
+// ToNodeRemoveMap builds a request body from RemoveOpts.
+func (opts RemoveOpts) ToNodeRemoveMap() (map[string]interface{}, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "spec")
+	if err != nil {
+		return nil, err
+	}
+	b["kind"] = "RemoveNodesTask"
+	b["apiVersion"] = "v3"
+	return b, nil
+}
+
+// Remove removes nodes from the cluster without deleting the ECSs.
+func Remove(c *golangsdk.ServiceClient, clusterID string, opts RemoveOptsBuilder) (r TaskResult) {
+	b, err := opts.ToNodeRemoveMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Put(removeURL(c, clusterID), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes:     []int{200},
+		MoreHeaders: RequestOpts.MoreHeaders,
+	})
+	return
+}
+
+// MigrateOptsBuilder allows extensions to add additional parameters to the
+// Migrate request.
+type MigrateOptsBuilder interface {
+	ToNodeMigrateMap() (map[string]interface{}, error)
+}
+
+// MigrateOpts contains the nodes to be migrated to another cluster
+type MigrateOpts struct {
+	// The OS of the migrated nodes
+	Os string `json:"os" required:"true"`
+	// Login parameters of the migrated nodes
+	Login LoginSpec `json:"login" required:"true"`
+	// IDs of the nodes to migrate
+	Nodes []NodeRef `json:"nodes" required:"true"`
+}
+
+// ToNodeMigrateMap builds a request body from MigrateOpts.
+func (opts MigrateOpts) ToNodeMigrateMap() (map[string]interface{}, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "spec")
+	if err != nil {
+		return nil, err
+	}
+	b["kind"] = "MigrateNodesTask"
+	b["apiVersion"] = "v3"
+	return b, nil
+}
+
+// Migrate moves nodes of the cluster to the target cluster. The nodes are reinstalled.
+func Migrate(c *golangsdk.ServiceClient, clusterID, targetClusterID string, opts MigrateOptsBuilder) (r TaskResult) {
+	b, err := opts.ToNodeMigrateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Put(migrateURL(c, clusterID, targetClusterID), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes:     []int{200},
+		MoreHeaders: RequestOpts.MoreHeaders,
+	})
+	return
+}
+
+// WaitForJobSuccess waits until the job finishes. A failed job returns an error.
+func WaitForJobSuccess(c *golangsdk.ServiceClient, jobID string, timeoutSeconds int) error {
+	return golangsdk.WaitFor(timeoutSeconds, func() (bool, error) {
+		job, err := GetJobDetails(c, jobID).ExtractJob()
+		if err != nil {
+			return false, fmt.Errorf("error retrieving job status: %w", err)
+		}
+		switch job.Status.Phase {
+		case "Success":
+			return true, nil
+		case "Failed":
+			return false, fmt.Errorf("job %s failed: %s", jobID, job.Status.Reason)
+		}
+		return false, nil
+	})
+}
@@ -59,7 +59,7 @@ type Spec struct {
 	DataVolumes []VolumeSpec `json:"dataVolumes" required:"true"`
 	// Elastic IP parameters of the node
 	PublicIP PublicIPSpec `json:"publicIP,omitempty"`
-	// The billing mode of the node: the value is 0 (on demand)
+	// The billing mode of the node: 0 (on demand) or 1 (yearly/monthly)
 	BillingMode int `json:"billingMode,omitempty"`
 	// Number of nodes when creating in batch
 	Count int `json:"count" required:"true"`
@@ -75,6 +75,72 @@ type Spec struct {
 	K8sTags map[string]string `json:"k8sTags,omitempty"`
 	// taints to created nodes to configure anti-affinity
 	Taints []TaintSpec `json:"taints,omitempty"`
+	// Disk initialization configuration of the node
+	Storage *StorageSpec `json:"storage,omitempty"`
+}
+
+// StorageSpec describes how the node disks are initialized
+type StorageSpec struct {
+	// Disk selection, matched disks are managed according to the name of the selector
+	StorageSelectors []StorageSelector `json:"storageSelectors" required:"true"`
+	// A storage group consists of multiple storage devices
+	StorageGroups []StorageGroup `json:"storageGroups" required:"true"`
+}
+
+type StorageSelector struct {
+	// Selector name, used as the index of SelectorNames in StorageGroup
+	Name string `json:"name" required:"true"`
+	// Storage type, currently only `evs` and `local` are supported
+	StorageType string `json:"storageType" required:"true"`
+	// Matching field of an EVS volume
+	MatchLabels *StorageMatchLabels `json:"matchLabels,omitempty"`
+}
+
+type StorageMatchLabels struct {
+	// Matched disk size, disks of any size are matched if left empty
+	Size string `json:"size,omitempty"`
+	// EVS disk type
+	VolumeType string `json:"volumeType,omitempty"`
+	// Disk encryption identifier, `0` or `1`
+	MetadataEncrypted string `json:"metadataEncrypted,omitempty"`
+	// Customer master key ID of an encrypted disk
+	MetadataCmkid string `json:"metadataCmkid,omitempty"`
+	// Number of disks to be selected, all matched disks are selected if left empty
+	Count string `json:"count,omitempty"`
+}
+
+type StorageGroup struct {
+	// Name of a virtual storage group, must be unique
+	Name string `json:"name" required:"true"`
+	// Storage space for Kubernetes and runtime components, only one group can be CCE managed
+	CceManaged bool `json:"cceManaged,omitempty"`
+	// Corresponding to Name in StorageSelector
+	SelectorNames []string `json:"selectorNames" required:"true"`
+	// Detailed management of space configuration in a group
+	VirtualSpaces []VirtualSpace `json:"virtualSpaces" required:"true"`
+}
+
+type VirtualSpace struct {
+	// Virtual space name: `kubernetes`, `runtime` or `user`
+	Name string `json:"name" required:"true"`
+	// Size of a virtual space, only an integer percentage is supported, e.g. `90%`
+	Size string `json:"size" required:"true"`
+	// LVM configuration management, applicable to the `user` space
+	LVMConfig *LVMConfig `json:"lvmConfig,omitempty"`
+	// Runtime configuration, applicable to the `runtime` space
+	RuntimeConfig *RuntimeConfig `json:"runtimeConfig,omitempty"`
+}
+
+type LVMConfig struct {
+	// LVM write mode: `linear` or `striped`
+	LvType string `json:"lvType" required:"true"`
+	// Path to which the disk is attached
+	Path string `json:"path,omitempty"`
+}
+
+type RuntimeConfig struct {
+	// LVM write mode: `linear` or `striped`
+	LvType string `json:"lvType" required:"true"`
 }
 
 // NodeNicSpec spec of the node
@@ -164,6 +230,10 @@ type ExtendParam struct {
 	IsAutoRenew *bool `json:"isAutoRenew,omitempty"`
 	// Whether to deduct fees automatically.
 	IsAutoPay *bool `json:"isAutoPay,omitempty"`
+	// Subscription period type for yearly/monthly billing: `month` or `year`.
+	PeriodType string `json:"periodType,omitempty"`
+	// Number of subscription periods for yearly/monthly billing.
+	PeriodNum int `json:"periodNum,omitempty"`
 	// Available disk space of a single Docker container on the node using the device mapper.
 	DockerBaseSize int `json:"dockerBaseSize,omitempty"`
 	// ConfigMap of the Docker data disk.
@@ -302,3 +372,33 @@ type UpdateResult struct {
 type DeleteResult struct {
 	golangsdk.ErrResult
 }
+
+// JobResult represents the result of an add or reset operation. Call its Extract
+// method to get the ID of the job.
+type JobResult struct {
+	golangsdk.Result
+}
+
+func (r JobResult) Extract() (string, error) {
+	var s struct {
+		JobID string `json:"jobid"`
+	}
+	err := r.ExtractInto(&s)
+	return s.JobID, err
+}
+
+// TaskResult represents the result of a remove or migrate operation. Call its Extract
+// method to get the ID of the job.
+type TaskResult struct {
+	golangsdk.Result
+}
+
+func (r TaskResult) Extract() (string, error) {
+	var s struct {
+		Status struct {
+			JobID string `json:"jobID"`
+		} `json:"status"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Status.JobID, err
+}
@@ -263,3 +263,170 @@ func TestGetV3Job(t *testing.T) {
 	th.AssertDeepEquals(t, expected, actual)
 
 }
+
+func TestAddExistingNode(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/api/v3/projects/c59fd21fd2a94963b822d8985b884673/clusters/cec124c2-58f1-11e8-ad73-0255ac101926/nodes/add", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `
+{
+    "kind": "List",
+    "apiVersion": "v3",
+    "nodeList": [
+        {
+            "serverID": "a7b2f1b4-3c8b-4d43-8a3b-3f3e3c9a6d1e",
+            "spec": {
+                "os": "EulerOS 2.9",
+                "login": {
+                    "sshKey": "my-keypair",
+                    "userPassword": {
+                        "username": "",
+                        "password": ""
+                    }
+                },
+                "k8sOptions": {
+                    "labels": {
+                        "app": "web"
+                    },
+                    "maxPods": 64
+                }
+            }
+        }
+    ]
+}
+`)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, `{"jobid": "73ce03fd-8b1b-11e8-8f9d-0255ac10193f"}`)
+	})
+
+	jobID, err := nodes.Add(fake.ServiceClient(), "cec124c2-58f1-11e8-ad73-0255ac101926", nodes.AddOpts{
+		NodeList: []nodes.AddNode{
+			{
+				ServerID: "a7b2f1b4-3c8b-4d43-8a3b-3f3e3c9a6d1e",
+				Spec: nodes.ReinstallSpec{
+					Os:    "EulerOS 2.9",
+					Login: nodes.LoginSpec{SshKey: "my-keypair"},
+					K8sOptions: &nodes.K8sOptions{
+						Labels:  map[string]string{"app": "web"},
+						MaxPods: 64,
+					},
+				},
+			},
+		},
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "73ce03fd-8b1b-11e8-8f9d-0255ac10193f", jobID)
+}
+
+func TestResetNode(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/api/v3/projects/c59fd21fd2a94963b822d8985b884673/clusters/cec124c2-58f1-11e8-ad73-0255ac101926/nodes/reset", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `
+{
+    "kind": "List",
+    "apiVersion": "v3",
+    "nodeList": [
+        {
+            "nodeID": "cf4bc001-58f1-11e8-ad73-0255ac101926",
+            "spec": {
+                "os": "EulerOS 2.9",
+                "login": {
+                    "sshKey": "my-keypair",
+                    "userPassword": {
+                        "username": "",
+                        "password": ""
+                    }
+                }
+            }
+        }
+    ]
+}
+`)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, `{"jobid": "73ce03fd-8b1b-11e8-8f9d-0255ac10193f"}`)
+	})
+
+	jobID, err := nodes.Reset(fake.ServiceClient(), "cec124c2-58f1-11e8-ad73-0255ac101926", nodes.ResetOpts{
+		NodeList: []nodes.ResetNode{
+			{
+				NodeID: "cf4bc001-58f1-11e8-ad73-0255ac101926",
+				Spec: nodes.ReinstallSpec{
+					Os:    "EulerOS 2.9",
+					Login: nodes.LoginSpec{SshKey: "my-keypair"},
+				},
+			},
+		},
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "73ce03fd-8b1b-11e8-8f9d-0255ac10193f", jobID)
+}
+
+func TestRemoveNode(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/api/v3/projects/c59fd21fd2a94963b822d8985b884673/clusters/cec124c2-58f1-11e8-ad73-0255ac101926/nodes/operation/remove", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `
+{
+    "kind": "RemoveNodesTask",
+    "apiVersion": "v3",
+    "spec": {
+        "login": {
+            "sshKey": "my-keypair",
+            "userPassword": {
+                "username": "",
+                "password": ""
+            }
+        },
+        "nodes": [
+            {
+                "uid": "cf4bc001-58f1-11e8-ad73-0255ac101926"
+            }
+        ]
+    }
+}
+`)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, `{"kind": "RemoveNodesTask", "apiVersion": "v3", "status": {"jobID": "73ce03fd-8b1b-11e8-8f9d-0255ac10193f"}}`)
+	})
+
+	jobID, err := nodes.Remove(fake.ServiceClient(), "cec124c2-58f1-11e8-ad73-0255ac101926", nodes.RemoveOpts{
+		Login: nodes.LoginSpec{SshKey: "my-keypair"},
+		Nodes: []nodes.NodeRef{{ID: "cf4bc001-58f1-11e8-ad73-0255ac101926"}},
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "73ce03fd-8b1b-11e8-8f9d-0255ac10193f", jobID)
+}
+
+func TestMigrateNode(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/api/v3/projects/c59fd21fd2a94963b822d8985b884673/clusters/cec124c2-58f1-11e8-ad73-0255ac101926/nodes/operation/migrateto/daa97872-59d7-11e8-a787-0255ac101f54", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, `{"kind": "MigrateNodesTask", "apiVersion": "v3", "status": {"jobID": "73ce03fd-8b1b-11e8-8f9d-0255ac10193f"}}`)
+	})
+
+	jobID, err := nodes.Migrate(fake.ServiceClient(), "cec124c2-58f1-11e8-ad73-0255ac101926", "daa97872-59d7-11e8-a787-0255ac101f54", nodes.MigrateOpts{
+		Os:    "EulerOS 2.9",
+		Login: nodes.LoginSpec{SshKey: "my-keypair"},
+		Nodes: []nodes.NodeRef{{ID: "cf4bc001-58f1-11e8-ad73-0255ac101926"}},
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "73ce03fd-8b1b-11e8-8f9d-0255ac10193f", jobID)
+}
@@ -18,3 +18,19 @@ func resourceURL(c *golangsdk.ServiceClient, clusterid, nodeid string) string {
 func getJobURL(c *golangsdk.ServiceClient, jobid string) string {
 	return c.ServiceURL("jobs", jobid)
 }
+
+func addURL(c *golangsdk.ServiceClient, clusterid string) string {
+	return c.ServiceURL(rootPath, clusterid, resourcePath, "add")
+}
+
+func resetURL(c *golangsdk.ServiceClient, clusterid string) string {
+	return c.ServiceURL(rootPath, clusterid, resourcePath, "reset")
+}
+
+func removeURL(c *golangsdk.ServiceClient, clusterid string) string {
+	return c.ServiceURL(rootPath, clusterid, resourcePath, "operation", "remove")
+}
+
+func migrateURL(c *golangsdk.ServiceClient, clusterid, targetClusterID string) string {
+	return c.ServiceURL(rootPath, clusterid, resourcePath, "operation", "migrateto", targetClusterID)
+}
@@ -0,0 +1,36 @@
+/*
+Package upgrades enables the upgrade of CCE clusters and their node pools.
+
+Example to Check and Upgrade a cluster
+
+	clusterID := "4e8e5957-649f-477b-9e5b-f1f75b21c03c"
+
+	check, err := upgrades.PreCheck(client, clusterID, upgrades.PreCheckOpts{
+		ClusterVersion: "v1.23",
+		TargetVersion:  "v1.25",
+	}).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	err = upgrades.WaitForPreCheck(client, clusterID, check.Metadata.UID, 600)
+	if err != nil {
+		panic(err)
+	}
+
+	taskID, err := upgrades.Upgrade(client, clusterID, upgrades.UpgradeOpts{
+		ClusterUpgradeAction: upgrades.ClusterUpgradeAction{
+			TargetVersion: "v1.25",
+			Strategy:      upgrades.UpgradeStrategy{Type: "inPlaceRollingUpdate"},
+		},
+	}).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	err = upgrades.WaitForTaskCompleted(client, clusterID, taskID, 3600)
+	if err != nil {
+		panic(err)
+	}
+*/
+package upgrades
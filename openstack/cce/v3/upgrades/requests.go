@@ -0,0 +1,211 @@
+package upgrades
+
+import (
+	"fmt"
+
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+var RequestOpts = golangsdk.RequestOpts{
+	MoreHeaders: map[string]string{"Content-Type": "application/json"},
+}
+
+// PreCheckOptsBuilder allows extensions to add additional parameters to the
+// PreCheck request.
+type PreCheckOptsBuilder interface {
+	ToPreCheckMap() (map[string]interface{}, error)
+}
+
+// PreCheckOpts contains all the values needed to check whether a cluster can be upgraded
+type PreCheckOpts struct {
+	// Current cluster version, e.g. `v1.23`
+	ClusterVersion string `json:"clusterVersion" required:"true"`
+	// Target cluster version, e.g. `v1.25`
+	TargetVersion string `json:"targetVersion" required:"true"`
+	// Check items to skip
+	SkippedCheckItemList []SkippedCheckItem `json:"skippedCheckItemList,omitempty"`
+}
+
+type SkippedCheckItem struct {
+	// Name of the check item
+	Name string `json:"name" required:"true"`
+	// Resource selector of the check item
+	ResourceSelector *ResourceSelector `json:"resourceSelector,omitempty"`
+}
+
+type ResourceSelector struct {
+	// Label key
+	Key string `json:"key" required:"true"`
+	// Label values
+	Values []string `json:"values,omitempty"`
+	// Selector operator, currently only `In` is supported
+	Operator string `json:"operator" required:"true"`
+}
+
+// ToPreCheckMap builds a request body from PreCheckOpts.
+func (opts PreCheckOpts) ToPreCheckMap() (map[string]interface{}, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "spec")
+	if err != nil {
+		return nil, err
+	}
+	b["kind"] = "PreCheckTask"
+	b["apiVersion"] = "v3"
+	return b, nil
+}
+
+// PreCheck starts a pre-upgrade check of the cluster.
+func PreCheck(c *golangsdk.ServiceClient, clusterID string, opts PreCheckOptsBuilder) (r PreCheckResult) {
+	b, err := opts.ToPreCheckMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Post(preCheckURL(c, clusterID), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes:     []int{200},
+		MoreHeaders: RequestOpts.MoreHeaders,
+	})
+	return
+}
+
+// GetPreCheck retrieves a pre-upgrade check task of the cluster.
+func GetPreCheck(c *golangsdk.ServiceClient, clusterID, taskID string) (r PreCheckResult) {
+	_, r.Err = c.Get(preCheckTaskURL(c, clusterID, taskID), &r.Body, &golangsdk.RequestOpts{
+		OkCodes:     []int{200},
+		MoreHeaders: RequestOpts.MoreHeaders,
+	})
+	return
+}
+
+// UpgradeOptsBuilder allows extensions to add additional parameters to the
+// Upgrade request.
+type UpgradeOptsBuilder interface {
+	ToUpgradeMap() (map[string]interface{}, error)
+}
+
+// UpgradeOpts contains all the values needed to upgrade a cluster
+type UpgradeOpts struct {
+	// Cluster upgrade parameters
+	ClusterUpgradeAction ClusterUpgradeAction `json:"clusterUpgradeAction" required:"true"`
+}
+
+type ClusterUpgradeAction struct {
+	// Current cluster version, e.g. `v1.23`
+	Version string `json:"version,omitempty"`
+	// Target cluster version, e.g. `v1.25`
+	TargetVersion string `json:"targetVersion" required:"true"`
+	// Upgrade strategy
+	Strategy UpgradeStrategy `json:"strategy" required:"true"`
+	// Add-ons to be upgraded together with the cluster
+	Addons []UpgradeAddon `json:"addons,omitempty"`
+	// Upgrade priority of the node pools, node pool ID to priority. Pools with a higher priority are upgraded first.
+	NodePoolOrder map[string]int `json:"nodePoolOrder,omitempty"`
+	// Upgrade priority of the nodes within node pools, node pool ID to the node priorities
+	NodeOrder map[string][]NodePriority `json:"nodeOrder,omitempty"`
+}
+
+type UpgradeStrategy struct {
+	// Upgrade strategy type, currently only `inPlaceRollingUpdate` is supported
+	Type string `json:"type" required:"true"`
+	// In-place upgrade settings
+	InPlaceRollingUpdate *InPlaceRollingUpdate `json:"inPlaceRollingUpdate,omitempty"`
+}
+
+type InPlaceRollingUpdate struct {
+	// Number of nodes upgraded in one batch, from 1 to 40
+	UserDefinedStep int `json:"userDefinedStep,omitempty"`
+}
+
+type UpgradeAddon struct {
+	// Add-on template name
+	AddonTemplateName string `json:"addonTemplateName" required:"true"`
+	// Target add-on version
+	Version string `json:"version" required:"true"`
+	// Add-on parameters
+	Values map[string]interface{} `json:"values,omitempty"`
+}
+
+type NodePriority struct {
+	// Node selector
+	NodeSelector ResourceSelector `json:"nodeSelector" required:"true"`
+	// Upgrade priority of the selected nodes
+	Priority int `json:"priority" required:"true"`
+}
+
+// ToUpgradeMap builds a request body from UpgradeOpts.
+func (opts UpgradeOpts) ToUpgradeMap() (map[string]interface{}, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "spec")
+	if err != nil {
+		return nil, err
+	}
+	b["metadata"] = map[string]string{
+		"kind":       "UpgradeTask",
+		"apiVersion": "v3",
+	}
+	return b, nil
+}
+
+// Upgrade starts the upgrade of the cluster and its node pools.
+func Upgrade(c *golangsdk.ServiceClient, clusterID string, opts UpgradeOptsBuilder) (r UpgradeResult) {
+	b, err := opts.ToUpgradeMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Post(upgradeURL(c, clusterID), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes:     []int{200},
+		MoreHeaders: RequestOpts.MoreHeaders,
+	})
+	return
+}
+
+// GetTask retrieves an upgrade task of the cluster.
+func GetTask(c *golangsdk.ServiceClient, clusterID, taskID string) (r GetTaskResult) {
+	_, r.Err = c.Get(upgradeTaskURL(c, clusterID, taskID), &r.Body, &golangsdk.RequestOpts{
+		OkCodes:     []int{200},
+		MoreHeaders: RequestOpts.MoreHeaders,
+	})
+	return
+}
+
+// ListTasks returns the upgrade tasks of the cluster.
+func ListTasks(c *golangsdk.ServiceClient, clusterID string) (r ListTasksResult) {
+	_, r.Err = c.Get(upgradeTasksURL(c, clusterID), &r.Body, &golangsdk.RequestOpts{
+		OkCodes:     []int{200},
+		MoreHeaders: RequestOpts.MoreHeaders,
+	})
+	return
+}
+
+// WaitForPreCheck waits until the pre-upgrade check finishes. A failed check returns an error.
+func WaitForPreCheck(c *golangsdk.ServiceClient, clusterID, taskID string, timeoutSeconds int) error {
+	return golangsdk.WaitFor(timeoutSeconds, func() (bool, error) {
+		task, err := GetPreCheck(c, clusterID, taskID).Extract()
+		if err != nil {
+			return false, fmt.Errorf("error retrieving pre-check status: %w", err)
+		}
+		switch task.Status.Phase {
+		case PhaseSuccess:
+			return true, nil
+		case PhaseFailed:
+			return false, fmt.Errorf("cluster pre-check failed: %s", task.Status.Message)
+		}
+		return false, nil
+	})
+}
+
+// WaitForTaskCompleted waits until the upgrade task finishes. A failed upgrade returns an error.
+func WaitForTaskCompleted(c *golangsdk.ServiceClient, clusterID, taskID string, timeoutSeconds int) error {
+	return golangsdk.WaitFor(timeoutSeconds, func() (bool, error) {
+		task, err := GetTask(c, clusterID, taskID).Extract()
+		if err != nil {
+			return false, fmt.Errorf("error retrieving upgrade task status: %w", err)
+		}
+		switch task.Status.Phase {
+		case PhaseSuccess:
+			return true, nil
+		case PhaseFailed:
+			return false, fmt.Errorf("cluster upgrade failed: %s", task.Status.Message)
+		}
+		return false, nil
+	})
+}
@@ -0,0 +1,149 @@
+package upgrades
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+const (
+	PhaseInit    = "Init"
+	PhaseQueuing = "Queuing"
+	PhaseRunning = "Running"
+	PhasePause   = "Pause"
+	PhaseSuccess = "Success"
+	PhaseFailed  = "Failed"
+)
+
+type TaskMetadata struct {
+	// Task ID
+	UID string `json:"uid"`
+	// Task creation time
+	CreationTimestamp string `json:"creationTimestamp"`
+	// Task update time
+	UpdateTimestamp string `json:"updateTimestamp"`
+}
+
+// PreCheckTask is a pre-upgrade check of a cluster
+type PreCheckTask struct {
+	// API type, fixed value PreCheckTask
+	Kind string `json:"kind"`
+	// API version, fixed value v3
+	ApiVersion string `json:"apiVersion"`
+	// Task metadata
+	Metadata TaskMetadata `json:"metadata"`
+	// Check parameters
+	Spec PreCheckOpts `json:"spec"`
+	// Check status
+	Status PreCheckStatus `json:"status"`
+}
+
+type PreCheckStatus struct {
+	// Check state: Init, Running, Success or Failed
+	Phase string `json:"phase"`
+	// Check details
+	Message string `json:"message"`
+	// Check expiration time, the cluster must be upgraded before it
+	ExpireTimestamp string `json:"expireTimeStamp"`
+	// Cluster check results
+	ClusterCheckStatus *CheckStatus `json:"clusterCheckStatus"`
+}
+
+type CheckStatus struct {
+	// Check state
+	Phase string `json:"phase"`
+	// Check item results
+	ItemsStatus []CheckItemStatus `json:"itemsStatus"`
+}
+
+type CheckItemStatus struct {
+	// Check item name
+	Name string `json:"name"`
+	// Check item kind
+	Kind string `json:"kind"`
+	// Check item group
+	Group string `json:"group"`
+	// Check item level: Info, Warning or Fatal
+	Level string `json:"level"`
+	// Check item state
+	Phase string `json:"phase"`
+	// Check item details
+	Message string `json:"message"`
+	// Troubleshooting link
+	Router string `json:"router"`
+}
+
+// UpgradeTask is an upgrade of a cluster
+type UpgradeTask struct {
+	// Task metadata
+	Metadata TaskMetadata `json:"metadata"`
+	// Upgrade details
+	Spec UpgradeTaskSpec `json:"spec"`
+	// Upgrade status
+	Status UpgradeTaskStatus `json:"status"`
+}
+
+type UpgradeTaskSpec struct {
+	// Version before the upgrade
+	Version string `json:"version"`
+	// Target version
+	TargetVersion string `json:"targetVersion"`
+	// Upgrade items
+	Items interface{} `json:"items"`
+}
+
+type UpgradeTaskStatus struct {
+	// Upgrade state: Init, Queuing, Running, Pause, Success or Failed
+	Phase string `json:"phase"`
+	// Upgrade progress in percent
+	Progress string `json:"progress"`
+	// Upgrade completion time
+	CompletionTime string `json:"completionTime"`
+	// Upgrade details
+	Message string `json:"message"`
+}
+
+// PreCheckResult represents the result of a pre-check or get pre-check operation.
+type PreCheckResult struct {
+	golangsdk.Result
+}
+
+func (r PreCheckResult) Extract() (*PreCheckTask, error) {
+	var s PreCheckTask
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// UpgradeResult represents the result of an upgrade operation. Call its Extract
+// method to get the ID of the upgrade task.
+type UpgradeResult struct {
+	golangsdk.Result
+}
+
+func (r UpgradeResult) Extract() (string, error) {
+	var s struct {
+		Metadata TaskMetadata `json:"metadata"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Metadata.UID, err
+}
+
+// GetTaskResult represents the result of a get upgrade task operation.
+type GetTaskResult struct {
+	golangsdk.Result
+}
+
+func (r GetTaskResult) Extract() (*UpgradeTask, error) {
+	var s UpgradeTask
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// ListTasksResult represents the result of a list upgrade tasks operation.
+type ListTasksResult struct {
+	golangsdk.Result
+}
+
+func (r ListTasksResult) Extract() ([]UpgradeTask, error) {
+	var s []UpgradeTask
+	err := r.ExtractIntoSlicePtr(&s, "items")
+	return s, err
+}
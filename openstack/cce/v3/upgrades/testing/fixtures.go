@@ -0,0 +1,151 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/opentelekomcloud/gophertelekomcloud/openstack/cce/v3/common"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+const (
+	clusterID = "cec124c2-58f1-11e8-ad73-0255ac101926"
+	taskID    = "a1b2c3d4-58f1-11e8-ad73-0255ac101926"
+	poolID    = "c2d3e4f5-58f1-11e8-ad73-0255ac101926"
+	baseURL   = "/api/v3/projects/c59fd21fd2a94963b822d8985b884673/clusters/" + clusterID + "/operation"
+)
+
+const expectedPreCheckRequest = `
+{
+  "kind": "PreCheckTask",
+  "apiVersion": "v3",
+  "spec": {
+    "clusterVersion": "v1.23",
+    "targetVersion": "v1.25"
+  }
+}
+`
+
+var preCheckResponse = fmt.Sprintf(`
+{
+  "kind": "PreCheckTask",
+  "apiVersion": "v3",
+  "metadata": {
+    "uid": "%s"
+  },
+  "spec": {
+    "clusterVersion": "v1.23",
+    "targetVersion": "v1.25"
+  },
+  "status": {
+    "phase": "Success",
+    "expireTimeStamp": "2023-06-02T08:00:00Z",
+    "clusterCheckStatus": {
+      "phase": "Success",
+      "itemsStatus": [
+        {
+          "name": "NodeStatus",
+          "kind": "Cluster",
+          "group": "LimitCheck",
+          "level": "Info",
+          "phase": "Success"
+        }
+      ]
+    }
+  }
+}
+`, taskID)
+
+var expectedUpgradeRequest = fmt.Sprintf(`
+{
+  "metadata": {
+    "kind": "UpgradeTask",
+    "apiVersion": "v3"
+  },
+  "spec": {
+    "clusterUpgradeAction": {
+      "targetVersion": "v1.25",
+      "strategy": {
+        "type": "inPlaceRollingUpdate",
+        "inPlaceRollingUpdate": {
+          "userDefinedStep": 20
+        }
+      },
+      "nodePoolOrder": {
+        "%s": 1
+      }
+    }
+  }
+}
+`, poolID)
+
+var upgradeTaskBody = fmt.Sprintf(`
+{
+  "metadata": {
+    "uid": "%s",
+    "creationTimestamp": "2023-06-01T08:00:00Z"
+  },
+  "spec": {
+    "version": "v1.23",
+    "targetVersion": "v1.25"
+  },
+  "status": {
+    "phase": "Success",
+    "progress": "100",
+    "completionTime": "2023-06-01T09:00:00Z"
+  }
+}
+`, taskID)
+
+// HandlePreCheckSuccessfully creates HTTP handlers at `/precheck` and `/precheck/tasks/{task_id}`
+// of the cluster on the test handler mux, the pre-check task is successful.
+func HandlePreCheckSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(baseURL+"/precheck", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, expectedPreCheckRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, preCheckResponse)
+	})
+	th.Mux.HandleFunc(baseURL+"/precheck/tasks/"+taskID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, preCheckResponse)
+	})
+}
+
+// HandleUpgradeSuccessfully creates HTTP handlers at `/upgrade`, `/upgrade/tasks` and
+// `/upgrade/tasks/{task_id}` of the cluster on the test handler mux.
+func HandleUpgradeSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(baseURL+"/upgrade", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, expectedUpgradeRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, `{"metadata": {"uid": "%s"}, "spec": {}}`, taskID)
+	})
+	th.Mux.HandleFunc(baseURL+"/upgrade/tasks", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, `{"kind": "List", "apiVersion": "v3", "items": [%s]}`, upgradeTaskBody)
+	})
+	th.Mux.HandleFunc(baseURL+"/upgrade/tasks/"+taskID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, upgradeTaskBody)
+	})
+}
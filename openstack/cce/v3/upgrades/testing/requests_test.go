@@ -0,0 +1,55 @@
+package testing
+
+import (
+	"testing"
+
+	fake "github.com/opentelekomcloud/gophertelekomcloud/openstack/cce/v3/common"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/cce/v3/upgrades"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestPreCheck(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandlePreCheckSuccessfully(t)
+
+	task, err := upgrades.PreCheck(fake.ServiceClient(), clusterID, upgrades.PreCheckOpts{
+		ClusterVersion: "v1.23",
+		TargetVersion:  "v1.25",
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, taskID, task.Metadata.UID)
+	th.AssertEquals(t, "NodeStatus", task.Status.ClusterCheckStatus.ItemsStatus[0].Name)
+
+	th.AssertNoErr(t, upgrades.WaitForPreCheck(fake.ServiceClient(), clusterID, taskID, 5))
+}
+
+func TestUpgrade(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpgradeSuccessfully(t)
+
+	id, err := upgrades.Upgrade(fake.ServiceClient(), clusterID, upgrades.UpgradeOpts{
+		ClusterUpgradeAction: upgrades.ClusterUpgradeAction{
+			TargetVersion: "v1.25",
+			Strategy: upgrades.UpgradeStrategy{
+				Type:                 "inPlaceRollingUpdate",
+				InPlaceRollingUpdate: &upgrades.InPlaceRollingUpdate{UserDefinedStep: 20},
+			},
+			NodePoolOrder: map[string]int{poolID: 1},
+		},
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, taskID, id)
+
+	task, err := upgrades.GetTask(fake.ServiceClient(), clusterID, taskID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, upgrades.PhaseSuccess, task.Status.Phase)
+	th.AssertEquals(t, "v1.25", task.Spec.TargetVersion)
+
+	tasks, err := upgrades.ListTasks(fake.ServiceClient(), clusterID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(tasks))
+
+	th.AssertNoErr(t, upgrades.WaitForTaskCompleted(fake.ServiceClient(), clusterID, taskID, 5))
+}
@@ -0,0 +1,29 @@
+package upgrades
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+const (
+	rootPath      = "clusters"
+	operationPath = "operation"
+	tasksPath     = "tasks"
+)
+
+func preCheckURL(c *golangsdk.ServiceClient, clusterID string) string {
+	return c.ServiceURL(rootPath, clusterID, operationPath, "precheck")
+}
+
+func preCheckTaskURL(c *golangsdk.ServiceClient, clusterID, taskID string) string {
+	return c.ServiceURL(rootPath, clusterID, operationPath, "precheck", tasksPath, taskID)
+}
+
+func upgradeURL(c *golangsdk.ServiceClient, clusterID string) string {
+	return c.ServiceURL(rootPath, clusterID, operationPath, "upgrade")
+}
+
+func upgradeTasksURL(c *golangsdk.ServiceClient, clusterID string) string {
+	return c.ServiceURL(rootPath, clusterID, operationPath, "upgrade", tasksPath)
+}
+
+func upgradeTaskURL(c *golangsdk.ServiceClient, clusterID, taskID string) string {
+	return c.ServiceURL(rootPath, clusterID, operationPath, "upgrade", tasksPath, taskID)
+}
@@ -0,0 +1,188 @@
+package domains
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// UpdateOrigin replaces the origin servers of the domain.
+func UpdateOrigin(client *golangsdk.ServiceClient, id string, sources []Source) (r OriginResult) {
+	b, err := golangsdk.BuildRequestBody(struct {
+		Sources []Source `json:"sources" required:"true"`
+	}{sources}, "origin")
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(actionURL(client, id, "origin"), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// HTTPS statuses.
+const (
+	HTTPSDisabled = 0
+	HTTPSEnabled  = 2
+)
+
+// HTTPSOptsBuilder allows extensions to add additional parameters to the UpdateHTTPS request.
+type HTTPSOptsBuilder interface {
+	ToHTTPSUpdateMap() (map[string]interface{}, error)
+}
+
+// HTTPSOpts contains the HTTPS certificate settings of the domain.
+type HTTPSOpts struct {
+	CertName string `json:"cert_name,omitempty"`
+	// HTTPSStatus is either HTTPSEnabled or HTTPSDisabled.
+	HTTPSStatus *int `json:"https_status" required:"true"`
+	// Certificate is the PEM encoded certificate chain.
+	Certificate string `json:"certificate,omitempty"`
+	// PrivateKey is the PEM encoded private key.
+	PrivateKey string `json:"private_key,omitempty"`
+	// ForceRedirectHTTPS is 1 to redirect HTTP requests to HTTPS.
+	ForceRedirectHTTPS *int `json:"force_redirect_https,omitempty"`
+	// HTTP2 is 1 to enable HTTP/2.
+	HTTP2 *int `json:"http2,omitempty"`
+}
+
+// ToHTTPSUpdateMap builds a request body from HTTPSOpts.
+func (opts HTTPSOpts) ToHTTPSUpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "https")
+}
+
+// UpdateHTTPS configures the HTTPS certificate of the domain.
+func UpdateHTTPS(client *golangsdk.ServiceClient, id string, opts HTTPSOptsBuilder) (r HTTPSResult) {
+	b, err := opts.ToHTTPSUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(actionURL(client, id, "https-info"), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// GetHTTPS retrieves the HTTPS settings of the domain.
+func GetHTTPS(client *golangsdk.ServiceClient, id string) (r HTTPSResult) {
+	_, r.Err = client.Get(actionURL(client, id, "https-info"), &r.Body, nil)
+	return
+}
+
+// Cache rule types.
+const (
+	CacheRuleAll       = 0
+	CacheRuleExtension = 1
+	CacheRuleDirectory = 2
+	CacheRulePath      = 3
+)
+
+// Cache TTL units.
+const (
+	TTLSeconds = 1
+	TTLMinutes = 2
+	TTLHours   = 3
+	TTLDays    = 4
+)
+
+// CacheRule defines how long matching content is cached.
+type CacheRule struct {
+	// RuleType is one of the CacheRule* constants.
+	RuleType int `json:"rule_type"`
+	// Content are the matched extensions or paths separated with semicolons, e.g. ".jpg;.png".
+	Content string `json:"content,omitempty"`
+	TTL     int    `json:"ttl"`
+	// TTLType is one of the TTL* constants.
+	TTLType int `json:"ttl_type" required:"true"`
+	// Priority of the rule, a higher value takes precedence.
+	Priority int `json:"priority"`
+}
+
+// CacheConfig contains the cache rules of the domain.
+type CacheConfig struct {
+	// IgnoreURLParameter ignores URL query parameters when caching.
+	IgnoreURLParameter bool        `json:"ignore_url_parameter"`
+	Rules              []CacheRule `json:"rules"`
+}
+
+// UpdateCache replaces the cache rules of the domain.
+func UpdateCache(client *golangsdk.ServiceClient, id string, opts CacheConfig) (r CacheResult) {
+	b, err := golangsdk.BuildRequestBody(opts, "cache_config")
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(actionURL(client, id, "cache"), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// GetCache retrieves the cache rules of the domain.
+func GetCache(client *golangsdk.ServiceClient, id string) (r CacheResult) {
+	_, r.Err = client.Get(actionURL(client, id, "cache"), &r.Body, nil)
+	return
+}
+
+// Referer and IP ACL types.
+const (
+	ACLDisabled  = 0
+	ACLBlacklist = 1
+	ACLWhitelist = 2
+)
+
+// Referer is the referer validation of the domain.
+type Referer struct {
+	// RefererType is one of the ACL* constants.
+	RefererType int `json:"referer_type"`
+	// RefererList are domain names or IPs separated with semicolons.
+	RefererList string `json:"referer_list,omitempty"`
+	// IncludeEmpty allows requests with an empty referer.
+	IncludeEmpty *bool `json:"include_empty,omitempty"`
+}
+
+// UpdateReferer configures the referer validation of the domain.
+func UpdateReferer(client *golangsdk.ServiceClient, id string, opts Referer) (r RefererResult) {
+	b, err := golangsdk.BuildRequestBody(opts, "referer")
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(actionURL(client, id, "referer"), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// GetReferer retrieves the referer validation of the domain.
+func GetReferer(client *golangsdk.ServiceClient, id string) (r RefererResult) {
+	_, r.Err = client.Get(actionURL(client, id, "referer"), &r.Body, nil)
+	return
+}
+
+// IPACL is the IP address blacklist or whitelist of the domain.
+type IPACL struct {
+	// Type is one of the ACL* constants.
+	Type int `json:"type"`
+	// IPList are IPs or CIDRs.
+	IPList []string `json:"ip_list,omitempty"`
+}
+
+// UpdateIPACL configures the IP address blacklist or whitelist of the domain.
+func UpdateIPACL(client *golangsdk.ServiceClient, id string, opts IPACL) (r IPACLResult) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(actionURL(client, id, "ip-acl"), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// GetIPACL retrieves the IP address blacklist or whitelist of the domain.
+func GetIPACL(client *golangsdk.ServiceClient, id string) (r IPACLResult) {
+	_, r.Err = client.Get(actionURL(client, id, "ip-acl"), &r.Body, nil)
+	return
+}
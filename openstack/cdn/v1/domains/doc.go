@@ -0,0 +1,70 @@
+/*
+Package domains manages the accelerated domains of the CDN service.
+
+Example to Add a Domain
+
+	domain, err := domains.Create(cdnClient, domains.CreateOpts{
+		DomainName:   "www.example.com",
+		BusinessType: domains.BusinessTypeWeb,
+		Sources: []domains.Source{
+			{
+				IPOrDomain:    "origin.example.com",
+				OriginType:    domains.OriginTypeDomain,
+				ActiveStandby: 1,
+			},
+		},
+	}).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	err = domains.WaitForStatus(cdnClient, domain.ID, domains.StatusOnline, 600)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Configure the Cache Rules
+
+	_, err := domains.UpdateCache(cdnClient, domainID, domains.CacheConfig{
+		Rules: []domains.CacheRule{
+			{
+				RuleType: domains.CacheRuleExtension,
+				Content:  ".jpg;.png",
+				TTL:      7,
+				TTLType:  domains.TTLDays,
+				Priority: 2,
+			},
+		},
+	}).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Allow Only Some Referers
+
+	_, err := domains.UpdateReferer(cdnClient, domainID, domains.Referer{
+		RefererType: domains.ACLWhitelist,
+		RefererList: "www.example.com;example.com",
+	}).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Remove a Domain
+
+	_, err := domains.Disable(cdnClient, domainID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	err = domains.WaitForStatus(cdnClient, domainID, domains.StatusOffline, 600)
+	if err != nil {
+		panic(err)
+	}
+
+	_, err = domains.Delete(cdnClient, domainID, nil).Extract()
+	if err != nil {
+		panic(err)
+	}
+*/
+package domains
@@ -0,0 +1,196 @@
+package domains
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// Business types of an accelerated domain.
+const (
+	BusinessTypeWeb       = "web"
+	BusinessTypeDownload  = "download"
+	BusinessTypeVideo     = "video"
+	BusinessTypeWholeSite = "wholeSite"
+)
+
+// Origin server types.
+const (
+	OriginTypeIP        = "ipaddr"
+	OriginTypeDomain    = "domain"
+	OriginTypeOBSBucket = "obs_bucket"
+)
+
+// Service areas of an accelerated domain.
+const (
+	ServiceAreaMainlandChina        = "mainland_china"
+	ServiceAreaOutsideMainlandChina = "outside_mainland_china"
+	ServiceAreaGlobal               = "global"
+)
+
+// Source is an origin server of the domain.
+type Source struct {
+	// IPOrDomain is the IP address or domain name of the origin server.
+	IPOrDomain string `json:"ip_or_domain" required:"true"`
+	// OriginType is one of the OriginType* constants.
+	OriginType string `json:"origin_type" required:"true"`
+	// ActiveStandby is 1 for the primary and 0 for the standby origin server.
+	ActiveStandby int `json:"active_standby"`
+}
+
+// EnterpriseProjectOpts scopes a request to an enterprise project.
+type EnterpriseProjectOpts struct {
+	EnterpriseProjectID string `q:"enterprise_project_id"`
+}
+
+func (opts EnterpriseProjectOpts) query() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), nil
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the Create request.
+type CreateOptsBuilder interface {
+	ToDomainCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts contains the options to add an accelerated domain.
+type CreateOpts struct {
+	DomainName string `json:"domain_name" required:"true"`
+	// BusinessType is one of the BusinessType* constants.
+	BusinessType string   `json:"business_type" required:"true"`
+	Sources      []Source `json:"sources" required:"true"`
+	// ServiceArea is one of the ServiceArea* constants.
+	ServiceArea         string `json:"service_area,omitempty"`
+	EnterpriseProjectID string `json:"enterprise_project_id,omitempty"`
+}
+
+// ToDomainCreateMap builds a create request body from CreateOpts.
+func (opts CreateOpts) ToDomainCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "domain")
+}
+
+// Create adds an accelerated domain. Use WaitForStatus to wait until the domain is online.
+func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToDomainCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(rootURL(client), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Get retrieves the details of an accelerated domain.
+func Get(client *golangsdk.ServiceClient, id string, opts *EnterpriseProjectOpts) (r GetResult) {
+	url := detailURL(client, id)
+	if opts != nil {
+		query, err := opts.query()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += query
+	}
+	_, r.Err = client.Get(url, &r.Body, nil)
+	return
+}
+
+// ListOptsBuilder allows extensions to add additional parameters to the List request.
+type ListOptsBuilder interface {
+	ToDomainListQuery() (string, error)
+}
+
+// ListOpts allows to filter the accelerated domains.
+type ListOpts struct {
+	DomainName          string `q:"domain_name"`
+	BusinessType        string `q:"business_type"`
+	DomainStatus        string `q:"domain_status"`
+	PageSize            int    `q:"page_size"`
+	PageNumber          int    `q:"page_number"`
+	EnterpriseProjectID string `q:"enterprise_project_id"`
+}
+
+// ToDomainListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToDomainListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), err
+}
+
+// List returns the accelerated domains.
+func List(client *golangsdk.ServiceClient, opts ListOptsBuilder) (r ListResult) {
+	url := rootURL(client)
+	if opts != nil {
+		query, err := opts.ToDomainListQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += query
+	}
+	_, r.Err = client.Get(url, &r.Body, nil)
+	return
+}
+
+// Delete removes an accelerated domain, the domain must be disabled first.
+func Delete(client *golangsdk.ServiceClient, id string, opts *EnterpriseProjectOpts) (r DeleteResult) {
+	url := resourceURL(client, id)
+	if opts != nil {
+		query, err := opts.query()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += query
+	}
+	_, r.Err = client.DeleteWithResponse(url, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Enable enables the acceleration of a disabled domain.
+func Enable(client *golangsdk.ServiceClient, id string) (r ActionResult) {
+	_, r.Err = client.Put(actionURL(client, id, "enable"), nil, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Disable disables the acceleration of a domain.
+func Disable(client *golangsdk.ServiceClient, id string) (r ActionResult) {
+	_, r.Err = client.Put(actionURL(client, id, "disable"), nil, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// WaitForStatus waits for the domain to reach the given status, e.g. StatusOnline.
+func WaitForStatus(client *golangsdk.ServiceClient, id, status string, secs int) error {
+	return golangsdk.WaitFor(secs, func() (bool, error) {
+		domain, err := Get(client, id, nil).Extract()
+		if err != nil {
+			return false, err
+		}
+		return domain.DomainStatus == status, nil
+	})
+}
+
+// WaitForDeleted waits for the domain to be removed.
+func WaitForDeleted(client *golangsdk.ServiceClient, id string, secs int) error {
+	return golangsdk.WaitFor(secs, func() (bool, error) {
+		_, err := Get(client, id, nil).Extract()
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return true, nil
+			}
+			return false, err
+		}
+		return false, nil
+	})
+}
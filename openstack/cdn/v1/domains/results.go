@@ -0,0 +1,182 @@
+package domains
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// Domain statuses.
+const (
+	StatusOnline          = "online"
+	StatusOffline         = "offline"
+	StatusConfiguring     = "configuring"
+	StatusConfigureFailed = "configure_failed"
+	StatusChecking        = "checking"
+	StatusCheckFailed     = "check_failed"
+	StatusDeleting        = "deleting"
+)
+
+// Domain represents an accelerated CDN domain.
+type Domain struct {
+	ID                  string      `json:"id"`
+	DomainName          string      `json:"domain_name"`
+	BusinessType        string      `json:"business_type"`
+	UserDomainID        string      `json:"user_domain_id"`
+	DomainStatus        string      `json:"domain_status"`
+	CName               string      `json:"cname"`
+	Sources             []Source    `json:"sources"`
+	DomainOriginHost    *OriginHost `json:"domain_origin_host"`
+	HTTPSStatus         *int        `json:"https_status"`
+	CreateTime          int64       `json:"create_time"`
+	ModifyTime          int64       `json:"modify_time"`
+	Disabled            *int        `json:"disabled"`
+	Locked              *int        `json:"locked"`
+	ServiceArea         string      `json:"service_area"`
+	EnterpriseProjectID string      `json:"enterprise_project_id"`
+}
+
+// OriginHost is the host header sent to the origin server.
+type OriginHost struct {
+	DomainID        string `json:"domain_id"`
+	OriginHostType  string `json:"origin_host_type"`
+	CustomizeDomain string `json:"customize_domain"`
+}
+
+type commonResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as a Domain.
+func (r commonResult) Extract() (*Domain, error) {
+	s := new(Domain)
+	err := r.ExtractIntoStructPtr(s, "domain")
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// CreateResult is the result of a Create request.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult is the result of a Get request.
+type GetResult struct {
+	commonResult
+}
+
+// DeleteResult is the result of a Delete request.
+type DeleteResult struct {
+	commonResult
+}
+
+// ActionResult is the result of Enable and Disable requests.
+type ActionResult struct {
+	commonResult
+}
+
+// ListDomains is the response of a List request.
+type ListDomains struct {
+	Total   int      `json:"total"`
+	Domains []Domain `json:"domains"`
+}
+
+// ListResult is the result of a List request.
+type ListResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as ListDomains.
+func (r ListResult) Extract() (*ListDomains, error) {
+	s := new(ListDomains)
+	err := r.ExtractInto(s)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// OriginResult is the result of an UpdateOrigin request.
+type OriginResult struct {
+	golangsdk.Result
+}
+
+// Extract returns the origin servers of the domain.
+func (r OriginResult) Extract() ([]Source, error) {
+	var s struct {
+		Origin struct {
+			Sources []Source `json:"sources"`
+		} `json:"origin"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Origin.Sources, err
+}
+
+// HTTPS are the HTTPS settings of the domain.
+type HTTPS struct {
+	CertName           string `json:"cert_name"`
+	HTTPSStatus        int    `json:"https_status"`
+	Certificate        string `json:"certificate"`
+	ForceRedirectHTTPS int    `json:"force_redirect_https"`
+	HTTP2              int    `json:"http2"`
+}
+
+// HTTPSResult is the result of UpdateHTTPS and GetHTTPS requests.
+type HTTPSResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as HTTPS.
+func (r HTTPSResult) Extract() (*HTTPS, error) {
+	s := new(HTTPS)
+	err := r.ExtractIntoStructPtr(s, "https")
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// CacheResult is the result of UpdateCache and GetCache requests.
+type CacheResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as CacheConfig.
+func (r CacheResult) Extract() (*CacheConfig, error) {
+	s := new(CacheConfig)
+	err := r.ExtractIntoStructPtr(s, "cache_config")
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// RefererResult is the result of UpdateReferer and GetReferer requests.
+type RefererResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as Referer.
+func (r RefererResult) Extract() (*Referer, error) {
+	s := new(Referer)
+	err := r.ExtractIntoStructPtr(s, "referer")
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// IPACLResult is the result of UpdateIPACL and GetIPACL requests.
+type IPACLResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as IPACL.
+func (r IPACLResult) Extract() (*IPACL, error) {
+	s := new(IPACL)
+	err := r.ExtractInto(s)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
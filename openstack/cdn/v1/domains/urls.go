@@ -0,0 +1,21 @@
+package domains
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+const rootPath = "cdn/domains"
+
+func rootURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL(rootPath)
+}
+
+func resourceURL(c *golangsdk.ServiceClient, id string) string {
+	return c.ServiceURL(rootPath, id)
+}
+
+func detailURL(c *golangsdk.ServiceClient, id string) string {
+	return c.ServiceURL(rootPath, id, "detail")
+}
+
+func actionURL(c *golangsdk.ServiceClient, id, action string) string {
+	return c.ServiceURL(rootPath, id, action)
+}
@@ -0,0 +1,17 @@
+/*
+Package statistics retrieves the traffic statistics of the CDN domains.
+
+Example to Get the Traffic of the Last Day
+
+	end := time.Now()
+	summary, err := statistics.GetSummary(cdnClient, statistics.QueryOpts{
+		StartTime:  int(end.Add(-24*time.Hour).UnixNano() / int64(time.Millisecond)),
+		EndTime:    int(end.UnixNano() / int64(time.Millisecond)),
+		DomainName: "ALL",
+		StatType:   statistics.StatFlux,
+	}).Extract()
+	if err != nil {
+		panic(err)
+	}
+*/
+package statistics
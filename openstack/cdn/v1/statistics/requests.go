@@ -0,0 +1,70 @@
+package statistics
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// Statistic types.
+const (
+	StatFlux            = "flux"
+	StatBandwidth       = "bw"
+	StatRequests        = "req_num"
+	StatHitRequests     = "req_hit_rate"
+	StatHitFlux         = "flux_hit_rate"
+	StatOriginFlux      = "bs_flux"
+	StatOriginBandwidth = "bs_bw"
+	StatHTTPCode2xx     = "http_code_2xx"
+	StatHTTPCode3xx     = "http_code_3xx"
+	StatHTTPCode4xx     = "http_code_4xx"
+	StatHTTPCode5xx     = "http_code_5xx"
+)
+
+// QueryOptsBuilder allows extensions to add additional parameters to the statistics requests.
+type QueryOptsBuilder interface {
+	ToStatisticsQuery() (string, error)
+}
+
+// QueryOpts contains the time range and the domains of the statistics.
+type QueryOpts struct {
+	// StartTime and EndTime are timestamps in milliseconds.
+	StartTime int `q:"start_time,required"`
+	EndTime   int `q:"end_time,required"`
+	// DomainName are domain names separated with commas, or "ALL".
+	DomainName string `q:"domain_name"`
+	// StatType is one of the Stat* constants.
+	StatType string `q:"stat_type"`
+	// ServiceArea is either "mainland_china" or "outside_mainland_china".
+	ServiceArea         string `q:"service_area"`
+	EnterpriseProjectID string `q:"enterprise_project_id"`
+}
+
+// ToStatisticsQuery formats a QueryOpts into a query string.
+func (opts QueryOpts) ToStatisticsQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), err
+}
+
+// GetSummary retrieves the total value of a statistic type in the time range.
+func GetSummary(client *golangsdk.ServiceClient, opts QueryOptsBuilder) (r SummaryResult) {
+	query, err := opts.ToStatisticsQuery()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Get(summaryURL(client)+query, &r.Body, nil)
+	return
+}
+
+// GetItemDetails retrieves the values of a statistic type in the time range, per domain and interval.
+func GetItemDetails(client *golangsdk.ServiceClient, opts QueryOptsBuilder) (r ItemDetailsResult) {
+	query, err := opts.ToStatisticsQuery()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Get(itemDetailsURL(client)+query, &r.Body, nil)
+	return
+}
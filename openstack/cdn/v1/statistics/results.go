@@ -0,0 +1,64 @@
+package statistics
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// Summary is the total value of a statistic type.
+type Summary struct {
+	StartTime   int64  `json:"start_time"`
+	EndTime     int64  `json:"end_time"`
+	DomainName  string `json:"domain_name"`
+	StatType    string `json:"stat_type"`
+	ServiceArea string `json:"service_area"`
+	Value       int64  `json:"value"`
+}
+
+// SummaryResult is the result of a GetSummary request.
+type SummaryResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as a Summary.
+func (r SummaryResult) Extract() (*Summary, error) {
+	s := new(Summary)
+	err := r.ExtractIntoStructPtr(s, "domain_summary")
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// DomainItemDetail are the values of a statistic type of a single domain.
+type DomainItemDetail struct {
+	DomainName string  `json:"domain_name"`
+	StatType   string  `json:"stat_type"`
+	StartTime  int64   `json:"start_time"`
+	EndTime    int64   `json:"end_time"`
+	Interval   int64   `json:"interval"`
+	Values     []int64 `json:"values"`
+}
+
+// ItemDetails are the values of a statistic type per domain.
+type ItemDetails struct {
+	StartTime         int64              `json:"start_time"`
+	EndTime           int64              `json:"end_time"`
+	Interval          int64              `json:"interval"`
+	StatType          string             `json:"stat_type"`
+	DomainItemDetails []DomainItemDetail `json:"domain_item_details"`
+}
+
+// ItemDetailsResult is the result of a GetItemDetails request.
+type ItemDetailsResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as ItemDetails.
+func (r ItemDetailsResult) Extract() (*ItemDetails, error) {
+	s := new(ItemDetails)
+	err := r.ExtractIntoStructPtr(s, "domain_item_details")
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
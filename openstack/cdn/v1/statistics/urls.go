@@ -0,0 +1,11 @@
+package statistics
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+func summaryURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("cdn", "statistics", "domain-summary")
+}
+
+func itemDetailsURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("cdn", "statistics", "domain-item-details")
+}
@@ -0,0 +1,28 @@
+/*
+Package tasks refreshes and preheats the CDN cache.
+
+Example to Refresh a Directory
+
+	task, err := tasks.Refresh(cdnClient, tasks.RefreshOpts{
+		Type: tasks.RefreshDirectory,
+		URLs: []string{"https://www.example.com/static/"},
+	}).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	err = tasks.WaitForTask(cdnClient, task.ID, 300)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Preheat Files
+
+	task, err := tasks.Preheat(cdnClient, tasks.PreheatOpts{
+		URLs: []string{"https://www.example.com/video.mp4"},
+	}).Extract()
+	if err != nil {
+		panic(err)
+	}
+*/
+package tasks
@@ -0,0 +1,142 @@
+package tasks
+
+import (
+	"fmt"
+
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// Refresh task types.
+const (
+	RefreshFile      = "file"
+	RefreshDirectory = "directory"
+)
+
+// RefreshOptsBuilder allows extensions to add additional parameters to the Refresh request.
+type RefreshOptsBuilder interface {
+	ToRefreshMap() (map[string]interface{}, error)
+}
+
+// RefreshOpts contains the URLs to purge from the CDN cache.
+type RefreshOpts struct {
+	// Type is either RefreshFile or RefreshDirectory, defaults to RefreshFile.
+	Type string `json:"type,omitempty"`
+	// URLs must start with http:// or https://, directories must end with a slash.
+	URLs []string `json:"urls" required:"true"`
+}
+
+// ToRefreshMap builds a request body from RefreshOpts.
+func (opts RefreshOpts) ToRefreshMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "refreshTask")
+}
+
+// Refresh creates a cache refresh task.
+func Refresh(client *golangsdk.ServiceClient, opts RefreshOptsBuilder) (r RefreshResult) {
+	b, err := opts.ToRefreshMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(refreshURL(client), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// PreheatOptsBuilder allows extensions to add additional parameters to the Preheat request.
+type PreheatOptsBuilder interface {
+	ToPreheatMap() (map[string]interface{}, error)
+}
+
+// PreheatOpts contains the URLs to load from the origin server into the CDN cache.
+type PreheatOpts struct {
+	URLs []string `json:"urls" required:"true"`
+}
+
+// ToPreheatMap builds a request body from PreheatOpts.
+func (opts PreheatOpts) ToPreheatMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "preheatingTask")
+}
+
+// Preheat creates a cache preheating task.
+func Preheat(client *golangsdk.ServiceClient, opts PreheatOptsBuilder) (r PreheatResult) {
+	b, err := opts.ToPreheatMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(preheatURL(client), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// ListOptsBuilder allows extensions to add additional parameters to the List request.
+type ListOptsBuilder interface {
+	ToTaskListQuery() (string, error)
+}
+
+// ListOpts allows to filter the refresh and preheating tasks.
+type ListOpts struct {
+	PageSize   int `q:"page_size"`
+	PageNumber int `q:"page_number"`
+	// Status is either "task_inprocess" or "task_done".
+	Status string `q:"status"`
+	// StartDate and EndDate are timestamps in milliseconds.
+	StartDate int `q:"start_date"`
+	EndDate   int `q:"end_date"`
+	// OrderField is e.g. "task_type", "total", "processing", "succeed", "failed" or "create_time".
+	OrderField string `q:"order_field"`
+	// OrderType is either "asc" or "desc".
+	OrderType string `q:"order_type"`
+	// FileType is either RefreshFile or RefreshDirectory.
+	FileType            string `q:"file_type"`
+	EnterpriseProjectID string `q:"enterprise_project_id"`
+}
+
+// ToTaskListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToTaskListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), err
+}
+
+// List returns the refresh and preheating tasks.
+func List(client *golangsdk.ServiceClient, opts ListOptsBuilder) (r ListResult) {
+	url := historyURL(client)
+	if opts != nil {
+		query, err := opts.ToTaskListQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += query
+	}
+	_, r.Err = client.Get(url, &r.Body, nil)
+	return
+}
+
+// Get retrieves the details of a task, including the status of each URL.
+func Get(client *golangsdk.ServiceClient, id string) (r GetResult) {
+	_, r.Err = client.Get(detailURL(client, id), &r.Body, nil)
+	return
+}
+
+// WaitForTask waits for the task to finish and returns an error if any of the URLs failed.
+func WaitForTask(client *golangsdk.ServiceClient, id string, secs int) error {
+	return golangsdk.WaitFor(secs, func() (bool, error) {
+		task, err := Get(client, id).Extract()
+		if err != nil {
+			return false, err
+		}
+		if task.Status != StatusDone {
+			return false, nil
+		}
+		if task.Failed > 0 {
+			return false, fmt.Errorf("task %s finished with %d failed URLs", id, task.Failed)
+		}
+		return true, nil
+	})
+}
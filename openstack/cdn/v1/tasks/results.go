@@ -0,0 +1,113 @@
+package tasks
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// Task statuses.
+const (
+	StatusInProcess = "task_inprocess"
+	StatusDone      = "task_done"
+)
+
+// CreatedTask is the response of Refresh and Preheat requests.
+type CreatedTask struct {
+	ID   string   `json:"id"`
+	Type string   `json:"type"`
+	URLs []string `json:"urls"`
+}
+
+// RefreshResult is the result of a Refresh request.
+type RefreshResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as a CreatedTask.
+func (r RefreshResult) Extract() (*CreatedTask, error) {
+	s := new(CreatedTask)
+	err := r.ExtractIntoStructPtr(s, "refreshTask")
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// PreheatResult is the result of a Preheat request.
+type PreheatResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as a CreatedTask.
+func (r PreheatResult) Extract() (*CreatedTask, error) {
+	s := new(CreatedTask)
+	err := r.ExtractIntoStructPtr(s, "preheatingTask")
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Task is a refresh or preheating task.
+type Task struct {
+	ID         string `json:"id"`
+	TaskType   string `json:"task_type"`
+	Status     string `json:"status"`
+	Processing int    `json:"processing"`
+	Succeed    int    `json:"succeed"`
+	Failed     int    `json:"failed"`
+	Total      int    `json:"total"`
+	CreateTime int64  `json:"create_time"`
+	FileType   string `json:"file_type"`
+}
+
+// ListTasks is the response of a List request.
+type ListTasks struct {
+	Total int    `json:"total"`
+	Tasks []Task `json:"tasks"`
+}
+
+// ListResult is the result of a List request.
+type ListResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as ListTasks.
+func (r ListResult) Extract() (*ListTasks, error) {
+	s := new(ListTasks)
+	err := r.ExtractInto(s)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// URL is the status of a single task URL.
+type URL struct {
+	ID         int64  `json:"id"`
+	URL        string `json:"url"`
+	Status     string `json:"status"`
+	Type       string `json:"type"`
+	TaskID     int64  `json:"task_id"`
+	CreateTime int64  `json:"create_time"`
+}
+
+// TaskDetail is a task with the statuses of its URLs.
+type TaskDetail struct {
+	Task
+	URLs []URL `json:"urls"`
+}
+
+// GetResult is the result of a Get request.
+type GetResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as a TaskDetail.
+func (r GetResult) Extract() (*TaskDetail, error) {
+	s := new(TaskDetail)
+	err := r.ExtractInto(s)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
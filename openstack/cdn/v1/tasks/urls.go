@@ -0,0 +1,19 @@
+package tasks
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+func refreshURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("cdn", "refreshtasks")
+}
+
+func preheatURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("cdn", "preheatingtasks")
+}
+
+func historyURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("cdn", "historytasks")
+}
+
+func detailURL(c *golangsdk.ServiceClient, id string) string {
+	return c.ServiceURL("cdn", "historytasks", id, "detail")
+}
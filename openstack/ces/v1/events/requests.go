@@ -0,0 +1,175 @@
+package events
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToEventsListQuery() (string, error)
+}
+
+// ListOpts allows the filtering of the event list.
+type ListOpts struct {
+	// Specifies the event type, either `EVENT.SYS` or `EVENT.CUSTOM`.
+	EventType string `q:"event_type"`
+
+	// Specifies the event name.
+	EventName string `q:"event_name"`
+
+	// Specifies the start and the end time of the query, UNIX timestamps in milliseconds.
+	From string `q:"from"`
+	To   string `q:"to"`
+
+	// Specifies the paging start value.
+	Start int `q:"start"`
+
+	// The value ranges from 1 to 100, and is 100 by default.
+	Limit int `q:"limit"`
+}
+
+// ToEventsListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToEventsListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), err
+}
+
+// List returns the events grouped by event name.
+func List(client *golangsdk.ServiceClient, opts ListOptsBuilder) (r ListResult) {
+	url := eventsURL(client)
+	if opts != nil {
+		q, err := opts.ToEventsListQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += q
+	}
+	_, r.Err = client.Get(url, &r.Body, nil)
+	return
+}
+
+// GetOptsBuilder allows extensions to add additional parameters to the
+// Get request.
+type GetOptsBuilder interface {
+	ToEventGetQuery() (string, error)
+}
+
+// GetOpts allows the filtering of the event occurrences.
+type GetOpts struct {
+	// Specifies the event type, either `EVENT.SYS` or `EVENT.CUSTOM`.
+	EventType string `q:"event_type,required"`
+
+	// Specifies the event source, e.g. `SYS.ECS`.
+	EventSource string `q:"event_source"`
+
+	// Specifies the event severity: `Critical`, `Major`, `Minor` or `Info`.
+	EventLevel string `q:"event_level"`
+
+	// Specifies the name of the user who reported the event.
+	EventUser string `q:"event_user"`
+
+	// Specifies the event state: `normal`, `warning` or `incident`.
+	EventState string `q:"event_state"`
+
+	// Specifies the start and the end time of the query, UNIX timestamps in milliseconds.
+	From string `q:"from"`
+	To   string `q:"to"`
+
+	Start int `q:"start"`
+	Limit int `q:"limit"`
+}
+
+// ToEventGetQuery formats a GetOpts into a query string.
+func (opts GetOpts) ToEventGetQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), err
+}
+
+// Get returns the occurrences of the event with the given name.
+func Get(client *golangsdk.ServiceClient, name string, opts GetOptsBuilder) (r GetResult) {
+	url := eventURL(client, name)
+	if opts != nil {
+		q, err := opts.ToEventGetQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += q
+	}
+	_, r.Err = client.Get(url, &r.Body, nil)
+	return
+}
+
+// EventItem represents a single custom event to be reported.
+type EventItem struct {
+	// Specifies the event name, starting with a letter, 1 to 64 characters.
+	EventName string `json:"event_name" required:"true"`
+
+	// Specifies the event source in the `service.item` format.
+	EventSource string `json:"event_source" required:"true"`
+
+	// Specifies the time when the event occurred, UNIX timestamp in milliseconds.
+	Time int64 `json:"time" required:"true"`
+
+	// Specifies the event details.
+	Detail EventDetailOpts `json:"detail" required:"true"`
+}
+
+// EventDetailOpts represents the details of the custom event.
+type EventDetailOpts struct {
+	Content      string `json:"content,omitempty"`
+	GroupID      string `json:"group_id,omitempty"`
+	ResourceID   string `json:"resource_id,omitempty"`
+	ResourceName string `json:"resource_name,omitempty"`
+	// Specifies the event state: `normal`, `warning` or `incident`.
+	EventState string `json:"event_state,omitempty"`
+	// Specifies the event severity: `Critical`, `Major`, `Minor` or `Info`.
+	EventLevel string `json:"event_level,omitempty"`
+	EventUser  string `json:"event_user,omitempty"`
+}
+
+func (opts EventItem) ToMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// CreateOpts is a list of custom events reported at once.
+type CreateOpts []EventItem
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToEventsCreateMap() ([]map[string]interface{}, error)
+}
+
+func (opts CreateOpts) ToEventsCreateMap() ([]map[string]interface{}, error) {
+	newOpts := make([]map[string]interface{}, len(opts))
+	for i, opt := range opts {
+		opt, err := opt.ToMap()
+		if err != nil {
+			return nil, err
+		}
+		newOpts[i] = opt
+	}
+	return newOpts, nil
+}
+
+// Create reports custom events to Cloud Eye.
+func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToEventsCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(eventsURL(client), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
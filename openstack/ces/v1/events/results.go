@@ -0,0 +1,92 @@
+package events
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+type MetaData struct {
+	Count  int `json:"count"`
+	Total  int `json:"total"`
+	Marker struct {
+		Start string `json:"start"`
+	} `json:"marker"`
+}
+
+// Event is an event type summary returned by List.
+type Event struct {
+	EventName   string `json:"event_name"`
+	EventType   string `json:"event_type"`
+	EventSource string `json:"event_source"`
+	// Specifies the number of the event occurrences.
+	EventCount int `json:"event_count"`
+	// Specifies the time of the last occurrence, UNIX timestamp in milliseconds.
+	LatestOccurTime   int64  `json:"latest_occur_time"`
+	LatestEventSource string `json:"latest_event_source"`
+}
+
+type ListResult struct {
+	golangsdk.Result
+}
+
+type ListResponse struct {
+	Events   []Event  `json:"events"`
+	MetaData MetaData `json:"meta_data"`
+}
+
+func (r ListResult) Extract() (*ListResponse, error) {
+	var s ListResponse
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+type EventDetail struct {
+	Content      string `json:"content"`
+	GroupID      string `json:"group_id"`
+	ResourceID   string `json:"resource_id"`
+	ResourceName string `json:"resource_name"`
+	EventState   string `json:"event_state"`
+	EventLevel   string `json:"event_level"`
+	EventUser    string `json:"event_user"`
+}
+
+// EventInfo is a single occurrence of the event.
+type EventInfo struct {
+	EventID     string      `json:"event_id"`
+	EventName   string      `json:"event_name"`
+	EventSource string      `json:"event_source"`
+	Time        int64       `json:"time"`
+	Detail      EventDetail `json:"detail"`
+}
+
+type GetResult struct {
+	golangsdk.Result
+}
+
+type GetResponse struct {
+	EventName string      `json:"event_name"`
+	EventType string      `json:"event_type"`
+	EventInfo []EventInfo `json:"event_info"`
+	MetaData  MetaData    `json:"meta_data"`
+}
+
+func (r GetResult) Extract() (*GetResponse, error) {
+	var s GetResponse
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// CreatedEvent contains the ID assigned to the reported event.
+type CreatedEvent struct {
+	EventID   string `json:"event_id"`
+	EventName string `json:"event_name"`
+}
+
+type CreateResult struct {
+	golangsdk.Result
+}
+
+func (r CreateResult) Extract() ([]CreatedEvent, error) {
+	var s []CreatedEvent
+	err := r.ExtractIntoSlicePtr(&s, "")
+	return s, err
+}
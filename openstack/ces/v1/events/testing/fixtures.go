@@ -0,0 +1,118 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const eventName = "delete_server"
+
+const listResponse = `
+{
+  "events": [
+    {
+      "event_name": "delete_server",
+      "event_type": "EVENT.SYS",
+      "event_count": 3,
+      "latest_occur_time": 1606179318000,
+      "latest_event_source": "SYS.ECS"
+    }
+  ],
+  "meta_data": {
+    "count": 1,
+    "total": 1,
+    "marker": {
+      "start": "1"
+    }
+  }
+}`
+
+const getResponse = `
+{
+  "event_name": "delete_server",
+  "event_type": "EVENT.SYS",
+  "event_info": [
+    {
+      "event_id": "ev16061793187502Kq2a9bOm",
+      "event_name": "delete_server",
+      "event_source": "SYS.ECS",
+      "time": 1606179318000,
+      "detail": {
+        "content": "",
+        "resource_id": "07814c0e-59a1-4fcd-a6fb-56f2f6923046",
+        "resource_name": "ecs-test",
+        "event_state": "incident",
+        "event_level": "Major",
+        "event_user": "user"
+      }
+    }
+  ],
+  "meta_data": {
+    "count": 1,
+    "total": 1,
+    "marker": {
+      "start": "1"
+    }
+  }
+}`
+
+const expectedCreateRequest = `
+[
+  {
+    "event_name": "custom_event",
+    "event_source": "APP.backend",
+    "time": 1606179318000,
+    "detail": {
+      "content": "backend restarted",
+      "resource_id": "07814c0e-59a1-4fcd-a6fb-56f2f6923046",
+      "event_state": "warning",
+      "event_level": "Minor"
+    }
+  }
+]`
+
+const createResponse = `
+[
+  {
+    "event_id": "evcustom16061793187502Kq",
+    "event_name": "custom_event"
+  }
+]`
+
+// HandleEventsSuccessfully creates an HTTP handler at `/events` on the test handler mux that
+// responds to GET and POST requests.
+func HandleEventsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/events", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+		switch r.Method {
+		case "GET":
+			th.TestFormValues(t, r, map[string]string{"event_type": "EVENT.SYS", "limit": "10"})
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprint(w, listResponse)
+		case "POST":
+			th.TestJSONRequest(t, r, expectedCreateRequest)
+			w.WriteHeader(http.StatusCreated)
+			_, _ = fmt.Fprint(w, createResponse)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+}
+
+// HandleGetSuccessfully creates an HTTP handler at `/event/{event_name}` on the test handler mux
+// that responds to a GET request with getResponse.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/event/%s", eventName), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"event_type": "EVENT.SYS", "event_source": "SYS.ECS"})
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, getResponse)
+	})
+}
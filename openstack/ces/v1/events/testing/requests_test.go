@@ -0,0 +1,73 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/ces/v1/events"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleEventsSuccessfully(t)
+
+	list, err := events.List(fake.ServiceClient(), events.ListOpts{
+		EventType: "EVENT.SYS",
+		Limit:     10,
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(list.Events))
+	th.AssertEquals(t, eventName, list.Events[0].EventName)
+	th.AssertEquals(t, 3, list.Events[0].EventCount)
+	th.AssertEquals(t, int64(1606179318000), list.Events[0].LatestOccurTime)
+	th.AssertEquals(t, "1", list.MetaData.Marker.Start)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	event, err := events.Get(fake.ServiceClient(), eventName, events.GetOpts{
+		EventType:   "EVENT.SYS",
+		EventSource: "SYS.ECS",
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, eventName, event.EventName)
+	th.AssertEquals(t, 1, len(event.EventInfo))
+	th.AssertEquals(t, "ecs-test", event.EventInfo[0].Detail.ResourceName)
+	th.AssertEquals(t, "incident", event.EventInfo[0].Detail.EventState)
+}
+
+func TestGetRequiresEventType(t *testing.T) {
+	_, err := events.Get(fake.ServiceClient(), eventName, events.GetOpts{}).Extract()
+	if err == nil {
+		t.Fatal("expected an error for missing event type")
+	}
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleEventsSuccessfully(t)
+
+	opts := events.CreateOpts{
+		{
+			EventName:   "custom_event",
+			EventSource: "APP.backend",
+			Time:        1606179318000,
+			Detail: events.EventDetailOpts{
+				Content:    "backend restarted",
+				ResourceID: "07814c0e-59a1-4fcd-a6fb-56f2f6923046",
+				EventState: "warning",
+				EventLevel: "Minor",
+			},
+		},
+	}
+	created, err := events.Create(fake.ServiceClient(), opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(created))
+	th.AssertEquals(t, "evcustom16061793187502Kq", created[0].EventID)
+}
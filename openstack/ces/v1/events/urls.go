@@ -0,0 +1,11 @@
+package events
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+func eventsURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("events")
+}
+
+func eventURL(c *golangsdk.ServiceClient, name string) string {
+	return c.ServiceURL("event", name)
+}
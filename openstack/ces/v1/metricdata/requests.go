@@ -4,6 +4,25 @@ import (
 	"github.com/opentelekomcloud/gophertelekomcloud"
 )
 
+// Data rollup methods used as Filter of the queries.
+const (
+	FilterAverage  = "average"
+	FilterMax      = "max"
+	FilterMin      = "min"
+	FilterSum      = "sum"
+	FilterVariance = "variance"
+)
+
+// Data monitoring granularities used as Period of the queries.
+const (
+	PeriodRaw       = "1"
+	Period5Minutes  = "300"
+	Period20Minutes = "1200"
+	Period1Hour     = "3600"
+	Period4Hours    = "14400"
+	Period1Day      = "86400"
+)
+
 // BatchQueryOptsBuilder allows extensions to add additional parameters to the
 // BatchQuery request.
 type BatchQueryOptsBuilder interface {
@@ -34,10 +53,10 @@ type BatchQueryOpts struct {
 	// Specifies the end time of the query.
 	To int64 `json:"to" required:"true"`
 
-	// Specifies the data monitoring granularity.
+	// Specifies the data monitoring granularity, see Period* constants.
 	Period string `json:"period" required:"true"`
 
-	// Specifies the data rollup method.
+	// Specifies the data rollup method, see Filter* constants.
 	Filter string `json:"filter" required:"true"`
 }
 
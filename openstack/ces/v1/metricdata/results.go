@@ -26,11 +26,21 @@ type Dimension struct {
 	Value string `json:"value"`
 }
 
+// Data is a single datapoint of the batch query, only the value of the requested rollup filter is set
 type Data struct {
 	Average   float64 `json:"average"`
+	Max       float64 `json:"max"`
+	Min       float64 `json:"min"`
+	Sum       float64 `json:"sum"`
+	Variance  float64 `json:"variance"`
 	Timestamp int     `json:"timestamp"`
 }
 
+// Value returns the datapoint value of the given rollup filter
+func (d Data) Value(filter string) float64 {
+	return pickValue(filter, d.Average, d.Max, d.Min, d.Sum, d.Variance)
+}
+
 type MetricDatasResult struct {
 	golangsdk.Result
 }
@@ -47,13 +57,37 @@ func (r MetricDatasResult) ExtractMetricDatas() ([]MetricData, error) {
 
 type Datapoint struct {
 	// 指标值，该字段名称与请求参数中filter使用的查询值相同。
-	Average float64 `json:"average"`
+	Average  float64 `json:"average"`
+	Max      float64 `json:"max"`
+	Min      float64 `json:"min"`
+	Sum      float64 `json:"sum"`
+	Variance float64 `json:"variance"`
 	// 指标采集时间。
 	Timestamp int `json:"timestamp"`
 	// 指标单位
 	Unit string `json:"unit,omitempty"`
 }
 
+// Value returns the datapoint value of the given rollup filter
+func (d Datapoint) Value(filter string) float64 {
+	return pickValue(filter, d.Average, d.Max, d.Min, d.Sum, d.Variance)
+}
+
+func pickValue(filter string, average, max, min, sum, variance float64) float64 {
+	switch filter {
+	case FilterMax:
+		return max
+	case FilterMin:
+		return min
+	case FilterSum:
+		return sum
+	case FilterVariance:
+		return variance
+	default:
+		return average
+	}
+}
+
 type EventDataInfo struct {
 	// 事件类型，例如instance_host_info。
 	Type string `json:"type"`
@@ -0,0 +1,105 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const instanceID = "faf31a3a-c9c7-4b1c-8f2e-2f9e8b4c5d17"
+
+var expectedBatchQueryRequest = fmt.Sprintf(`
+{
+  "metrics": [
+    {
+      "namespace": "SYS.ECS",
+      "metric_name": "cpu_util",
+      "dimensions": [
+        {
+          "name": "instance_id",
+          "value": "%s"
+        }
+      ]
+    }
+  ],
+  "from": 1484153313000,
+  "to": 1484653313000,
+  "period": "3600",
+  "filter": "max"
+}`, instanceID)
+
+var batchQueryResponse = fmt.Sprintf(`
+{
+  "metrics": [
+    {
+      "namespace": "SYS.ECS",
+      "metric_name": "cpu_util",
+      "dimensions": [
+        {
+          "name": "instance_id",
+          "value": "%s"
+        }
+      ],
+      "datapoints": [
+        {
+          "max": 12.5,
+          "timestamp": 1484153313000
+        },
+        {
+          "max": 27.25,
+          "timestamp": 1484156913000
+        }
+      ],
+      "unit": "%%"
+    }
+  ]
+}`, instanceID)
+
+const getResponse = `
+{
+  "datapoints": [
+    {
+      "average": 0.23,
+      "timestamp": 1442341200000,
+      "unit": "%"
+    }
+  ],
+  "metric_name": "cpu_util"
+}`
+
+// HandleBatchQuerySuccessfully creates an HTTP handler at `/batch-query-metric-data` on the test
+// handler mux that responds with the `max` datapoints of a single metric.
+func HandleBatchQuerySuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/batch-query-metric-data", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, expectedBatchQueryRequest)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, batchQueryResponse)
+	})
+}
+
+// HandleGetSuccessfully creates an HTTP handler at `/metric-data` on the test handler mux
+// that responds with the `average` datapoints of the metric.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/metric-data", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"dim.0":       "instance_id," + instanceID,
+			"filter":      "average",
+			"from":        "1442341200000",
+			"to":          "1442354800000",
+			"metric_name": "cpu_util",
+			"namespace":   "SYS.ECS",
+			"period":      "1",
+		})
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, getResponse)
+	})
+}
@@ -0,0 +1,75 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/ces/v1/metricdata"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestBatchQuery(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleBatchQuerySuccessfully(t)
+
+	datas, err := metricdata.BatchQuery(fake.ServiceClient(), metricdata.BatchQueryOpts{
+		Metrics: []metricdata.Metric{
+			{
+				Namespace:  "SYS.ECS",
+				MetricName: "cpu_util",
+				Dimensions: []metricdata.Dimension{{Name: "instance_id", Value: instanceID}},
+			},
+		},
+		From:   1484153313000,
+		To:     1484653313000,
+		Period: metricdata.Period1Hour,
+		Filter: metricdata.FilterMax,
+	}).ExtractMetricDatas()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(datas))
+	th.AssertEquals(t, "%", datas[0].Unit)
+	th.AssertEquals(t, 2, len(datas[0].Datapoints))
+	th.AssertEquals(t, 12.5, datas[0].Datapoints[0].Value(metricdata.FilterMax))
+	th.AssertEquals(t, 27.25, datas[0].Datapoints[1].Value(metricdata.FilterMax))
+	th.AssertEquals(t, 0.0, datas[0].Datapoints[1].Value(metricdata.FilterAverage))
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	data, err := metricdata.Get(fake.ServiceClient(), metricdata.GetOpts{
+		Dim0:       "instance_id," + instanceID,
+		Filter:     metricdata.FilterAverage,
+		From:       "1442341200000",
+		To:         "1442354800000",
+		MetricName: "cpu_util",
+		Namespace:  "SYS.ECS",
+		Period:     metricdata.PeriodRaw,
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "cpu_util", data.MetricName)
+	th.AssertEquals(t, 1, len(data.Datapoints))
+	th.AssertEquals(t, 0.23, data.Datapoints[0].Value(metricdata.FilterAverage))
+	th.AssertEquals(t, "%", data.Datapoints[0].Unit)
+}
+
+func TestValue(t *testing.T) {
+	data := metricdata.Data{Average: 1, Max: 2, Min: 3, Sum: 4, Variance: 5}
+	datapoint := metricdata.Datapoint{Average: 1, Max: 2, Min: 3, Sum: 4, Variance: 5}
+	expected := map[string]float64{
+		metricdata.FilterAverage:  1,
+		metricdata.FilterMax:      2,
+		metricdata.FilterMin:      3,
+		metricdata.FilterSum:      4,
+		metricdata.FilterVariance: 5,
+		// unknown filters fall back to the average
+		"": 1,
+	}
+	for filter, value := range expected {
+		th.AssertEquals(t, value, data.Value(filter))
+		th.AssertEquals(t, value, datapoint.Value(filter))
+	}
+}
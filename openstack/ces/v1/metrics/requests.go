@@ -59,3 +59,46 @@ func List(client *golangsdk.ServiceClient, opts ListOptsBuilder) pagination.Page
 		return MetricsPage{pagination.LinkedPageBase{PageResult: r}}
 	})
 }
+
+// ListNamespaces returns the distinct namespaces of all metrics available in the project,
+// e.g. `SYS.ECS` or `SYS.RDS`
+func ListNamespaces(client *golangsdk.ServiceClient) ([]string, error) {
+	limit := 1000
+	var namespaces []string
+	seen := make(map[string]bool)
+	err := List(client, ListOpts{Limit: &limit}).EachPage(func(page pagination.Page) (bool, error) {
+		metrics, err := ExtractMetrics(page)
+		if err != nil {
+			return false, err
+		}
+		for _, metric := range metrics.Metrics {
+			if !seen[metric.Namespace] {
+				seen[metric.Namespace] = true
+				namespaces = append(namespaces, metric.Namespace)
+			}
+		}
+		return true, nil
+	})
+	return namespaces, err
+}
+
+// ListNamespaceMetrics returns the distinct metric names of the given namespace
+func ListNamespaceMetrics(client *golangsdk.ServiceClient, namespace string) ([]string, error) {
+	limit := 1000
+	var names []string
+	seen := make(map[string]bool)
+	err := List(client, ListOpts{Namespace: namespace, Limit: &limit}).EachPage(func(page pagination.Page) (bool, error) {
+		metrics, err := ExtractMetrics(page)
+		if err != nil {
+			return false, err
+		}
+		for _, metric := range metrics.Metrics {
+			if !seen[metric.MetricName] {
+				seen[metric.MetricName] = true
+				names = append(names, metric.MetricName)
+			}
+		}
+		return true, nil
+	})
+	return names, err
+}
@@ -0,0 +1,94 @@
+package testing
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+type fakeDimension struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type fakeMetric struct {
+	Namespace  string          `json:"namespace"`
+	MetricName string          `json:"metric_name"`
+	Unit       string          `json:"unit"`
+	Dimensions []fakeDimension `json:"dimensions"`
+}
+
+func (m fakeMetric) marker() string {
+	return fmt.Sprintf("%s.%s.%s:%s", m.Namespace, m.MetricName, m.Dimensions[0].Name, m.Dimensions[0].Value)
+}
+
+// fakeMetrics returns 1002 metrics, so listing them with the maximum limit takes two pages
+func fakeMetrics() []fakeMetric {
+	var metrics []fakeMetric
+	names := []string{"cpu_util", "mem_util"}
+	for i := 0; i < 1000; i++ {
+		metrics = append(metrics, fakeMetric{
+			Namespace:  "SYS.ECS",
+			MetricName: names[i%2],
+			Unit:       "%",
+			Dimensions: []fakeDimension{{Name: "instance_id", Value: strconv.Itoa(i)}},
+		})
+	}
+	return append(metrics,
+		fakeMetric{
+			Namespace:  "SYS.RDS",
+			MetricName: "rds001_cpu_util",
+			Unit:       "%",
+			Dimensions: []fakeDimension{{Name: "rds_instance_id", Value: "0"}},
+		},
+		fakeMetric{
+			Namespace:  "SYS.ECS",
+			MetricName: "disk_util_inband",
+			Unit:       "%",
+			Dimensions: []fakeDimension{{Name: "instance_id", Value: "0"}},
+		},
+	)
+}
+
+// HandleListSuccessfully creates an HTTP handler at `/metrics` on the test handler mux
+// that pages through fakeMetrics using the `start` marker and the `namespace` filter.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/metrics", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		query := r.URL.Query()
+		limit, err := strconv.Atoi(query.Get("limit"))
+		th.AssertNoErr(t, err)
+
+		var metrics []fakeMetric
+		for _, metric := range fakeMetrics() {
+			if namespace := query.Get("namespace"); namespace == "" || metric.Namespace == namespace {
+				metrics = append(metrics, metric)
+			}
+		}
+		if start := query.Get("start"); start != "" {
+			for i, metric := range metrics {
+				if metric.marker() == start {
+					metrics = metrics[i+1:]
+					break
+				}
+			}
+		}
+		if len(metrics) > limit {
+			metrics = metrics[:limit]
+		}
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"metrics":   metrics,
+			"meta_data": map[string]interface{}{"count": len(metrics), "total": len(metrics)},
+		})
+	})
+}
@@ -0,0 +1,33 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/ces/v1/metrics"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestListNamespaces(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	namespaces, err := metrics.ListNamespaces(fake.ServiceClient())
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []string{"SYS.ECS", "SYS.RDS"}, namespaces)
+}
+
+func TestListNamespaceMetrics(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	names, err := metrics.ListNamespaceMetrics(fake.ServiceClient(), "SYS.ECS")
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []string{"cpu_util", "mem_util", "disk_util_inband"}, names)
+
+	names, err = metrics.ListNamespaceMetrics(fake.ServiceClient(), "SYS.RDS")
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []string{"rds001_cpu_util"}, names)
+}
@@ -0,0 +1,130 @@
+package resourcegroups
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToResourceGroupCreateMap() (map[string]interface{}, error)
+}
+
+type DimensionOpts struct {
+	Name  string `json:"name" required:"true"`
+	Value string `json:"value" required:"true"`
+}
+
+// ResourceOpts represents a single monitored resource of the group.
+type ResourceOpts struct {
+	// Specifies the resource namespace, e.g. `SYS.ECS`.
+	Namespace string `json:"namespace" required:"true"`
+
+	// Specifies the dimensions identifying the resource.
+	Dimensions []DimensionOpts `json:"dimensions" required:"true"`
+}
+
+// CreateOpts represents options for creating the resource group.
+type CreateOpts struct {
+	// Specifies the resource group name, 1 to 128 characters.
+	Name string `json:"group_name" required:"true"`
+
+	// Specifies the resources of the group.
+	Resources []ResourceOpts `json:"resources" required:"true"`
+}
+
+func (opts CreateOpts) ToResourceGroupCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Create creates a resource group.
+func Create(c *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToResourceGroupCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Post(rootURL(c), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+// Get returns the resource group with its resources.
+func Get(c *golangsdk.ServiceClient, id string) (r GetResult) {
+	_, r.Err = c.Get(resourceURL(c, id), &r.Body, nil)
+	return
+}
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToResourceGroupListQuery() (string, error)
+}
+
+type ListOpts struct {
+	Name string `q:"group_name"`
+	ID   string `q:"group_id"`
+	// Specifies the group health status: `health`, `unhealthy` or `no_alarm_rule`.
+	Status string `q:"status"`
+	Start  int    `q:"start"`
+	Limit  int    `q:"limit"`
+}
+
+func (opts ListOpts) ToResourceGroupListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), err
+}
+
+// List returns the resource groups of the project.
+func List(c *golangsdk.ServiceClient, opts ListOptsBuilder) (r ListResult) {
+	url := rootURL(c)
+	if opts != nil {
+		q, err := opts.ToResourceGroupListQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += q
+	}
+	_, r.Err = c.Get(url, &r.Body, nil)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToResourceGroupUpdateMap() (map[string]interface{}, error)
+}
+
+type UpdateOpts struct {
+	Name string `json:"group_name" required:"true"`
+}
+
+func (opts UpdateOpts) ToResourceGroupUpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Update changes the resource group name.
+func Update(c *golangsdk.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToResourceGroupUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Put(resourceURL(c, id), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// Delete deletes the resource group.
+func Delete(c *golangsdk.ServiceClient, id string) (r DeleteResult) {
+	_, r.Err = c.Delete(resourceURL(c, id), &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
@@ -0,0 +1,76 @@
+package resourcegroups
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+type CreateResult struct {
+	golangsdk.Result
+}
+
+// Extract returns the ID of the created resource group.
+func (r CreateResult) Extract() (string, error) {
+	var s struct {
+		ID string `json:"group_id"`
+	}
+	err := r.ExtractInto(&s)
+	return s.ID, err
+}
+
+type Dimension struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type Resource struct {
+	Namespace  string      `json:"namespace"`
+	Dimensions []Dimension `json:"dimensions"`
+	Status     string      `json:"status"`
+}
+
+type ResourceGroup struct {
+	ID         string     `json:"group_id"`
+	Name       string     `json:"group_name"`
+	Status     string     `json:"status"`
+	CreateTime int64      `json:"create_time"`
+	Resources  []Resource `json:"resources"`
+}
+
+type GetResult struct {
+	golangsdk.Result
+}
+
+func (r GetResult) Extract() (*ResourceGroup, error) {
+	var s ResourceGroup
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+type MetaData struct {
+	Count  int    `json:"count"`
+	Marker string `json:"marker"`
+	Total  int    `json:"total"`
+}
+
+type ListResult struct {
+	golangsdk.Result
+}
+
+type ListResponse struct {
+	ResourceGroups []ResourceGroup `json:"resource_groups"`
+	MetaData       MetaData        `json:"meta_data"`
+}
+
+func (r ListResult) Extract() (*ListResponse, error) {
+	var s ListResponse
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+type UpdateResult struct {
+	golangsdk.ErrResult
+}
+
+type DeleteResult struct {
+	golangsdk.ErrResult
+}
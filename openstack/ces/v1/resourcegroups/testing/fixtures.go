@@ -0,0 +1,117 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const groupID = "rg1606377637506DmVOENVyL"
+
+const expectedCreateRequest = `
+{
+  "group_name": "rg-test",
+  "resources": [
+    {
+      "namespace": "SYS.ECS",
+      "dimensions": [
+        {
+          "name": "instance_id",
+          "value": "07814c0e-59a1-4fcd-a6fb-56f2f6923046"
+        }
+      ]
+    }
+  ]
+}`
+
+var createResponse = fmt.Sprintf(`
+{
+  "group_id": "%s"
+}`, groupID)
+
+var getResponse = fmt.Sprintf(`
+{
+  "group_id": "%s",
+  "group_name": "rg-test",
+  "status": "health",
+  "create_time": 1606377637506,
+  "resources": [
+    {
+      "namespace": "SYS.ECS",
+      "dimensions": [
+        {
+          "name": "instance_id",
+          "value": "07814c0e-59a1-4fcd-a6fb-56f2f6923046"
+        }
+      ],
+      "status": "health"
+    }
+  ]
+}`, groupID)
+
+var listResponse = fmt.Sprintf(`
+{
+  "resource_groups": [
+    {
+      "group_id": "%s",
+      "group_name": "rg-test",
+      "status": "health",
+      "create_time": 1606377637506
+    }
+  ],
+  "meta_data": {
+    "count": 1,
+    "marker": "%s",
+    "total": 1
+  }
+}`, groupID, groupID)
+
+const expectedUpdateRequest = `
+{
+  "group_name": "rg-updated"
+}`
+
+// HandleResourceGroupsSuccessfully creates an HTTP handler at `/resource-groups` on the test
+// handler mux that responds to POST and GET requests.
+func HandleResourceGroupsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/resource-groups", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+		switch r.Method {
+		case "POST":
+			th.TestJSONRequest(t, r, expectedCreateRequest)
+			w.WriteHeader(http.StatusCreated)
+			_, _ = fmt.Fprint(w, createResponse)
+		case "GET":
+			th.TestFormValues(t, r, map[string]string{"group_name": "rg-test"})
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprint(w, listResponse)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+}
+
+// HandleResourceGroupSuccessfully creates an HTTP handler at `/resource-groups/{group_id}` on the
+// test handler mux that responds to GET, PUT and DELETE requests.
+func HandleResourceGroupSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/resource-groups/%s", groupID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		switch r.Method {
+		case "GET":
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprint(w, getResponse)
+		case "PUT":
+			th.TestJSONRequest(t, r, expectedUpdateRequest)
+			w.WriteHeader(http.StatusNoContent)
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+}
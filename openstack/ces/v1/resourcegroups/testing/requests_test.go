@@ -0,0 +1,75 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/ces/v1/resourcegroups"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleResourceGroupsSuccessfully(t)
+
+	opts := resourcegroups.CreateOpts{
+		Name: "rg-test",
+		Resources: []resourcegroups.ResourceOpts{
+			{
+				Namespace: "SYS.ECS",
+				Dimensions: []resourcegroups.DimensionOpts{
+					{
+						Name:  "instance_id",
+						Value: "07814c0e-59a1-4fcd-a6fb-56f2f6923046",
+					},
+				},
+			},
+		},
+	}
+	id, err := resourcegroups.Create(fake.ServiceClient(), opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, groupID, id)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleResourceGroupSuccessfully(t)
+
+	group, err := resourcegroups.Get(fake.ServiceClient(), groupID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "rg-test", group.Name)
+	th.AssertEquals(t, int64(1606377637506), group.CreateTime)
+	th.AssertEquals(t, 1, len(group.Resources))
+	th.AssertEquals(t, "instance_id", group.Resources[0].Dimensions[0].Name)
+}
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleResourceGroupsSuccessfully(t)
+
+	list, err := resourcegroups.List(fake.ServiceClient(), resourcegroups.ListOpts{Name: "rg-test"}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(list.ResourceGroups))
+	th.AssertEquals(t, groupID, list.ResourceGroups[0].ID)
+	th.AssertEquals(t, 1, list.MetaData.Total)
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleResourceGroupSuccessfully(t)
+
+	err := resourcegroups.Update(fake.ServiceClient(), groupID, resourcegroups.UpdateOpts{Name: "rg-updated"}).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleResourceGroupSuccessfully(t)
+
+	th.AssertNoErr(t, resourcegroups.Delete(fake.ServiceClient(), groupID).ExtractErr())
+}
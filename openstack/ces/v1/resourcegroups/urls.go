@@ -0,0 +1,13 @@
+package resourcegroups
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+const rootPath = "resource-groups"
+
+func rootURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL(rootPath)
+}
+
+func resourceURL(c *golangsdk.ServiceClient, id string) string {
+	return c.ServiceURL(rootPath, id)
+}
@@ -0,0 +1,221 @@
+package dashboards
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToDashboardCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts represents options for creating the dashboard.
+type CreateOpts struct {
+	// Specifies the dashboard name, 1 to 128 characters.
+	Name string `json:"dashboard_name" required:"true"`
+
+	// Specifies the enterprise project ID.
+	EnterpriseID string `json:"enterprise_id,omitempty"`
+
+	// Specifies the ID of the dashboard to be copied, its widgets are copied to the new one.
+	DashboardID string `json:"dashboard_id,omitempty"`
+
+	// Specifies the number of widgets displayed in a row, from 1 to 3.
+	RowWidgetNum int `json:"row_widget_num,omitempty"`
+}
+
+func (opts CreateOpts) ToDashboardCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Create creates a dashboard.
+func Create(c *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToDashboardCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Post(rootURL(c), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToDashboardListQuery() (string, error)
+}
+
+type ListOpts struct {
+	EnterpriseID string `q:"enterprise_id"`
+	IsFavorite   *bool  `q:"is_favorite"`
+	Name         string `q:"dashboard_name"`
+	ID           string `q:"dashboard_id"`
+}
+
+func (opts ListOpts) ToDashboardListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), err
+}
+
+// List returns the dashboards of the project.
+func List(c *golangsdk.ServiceClient, opts ListOptsBuilder) (r ListResult) {
+	url := rootURL(c)
+	if opts != nil {
+		q, err := opts.ToDashboardListQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += q
+	}
+	_, r.Err = c.Get(url, &r.Body, nil)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToDashboardUpdateMap() (map[string]interface{}, error)
+}
+
+type UpdateOpts struct {
+	Name         string `json:"dashboard_name,omitempty"`
+	IsFavorite   *bool  `json:"is_favorite,omitempty"`
+	RowWidgetNum int    `json:"row_widget_num,omitempty"`
+}
+
+func (opts UpdateOpts) ToDashboardUpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Update changes the dashboard settings.
+func Update(c *golangsdk.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToDashboardUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Put(resourceURL(c, id), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// BatchDelete deletes the dashboards with the given IDs.
+func BatchDelete(c *golangsdk.ServiceClient, ids []string) (r BatchDeleteResult) {
+	b := map[string]interface{}{
+		"dashboard_ids": ids,
+	}
+	_, r.Err = c.Post(batchDeleteURL(c), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// WidgetMetricDimensionOpts selects the resources displayed by the widget metric.
+type WidgetMetricDimensionOpts struct {
+	// Specifies the dimension name, e.g. `instance_id`.
+	Name string `json:"name" required:"true"`
+
+	// Specifies the filter type: `all_instances` or `specific_instances`.
+	FilterType string `json:"filter_type" required:"true"`
+
+	// Specifies the dimension values for `specific_instances`.
+	Values []string `json:"values,omitempty"`
+}
+
+type WidgetMetricOpts struct {
+	Namespace  string                    `json:"namespace" required:"true"`
+	MetricName string                    `json:"metric_name" required:"true"`
+	Dimensions WidgetMetricDimensionOpts `json:"dimensions" required:"true"`
+	Alias      []string                  `json:"alias,omitempty"`
+}
+
+type WidgetPropertiesOpts struct {
+	// Specifies the aggregation method: `average`, `max`, `min` or `sum`.
+	Filter string `json:"filter" required:"true"`
+
+	// Specifies the number of top resources displayed.
+	TopN int `json:"topN" required:"true"`
+}
+
+// WidgetLocationOpts places the widget on the dashboard grid, Top and Left start from 0.
+type WidgetLocationOpts struct {
+	Top    int `json:"top"`
+	Left   int `json:"left"`
+	Width  int `json:"width" required:"true"`
+	Height int `json:"height" required:"true"`
+}
+
+// WidgetOpts represents a single dashboard widget.
+type WidgetOpts struct {
+	Metrics          []WidgetMetricOpts `json:"metrics" required:"true"`
+	Title            string             `json:"title" required:"true"`
+	Threshold        *float64           `json:"threshold,omitempty"`
+	ThresholdEnabled bool               `json:"threshold_enabled"`
+	// Specifies the chart type: `bar`, `line`, `bar_chart`, `table`, `circular_bar` or `area_chart`.
+	View string `json:"view" required:"true"`
+	// Specifies the display mode of metrics: `single` or `multiple`.
+	MetricDisplayMode string                `json:"metric_display_mode" required:"true"`
+	Properties        *WidgetPropertiesOpts `json:"properties,omitempty"`
+	Location          WidgetLocationOpts    `json:"location" required:"true"`
+	Unit              string                `json:"unit,omitempty"`
+}
+
+func (opts WidgetOpts) ToMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// AddWidgetsOpts is a list of widgets added to the dashboard at once.
+type AddWidgetsOpts []WidgetOpts
+
+// AddWidgetsOptsBuilder allows extensions to add additional parameters to the
+// AddWidgets request.
+type AddWidgetsOptsBuilder interface {
+	ToWidgetsAddMap() ([]map[string]interface{}, error)
+}
+
+func (opts AddWidgetsOpts) ToWidgetsAddMap() ([]map[string]interface{}, error) {
+	newOpts := make([]map[string]interface{}, len(opts))
+	for i, opt := range opts {
+		opt, err := opt.ToMap()
+		if err != nil {
+			return nil, err
+		}
+		newOpts[i] = opt
+	}
+	return newOpts, nil
+}
+
+// AddWidgets adds widgets to the dashboard.
+func AddWidgets(c *golangsdk.ServiceClient, id string, opts AddWidgetsOptsBuilder) (r AddWidgetsResult) {
+	b, err := opts.ToWidgetsAddMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Post(widgetsURL(c, id), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// ListWidgets returns the widgets of the dashboard.
+func ListWidgets(c *golangsdk.ServiceClient, id string) (r ListWidgetsResult) {
+	_, r.Err = c.Get(widgetsURL(c, id), &r.Body, nil)
+	return
+}
+
+// DeleteWidget deletes the widget.
+func DeleteWidget(c *golangsdk.ServiceClient, widgetID string) (r DeleteResult) {
+	_, r.Err = c.Delete(widgetURL(c, widgetID), &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
@@ -0,0 +1,126 @@
+package dashboards
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+type CreateResult struct {
+	golangsdk.Result
+}
+
+// Extract returns the ID of the created dashboard.
+func (r CreateResult) Extract() (string, error) {
+	var s struct {
+		ID string `json:"dashboard_id"`
+	}
+	err := r.ExtractInto(&s)
+	return s.ID, err
+}
+
+type Dashboard struct {
+	ID           string `json:"dashboard_id"`
+	Name         string `json:"dashboard_name"`
+	EnterpriseID string `json:"enterprise_id"`
+	CreatorName  string `json:"creator_name"`
+	CreateTime   int64  `json:"create_time"`
+	RowWidgetNum int    `json:"row_widget_num"`
+	IsFavorite   bool   `json:"is_favorite"`
+}
+
+type ListResult struct {
+	golangsdk.Result
+}
+
+func (r ListResult) Extract() ([]Dashboard, error) {
+	var s []Dashboard
+	err := r.ExtractIntoSlicePtr(&s, "dashboards")
+	return s, err
+}
+
+type UpdateResult struct {
+	golangsdk.ErrResult
+}
+
+type DeleteResult struct {
+	golangsdk.ErrResult
+}
+
+// BatchDeleteStatus is the deletion result of a single dashboard.
+type BatchDeleteStatus struct {
+	ID string `json:"dashboard_id"`
+	// RetStatus is either `successful` or `error`.
+	RetStatus string `json:"ret_status"`
+	ErrorMsg  string `json:"error_msg"`
+}
+
+type BatchDeleteResult struct {
+	golangsdk.Result
+}
+
+func (r BatchDeleteResult) Extract() ([]BatchDeleteStatus, error) {
+	var s []BatchDeleteStatus
+	err := r.ExtractIntoSlicePtr(&s, "dashboards")
+	return s, err
+}
+
+type AddWidgetsResult struct {
+	golangsdk.Result
+}
+
+// Extract returns IDs of the added widgets.
+func (r AddWidgetsResult) Extract() ([]string, error) {
+	var s struct {
+		IDs []string `json:"widget_ids"`
+	}
+	err := r.ExtractInto(&s)
+	return s.IDs, err
+}
+
+type WidgetMetricDimension struct {
+	Name       string   `json:"name"`
+	FilterType string   `json:"filter_type"`
+	Values     []string `json:"values"`
+}
+
+type WidgetMetric struct {
+	Namespace  string                `json:"namespace"`
+	MetricName string                `json:"metric_name"`
+	Dimensions WidgetMetricDimension `json:"dimensions"`
+	Alias      []string              `json:"alias"`
+}
+
+type WidgetProperties struct {
+	Filter string `json:"filter"`
+	TopN   int    `json:"topN"`
+}
+
+type WidgetLocation struct {
+	Top    int `json:"top"`
+	Left   int `json:"left"`
+	Width  int `json:"width"`
+	Height int `json:"height"`
+}
+
+type Widget struct {
+	ID                string           `json:"widget_id"`
+	Metrics           []WidgetMetric   `json:"metrics"`
+	Title             string           `json:"title"`
+	Threshold         float64          `json:"threshold"`
+	ThresholdEnabled  bool             `json:"threshold_enabled"`
+	View              string           `json:"view"`
+	MetricDisplayMode string           `json:"metric_display_mode"`
+	Properties        WidgetProperties `json:"properties"`
+	Location          WidgetLocation   `json:"location"`
+	Unit              string           `json:"unit"`
+	CreateTime        int64            `json:"create_time"`
+}
+
+type ListWidgetsResult struct {
+	golangsdk.Result
+}
+
+func (r ListWidgetsResult) Extract() ([]Widget, error) {
+	var s []Widget
+	err := r.ExtractIntoSlicePtr(&s, "widgets")
+	return s, err
+}
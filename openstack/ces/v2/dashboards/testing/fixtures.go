@@ -0,0 +1,207 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const (
+	dashboardID = "db16564943172807wjOmoLyn"
+	widgetID    = "wg1656494431736pvKqMeyOm"
+)
+
+const expectedCreateRequest = `
+{
+  "dashboard_name": "dashboard-test",
+  "row_widget_num": 2
+}`
+
+var createResponse = fmt.Sprintf(`
+{
+  "dashboard_id": "%s"
+}`, dashboardID)
+
+var listResponse = fmt.Sprintf(`
+{
+  "dashboards": [
+    {
+      "dashboard_id": "%s",
+      "dashboard_name": "dashboard-test",
+      "enterprise_id": "0",
+      "creator_name": "user",
+      "create_time": 1656494317280,
+      "row_widget_num": 2,
+      "is_favorite": false
+    }
+  ]
+}`, dashboardID)
+
+const expectedUpdateRequest = `
+{
+  "dashboard_name": "dashboard-updated",
+  "is_favorite": true
+}`
+
+var expectedBatchDeleteRequest = fmt.Sprintf(`
+{
+  "dashboard_ids": ["%s"]
+}`, dashboardID)
+
+var batchDeleteResponse = fmt.Sprintf(`
+{
+  "dashboards": [
+    {
+      "dashboard_id": "%s",
+      "ret_status": "successful"
+    }
+  ]
+}`, dashboardID)
+
+const expectedAddWidgetsRequest = `
+[
+  {
+    "metrics": [
+      {
+        "namespace": "SYS.ECS",
+        "metric_name": "cpu_util",
+        "dimensions": {
+          "name": "instance_id",
+          "filter_type": "specific_instances",
+          "values": ["07814c0e-59a1-4fcd-a6fb-56f2f6923046"]
+        }
+      }
+    ],
+    "title": "CPU usage",
+    "threshold_enabled": false,
+    "view": "line",
+    "metric_display_mode": "single",
+    "location": {
+      "top": 0,
+      "left": 0,
+      "width": 1,
+      "height": 1
+    }
+  }
+]`
+
+var addWidgetsResponse = fmt.Sprintf(`
+{
+  "widget_ids": ["%s"]
+}`, widgetID)
+
+var listWidgetsResponse = fmt.Sprintf(`
+{
+  "widgets": [
+    {
+      "widget_id": "%s",
+      "metrics": [
+        {
+          "namespace": "SYS.ECS",
+          "metric_name": "cpu_util",
+          "dimensions": {
+            "name": "instance_id",
+            "filter_type": "specific_instances",
+            "values": ["07814c0e-59a1-4fcd-a6fb-56f2f6923046"]
+          },
+          "alias": []
+        }
+      ],
+      "title": "CPU usage",
+      "threshold": 0,
+      "threshold_enabled": false,
+      "view": "line",
+      "metric_display_mode": "single",
+      "properties": {
+        "filter": "average",
+        "topN": 0
+      },
+      "location": {
+        "top": 0,
+        "left": 0,
+        "width": 1,
+        "height": 1
+      },
+      "unit": "%%",
+      "create_time": 1656494431736
+    }
+  ]
+}`, widgetID)
+
+// HandleDashboardsSuccessfully creates an HTTP handler at `/dashboards` on the test handler mux
+// that responds to POST and GET requests.
+func HandleDashboardsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/dashboards", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+		switch r.Method {
+		case "POST":
+			th.TestJSONRequest(t, r, expectedCreateRequest)
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprint(w, createResponse)
+		case "GET":
+			th.TestFormValues(t, r, map[string]string{"dashboard_name": "dashboard-test"})
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprint(w, listResponse)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+}
+
+// HandleUpdateSuccessfully creates an HTTP handler at `/dashboards/{dashboard_id}` on the test
+// handler mux that responds to a PUT request.
+func HandleUpdateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/dashboards/%s", dashboardID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, expectedUpdateRequest)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandleBatchDeleteSuccessfully creates an HTTP handler at `/dashboards/batch-delete` on the test
+// handler mux that responds to a POST request with batchDeleteResponse.
+func HandleBatchDeleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/dashboards/batch-delete", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, expectedBatchDeleteRequest)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, batchDeleteResponse)
+	})
+}
+
+// HandleWidgetsSuccessfully creates an HTTP handler at `/dashboards/{dashboard_id}/widgets` on the
+// test handler mux that responds to POST and GET requests.
+func HandleWidgetsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/dashboards/%s/widgets", dashboardID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+		switch r.Method {
+		case "POST":
+			th.TestJSONRequest(t, r, expectedAddWidgetsRequest)
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprint(w, addWidgetsResponse)
+		case "GET":
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprint(w, listWidgetsResponse)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+}
+
+// HandleDeleteWidgetSuccessfully creates an HTTP handler at `/widgets/{widget_id}` on the test
+// handler mux that responds to a DELETE request.
+func HandleDeleteWidgetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/widgets/%s", widgetID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,105 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/ces/v2/dashboards"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDashboardsSuccessfully(t)
+
+	id, err := dashboards.Create(fake.ServiceClient(), dashboards.CreateOpts{
+		Name:         "dashboard-test",
+		RowWidgetNum: 2,
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, dashboardID, id)
+}
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDashboardsSuccessfully(t)
+
+	list, err := dashboards.List(fake.ServiceClient(), dashboards.ListOpts{Name: "dashboard-test"}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(list))
+	th.AssertEquals(t, dashboardID, list[0].ID)
+	th.AssertEquals(t, 2, list[0].RowWidgetNum)
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateSuccessfully(t)
+
+	favorite := true
+	err := dashboards.Update(fake.ServiceClient(), dashboardID, dashboards.UpdateOpts{
+		Name:       "dashboard-updated",
+		IsFavorite: &favorite,
+	}).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestBatchDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleBatchDeleteSuccessfully(t)
+
+	statuses, err := dashboards.BatchDelete(fake.ServiceClient(), []string{dashboardID}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(statuses))
+	th.AssertEquals(t, "successful", statuses[0].RetStatus)
+}
+
+func TestAddAndListWidgets(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleWidgetsSuccessfully(t)
+
+	opts := dashboards.AddWidgetsOpts{
+		{
+			Metrics: []dashboards.WidgetMetricOpts{
+				{
+					Namespace:  "SYS.ECS",
+					MetricName: "cpu_util",
+					Dimensions: dashboards.WidgetMetricDimensionOpts{
+						Name:       "instance_id",
+						FilterType: "specific_instances",
+						Values:     []string{"07814c0e-59a1-4fcd-a6fb-56f2f6923046"},
+					},
+				},
+			},
+			Title:             "CPU usage",
+			View:              "line",
+			MetricDisplayMode: "single",
+			Location: dashboards.WidgetLocationOpts{
+				Width:  1,
+				Height: 1,
+			},
+		},
+	}
+	ids, err := dashboards.AddWidgets(fake.ServiceClient(), dashboardID, opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []string{widgetID}, ids)
+
+	widgets, err := dashboards.ListWidgets(fake.ServiceClient(), dashboardID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(widgets))
+	th.AssertEquals(t, widgetID, widgets[0].ID)
+	th.AssertEquals(t, "specific_instances", widgets[0].Metrics[0].Dimensions.FilterType)
+	th.AssertEquals(t, "average", widgets[0].Properties.Filter)
+}
+
+func TestDeleteWidget(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteWidgetSuccessfully(t)
+
+	th.AssertNoErr(t, dashboards.DeleteWidget(fake.ServiceClient(), widgetID).ExtractErr())
+}
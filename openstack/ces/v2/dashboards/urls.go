@@ -0,0 +1,25 @@
+package dashboards
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+const rootPath = "dashboards"
+
+func rootURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL(rootPath)
+}
+
+func resourceURL(c *golangsdk.ServiceClient, id string) string {
+	return c.ServiceURL(rootPath, id)
+}
+
+func batchDeleteURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL(rootPath, "batch-delete")
+}
+
+func widgetsURL(c *golangsdk.ServiceClient, id string) string {
+	return c.ServiceURL(rootPath, id, "widgets")
+}
+
+func widgetURL(c *golangsdk.ServiceClient, widgetID string) string {
+	return c.ServiceURL("widgets", widgetID)
+}
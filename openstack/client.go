@@ -12,6 +12,7 @@ import (
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/identity/v3/domains"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/identity/v3/projects"
 	tokens3 "github.com/opentelekomcloud/gophertelekomcloud/openstack/identity/v3/tokens"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/obs"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/utils"
 	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
 )
@@ -189,6 +190,7 @@ func v3auth(client *golangsdk.ProviderClient, endpoint string, opts tokens3.Auth
 	}
 
 	client.TokenID = token.ID
+	client.TokenExpiresAt = token.ExpiresAt
 	if project != nil {
 		client.ProjectID = project.ID
 		client.DomainID = project.Domain.ID
@@ -385,6 +387,7 @@ func authWithAgencyByAKSK(client *golangsdk.ProviderClient, endpoint string, opt
 	}
 
 	client.TokenID = token.ID
+	client.TokenExpiresAt = token.ExpiresAt
 	if project != nil {
 		client.ProjectID = project.ID
 	}
@@ -610,6 +613,72 @@ func NewCESClient(client *golangsdk.ProviderClient, eo golangsdk.EndpointOpts) (
 	return sc, err
 }
 
+// NewCESV2Client creates a ServiceClient that may be used to access the v2 CES service.
+func NewCESV2Client(client *golangsdk.ProviderClient, eo golangsdk.EndpointOpts) (*golangsdk.ServiceClient, error) {
+	return initCommonServiceClient(client, eo, "ces", "v2")
+}
+
+// NewHSSV5 creates a ServiceClient that may be used to access the Host Security Service.
+func NewHSSV5(client *golangsdk.ProviderClient, eo golangsdk.EndpointOpts) (*golangsdk.ServiceClient, error) {
+	return initCommonServiceClient(client, eo, "hss", "v5")
+}
+
+// NewEPSV1 creates a ServiceClient that may be used to access the Enterprise Project Service.
+func NewEPSV1(client *golangsdk.ProviderClient, eo golangsdk.EndpointOpts) (*golangsdk.ServiceClient, error) {
+	sc, err := initClientOpts(client, eo, "network")
+	if err != nil {
+		return nil, err
+	}
+	sc.Endpoint = strings.Replace(sc.Endpoint, "vpc", "eps", 1)
+	sc.ResourceBase = sc.Endpoint + "v1.0/"
+	return sc, err
+}
+
+// NewTMSV1 creates a ServiceClient that may be used to access the v1 Tag Management Service.
+func NewTMSV1(client *golangsdk.ProviderClient, eo golangsdk.EndpointOpts) (*golangsdk.ServiceClient, error) {
+	sc, err := initClientOpts(client, eo, "network")
+	if err != nil {
+		return nil, err
+	}
+	sc.Endpoint = strings.Replace(sc.Endpoint, "vpc", "tms", 1)
+	sc.ResourceBase = sc.Endpoint + "v1.0/"
+	return sc, err
+}
+
+// NewCDNV1 creates a ServiceClient that may be used with the v1 CDN service.
+func NewCDNV1(client *golangsdk.ProviderClient, eo golangsdk.EndpointOpts) (*golangsdk.ServiceClient, error) {
+	sc, err := initClientOpts(client, eo, "network")
+	if err != nil {
+		return nil, err
+	}
+	sc.Endpoint = strings.Replace(sc.Endpoint, "vpc", "cdn", 1)
+	sc.ResourceBase = sc.Endpoint + "v1.0/"
+	return sc, err
+}
+
+// NewRMSV1 creates a ServiceClient that may be used with the v1 Resource Management service.
+// The service is scoped to the domain of the provider client.
+func NewRMSV1(client *golangsdk.ProviderClient, eo golangsdk.EndpointOpts) (*golangsdk.ServiceClient, error) {
+	sc, err := initClientOpts(client, eo, "network")
+	if err != nil {
+		return nil, err
+	}
+	sc.Endpoint = strings.Replace(sc.Endpoint, "vpc", "rms", 1)
+	sc.ResourceBase = sc.Endpoint + "v1/resource-manager/domains/" + client.DomainID + "/"
+	return sc, err
+}
+
+// NewBSSV2 creates a ServiceClient that may be used with the v2 Business Support System (billing) service.
+func NewBSSV2(client *golangsdk.ProviderClient, eo golangsdk.EndpointOpts) (*golangsdk.ServiceClient, error) {
+	sc, err := initClientOpts(client, eo, "network")
+	if err != nil {
+		return nil, err
+	}
+	sc.Endpoint = strings.Replace(sc.Endpoint, "vpc", "bss", 1)
+	sc.ResourceBase = sc.Endpoint + "v2/"
+	return sc, err
+}
+
 // NewComputeV1 creates a ServiceClient that may be used with the v1 compute
 // package.
 func NewComputeV1(client *golangsdk.ProviderClient, eo golangsdk.EndpointOpts) (*golangsdk.ServiceClient, error) {
@@ -679,17 +748,51 @@ func NewMapReduceV1(client *golangsdk.ProviderClient, eo golangsdk.EndpointOpts)
 	return sc, err
 }
 
+// NewMapReduceV2 creates a ServiceClient that may be used with the v2 MapReduce service.
+func NewMapReduceV2(client *golangsdk.ProviderClient, eo golangsdk.EndpointOpts) (*golangsdk.ServiceClient, error) {
+	sc, err := initClientOpts(client, eo, "mrs")
+	if err != nil {
+		return nil, err
+	}
+	sc.Endpoint = strings.Replace(sc.Endpoint, "v1.1", "v2", 1)
+	sc.ResourceBase = sc.Endpoint + client.ProjectID + "/"
+	return sc, err
+}
+
 // NewAntiDDoSV1 creates a ServiceClient that may be used with the v1 Anti DDoS Service
 // package.
 func NewAntiDDoSV1(client *golangsdk.ProviderClient, eo golangsdk.EndpointOpts) (*golangsdk.ServiceClient, error) {
 	return initClientOpts(client, eo, "antiddos")
 }
 
+// NewAntiDDoSV2 creates a ServiceClient that may be used with the v2 Anti DDoS Service
+// package.
+func NewAntiDDoSV2(client *golangsdk.ProviderClient, eo golangsdk.EndpointOpts) (*golangsdk.ServiceClient, error) {
+	sc, err := initClientOpts(client, eo, "antiddos")
+	if err != nil {
+		return nil, err
+	}
+	sc.Endpoint = strings.Replace(sc.Endpoint, "/v1/", "/v2/", 1)
+	sc.ResourceBase = sc.Endpoint
+	return sc, err
+}
+
 // NewDMSServiceV1 creates a ServiceClient that may be used to access the v1 Distributed Message Service.
 func NewDMSServiceV1(client *golangsdk.ProviderClient, eo golangsdk.EndpointOpts) (*golangsdk.ServiceClient, error) {
 	return initClientOpts(client, eo, "dmsv1")
 }
 
+// NewDMSServiceV2 creates a ServiceClient that may be used to access the v2 Distributed Message Service.
+func NewDMSServiceV2(client *golangsdk.ProviderClient, eo golangsdk.EndpointOpts) (*golangsdk.ServiceClient, error) {
+	sc, err := initClientOpts(client, eo, "network")
+	if err != nil {
+		return nil, err
+	}
+	sc.Endpoint = strings.Replace(sc.Endpoint, "vpc", "dms", 1)
+	sc.ResourceBase = sc.Endpoint + "v2/" + client.ProjectID + "/"
+	return sc, err
+}
+
 // NewDCSServiceV1 creates a ServiceClient that may be used to access the v1 Distributed Cache Service.
 func NewDCSServiceV1(client *golangsdk.ProviderClient, eo golangsdk.EndpointOpts) (*golangsdk.ServiceClient, error) {
 	sc, err := initClientOpts(client, eo, "network")
@@ -701,6 +804,17 @@ func NewDCSServiceV1(client *golangsdk.ProviderClient, eo golangsdk.EndpointOpts
 	return sc, err
 }
 
+// NewDCSServiceV2 creates a ServiceClient that may be used to access the v2 Distributed Cache Service.
+func NewDCSServiceV2(client *golangsdk.ProviderClient, eo golangsdk.EndpointOpts) (*golangsdk.ServiceClient, error) {
+	sc, err := initClientOpts(client, eo, "network")
+	if err != nil {
+		return nil, err
+	}
+	sc.Endpoint = strings.Replace(sc.Endpoint, "vpc", "dcs", 1)
+	sc.ResourceBase = sc.Endpoint + "v2/" + client.ProjectID + "/"
+	return sc, err
+}
+
 // NewDDSServiceV3 creates a ServiceClient that may be used to access the Document Database Service.
 func NewDDSServiceV3(client *golangsdk.ProviderClient, eo golangsdk.EndpointOpts) (*golangsdk.ServiceClient, error) {
 	sc, err := initClientOpts(client, eo, "ddsv3")
@@ -716,6 +830,26 @@ func NewOBSService(client *golangsdk.ProviderClient, eo golangsdk.EndpointOpts)
 	return sc, err
 }
 
+// NewOBSClient creates an ObsClient that may be used to access the Object Storage Service.
+// The client reuses AK/SK credentials (and security token, if any) of the provider client,
+// so the provider client has to be authenticated with AK/SK or temporary AK/SK.
+func NewOBSClient(client *golangsdk.ProviderClient, eo golangsdk.EndpointOpts, configurers ...obs.Configurer) (*obs.ObsClient, error) {
+	opts := client.AKSKAuthOptions
+	if opts.AccessKey == "" || opts.SecretKey == "" {
+		return nil, fmt.Errorf("AK/SK credentials are required to create OBS client")
+	}
+
+	sc, err := NewOBSService(client, eo)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.SecurityToken != "" {
+		configurers = append([]obs.Configurer{obs.WithSecurityToken(opts.SecurityToken)}, configurers...)
+	}
+	return obs.New(opts.AccessKey, opts.SecretKey, sc.Endpoint, configurers...)
+}
+
 // NewDeHServiceV1 creates a ServiceClient that may be used to access the v1 Dedicated Hosts service.
 func NewDeHServiceV1(client *golangsdk.ProviderClient, eo golangsdk.EndpointOpts) (*golangsdk.ServiceClient, error) {
 	sc, err := initClientOpts(client, eo, "deh")
@@ -759,6 +893,17 @@ func NewCTSService(client *golangsdk.ProviderClient, eo golangsdk.EndpointOpts)
 	return sc, err
 }
 
+// NewCTSV3 creates a ServiceClient that can be used to access the v3 Cloud Trace service.
+func NewCTSV3(client *golangsdk.ProviderClient, eo golangsdk.EndpointOpts) (*golangsdk.ServiceClient, error) {
+	sc, err := initClientOpts(client, eo, "cts")
+	if err != nil {
+		return nil, err
+	}
+	sc.Endpoint = strings.Replace(sc.Endpoint, "v1.0", "v3", 1)
+	sc.ResourceBase = sc.Endpoint
+	return sc, err
+}
+
 // NewELBV1 creates a ServiceClient that may be used to access the ELB service.
 func NewELBV1(client *golangsdk.ProviderClient, eo golangsdk.EndpointOpts) (*golangsdk.ServiceClient, error) {
 	sc, err := initClientOpts(client, eo, "elbv1")
@@ -796,6 +941,17 @@ func NewKMSV1(client *golangsdk.ProviderClient, eo golangsdk.EndpointOpts) (*gol
 	return initClientOpts(client, eo, "kmsv1")
 }
 
+// NewCSMSV1 creates a ServiceClient that may be used to access the Cloud Secret Management service.
+func NewCSMSV1(client *golangsdk.ProviderClient, eo golangsdk.EndpointOpts) (*golangsdk.ServiceClient, error) {
+	sc, err := initClientOpts(client, eo, "kmsv1")
+	if err != nil {
+		return nil, err
+	}
+	sc.Endpoint = strings.Replace(sc.Endpoint, "v1.0", "v1", 1)
+	sc.ResourceBase = sc.Endpoint
+	return sc, err
+}
+
 // NewSMNV2 creates a ServiceClient that may be used to access the SMN service.
 func NewSMNV2(client *golangsdk.ProviderClient, eo golangsdk.EndpointOpts) (*golangsdk.ServiceClient, error) {
 	sc, err := initClientOpts(client, eo, "smnv2")
@@ -831,6 +987,16 @@ func NewWAFV1(client *golangsdk.ProviderClient, eo golangsdk.EndpointOpts) (*gol
 	return sc, err
 }
 
+// NewWAFDedicatedV1 creates a ServiceClient that may be used to access the dedicated WAF service.
+func NewWAFDedicatedV1(client *golangsdk.ProviderClient, eo golangsdk.EndpointOpts) (*golangsdk.ServiceClient, error) {
+	sc, err := initClientOpts(client, eo, "waf")
+	if err != nil {
+		return nil, err
+	}
+	sc.ResourceBase = sc.Endpoint + "v1/" + client.ProjectID + "/"
+	return sc, err
+}
+
 // NewRDSV3 creates a ServiceClient that may be used to access the RDS service.
 func NewRDSV3(client *golangsdk.ProviderClient, eo golangsdk.EndpointOpts) (*golangsdk.ServiceClient, error) {
 	return initClientOpts(client, eo, "rdsv3")
@@ -841,12 +1007,56 @@ func NewSDRSV1(client *golangsdk.ProviderClient, eo golangsdk.EndpointOpts) (*go
 	return initClientOpts(client, eo, "sdrs")
 }
 
+// NewDSSV1 creates a ServiceClient that may be used to access the v1 Dedicated Storage Service.
+func NewDSSV1(client *golangsdk.ProviderClient, eo golangsdk.EndpointOpts) (*golangsdk.ServiceClient, error) {
+	sc, err := initCommonServiceClient(client, eo, "dss", "v1")
+	return sc, err
+}
+
+// NewGaussDBV3 creates a ServiceClient that may be used to access the GaussDB(for openGauss) service.
+func NewGaussDBV3(client *golangsdk.ProviderClient, eo golangsdk.EndpointOpts) (*golangsdk.ServiceClient, error) {
+	sc, err := initCommonServiceClient(client, eo, "gaussdb-opengauss", "v3")
+	return sc, err
+}
+
+// NewDWSV1 creates a ServiceClient that may be used to access the v1 Data Warehouse Service.
+func NewDWSV1(client *golangsdk.ProviderClient, eo golangsdk.EndpointOpts) (*golangsdk.ServiceClient, error) {
+	sc, err := initCommonServiceClient(client, eo, "dws", "v1.0")
+	return sc, err
+}
+
 // NewLTSV2 creates a ServiceClient that may be used to access the LTS service.
 func NewLTSV2(client *golangsdk.ProviderClient, eo golangsdk.EndpointOpts) (*golangsdk.ServiceClient, error) {
 	sc, err := initCommonServiceClient(client, eo, "lts", "v2.0")
 	return sc, err
 }
 
+// NewLTSV2Client creates a ServiceClient rooted at `v2` instead of `v2.0` of NewLTSV2,
+// it may be used to access the LTS log transfers, structuring and keyword alarms.
+func NewLTSV2Client(client *golangsdk.ProviderClient, eo golangsdk.EndpointOpts) (*golangsdk.ServiceClient, error) {
+	return initCommonServiceClient(client, eo, "lts", "v2")
+}
+
+// NewAPIGV2 creates a ServiceClient that may be used to access the v2 dedicated API Gateway service.
+func NewAPIGV2(client *golangsdk.ProviderClient, eo golangsdk.EndpointOpts) (*golangsdk.ServiceClient, error) {
+	return initCommonServiceClient(client, eo, "apig", "v2")
+}
+
+// NewDISV2 creates a ServiceClient that may be used to access the v2 Data Ingestion Service.
+func NewDISV2(client *golangsdk.ProviderClient, eo golangsdk.EndpointOpts) (*golangsdk.ServiceClient, error) {
+	return initCommonServiceClient(client, eo, "dis", "v2")
+}
+
+// NewDLIV1 creates a ServiceClient that may be used to access the v1 Data Lake Insight service.
+func NewDLIV1(client *golangsdk.ProviderClient, eo golangsdk.EndpointOpts) (*golangsdk.ServiceClient, error) {
+	return initCommonServiceClient(client, eo, "dli", "v1.0")
+}
+
+// NewFGSV2 creates a ServiceClient that may be used to access the v2 FunctionGraph service.
+func NewFGSV2(client *golangsdk.ProviderClient, eo golangsdk.EndpointOpts) (*golangsdk.ServiceClient, error) {
+	return initCommonServiceClient(client, eo, "functiongraph", "v2")
+}
+
 // NewSWRV2 creates a ServiceClient that may be used to access the SWR service.
 func NewSWRV2(client *golangsdk.ProviderClient, eo golangsdk.EndpointOpts) (*golangsdk.ServiceClient, error) {
 	serviceClient, err := initClientOpts(client, eo, "smn") // SMN is v2 and has no project ID
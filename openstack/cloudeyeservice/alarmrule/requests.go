@@ -6,6 +6,30 @@ import (
 	"github.com/opentelekomcloud/gophertelekomcloud"
 )
 
+// Comparison operators of the alarm condition.
+const (
+	OperatorGreater        = ">"
+	OperatorGreaterOrEqual = ">="
+	OperatorLess           = "<"
+	OperatorLessOrEqual    = "<="
+	OperatorEqual          = "="
+)
+
+// Alarm action types: `notification` sends the alarm to SMN topics,
+// `autoscaling` triggers AS policies.
+const (
+	ActionNotification = "notification"
+	ActionAutoscaling  = "autoscaling"
+)
+
+// Alarm types: metric alarms are created with an empty type, `EVENT.SYS` and `EVENT.CUSTOM`
+// are event alarms, `MULTI_INSTANCE` monitors all resources set in the metric dimensions.
+const (
+	AlarmTypeEventSys      = "EVENT.SYS"
+	AlarmTypeEventCustom   = "EVENT.CUSTOM"
+	AlarmTypeMultiInstance = "MULTI_INSTANCE"
+)
+
 type CreateOptsBuilder interface {
 	ToAlarmRuleCreateMap() (map[string]interface{}, error)
 }
@@ -29,10 +53,16 @@ type ConditionOpts struct {
 	Value int    `json:"value"`
 	Unit  string `json:"unit,omitempty"`
 	Count int    `json:"count" required:"true"`
+	// SuppressDuration is the interval in seconds at which the alarm is triggered repeatedly,
+	// 0 disables repeating
+	SuppressDuration int `json:"suppress_duration,omitempty"`
 }
 
 type ActionOpts struct {
-	Type             string   `json:"type" required:"true"`
+	// Type is either `notification` or `autoscaling`
+	Type string `json:"type" required:"true"`
+	// NotificationList contains SMN topic URNs for `notification` actions,
+	// it must be set to an empty slice for `autoscaling` actions
 	NotificationList []string `json:"notificationList" required:"true"`
 }
 
@@ -100,3 +130,123 @@ func Delete(c *golangsdk.ServiceClient, id string) (r DeleteResult) {
 	_, r.Err = c.Delete(resourceURL(c, id), reqOpt)
 	return
 }
+
+// Enable is a method which enables the alarm rule
+func Enable(c *golangsdk.ServiceClient, id string) (r UpdateResult) {
+	return Update(c, id, UpdateOpts{AlarmEnabled: true})
+}
+
+// Disable is a method which disables the alarm rule
+func Disable(c *golangsdk.ServiceClient, id string) (r UpdateResult) {
+	return Update(c, id, UpdateOpts{AlarmEnabled: false})
+}
+
+type UpdateRuleOptsBuilder interface {
+	ToAlarmRuleUpdateRuleMap() (map[string]interface{}, error)
+}
+
+// UpdateRuleOpts contains the alarm rule parameters which can be changed, unset values are kept
+type UpdateRuleOpts struct {
+	AlarmName               string         `json:"alarm_name,omitempty"`
+	AlarmDescription        *string        `json:"alarm_description,omitempty"`
+	AlarmLevel              int            `json:"alarm_level,omitempty"`
+	Condition               *ConditionOpts `json:"condition,omitempty"`
+	AlarmActions            []ActionOpts   `json:"alarm_actions,omitempty"`
+	InsufficientdataActions []ActionOpts   `json:"insufficientdata_actions,omitempty"`
+	OkActions               []ActionOpts   `json:"ok_actions,omitempty"`
+	AlarmActionEnabled      *bool          `json:"alarm_action_enabled,omitempty"`
+}
+
+func (opts UpdateRuleOpts) ToAlarmRuleUpdateRuleMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// UpdateRule is a method which changes the alarm rule definition
+func UpdateRule(c *golangsdk.ServiceClient, id string, opts UpdateRuleOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToAlarmRuleUpdateRuleMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Put(resourceURL(c, id), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+type ListOptsBuilder interface {
+	ToAlarmRuleListQuery() (string, error)
+}
+
+type ListOpts struct {
+	// Limit ranges from 1 to 100, 100 by default
+	Limit int `q:"limit"`
+	// Order is either `asc` or `desc`
+	Order string `q:"order"`
+	// Start is the alarm ID to start the listing from
+	Start string `q:"start"`
+}
+
+func (opts ListOpts) ToAlarmRuleListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), err
+}
+
+// List is a method which lists the alarm rules of the project
+func List(c *golangsdk.ServiceClient, opts ListOptsBuilder) (r ListResult) {
+	url := rootURL(c)
+	if opts != nil {
+		q, err := opts.ToAlarmRuleListQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += q
+	}
+	_, r.Err = c.Get(url, &r.Body, nil)
+	return
+}
+
+type ListHistoryOptsBuilder interface {
+	ToAlarmHistoryListQuery() (string, error)
+}
+
+type ListHistoryOpts struct {
+	AlarmID string `q:"alarm_id"`
+	Name    string `q:"name"`
+	// Status is one of `ok`, `alarm`, `insufficient_data` or `invalid`
+	Status    string `q:"status"`
+	Level     int    `q:"level"`
+	Namespace string `q:"namespace"`
+	// From and To are UNIX timestamps in milliseconds
+	From  string `q:"from"`
+	To    string `q:"to"`
+	Start int    `q:"start"`
+	Limit int    `q:"limit"`
+}
+
+func (opts ListHistoryOpts) ToAlarmHistoryListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), err
+}
+
+// ListHistory is a method which lists the alarm histories of the project
+func ListHistory(c *golangsdk.ServiceClient, opts ListHistoryOptsBuilder) (r ListHistoryResult) {
+	url := historyURL(c)
+	if opts != nil {
+		q, err := opts.ToAlarmHistoryListQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += q
+	}
+	_, r.Err = c.Get(url, &r.Body, nil)
+	return
+}
@@ -37,6 +37,7 @@ type ConditionInfo struct {
 	Value              int    `json:"value"`
 	Unit               string `json:"unit"`
 	Count              int    `json:"count"`
+	SuppressDuration   int    `json:"suppress_duration"`
 }
 
 type ActionInfo struct {
@@ -45,6 +46,7 @@ type ActionInfo struct {
 }
 
 type AlarmRule struct {
+	AlarmID                 string        `json:"alarm_id"`
 	AlarmName               string        `json:"alarm_name"`
 	AlarmDescription        string        `json:"alarm_description"`
 	AlarmType               string        `json:"alarm_type"`
@@ -85,3 +87,57 @@ type UpdateResult struct {
 type DeleteResult struct {
 	golangsdk.ErrResult
 }
+
+type MetaData struct {
+	Count  int    `json:"count"`
+	Marker string `json:"marker"`
+	Total  int    `json:"total"`
+}
+
+type ListResult struct {
+	golangsdk.Result
+}
+
+type ListResponse struct {
+	MetricAlarms []AlarmRule `json:"metric_alarms"`
+	MetaData     MetaData    `json:"meta_data"`
+}
+
+func (l ListResult) Extract() (*ListResponse, error) {
+	r := &ListResponse{}
+	return r, l.ExtractInto(r)
+}
+
+type DataPoint struct {
+	Time  int64   `json:"time"`
+	Value float64 `json:"value"`
+}
+
+type AlarmHistory struct {
+	AlarmID           string        `json:"alarm_id"`
+	Name              string        `json:"name"`
+	Status            string        `json:"status"`
+	Level             int           `json:"level"`
+	Type              string        `json:"type"`
+	ActionEnabled     bool          `json:"action_enabled"`
+	BeginTime         string        `json:"begin_time"`
+	EndTime           string        `json:"end_time"`
+	FirstAlarmTime    string        `json:"first_alarm_time"`
+	LastAlarmTime     string        `json:"last_alarm_time"`
+	AlarmRecoveryTime string        `json:"alarm_recovery_time"`
+	Metric            MetricInfo    `json:"metric"`
+	Condition         ConditionInfo `json:"condition"`
+	AlarmActions      []ActionInfo  `json:"alarm_actions"`
+	OkActions         []ActionInfo  `json:"ok_actions"`
+	DataPoints        []DataPoint   `json:"data_points"`
+}
+
+type ListHistoryResult struct {
+	golangsdk.Result
+}
+
+func (l ListHistoryResult) Extract() ([]AlarmHistory, error) {
+	var s []AlarmHistory
+	err := l.ExtractIntoSlicePtr(&s, "alarm_histories")
+	return s, err
+}
@@ -0,0 +1,153 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const alarmID = "al1619578509719Ga0X1RGWv"
+
+const expectedUpdateRuleRequest = `
+{
+  "alarm_name": "alarm-updated",
+  "alarm_description": "",
+  "condition": {
+    "period": 300,
+    "filter": "average",
+    "comparison_operator": ">=",
+    "value": 80,
+    "unit": "%",
+    "count": 3
+  },
+  "alarm_action_enabled": false
+}`
+
+const expectedEnableRequest = `{"alarm_enabled": true}`
+
+const expectedDisableRequest = `{"alarm_enabled": false}`
+
+var listResponse = fmt.Sprintf(`
+{
+  "metric_alarms": [
+    {
+      "alarm_id": "%s",
+      "alarm_name": "alarm-test",
+      "alarm_description": "",
+      "alarm_level": 2,
+      "metric": {
+        "namespace": "SYS.ECS",
+        "metric_name": "cpu_util",
+        "dimensions": [
+          {
+            "name": "instance_id",
+            "value": "07814c0e-59a1-4fcd-a6fb-56f2f6923046"
+          }
+        ]
+      },
+      "condition": {
+        "period": 300,
+        "filter": "average",
+        "comparison_operator": ">=",
+        "value": 80,
+        "unit": "%%",
+        "count": 3
+      },
+      "alarm_enabled": true,
+      "alarm_action_enabled": false,
+      "update_time": 1619578509972,
+      "alarm_state": "ok"
+    }
+  ],
+  "meta_data": {
+    "count": 1,
+    "marker": "%s",
+    "total": 1
+  }
+}`, alarmID, alarmID)
+
+var historyResponse = fmt.Sprintf(`
+{
+  "alarm_histories": [
+    {
+      "alarm_id": "%s",
+      "name": "alarm-test",
+      "status": "alarm",
+      "level": 2,
+      "type": "EVENT.SYS",
+      "action_enabled": false,
+      "begin_time": "2021-04-28T03:08:30.000+0000",
+      "end_time": "",
+      "metric": {
+        "namespace": "SYS.ECS",
+        "metric_name": "cpu_util",
+        "dimensions": []
+      },
+      "condition": {
+        "period": 300,
+        "filter": "average",
+        "comparison_operator": ">=",
+        "value": 80,
+        "unit": "%%",
+        "count": 3
+      },
+      "data_points": [
+        {
+          "time": 1619579310000,
+          "value": 91.5
+        }
+      ]
+    }
+  ]
+}`, alarmID)
+
+// HandleUpdateRuleSuccessfully creates an HTTP handler at `/alarms/{alarm_id}` on the test handler
+// mux that responds to a PUT request.
+func HandleUpdateRuleSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/alarms/%s", alarmID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, expectedUpdateRuleRequest)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandleActionSuccessfully creates an HTTP handler at `/alarms/{alarm_id}/action` on the test
+// handler mux that responds to a PUT request.
+func HandleActionSuccessfully(t *testing.T, expectedRequest string) {
+	th.Mux.HandleFunc(fmt.Sprintf("/alarms/%s/action", alarmID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, expectedRequest)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandleListSuccessfully creates an HTTP handler at `/alarms` on the test handler mux that responds
+// to a GET request with listResponse.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/alarms", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"limit": "10", "order": "desc"})
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, listResponse)
+	})
+}
+
+// HandleListHistorySuccessfully creates an HTTP handler at `/alarm-histories` on the test handler
+// mux that responds to a GET request with historyResponse.
+func HandleListHistorySuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/alarm-histories", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"alarm_id": alarmID, "status": "alarm"})
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, historyResponse)
+	})
+}
@@ -0,0 +1,80 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/cloudeyeservice/alarmrule"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestUpdateRule(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateRuleSuccessfully(t)
+
+	description := ""
+	actionEnabled := false
+	opts := alarmrule.UpdateRuleOpts{
+		AlarmName:        "alarm-updated",
+		AlarmDescription: &description,
+		Condition: &alarmrule.ConditionOpts{
+			Period:             300,
+			Filter:             "average",
+			ComparisonOperator: alarmrule.OperatorGreaterOrEqual,
+			Value:              80,
+			Unit:               "%",
+			Count:              3,
+		},
+		AlarmActionEnabled: &actionEnabled,
+	}
+	th.AssertNoErr(t, alarmrule.UpdateRule(fake.ServiceClient(), alarmID, opts).ExtractErr())
+}
+
+func TestEnable(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleActionSuccessfully(t, expectedEnableRequest)
+
+	th.AssertNoErr(t, alarmrule.Enable(fake.ServiceClient(), alarmID).ExtractErr())
+}
+
+func TestDisable(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleActionSuccessfully(t, expectedDisableRequest)
+
+	th.AssertNoErr(t, alarmrule.Disable(fake.ServiceClient(), alarmID).ExtractErr())
+}
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	list, err := alarmrule.List(fake.ServiceClient(), alarmrule.ListOpts{
+		Limit: 10,
+		Order: "desc",
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(list.MetricAlarms))
+	th.AssertEquals(t, alarmID, list.MetricAlarms[0].AlarmID)
+	th.AssertEquals(t, "cpu_util", list.MetricAlarms[0].Metric.MetricName)
+	th.AssertEquals(t, 1, list.MetaData.Total)
+	th.AssertEquals(t, alarmID, list.MetaData.Marker)
+}
+
+func TestListHistory(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListHistorySuccessfully(t)
+
+	histories, err := alarmrule.ListHistory(fake.ServiceClient(), alarmrule.ListHistoryOpts{
+		AlarmID: alarmID,
+		Status:  "alarm",
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(histories))
+	th.AssertEquals(t, "alarm", histories[0].Status)
+	th.AssertEquals(t, 91.5, histories[0].DataPoints[0].Value)
+}
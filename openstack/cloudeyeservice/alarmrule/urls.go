@@ -17,3 +17,7 @@ func resourceURL(c *golangsdk.ServiceClient, id string) string {
 func actionURL(c *golangsdk.ServiceClient, id string) string {
 	return c.ServiceURL(rootPath, id, "action")
 }
+
+func historyURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("alarm-histories")
+}
@@ -19,3 +19,21 @@ func ExtractIDs(refs []ResourceRef) []string {
 	}
 	return ids
 }
+
+// QuotaResource is a single resource quota as returned by the database services (RDS, DDS, DCS, DMS).
+type QuotaResource struct {
+	// Resource type, e.g. `instance`, `volume` or `ram`
+	Type string `json:"type"`
+	// Instance mode the quota applies to, returned only by some services
+	Mode string `json:"mode"`
+	// Unit of the quota value
+	Unit string `json:"unit"`
+	// Quota value, -1 means unlimited
+	Quota int `json:"quota"`
+	// Used amount
+	Used int `json:"used"`
+	// Minimum quota value
+	Min int `json:"min"`
+	// Maximum quota value
+	Max int `json:"max"`
+}
@@ -1,6 +1,8 @@
 package backup
 
 import (
+	"fmt"
+
 	"github.com/opentelekomcloud/gophertelekomcloud"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/tags"
 	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
@@ -153,9 +155,33 @@ func Get(client *golangsdk.ServiceClient, backupID string) (r GetResult) {
 	return
 }
 
+// GetCheckpoint will get a single checkpoint with specific ID. To extract the Checkpoint object
+// from the response, call the Extract method on the CheckpointResult.
+func GetCheckpoint(client *golangsdk.ServiceClient, checkpointID string) (r CheckpointResult) {
+	_, r.Err = client.Get(checkpointURL(client, checkpointID), &r.Body, nil)
+	return
+}
+
+// WaitForCheckpointAvailable will wait until the checkpoint status becomes `available`.
+func WaitForCheckpointAvailable(client *golangsdk.ServiceClient, checkpointID string, secs int) error {
+	return golangsdk.WaitFor(secs, func() (bool, error) {
+		checkpoint, err := GetCheckpoint(client, checkpointID).Extract()
+		if err != nil {
+			return false, err
+		}
+		if checkpoint.Status == "available" {
+			return true, nil
+		}
+		if checkpoint.Status == "error" {
+			return false, fmt.Errorf("checkpoint %s is in error status", checkpointID)
+		}
+		return false, nil
+	})
+}
+
 // Delete will delete an existing backup.
 func Delete(client *golangsdk.ServiceClient, checkpointID string) (r DeleteResult) {
-	_, r.Err = client.Delete(deleteURL(client, checkpointID), &golangsdk.RequestOpts{
+	_, r.Err = client.Delete(checkpointURL(client, checkpointID), &golangsdk.RequestOpts{
 		OkCodes:      []int{200},
 		JSONResponse: nil,
 	})
@@ -127,6 +127,16 @@ func (r CreateResult) Extract() (*Checkpoint, error) {
 	return s, nil
 }
 
+// Extract will get the checkpoint object from the CheckpointResult
+func (r CheckpointResult) Extract() (*Checkpoint, error) {
+	s := new(Checkpoint)
+	err := r.ExtractIntoStructPtr(s, "checkpoint")
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
 // Extract will get the backup object from the golangsdk.Result
 func (r GetResult) Extract() (*Backup, error) {
 	s := new(Backup)
@@ -187,6 +197,10 @@ type GetResult struct {
 	golangsdk.Result
 }
 
+type CheckpointResult struct {
+	golangsdk.Result
+}
+
 type QueryResult struct {
 	golangsdk.Result
 }
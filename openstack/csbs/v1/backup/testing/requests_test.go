@@ -171,3 +171,22 @@ func TestList(t *testing.T) {
 
 	th.AssertDeepEquals(t, expected, actual)
 }
+
+func TestGetCheckpoint(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/providers/fc4d5750-22e7-4798-8a46-f48f62c4c1da/checkpoints/92dba83d-cc6f-4883-a20d-de6934510b7e",
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "GET")
+			th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprint(w, createResponse)
+		})
+
+	checkpoint, err := backup.GetCheckpoint(fake.ServiceClient(), "92dba83d-cc6f-4883-a20d-de6934510b7e").Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "92dba83d-cc6f-4883-a20d-de6934510b7e", checkpoint.Id)
+	th.AssertEquals(t, "protecting", checkpoint.Status)
+}
@@ -17,7 +17,7 @@ func getURL(c *golangsdk.ServiceClient, checkpointItemID string) string {
 	return c.ServiceURL("checkpoint_items", checkpointItemID)
 }
 
-func deleteURL(c *golangsdk.ServiceClient, checkpointID string) string {
+func checkpointURL(c *golangsdk.ServiceClient, checkpointID string) string {
 	return c.ServiceURL(rootPath, providerID, "checkpoints", checkpointID)
 }
 
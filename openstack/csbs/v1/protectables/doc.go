@@ -0,0 +1,23 @@
+/*
+Package protectables enables retrieval of the objects
+which can be protected by the Cloud Server Backup Service.
+
+Example to List protectable servers
+
+	allPages, err := protectables.List(client, protectables.ListOpts{Status: "active"}).AllPages()
+	if err != nil {
+		panic(err)
+	}
+	instances, err := protectables.ExtractInstances(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Get a protectable server
+
+	instance, err := protectables.Get(client, "069e678a-f1d1-4a38-880b-459bde82fcc6").Extract()
+	if err != nil {
+		panic(err)
+	}
+*/
+package protectables
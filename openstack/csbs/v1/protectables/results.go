@@ -0,0 +1,88 @@
+package protectables
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
+)
+
+type Instance struct {
+	ID                 string              `json:"id"`
+	Type               string              `json:"type"`
+	Name               string              `json:"name"`
+	Status             string              `json:"status"`
+	ExtraInfo          InstanceExtraInfo   `json:"extra_info"`
+	DependentResources []DependentResource `json:"dependent_resources"`
+	Children           []Instance          `json:"children"`
+}
+
+type InstanceExtraInfo struct {
+	ArchitectureType string            `json:"architecture"`
+	Protectable      ProtectableResult `json:"protectable"`
+	Size             string            `json:"size"`
+	NetworkIP        string            `json:"network_ip"`
+	ImageType        string            `json:"image_type"`
+	AvailabilityZone string            `json:"availability_zone"`
+}
+
+type ProtectableResult struct {
+	Result  bool   `json:"result"`
+	Reason  string `json:"reason"`
+	Message string `json:"message"`
+	Code    string `json:"code"`
+}
+
+type DependentResource struct {
+	ID        string      `json:"id"`
+	Name      string      `json:"name"`
+	Type      string      `json:"type"`
+	ExtraInfo interface{} `json:"extra_info"`
+}
+
+type GetResult struct {
+	golangsdk.Result
+}
+
+// Extract will get the Instance object from the GetResult
+func (r GetResult) Extract() (*Instance, error) {
+	var s struct {
+		Instance *Instance `json:"instance"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Instance, err
+}
+
+// InstancePage is the page returned by a pager when traversing over a
+// collection of protectable instances.
+type InstancePage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of instances has reached
+// the end of a page and the pager seeks to traverse over a new one.
+func (r InstancePage) NextPageURL() (string, error) {
+	var s struct {
+		Links []golangsdk.Link `json:"instances_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return golangsdk.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether an InstancePage struct is empty.
+func (r InstancePage) IsEmpty() (bool, error) {
+	is, err := ExtractInstances(r)
+	return len(is) == 0, err
+}
+
+// ExtractInstances accepts a Page struct, specifically a InstancePage struct,
+// and extracts the elements into a slice of Instance structs.
+func ExtractInstances(r pagination.Page) ([]Instance, error) {
+	var s []Instance
+	err := (r.(InstancePage)).ExtractIntoSlicePtr(&s, "instances")
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
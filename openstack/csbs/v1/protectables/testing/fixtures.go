@@ -0,0 +1,55 @@
+package testing
+
+const (
+	instancesEndpoint = "/protectables/OS::Nova::Server/instances"
+	instanceID        = "069e678a-f1d1-4a38-880b-459bde82fcc6"
+)
+
+var listResponse = `
+{
+  "instances" : [ {
+    "status" : "active",
+    "name" : "ecs-test",
+    "extra_info" : {
+      "architecture" : "x86_64",
+      "protectable" : {
+        "result" : true,
+        "reason" : "",
+        "message" : "",
+        "code" : ""
+      },
+      "size" : "40",
+      "network_ip" : "192.168.0.12",
+      "image_type" : "gold",
+      "availability_zone" : "eu-de-01"
+    },
+    "dependent_resources" : [ ],
+    "type" : "OS::Nova::Server",
+    "id" : "069e678a-f1d1-4a38-880b-459bde82fcc6",
+    "children" : [ {
+      "status" : "in-use",
+      "name" : "ecs-test-volume",
+      "extra_info" : {
+        "size" : "40"
+      },
+      "type" : "OS::Cinder::Volume",
+      "id" : "4e4bcfd7-5c5c-4a86-8b63-4de5e2af5c7c"
+    } ]
+  } ],
+  "instances_links" : [ ]
+}`
+
+var getResponse = `
+{
+  "instance" : {
+    "status" : "active",
+    "name" : "ecs-test",
+    "extra_info" : {
+      "architecture" : "x86_64",
+      "size" : "40",
+      "availability_zone" : "eu-de-01"
+    },
+    "type" : "OS::Nova::Server",
+    "id" : "069e678a-f1d1-4a38-880b-459bde82fcc6"
+  }
+}`
@@ -0,0 +1,53 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/csbs/v1/protectables"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc(instancesEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"status": "active"})
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, listResponse)
+	})
+
+	pages, err := protectables.List(fake.ServiceClient(), protectables.ListOpts{Status: "active"}).AllPages()
+	th.AssertNoErr(t, err)
+	actual, err := protectables.ExtractInstances(pages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(actual))
+	th.AssertEquals(t, instanceID, actual[0].ID)
+	th.AssertEquals(t, true, actual[0].ExtraInfo.Protectable.Result)
+	th.AssertEquals(t, 1, len(actual[0].Children))
+	th.AssertEquals(t, "OS::Cinder::Volume", actual[0].Children[0].Type)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc(instancesEndpoint+"/"+instanceID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, getResponse)
+	})
+
+	actual, err := protectables.Get(fake.ServiceClient(), instanceID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "ecs-test", actual.Name)
+	th.AssertEquals(t, "eu-de-01", actual.ExtraInfo.AvailabilityZone)
+}
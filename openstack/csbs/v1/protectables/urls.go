@@ -0,0 +1,17 @@
+package protectables
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+const (
+	rootPath         = "protectables"
+	protectableType  = "OS::Nova::Server"
+	instancesSubPath = "instances"
+)
+
+func listURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL(rootPath, protectableType, instancesSubPath)
+}
+
+func getURL(c *golangsdk.ServiceClient, id string) string {
+	return c.ServiceURL(rootPath, protectableType, instancesSubPath, id)
+}
@@ -0,0 +1,33 @@
+/*
+Package secrets enables management and retrieval of secrets and their
+versions through the Cloud Secret Management Service (CSMS).
+
+Example to create a secret
+
+	createOpts := secrets.CreateOpts{
+		Name:         "db-password",
+		SecretString: "s3cr3t",
+	}
+	secret, err := secrets.Create(client, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to fetch the current value of a secret
+
+	version, err := secrets.GetVersion(client, "db-password", secrets.VersionLatest).Extract()
+	if err != nil {
+		panic(err)
+	}
+	password := version.SecretString
+
+Example to generate a random password using the KMS service client
+
+	password, err := secrets.GeneratePassword(kmsClient, secrets.PasswordOpts{
+		Length: 24,
+	})
+	if err != nil {
+		panic(err)
+	}
+*/
+package secrets
@@ -0,0 +1,84 @@
+package secrets
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/kms/v1/keys"
+)
+
+const (
+	lowerChars   = "abcdefghijklmnopqrstuvwxyz"
+	upperChars   = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	digitChars   = "0123456789"
+	specialChars = "!@#$%^&*()-_=+[]{}<>:?"
+)
+
+// PasswordOpts configures the generated password.
+type PasswordOpts struct {
+	// Password length, 32 characters are used if 0
+	Length int
+	// Exclude the lowercase letters
+	ExcludeLowercase bool
+	// Exclude the uppercase letters
+	ExcludeUppercase bool
+	// Exclude the digits
+	ExcludeNumbers bool
+	// Exclude the special characters
+	ExcludePunctuation bool
+}
+
+func (opts PasswordOpts) charset() string {
+	var chars string
+	if !opts.ExcludeLowercase {
+		chars += lowerChars
+	}
+	if !opts.ExcludeUppercase {
+		chars += upperChars
+	}
+	if !opts.ExcludeNumbers {
+		chars += digitChars
+	}
+	if !opts.ExcludePunctuation {
+		chars += specialChars
+	}
+	return chars
+}
+
+// GeneratePassword builds a random password from the random numbers generated
+// by the KMS service, so kmsClient has to be a KMS v1 service client.
+func GeneratePassword(kmsClient *golangsdk.ServiceClient, opts PasswordOpts) (string, error) {
+	length := opts.Length
+	if length == 0 {
+		length = 32
+	}
+	chars := opts.charset()
+	if chars == "" {
+		return "", fmt.Errorf("at least one character class has to be included")
+	}
+	// drop the values above the highest multiple of the charset size to keep the distribution uniform
+	limit := 256 - 256%len(chars)
+
+	password := make([]byte, 0, length)
+	for len(password) < length {
+		random, err := keys.GenRandom(kmsClient, keys.GenRandomOpts{RandomDataLength: "512"}).ExtractRandom()
+		if err != nil {
+			return "", err
+		}
+		data, err := hex.DecodeString(random.RandomData)
+		if err != nil {
+			return "", fmt.Errorf("error decoding random data: %s", err)
+		}
+		for _, b := range data {
+			if int(b) >= limit {
+				continue
+			}
+			password = append(password, chars[int(b)%len(chars)])
+			if len(password) == length {
+				break
+			}
+		}
+	}
+	return string(password), nil
+}
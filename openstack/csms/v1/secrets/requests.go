@@ -0,0 +1,217 @@
+package secrets
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
+)
+
+const (
+	// VersionLatest is the alias of the latest secret version
+	VersionLatest = "latest"
+	// StageCurrent marks the version currently in use
+	StageCurrent = "SYSCURRENT"
+	// StagePrevious marks the previously used version
+	StagePrevious = "SYSPREVIOUS"
+)
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToSecretCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts contains all the values needed to create a new secret.
+type CreateOpts struct {
+	// Secret name, 1 to 64 characters matching ^[a-zA-Z0-9._-]{1,64}$
+	Name string `json:"name" required:"true"`
+	// ID of the KMS CMK used to encrypt the secret.
+	// The default master key csms/default is used if empty.
+	KmsKeyID string `json:"kms_key_id,omitempty"`
+	// Description of the secret
+	Description string `json:"description,omitempty"`
+	// Binary secret value encoded in Base64
+	SecretBinary string `json:"secret_binary,omitempty"`
+	// Text secret value
+	SecretString string `json:"secret_string,omitempty"`
+}
+
+// ToSecretCreateMap builds a create request body from CreateOpts.
+func (opts CreateOpts) ToSecretCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Create accepts a CreateOpts struct and uses the values to create a new secret.
+func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToSecretCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(rootURL(client), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToSecretListQuery() (string, error)
+}
+
+// ListOpts allows to filter the list of secrets.
+type ListOpts struct {
+	// Number of records returned per page
+	Limit int `q:"limit"`
+	// Name of the secret the query starts after
+	Marker string `q:"marker"`
+}
+
+// ToSecretListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToSecretListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of secrets.
+func List(client *golangsdk.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(client)
+	if opts != nil {
+		query, err := opts.ToSecretListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return SecretPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves the secret with the provided name.
+func Get(client *golangsdk.ServiceClient, name string) (r GetResult) {
+	_, r.Err = client.Get(secretURL(client, name), &r.Body, nil)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToSecretUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts contains all the values needed to update a secret.
+type UpdateOpts struct {
+	// ID of the KMS CMK used to encrypt the new secret versions
+	KmsKeyID string `json:"kms_key_id,omitempty"`
+	// Description of the secret
+	Description *string `json:"description,omitempty"`
+}
+
+// ToSecretUpdateMap builds an update request body from UpdateOpts.
+func (opts UpdateOpts) ToSecretUpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Update accepts a UpdateOpts struct and updates the secret with the provided name.
+func Update(client *golangsdk.ServiceClient, name string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToSecretUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(secretURL(client, name), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete immediately deletes the secret with the provided name and all its versions.
+func Delete(client *golangsdk.ServiceClient, name string) (r DeleteResult) {
+	_, r.Err = client.Delete(secretURL(client, name), &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// CreateVersionOptsBuilder allows extensions to add additional parameters to the
+// CreateVersion request.
+type CreateVersionOptsBuilder interface {
+	ToSecretVersionCreateMap() (map[string]interface{}, error)
+}
+
+// CreateVersionOpts contains all the values needed to create a new secret version.
+type CreateVersionOpts struct {
+	// Binary secret value encoded in Base64
+	SecretBinary string `json:"secret_binary,omitempty"`
+	// Text secret value
+	SecretString string `json:"secret_string,omitempty"`
+	// Stages of the new version, SYSCURRENT is used if empty
+	VersionStages []string `json:"version_stages,omitempty"`
+}
+
+// ToSecretVersionCreateMap builds a create request body from CreateVersionOpts.
+func (opts CreateVersionOpts) ToSecretVersionCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// CreateVersion creates a new version of the secret with the provided name.
+func CreateVersion(client *golangsdk.ServiceClient, name string, opts CreateVersionOptsBuilder) (r CreateVersionResult) {
+	b, err := opts.ToSecretVersionCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(versionsURL(client, name), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// ListVersions returns a Pager which allows you to iterate over the versions of a secret.
+func ListVersions(client *golangsdk.ServiceClient, name string, opts ListOptsBuilder) pagination.Pager {
+	url := versionsURL(client, name)
+	if opts != nil {
+		query, err := opts.ToSecretListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return VersionPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// GetVersion retrieves the secret version with the provided ID.
+// Use VersionLatest to retrieve the latest version.
+func GetVersion(client *golangsdk.ServiceClient, name, versionID string) (r GetVersionResult) {
+	_, r.Err = client.Get(versionURL(client, name, versionID), &r.Body, nil)
+	return
+}
+
+// UpdateStage moves the version stage with the provided name to the version.
+func UpdateStage(client *golangsdk.ServiceClient, name, stage, versionID string) (r StageResult) {
+	b := map[string]interface{}{"version_id": versionID}
+	_, r.Err = client.Put(stageURL(client, name, stage), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// GetStage retrieves the version stage with the provided name.
+func GetStage(client *golangsdk.ServiceClient, name, stage string) (r StageResult) {
+	_, r.Err = client.Get(stageURL(client, name, stage), &r.Body, nil)
+	return
+}
+
+// DeleteStage deletes the version stage with the provided name.
+func DeleteStage(client *golangsdk.ServiceClient, name, stage string) (r DeleteResult) {
+	_, r.Err = client.Delete(stageURL(client, name, stage), &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
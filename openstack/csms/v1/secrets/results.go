@@ -0,0 +1,191 @@
+package secrets
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
+)
+
+// Secret contains the secret metadata, the secret value is kept in its versions.
+type Secret struct {
+	ID                  string `json:"id"`
+	Name                string `json:"name"`
+	State               string `json:"state"`
+	KmsKeyID            string `json:"kms_key_id"`
+	Description         string `json:"description"`
+	CreateTime          int64  `json:"create_time"`
+	UpdateTime          int64  `json:"update_time"`
+	ScheduledDeleteTime int64  `json:"scheduled_delete_time"`
+}
+
+// VersionMetadata describes a secret version.
+type VersionMetadata struct {
+	ID            string   `json:"id"`
+	CreateTime    int64    `json:"create_time"`
+	ExpireTime    int64    `json:"expire_time"`
+	KmsKeyID      string   `json:"kms_key_id"`
+	SecretName    string   `json:"secret_name"`
+	VersionStages []string `json:"version_stages"`
+}
+
+// Version contains the secret version metadata and the secret value.
+type Version struct {
+	Metadata     VersionMetadata `json:"version_metadata"`
+	SecretBinary string          `json:"secret_binary"`
+	SecretString string          `json:"secret_string"`
+}
+
+// Stage describes a version stage of a secret.
+type Stage struct {
+	Name       string `json:"name"`
+	UpdateTime int64  `json:"update_time"`
+	SecretName string `json:"secret_name"`
+	VersionID  string `json:"version_id"`
+}
+
+// PageInfo contains the pagination details of the listing.
+type PageInfo struct {
+	NextMarker     string `json:"next_marker"`
+	PreviousMarker string `json:"previous_marker"`
+	CurrentCount   int    `json:"current_count"`
+}
+
+type commonResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets any commonResult as a Secret.
+func (r commonResult) Extract() (*Secret, error) {
+	var s Secret
+	err := r.ExtractIntoStructPtr(&s, "secret")
+	if err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// CreateResult represents the result of a create operation.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a get operation.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an update operation.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation.
+type DeleteResult struct {
+	golangsdk.ErrResult
+}
+
+// CreateVersionResult represents the result of a version create operation.
+type CreateVersionResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets a CreateVersionResult as a VersionMetadata.
+func (r CreateVersionResult) Extract() (*VersionMetadata, error) {
+	var s VersionMetadata
+	err := r.ExtractIntoStructPtr(&s, "version_metadata")
+	if err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// GetVersionResult represents the result of a version get operation.
+type GetVersionResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets a GetVersionResult as a Version.
+func (r GetVersionResult) Extract() (*Version, error) {
+	var s Version
+	err := r.ExtractIntoStructPtr(&s, "version")
+	if err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// StageResult represents the result of a version stage operation.
+type StageResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets a StageResult as a Stage.
+func (r StageResult) Extract() (*Stage, error) {
+	var s Stage
+	err := r.ExtractIntoStructPtr(&s, "stage")
+	if err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// SecretPage is a single page of secrets.
+type SecretPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty returns true if a page contains no secrets.
+func (r SecretPage) IsEmpty() (bool, error) {
+	s, err := ExtractSecrets(r)
+	return len(s) == 0, err
+}
+
+// NextPageURL uses the next marker of the page info to construct the next page's URL.
+func (r SecretPage) NextPageURL() (string, error) {
+	return nextPageURL(r.LinkedPageBase)
+}
+
+// ExtractSecrets interprets a page of results as a slice of Secret.
+func ExtractSecrets(r pagination.Page) ([]Secret, error) {
+	var s []Secret
+	err := (r.(SecretPage)).ExtractIntoSlicePtr(&s, "secrets")
+	return s, err
+}
+
+// VersionPage is a single page of secret versions.
+type VersionPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty returns true if a page contains no versions.
+func (r VersionPage) IsEmpty() (bool, error) {
+	s, err := ExtractVersions(r)
+	return len(s) == 0, err
+}
+
+// NextPageURL uses the next marker of the page info to construct the next page's URL.
+func (r VersionPage) NextPageURL() (string, error) {
+	return nextPageURL(r.LinkedPageBase)
+}
+
+// ExtractVersions interprets a page of results as a slice of VersionMetadata.
+func ExtractVersions(r pagination.Page) ([]VersionMetadata, error) {
+	var s []VersionMetadata
+	err := (r.(VersionPage)).ExtractIntoSlicePtr(&s, "version_metadatas")
+	return s, err
+}
+
+func nextPageURL(r pagination.LinkedPageBase) (string, error) {
+	var s struct {
+		PageInfo PageInfo `json:"page_info"`
+	}
+	if err := r.ExtractInto(&s); err != nil {
+		return "", err
+	}
+	if s.PageInfo.NextMarker == "" {
+		return "", nil
+	}
+	q := r.URL.Query()
+	q.Set("marker", s.PageInfo.NextMarker)
+	r.URL.RawQuery = q.Encode()
+	return r.URL.String(), nil
+}
@@ -0,0 +1,102 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const firstPage = `
+{
+  "secrets": [
+    {
+      "id": "7c9f3a0e-7b2d-4b1c-9d8e-2a5f6b7c8d91",
+      "name": "secret-1",
+      "state": "ENABLED"
+    },
+    {
+      "id": "0f5b1a2c-3d4e-4f60-8a9b-c1d2e3f4a5b6",
+      "name": "secret-2",
+      "state": "ENABLED"
+    }
+  ],
+  "page_info": {
+    "next_marker": "secret-2",
+    "previous_marker": "",
+    "current_count": 2
+  }
+}`
+
+const secondPage = `
+{
+  "secrets": [
+    {
+      "id": "5e6f7a8b-9c0d-4e1f-a2b3-c4d5e6f7a8b9",
+      "name": "secret-3",
+      "state": "ENABLED"
+    }
+  ],
+  "page_info": {
+    "next_marker": "",
+    "previous_marker": "secret-2",
+    "current_count": 1
+  }
+}`
+
+// HandleListSuccessfully creates an HTTP handler at `/secrets` on the test handler mux
+// that responds with two pages of secrets linked by the `marker` query parameter.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/secrets", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.AssertEquals(t, "2", r.URL.Query().Get("limit"))
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		switch marker := r.URL.Query().Get("marker"); marker {
+		case "":
+			_, _ = fmt.Fprint(w, firstPage)
+		case "secret-2":
+			_, _ = fmt.Fprint(w, secondPage)
+		default:
+			t.Errorf("unexpected marker: %s", marker)
+		}
+	})
+}
+
+// FakeRandom serves the KMS random data, returning the next queued value on every request.
+type FakeRandom struct {
+	sync.Mutex
+	data  []string
+	Calls int
+}
+
+// HandleGenRandomSuccessfully creates an HTTP handler at `/kms/gen-random` on the test handler mux
+// that responds with the given hex encoded random data, one value per request.
+func HandleGenRandomSuccessfully(t *testing.T, data ...string) *FakeRandom {
+	random := &FakeRandom{data: data}
+	th.Mux.HandleFunc("/kms/gen-random", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `{"random_data_length": "512"}`)
+
+		random.Lock()
+		defer random.Unlock()
+		if random.Calls >= len(random.data) {
+			t.Errorf("unexpected random data request #%d", random.Calls+1)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		value := random.data[random.Calls]
+		random.Calls++
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, `{"random_data": "%s"}`, value)
+	})
+	return random
+}
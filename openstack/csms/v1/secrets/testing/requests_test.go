@@ -0,0 +1,99 @@
+package testing
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/csms/v1/secrets"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const lowerChars = "abcdefghijklmnopqrstuvwxyz"
+
+// randomData returns the hex encoded bytes
+func randomData(data ...byte) string {
+	return hex.EncodeToString(data)
+}
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	pages, err := secrets.List(fake.ServiceClient(), secrets.ListOpts{Limit: 2}).AllPages()
+	th.AssertNoErr(t, err)
+	list, err := secrets.ExtractSecrets(pages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 3, len(list))
+	th.AssertEquals(t, "secret-1", list[0].Name)
+	th.AssertEquals(t, "secret-3", list[2].Name)
+}
+
+func TestGeneratePasswordCharset(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	data := make([]byte, 40)
+	for i := range data {
+		data[i] = byte(i)
+	}
+	random := HandleGenRandomSuccessfully(t, randomData(data...))
+
+	password, err := secrets.GeneratePassword(fake.ServiceClient(), secrets.PasswordOpts{
+		Length:             40,
+		ExcludeUppercase:   true,
+		ExcludeNumbers:     true,
+		ExcludePunctuation: true,
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, lowerChars+lowerChars[:14], password)
+	th.AssertEquals(t, 1, random.Calls)
+}
+
+func TestGeneratePasswordRejectsBiasedValues(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	// 26 lowercase letters make 234 the rejection limit, the values of the first response
+	// are all above it, so another response has to be requested
+	rejected := bytes.Repeat([]byte{234, 255}, 16)
+	random := HandleGenRandomSuccessfully(t, randomData(rejected...), randomData(233, 0, 25, 26, 240, 1))
+
+	password, err := secrets.GeneratePassword(fake.ServiceClient(), secrets.PasswordOpts{
+		Length:             5,
+		ExcludeUppercase:   true,
+		ExcludeNumbers:     true,
+		ExcludePunctuation: true,
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "zazab", password)
+	th.AssertEquals(t, 2, random.Calls)
+}
+
+func TestGeneratePasswordDefaults(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	data := make([]byte, 256)
+	for i := range data {
+		data[i] = byte(i)
+	}
+	HandleGenRandomSuccessfully(t, randomData(data...))
+
+	password, err := secrets.GeneratePassword(fake.ServiceClient(), secrets.PasswordOpts{})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 32, len(password))
+	th.AssertEquals(t, lowerChars+"ABCDEF", password)
+}
+
+func TestGeneratePasswordEmptyCharset(t *testing.T) {
+	_, err := secrets.GeneratePassword(fake.ServiceClient(), secrets.PasswordOpts{
+		ExcludeLowercase:   true,
+		ExcludeUppercase:   true,
+		ExcludeNumbers:     true,
+		ExcludePunctuation: true,
+	})
+	if err == nil || !strings.Contains(err.Error(), "character class") {
+		t.Fatalf("expected charset error, got %v", err)
+	}
+}
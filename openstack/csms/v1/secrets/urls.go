@@ -0,0 +1,29 @@
+package secrets
+
+import golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+const (
+	resourcePath = "secrets"
+	versionsPath = "versions"
+	stagesPath   = "stages"
+)
+
+func rootURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL(resourcePath)
+}
+
+func secretURL(c *golangsdk.ServiceClient, name string) string {
+	return c.ServiceURL(resourcePath, name)
+}
+
+func versionsURL(c *golangsdk.ServiceClient, name string) string {
+	return c.ServiceURL(resourcePath, name, versionsPath)
+}
+
+func versionURL(c *golangsdk.ServiceClient, name, versionID string) string {
+	return c.ServiceURL(resourcePath, name, versionsPath, versionID)
+}
+
+func stageURL(c *golangsdk.ServiceClient, name, stage string) string {
+	return c.ServiceURL(resourcePath, name, stagesPath, stage)
+}
@@ -3,6 +3,7 @@ package clusters
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
@@ -284,3 +285,169 @@ func WaitForClusterToExtend(client *golangsdk.ServiceClient, id string, timeout
 		return false, fmt.Errorf("unexpected cluster actions: %v; progress: %v", cluster.Actions, cluster.ActionProgress)
 	})
 }
+
+// Restart restarts the cluster, use WaitForClusterOperationSucces to wait until it's available again.
+func Restart(client *golangsdk.ServiceClient, clusterID string) (r ErrorResult) {
+	_, r.Err = client.Post(restartURL(client, clusterID), map[string]interface{}{}, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+type BandWidth struct {
+	// Size - bandwidth size in Mbit/s.
+	Size int `json:"size" required:"true"`
+}
+
+type PublicEIP struct {
+	BandWidth *BandWidth `json:"bandWidth" required:"true"`
+}
+
+type PublicAccessOptsBuilder interface {
+	ToPublicAccessMap() (map[string]interface{}, error)
+}
+
+// PublicAccessOpts is used to enable the public access to the cluster.
+// Only clusters with enabled authentication (`AuthorityEnabled`) support the public access.
+type PublicAccessOpts struct {
+	// EIP - bandwidth of the EIP bound to the cluster.
+	EIP *PublicEIP `json:"eip" required:"true"`
+
+	// IsAutoPay - value `1` indicates the order is paid automatically.
+	IsAutoPay int `json:"isAutoPay,omitempty"`
+}
+
+func (opts PublicAccessOpts) ToPublicAccessMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// EnablePublicAccess binds an EIP to the cluster.
+func EnablePublicAccess(client *golangsdk.ServiceClient, clusterID string, opts PublicAccessOptsBuilder) (r ErrorResult) {
+	b, err := opts.ToPublicAccessMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(publicURL(client, clusterID, "open"), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// DisablePublicAccess unbinds the EIP from the cluster.
+func DisablePublicAccess(client *golangsdk.ServiceClient, clusterID string) (r ErrorResult) {
+	_, r.Err = client.Put(publicURL(client, clusterID, "close"), map[string]interface{}{}, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// UpdatePublicBandwidth changes the bandwidth of the cluster public access.
+func UpdatePublicBandwidth(client *golangsdk.ServiceClient, clusterID string, size int) (r ErrorResult) {
+	b := map[string]interface{}{
+		"bandWidth": map[string]interface{}{"size": size},
+	}
+	_, r.Err = client.Post(publicURL(client, clusterID, "bandwidth"), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// UpdatePublicWhitelist enables the access control of the cluster public access
+// and allows only the given IPs or CIDRs.
+func UpdatePublicWhitelist(client *golangsdk.ServiceClient, clusterID string, whitelist []string) (r ErrorResult) {
+	b := map[string]interface{}{
+		"whiteIpList": strings.Join(whitelist, ","),
+	}
+	_, r.Err = client.Post(publicURL(client, clusterID, "whitelist", "update"), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// DisablePublicWhitelist disables the access control of the cluster public access.
+func DisablePublicWhitelist(client *golangsdk.ServiceClient, clusterID string) (r ErrorResult) {
+	_, r.Err = client.Put(publicURL(client, clusterID, "whitelist", "close"), map[string]interface{}{}, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+type KibanaWhitelist struct {
+	// EnableWhiteList - whether to enable the Kibana access control.
+	EnableWhiteList bool `json:"enableWhiteList"`
+
+	// WhiteList - comma-separated IPs or CIDRs allowed to access Kibana.
+	WhiteList string `json:"whiteList,omitempty"`
+}
+
+type KibanaPublicAccessOptsBuilder interface {
+	ToKibanaPublicAccessMap() (map[string]interface{}, error)
+}
+
+// KibanaPublicAccessOpts is used to enable the public access to the cluster Kibana.
+type KibanaPublicAccessOpts struct {
+	// EIPSize - bandwidth of the EIP in Mbit/s.
+	EIPSize int `json:"eipSize" required:"true"`
+
+	// Whitelist - Kibana access control.
+	Whitelist *KibanaWhitelist `json:"elbWhiteList" required:"true"`
+
+	// IsAutoPay - value `1` indicates the order is paid automatically.
+	IsAutoPay int `json:"isAutoPay,omitempty"`
+}
+
+func (opts KibanaPublicAccessOpts) ToKibanaPublicAccessMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// EnableKibanaPublicAccess enables the access to the cluster Kibana from the internet.
+func EnableKibanaPublicAccess(client *golangsdk.ServiceClient, clusterID string, opts KibanaPublicAccessOptsBuilder) (r ErrorResult) {
+	b, err := opts.ToKibanaPublicAccessMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(kibanaURL(client, clusterID, "open"), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// DisableKibanaPublicAccess disables the access to the cluster Kibana from the internet.
+func DisableKibanaPublicAccess(client *golangsdk.ServiceClient, clusterID string) (r ErrorResult) {
+	_, r.Err = client.Put(kibanaURL(client, clusterID, "close"), map[string]interface{}{}, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// UpdateKibanaBandwidth changes the bandwidth of the Kibana public access.
+func UpdateKibanaBandwidth(client *golangsdk.ServiceClient, clusterID string, size int) (r ErrorResult) {
+	b := map[string]interface{}{
+		"bandWidth": map[string]interface{}{"size": size},
+	}
+	_, r.Err = client.Post(kibanaURL(client, clusterID, "bandwidth"), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// UpdateKibanaWhitelist enables the Kibana access control and allows only the given IPs or CIDRs.
+func UpdateKibanaWhitelist(client *golangsdk.ServiceClient, clusterID string, whitelist []string) (r ErrorResult) {
+	b := map[string]interface{}{
+		"whiteIpList": strings.Join(whitelist, ","),
+	}
+	_, r.Err = client.Post(kibanaURL(client, clusterID, "whitelist", "update"), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// DisableKibanaWhitelist disables the Kibana access control.
+func DisableKibanaWhitelist(client *golangsdk.ServiceClient, clusterID string) (r ErrorResult) {
+	_, r.Err = client.Put(kibanaURL(client, clusterID, "whitelist", "close"), map[string]interface{}{}, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
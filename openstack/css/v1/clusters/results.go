@@ -45,6 +45,21 @@ type Cluster struct {
 	SubnetID         string             `json:"subnetId"`
 	SecurityGroupID  string             `json:"securityGroupId" required:"true"`
 	Tags             []tags.ResourceTag `json:"tags"`
+	PublicIP         string             `json:"publicIp"`
+	BandwidthSize    int                `json:"bandwidthSize"`
+	PublicKibanaResp *PublicKibana      `json:"publicKibanaResp"`
+	ElbWhiteList     *PublicWhitelist   `json:"elbWhiteList"`
+}
+
+type PublicKibana struct {
+	EipSize         int              `json:"eipSize"`
+	PublicKibanaIP  string           `json:"publicKibanaIp"`
+	ElbWhiteListRes *PublicWhitelist `json:"elbWhiteListResp"`
+}
+
+type PublicWhitelist struct {
+	EnableWhiteList bool   `json:"enableWhiteList"`
+	WhiteList       string `json:"whiteList"`
 }
 
 type CreateResult struct {
@@ -126,3 +141,9 @@ func (r ExtendResult) Extract() (*ExtendedCluster, error) {
 	err := r.ExtractInto(c)
 	return c, err
 }
+
+// ErrorResult is the result of cluster actions.
+// Call its ExtractErr method to determine if the request succeeded or failed.
+type ErrorResult struct {
+	golangsdk.ErrResult
+}
@@ -129,6 +129,65 @@ func (s *Clusters) TestDeleteRequest() {
 	th.AssertNoErr(t, err)
 }
 
+func (s *Clusters) TestRestartRequest() {
+	t := s.T()
+
+	th.Mux.HandleFunc(fmt.Sprintf("/clusters/%s/restart", clusterID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, `{}`)
+	})
+
+	err := clusters.Restart(fake.ServiceClient(), clusterID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func (s *Clusters) TestEnablePublicAccessRequest() {
+	t := s.T()
+
+	th.Mux.HandleFunc(fmt.Sprintf("/clusters/%s/public/open", clusterID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `{"eip": {"bandWidth": {"size": 5}}}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, `{}`)
+	})
+
+	opts := clusters.PublicAccessOpts{
+		EIP: &clusters.PublicEIP{
+			BandWidth: &clusters.BandWidth{Size: 5},
+		},
+	}
+	err := clusters.EnablePublicAccess(fake.ServiceClient(), clusterID, opts).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func (s *Clusters) TestEnableKibanaPublicAccessRequest() {
+	t := s.T()
+
+	th.Mux.HandleFunc(fmt.Sprintf("/clusters/%s/publickibana/open", clusterID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `{"eipSize": 10, "elbWhiteList": {"enableWhiteList": true, "whiteList": "10.0.0.0/8"}}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		_, _ = fmt.Fprint(w, `{}`)
+	})
+
+	opts := clusters.KibanaPublicAccessOpts{
+		EIPSize: 10,
+		Whitelist: &clusters.KibanaWhitelist{
+			EnableWhiteList: true,
+			WhiteList:       "10.0.0.0/8",
+		},
+	}
+	err := clusters.EnableKibanaPublicAccess(fake.ServiceClient(), clusterID, opts).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
 func TestClustersMethods(t *testing.T) {
 	suite.Run(t, new(Clusters))
 }
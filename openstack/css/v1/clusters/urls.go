@@ -25,3 +25,15 @@ func extendCommonURL(c *golangsdk.ServiceClient, clusterID string) string {
 func extendSpecialURL(c *golangsdk.ServiceClient, clusterID string) string {
 	return c.ServiceURL(clusters, clusterID, "role_extend")
 }
+
+func restartURL(c *golangsdk.ServiceClient, clusterID string) string {
+	return c.ServiceURL(clusters, clusterID, "restart")
+}
+
+func publicURL(c *golangsdk.ServiceClient, clusterID string, parts ...string) string {
+	return c.ServiceURL(append([]string{clusters, clusterID, "public"}, parts...)...)
+}
+
+func kibanaURL(c *golangsdk.ServiceClient, clusterID string, parts ...string) string {
+	return c.ServiceURL(append([]string{clusters, clusterID, "publickibana"}, parts...)...)
+}
@@ -141,3 +141,45 @@ func UpdateConfiguration(client *golangsdk.ServiceClient, clusterID string, opts
 	})
 	return
 }
+
+// RestoreOptsBuilder allows extensions to add additional parameters to the
+// Restore request.
+type RestoreOptsBuilder interface {
+	ToSnapshotRestoreMap() (map[string]interface{}, error)
+}
+
+// RestoreOpts contains options for restoring a snapshot.
+// This object is passed to the snapshots.Restore function.
+type RestoreOpts struct {
+	// TargetCluster - ID of the cluster the snapshot is restored to.
+	TargetCluster string `json:"targetCluster" required:"true"`
+
+	// Indices - comma-separated names of the indices to restore, all indices are restored by default.
+	Indices string `json:"indices,omitempty"`
+
+	// RenamePattern - regular expression matching the indices to rename.
+	RenamePattern string `json:"renamePattern,omitempty"`
+
+	// RenameReplacement - rule for renaming the restored indices.
+	RenameReplacement string `json:"renameReplacement,omitempty"`
+}
+
+// ToSnapshotRestoreMap assembles a request body based on the contents of a
+// RestoreOpts.
+func (opts RestoreOpts) ToSnapshotRestoreMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Restore will restore the Snapshot with the provided ID to the target cluster.
+// Existing indices with the same names are overwritten.
+func Restore(client *golangsdk.ServiceClient, clusterId, id string, opts RestoreOptsBuilder) (r ErrorResult) {
+	b, err := opts.ToSnapshotRestoreMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(restoreURL(client, clusterId, id), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	return
+}
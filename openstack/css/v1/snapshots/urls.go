@@ -35,3 +35,8 @@ func listURL(c *golangsdk.ServiceClient, clusterId string) string {
 func deleteURL(c *golangsdk.ServiceClient, clusterId, snapId string) string {
 	return c.ServiceURL("clusters", clusterId, "index_snapshot", snapId)
 }
+
+// restoreURL used to restore a snapshot
+func restoreURL(c *golangsdk.ServiceClient, clusterId, snapId string) string {
+	return c.ServiceURL("clusters", clusterId, "index_snapshot", snapId, "restore")
+}
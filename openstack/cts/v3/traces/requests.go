@@ -0,0 +1,60 @@
+package traces
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToTraceListQuery() (string, error)
+}
+
+// ListOpts allows the filtering of the traces.
+type ListOpts struct {
+	// TraceType is either `system` for management traces or `data` for data traces.
+	TraceType string `q:"trace_type,required"`
+	// Limit is the number of traces on a page, up to 200, 10 by default.
+	Limit int `q:"limit"`
+	// From and To are UNIX timestamps in milliseconds, traces of the last hour are returned by default.
+	From string `q:"from"`
+	To   string `q:"to"`
+	// Next is the marker of the page to start with, see TracePage.
+	Next string `q:"next"`
+	// TrackerName is required for data traces.
+	TrackerName  string `q:"tracker_name"`
+	ServiceType  string `q:"service_type"`
+	User         string `q:"user"`
+	ResourceID   string `q:"resource_id"`
+	ResourceName string `q:"resource_name"`
+	ResourceType string `q:"resource_type"`
+	TraceID      string `q:"trace_id"`
+	TraceName    string `q:"trace_name"`
+	// TraceRating is one of `normal`, `warning` or `incident`.
+	TraceRating string `q:"trace_rating"`
+}
+
+// ToTraceListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToTraceListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over the traces.
+func List(client *golangsdk.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client)
+	if opts != nil {
+		q, err := opts.ToTraceListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += q
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return TracePage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
@@ -0,0 +1,88 @@
+package traces
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
+)
+
+type Domain struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type User struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Domain Domain `json:"domain"`
+}
+
+type Trace struct {
+	ID           string `json:"trace_id"`
+	Name         string `json:"trace_name"`
+	Rating       string `json:"trace_rating"`
+	Type         string `json:"trace_type"`
+	ResourceID   string `json:"resource_id"`
+	ResourceName string `json:"resource_name"`
+	ResourceType string `json:"resource_type"`
+	ResourceURL  string `json:"resource_url"`
+	ServiceType  string `json:"service_type"`
+	Request      string `json:"request"`
+	Response     string `json:"response"`
+	Code         string `json:"code"`
+	APIVersion   string `json:"api_version"`
+	Message      string `json:"message"`
+	// Time and RecordTime are UNIX timestamps in milliseconds.
+	Time         int64  `json:"time"`
+	RecordTime   int64  `json:"record_time"`
+	User         User   `json:"user"`
+	SourceIP     string `json:"source_ip"`
+	RequestID    string `json:"request_id"`
+	LocationInfo string `json:"location_info"`
+	Endpoint     string `json:"endpoint"`
+}
+
+type MetaData struct {
+	Count  int    `json:"count"`
+	Marker string `json:"marker"`
+}
+
+// TracePage is the page returned by a pager when traversing over a
+// collection of traces.
+type TracePage struct {
+	pagination.LinkedPageBase
+}
+
+// ExtractTraces accepts a Page struct, specifically a TracePage struct,
+// and extracts the elements into a slice of Trace structs.
+func ExtractTraces(r pagination.Page) ([]Trace, error) {
+	var s []Trace
+	err := (r.(TracePage)).ExtractIntoSlicePtr(&s, "traces")
+	return s, err
+}
+
+// ExtractMetaData returns the paging information of the TracePage.
+func ExtractMetaData(r pagination.Page) (*MetaData, error) {
+	var s MetaData
+	err := (r.(TracePage)).ExtractIntoStructPtr(&s, "meta_data")
+	return &s, err
+}
+
+// NextPageURL uses the marker of the meta data to construct the next page's URL.
+func (r TracePage) NextPageURL() (string, error) {
+	meta, err := ExtractMetaData(r)
+	if err != nil {
+		return "", err
+	}
+	if meta.Marker == "" {
+		return "", nil
+	}
+	q := r.URL.Query()
+	q.Set("next", meta.Marker)
+	r.URL.RawQuery = q.Encode()
+	return r.URL.String(), nil
+}
+
+// IsEmpty checks whether a TracePage struct is empty.
+func (r TracePage) IsEmpty() (bool, error) {
+	s, err := ExtractTraces(r)
+	return len(s) == 0, err
+}
@@ -0,0 +1,52 @@
+package testing
+
+const firstPage = `
+{
+  "meta_data": {
+    "count": 1,
+    "marker": "e001ccb8-bc09-11e6-b2cc-2640a43cc6e8"
+  },
+  "traces": [
+    {
+      "time": 1472148708232,
+      "user": {
+        "name": "xxx",
+        "domain": {
+          "name": "xxx",
+          "id": "ded649d814464428ba89d04d7955c93e"
+        }
+      },
+      "response": "{\"code\":\"VPC.0514\",\"message\":\"Update port fail.\"}",
+      "code": "200",
+      "service_type": "VPC",
+      "resource_type": "eip",
+      "resource_name": "192.144.163.1",
+      "resource_id": "d502809d-0d1d-41ce-9690-784282142ccc",
+      "trace_name": "deleteEip",
+      "trace_rating": "warning",
+      "trace_type": "ConsoleAction",
+      "api_version": "2.0",
+      "record_time": 1481066128032,
+      "trace_id": "e001ccb9-bc09-11e6-b00b-4b2a61338db6"
+    }
+  ]
+}
+`
+
+const secondPage = `
+{
+  "meta_data": {
+    "count": 1
+  },
+  "traces": [
+    {
+      "time": 1472148708233,
+      "service_type": "ECS",
+      "resource_type": "ecs",
+      "trace_name": "createServer",
+      "trace_rating": "normal",
+      "trace_id": "e001ccb8-bc09-11e6-b2cc-2640a43cc6e8"
+    }
+  ]
+}
+`
@@ -0,0 +1,65 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/cts/v3/traces"
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/traces", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		if err := r.ParseForm(); err != nil {
+			t.Error(err)
+			return
+		}
+		th.AssertEquals(t, "system", r.Form.Get("trace_type"))
+		th.AssertEquals(t, "warning", r.Form.Get("trace_rating"))
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		switch r.Form.Get("next") {
+		case "":
+			_, _ = fmt.Fprint(w, firstPage)
+		case "e001ccb8-bc09-11e6-b2cc-2640a43cc6e8":
+			_, _ = fmt.Fprint(w, secondPage)
+		default:
+			t.Errorf("unexpected marker: %s", r.Form.Get("next"))
+		}
+	})
+
+	opts := traces.ListOpts{
+		TraceType:   "system",
+		TraceRating: "warning",
+	}
+	var all []traces.Trace
+	err := traces.List(fake.ServiceClient(), opts).EachPage(func(page pagination.Page) (bool, error) {
+		pageTraces, err := traces.ExtractTraces(page)
+		if err != nil {
+			return false, err
+		}
+		all = append(all, pageTraces...)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, len(all))
+	th.AssertEquals(t, "deleteEip", all[0].Name)
+	th.AssertEquals(t, "ded649d814464428ba89d04d7955c93e", all[0].User.Domain.ID)
+	th.AssertEquals(t, int64(1481066128032), all[0].RecordTime)
+	th.AssertEquals(t, "createServer", all[1].Name)
+}
+
+func TestListRequiresType(t *testing.T) {
+	_, err := traces.ListOpts{}.ToTraceListQuery()
+	if err == nil {
+		t.Fatal("expected error for missing trace type")
+	}
+}
@@ -0,0 +1,7 @@
+package traces
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+func listURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("traces")
+}
@@ -0,0 +1,48 @@
+/*
+Package tracker provides management of CTS v3 trackers.
+
+Example to Create a Tracker
+
+	enabled := true
+	createOpts := tracker.CreateOpts{
+		TrackerType:       tracker.TypeSystem,
+		TrackerName:       "system",
+		IsLtsEnabled:      &enabled,
+		IsSupportValidate: &enabled,
+		ObsInfo: &tracker.ObsInfo{
+			BucketName:     "cts-bucket",
+			FilePrefixName: "audit",
+		},
+	}
+	ctsTracker, err := tracker.Create(client, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Disable a Tracker
+
+	updateOpts := tracker.UpdateOpts{
+		TrackerType: tracker.TypeSystem,
+		TrackerName: "system",
+		Status:      "disabled",
+	}
+	err := tracker.Update(client, updateOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to List Trackers
+
+	trackers, err := tracker.List(client, tracker.ListOpts{TrackerType: tracker.TypeSystem}).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Tracker
+
+	err := tracker.Delete(client, tracker.DeleteOpts{TrackerName: "system"}).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package tracker
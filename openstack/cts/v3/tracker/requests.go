@@ -0,0 +1,146 @@
+package tracker
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+const (
+	// TypeSystem is the management tracker type, only one system tracker exists per project.
+	TypeSystem = "system"
+	// TypeData is the data tracker type recording operations on OBS objects.
+	TypeData = "data"
+)
+
+// ObsInfo contains the OBS bucket the traces are transferred to.
+type ObsInfo struct {
+	BucketName     string `json:"bucket_name,omitempty"`
+	FilePrefixName string `json:"file_prefix_name,omitempty"`
+	// IsObsCreated shows whether the bucket is created by CTS.
+	IsObsCreated *bool `json:"is_obs_created,omitempty"`
+	// BucketLifecycle is the trace retention period in days, used when CTS creates the bucket.
+	BucketLifecycle int `json:"bucket_lifecycle,omitempty"`
+}
+
+// DataBucket contains the OBS bucket tracked by the data tracker.
+type DataBucket struct {
+	DataBucketName string `json:"data_bucket_name,omitempty"`
+	// DataEvent is a list of the recorded operations: `READ` and `WRITE`.
+	DataEvent []string `json:"data_event,omitempty"`
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToTrackerCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts contains the options for create a Tracker. This object is
+// passed to tracker.Create().
+type CreateOpts struct {
+	// TrackerType is either `system` or `data`.
+	TrackerType string `json:"tracker_type" required:"true"`
+	// TrackerName is `system` for the system tracker.
+	TrackerName string `json:"tracker_name" required:"true"`
+	// IsLtsEnabled enables the trace transfer to LTS.
+	IsLtsEnabled *bool `json:"is_lts_enabled,omitempty"`
+	// IsSupportTraceFilesEncryption enables trace files encryption with KmsId key.
+	IsSupportTraceFilesEncryption *bool  `json:"is_support_trace_files_encryption,omitempty"`
+	KmsId                         string `json:"kms_id,omitempty"`
+	// IsSupportValidate enables trace file integrity verification.
+	IsSupportValidate *bool       `json:"is_support_validate,omitempty"`
+	ObsInfo           *ObsInfo    `json:"obs_info,omitempty"`
+	DataBucket        *DataBucket `json:"data_bucket,omitempty"`
+}
+
+// ToTrackerCreateMap assembles a request body based on the contents of a
+// CreateOpts.
+func (opts CreateOpts) ToTrackerCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Create will create a new tracker based on the values in CreateOpts.
+func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToTrackerCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(rootURL(client), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToTrackerUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts contains all the values needed to update a tracker.
+type UpdateOpts struct {
+	TrackerType string `json:"tracker_type" required:"true"`
+	TrackerName string `json:"tracker_name" required:"true"`
+	// Status is either `enabled` or `disabled`.
+	Status                        string      `json:"status,omitempty"`
+	IsLtsEnabled                  *bool       `json:"is_lts_enabled,omitempty"`
+	IsSupportTraceFilesEncryption *bool       `json:"is_support_trace_files_encryption,omitempty"`
+	KmsId                         string      `json:"kms_id,omitempty"`
+	IsSupportValidate             *bool       `json:"is_support_validate,omitempty"`
+	ObsInfo                       *ObsInfo    `json:"obs_info,omitempty"`
+	DataBucket                    *DataBucket `json:"data_bucket,omitempty"`
+}
+
+// ToTrackerUpdateMap builds an update body based on UpdateOpts.
+func (opts UpdateOpts) ToTrackerUpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Update will change the tracker configuration.
+func Update(client *golangsdk.ServiceClient, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToTrackerUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(rootURL(client), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// ListOpts allows the filtering of trackers.
+type ListOpts struct {
+	TrackerName string `q:"tracker_name"`
+	TrackerType string `q:"tracker_type"`
+}
+
+// List returns collection of Tracker.
+func List(client *golangsdk.ServiceClient, opts ListOpts) (r ListResult) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Get(listURL(client)+q.String(), &r.Body, nil)
+	return
+}
+
+// DeleteOpts identifies the tracker to be deleted.
+type DeleteOpts struct {
+	TrackerName string `q:"tracker_name,required"`
+	TrackerType string `q:"tracker_type"`
+}
+
+// Delete will permanently delete a particular tracker.
+func Delete(client *golangsdk.ServiceClient, opts DeleteOpts) (r DeleteResult) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Delete(listURL(client)+q.String(), &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
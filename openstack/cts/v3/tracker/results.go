@@ -0,0 +1,71 @@
+package tracker
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+type Lts struct {
+	IsLtsEnabled bool   `json:"is_lts_enabled"`
+	LogGroupName string `json:"log_group_name"`
+	LogTopicName string `json:"log_topic_name"`
+}
+
+type ObsInfoResp struct {
+	BucketName         string `json:"bucket_name"`
+	FilePrefixName     string `json:"file_prefix_name"`
+	IsObsCreated       bool   `json:"is_obs_created"`
+	IsAuthorizedBucket bool   `json:"is_authorized_bucket"`
+	BucketLifecycle    int    `json:"bucket_lifecycle"`
+}
+
+type DataBucketResp struct {
+	DataBucketName string   `json:"data_bucket_name"`
+	DataEvent      []string `json:"data_event"`
+}
+
+type Tracker struct {
+	ID                            string         `json:"id"`
+	CreateTime                    int64          `json:"create_time"`
+	KmsId                         string         `json:"kms_id"`
+	IsSupportValidate             bool           `json:"is_support_validate"`
+	IsSupportTraceFilesEncryption bool           `json:"is_support_trace_files_encryption"`
+	Lts                           Lts            `json:"lts"`
+	TrackerType                   string         `json:"tracker_type"`
+	DomainId                      string         `json:"domain_id"`
+	ProjectId                     string         `json:"project_id"`
+	TrackerName                   string         `json:"tracker_name"`
+	Status                        string         `json:"status"`
+	Detail                        string         `json:"detail"`
+	ObsInfo                       ObsInfoResp    `json:"obs_info"`
+	DataBucket                    DataBucketResp `json:"data_bucket"`
+}
+
+type CreateResult struct {
+	golangsdk.Result
+}
+
+// Extract will get the tracker object from the CreateResult
+func (r CreateResult) Extract() (*Tracker, error) {
+	var s Tracker
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+type ListResult struct {
+	golangsdk.Result
+}
+
+// Extract will get the trackers from the ListResult
+func (r ListResult) Extract() ([]Tracker, error) {
+	var s []Tracker
+	err := r.ExtractIntoSlicePtr(&s, "trackers")
+	return s, err
+}
+
+type UpdateResult struct {
+	golangsdk.ErrResult
+}
+
+type DeleteResult struct {
+	golangsdk.ErrResult
+}
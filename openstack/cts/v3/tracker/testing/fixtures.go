@@ -0,0 +1,54 @@
+package testing
+
+const createRequest = `
+{
+  "tracker_type": "system",
+  "tracker_name": "system",
+  "is_lts_enabled": true,
+  "is_support_trace_files_encryption": true,
+  "kms_id": "13a4207c-7abe-4b68-8510-16b84c3b5504",
+  "is_support_validate": true,
+  "obs_info": {
+    "bucket_name": "saveTrace",
+    "file_prefix_name": "11"
+  }
+}
+`
+
+const trackerBody = `
+{
+  "id": "ebf8d1c3-762b-4ce3-b316-6b1aa32f8be3",
+  "create_time": 1587958431737,
+  "kms_id": "13a4207c-7abe-4b68-8510-16b84c3b5504",
+  "is_support_validate": true,
+  "is_support_trace_files_encryption": true,
+  "lts": {
+    "is_lts_enabled": true,
+    "log_group_name": "CTS",
+    "log_topic_name": "system-trace"
+  },
+  "tracker_type": "system",
+  "domain_id": "2306579dc99f4c8690b14b68e734fcd9",
+  "project_id": "24edf66e79d04187acb99a463e610764",
+  "tracker_name": "system",
+  "status": "enabled",
+  "obs_info": {
+    "bucket_name": "saveTrace",
+    "file_prefix_name": "11",
+    "is_obs_created": false,
+    "is_authorized_bucket": true,
+    "bucket_lifecycle": 30
+  }
+}
+`
+
+const updateRequest = `
+{
+  "tracker_type": "system",
+  "tracker_name": "system",
+  "status": "disabled",
+  "is_lts_enabled": false
+}
+`
+
+var listResponse = `{"trackers": [` + trackerBody + `]}`
@@ -0,0 +1,101 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/cts/v3/tracker"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/tracker", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, createRequest)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprint(w, trackerBody)
+	})
+
+	enabled := true
+	opts := tracker.CreateOpts{
+		TrackerType:                   tracker.TypeSystem,
+		TrackerName:                   "system",
+		IsLtsEnabled:                  &enabled,
+		IsSupportTraceFilesEncryption: &enabled,
+		KmsId:                         "13a4207c-7abe-4b68-8510-16b84c3b5504",
+		IsSupportValidate:             &enabled,
+		ObsInfo: &tracker.ObsInfo{
+			BucketName:     "saveTrace",
+			FilePrefixName: "11",
+		},
+	}
+	n, err := tracker.Create(fake.ServiceClient(), opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "ebf8d1c3-762b-4ce3-b316-6b1aa32f8be3", n.ID)
+	th.AssertEquals(t, "enabled", n.Status)
+	th.AssertEquals(t, true, n.Lts.IsLtsEnabled)
+	th.AssertEquals(t, "saveTrace", n.ObsInfo.BucketName)
+	th.AssertEquals(t, 30, n.ObsInfo.BucketLifecycle)
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/tracker", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, updateRequest)
+		w.WriteHeader(http.StatusOK)
+	})
+
+	disabled := false
+	opts := tracker.UpdateOpts{
+		TrackerType:  tracker.TypeSystem,
+		TrackerName:  "system",
+		Status:       "disabled",
+		IsLtsEnabled: &disabled,
+	}
+	th.AssertNoErr(t, tracker.Update(fake.ServiceClient(), opts).ExtractErr())
+}
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/trackers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"tracker_type": "system"})
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, listResponse)
+	})
+
+	trackers, err := tracker.List(fake.ServiceClient(), tracker.ListOpts{TrackerType: tracker.TypeSystem}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(trackers))
+	th.AssertEquals(t, "system", trackers[0].TrackerName)
+	th.AssertEquals(t, "CTS", trackers[0].Lts.LogGroupName)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/trackers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"tracker_name": "system"})
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	th.AssertNoErr(t, tracker.Delete(fake.ServiceClient(), tracker.DeleteOpts{TrackerName: "system"}).ExtractErr())
+}
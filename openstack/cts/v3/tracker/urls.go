@@ -0,0 +1,11 @@
+package tracker
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+func rootURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("tracker")
+}
+
+func listURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("trackers")
+}
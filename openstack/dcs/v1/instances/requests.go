@@ -86,6 +86,9 @@ type CreateOps struct {
 	// Indicates the time at which a maintenance time window ends.
 	// Format: HH:mm:ss
 	MaintainEnd string `json:"maintain_end,omitempty"`
+
+	// ID of the enterprise project the DCS instance is created in.
+	EnterpriseProjectID string `json:"enterprise_project_id,omitempty"`
 }
 
 // InstanceBackupPolicy for dcs
@@ -67,6 +67,7 @@ type Instance struct {
 	MaintainEnd          string               `json:"maintain_end"`
 	NoPasswordAccess     string               `json:"no_password_access"`
 	AccessUser           string               `json:"access_user"`
+	EnterpriseProjectID  string               `json:"enterprise_project_id"`
 }
 
 // UpdateResult is a struct from which can get the result of update method
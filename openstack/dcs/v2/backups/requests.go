@@ -0,0 +1,139 @@
+package backups
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
+)
+
+// CreateOptsBuilder is used for creating backup parameters.
+// any struct providing the parameters should implement this interface
+type CreateOptsBuilder interface {
+	ToBackupCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts is a struct that contains all the parameters of the manual backup.
+type CreateOpts struct {
+	// Description of the backup.
+	Remark string `json:"remark,omitempty"`
+
+	// Backup format: `aof` or `rdb`, Redis 3.0 instances support `aof` only.
+	BackupFormat string `json:"backup_format,omitempty"`
+}
+
+// ToBackupCreateMap is used for type convert
+func (opts CreateOpts) ToBackupCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Create backs up the instance data manually.
+func Create(client *golangsdk.ServiceClient, instanceID string, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToBackupCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Post(backupsURL(client, instanceID), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete deletes the backup of the instance.
+func Delete(client *golangsdk.ServiceClient, instanceID, backupID string) (r DeleteResult) {
+	_, r.Err = client.Delete(backupURL(client, instanceID, backupID), &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List and ListRestores requests.
+type ListOptsBuilder interface {
+	ToListQuery() (string, error)
+}
+
+// ListOpts allows filtering the backups and the restorations.
+type ListOpts struct {
+	Offset int `q:"offset"`
+	Limit  int `q:"limit"`
+	// Query start time in `yyyyMMddHHmmss` format
+	BeginTime string `q:"begin_time"`
+	// Query end time in `yyyyMMddHHmmss` format
+	EndTime string `q:"end_time"`
+}
+
+// ToListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), err
+}
+
+// List returns the backups of the instance.
+func List(client *golangsdk.ServiceClient, instanceID string, opts ListOptsBuilder) pagination.Pager {
+	url := backupsURL(client, instanceID)
+	if opts != nil {
+		query, err := opts.ToListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return BackupPage{pagination.SinglePageBase(r)}
+	})
+}
+
+// RestoreOptsBuilder is used for restoring parameters.
+// any struct providing the parameters should implement this interface
+type RestoreOptsBuilder interface {
+	ToRestoreMap() (map[string]interface{}, error)
+}
+
+// RestoreOpts is a struct that contains all the parameters of the restoration.
+type RestoreOpts struct {
+	// ID of the backup to restore.
+	BackupID string `json:"backup_id" required:"true"`
+
+	// Description of the restoration.
+	Remark string `json:"remark,omitempty"`
+}
+
+// ToRestoreMap is used for type convert
+func (opts RestoreOpts) ToRestoreMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Restore restores the backup data to the instance.
+func Restore(client *golangsdk.ServiceClient, instanceID string, opts RestoreOptsBuilder) (r RestoreResult) {
+	b, err := opts.ToRestoreMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Post(restoresURL(client, instanceID), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// ListRestores returns the restoration records of the instance.
+func ListRestores(client *golangsdk.ServiceClient, instanceID string, opts ListOptsBuilder) pagination.Pager {
+	url := restoresURL(client, instanceID)
+	if opts != nil {
+		query, err := opts.ToListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return RestorePage{pagination.SinglePageBase(r)}
+	})
+}
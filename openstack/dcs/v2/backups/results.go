@@ -0,0 +1,115 @@
+package backups
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
+)
+
+// Backup record of the instance
+type Backup struct {
+	BackupID   string `json:"backup_id"`
+	BackupName string `json:"backup_name"`
+	InstanceID string `json:"instance_id"`
+	// Backup period, for automatic backups
+	Period string `json:"period"`
+	// Backup size in bytes
+	Size int64 `json:"size"`
+	// Backup type: `auto` or `manual`
+	BackupType string `json:"backup_type"`
+	CreatedAt  string `json:"created_at"`
+	UpdatedAt  string `json:"updated_at"`
+	Progress   string `json:"progress"`
+	ErrorCode  string `json:"error_code"`
+	Remark     string `json:"remark"`
+	// Backup status: `waiting`, `backuping`, `succeed`, `failed`, `expired` or `deleted`
+	Status           string `json:"status"`
+	IsSupportRestore string `json:"is_support_restore"`
+	BackupFormat     string `json:"backup_format"`
+}
+
+// RestoreRecord is a restoration record of the instance
+type RestoreRecord struct {
+	RestoreID    string `json:"restore_id"`
+	RestoreName  string `json:"restore_name"`
+	BackupID     string `json:"backup_id"`
+	BackupName   string `json:"backup_name"`
+	BackupRemark string `json:"backup_remark"`
+	Remark       string `json:"restore_remark"`
+	CreatedAt    string `json:"created_at"`
+	UpdatedAt    string `json:"updated_at"`
+	Progress     string `json:"progress"`
+	ErrorCode    string `json:"error_code"`
+	// Restoration status: `waiting`, `restoring`, `succeed` or `failed`
+	Status string `json:"status"`
+}
+
+// CreateResult is a struct that contains all the return parameters of backup creation
+type CreateResult struct {
+	golangsdk.Result
+}
+
+// Extract returns the ID of the created backup
+func (r CreateResult) Extract() (string, error) {
+	var s struct {
+		BackupID string `json:"backup_id"`
+	}
+	err := r.Result.ExtractInto(&s)
+	return s.BackupID, err
+}
+
+// DeleteResult is a struct which contains the result of deletion
+type DeleteResult struct {
+	golangsdk.ErrResult
+}
+
+// RestoreResult is a struct that contains all the return parameters of restoration
+type RestoreResult struct {
+	golangsdk.Result
+}
+
+// Extract returns the ID of the restoration
+func (r RestoreResult) Extract() (string, error) {
+	var s struct {
+		RestoreID string `json:"restore_id"`
+	}
+	err := r.Result.ExtractInto(&s)
+	return s.RestoreID, err
+}
+
+type BackupPage struct {
+	pagination.SinglePageBase
+}
+
+func (r BackupPage) IsEmpty() (bool, error) {
+	backups, err := ExtractBackups(r)
+	if err != nil {
+		return false, err
+	}
+	return len(backups) == 0, nil
+}
+
+// ExtractBackups is a function that takes a List page and returns the backup records.
+func ExtractBackups(r pagination.Page) ([]Backup, error) {
+	var s []Backup
+	err := (r.(BackupPage)).ExtractIntoSlicePtr(&s, "backup_record_response")
+	return s, err
+}
+
+type RestorePage struct {
+	pagination.SinglePageBase
+}
+
+func (r RestorePage) IsEmpty() (bool, error) {
+	restores, err := ExtractRestores(r)
+	if err != nil {
+		return false, err
+	}
+	return len(restores) == 0, nil
+}
+
+// ExtractRestores is a function that takes a ListRestores page and returns the restoration records.
+func ExtractRestores(r pagination.Page) ([]RestoreRecord, error) {
+	var s []RestoreRecord
+	err := (r.(RestorePage)).ExtractIntoSlicePtr(&s, "restore_record_response")
+	return s, err
+}
@@ -0,0 +1,109 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const (
+	instanceID = "3c49a2b5-8e8f-4f8c-9bc1-6dc3a6b4d0e1"
+	backupID   = "5c5e7aa2-7e3a-4a4c-8a2a-6a0e0bd8f9c2"
+	restoreID  = "a6b0bf6f-3ac6-4d4e-9a37-06a3f28e4a10"
+)
+
+const listBackupsResponse = `
+{
+  "total_num": 1,
+  "backup_record_response": [
+    {
+      "backup_id": "5c5e7aa2-7e3a-4a4c-8a2a-6a0e0bd8f9c2",
+      "backup_name": "backup_20221010153025",
+      "instance_id": "3c49a2b5-8e8f-4f8c-9bc1-6dc3a6b4d0e1",
+      "size": 1024,
+      "backup_type": "manual",
+      "created_at": "2022-10-10T15:30:25.514Z",
+      "updated_at": "2022-10-10T15:31:25.514Z",
+      "progress": "100.00",
+      "remark": "test backup",
+      "status": "succeed",
+      "is_support_restore": "TRUE",
+      "backup_format": "rdb"
+    }
+  ]
+}
+`
+
+const listRestoresResponse = `
+{
+  "total_num": 1,
+  "restore_record_response": [
+    {
+      "restore_id": "a6b0bf6f-3ac6-4d4e-9a37-06a3f28e4a10",
+      "restore_name": "restore_20221010160000",
+      "backup_id": "5c5e7aa2-7e3a-4a4c-8a2a-6a0e0bd8f9c2",
+      "backup_name": "backup_20221010153025",
+      "restore_remark": "test restore",
+      "created_at": "2022-10-10T16:00:00.514Z",
+      "updated_at": "2022-10-10T16:01:00.514Z",
+      "progress": "100.00",
+      "status": "succeed"
+    }
+  ]
+}
+`
+
+// HandleBackupsSuccessfully creates an HTTP handler at `/instances/{instance_id}/backups` on the
+// test handler mux that responds to POST and GET requests.
+func HandleBackupsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/instances/%s/backups", instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+		switch r.Method {
+		case "POST":
+			th.TestJSONRequest(t, r, `{"remark": "test backup", "backup_format": "rdb"}`)
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprintf(w, `{"backup_id": "%s"}`, backupID)
+		case "GET":
+			th.TestFormValues(t, r, map[string]string{"limit": "10"})
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprint(w, listBackupsResponse)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+}
+
+// HandleBackupDeleteSuccessfully creates an HTTP handler at
+// `/instances/{instance_id}/backups/{backup_id}` on the test handler mux that responds to a DELETE
+// request.
+func HandleBackupDeleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/instances/%s/backups/%s", instanceID, backupID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandleRestoresSuccessfully creates an HTTP handler at `/instances/{instance_id}/restores` on the
+// test handler mux that responds to POST and GET requests.
+func HandleRestoresSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/instances/%s/restores", instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+		switch r.Method {
+		case "POST":
+			th.TestJSONRequest(t, r, fmt.Sprintf(`{"backup_id": "%s", "remark": "test restore"}`, backupID))
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprintf(w, `{"restore_id": "%s"}`, restoreID)
+		case "GET":
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprint(w, listRestoresResponse)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+}
@@ -0,0 +1,75 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dcs/v2/backups"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleBackupsSuccessfully(t)
+
+	opts := backups.CreateOpts{
+		Remark:       "test backup",
+		BackupFormat: "rdb",
+	}
+	id, err := backups.Create(fake.ServiceClient(), instanceID, opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, backupID, id)
+}
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleBackupsSuccessfully(t)
+
+	pages, err := backups.List(fake.ServiceClient(), instanceID, backups.ListOpts{Limit: 10}).AllPages()
+	th.AssertNoErr(t, err)
+	list, err := backups.ExtractBackups(pages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(list))
+	th.AssertEquals(t, backupID, list[0].BackupID)
+	th.AssertEquals(t, "succeed", list[0].Status)
+	th.AssertEquals(t, int64(1024), list[0].Size)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleBackupDeleteSuccessfully(t)
+
+	th.AssertNoErr(t, backups.Delete(fake.ServiceClient(), instanceID, backupID).ExtractErr())
+}
+
+func TestRestore(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleRestoresSuccessfully(t)
+
+	opts := backups.RestoreOpts{
+		BackupID: backupID,
+		Remark:   "test restore",
+	}
+	id, err := backups.Restore(fake.ServiceClient(), instanceID, opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, restoreID, id)
+
+	pages, err := backups.ListRestores(fake.ServiceClient(), instanceID, nil).AllPages()
+	th.AssertNoErr(t, err)
+	list, err := backups.ExtractRestores(pages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(list))
+	th.AssertEquals(t, restoreID, list[0].RestoreID)
+	th.AssertEquals(t, "test restore", list[0].Remark)
+}
+
+func TestRestoreRequired(t *testing.T) {
+	_, err := backups.Restore(fake.ServiceClient(), instanceID, backups.RestoreOpts{}).Extract()
+	if err == nil {
+		t.Fatal("expected error for missing backup ID")
+	}
+}
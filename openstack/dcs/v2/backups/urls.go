@@ -0,0 +1,17 @@
+package backups
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+const resourcePath = "instances"
+
+func backupsURL(client *golangsdk.ServiceClient, instanceID string) string {
+	return client.ServiceURL(resourcePath, instanceID, "backups")
+}
+
+func backupURL(client *golangsdk.ServiceClient, instanceID, backupID string) string {
+	return client.ServiceURL(resourcePath, instanceID, "backups", backupID)
+}
+
+func restoresURL(client *golangsdk.ServiceClient, instanceID string) string {
+	return client.ServiceURL(resourcePath, instanceID, "restores")
+}
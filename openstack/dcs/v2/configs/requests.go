@@ -0,0 +1,53 @@
+package configs
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// List returns the configuration parameters of the instance.
+func List(client *golangsdk.ServiceClient, instanceID string) (r ListResult) {
+	_, r.Err = client.Get(rootURL(client, instanceID), &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// UpdateOptsBuilder is used for updating configuration parameters.
+// any struct providing the parameters should implement this interface
+type UpdateOptsBuilder interface {
+	ToConfigUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts is a struct that contains all the parameters to modify.
+type UpdateOpts struct {
+	RedisConfigs []RedisConfig `json:"redis_config" required:"true"`
+}
+
+// RedisConfig is a configuration parameter to modify.
+type RedisConfig struct {
+	// Configuration item ID.
+	ParamID string `json:"param_id" required:"true"`
+	// Configuration item name, e.g. `timeout` or `maxmemory-policy`.
+	ParamName string `json:"param_name" required:"true"`
+	// New value of the configuration item.
+	ParamValue string `json:"param_value" required:"true"`
+}
+
+// ToConfigUpdateMap is used for type convert
+func (opts UpdateOpts) ToConfigUpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Update modifies the configuration parameters of the instance.
+func Update(client *golangsdk.ServiceClient, instanceID string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToConfigUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Put(rootURL(client, instanceID), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
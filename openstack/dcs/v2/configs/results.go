@@ -0,0 +1,44 @@
+package configs
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+// ConfigParam contains the configuration parameters of the instance.
+type ConfigParam struct {
+	// Instance status
+	Status     string `json:"status"`
+	InstanceID string `json:"instance_id"`
+	// List of the configuration parameters
+	RedisConfigs []ResultRedisConfig `json:"redis_config"`
+	// Status of the last modification: `UPDATING`, `FAILURE` or `SUCCESS`
+	ConfigStatus string `json:"config_status"`
+	// Time of the last modification
+	ConfigTime string `json:"config_time"`
+}
+
+// ResultRedisConfig is a configuration parameter of the instance.
+type ResultRedisConfig struct {
+	Description  string `json:"description"`
+	ParamID      string `json:"param_id"`
+	ParamName    string `json:"param_name"`
+	ParamValue   string `json:"param_value"`
+	DefaultValue string `json:"default_value"`
+	ValueType    string `json:"value_type"`
+	ValueRange   string `json:"value_range"`
+}
+
+// ListResult is a struct from which can get the result of list method
+type ListResult struct {
+	golangsdk.Result
+}
+
+// Extract from ListResult
+func (r ListResult) Extract() (*ConfigParam, error) {
+	s := new(ConfigParam)
+	err := r.ExtractIntoStructPtr(s, "")
+	return s, err
+}
+
+// UpdateResult is a struct from which can get the result of update method
+type UpdateResult struct {
+	golangsdk.ErrResult
+}
@@ -0,0 +1,63 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const instanceID = "3c49a2b5-8e8f-4f8c-9bc1-6dc3a6b4d0e1"
+
+const expectedUpdateRequest = `
+{
+  "redis_config": [
+    {
+      "param_id": "1",
+      "param_name": "timeout",
+      "param_value": "100"
+    }
+  ]
+}
+`
+
+const listResponse = `
+{
+  "instance_id": "3c49a2b5-8e8f-4f8c-9bc1-6dc3a6b4d0e1",
+  "status": "RUNNING",
+  "config_status": "SUCCESS",
+  "config_time": "2022-10-10T16:00:00.514Z",
+  "redis_config": [
+    {
+      "param_id": "1",
+      "param_name": "timeout",
+      "param_value": "100",
+      "default_value": "0",
+      "value_type": "Interger",
+      "value_range": "0-7200",
+      "description": "Close connection if client is idle for a given number of seconds"
+    }
+  ]
+}
+`
+
+// HandleConfigsSuccessfully creates an HTTP handler at `/instances/{instance_id}/configs` on the
+// test handler mux that responds to PUT and GET requests.
+func HandleConfigsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/instances/%s/configs", instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		switch r.Method {
+		case "PUT":
+			th.TestJSONRequest(t, r, expectedUpdateRequest)
+			w.WriteHeader(http.StatusNoContent)
+		case "GET":
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprint(w, listResponse)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+}
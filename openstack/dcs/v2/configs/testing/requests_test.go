@@ -0,0 +1,33 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dcs/v2/configs"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestUpdateAndList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleConfigsSuccessfully(t)
+
+	opts := configs.UpdateOpts{
+		RedisConfigs: []configs.RedisConfig{
+			{
+				ParamID:    "1",
+				ParamName:  "timeout",
+				ParamValue: "100",
+			},
+		},
+	}
+	th.AssertNoErr(t, configs.Update(fake.ServiceClient(), instanceID, opts).ExtractErr())
+
+	params, err := configs.List(fake.ServiceClient(), instanceID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "SUCCESS", params.ConfigStatus)
+	th.AssertEquals(t, 1, len(params.RedisConfigs))
+	th.AssertEquals(t, "timeout", params.RedisConfigs[0].ParamName)
+	th.AssertEquals(t, "100", params.RedisConfigs[0].ParamValue)
+}
@@ -0,0 +1,14 @@
+package configs
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+const (
+	rootPath   = "instances"
+	configPath = "configs"
+)
+
+// rootURL will build the url of list and update request url
+// url: client.Endpoint/instances/{instance_id}/configs
+func rootURL(client *golangsdk.ServiceClient, instanceID string) string {
+	return client.ServiceURL(rootPath, instanceID, configPath)
+}
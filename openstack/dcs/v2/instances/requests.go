@@ -0,0 +1,367 @@
+package instances
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
+)
+
+// CreateOptsBuilder is used for creating instance parameters.
+// any struct providing the parameters should implement this interface
+type CreateOptsBuilder interface {
+	ToInstanceCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts is a struct that contains all the parameters.
+type CreateOpts struct {
+	// DCS instance name.
+	// An instance name is a string of 4–64 characters
+	// that contain letters, digits, underscores (_), and hyphens (-).
+	// An instance name must start with letters.
+	Name string `json:"name" required:"true"`
+
+	// Brief description of the DCS instance.
+	Description string `json:"description,omitempty"`
+
+	// Cache engine, which is Redis.
+	Engine string `json:"engine" required:"true"`
+
+	// Cache engine version: 3.0, 4.0 or 5.0.
+	EngineVersion string `json:"engine_version,omitempty"`
+
+	// Cache capacity in GB.
+	// Together with SpecCode the capacity defines the cache mode:
+	// single-node, master/standby, Proxy Cluster or Redis Cluster.
+	Capacity float64 `json:"capacity" required:"true"`
+
+	// Resource specification code of the instance, e.g. `redis.ha.xu1.large.r2.2`.
+	SpecCode string `json:"spec_code" required:"true"`
+
+	// Codes of the AZs where the cache nodes reside.
+	// Master/standby and cluster instances may be deployed across several AZs.
+	AzCodes []string `json:"az_codes" required:"true"`
+
+	// Tenant's VPC ID.
+	VpcID string `json:"vpc_id" required:"true"`
+
+	// Subnet ID.
+	SubnetID string `json:"subnet_id" required:"true"`
+
+	// Tenant's security group ID, not supported by Redis 4.0 and 5.0 instances.
+	SecurityGroupID string `json:"security_group_id,omitempty"`
+
+	// Port of the instance, Redis 4.0 and 5.0 only. Default: 6379.
+	Port int `json:"port,omitempty"`
+
+	// Password of the DCS instance. Password-free access is enabled when empty.
+	Password string `json:"password,omitempty"`
+
+	// IP address of the instance, assigned automatically when empty.
+	PrivateIP string `json:"private_ip,omitempty"`
+
+	// Backup policy, available for master/standby and cluster instances.
+	InstanceBackupPolicy *InstanceBackupPolicy `json:"instance_backup_policy,omitempty"`
+
+	// Time at which the maintenance time window starts.
+	// Format: HH:mm:ss
+	MaintainBegin string `json:"maintain_begin,omitempty"`
+
+	// Time at which the maintenance time window ends.
+	// Format: HH:mm:ss
+	MaintainEnd string `json:"maintain_end,omitempty"`
+
+	// High-risk commands to rename.
+	RenameCommands *RenameCommands `json:"rename_commands,omitempty"`
+}
+
+// InstanceBackupPolicy for dcs
+type InstanceBackupPolicy struct {
+	// Retention time.
+	// Unit: day.
+	// Range: 1–7.
+	SaveDays int `json:"save_days,omitempty"`
+
+	// Backup type. Options:
+	// auto: automatic backup.
+	// manual: manual backup.
+	BackupType string `json:"backup_type,omitempty"`
+
+	// Backup plan.
+	PeriodicalBackupPlan PeriodicalBackupPlan `json:"periodical_backup_plan" required:"true"`
+}
+
+// PeriodicalBackupPlan for dcs
+type PeriodicalBackupPlan struct {
+	// Time at which backup starts.
+	// "00:00-01:00" indicates that backup starts at 00:00:00.
+	BeginAt string `json:"begin_at" required:"true"`
+
+	// Interval at which backup is performed.
+	// Currently, only weekly backup is supported.
+	PeriodType string `json:"period_type" required:"true"`
+
+	// Day in a week on which backup starts.
+	// Range: 1–7. Where: 1 indicates Monday; 7 indicates Sunday.
+	BackupAt []int `json:"backup_at" required:"true"`
+}
+
+// RenameCommands contains the new names of the high-risk commands.
+type RenameCommands struct {
+	Command  string `json:"command,omitempty"`
+	Keys     string `json:"keys,omitempty"`
+	Flushdb  string `json:"flushdb,omitempty"`
+	Flushall string `json:"flushall,omitempty"`
+	Hgetall  string `json:"hgetall,omitempty"`
+}
+
+// ToInstanceCreateMap is used for type convert
+func (opts CreateOpts) ToInstanceCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Create an instance with given parameters.
+func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToInstanceCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Post(rootURL(client), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete an instance by id
+func Delete(client *golangsdk.ServiceClient, id string) (r DeleteResult) {
+	_, r.Err = client.Delete(resourceURL(client, id), &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// Get a instance with detailed information by id
+func Get(client *golangsdk.ServiceClient, id string) (r GetResult) {
+	_, r.Err = client.Get(resourceURL(client, id), &r.Body, nil)
+	return
+}
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToInstanceListQuery() (string, error)
+}
+
+// ListOpts allows filtering the instances.
+type ListOpts struct {
+	InstanceID string `q:"instance_id"`
+	Name       string `q:"name"`
+	Status     string `q:"status"`
+	IP         string `q:"ip"`
+	// Whether the name is matched exactly: `true` or `false`
+	NameEqual string `q:"name_equal"`
+	Offset    int    `q:"offset"`
+	Limit     int    `q:"limit"`
+}
+
+// ToInstanceListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToInstanceListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), err
+}
+
+// List returns the instances matching the options.
+func List(client *golangsdk.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(client)
+	if opts != nil {
+		query, err := opts.ToInstanceListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return InstancePage{pagination.SinglePageBase(r)}
+	})
+}
+
+// UpdateOptsBuilder is an interface which can build the map paramter of update function
+type UpdateOptsBuilder interface {
+	ToInstanceUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts is a struct which represents the parameters of update function
+type UpdateOpts struct {
+	// DCS instance name.
+	Name string `json:"name,omitempty"`
+
+	// Brief description of the DCS instance.
+	Description *string `json:"description,omitempty"`
+
+	// Backup policy, available for master/standby and cluster instances.
+	InstanceBackupPolicy *InstanceBackupPolicy `json:"instance_backup_policy,omitempty"`
+
+	// Tenant's security group ID, not supported by Redis 4.0 and 5.0 instances.
+	SecurityGroupID string `json:"security_group_id,omitempty"`
+
+	// Time at which the maintenance time window starts.
+	// Format: HH:mm:ss
+	MaintainBegin string `json:"maintain_begin,omitempty"`
+
+	// Time at which the maintenance time window ends.
+	// Format: HH:mm:ss
+	MaintainEnd string `json:"maintain_end,omitempty"`
+}
+
+// ToInstanceUpdateMap is used for type convert
+func (opts UpdateOpts) ToInstanceUpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Update is a method which can be able to update the instance
+// via accessing to the service with Put method and parameters
+func Update(client *golangsdk.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToInstanceUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Put(resourceURL(client, id), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// Supported batch actions.
+const (
+	ActionRestart = "restart"
+	ActionFlush   = "flush"
+)
+
+// ActionOptsBuilder is an interface which can build the map paramter of action function
+type ActionOptsBuilder interface {
+	ToInstanceActionMap() (map[string]interface{}, error)
+}
+
+// ActionOpts is a struct which represents the parameters of action function
+type ActionOpts struct {
+	// IDs of the instances
+	Instances []string `json:"instances" required:"true"`
+	// Operation to perform: `restart` or `flush`
+	Action string `json:"action" required:"true"`
+}
+
+// ToInstanceActionMap is used for type convert
+func (opts ActionOpts) ToInstanceActionMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Action restarts instances or clears their data
+func Action(client *golangsdk.ServiceClient, opts ActionOptsBuilder) (r ActionResult) {
+	b, err := opts.ToInstanceActionMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Put(statusURL(client), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return
+}
+
+// Restart restarts the instance
+func Restart(client *golangsdk.ServiceClient, id string) (r ActionResult) {
+	return Action(client, ActionOpts{Instances: []string{id}, Action: ActionRestart})
+}
+
+// Flush clears the data of the instance
+func Flush(client *golangsdk.ServiceClient, id string) (r ActionResult) {
+	return Action(client, ActionOpts{Instances: []string{id}, Action: ActionFlush})
+}
+
+// UpdatePasswordOptsBuilder is an interface which can build the map paramter of update password function
+type UpdatePasswordOptsBuilder interface {
+	ToPasswordUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdatePasswordOpts is a struct which represents the parameters of update function
+type UpdatePasswordOpts struct {
+	// Old password.
+	OldPassword string `json:"old_password" required:"true"`
+
+	// New password.
+	// Password complexity requirements:
+	// A string of 8–32 characters.
+	// Must be different from the old password.
+	// Contains at least three types of the following characters:
+	// Uppercase letters
+	// Lowercase letters
+	// Digits
+	// Special characters `~!@#$%^&*()-_=+\|[{}]:'",<.>/?
+	NewPassword string `json:"new_password" required:"true"`
+}
+
+// ToPasswordUpdateMap is used for type convert
+func (opts UpdatePasswordOpts) ToPasswordUpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// UpdatePassword is updating password for a dcs instance
+func UpdatePassword(client *golangsdk.ServiceClient, id string, opts UpdatePasswordOptsBuilder) (r UpdatePasswordResult) {
+	body, err := opts.ToPasswordUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Put(passwordURL(client, id), body, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// ResizeOptsBuilder is an interface which can build the map paramter of resize function
+type ResizeOptsBuilder interface {
+	ToInstanceResizeMap() (map[string]interface{}, error)
+}
+
+// ResizeOpts is a struct which represents the parameters of resize function
+type ResizeOpts struct {
+	// Resource specification code of the new instance flavor.
+	SpecCode string `json:"spec_code" required:"true"`
+
+	// New cache capacity in GB.
+	NewCapacity float64 `json:"new_capacity" required:"true"`
+
+	// IP addresses to reserve, for scaling in Redis Cluster instances.
+	ReservedIP []string `json:"reserved_ip,omitempty"`
+
+	// IDs of the replicas to delete, for scaling in replica sets.
+	DeletedNodes []string `json:"deleted_nodes,omitempty"`
+}
+
+// ToInstanceResizeMap is used for type convert
+func (opts ResizeOpts) ToInstanceResizeMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Resize modifies the capacity or specification of the instance online.
+// Use WaitForTaskCompleted to wait for the modification to finish.
+func Resize(client *golangsdk.ServiceClient, id string, opts ResizeOptsBuilder) (r ResizeResult) {
+	b, err := opts.ToInstanceResizeMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Post(resizeURL(client, id), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return
+}
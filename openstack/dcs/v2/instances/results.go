@@ -0,0 +1,167 @@
+package instances
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
+)
+
+// InstanceCreate response
+type InstanceCreate struct {
+	OrderID   string            `json:"order_id"`
+	Instances []CreatedInstance `json:"instances"`
+}
+
+type CreatedInstance struct {
+	InstanceID   string `json:"instance_id"`
+	InstanceName string `json:"instance_name"`
+}
+
+// CreateResult is a struct that contains all the return parameters of creation
+type CreateResult struct {
+	golangsdk.Result
+}
+
+// Extract from CreateResult
+func (r CreateResult) Extract() (*InstanceCreate, error) {
+	var s InstanceCreate
+	err := r.Result.ExtractInto(&s)
+	return &s, err
+}
+
+// DeleteResult is a struct which contains the result of deletion
+type DeleteResult struct {
+	golangsdk.ErrResult
+}
+
+// Instance response
+type Instance struct {
+	Name                 string               `json:"name"`
+	Engine               string               `json:"engine"`
+	EngineVersion        string               `json:"engine_version"`
+	Capacity             int                  `json:"capacity"`
+	CapacityMinor        string               `json:"capacity_minor"`
+	IP                   string               `json:"ip"`
+	DomainName           string               `json:"domain_name"`
+	Port                 int                  `json:"port"`
+	Status               string               `json:"status"`
+	Description          string               `json:"description"`
+	InstanceID           string               `json:"instance_id"`
+	SpecCode             string               `json:"spec_code"`
+	CacheMode            string               `json:"cache_mode"`
+	ChargingMode         int                  `json:"charging_mode"`
+	VpcID                string               `json:"vpc_id"`
+	VpcName              string               `json:"vpc_name"`
+	CreatedAt            string               `json:"created_at"`
+	ProductType          string               `json:"product_type"`
+	SecurityGroupID      string               `json:"security_group_id"`
+	SecurityGroupName    string               `json:"security_group_name"`
+	SubnetID             string               `json:"subnet_id"`
+	SubnetName           string               `json:"subnet_name"`
+	SubnetCidr           string               `json:"subnet_cidr"`
+	AzCodes              []string             `json:"az_codes"`
+	MaxMemory            int                  `json:"max_memory"`
+	UsedMemory           int                  `json:"used_memory"`
+	InstanceBackupPolicy InstanceBackupPolicy `json:"instance_backup_policy"`
+	OrderID              string               `json:"order_id"`
+	MaintainBegin        string               `json:"maintain_begin"`
+	MaintainEnd          string               `json:"maintain_end"`
+	NoPasswordAccess     string               `json:"no_password_access"`
+	AccessUser           string               `json:"access_user"`
+	PublicIP             string               `json:"publicip_address"`
+}
+
+// UpdateResult is a struct from which can get the result of update method
+type UpdateResult struct {
+	golangsdk.ErrResult
+}
+
+// GetResult contains the body of getting detailed
+type GetResult struct {
+	golangsdk.Result
+}
+
+// Extract from GetResult
+func (r GetResult) Extract() (*Instance, error) {
+	var s Instance
+	err := r.Result.ExtractInto(&s)
+	return &s, err
+}
+
+type ListResponse struct {
+	Instances  []Instance `json:"instances"`
+	TotalCount int        `json:"instance_num"`
+}
+
+type InstancePage struct {
+	pagination.SinglePageBase
+}
+
+func (r InstancePage) IsEmpty() (bool, error) {
+	data, err := ExtractInstances(r)
+	if err != nil {
+		return false, err
+	}
+	return len(data.Instances) == 0, err
+}
+
+// ExtractInstances is a function that takes a List page and returns the instances' information.
+func ExtractInstances(r pagination.Page) (ListResponse, error) {
+	var s ListResponse
+	err := (r.(InstancePage)).ExtractInto(&s)
+	return s, err
+}
+
+// ActionResponse contains the result of the batch action per instance
+type ActionResponse struct {
+	Results []InstanceActionResult `json:"results"`
+}
+
+type InstanceActionResult struct {
+	// `success` or `failed`
+	Result   string `json:"result"`
+	Instance string `json:"instance"`
+}
+
+// ActionResult is a struct from which can get the result of action method
+type ActionResult struct {
+	golangsdk.Result
+}
+
+// Extract from ActionResult
+func (r ActionResult) Extract() (*ActionResponse, error) {
+	var s ActionResponse
+	err := r.Result.ExtractInto(&s)
+	return &s, err
+}
+
+// Password response
+type Password struct {
+	// Whether the password is successfully changed:
+	// Values:
+	// Success: The password is successfully changed.
+	// passwordFailed: The old password is incorrect.
+	// Locked: This account has been locked.
+	// Failed: Failed to change the password.
+	Result         string `json:"result"`
+	Message        string `json:"message"`
+	RetryTimesLeft string `json:"retry_times_left"`
+	LockTime       string `json:"lock_time"`
+	LockTimesLeft  string `json:"lock_time_left"`
+}
+
+// UpdatePasswordResult is a struct from which can get the result of update password method
+type UpdatePasswordResult struct {
+	golangsdk.Result
+}
+
+// Extract from UpdatePasswordResult
+func (r UpdatePasswordResult) Extract() (*Password, error) {
+	var s Password
+	err := r.Result.ExtractInto(&s)
+	return &s, err
+}
+
+// ResizeResult is a struct from which can get the result of resize method
+type ResizeResult struct {
+	golangsdk.ErrResult
+}
@@ -0,0 +1,92 @@
+package instances
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// Task is a background task of the instance, e.g. specification modification.
+type Task struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	UserName string `json:"user_name"`
+	UserID   string `json:"user_id"`
+	Params   string `json:"params"`
+	// Task status: `RUNNING`, `SUCCESS`, `FAILED` or `DELETED`
+	Status     string `json:"status"`
+	CreatedAt  string `json:"created_at"`
+	UpdatedAt  string `json:"updated_at"`
+	EnableShow bool   `json:"enable_show"`
+	JobID      string `json:"job_id"`
+}
+
+// TaskList is a list of the background tasks, newest first.
+type TaskList struct {
+	TaskCount string `json:"task_count"`
+	Tasks     []Task `json:"tasks"`
+}
+
+// ListTasks returns the background tasks of the instance.
+func ListTasks(client *golangsdk.ServiceClient, id string) (*TaskList, error) {
+	tasks := new(TaskList)
+	_, err := client.Get(tasksURL(client, id), tasks, &golangsdk.RequestOpts{
+		MoreHeaders: map[string]string{"Content-Type": "application/json"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tasks, nil
+}
+
+// TaskModify is the name of the task started by Resize.
+const TaskModify = "MODIFY"
+
+// TaskClockSkew is the tolerated difference between the client and the service clocks
+// when matching tasks by their creation time.
+const TaskClockSkew = 2 * time.Minute
+
+// WaitForTaskCompleted waits until the background task `name` of the instance created
+// at or after `since` reaches `SUCCESS` status, failing if the task fails.
+// Pass the time taken right before the operation call, e.g. before Resize, so tasks
+// of previous operations are not matched. As the operation calls don't return the task ID,
+// tasks created up to TaskClockSkew before `since` are matched as well.
+func WaitForTaskCompleted(client *golangsdk.ServiceClient, id, name string, since time.Time, secs int) error {
+	conf := golangsdk.StateChangeConf{
+		// the task may not be listed right after the operation call
+		Pending:      []string{"", "RUNNING"},
+		Target:       []string{"SUCCESS"},
+		Refresh:      taskStateRefreshFunc(client, id, name, since),
+		Timeout:      time.Duration(secs) * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	_, err := conf.WaitForState()
+	return err
+}
+
+func taskStateRefreshFunc(client *golangsdk.ServiceClient, id, name string, since time.Time) golangsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		tasks, err := ListTasks(client, id)
+		if err != nil {
+			return nil, "", err
+		}
+		for _, task := range tasks.Tasks {
+			if task.Name != name {
+				continue
+			}
+			createdAt, err := time.Parse(time.RFC3339, task.CreatedAt)
+			if err != nil {
+				return nil, "", fmt.Errorf("error parsing creation time of task %s: %w", task.ID, err)
+			}
+			if createdAt.Before(since.Add(-TaskClockSkew)) {
+				continue
+			}
+			if task.Status == "FAILED" || task.Status == "DELETED" {
+				return task, task.Status, fmt.Errorf("task %s of instance %s is in %s status", task.Name, id, task.Status)
+			}
+			return task, task.Status, nil
+		}
+		return nil, "", nil
+	}
+}
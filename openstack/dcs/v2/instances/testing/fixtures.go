@@ -0,0 +1,245 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const instanceID = "3c49a2b5-8e8f-4f8c-9bc1-6dc3a6b4d0e1"
+
+const expectedCreateRequest = `
+{
+  "name": "dcs-test",
+  "engine": "Redis",
+  "engine_version": "5.0",
+  "capacity": 0.125,
+  "spec_code": "redis.ha.xu1.tiny.r2.128",
+  "az_codes": ["eu-de-01", "eu-de-02"],
+  "vpc_id": "5d7e2c4f-2f3a-4a5b-9c1d-7e8f9a0b1c2d",
+  "subnet_id": "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d",
+  "password": "Qwerty123!",
+  "instance_backup_policy": {
+    "backup_type": "auto",
+    "save_days": 3,
+    "periodical_backup_plan": {
+      "begin_at": "00:00-01:00",
+      "period_type": "weekly",
+      "backup_at": [1, 3, 5]
+    }
+  }
+}`
+
+var createResponse = fmt.Sprintf(`
+{
+  "order_id": "",
+  "instances": [
+    {
+      "instance_id": "%s",
+      "instance_name": "dcs-test"
+    }
+  ]
+}`, instanceID)
+
+var getResponse = fmt.Sprintf(`
+{
+  "name": "dcs-test",
+  "engine": "Redis",
+  "engine_version": "5.0",
+  "capacity": 0,
+  "capacity_minor": ".125",
+  "ip": "192.168.0.10",
+  "domain_name": "redis-3c49a2b5-dcs.dcs.eu-de.otc.t-systems.com",
+  "port": 6379,
+  "status": "RUNNING",
+  "instance_id": "%s",
+  "spec_code": "redis.ha.xu1.tiny.r2.128",
+  "cache_mode": "ha",
+  "az_codes": ["eu-de-01", "eu-de-02"],
+  "max_memory": 128,
+  "used_memory": 2,
+  "no_password_access": "false"
+}`, instanceID)
+
+var listResponse = fmt.Sprintf(`
+{
+  "instance_num": 1,
+  "instances": [%s]
+}`, getResponse)
+
+const expectedActionRequest = `
+{
+  "instances": ["3c49a2b5-8e8f-4f8c-9bc1-6dc3a6b4d0e1"],
+  "action": "flush"
+}`
+
+var actionResponse = fmt.Sprintf(`
+{
+  "results": [
+    {
+      "result": "success",
+      "instance": "%s"
+    }
+  ]
+}`, instanceID)
+
+const passwordResponse = `
+{
+  "lock_time": "0",
+  "result": "Success",
+  "lock_time_left": "0",
+  "retry_times_left": "5",
+  "message": "Modify DCSInstance password success."
+}`
+
+const expectedUpdateRequest = `
+{
+  "description": "",
+  "instance_backup_policy": {
+    "save_days": 3,
+    "backup_type": "auto",
+    "periodical_backup_plan": {
+      "begin_at": "00:00-01:00",
+      "period_type": "weekly",
+      "backup_at": [1, 3, 5]
+    }
+  }
+}
+`
+
+const expectedMaintenanceWindowRequest = `
+{
+  "maintain_begin": "02:00:00",
+  "maintain_end": "06:00:00"
+}
+`
+
+// HandleInstancesSuccessfully creates an HTTP handler at `/instances` on the test handler mux that
+// responds to POST and GET requests.
+func HandleInstancesSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/instances", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+		switch r.Method {
+		case "POST":
+			th.TestJSONRequest(t, r, expectedCreateRequest)
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprint(w, createResponse)
+		case "GET":
+			th.TestFormValues(t, r, map[string]string{"name": "dcs-test"})
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprint(w, listResponse)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+}
+
+// HandleInstanceSuccessfully creates an HTTP handler at `/instances/{instance_id}` on the test
+// handler mux that responds to GET, PUT and DELETE requests.
+func HandleInstanceSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/instances/%s", instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		switch r.Method {
+		case "GET":
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprint(w, getResponse)
+		case "PUT":
+			th.TestJSONRequest(t, r, expectedUpdateRequest)
+			w.WriteHeader(http.StatusNoContent)
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+}
+
+// HandleActionSuccessfully creates an HTTP handler at `/instances/status` on the test handler mux
+// that responds to a PUT request with actionResponse.
+func HandleActionSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/instances/status", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, expectedActionRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, actionResponse)
+	})
+}
+
+// HandlePasswordSuccessfully creates an HTTP handler at `/instances/{instance_id}/password` on the
+// test handler mux that responds to a PUT request with passwordResponse.
+func HandlePasswordSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/instances/%s/password", instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `{"old_password": "Qwerty123!", "new_password": "Qwerty456!"}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, passwordResponse)
+	})
+}
+
+const tasksResponse = `
+{
+  "task_count": "2",
+  "tasks": [
+    {
+      "id": "ff80808183a4b8e70183d9c4c9f52b8b",
+      "name": "MODIFY",
+      "user_name": "user",
+      "user_id": "0c3a9d2a1c00d3a61f0ac0145b8b7a2b",
+      "params": "old_capacity=0.125,new_capacity=1",
+      "status": "SUCCESS",
+      "created_at": "2022-10-10T16:00:00.514Z",
+      "updated_at": "2022-10-10T16:05:00.514Z",
+      "enable_show": true
+    },
+    {
+      "id": "ff80808183a4b8e70183d9a1b2c62a11",
+      "name": "CREATE",
+      "status": "SUCCESS",
+      "created_at": "2022-10-10T15:00:00.514Z",
+      "updated_at": "2022-10-10T15:05:00.514Z",
+      "enable_show": true
+    }
+  ]
+}
+`
+
+// HandleUpdateMaintenanceWindowSuccessfully creates an HTTP handler at `/instances/{instance_id}`
+// on the test handler mux that responds to a PUT request.
+func HandleUpdateMaintenanceWindowSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/instances/%s", instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, expectedMaintenanceWindowRequest)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandleResizeSuccessfully creates HTTP handlers at `/instances/{instance_id}/resize` and
+// `/instances/{instance_id}/tasks` on the test handler mux, the task list contains the resize task.
+func HandleResizeSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/instances/%s/resize", instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `{"spec_code": "redis.ha.xu1.large.r2.1", "new_capacity": 1}`)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	th.Mux.HandleFunc(fmt.Sprintf("/instances/%s/tasks", instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, tasksResponse)
+	})
+}
@@ -0,0 +1,174 @@
+package testing
+
+import (
+	"testing"
+	"time"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dcs/v2/instances"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleInstancesSuccessfully(t)
+
+	opts := instances.CreateOpts{
+		Name:          "dcs-test",
+		Engine:        "Redis",
+		EngineVersion: "5.0",
+		Capacity:      0.125,
+		SpecCode:      "redis.ha.xu1.tiny.r2.128",
+		AzCodes:       []string{"eu-de-01", "eu-de-02"},
+		VpcID:         "5d7e2c4f-2f3a-4a5b-9c1d-7e8f9a0b1c2d",
+		SubnetID:      "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d",
+		Password:      "Qwerty123!",
+		InstanceBackupPolicy: &instances.InstanceBackupPolicy{
+			BackupType: "auto",
+			SaveDays:   3,
+			PeriodicalBackupPlan: instances.PeriodicalBackupPlan{
+				BeginAt:    "00:00-01:00",
+				PeriodType: "weekly",
+				BackupAt:   []int{1, 3, 5},
+			},
+		},
+	}
+	created, err := instances.Create(fake.ServiceClient(), opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, instanceID, created.Instances[0].InstanceID)
+}
+
+func TestGetAndWait(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleInstanceSuccessfully(t)
+
+	instance, err := instances.Get(fake.ServiceClient(), instanceID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "ha", instance.CacheMode)
+	th.AssertEquals(t, ".125", instance.CapacityMinor)
+	th.AssertEquals(t, 6379, instance.Port)
+
+	th.AssertNoErr(t, instances.WaitForStatus(fake.ServiceClient(), instanceID, "RUNNING", 10))
+}
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleInstancesSuccessfully(t)
+
+	pages, err := instances.List(fake.ServiceClient(), instances.ListOpts{Name: "dcs-test"}).AllPages()
+	th.AssertNoErr(t, err)
+	list, err := instances.ExtractInstances(pages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, list.TotalCount)
+	th.AssertEquals(t, instanceID, list.Instances[0].InstanceID)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleInstanceSuccessfully(t)
+
+	th.AssertNoErr(t, instances.Delete(fake.ServiceClient(), instanceID).ExtractErr())
+}
+
+func TestUpdateBackupPolicy(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleInstanceSuccessfully(t)
+
+	description := ""
+	opts := instances.UpdateOpts{
+		Description: &description,
+		InstanceBackupPolicy: &instances.InstanceBackupPolicy{
+			SaveDays:   3,
+			BackupType: "auto",
+			PeriodicalBackupPlan: instances.PeriodicalBackupPlan{
+				BeginAt:    "00:00-01:00",
+				PeriodType: "weekly",
+				BackupAt:   []int{1, 3, 5},
+			},
+		},
+	}
+	th.AssertNoErr(t, instances.Update(fake.ServiceClient(), instanceID, opts).ExtractErr())
+}
+
+func TestUpdateMaintenanceWindow(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateMaintenanceWindowSuccessfully(t)
+
+	opts := instances.UpdateOpts{
+		MaintainBegin: "02:00:00",
+		MaintainEnd:   "06:00:00",
+	}
+	th.AssertNoErr(t, instances.Update(fake.ServiceClient(), instanceID, opts).ExtractErr())
+}
+
+func TestFlush(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleActionSuccessfully(t)
+
+	result, err := instances.Flush(fake.ServiceClient(), instanceID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "success", result.Results[0].Result)
+}
+
+func TestUpdatePassword(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandlePasswordSuccessfully(t)
+
+	opts := instances.UpdatePasswordOpts{
+		OldPassword: "Qwerty123!",
+		NewPassword: "Qwerty456!",
+	}
+	result, err := instances.UpdatePassword(fake.ServiceClient(), instanceID, opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "Success", result.Result)
+}
+
+func TestResize(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleResizeSuccessfully(t)
+
+	opts := instances.ResizeOpts{
+		SpecCode:    "redis.ha.xu1.large.r2.1",
+		NewCapacity: 1,
+	}
+	th.AssertNoErr(t, instances.Resize(fake.ServiceClient(), instanceID, opts).ExtractErr())
+	since := time.Date(2022, 10, 10, 15, 30, 0, 0, time.UTC)
+	th.AssertNoErr(t, instances.WaitForTaskCompleted(fake.ServiceClient(), instanceID, instances.TaskModify, since, 10))
+
+	tasks, err := instances.ListTasks(fake.ServiceClient(), instanceID)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, len(tasks.Tasks))
+	th.AssertEquals(t, "MODIFY", tasks.Tasks[0].Name)
+}
+
+func TestWaitForTaskCompletedClockSkew(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleResizeSuccessfully(t)
+
+	// the client clock is ahead of the service one, the task is created "before" the call
+	since := time.Date(2022, 10, 10, 16, 1, 0, 0, time.UTC)
+	th.AssertNoErr(t, instances.WaitForTaskCompleted(fake.ServiceClient(), instanceID, instances.TaskModify, since, 10))
+}
+
+func TestWaitForTaskCompletedIgnoresPreviousTasks(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleResizeSuccessfully(t)
+
+	since := time.Date(2022, 10, 10, 16, 30, 0, 0, time.UTC)
+	err := instances.WaitForTaskCompleted(fake.ServiceClient(), instanceID, instances.TaskModify, since, 1)
+	if _, ok := err.(golangsdk.ErrTimeOut); !ok {
+		t.Fatalf("Expected ErrTimeOut, got %v", err)
+	}
+}
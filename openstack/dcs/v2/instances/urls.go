@@ -0,0 +1,36 @@
+package instances
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+// endpoint/instances
+const resourcePath = "instances"
+
+// rootURL will build the url of creation and listing
+func rootURL(client *golangsdk.ServiceClient) string {
+	return client.ServiceURL(resourcePath)
+}
+
+// resourceURL will build the url of get and deletion
+func resourceURL(client *golangsdk.ServiceClient, id string) string {
+	return client.ServiceURL(resourcePath, id)
+}
+
+// statusURL will build the url of batch actions
+func statusURL(client *golangsdk.ServiceClient) string {
+	return client.ServiceURL(resourcePath, "status")
+}
+
+// passwordURL will build the password update url
+func passwordURL(client *golangsdk.ServiceClient, id string) string {
+	return client.ServiceURL(resourcePath, id, "password")
+}
+
+// resizeURL will build the url of specification modification
+func resizeURL(client *golangsdk.ServiceClient, id string) string {
+	return client.ServiceURL(resourcePath, id, "resize")
+}
+
+// tasksURL will build the url of background tasks listing
+func tasksURL(client *golangsdk.ServiceClient, id string) string {
+	return client.ServiceURL(resourcePath, id, "tasks")
+}
@@ -0,0 +1,45 @@
+package instances
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+const deletedStatus = "DELETED"
+
+// WaitForStatus waits until the instance reaches the status, e.g. `RUNNING`.
+// It fails immediately when the instance turns to `ERROR` or `CREATEFAILED`.
+func WaitForStatus(client *golangsdk.ServiceClient, id, status string, secs int) error {
+	conf := golangsdk.StateChangeConf{
+		Target:       []string{status},
+		Refresh:      stateRefreshFunc(client, id),
+		Timeout:      time.Duration(secs) * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	_, err := conf.WaitForState()
+	return err
+}
+
+// WaitForDeleted waits until the instance is not found.
+func WaitForDeleted(client *golangsdk.ServiceClient, id string, secs int) error {
+	return WaitForStatus(client, id, deletedStatus, secs)
+}
+
+func stateRefreshFunc(client *golangsdk.ServiceClient, id string) golangsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		instance, err := Get(client, id).Extract()
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return instance, deletedStatus, nil
+			}
+			return nil, "", err
+		}
+		switch instance.Status {
+		case "ERROR", "CREATEFAILED":
+			return instance, instance.Status, fmt.Errorf("DCS instance %s is in %s status", id, instance.Status)
+		}
+		return instance, instance.Status, nil
+	}
+}
@@ -0,0 +1,9 @@
+package quotas
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+// Get retrieves DCS resource quotas of the project together with their usage.
+func Get(client *golangsdk.ServiceClient) (r GetResult) {
+	_, r.Err = client.Get(getURL(client), &r.Body, nil)
+	return
+}
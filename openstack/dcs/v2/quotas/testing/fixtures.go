@@ -0,0 +1,47 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const getResponse = `
+{
+  "quotas": {
+    "resources": [
+      {
+        "unit": "",
+        "min": 1,
+        "max": 10,
+        "quota": 10,
+        "used": 3,
+        "type": "instances"
+      },
+      {
+        "unit": "GB",
+        "min": 1,
+        "max": 800,
+        "quota": 800,
+        "used": 12,
+        "type": "ram"
+      }
+    ]
+  }
+}`
+
+// HandleGetSuccessfully creates an HTTP handler at `/quota` on the test handler mux that responds
+// to a GET request with getResponse.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/quota", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, getResponse)
+	})
+}
@@ -0,0 +1,56 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const instanceID = "3c49a2b5-8e8f-4f8c-9bc1-6dc3a6b4d0e1"
+
+const expectedPutRequest = `
+{
+  "enable_whitelist": true,
+  "whitelist": [
+    {
+      "group_name": "test-group",
+      "ip_list": ["10.10.10.1", "10.10.20.0/24"]
+    }
+  ]
+}
+`
+
+const getResponse = `
+{
+  "instance_id": "3c49a2b5-8e8f-4f8c-9bc1-6dc3a6b4d0e1",
+  "enable_whitelist": true,
+  "whitelist": [
+    {
+      "group_name": "test-group",
+      "ip_list": ["10.10.10.1", "10.10.20.0/24"]
+    }
+  ]
+}
+`
+
+// HandleWhitelistSuccessfully creates an HTTP handler at `/instance/{instance_id}/whitelist` on the
+// test handler mux that responds to PUT and GET requests.
+func HandleWhitelistSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/instance/%s/whitelist", instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		switch r.Method {
+		case "PUT":
+			th.TestJSONRequest(t, r, expectedPutRequest)
+			w.WriteHeader(http.StatusNoContent)
+		case "GET":
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprint(w, getResponse)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+}
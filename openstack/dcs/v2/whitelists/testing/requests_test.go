@@ -0,0 +1,34 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dcs/v2/whitelists"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestPutAndGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleWhitelistSuccessfully(t)
+
+	enable := true
+	opts := whitelists.WhitelistOpts{
+		Enable: &enable,
+		Groups: []whitelists.WhitelistGroupOpts{
+			{
+				GroupName: "test-group",
+				IPList:    []string{"10.10.10.1", "10.10.20.0/24"},
+			},
+		},
+	}
+	th.AssertNoErr(t, whitelists.Put(fake.ServiceClient(), instanceID, opts).ExtractErr())
+
+	whitelist, err := whitelists.Get(fake.ServiceClient(), instanceID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, instanceID, whitelist.InstanceID)
+	th.AssertEquals(t, true, whitelist.Enable)
+	th.AssertEquals(t, 1, len(whitelist.Groups))
+	th.AssertDeepEquals(t, opts.Groups[0].IPList, whitelist.Groups[0].IPList)
+}
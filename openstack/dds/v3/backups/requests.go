@@ -0,0 +1,287 @@
+package backups
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
+)
+
+var requestOpts = golangsdk.RequestOpts{
+	MoreHeaders: map[string]string{"Content-Type": "application/json"},
+}
+
+// PolicyOptsBuilder allows extensions to add additional parameters to the
+// UpdatePolicy request.
+type PolicyOptsBuilder interface {
+	ToBackupPolicyUpdateMap() (map[string]interface{}, error)
+}
+
+// PolicyOpts contains all the values needed to change the automated backup policy.
+type PolicyOpts struct {
+	BackupPolicy *BackupPolicy `json:"backup_policy" required:"true"`
+}
+
+type BackupPolicy struct {
+	// Number of days to retain the backup files, from 0 to 732. 0 disables automated backups.
+	KeepDays *int `json:"keep_days" required:"true"`
+	// Backup time window in `hh:mm-HH:MM` format, UTC
+	StartTime string `json:"start_time,omitempty"`
+	// Backup cycle as a comma-separated list of the days of the week, e.g. `1,2,3,4,5,6,7`
+	Period string `json:"period,omitempty"`
+}
+
+// ToBackupPolicyUpdateMap builds a request body from PolicyOpts.
+func (opts PolicyOpts) ToBackupPolicyUpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// UpdatePolicy sets the automated backup policy of the instance.
+func UpdatePolicy(client *golangsdk.ServiceClient, instanceID string, opts PolicyOptsBuilder) (r UpdatePolicyResult) {
+	b, err := opts.ToBackupPolicyUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(policyURL(client, instanceID), b, nil, &golangsdk.RequestOpts{
+		OkCodes:     []int{200},
+		MoreHeaders: requestOpts.MoreHeaders,
+	})
+	return
+}
+
+// GetPolicy retrieves the automated backup policy of the instance.
+func GetPolicy(client *golangsdk.ServiceClient, instanceID string) (r GetPolicyResult) {
+	_, r.Err = client.Get(policyURL(client, instanceID), &r.Body, &requestOpts)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToBackupCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts contains all the values needed to create a manual backup.
+type CreateOpts struct {
+	Backup *Backup `json:"backup" required:"true"`
+}
+
+type Backup struct {
+	// ID of the instance to back up
+	InstanceID string `json:"instance_id" required:"true"`
+	// Backup name, 4 to 64 characters
+	Name string `json:"name" required:"true"`
+	// Backup description
+	Description string `json:"description,omitempty"`
+}
+
+// ToBackupCreateMap builds a create request body from CreateOpts.
+func (opts CreateOpts) ToBackupCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Create creates a manual backup of the instance.
+func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToBackupCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(baseURL(client), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes:     []int{200, 202},
+		MoreHeaders: requestOpts.MoreHeaders,
+	})
+	return
+}
+
+// Delete deletes a manual backup.
+func Delete(client *golangsdk.ServiceClient, backupID string) (r JobResult) {
+	_, r.Err = client.Delete(resourceURL(client, backupID), &golangsdk.RequestOpts{
+		OkCodes:      []int{200, 202},
+		JSONResponse: &r.Body,
+		MoreHeaders:  requestOpts.MoreHeaders,
+	})
+	return
+}
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToBackupListQuery() (string, error)
+}
+
+// ListOpts allows filtering the backups.
+type ListOpts struct {
+	InstanceID string `q:"instance_id"`
+	BackupID   string `q:"backup_id"`
+	// Backup type: `Auto`, `Manual` or `Incremental`
+	BackupType string `q:"backup_type"`
+	// Instance mode: `Sharding`, `ReplicaSet` or `Single`
+	Mode string `q:"mode"`
+	// Query start time in `yyyy-mm-dd hh:mm:ss` format, UTC
+	BeginTime string `q:"begin_time"`
+	// Query end time in `yyyy-mm-dd hh:mm:ss` format, UTC
+	EndTime string `q:"end_time"`
+	Offset  int    `q:"offset"`
+	Limit   int    `q:"limit"`
+}
+
+// ToBackupListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToBackupListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), err
+}
+
+// List returns the backups matching the options.
+func List(client *golangsdk.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := baseURL(client)
+	if opts != nil {
+		query, err := opts.ToBackupListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return BackupPage{pagination.SinglePageBase(r)}
+	})
+}
+
+// RestoreOptsBuilder allows extensions to add additional parameters to the
+// Restore request.
+type RestoreOptsBuilder interface {
+	ToRestoreMap() (map[string]interface{}, error)
+}
+
+// RestoreOpts contains the source and the target of restoring data to an existing instance.
+type RestoreOpts struct {
+	Source Source `json:"source" required:"true"`
+	Target Target `json:"target" required:"true"`
+}
+
+type Source struct {
+	// ID of the instance the backup or the point in time belongs to
+	InstanceID string `json:"instance_id" required:"true"`
+	// Restoration mode: `backup` or `timestamp`
+	Type string `json:"type,omitempty"`
+	// ID of the backup to restore, used when Type is `backup`
+	BackupID string `json:"backup_id,omitempty"`
+	// Point in time to restore to, UNIX timestamp in milliseconds, used when Type is `timestamp`
+	RestoreTime int64 `json:"restore_time,omitempty"`
+}
+
+type Target struct {
+	// ID of the instance to restore data to
+	InstanceID string `json:"instance_id" required:"true"`
+}
+
+// ToRestoreMap builds a restore request body from RestoreOpts.
+func (opts RestoreOpts) ToRestoreMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Restore restores the backup or the point in time data to an existing instance.
+// To restore data to a new instance, use instances.Create with RestorePoint set.
+func Restore(client *golangsdk.ServiceClient, opts RestoreOptsBuilder) (r JobResult) {
+	b, err := opts.ToRestoreMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(restoreURL(client), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes:     []int{200, 202},
+		MoreHeaders: requestOpts.MoreHeaders,
+	})
+	return
+}
+
+// OffsitePolicyOptsBuilder allows extensions to add additional parameters to the
+// UpdateOffsitePolicy request.
+type OffsitePolicyOptsBuilder interface {
+	ToOffsitePolicyUpdateMap() (map[string]interface{}, error)
+}
+
+// OffsitePolicyOpts contains all the values needed to change the cross-region backup policy.
+type OffsitePolicyOpts struct {
+	// Number of days to retain the cross-region backups, from 1 to 1825. 0 disables cross-region backups.
+	KeepDays *int `json:"keep_days" required:"true"`
+	// Region to store the backups in, mandatory when enabling cross-region backups
+	DestinationRegion string `json:"destination_region,omitempty"`
+	// Project ID in the destination region, mandatory when enabling cross-region backups
+	DestinationProjectID string `json:"destination_project_id,omitempty"`
+}
+
+// ToOffsitePolicyUpdateMap builds a request body from OffsitePolicyOpts.
+func (opts OffsitePolicyOpts) ToOffsitePolicyUpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "policy")
+}
+
+// UpdateOffsitePolicy enables, changes or disables the cross-region backups of the instance.
+func UpdateOffsitePolicy(client *golangsdk.ServiceClient, instanceID string, opts OffsitePolicyOptsBuilder) (r UpdatePolicyResult) {
+	b, err := opts.ToOffsitePolicyUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(offsitePolicyURL(client, instanceID), b, nil, &golangsdk.RequestOpts{
+		OkCodes:     []int{200},
+		MoreHeaders: requestOpts.MoreHeaders,
+	})
+	return
+}
+
+// GetOffsitePolicy retrieves the cross-region backup policy of the instance.
+func GetOffsitePolicy(client *golangsdk.ServiceClient, instanceID string) (r GetOffsitePolicyResult) {
+	_, r.Err = client.Get(offsitePolicyURL(client, instanceID), &r.Body, &requestOpts)
+	return
+}
+
+// ListOffsiteOpts allows filtering the cross-region backups.
+type ListOffsiteOpts struct {
+	InstanceID string `q:"instance_id,required"`
+	BackupID   string `q:"backup_id"`
+	// Backup type: `Auto` or `Incremental`
+	BackupType string `q:"backup_type"`
+	// Query start time in `yyyy-mm-dd hh:mm:ss` format, UTC
+	BeginTime string `q:"begin_time"`
+	// Query end time in `yyyy-mm-dd hh:mm:ss` format, UTC
+	EndTime string `q:"end_time"`
+	Offset  int    `q:"offset"`
+	Limit   int    `q:"limit"`
+}
+
+// ToBackupListQuery formats a ListOffsiteOpts into a query string.
+func (opts ListOffsiteOpts) ToBackupListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), err
+}
+
+// ListOffsite returns the cross-region backups of the instance.
+func ListOffsite(client *golangsdk.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := offsiteBackupsURL(client)
+	if opts != nil {
+		query, err := opts.ToBackupListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return BackupPage{pagination.SinglePageBase(r)}
+	})
+}
+
+// ListOffsiteRestoreTimes returns the time ranges the instance can be restored to
+// from the cross-region backups on the given date in `yyyy-mm-dd` format.
+func ListOffsiteRestoreTimes(client *golangsdk.ServiceClient, instanceID, date string) (r RestoreTimesResult) {
+	url := offsiteRestoreTimeURL(client, instanceID) + "?date=" + date
+	_, r.Err = client.Get(url, &r.Body, &requestOpts)
+	return
+}
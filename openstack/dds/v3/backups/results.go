@@ -0,0 +1,133 @@
+package backups
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
+)
+
+type BackupPolicyResp struct {
+	KeepDays  int    `json:"keep_days"`
+	StartTime string `json:"start_time"`
+	Period    string `json:"period"`
+}
+
+type BackupResp struct {
+	ID           string    `json:"id"`
+	Name         string    `json:"name"`
+	InstanceID   string    `json:"instance_id"`
+	InstanceName string    `json:"instance_name"`
+	Datastore    Datastore `json:"datastore"`
+	// Backup type: `Auto`, `Manual` or `Incremental`
+	Type        string `json:"type"`
+	BeginTime   string `json:"begin_time"`
+	EndTime     string `json:"end_time"`
+	Status      string `json:"status"`
+	Size        int64  `json:"size"`
+	Description string `json:"description"`
+}
+
+type Datastore struct {
+	Type    string `json:"type"`
+	Version string `json:"version"`
+}
+
+type CreateResponse struct {
+	BackupID string `json:"backup_id"`
+	JobID    string `json:"job_id"`
+}
+
+type JobResponse struct {
+	JobID string `json:"job_id"`
+}
+
+// UpdatePolicyResult represents the result of a policy update operation.
+type UpdatePolicyResult struct {
+	golangsdk.ErrResult
+}
+
+// GetPolicyResult represents the result of a get policy operation.
+type GetPolicyResult struct {
+	golangsdk.Result
+}
+
+func (r GetPolicyResult) Extract() (*BackupPolicyResp, error) {
+	var policy BackupPolicyResp
+	err := r.ExtractIntoStructPtr(&policy, "backup_policy")
+	return &policy, err
+}
+
+// CreateResult represents the result of a create operation.
+type CreateResult struct {
+	golangsdk.Result
+}
+
+func (r CreateResult) Extract() (*CreateResponse, error) {
+	var response CreateResponse
+	err := r.ExtractInto(&response)
+	return &response, err
+}
+
+// JobResult represents the result of an operation performed by an asynchronous job.
+type JobResult struct {
+	golangsdk.Result
+}
+
+func (r JobResult) Extract() (*JobResponse, error) {
+	var response JobResponse
+	err := r.ExtractInto(&response)
+	return &response, err
+}
+
+type BackupPage struct {
+	pagination.SinglePageBase
+}
+
+func (r BackupPage) IsEmpty() (bool, error) {
+	backups, err := ExtractBackups(r)
+	if err != nil {
+		return false, err
+	}
+	return len(backups) == 0, nil
+}
+
+// ExtractBackups interprets the results of a single page from a List() call,
+// producing a slice of BackupResp entities.
+func ExtractBackups(r pagination.Page) ([]BackupResp, error) {
+	var s []BackupResp
+	err := (r.(BackupPage)).ExtractIntoSlicePtr(&s, "backups")
+	return s, err
+}
+
+type OffsitePolicyResp struct {
+	KeepDays             int    `json:"keep_days"`
+	DestinationRegion    string `json:"destination_region"`
+	DestinationProjectID string `json:"destination_project_id"`
+}
+
+// GetOffsitePolicyResult represents the result of a get cross-region policy operation.
+type GetOffsitePolicyResult struct {
+	golangsdk.Result
+}
+
+func (r GetOffsitePolicyResult) Extract() (*OffsitePolicyResp, error) {
+	var policy OffsitePolicyResp
+	err := r.ExtractIntoStructPtr(&policy, "policy")
+	return &policy, err
+}
+
+// RestoreTime is a time range the instance can be restored to, in milliseconds since epoch.
+type RestoreTime struct {
+	StartTime int64 `json:"start_time"`
+	EndTime   int64 `json:"end_time"`
+}
+
+// RestoreTimesResult represents the result of a list restore times operation.
+type RestoreTimesResult struct {
+	golangsdk.Result
+}
+
+func (r RestoreTimesResult) Extract() ([]RestoreTime, error) {
+	var times []RestoreTime
+	err := r.ExtractIntoSlicePtr(&times, "restore_time")
+	return times, err
+}
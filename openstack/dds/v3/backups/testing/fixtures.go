@@ -0,0 +1,204 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const (
+	instanceID = "9136fd2a9fcd405ea4674276ce36dae8in02"
+	backupID   = "43e4feaab48f11e89039fa163ebaa7e4br02"
+	jobID      = "3711e2ad-5787-49bc-a47f-3f0b066af9f5"
+)
+
+const expectedPolicyRequest = `
+{
+  "backup_policy": {
+    "keep_days": 7,
+    "start_time": "23:00-00:00",
+    "period": "1,2,3,4,5,6,7"
+  }
+}`
+
+const expectedCreateRequest = `
+{
+  "backup": {
+    "instance_id": "9136fd2a9fcd405ea4674276ce36dae8in02",
+    "name": "backup-test",
+    "description": "manual backup"
+  }
+}`
+
+const listResponse = `
+{
+  "backups": [
+    {
+      "id": "43e4feaab48f11e89039fa163ebaa7e4br02",
+      "name": "backup-test",
+      "instance_id": "9136fd2a9fcd405ea4674276ce36dae8in02",
+      "instance_name": "dds-test",
+      "datastore": {
+        "type": "DDS-Community",
+        "version": "3.4"
+      },
+      "type": "Manual",
+      "begin_time": "2018-08-06 12:41:14",
+      "end_time": "2018-08-06 12:43:14",
+      "status": "COMPLETED",
+      "size": 2803,
+      "description": "manual backup"
+    }
+  ],
+  "total_count": 1
+}`
+
+const expectedRestoreRequest = `
+{
+  "source": {
+    "instance_id": "9136fd2a9fcd405ea4674276ce36dae8in02",
+    "type": "timestamp",
+    "restore_time": 1533533000000
+  },
+  "target": {
+    "instance_id": "9136fd2a9fcd405ea4674276ce36dae8in02"
+  }
+}`
+
+var jobResponse = fmt.Sprintf(`{"job_id": "%s"}`, jobID)
+
+// HandlePolicySuccessfully creates an HTTP handler at `/instances/{instance_id}/backups/policy` on
+// the test handler mux that responds to PUT and GET requests.
+func HandlePolicySuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/instances/%s/backups/policy", instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		switch r.Method {
+		case "PUT":
+			th.TestJSONRequest(t, r, expectedPolicyRequest)
+			w.WriteHeader(http.StatusOK)
+		case "GET":
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprint(w, expectedPolicyRequest)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+}
+
+// HandleBackupsSuccessfully creates an HTTP handler at `/backups` on the test handler mux that
+// responds to POST and GET requests.
+func HandleBackupsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/backups", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+		switch r.Method {
+		case "POST":
+			th.TestJSONRequest(t, r, expectedCreateRequest)
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = fmt.Fprintf(w, `{"backup_id": "%s", "job_id": "%s"}`, backupID, jobID)
+		case "GET":
+			th.TestFormValues(t, r, map[string]string{"instance_id": instanceID, "backup_type": "Manual"})
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprint(w, listResponse)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+}
+
+// HandleDeleteSuccessfully creates an HTTP handler at `/backups/{backup_id}` on the test handler
+// mux that responds to a DELETE request with jobResponse.
+func HandleDeleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/backups/%s", backupID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = fmt.Fprint(w, jobResponse)
+	})
+}
+
+// HandleRestoreSuccessfully creates an HTTP handler at `/instances/recovery` on the test handler
+// mux that responds to a POST request with jobResponse.
+func HandleRestoreSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/instances/recovery", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, expectedRestoreRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = fmt.Fprint(w, jobResponse)
+	})
+}
+
+const expectedOffsitePolicyRequest = `
+{
+  "policy": {
+    "keep_days": 7,
+    "destination_region": "eu-nl",
+    "destination_project_id": "d8e8b1e4f4b44c2b8f1cd1bd5d4bb2ac"
+  }
+}`
+
+const restoreTimesResponse = `
+{
+  "restore_time": [
+    {
+      "start_time": 1533531000000,
+      "end_time": 1533535000000
+    }
+  ]
+}`
+
+// HandleOffsitePolicySuccessfully creates an HTTP handler at
+// `/instances/{instance_id}/backups/offsite-policy` on the test handler mux that responds to PUT
+// and GET requests.
+func HandleOffsitePolicySuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/instances/%s/backups/offsite-policy", instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		switch r.Method {
+		case "PUT":
+			th.TestJSONRequest(t, r, expectedOffsitePolicyRequest)
+			w.WriteHeader(http.StatusOK)
+		case "GET":
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprint(w, expectedOffsitePolicyRequest)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+}
+
+// HandleOffsiteBackupsSuccessfully creates an HTTP handler at `/offsite-backups` on the test
+// handler mux that responds to a GET request with listResponse.
+func HandleOffsiteBackupsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/offsite-backups", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"instance_id": instanceID})
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, listResponse)
+	})
+}
+
+// HandleOffsiteRestoreTimesSuccessfully creates an HTTP handler at
+// `/instances/{instance_id}/offsite-restore-time` on the test handler mux that responds to a GET
+// request with restoreTimesResponse.
+func HandleOffsiteRestoreTimesSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/instances/%s/offsite-restore-time", instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"date": "2018-08-06"})
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, restoreTimesResponse)
+	})
+}
@@ -0,0 +1,140 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dds/v3/backups"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestPolicy(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandlePolicySuccessfully(t)
+
+	keepDays := 7
+	opts := backups.PolicyOpts{
+		BackupPolicy: &backups.BackupPolicy{
+			KeepDays:  &keepDays,
+			StartTime: "23:00-00:00",
+			Period:    "1,2,3,4,5,6,7",
+		},
+	}
+	err := backups.UpdatePolicy(fake.ServiceClient(), instanceID, opts).ExtractErr()
+	th.AssertNoErr(t, err)
+
+	policy, err := backups.GetPolicy(fake.ServiceClient(), instanceID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 7, policy.KeepDays)
+	th.AssertEquals(t, "23:00-00:00", policy.StartTime)
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleBackupsSuccessfully(t)
+
+	opts := backups.CreateOpts{
+		Backup: &backups.Backup{
+			InstanceID:  instanceID,
+			Name:        "backup-test",
+			Description: "manual backup",
+		},
+	}
+	backup, err := backups.Create(fake.ServiceClient(), opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, backupID, backup.BackupID)
+	th.AssertEquals(t, jobID, backup.JobID)
+}
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleBackupsSuccessfully(t)
+
+	pages, err := backups.List(fake.ServiceClient(), backups.ListOpts{
+		InstanceID: instanceID,
+		BackupType: "Manual",
+	}).AllPages()
+	th.AssertNoErr(t, err)
+	list, err := backups.ExtractBackups(pages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(list))
+	th.AssertEquals(t, backupID, list[0].ID)
+	th.AssertEquals(t, int64(2803), list[0].Size)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteSuccessfully(t)
+
+	job, err := backups.Delete(fake.ServiceClient(), backupID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, jobID, job.JobID)
+}
+
+func TestRestore(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleRestoreSuccessfully(t)
+
+	opts := backups.RestoreOpts{
+		Source: backups.Source{
+			InstanceID:  instanceID,
+			Type:        "timestamp",
+			RestoreTime: 1533533000000,
+		},
+		Target: backups.Target{InstanceID: instanceID},
+	}
+	job, err := backups.Restore(fake.ServiceClient(), opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, jobID, job.JobID)
+}
+
+func TestOffsitePolicy(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleOffsitePolicySuccessfully(t)
+
+	keepDays := 7
+	opts := backups.OffsitePolicyOpts{
+		KeepDays:             &keepDays,
+		DestinationRegion:    "eu-nl",
+		DestinationProjectID: "d8e8b1e4f4b44c2b8f1cd1bd5d4bb2ac",
+	}
+	err := backups.UpdateOffsitePolicy(fake.ServiceClient(), instanceID, opts).ExtractErr()
+	th.AssertNoErr(t, err)
+
+	policy, err := backups.GetOffsitePolicy(fake.ServiceClient(), instanceID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 7, policy.KeepDays)
+	th.AssertEquals(t, "eu-nl", policy.DestinationRegion)
+}
+
+func TestListOffsite(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleOffsiteBackupsSuccessfully(t)
+
+	pages, err := backups.ListOffsite(fake.ServiceClient(), backups.ListOffsiteOpts{
+		InstanceID: instanceID,
+	}).AllPages()
+	th.AssertNoErr(t, err)
+	list, err := backups.ExtractBackups(pages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(list))
+	th.AssertEquals(t, backupID, list[0].ID)
+}
+
+func TestListOffsiteRestoreTimes(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleOffsiteRestoreTimesSuccessfully(t)
+
+	times, err := backups.ListOffsiteRestoreTimes(fake.ServiceClient(), instanceID, "2018-08-06").Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(times))
+	th.AssertEquals(t, int64(1533535000000), times[0].EndTime)
+}
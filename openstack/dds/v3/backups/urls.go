@@ -0,0 +1,31 @@
+package backups
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+func baseURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("backups")
+}
+
+func resourceURL(c *golangsdk.ServiceClient, backupID string) string {
+	return c.ServiceURL("backups", backupID)
+}
+
+func policyURL(c *golangsdk.ServiceClient, instanceID string) string {
+	return c.ServiceURL("instances", instanceID, "backups", "policy")
+}
+
+func restoreURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("instances", "recovery")
+}
+
+func offsitePolicyURL(c *golangsdk.ServiceClient, instanceID string) string {
+	return c.ServiceURL("instances", instanceID, "backups", "offsite-policy")
+}
+
+func offsiteBackupsURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("offsite-backups")
+}
+
+func offsiteRestoreTimeURL(c *golangsdk.ServiceClient, instanceID string) string {
+	return c.ServiceURL("instances", instanceID, "offsite-restore-time")
+}
@@ -0,0 +1,148 @@
+package configurations
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+var requestOpts = golangsdk.RequestOpts{
+	MoreHeaders: map[string]string{"Content-Type": "application/json"},
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToConfigCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts contains all the values needed to create a new parameter group.
+type CreateOpts struct {
+	// Parameter group name
+	Name string `json:"name" required:"true"`
+	// Parameter group description
+	Description string `json:"description,omitempty"`
+	// Parameter values overriding the defaults
+	Values map[string]string `json:"values,omitempty"`
+	// Database object
+	DataStore DataStore `json:"datastore" required:"true"`
+}
+
+type DataStore struct {
+	// Node type the parameter group is for: `mongos`, `shard`, `config`, `replica` or `single`
+	Type string `json:"type" required:"true"`
+	// DB version
+	Version string `json:"version" required:"true"`
+}
+
+// ToConfigCreateMap builds a create request body from CreateOpts.
+func (opts CreateOpts) ToConfigCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Create will create a new parameter group based on the values in CreateOpts.
+func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToConfigCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(rootURL(client), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes:     []int{200},
+		MoreHeaders: requestOpts.MoreHeaders,
+	})
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToConfigUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts contains all the values needed to update a parameter group.
+type UpdateOpts struct {
+	// Parameter group name
+	Name string `json:"name,omitempty"`
+	// Parameter group description
+	Description string `json:"description,omitempty"`
+	// Parameter values
+	Values map[string]string `json:"values,omitempty"`
+}
+
+// ToConfigUpdateMap builds a update request body from UpdateOpts.
+func (opts UpdateOpts) ToConfigUpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Update accepts a UpdateOpts struct and uses the values to update a parameter group.
+func Update(client *golangsdk.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToConfigUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(resourceURL(client, id), b, nil, &golangsdk.RequestOpts{
+		OkCodes:     []int{200},
+		MoreHeaders: requestOpts.MoreHeaders,
+	})
+	return
+}
+
+// Get retrieves a particular parameter group based on its unique ID.
+func Get(client *golangsdk.ServiceClient, id string) (r GetResult) {
+	_, r.Err = client.Get(resourceURL(client, id), &r.Body, &requestOpts)
+	return
+}
+
+// GetForInstance retrieves the parameters applied to the instance node or group.
+// For the replica set and single node instances entityID is the instance ID.
+func GetForInstance(client *golangsdk.ServiceClient, instanceID, entityID string) (r GetResult) {
+	_, r.Err = client.Get(instanceConfigURL(client, instanceID)+"?entity_id="+entityID, &r.Body, &requestOpts)
+	return
+}
+
+// Delete will permanently delete a particular parameter group based on its unique ID.
+func Delete(client *golangsdk.ServiceClient, id string) (r DeleteResult) {
+	_, r.Err = client.Delete(resourceURL(client, id), &golangsdk.RequestOpts{
+		OkCodes:     []int{200},
+		MoreHeaders: requestOpts.MoreHeaders,
+	})
+	return
+}
+
+// List is used to obtain the parameter group list, including default
+// parameter groups and those created by users.
+func List(client *golangsdk.ServiceClient) (r ListResult) {
+	_, r.Err = client.Get(rootURL(client), &r.Body, &requestOpts)
+	return
+}
+
+// ApplyOptsBuilder allows extensions to add additional parameters to the
+// Apply request.
+type ApplyOptsBuilder interface {
+	ToConfigApplyMap() (map[string]interface{}, error)
+}
+
+// ApplyOpts contains the entities to apply the parameter group to.
+type ApplyOpts struct {
+	// IDs of the instances, groups or nodes, depending on the parameter group type
+	EntityIDs []string `json:"entity_ids" required:"true"`
+}
+
+// ToConfigApplyMap builds an apply request body from ApplyOpts.
+func (opts ApplyOpts) ToConfigApplyMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Apply is used to apply a parameter group to one or more instances, groups or nodes.
+func Apply(client *golangsdk.ServiceClient, id string, opts ApplyOptsBuilder) (r ApplyResult) {
+	b, err := opts.ToConfigApplyMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(applyURL(client, id), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes:     []int{200, 202},
+		MoreHeaders: requestOpts.MoreHeaders,
+	})
+	return
+}
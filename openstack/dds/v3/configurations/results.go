@@ -0,0 +1,118 @@
+package configurations
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+type ConfigurationCreate struct {
+	// Parameter group ID
+	ID string `json:"id"`
+	// Parameter group name
+	Name string `json:"name"`
+}
+
+type Configuration struct {
+	// Parameter group ID
+	ID string `json:"id"`
+	// Parameter group name
+	Name string `json:"name"`
+	// Database version
+	DatastoreVersion string `json:"datastore_version"`
+	// Database type
+	DatastoreName string `json:"datastore_name"`
+	// Parameter group description
+	Description string `json:"description"`
+	// Whether the parameter group is created by the user
+	UserDefined bool `json:"user_defined"`
+	// Indicates the creation time in the following format: yyyy-MM-ddTHH:mm:ssZ.
+	Created string `json:"created"`
+	// Indicates the update time in the following format: yyyy-MM-ddTHH:mm:ssZ.
+	Updated string `json:"updated"`
+	// Parameters, returned only by Get and GetForInstance
+	Parameters []Parameter `json:"parameters"`
+}
+
+type Parameter struct {
+	// Parameter name
+	Name string `json:"name"`
+	// Parameter value
+	Value string `json:"value"`
+	// Whether a restart is required
+	RestartRequired bool `json:"restart_required"`
+	// Whether the parameter is read-only
+	ReadOnly bool `json:"readonly"`
+	// Parameter value range
+	ValueRange string `json:"value_range"`
+	// Parameter type
+	Type string `json:"type"`
+	// Parameter description
+	Description string `json:"description"`
+}
+
+type ApplyResponse struct {
+	// ID of the job applying the parameter group
+	JobID string `json:"job_id"`
+	// Whether the parameter group is applied successfully
+	Success bool `json:"success"`
+}
+
+// CreateResult represents the result of a create operation. Call its Extract
+// method to interpret it as a ConfigurationCreate.
+type CreateResult struct {
+	golangsdk.Result
+}
+
+// Extract is a function that accepts a result and extracts a parameter group.
+func (r CreateResult) Extract() (*ConfigurationCreate, error) {
+	var response ConfigurationCreate
+	err := r.ExtractIntoStructPtr(&response, "configuration")
+	return &response, err
+}
+
+// UpdateResult represents the result of a update operation.
+type UpdateResult struct {
+	golangsdk.ErrResult
+}
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as a Configuration.
+type GetResult struct {
+	golangsdk.Result
+}
+
+// Extract is a function that accepts a result and extracts a parameter group.
+func (r GetResult) Extract() (*Configuration, error) {
+	var response Configuration
+	err := r.ExtractInto(&response)
+	return &response, err
+}
+
+// DeleteResult represents the result of a delete operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	golangsdk.ErrResult
+}
+
+// ListResult represents the result of a list operation.
+type ListResult struct {
+	golangsdk.Result
+}
+
+// Extract is a function that accepts a result and extracts the list of parameter groups.
+func (r ListResult) Extract() ([]Configuration, error) {
+	var s []Configuration
+	err := r.ExtractIntoSlicePtr(&s, "configurations")
+	return s, err
+}
+
+// ApplyResult represents the result of an apply operation.
+type ApplyResult struct {
+	golangsdk.Result
+}
+
+// Extract is a function that accepts a result and extracts the apply response.
+func (r ApplyResult) Extract() (*ApplyResponse, error) {
+	var response ApplyResponse
+	err := r.ExtractInto(&response)
+	return &response, err
+}
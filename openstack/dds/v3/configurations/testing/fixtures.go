@@ -0,0 +1,144 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const (
+	configID   = "887ea0d1bb0843c49e8d8e5a09a95652pr02"
+	instanceID = "9136fd2a9fcd405ea4674276ce36dae8in02"
+)
+
+const expectedCreateRequest = `
+{
+  "name": "param-test",
+  "description": "test group",
+  "values": {
+    "net.maxIncomingConnections": "500"
+  },
+  "datastore": {
+    "type": "replica",
+    "version": "3.4"
+  }
+}`
+
+var createResponse = fmt.Sprintf(`
+{
+  "configuration": {
+    "id": "%s",
+    "name": "param-test"
+  }
+}`, configID)
+
+var getResponse = fmt.Sprintf(`
+{
+  "id": "%s",
+  "name": "param-test",
+  "datastore_version": "3.4",
+  "datastore_name": "replica",
+  "description": "test group",
+  "created": "2019-10-09T13:39:20+0000",
+  "updated": "2019-10-09T13:39:20+0000",
+  "parameters": [
+    {
+      "name": "net.maxIncomingConnections",
+      "value": "500",
+      "restart_required": true,
+      "readonly": false,
+      "value_range": "200-1000",
+      "type": "integer",
+      "description": "The maximum number of simultaneous connections"
+    }
+  ]
+}`, configID)
+
+var listResponse = fmt.Sprintf(`
+{
+  "quota": 100,
+  "configurations": [
+    {
+      "id": "%s",
+      "name": "param-test",
+      "datastore_version": "3.4",
+      "datastore_name": "replica",
+      "user_defined": true,
+      "created": "2019-10-09T13:39:20+0000",
+      "updated": "2019-10-09T13:39:20+0000"
+    }
+  ]
+}`, configID)
+
+// HandleRootSuccessfully creates an HTTP handler at `/configurations` on the test handler mux that
+// responds to POST and GET requests.
+func HandleRootSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/configurations", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+		switch r.Method {
+		case "POST":
+			th.TestJSONRequest(t, r, expectedCreateRequest)
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprint(w, createResponse)
+		case "GET":
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprint(w, listResponse)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+}
+
+// HandleResourceSuccessfully creates an HTTP handler at `/configurations/{config_id}` on the test
+// handler mux that responds to GET, PUT and DELETE requests.
+func HandleResourceSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/configurations/%s", configID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		switch r.Method {
+		case "GET":
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprint(w, getResponse)
+		case "PUT":
+			th.TestJSONRequest(t, r, `{"values": {"net.maxIncomingConnections": "600"}}`)
+			w.WriteHeader(http.StatusOK)
+		case "DELETE":
+			w.WriteHeader(http.StatusOK)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+}
+
+// HandleApplySuccessfully creates an HTTP handler at `/configurations/{config_id}/apply` on the
+// test handler mux that responds to a PUT request.
+func HandleApplySuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/configurations/%s/apply", configID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, fmt.Sprintf(`{"entity_ids": ["%s"]}`, instanceID))
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, `{"job_id": "3711e2ad-5787-49bc-a47f-3f0b066af9f5", "success": true}`)
+	})
+}
+
+// HandleGetForInstanceSuccessfully creates an HTTP handler at
+// `/instances/{instance_id}/configurations` on the test handler mux that responds to a GET request
+// with getResponse.
+func HandleGetForInstanceSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/instances/%s/configurations", instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"entity_id": instanceID})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, getResponse)
+	})
+}
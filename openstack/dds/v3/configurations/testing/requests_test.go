@@ -0,0 +1,79 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dds/v3/configurations"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleRootSuccessfully(t)
+
+	opts := configurations.CreateOpts{
+		Name:        "param-test",
+		Description: "test group",
+		Values:      map[string]string{"net.maxIncomingConnections": "500"},
+		DataStore: configurations.DataStore{
+			Type:    "replica",
+			Version: "3.4",
+		},
+	}
+	config, err := configurations.Create(fake.ServiceClient(), opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, configID, config.ID)
+}
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleRootSuccessfully(t)
+
+	configs, err := configurations.List(fake.ServiceClient()).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(configs))
+	th.AssertEquals(t, true, configs[0].UserDefined)
+}
+
+func TestGetUpdateDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleResourceSuccessfully(t)
+
+	config, err := configurations.Get(fake.ServiceClient(), configID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "param-test", config.Name)
+	th.AssertEquals(t, true, config.Parameters[0].RestartRequired)
+
+	err = configurations.Update(fake.ServiceClient(), configID, configurations.UpdateOpts{
+		Values: map[string]string{"net.maxIncomingConnections": "600"},
+	}).ExtractErr()
+	th.AssertNoErr(t, err)
+
+	th.AssertNoErr(t, configurations.Delete(fake.ServiceClient(), configID).ExtractErr())
+}
+
+func TestApply(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleApplySuccessfully(t)
+
+	result, err := configurations.Apply(fake.ServiceClient(), configID, configurations.ApplyOpts{
+		EntityIDs: []string{instanceID},
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, result.Success)
+}
+
+func TestGetForInstance(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetForInstanceSuccessfully(t)
+
+	config, err := configurations.GetForInstance(fake.ServiceClient(), instanceID, instanceID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "500", config.Parameters[0].Value)
+}
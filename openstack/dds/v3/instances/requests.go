@@ -7,6 +7,13 @@ import (
 	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
 )
 
+// Supported instance types.
+const (
+	ModeSharding   = "Sharding"
+	ModeReplicaSet = "ReplicaSet"
+	ModeSingle     = "Single"
+)
+
 type CreateOpts struct {
 	Name             string         `json:"name" required:"true"`
 	DataStore        DataStore      `json:"datastore" required:"true"`
@@ -21,6 +28,22 @@ type CreateOpts struct {
 	Flavor           []Flavor       `json:"flavor" required:"true"`
 	BackupStrategy   BackupStrategy `json:"backup_strategy" required:"true"`
 	Ssl              string         `json:"ssl_option,omitempty"`
+	Port             string         `json:"port,omitempty"`
+	// Restores backup or point in time data to the created instance
+	RestorePoint *RestorePoint `json:"restore_point,omitempty"`
+	// ID of the enterprise project the instance is created in
+	EnterpriseProjectID string `json:"enterprise_project_id,omitempty"`
+}
+
+type RestorePoint struct {
+	// ID of the instance the backup or the point in time belongs to
+	InstanceId string `json:"instance_id" required:"true"`
+	// Restoration mode: `backup` or `timestamp`
+	Type string `json:"type,omitempty"`
+	// ID of the backup to restore, used when Type is `backup`
+	BackupId string `json:"backup_id,omitempty"`
+	// Point in time to restore to, UNIX timestamp in milliseconds, used when Type is `timestamp`
+	RestoreTime int64 `json:"restore_time,omitempty"`
 }
 
 type DataStore struct {
@@ -30,6 +53,8 @@ type DataStore struct {
 }
 
 type Flavor struct {
+	// Node type: `mongos`, `shard` and `config` for the cluster instance,
+	// `replica` for the replica set instance and `single` for the single node instance.
 	Type     string `json:"type" required:"true"`
 	Num      int    `json:"num" required:"true"`
 	Storage  string `json:"storage,omitempty"`
@@ -167,3 +192,144 @@ func Update(client *golangsdk.ServiceClient, instanceId string, opts []UpdateOpt
 	}
 	return
 }
+
+type RestartOpts struct {
+	// Type of the object to restart: `mongos`, `shard` or `config` for the cluster instance.
+	// The whole instance is restarted when empty.
+	TargetType string `json:"target_type,omitempty"`
+	// ID of the instance, node or group to restart
+	TargetId string `json:"target_id" required:"true"`
+}
+
+type RestartOptsBuilder interface {
+	ToRestartMap() (map[string]interface{}, error)
+}
+
+func (opts RestartOpts) ToRestartMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Restart restarts the instance or one of its nodes or groups.
+func Restart(client *golangsdk.ServiceClient, instanceId string, opts RestartOptsBuilder) (r JobResult) {
+	b, err := opts.ToRestartMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(modifyURL(client, instanceId, "restart"), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return
+}
+
+// GetConnectionStatistics returns the number of connections to the instance grouped by client address.
+func GetConnectionStatistics(client *golangsdk.ServiceClient, instanceId string) (r ConnectionStatisticsResult) {
+	_, r.Err = client.Get(modifyURL(client, instanceId, "conn-statistics"), &r.Body, &golangsdk.RequestOpts{
+		MoreHeaders: map[string]string{"Content-Type": "application/json"},
+	})
+	return
+}
+
+type EnlargeNodesOpts struct {
+	// Type of the nodes to add: `mongos` or `shard`
+	Type string `json:"type" required:"true"`
+	// Resource specification code of the new nodes
+	SpecCode string `json:"spec_code" required:"true"`
+	// Number of nodes to add
+	Num int `json:"num" required:"true"`
+	// Storage of the new shard nodes, must not be set for mongos
+	Volume *EnlargeVolume `json:"volume,omitempty"`
+}
+
+type EnlargeVolume struct {
+	// Storage size in GB
+	Size string `json:"size" required:"true"`
+}
+
+type EnlargeNodesOptsBuilder interface {
+	ToEnlargeNodesMap() (map[string]interface{}, error)
+}
+
+func (opts EnlargeNodesOpts) ToEnlargeNodesMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// EnlargeNodes adds shards or mongos nodes to the cluster instance.
+func EnlargeNodes(client *golangsdk.ServiceClient, instanceId string, opts EnlargeNodesOptsBuilder) (r JobResult) {
+	b, err := opts.ToEnlargeNodesMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(modifyURL(client, instanceId, "enlarge"), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return
+}
+
+type EnlargeStorageOpts struct {
+	Volume *EnlargeStorageVolume `json:"volume" required:"true"`
+}
+
+type EnlargeStorageVolume struct {
+	// ID of the shard group, required for the cluster instance
+	GroupId string `json:"group_id,omitempty"`
+	// New storage size in GB
+	Size string `json:"size" required:"true"`
+}
+
+type EnlargeStorageOptsBuilder interface {
+	ToEnlargeStorageMap() (map[string]interface{}, error)
+}
+
+func (opts EnlargeStorageOpts) ToEnlargeStorageMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// EnlargeStorage scales up the storage of the instance or of one of the cluster shards.
+func EnlargeStorage(client *golangsdk.ServiceClient, instanceId string, opts EnlargeStorageOptsBuilder) (r JobResult) {
+	b, err := opts.ToEnlargeStorageMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(modifyURL(client, instanceId, "enlarge-volume"), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return
+}
+
+type ResizeOpts struct {
+	Resize *ResizeTarget `json:"resize" required:"true"`
+}
+
+type ResizeTarget struct {
+	// Type of the object to resize: `mongos` or `shard` for the cluster instance.
+	// Must not be set for the replica set and single node instances.
+	TargetType string `json:"target_type,omitempty"`
+	// ID of the node for `mongos`, of the group for `shard`, or of the instance
+	TargetId string `json:"target_id" required:"true"`
+	// New resource specification code
+	TargetSpecCode string `json:"target_spec_code" required:"true"`
+}
+
+type ResizeOptsBuilder interface {
+	ToResizeMap() (map[string]interface{}, error)
+}
+
+func (opts ResizeOpts) ToResizeMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Resize changes the flavor of the instance or of one of its nodes or groups.
+func Resize(client *golangsdk.ServiceClient, instanceId string, opts ResizeOptsBuilder) (r JobResult) {
+	b, err := opts.ToResizeMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(modifyURL(client, instanceId, "resize"), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return
+}
@@ -101,6 +101,8 @@ type InstanceResponse struct {
 	TimeZone          string         `json:"time_zone"`
 	Actions           []string       `json:"actions"`
 	PayMode           string         `json:"pay_mode"`
+
+	EnterpriseProjectID string `json:"enterprise_project_id"`
 }
 
 type Group struct {
@@ -145,3 +147,41 @@ func ExtractInstances(r pagination.Page) (ListInstanceResponse, error) {
 	err := (r.(InstancePage)).ExtractInto(&s)
 	return s, err
 }
+
+// JobResult is the result of an operation which is performed asynchronously.
+type JobResult struct {
+	commonResult
+}
+
+type JobResponse struct {
+	JobId string `json:"job_id"`
+}
+
+func (r JobResult) Extract() (*JobResponse, error) {
+	var response JobResponse
+	err := r.ExtractInto(&response)
+	return &response, err
+}
+
+type ConnectionStatisticsResult struct {
+	commonResult
+}
+
+type ConnectionStatistics struct {
+	TotalConnections      int                `json:"total_connections"`
+	TotalInnerConnections int                `json:"total_inner_connections"`
+	TotalOuterConnections int                `json:"total_outer_connections"`
+	InnerConnections      []ClientConnection `json:"inner_connections"`
+	OuterConnections      []ClientConnection `json:"outer_connections"`
+}
+
+type ClientConnection struct {
+	ClientIP string `json:"client_ip"`
+	Count    int    `json:"count"`
+}
+
+func (r ConnectionStatisticsResult) Extract() (*ConnectionStatistics, error) {
+	var response ConnectionStatistics
+	err := r.ExtractInto(&response)
+	return &response, err
+}
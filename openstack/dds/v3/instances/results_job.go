@@ -0,0 +1,59 @@
+package instances
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+type JobStatus struct {
+	Job Job `json:"job"`
+}
+
+type Job struct {
+	Id         string `json:"id"`
+	Name       string `json:"name"`
+	Status     string `json:"status"`
+	Progress   string `json:"progress"`
+	Instance   Target `json:"instance"`
+	Created    string `json:"created"`
+	Ended      string `json:"ended"`
+	FailReason string `json:"fail_reason"`
+}
+
+type Target struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// GetJob returns the status of the asynchronous job.
+func GetJob(client *golangsdk.ServiceClient, jobId string) (*JobStatus, error) {
+	job := new(JobStatus)
+	_, err := client.Get(jobURL(client)+"?id="+jobId, job, &golangsdk.RequestOpts{
+		MoreHeaders: map[string]string{"Content-Type": "application/json"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// WaitForJobCompleted waits until the job reaches `Completed` status or fails.
+func WaitForJobCompleted(client *golangsdk.ServiceClient, secs int, jobId string) error {
+	return golangsdk.WaitFor(secs, func() (bool, error) {
+		job, err := GetJob(client, jobId)
+		if err != nil {
+			return false, err
+		}
+
+		switch job.Job.Status {
+		case "Completed":
+			return true, nil
+		case "Failed":
+			return false, fmt.Errorf("job %s failed: %s", jobId, job.Job.FailReason)
+		}
+		time.Sleep(10 * time.Second)
+		return false, nil
+	})
+}
@@ -0,0 +1,255 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const (
+	instanceID = "9136fd2a9fcd405ea4674276ce36dae8in02"
+	jobID      = "3711e2ad-5787-49bc-a47f-3f0b066af9f5"
+)
+
+const expectedCreateRequest = `
+{
+  "name": "dds-test",
+  "datastore": {
+    "type": "DDS-Community",
+    "version": "3.4",
+    "storage_engine": "wiredTiger"
+  },
+  "region": "eu-de",
+  "availability_zone": "eu-de-01",
+  "vpc_id": "674e9b42-cd8d-4d25-a2e6-5abcc565b961",
+  "subnet_id": "f1df08c5-71d1-406a-aff0-de435a51007b",
+  "security_group_id": "7aa51dbf-5b63-40db-9724-dad3c4828b58",
+  "password": "Test@123",
+  "mode": "ReplicaSet",
+  "flavor": [
+    {
+      "type": "replica",
+      "num": 1,
+      "storage": "ULTRAHIGH",
+      "size": 20,
+      "spec_code": "dds.mongodb.s2.medium.4.repset"
+    }
+  ],
+  "backup_strategy": {
+    "start_time": "08:15-09:15"
+  }
+}`
+
+var createResponse = fmt.Sprintf(`
+{
+  "id": "%s",
+  "name": "dds-test",
+  "datastore": {
+    "type": "DDS-Community",
+    "version": "3.4",
+    "storage_engine": "wiredTiger"
+  },
+  "created": "2019-01-16 09:34:36",
+  "status": "creating",
+  "region": "eu-de",
+  "availability_zone": "eu-de-01",
+  "vpc_id": "674e9b42-cd8d-4d25-a2e6-5abcc565b961",
+  "subnet_id": "f1df08c5-71d1-406a-aff0-de435a51007b",
+  "security_group_id": "7aa51dbf-5b63-40db-9724-dad3c4828b58",
+  "mode": "ReplicaSet",
+  "flavor": [
+    {
+      "type": "replica",
+      "num": "1",
+      "storage": "ULTRAHIGH",
+      "size": "20",
+      "spec_code": "dds.mongodb.s2.medium.4.repset"
+    }
+  ],
+  "backup_strategy": {
+    "start_time": "08:15-09:15",
+    "keep_days": "7"
+  },
+  "ssl_option": "1",
+  "job_id": "%s"
+}`, instanceID, jobID)
+
+var listResponse = fmt.Sprintf(`
+{
+  "instances": [
+    {
+      "id": "%s",
+      "name": "dds-test",
+      "status": "normal",
+      "port": "8635",
+      "mode": "ReplicaSet",
+      "region": "eu-de",
+      "datastore": {
+        "type": "DDS-Community",
+        "version": "3.4"
+      },
+      "engine": "wiredTiger",
+      "db_user_name": "rwuser",
+      "ssl": 1,
+      "groups": [
+        {
+          "type": "replica",
+          "volume": {
+            "size": "20",
+            "used": "0.33"
+          },
+          "nodes": [
+            {
+              "id": "933d9e1e3e0a4dd7b5b1b0ac5e3f2fbeno02",
+              "name": "dds-test_replica_node_1",
+              "status": "normal",
+              "role": "Primary",
+              "private_ip": "192.168.0.174",
+              "spec_code": "dds.mongodb.s2.medium.4.repset",
+              "availability_zone": "eu-de-01"
+            }
+          ]
+        }
+      ]
+    }
+  ],
+  "total_count": 1
+}`, instanceID)
+
+var jobResponse = fmt.Sprintf(`{"job_id": "%s"}`, jobID)
+
+var jobStatusResponse = fmt.Sprintf(`
+{
+  "job": {
+    "id": "%s",
+    "name": "Restart_DDS_Instance",
+    "status": "Completed",
+    "progress": "100%%",
+    "instance": {
+      "id": "%s",
+      "name": "dds-test"
+    },
+    "created": "2019-01-16T09:34:36+0000",
+    "ended": "2019-01-16T09:40:36+0000",
+    "fail_reason": ""
+  }
+}`, jobID, instanceID)
+
+const connectionStatisticsResponse = `
+{
+  "total_connections": 12,
+  "total_inner_connections": 10,
+  "total_outer_connections": 2,
+  "inner_connections": [
+    {
+      "client_ip": "192.168.0.12",
+      "count": 10
+    }
+  ],
+  "outer_connections": [
+    {
+      "client_ip": "80.158.1.1",
+      "count": 2
+    }
+  ]
+}`
+
+// HandleCreateSuccessfully creates an HTTP handler at `/instances` on the test handler mux that
+// responds to a POST request with createResponse.
+func HandleCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/instances", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, expectedCreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = fmt.Fprint(w, createResponse)
+	})
+}
+
+// HandleListSuccessfully creates an HTTP handler at `/instances` on the test handler mux that
+// responds to a GET request with listResponse.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/instances", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"id": instanceID})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, listResponse)
+	})
+}
+
+// HandleDeleteSuccessfully creates an HTTP handler at `/instances/{instance_id}` on the test
+// handler mux that responds to a DELETE request with jobResponse.
+func HandleDeleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/instances/%s", instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = fmt.Fprint(w, jobResponse)
+	})
+}
+
+// HandleRestartSuccessfully creates an HTTP handler at `/instances/{instance_id}/restart` on the
+// test handler mux that responds to a POST request with jobResponse.
+func HandleRestartSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/instances/%s/restart", instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, fmt.Sprintf(`{"target_id": "%s"}`, instanceID))
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = fmt.Fprint(w, jobResponse)
+	})
+}
+
+// HandleGetJobSuccessfully creates an HTTP handler at `/jobs` on the test handler mux that responds
+// to a GET request with jobStatusResponse.
+func HandleGetJobSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/jobs", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"id": jobID})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, jobStatusResponse)
+	})
+}
+
+// HandleConnectionStatisticsSuccessfully creates an HTTP handler at
+// `/instances/{instance_id}/conn-statistics` on the test handler mux that responds to a GET request
+// with connectionStatisticsResponse.
+func HandleConnectionStatisticsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/instances/%s/conn-statistics", instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, connectionStatisticsResponse)
+	})
+}
+
+// HandleJobActionSuccessfully creates an HTTP handler at `/instances/{instance_id}/{action}` on the
+// test handler mux that responds to a POST request with jobResponse.
+func HandleJobActionSuccessfully(t *testing.T, action, expectedRequest string) {
+	th.Mux.HandleFunc(fmt.Sprintf("/instances/%s/%s", instanceID, action), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, expectedRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = fmt.Fprint(w, jobResponse)
+	})
+}
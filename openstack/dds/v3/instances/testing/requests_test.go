@@ -0,0 +1,157 @@
+package testing
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dds/v3/instances"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSuccessfully(t)
+
+	opts := instances.CreateOpts{
+		Name: "dds-test",
+		DataStore: instances.DataStore{
+			Type:          "DDS-Community",
+			Version:       "3.4",
+			StorageEngine: "wiredTiger",
+		},
+		Region:           "eu-de",
+		AvailabilityZone: "eu-de-01",
+		VpcId:            "674e9b42-cd8d-4d25-a2e6-5abcc565b961",
+		SubnetId:         "f1df08c5-71d1-406a-aff0-de435a51007b",
+		SecurityGroupId:  "7aa51dbf-5b63-40db-9724-dad3c4828b58",
+		Password:         "Test@123",
+		Mode:             instances.ModeReplicaSet,
+		Flavor: []instances.Flavor{
+			{
+				Type:     "replica",
+				Num:      1,
+				Storage:  "ULTRAHIGH",
+				Size:     20,
+				SpecCode: "dds.mongodb.s2.medium.4.repset",
+			},
+		},
+		BackupStrategy: instances.BackupStrategy{StartTime: "08:15-09:15"},
+	}
+	instance, err := instances.Create(fake.ServiceClient(), opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, instanceID, instance.Id)
+	th.AssertEquals(t, jobID, instance.JobId)
+	th.AssertEquals(t, "20", instance.Flavor[0].Size)
+}
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	pages, err := instances.List(fake.ServiceClient(), instances.ListInstanceOpts{Id: instanceID}).AllPages()
+	th.AssertNoErr(t, err)
+	list, err := instances.ExtractInstances(pages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, list.TotalCount)
+	th.AssertEquals(t, 8635, list.Instances[0].Port)
+	th.AssertEquals(t, "192.168.0.174", list.Instances[0].Groups[0].Nodes[0].PrivateIP)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteSuccessfully(t)
+
+	job, err := instances.Delete(fake.ServiceClient(), instanceID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, jobID, job.JobId)
+}
+
+func TestRestart(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleRestartSuccessfully(t)
+	HandleGetJobSuccessfully(t)
+
+	job, err := instances.Restart(fake.ServiceClient(), instanceID, instances.RestartOpts{TargetId: instanceID}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, jobID, job.JobId)
+	th.AssertNoErr(t, instances.WaitForJobCompleted(fake.ServiceClient(), 10, job.JobId))
+}
+
+func TestGetConnectionStatistics(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleConnectionStatisticsSuccessfully(t)
+
+	stats, err := instances.GetConnectionStatistics(fake.ServiceClient(), instanceID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 12, stats.TotalConnections)
+	th.AssertEquals(t, "80.158.1.1", stats.OuterConnections[0].ClientIP)
+}
+
+func TestEnlargeNodes(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleJobActionSuccessfully(t, "enlarge", `
+{
+  "type": "shard",
+  "spec_code": "dds.mongodb.s2.medium.4.shard",
+  "num": 1,
+  "volume": {
+    "size": "10"
+  }
+}`)
+	HandleGetJobSuccessfully(t)
+
+	opts := instances.EnlargeNodesOpts{
+		Type:     "shard",
+		SpecCode: "dds.mongodb.s2.medium.4.shard",
+		Num:      1,
+		Volume:   &instances.EnlargeVolume{Size: "10"},
+	}
+	job, err := instances.EnlargeNodes(fake.ServiceClient(), instanceID, opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertNoErr(t, instances.WaitForJobCompleted(fake.ServiceClient(), 10, job.JobId))
+}
+
+func TestEnlargeStorage(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleJobActionSuccessfully(t, "enlarge-volume", `{"volume": {"group_id": "8a0f2aa8a9e247cda2c4b6a1a3ea6d41gr02", "size": "20"}}`)
+
+	opts := instances.EnlargeStorageOpts{
+		Volume: &instances.EnlargeStorageVolume{
+			GroupId: "8a0f2aa8a9e247cda2c4b6a1a3ea6d41gr02",
+			Size:    "20",
+		},
+	}
+	job, err := instances.EnlargeStorage(fake.ServiceClient(), instanceID, opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, jobID, job.JobId)
+}
+
+func TestResize(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleJobActionSuccessfully(t, "resize", fmt.Sprintf(`
+{
+  "resize": {
+    "target_id": "%s",
+    "target_spec_code": "dds.mongodb.s2.large.4.repset"
+  }
+}`, instanceID))
+
+	opts := instances.ResizeOpts{
+		Resize: &instances.ResizeTarget{
+			TargetId:       instanceID,
+			TargetSpecCode: "dds.mongodb.s2.large.4.repset",
+		},
+	}
+	job, err := instances.Resize(fake.ServiceClient(), instanceID, opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, jobID, job.JobId)
+}
@@ -17,3 +17,7 @@ func listURL(c *golangsdk.ServiceClient) string {
 func modifyURL(c *golangsdk.ServiceClient, serverID, action string) string {
 	return c.ServiceURL("instances", serverID, action)
 }
+
+func jobURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("jobs")
+}
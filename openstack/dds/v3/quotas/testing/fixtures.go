@@ -0,0 +1,42 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const getResponse = `
+{
+  "quotas": {
+    "resources": [
+      {
+        "type": "instance",
+        "mode": "Cluster",
+        "quota": 200,
+        "used": 2
+      },
+      {
+        "type": "volume",
+        "quota": 20000,
+        "used": 120
+      }
+    ]
+  }
+}`
+
+// HandleGetSuccessfully creates an HTTP handler at `/quotas` on the test handler mux that responds
+// to a GET request with getResponse.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/quotas", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, getResponse)
+	})
+}
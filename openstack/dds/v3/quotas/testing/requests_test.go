@@ -0,0 +1,23 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dds/v3/quotas"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	resources, err := quotas.Get(fake.ServiceClient()).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, len(resources))
+	th.AssertEquals(t, "instance", resources[0].Type)
+	th.AssertEquals(t, 2, resources[0].Used)
+	th.AssertEquals(t, 200, resources[0].Quota)
+	th.AssertEquals(t, "Cluster", resources[0].Mode)
+}
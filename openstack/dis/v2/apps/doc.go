@@ -0,0 +1,11 @@
+/*
+Package apps manages the consumer apps of the Data Ingestion Service (DIS).
+
+Example to Create a Consumer App
+
+	err := apps.Create(disClient, "my-consumer").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package apps
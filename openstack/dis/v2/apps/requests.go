@@ -0,0 +1,46 @@
+package apps
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// Create creates a consumer app, checkpoints are stored per app.
+func Create(client *golangsdk.ServiceClient, name string) (r CreateResult) {
+	b := map[string]interface{}{"app_name": name}
+	_, r.Err = client.Post(rootURL(client), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+// Get retrieves the details of a consumer app.
+func Get(client *golangsdk.ServiceClient, name string) (r GetResult) {
+	_, r.Err = client.Get(resourceURL(client, name), &r.Body, nil)
+	return
+}
+
+// ListOpts allows to page through the consumer apps.
+type ListOpts struct {
+	Limit        int    `q:"limit"`
+	StartAppName string `q:"start_app_name"`
+	StreamName   string `q:"stream_name"`
+}
+
+// List retrieves the consumer apps of the project.
+func List(client *golangsdk.ServiceClient, opts ListOpts) (r ListResult) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Get(rootURL(client)+q.String(), &r.Body, nil)
+	return
+}
+
+// Delete deletes a consumer app.
+func Delete(client *golangsdk.ServiceClient, name string) (r DeleteResult) {
+	_, r.Err = client.Delete(resourceURL(client, name), &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
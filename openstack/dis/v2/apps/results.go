@@ -0,0 +1,55 @@
+package apps
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// App represents a consumer app.
+type App struct {
+	Name       string `json:"app_name"`
+	ID         string `json:"app_id"`
+	CreateTime int64  `json:"create_time"`
+}
+
+// CreateResult is the result of a Create request.
+// Call its ExtractErr method to determine if the request succeeded or failed.
+type CreateResult struct {
+	golangsdk.ErrResult
+}
+
+// DeleteResult is the result of a Delete request.
+// Call its ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	golangsdk.ErrResult
+}
+
+// GetResult is the result of a Get request.
+type GetResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as an App.
+func (r GetResult) Extract() (*App, error) {
+	var s App
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// AppList is a page of consumer apps.
+type AppList struct {
+	HasMoreApp  bool  `json:"has_more_app"`
+	Apps        []App `json:"apps"`
+	TotalNumber int   `json:"total_number"`
+}
+
+// ListResult is the result of a List request.
+type ListResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as an AppList.
+func (r ListResult) Extract() (*AppList, error) {
+	var s AppList
+	err := r.ExtractInto(&s)
+	return &s, err
+}
@@ -0,0 +1,13 @@
+package apps
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+const rootPath = "apps"
+
+func rootURL(client *golangsdk.ServiceClient) string {
+	return client.ServiceURL(rootPath)
+}
+
+func resourceURL(client *golangsdk.ServiceClient, name string) string {
+	return client.ServiceURL(rootPath, name)
+}
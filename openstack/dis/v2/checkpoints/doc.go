@@ -0,0 +1,37 @@
+/*
+Package checkpoints stores the read progress of Data Ingestion Service (DIS) consumer apps.
+
+Example to Resume Reading After the Last Checkpoint
+
+	checkpoint, err := checkpoints.Get(disClient, checkpoints.GetOpts{
+		AppName:     "my-consumer",
+		StreamName:  "my-stream",
+		PartitionID: "shardId-0000000000",
+	}).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	cursorOpts := records.CursorOpts{
+		StreamName:  "my-stream",
+		PartitionID: "shardId-0000000000",
+		CursorType:  records.CursorTrimHorizon,
+	}
+	if checkpoint.SequenceNumber != "-1" {
+		cursorOpts.CursorType = records.CursorAfterSequenceNumber
+		cursorOpts.StartingSequenceNumber = checkpoint.SequenceNumber
+	}
+
+Example to Commit a Checkpoint
+
+	err := checkpoints.Commit(disClient, checkpoints.CommitOpts{
+		AppName:        "my-consumer",
+		StreamName:     "my-stream",
+		PartitionID:    "shardId-0000000000",
+		SequenceNumber: lastRecord.SequenceNumber,
+	}).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package checkpoints
@@ -0,0 +1,95 @@
+package checkpoints
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// TypeLastRead is the only supported checkpoint type.
+const TypeLastRead = "LAST_READ"
+
+// CommitOptsBuilder allows extensions to add additional parameters to the Commit request.
+type CommitOptsBuilder interface {
+	ToCheckpointCommitMap() (map[string]interface{}, error)
+}
+
+// CommitOpts contains the options to commit a checkpoint.
+type CommitOpts struct {
+	// AppName is the consumer app the checkpoint belongs to.
+	AppName        string `json:"app_name" required:"true"`
+	StreamName     string `json:"stream_name" required:"true"`
+	PartitionID    string `json:"partition_id" required:"true"`
+	SequenceNumber string `json:"sequence_number" required:"true"`
+	// CheckpointType is TypeLastRead if it's empty.
+	CheckpointType string `json:"checkpoint_type,omitempty"`
+	Metadata       string `json:"metadata,omitempty"`
+}
+
+// ToCheckpointCommitMap builds a request body from CommitOpts.
+func (opts CommitOpts) ToCheckpointCommitMap() (map[string]interface{}, error) {
+	if opts.CheckpointType == "" {
+		opts.CheckpointType = TypeLastRead
+	}
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Commit stores the sequence number the consumer app has processed up to.
+func Commit(client *golangsdk.ServiceClient, opts CommitOptsBuilder) (r CommitResult) {
+	b, err := opts.ToCheckpointCommitMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(rootURL(client), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+// GetOpts contains the options to query a checkpoint.
+type GetOpts struct {
+	AppName     string `q:"app_name,required"`
+	StreamName  string `q:"stream_name,required"`
+	PartitionID string `q:"partition_id,required"`
+	// CheckpointType is TypeLastRead if it's empty.
+	CheckpointType string `q:"checkpoint_type"`
+}
+
+// Get retrieves the checkpoint of a partition.
+func Get(client *golangsdk.ServiceClient, opts GetOpts) (r GetResult) {
+	if opts.CheckpointType == "" {
+		opts.CheckpointType = TypeLastRead
+	}
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Get(rootURL(client)+q.String(), &r.Body, nil)
+	return
+}
+
+// DeleteOpts contains the options to delete checkpoints.
+type DeleteOpts struct {
+	AppName    string `q:"app_name,required"`
+	StreamName string `q:"stream_name,required"`
+	// PartitionID deletes the checkpoint of a single partition, all partitions are affected if it's empty.
+	PartitionID string `q:"partition_id"`
+	// CheckpointType is TypeLastRead if it's empty.
+	CheckpointType string `q:"checkpoint_type"`
+}
+
+// Delete deletes the checkpoints of a consumer app.
+func Delete(client *golangsdk.ServiceClient, opts DeleteOpts) (r DeleteResult) {
+	if opts.CheckpointType == "" {
+		opts.CheckpointType = TypeLastRead
+	}
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Delete(rootURL(client)+q.String(), &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
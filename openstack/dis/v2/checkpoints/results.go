@@ -0,0 +1,36 @@
+package checkpoints
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// Checkpoint is the position a consumer app has processed a partition up to.
+type Checkpoint struct {
+	// SequenceNumber is "-1" if no checkpoint was committed.
+	SequenceNumber string `json:"sequence_number"`
+	Metadata       string `json:"metadata"`
+}
+
+// CommitResult is the result of a Commit request.
+// Call its ExtractErr method to determine if the request succeeded or failed.
+type CommitResult struct {
+	golangsdk.ErrResult
+}
+
+// DeleteResult is the result of a Delete request.
+// Call its ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	golangsdk.ErrResult
+}
+
+// GetResult is the result of a Get request.
+type GetResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as a Checkpoint.
+func (r GetResult) Extract() (*Checkpoint, error) {
+	var s Checkpoint
+	err := r.ExtractInto(&s)
+	return &s, err
+}
@@ -0,0 +1,7 @@
+package checkpoints
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+func rootURL(client *golangsdk.ServiceClient) string {
+	return client.ServiceURL("checkpoints")
+}
@@ -0,0 +1,44 @@
+/*
+Package records writes records to and reads records from Data Ingestion Service (DIS) streams.
+
+Example to Write Records
+
+	result, err := records.Put(disClient, records.PutOpts{
+		StreamName: "my-stream",
+		Records: []records.Record{
+			{Data: []byte(`{"event":"click"}`), PartitionKey: "user-1"},
+		},
+	}).Extract()
+	if err != nil {
+		panic(err)
+	}
+	if result.FailedRecordCount > 0 {
+		// retry the records having an ErrorCode
+	}
+
+Example to Read a Partition From the Beginning
+
+	cursor, err := records.GetCursor(disClient, records.CursorOpts{
+		StreamName:  "my-stream",
+		PartitionID: "shardId-0000000000",
+		CursorType:  records.CursorTrimHorizon,
+	}).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	for cursor != "" {
+		page, err := records.Get(disClient, records.GetOpts{PartitionCursor: cursor}).Extract()
+		if err != nil {
+			panic(err)
+		}
+		if len(page.Records) == 0 {
+			break
+		}
+		for _, record := range page.Records {
+			fmt.Println(string(record.Data))
+		}
+		cursor = page.NextPartitionCursor
+	}
+*/
+package records
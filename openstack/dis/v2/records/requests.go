@@ -0,0 +1,123 @@
+package records
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// Cursor types.
+const (
+	// CursorAtSequenceNumber starts reading at the given sequence number.
+	CursorAtSequenceNumber = "AT_SEQUENCE_NUMBER"
+	// CursorAfterSequenceNumber starts reading after the given sequence number.
+	CursorAfterSequenceNumber = "AFTER_SEQUENCE_NUMBER"
+	// CursorTrimHorizon starts reading at the oldest record available.
+	CursorTrimHorizon = "TRIM_HORIZON"
+	// CursorLatest starts reading at the records written after the cursor is created.
+	CursorLatest = "LATEST"
+	// CursorAtTimestamp starts reading at the given timestamp.
+	CursorAtTimestamp = "AT_TIMESTAMP"
+)
+
+// Record is a record written to a stream.
+type Record struct {
+	// Data is the record payload, it's base64 encoded on the wire.
+	Data []byte `json:"data" required:"true"`
+	// PartitionKey is hashed to select the partition the record is written to.
+	PartitionKey string `json:"partition_key,omitempty"`
+	// PartitionID writes the record to the given partition.
+	PartitionID string `json:"partition_id,omitempty"`
+	// ExplicitHashKey overrides the hash of PartitionKey.
+	ExplicitHashKey string `json:"explicit_hash_key,omitempty"`
+	// Timestamp of the record in milliseconds.
+	Timestamp int64 `json:"timestamp,omitempty"`
+}
+
+// PutOptsBuilder allows extensions to add additional parameters to the Put request.
+type PutOptsBuilder interface {
+	ToRecordsPutMap() (map[string]interface{}, error)
+}
+
+// PutOpts contains the records to write to a stream.
+type PutOpts struct {
+	StreamName string   `json:"stream_name" required:"true"`
+	StreamID   string   `json:"stream_id,omitempty"`
+	Records    []Record `json:"records" required:"true"`
+}
+
+// ToRecordsPutMap builds a request body from PutOpts.
+func (opts PutOpts) ToRecordsPutMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Put writes records to a stream. Records may be rejected individually,
+// check FailedRecordCount of the result and retry the failed ones.
+func Put(client *golangsdk.ServiceClient, opts PutOptsBuilder) (r PutResult) {
+	b, err := opts.ToRecordsPutMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(recordsURL(client), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// CursorOptsBuilder allows extensions to add additional parameters to the GetCursor request.
+type CursorOptsBuilder interface {
+	ToCursorQuery() (string, error)
+}
+
+// CursorOpts contains the options to obtain a partition cursor.
+type CursorOpts struct {
+	StreamName  string `q:"stream-name,required"`
+	PartitionID string `q:"partition-id,required"`
+	// CursorType is one of the Cursor* constants.
+	CursorType string `q:"cursor-type"`
+	// StartingSequenceNumber is used with CursorAtSequenceNumber and CursorAfterSequenceNumber.
+	StartingSequenceNumber string `q:"starting-sequence-number"`
+	// Timestamp in milliseconds is used with CursorAtTimestamp.
+	Timestamp string `q:"timestamp"`
+	StreamID  string `q:"stream-id"`
+}
+
+// ToCursorQuery formats a CursorOpts into a query string.
+func (opts CursorOpts) ToCursorQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), nil
+}
+
+// GetCursor obtains a cursor to read records of a partition.
+// A cursor expires 5 minutes after it's obtained.
+func GetCursor(client *golangsdk.ServiceClient, opts CursorOptsBuilder) (r CursorResult) {
+	query, err := opts.ToCursorQuery()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Get(cursorsURL(client)+query, &r.Body, nil)
+	return
+}
+
+// GetOpts contains the options to read records.
+type GetOpts struct {
+	// PartitionCursor is the cursor obtained with GetCursor or returned by the previous Get.
+	PartitionCursor string `q:"partition-cursor,required"`
+	// MaxFetchBytes limits the size of the returned records.
+	MaxFetchBytes int `q:"max_fetch_bytes"`
+}
+
+// Get reads the records at the cursor position.
+// Continue reading with the NextPartitionCursor of the result.
+func Get(client *golangsdk.ServiceClient, opts GetOpts) (r GetResult) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Get(recordsURL(client)+q.String(), &r.Body, nil)
+	return
+}
@@ -0,0 +1,76 @@
+package records
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// PutRecord is the outcome of writing a single record.
+type PutRecord struct {
+	PartitionID    string `json:"partition_id"`
+	SequenceNumber string `json:"sequence_number"`
+	// ErrorCode and ErrorMessage are set if the record was rejected.
+	ErrorCode    string `json:"error_code"`
+	ErrorMessage string `json:"error_message"`
+}
+
+// PutResponse is the response of a Put request.
+type PutResponse struct {
+	FailedRecordCount int `json:"failed_record_count"`
+	// Records are in the order of the written records.
+	Records []PutRecord `json:"records"`
+}
+
+// PutResult is the result of a Put request.
+type PutResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as a PutResponse.
+func (r PutResult) Extract() (*PutResponse, error) {
+	var s PutResponse
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// CursorResult is the result of a GetCursor request.
+type CursorResult struct {
+	golangsdk.Result
+}
+
+// Extract returns the partition cursor.
+func (r CursorResult) Extract() (string, error) {
+	var s struct {
+		PartitionCursor string `json:"partition_cursor"`
+	}
+	err := r.ExtractInto(&s)
+	return s.PartitionCursor, err
+}
+
+// StoredRecord is a record read from a stream.
+type StoredRecord struct {
+	PartitionKey   string `json:"partition_key"`
+	SequenceNumber string `json:"sequence_number"`
+	// Data is the record payload.
+	Data []byte `json:"data"`
+	// Timestamp of the record in milliseconds.
+	Timestamp     int64  `json:"timestamp"`
+	TimestampType string `json:"timestamp_type"`
+}
+
+// GetResponse is the response of a Get request.
+type GetResponse struct {
+	Records             []StoredRecord `json:"records"`
+	NextPartitionCursor string         `json:"next_partition_cursor"`
+}
+
+// GetResult is the result of a Get request.
+type GetResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as a GetResponse.
+func (r GetResult) Extract() (*GetResponse, error) {
+	var s GetResponse
+	err := r.ExtractInto(&s)
+	return &s, err
+}
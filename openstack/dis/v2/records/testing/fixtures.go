@@ -0,0 +1,35 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const partitionCursor = "eyJnZXRJdGVyYXRvclBhcmFtIjp7InN0cmVhbS1uYW1lIjoiZGlzLXRlc3QifX0"
+
+var cursorResponse = fmt.Sprintf(`
+{
+  "partition_cursor": "%s"
+}`, partitionCursor)
+
+// HandleGetCursorSuccessfully creates an HTTP handler at `/cursors` on the
+// test handler mux that responds with a partition cursor.
+func HandleGetCursorSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/cursors", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"stream-name":  "dis-test",
+			"partition-id": "shardId-0000000000",
+			"cursor-type":  "TRIM_HORIZON",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, cursorResponse)
+	})
+}
@@ -0,0 +1,35 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dis/v2/records"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestGetCursor(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetCursorSuccessfully(t)
+
+	cursor, err := records.GetCursor(fake.ServiceClient(), records.CursorOpts{
+		StreamName:  "dis-test",
+		PartitionID: "shardId-0000000000",
+		CursorType:  records.CursorTrimHorizon,
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, partitionCursor, cursor)
+}
+
+func TestCursorOptsRequired(t *testing.T) {
+	_, err := records.CursorOpts{StreamName: "dis-test"}.ToCursorQuery()
+	if err == nil {
+		t.Fatal("expected an error for the missing partition ID")
+	}
+
+	err = records.Get(fake.ServiceClient(), records.GetOpts{}).Err
+	if err == nil {
+		t.Fatal("expected an error for the missing partition cursor")
+	}
+}
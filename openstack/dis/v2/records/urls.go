@@ -0,0 +1,11 @@
+package records
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+func recordsURL(client *golangsdk.ServiceClient) string {
+	return client.ServiceURL("records")
+}
+
+func cursorsURL(client *golangsdk.ServiceClient) string {
+	return client.ServiceURL("cursors")
+}
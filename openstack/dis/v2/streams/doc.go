@@ -0,0 +1,23 @@
+/*
+Package streams manages Data Ingestion Service (DIS) streams.
+
+Example to Create a Stream
+
+	err := streams.Create(disClient, streams.CreateOpts{
+		Name:            "my-stream",
+		PartitionCount:  2,
+		DataType:        streams.DataTypeJSON,
+		RetentionPeriod: 72,
+	}).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Scale the Partitions of a Stream
+
+	err := streams.ScalePartitions(disClient, "my-stream", 4).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package streams
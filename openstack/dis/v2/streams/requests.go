@@ -0,0 +1,150 @@
+package streams
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/tags"
+)
+
+// Stream types.
+const (
+	TypeCommon   = "COMMON"
+	TypeAdvanced = "ADVANCED"
+)
+
+// Data types.
+const (
+	DataTypeBlob = "BLOB"
+	DataTypeJSON = "JSON"
+	DataTypeCSV  = "CSV"
+)
+
+// CreateOptsBuilder allows extensions to add additional parameters to the Create request.
+type CreateOptsBuilder interface {
+	ToStreamCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts contains the options to create a stream.
+type CreateOpts struct {
+	Name string `json:"stream_name" required:"true"`
+	// PartitionCount is the number of partitions, each partition has a fixed throughput.
+	PartitionCount int `json:"partition_count" required:"true"`
+	// Type is either TypeCommon (1 MB/s per partition) or TypeAdvanced (5 MB/s per partition).
+	Type string `json:"stream_type,omitempty"`
+	// DataType is one of the DataType* constants.
+	DataType string `json:"data_type,omitempty"`
+	// RetentionPeriod is the data retention in hours, 24 to 168.
+	RetentionPeriod int `json:"data_duration,omitempty"`
+	// AutoScaleEnabled enables automatic partition scaling between the min and max partition count.
+	AutoScaleEnabled       *bool              `json:"auto_scale_enabled,omitempty"`
+	AutoScaleMinPartitions int                `json:"auto_scale_min_partition_count,omitempty"`
+	AutoScaleMaxPartitions int                `json:"auto_scale_max_partition_count,omitempty"`
+	CompressionFormat      string             `json:"compression_format,omitempty"`
+	Tags                   []tags.ResourceTag `json:"tags,omitempty"`
+}
+
+// ToStreamCreateMap builds a create request body from CreateOpts.
+func (opts CreateOpts) ToStreamCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Create creates a stream.
+func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToStreamCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(rootURL(client), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+// GetOpts allows to page through the partitions of a stream.
+type GetOpts struct {
+	StartPartitionID string `q:"start_partitionId"`
+	LimitPartitions  int    `q:"limit_partitions"`
+}
+
+// Get retrieves the details of a stream.
+func Get(client *golangsdk.ServiceClient, name string, opts GetOpts) (r GetResult) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Get(resourceURL(client, name)+q.String(), &r.Body, nil)
+	return
+}
+
+// ListOpts allows to page through the streams.
+type ListOpts struct {
+	Limit int `q:"limit"`
+	// StartStreamName lists the streams after the given one.
+	StartStreamName string `q:"start_stream_name"`
+}
+
+// List retrieves the streams of the project.
+func List(client *golangsdk.ServiceClient, opts ListOpts) (r ListResult) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Get(rootURL(client)+q.String(), &r.Body, nil)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the Update request.
+type UpdateOptsBuilder interface {
+	ToStreamUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts contains the options to update the retention and data type of a stream.
+type UpdateOpts struct {
+	RetentionPeriod        int    `json:"data_duration,omitempty"`
+	DataType               string `json:"data_type,omitempty"`
+	AutoScaleEnabled       *bool  `json:"auto_scale_enabled,omitempty"`
+	AutoScaleMinPartitions int    `json:"auto_scale_min_partition_count,omitempty"`
+	AutoScaleMaxPartitions int    `json:"auto_scale_max_partition_count,omitempty"`
+}
+
+// ToStreamUpdateMap builds an update request body from UpdateOpts.
+func (opts UpdateOpts) ToStreamUpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Update updates a stream.
+func Update(client *golangsdk.ServiceClient, name string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToStreamUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	b["stream_name"] = name
+	_, r.Err = client.Put(resourceURL(client, name), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201, 204},
+	})
+	return
+}
+
+// ScalePartitions changes the number of writable partitions of a stream.
+// Scaling in keeps the removed partitions readable until their data expires.
+func ScalePartitions(client *golangsdk.ServiceClient, name string, count int) (r UpdateResult) {
+	b := map[string]interface{}{
+		"stream_name":            name,
+		"target_partition_count": count,
+	}
+	_, r.Err = client.Put(resourceURL(client, name), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return
+}
+
+// Delete deletes a stream.
+func Delete(client *golangsdk.ServiceClient, name string) (r DeleteResult) {
+	_, r.Err = client.Delete(resourceURL(client, name), &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
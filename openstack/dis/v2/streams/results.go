@@ -0,0 +1,87 @@
+package streams
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/tags"
+)
+
+// Partition is a partition of a stream.
+type Partition struct {
+	ID     string `json:"partition_id"`
+	Status string `json:"status"`
+	// HashRange is the range of the partition key hashes assigned to the partition.
+	HashRange string `json:"hash_range"`
+	// SequenceNumberRange is the range of the sequence numbers available for reading.
+	SequenceNumberRange string `json:"sequence_number_range"`
+	ParentPartitions    string `json:"parent_partitions"`
+}
+
+// Stream represents a DIS stream.
+type Stream struct {
+	Name                   string             `json:"stream_name"`
+	ID                     string             `json:"stream_id"`
+	CreateTime             int64              `json:"create_time"`
+	LastModifiedTime       int64              `json:"last_modified_time"`
+	RetentionPeriod        int                `json:"retention_period"`
+	Status                 string             `json:"status"`
+	Type                   string             `json:"stream_type"`
+	DataType               string             `json:"data_type"`
+	WritablePartitionCount int                `json:"writable_partition_count"`
+	ReadablePartitionCount int                `json:"readable_partition_count"`
+	Partitions             []Partition        `json:"partitions"`
+	HasMorePartitions      bool               `json:"has_more_partitions"`
+	AutoScaleEnabled       bool               `json:"auto_scale_enabled"`
+	AutoScaleMinPartitions int                `json:"auto_scale_min_partition_count"`
+	AutoScaleMaxPartitions int                `json:"auto_scale_max_partition_count"`
+	Tags                   []tags.ResourceTag `json:"tags"`
+}
+
+// CreateResult is the result of a Create request.
+// Call its ExtractErr method to determine if the request succeeded or failed.
+type CreateResult struct {
+	golangsdk.ErrResult
+}
+
+// UpdateResult is the result of Update and ScalePartitions requests.
+// Call its ExtractErr method to determine if the request succeeded or failed.
+type UpdateResult struct {
+	golangsdk.ErrResult
+}
+
+// DeleteResult is the result of a Delete request.
+// Call its ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	golangsdk.ErrResult
+}
+
+// GetResult is the result of a Get request.
+type GetResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as a Stream.
+func (r GetResult) Extract() (*Stream, error) {
+	var s Stream
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// StreamList is a page of streams.
+type StreamList struct {
+	TotalNumber    int      `json:"total_number"`
+	StreamNames    []string `json:"stream_names"`
+	HasMoreStreams bool     `json:"has_more_streams"`
+	Streams        []Stream `json:"stream_info_list"`
+}
+
+// ListResult is the result of a List request.
+type ListResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as a StreamList.
+func (r ListResult) Extract() (*StreamList, error) {
+	var s StreamList
+	err := r.ExtractInto(&s)
+	return &s, err
+}
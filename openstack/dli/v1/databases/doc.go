@@ -0,0 +1,20 @@
+/*
+Package databases manages Data Lake Insight (DLI) databases.
+
+Example to Create a Database
+
+	err := databases.Create(dliClient, databases.CreateOpts{
+		Name: "my_db",
+	}).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Database With Its Tables
+
+	err := databases.Delete(dliClient, "my_db", databases.DeleteOpts{Cascade: true}).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package databases
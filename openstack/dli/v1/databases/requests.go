@@ -0,0 +1,79 @@
+package databases
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// CreateOptsBuilder allows extensions to add additional parameters to the Create request.
+type CreateOptsBuilder interface {
+	ToDatabaseCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts contains the options to create a database.
+type CreateOpts struct {
+	// Name of the database, "default" is reserved.
+	Name                string              `json:"database_name" required:"true"`
+	Description         string              `json:"description,omitempty"`
+	EnterpriseProjectID string              `json:"enterprise_project_id,omitempty"`
+	Tags                []map[string]string `json:"tags,omitempty"`
+}
+
+// ToDatabaseCreateMap builds a create request body from CreateOpts.
+func (opts CreateOpts) ToDatabaseCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Create creates a database.
+func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r ActionResult) {
+	b, err := opts.ToDatabaseCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(rootURL(client), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// ListOpts allows to filter the listed databases.
+type ListOpts struct {
+	// Keyword filters the databases by name.
+	Keyword string `q:"keyword"`
+	// WithPriv includes the permissions of the databases.
+	WithPriv bool `q:"with-priv"`
+	Offset   int  `q:"offset"`
+	Limit    int  `q:"limit"`
+}
+
+// List retrieves the databases of the project.
+func List(client *golangsdk.ServiceClient, opts ListOpts) (r ListResult) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Get(rootURL(client)+q.String(), &r.Body, nil)
+	return
+}
+
+// DeleteOpts contains the options to delete a database.
+type DeleteOpts struct {
+	// Cascade deletes the tables of the database as well, deletion of non-empty databases fails otherwise.
+	Cascade bool `q:"cascade"`
+	// Async deletes the database asynchronously, the returned job ID can be polled with sqljobs.
+	Async bool `q:"async"`
+}
+
+// Delete deletes a database.
+func Delete(client *golangsdk.ServiceClient, name string, opts DeleteOpts) (r ActionResult) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.DeleteWithResponse(resourceURL(client, name)+q.String(), &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
@@ -0,0 +1,61 @@
+package databases
+
+import (
+	"fmt"
+
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// Database represents a DLI database.
+type Database struct {
+	Name                string `json:"database_name"`
+	Owner               string `json:"owner"`
+	TableNumber         int    `json:"table_number"`
+	Description         string `json:"description"`
+	EnterpriseProjectID string `json:"enterprise_project_id"`
+	ResourceID          string `json:"resource_id"`
+}
+
+// Response is the generic response of the DLI operations.
+type Response struct {
+	IsSuccess bool   `json:"is_success"`
+	Message   string `json:"message"`
+	// JobID is the ID of the asynchronous job, if any.
+	JobID string `json:"job_id"`
+}
+
+// ListResult is the result of a List request.
+type ListResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as a slice of Database.
+func (r ListResult) Extract() ([]Database, error) {
+	var s []Database
+	err := r.ExtractIntoSlicePtr(&s, "databases")
+	return s, err
+}
+
+// ActionResult is the result of Create and Delete requests.
+type ActionResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as a Response.
+func (r ActionResult) Extract() (*Response, error) {
+	var s Response
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// ExtractErr is used to determine whether the request succeeded or failed.
+func (r ActionResult) ExtractErr() error {
+	s, err := r.Extract()
+	if err != nil {
+		return err
+	}
+	if !s.IsSuccess {
+		return fmt.Errorf("request failed: %s", s.Message)
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const expectedCreateRequest = `
+{
+  "database_name": "db1",
+  "description": "test database"
+}`
+
+const listResponse = `
+{
+  "is_success": true,
+  "message": "",
+  "database_count": 1,
+  "databases": [
+    {
+      "database_name": "db1",
+      "description": "test database",
+      "owner": "tenant1",
+      "table_number": 2
+    }
+  ]
+}`
+
+const successResponse = `
+{
+  "is_success": true,
+  "message": ""
+}`
+
+// HandleCreateSuccessfully creates an HTTP handler at `/databases` on the
+// test handler mux that tests database creation.
+func HandleCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/databases", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, expectedCreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, successResponse)
+	})
+}
+
+// HandleListSuccessfully creates an HTTP handler at `/databases` on the
+// test handler mux that responds with a single database.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/databases", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"keyword": "db", "limit": "10"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, listResponse)
+	})
+}
+
+// HandleDeleteSuccessfully creates an HTTP handler at `/databases/db1` on the
+// test handler mux that tests cascading database deletion.
+func HandleDeleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/databases/db1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"cascade": "true"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, successResponse)
+	})
+}
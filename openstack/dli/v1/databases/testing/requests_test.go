@@ -0,0 +1,38 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dli/v1/databases"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSuccessfully(t)
+
+	opts := databases.CreateOpts{Name: "db1", Description: "test database"}
+	th.AssertNoErr(t, databases.Create(fake.ServiceClient(), opts).ExtractErr())
+}
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	list, err := databases.List(fake.ServiceClient(), databases.ListOpts{Keyword: "db", Limit: 10}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(list))
+	th.AssertEquals(t, "db1", list[0].Name)
+	th.AssertEquals(t, 2, list[0].TableNumber)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteSuccessfully(t)
+
+	th.AssertNoErr(t, databases.Delete(fake.ServiceClient(), "db1", databases.DeleteOpts{Cascade: true}).ExtractErr())
+}
@@ -0,0 +1,29 @@
+/*
+Package queues manages Data Lake Insight (DLI) queues.
+
+Example to Create a Queue
+
+	name, err := queues.Create(dliClient, queues.CreateOpts{
+		Name:    "my_queue",
+		Type:    queues.TypeSQL,
+		CUCount: 16,
+	}).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Scale Out a Queue
+
+	err := queues.ScaleOut(dliClient, "my_queue", 16).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Queue
+
+	err := queues.Delete(dliClient, "my_queue").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package queues
@@ -0,0 +1,134 @@
+package queues
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// Queue types.
+const (
+	TypeSQL     = "sql"
+	TypeGeneral = "general"
+)
+
+// Queue actions.
+const (
+	ActionScaleOut = "scale_out"
+	ActionScaleIn  = "scale_in"
+	ActionRestart  = "restart"
+)
+
+// CreateOptsBuilder allows extensions to add additional parameters to the Create request.
+type CreateOptsBuilder interface {
+	ToQueueCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts contains the options to create a queue.
+type CreateOpts struct {
+	// Name of the queue, lowercase letters, digits and underscores only.
+	Name string `json:"queue_name" required:"true"`
+	// Type is either TypeSQL or TypeGeneral (Spark jobs).
+	Type        string `json:"queue_type,omitempty"`
+	Description string `json:"description,omitempty"`
+	// CUCount is the number of compute units, a multiple of 16.
+	CUCount int `json:"cu_count" required:"true"`
+	// Platform is either "x86_64" or "aarch64".
+	Platform            string `json:"platform,omitempty"`
+	EnterpriseProjectID string `json:"enterprise_project_id,omitempty"`
+	// Labels configure the queue, e.g. "multi_az=2".
+	Labels []string            `json:"labels,omitempty"`
+	Tags   []map[string]string `json:"tags,omitempty"`
+}
+
+// ToQueueCreateMap builds a create request body from CreateOpts.
+func (opts CreateOpts) ToQueueCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Create creates a queue.
+func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToQueueCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(rootURL(client), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Get retrieves details of a queue.
+func Get(client *golangsdk.ServiceClient, name string) (r GetResult) {
+	_, r.Err = client.Get(resourceURL(client, name), &r.Body, nil)
+	return
+}
+
+// ListOpts allows to filter the listed queues.
+type ListOpts struct {
+	// Type is TypeSQL, TypeGeneral or "all".
+	Type string `q:"queue_type"`
+	// WithPriv includes the permissions of the queues.
+	WithPriv bool `q:"with-priv"`
+}
+
+// List retrieves the queues of the project.
+func List(client *golangsdk.ServiceClient, opts ListOpts) (r ListResult) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Get(rootURL(client)+q.String(), &r.Body, nil)
+	return
+}
+
+// Delete deletes a queue.
+func Delete(client *golangsdk.ServiceClient, name string) (r ActionResult) {
+	_, r.Err = client.DeleteWithResponse(resourceURL(client, name), &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// ActionOptsBuilder allows extensions to add additional parameters to the Action request.
+type ActionOptsBuilder interface {
+	ToQueueActionMap() (map[string]interface{}, error)
+}
+
+// ActionOpts contains the options to scale or restart a queue.
+type ActionOpts struct {
+	// Action is one of the Action* constants.
+	Action string `json:"action" required:"true"`
+	// CUCount is the number of compute units to add or remove when scaling.
+	CUCount int `json:"cu_count,omitempty"`
+	// Force restarts the queue without waiting for the running jobs.
+	Force *bool `json:"force,omitempty"`
+}
+
+// ToQueueActionMap builds an action request body from ActionOpts.
+func (opts ActionOpts) ToQueueActionMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Action scales or restarts a queue.
+func Action(client *golangsdk.ServiceClient, name string, opts ActionOptsBuilder) (r ActionResult) {
+	b, err := opts.ToQueueActionMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(actionURL(client, name), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// ScaleOut adds compute units to a queue.
+func ScaleOut(client *golangsdk.ServiceClient, name string, cuCount int) (r ActionResult) {
+	return Action(client, name, ActionOpts{Action: ActionScaleOut, CUCount: cuCount})
+}
+
+// ScaleIn removes compute units from a queue.
+func ScaleIn(client *golangsdk.ServiceClient, name string, cuCount int) (r ActionResult) {
+	return Action(client, name, ActionOpts{Action: ActionScaleIn, CUCount: cuCount})
+}
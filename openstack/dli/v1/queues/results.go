@@ -0,0 +1,103 @@
+package queues
+
+import (
+	"fmt"
+
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// Queue represents a DLI queue.
+type Queue struct {
+	Name                string   `json:"queue_name"`
+	Description         string   `json:"description"`
+	Owner               string   `json:"owner"`
+	CreateTime          int64    `json:"create_time"`
+	Type                string   `json:"queue_type"`
+	CUCount             int      `json:"cu_count"`
+	ChargingMode        int      `json:"charging_mode"`
+	ResourceID          string   `json:"resource_id"`
+	ResourceMode        int      `json:"resource_mode"`
+	EnterpriseProjectID string   `json:"enterprise_project_id"`
+	Platform            string   `json:"platform"`
+	IsRestarting        bool     `json:"is_restarting"`
+	Labels              []string `json:"labels"`
+	CUSpec              int      `json:"cu_spec"`
+	CUScaleOutLimit     int      `json:"cu_scale_out_limit"`
+	CUScaleInLimit      int      `json:"cu_scale_in_limit"`
+}
+
+// Response is the generic response of the DLI operations.
+type Response struct {
+	IsSuccess bool   `json:"is_success"`
+	Message   string `json:"message"`
+	// JobID is the ID of the asynchronous job, if any.
+	JobID string `json:"job_id"`
+}
+
+// CreateResult is the result of a Create request.
+type CreateResult struct {
+	golangsdk.Result
+}
+
+// Extract returns the name of the created queue.
+func (r CreateResult) Extract() (string, error) {
+	var s struct {
+		Response
+		QueueName string `json:"queue_name"`
+	}
+	if err := r.ExtractInto(&s); err != nil {
+		return "", err
+	}
+	if !s.IsSuccess {
+		return "", fmt.Errorf("failed to create queue: %s", s.Message)
+	}
+	return s.QueueName, nil
+}
+
+// GetResult is the result of a Get request.
+type GetResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as a Queue.
+func (r GetResult) Extract() (*Queue, error) {
+	var s Queue
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// ListResult is the result of a List request.
+type ListResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as a slice of Queue.
+func (r ListResult) Extract() ([]Queue, error) {
+	var s []Queue
+	err := r.ExtractIntoSlicePtr(&s, "queues")
+	return s, err
+}
+
+// ActionResult is the result of Delete and Action requests.
+type ActionResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as a Response.
+func (r ActionResult) Extract() (*Response, error) {
+	var s Response
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// ExtractErr is used to determine whether the request succeeded or failed.
+func (r ActionResult) ExtractErr() error {
+	s, err := r.Extract()
+	if err != nil {
+		return err
+	}
+	if !s.IsSuccess {
+		return fmt.Errorf("request failed: %s", s.Message)
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const expectedCreateRequest = `
+{
+  "queue_name": "queue1",
+  "queue_type": "sql",
+  "cu_count": 16
+}`
+
+const createResponse = `
+{
+  "is_success": true,
+  "message": "",
+  "queue_name": "queue1"
+}`
+
+const getResponse = `
+{
+  "is_success": true,
+  "message": "",
+  "queue_name": "queue1",
+  "queue_type": "sql",
+  "owner": "tenant1",
+  "cu_count": 16,
+  "charging_mode": 1,
+  "resource_mode": 0
+}`
+
+const expectedScaleOutRequest = `
+{
+  "action": "scale_out",
+  "cu_count": 16
+}`
+
+const successResponse = `
+{
+  "is_success": true,
+  "message": ""
+}`
+
+// HandleCreateSuccessfully creates an HTTP handler at `/queues` on the
+// test handler mux that tests queue creation.
+func HandleCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/queues", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, expectedCreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, createResponse)
+	})
+}
+
+// HandleGetSuccessfully creates an HTTP handler at `/queues/queue1` on the
+// test handler mux that responds with a single queue.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/queues/queue1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, getResponse)
+	})
+}
+
+// HandleScaleOutSuccessfully creates an HTTP handler at `/queues/queue1/action` on the
+// test handler mux that tests scaling out a queue.
+func HandleScaleOutSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/queues/queue1/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, expectedScaleOutRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, successResponse)
+	})
+}
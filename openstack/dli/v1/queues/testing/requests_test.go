@@ -0,0 +1,42 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dli/v1/queues"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSuccessfully(t)
+
+	name, err := queues.Create(fake.ServiceClient(), queues.CreateOpts{
+		Name:    "queue1",
+		Type:    queues.TypeSQL,
+		CUCount: 16,
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "queue1", name)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	queue, err := queues.Get(fake.ServiceClient(), "queue1").Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "queue1", queue.Name)
+	th.AssertEquals(t, 16, queue.CUCount)
+}
+
+func TestScaleOut(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleScaleOutSuccessfully(t)
+
+	th.AssertNoErr(t, queues.ScaleOut(fake.ServiceClient(), "queue1", 16).ExtractErr())
+}
@@ -0,0 +1,17 @@
+package queues
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+const rootPath = "queues"
+
+func rootURL(client *golangsdk.ServiceClient) string {
+	return client.ServiceURL(rootPath)
+}
+
+func resourceURL(client *golangsdk.ServiceClient, name string) string {
+	return client.ServiceURL(rootPath, name)
+}
+
+func actionURL(client *golangsdk.ServiceClient, name string) string {
+	return client.ServiceURL(rootPath, name, "action")
+}
@@ -0,0 +1,21 @@
+/*
+Package sparkjobs submits Data Lake Insight (DLI) Spark jobs.
+
+Example to Submit a Spark Job and Wait for It
+
+	job, err := sparkjobs.Submit(dliClient, sparkjobs.SubmitOpts{
+		File:      "obs://my-bucket/jobs/etl.jar",
+		ClassName: "com.example.Etl",
+		QueueName: "my_general_queue",
+		Args:      []string{"--date", "2020-01-01"},
+	}).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	err = sparkjobs.WaitForJob(dliClient, job.ID, 3600)
+	if err != nil {
+		panic(err)
+	}
+*/
+package sparkjobs
@@ -0,0 +1,115 @@
+package sparkjobs
+
+import (
+	"fmt"
+
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// Job states.
+const (
+	StateStarting = "starting"
+	StateRunning  = "running"
+	StateDead     = "dead"
+	StateSuccess  = "success"
+	StateRecovery = "recovering"
+)
+
+// Resource is a package resource of a job.
+type Resource struct {
+	Name string `json:"name" required:"true"`
+	// Type is e.g. "jar", "pyFile", "file", "modelFile".
+	Type string `json:"type" required:"true"`
+}
+
+// SubmitOptsBuilder allows extensions to add additional parameters to the Submit request.
+type SubmitOptsBuilder interface {
+	ToJobSubmitMap() (map[string]interface{}, error)
+}
+
+// SubmitOpts contains the options to submit a Spark job.
+type SubmitOpts struct {
+	// File is the main package of the job, an OBS path or an uploaded package name.
+	File string `json:"file" required:"true"`
+	// ClassName is the main class of Java and Scala jobs.
+	ClassName string `json:"class_name,omitempty"`
+	// QueueName is the general queue executing the job.
+	QueueName   string   `json:"queue" required:"true"`
+	Name        string   `json:"name,omitempty"`
+	Args        []string `json:"args,omitempty"`
+	Jars        []string `json:"jars,omitempty"`
+	PythonFiles []string `json:"python_files,omitempty"`
+	Files       []string `json:"files,omitempty"`
+	// Modules are the DLI built-in dependencies, e.g. "sys.datasource.rds".
+	Modules   []string          `json:"modules,omitempty"`
+	Resources []Resource        `json:"resources,omitempty"`
+	Conf      map[string]string `json:"conf,omitempty"`
+	// ScType is the compute resource specification: "A", "B" or "C".
+	ScType         string `json:"sc_type,omitempty"`
+	DriverMemory   string `json:"driver_memory,omitempty"`
+	DriverCores    int    `json:"driver_cores,omitempty"`
+	ExecutorMemory string `json:"executor_memory,omitempty"`
+	ExecutorCores  int    `json:"executor_cores,omitempty"`
+	NumExecutors   int    `json:"num_executors,omitempty"`
+	SparkVersion   string `json:"spark_version,omitempty"`
+	// AutoRecovery restarts the job on failure.
+	AutoRecovery *bool `json:"auto_recovery,omitempty"`
+	// MaxRetryTimes is the maximum number of restarts if AutoRecovery is set.
+	MaxRetryTimes int `json:"max_retry_times,omitempty"`
+}
+
+// ToJobSubmitMap builds a submit request body from SubmitOpts.
+func (opts SubmitOpts) ToJobSubmitMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Submit submits a Spark job.
+func Submit(client *golangsdk.ServiceClient, opts SubmitOptsBuilder) (r SubmitResult) {
+	b, err := opts.ToJobSubmitMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(rootURL(client), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	return
+}
+
+// Get retrieves the details of a Spark job.
+func Get(client *golangsdk.ServiceClient, id string) (r GetResult) {
+	_, r.Err = client.Get(resourceURL(client, id), &r.Body, nil)
+	return
+}
+
+// GetState retrieves the state of a Spark job.
+func GetState(client *golangsdk.ServiceClient, id string) (r StateResult) {
+	_, r.Err = client.Get(stateURL(client, id), &r.Body, nil)
+	return
+}
+
+// Cancel cancels a Spark job.
+func Cancel(client *golangsdk.ServiceClient, id string) (r CancelResult) {
+	_, r.Err = client.DeleteWithResponse(resourceURL(client, id), &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// WaitForJob polls the job state until the job finishes.
+// An error is returned if the job died.
+func WaitForJob(client *golangsdk.ServiceClient, id string, secs int) error {
+	return golangsdk.WaitFor(secs, func() (bool, error) {
+		state, err := GetState(client, id).Extract()
+		if err != nil {
+			return false, err
+		}
+		switch state {
+		case StateSuccess:
+			return true, nil
+		case StateDead:
+			return false, fmt.Errorf("spark job %s is dead", id)
+		}
+		return false, nil
+	})
+}
@@ -0,0 +1,81 @@
+package sparkjobs
+
+import (
+	"fmt"
+
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// Job represents a Spark job.
+type Job struct {
+	ID          string   `json:"id"`
+	AppID       string   `json:"appId"`
+	Name        string   `json:"name"`
+	Owner       string   `json:"owner"`
+	Proxy       string   `json:"proxyUser"`
+	State       string   `json:"state"`
+	Kind        string   `json:"kind"`
+	Log         []string `json:"log"`
+	ScType      string   `json:"sc_type"`
+	ClusterName string   `json:"cluster_name"`
+	QueueName   string   `json:"queue"`
+	// CreateTime and UpdateTime are timestamps in milliseconds.
+	CreateTime int64 `json:"create_time"`
+	UpdateTime int64 `json:"update_time"`
+	Duration   int64 `json:"duration"`
+}
+
+type commonResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as a Job.
+func (r commonResult) Extract() (*Job, error) {
+	var s Job
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// SubmitResult is the result of a Submit request.
+type SubmitResult struct {
+	commonResult
+}
+
+// GetResult is the result of a Get request.
+type GetResult struct {
+	commonResult
+}
+
+// StateResult is the result of a GetState request.
+type StateResult struct {
+	golangsdk.Result
+}
+
+// Extract returns the state of the job.
+func (r StateResult) Extract() (string, error) {
+	var s struct {
+		State string `json:"state"`
+	}
+	err := r.ExtractInto(&s)
+	return s.State, err
+}
+
+// CancelResult is the result of a Cancel request.
+type CancelResult struct {
+	golangsdk.Result
+}
+
+// ExtractErr is used to determine whether the request succeeded or failed.
+func (r CancelResult) ExtractErr() error {
+	var s struct {
+		IsSuccess bool   `json:"is_success"`
+		Message   string `json:"message"`
+	}
+	if err := r.ExtractInto(&s); err != nil {
+		return err
+	}
+	if !s.IsSuccess {
+		return fmt.Errorf("failed to cancel spark job: %s", s.Message)
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const jobID = "07a3e4e6-9a28-4e92-8d3f-9c538621a166"
+
+const expectedSubmitRequest = `
+{
+  "file": "batchtest/spark-examples_2.11-2.1.0.luxor.jar",
+  "class_name": "org.apache.spark.examples.SparkPi",
+  "queue": "queue1",
+  "args": ["10"]
+}`
+
+var submitResponse = fmt.Sprintf(`
+{
+  "id": "%s",
+  "appId": "",
+  "name": "",
+  "owner": "tenant1",
+  "proxyUser": "",
+  "state": "starting",
+  "kind": "",
+  "log": [],
+  "sc_type": "CUSTOMIZED",
+  "cluster_name": "queue1",
+  "queue": "queue1",
+  "create_time": 1579426195000,
+  "update_time": 1579426195000
+}`, jobID)
+
+const stateResponse = `
+{
+  "id": "07a3e4e6-9a28-4e92-8d3f-9c538621a166",
+  "state": "success"
+}`
+
+// HandleSubmitSuccessfully creates an HTTP handler at `/batches` on the
+// test handler mux that tests Spark job submission.
+func HandleSubmitSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/batches", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, expectedSubmitRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, submitResponse)
+	})
+}
+
+// HandleGetStateSuccessfully creates an HTTP handler at `/batches/{id}/state` on the
+// test handler mux that responds with a succeeded job.
+func HandleGetStateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/batches/%s/state", jobID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, stateResponse)
+	})
+}
@@ -0,0 +1,33 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dli/v1/sparkjobs"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestSubmit(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleSubmitSuccessfully(t)
+
+	job, err := sparkjobs.Submit(fake.ServiceClient(), sparkjobs.SubmitOpts{
+		File:      "batchtest/spark-examples_2.11-2.1.0.luxor.jar",
+		ClassName: "org.apache.spark.examples.SparkPi",
+		QueueName: "queue1",
+		Args:      []string{"10"},
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, jobID, job.ID)
+	th.AssertEquals(t, sparkjobs.StateStarting, job.State)
+}
+
+func TestWaitForJob(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetStateSuccessfully(t)
+
+	th.AssertNoErr(t, sparkjobs.WaitForJob(fake.ServiceClient(), jobID, 10))
+}
@@ -0,0 +1,26 @@
+package sparkjobs
+
+import (
+	"strings"
+
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+const rootPath = "batches"
+
+// Spark jobs are managed with the v2.0 API of the service.
+func v2URL(client *golangsdk.ServiceClient, parts ...string) string {
+	return strings.Replace(client.ServiceURL(parts...), "/v1.0/", "/v2.0/", 1)
+}
+
+func rootURL(client *golangsdk.ServiceClient) string {
+	return v2URL(client, rootPath)
+}
+
+func resourceURL(client *golangsdk.ServiceClient, id string) string {
+	return v2URL(client, rootPath, id)
+}
+
+func stateURL(client *golangsdk.ServiceClient, id string) string {
+	return v2URL(client, rootPath, id, "state")
+}
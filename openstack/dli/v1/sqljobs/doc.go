@@ -0,0 +1,25 @@
+/*
+Package sqljobs submits Data Lake Insight (DLI) SQL jobs and retrieves their results.
+
+Example to Run a Query and Read Its Result
+
+	job, err := sqljobs.Submit(dliClient, sqljobs.SubmitOpts{
+		SQL:       "SELECT count(*) FROM events",
+		CurrentDB: "my_db",
+		QueueName: "my_queue",
+	}).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	_, err = sqljobs.WaitForJob(dliClient, job.JobID, 600)
+	if err != nil {
+		panic(err)
+	}
+
+	preview, err := sqljobs.Preview(dliClient, job.JobID, sqljobs.PreviewOpts{QueueName: "my_queue"}).Extract()
+	if err != nil {
+		panic(err)
+	}
+*/
+package sqljobs
@@ -0,0 +1,130 @@
+package sqljobs
+
+import (
+	"fmt"
+
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// Job statuses.
+const (
+	StatusLaunching = "LAUNCHING"
+	StatusRunning   = "RUNNING"
+	StatusFinished  = "FINISHED"
+	StatusFailed    = "FAILED"
+	StatusCancelled = "CANCELLED"
+)
+
+// SubmitOptsBuilder allows extensions to add additional parameters to the Submit request.
+type SubmitOptsBuilder interface {
+	ToJobSubmitMap() (map[string]interface{}, error)
+}
+
+// SubmitOpts contains the options to submit an SQL job.
+type SubmitOpts struct {
+	// SQL is the statement to execute.
+	SQL string `json:"sql" required:"true"`
+	// CurrentDB is the database the statement is executed in.
+	CurrentDB string `json:"currentdb,omitempty"`
+	// QueueName is the queue executing the job, the "default" queue is used if it's empty.
+	QueueName string `json:"queue_name,omitempty"`
+	// Conf are the job configuration entries in the "key=value" format, e.g. "dli.sql.shuffle.partitions=200".
+	Conf []string            `json:"conf,omitempty"`
+	Tags []map[string]string `json:"tags,omitempty"`
+}
+
+// ToJobSubmitMap builds a submit request body from SubmitOpts.
+func (opts SubmitOpts) ToJobSubmitMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Submit submits an SQL job. Statements like DDL return their result immediately,
+// queries are executed asynchronously and must be polled with GetStatus or WaitForJob.
+func Submit(client *golangsdk.ServiceClient, opts SubmitOptsBuilder) (r SubmitResult) {
+	b, err := opts.ToJobSubmitMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(submitURL(client), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// GetStatus retrieves the status of a job.
+func GetStatus(client *golangsdk.ServiceClient, id string) (r StatusResult) {
+	_, r.Err = client.Get(statusURL(client, id), &r.Body, nil)
+	return
+}
+
+// PreviewOpts contains the options of a Preview request.
+type PreviewOpts struct {
+	// QueueName is required if the job wasn't executed in the "default" queue.
+	QueueName string `q:"queue-name"`
+}
+
+// Preview retrieves the first 1000 rows of the result of a finished query job.
+func Preview(client *golangsdk.ServiceClient, id string, opts PreviewOpts) (r PreviewResult) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Get(previewURL(client, id)+q.String(), &r.Body, nil)
+	return
+}
+
+// ListOpts allows to filter the listed jobs.
+type ListOpts struct {
+	// JobType is e.g. "DDL", "DCL", "IMPORT", "EXPORT", "QUERY", "INSERT" or "ALL".
+	JobType   string `q:"job-type"`
+	QueueName string `q:"queue_name"`
+	// Start and End are timestamps in milliseconds.
+	Start       int `q:"start"`
+	End         int `q:"end"`
+	PageSize    int `q:"page-size"`
+	CurrentPage int `q:"current-page"`
+	// Order is e.g. "start_time_desc" or "duration_asc".
+	Order string `q:"order"`
+}
+
+// List retrieves the jobs of the project.
+func List(client *golangsdk.ServiceClient, opts ListOpts) (r ListResult) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Get(rootURL(client)+q.String(), &r.Body, nil)
+	return
+}
+
+// Cancel cancels a running job.
+func Cancel(client *golangsdk.ServiceClient, id string) (r CancelResult) {
+	_, r.Err = client.DeleteWithResponse(resourceURL(client, id), &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// WaitForJob polls the job status until the job finishes.
+// An error is returned if the job failed or was cancelled.
+func WaitForJob(client *golangsdk.ServiceClient, id string, secs int) (*JobStatus, error) {
+	var status *JobStatus
+	err := golangsdk.WaitFor(secs, func() (bool, error) {
+		current, err := GetStatus(client, id).Extract()
+		if err != nil {
+			return false, err
+		}
+		status = current
+		switch current.Status {
+		case StatusFinished:
+			return true, nil
+		case StatusFailed, StatusCancelled:
+			return false, fmt.Errorf("job %s finished with status %s: %s", id, current.Status, current.Message)
+		}
+		return false, nil
+	})
+	return status, err
+}
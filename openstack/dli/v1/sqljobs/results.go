@@ -0,0 +1,128 @@
+package sqljobs
+
+import (
+	"fmt"
+
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// Job is the response of a Submit request.
+type Job struct {
+	IsSuccess bool   `json:"is_success"`
+	Message   string `json:"message"`
+	JobID     string `json:"job_id"`
+	JobType   string `json:"job_type"`
+	// JobMode is either "sync" or "async".
+	JobMode string `json:"job_mode"`
+	// Schema and Rows contain the result of synchronous jobs.
+	Schema []map[string]string `json:"schema"`
+	Rows   [][]interface{}     `json:"rows"`
+}
+
+// SubmitResult is the result of a Submit request.
+type SubmitResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as a Job.
+func (r SubmitResult) Extract() (*Job, error) {
+	var s Job
+	if err := r.ExtractInto(&s); err != nil {
+		return nil, err
+	}
+	if !s.IsSuccess {
+		return &s, fmt.Errorf("failed to submit job: %s", s.Message)
+	}
+	return &s, nil
+}
+
+// JobStatus is the status of a job.
+type JobStatus struct {
+	IsSuccess bool   `json:"is_success"`
+	Message   string `json:"message"`
+	JobID     string `json:"job_id"`
+	JobType   string `json:"job_type"`
+	JobMode   string `json:"job_mode"`
+	QueueName string `json:"queue_name"`
+	Owner     string `json:"owner"`
+	// StartTime is a timestamp in milliseconds.
+	StartTime int64 `json:"start_time"`
+	// Duration is the execution duration in milliseconds.
+	Duration int64  `json:"duration"`
+	Status   string `json:"status"`
+	// InputRowCount, BadRowCount and InputSize are set for import jobs.
+	InputRowCount int64 `json:"input_row_count"`
+	BadRowCount   int64 `json:"bad_row_count"`
+	InputSize     int64 `json:"input_size"`
+	// ResultCount is the number of rows returned by a query.
+	ResultCount  int64  `json:"result_count"`
+	DatabaseName string `json:"database_name"`
+	TableName    string `json:"table_name"`
+	Statement    string `json:"statement"`
+	Detail       string `json:"detail"`
+}
+
+// StatusResult is the result of a GetStatus request.
+type StatusResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as a JobStatus.
+func (r StatusResult) Extract() (*JobStatus, error) {
+	var s JobStatus
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// JobResult contains the result rows of a query job.
+type JobResult struct {
+	JobID    string              `json:"job_id"`
+	JobType  string              `json:"job_type"`
+	RowCount int                 `json:"row_count"`
+	Schema   []map[string]string `json:"schema"`
+	Rows     [][]interface{}     `json:"rows"`
+}
+
+// PreviewResult is the result of a Preview request.
+type PreviewResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as a JobResult.
+func (r PreviewResult) Extract() (*JobResult, error) {
+	var s JobResult
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// ListResult is the result of a List request.
+type ListResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as a slice of JobStatus.
+func (r ListResult) Extract() ([]JobStatus, error) {
+	var s []JobStatus
+	err := r.ExtractIntoSlicePtr(&s, "jobs")
+	return s, err
+}
+
+// CancelResult is the result of a Cancel request.
+type CancelResult struct {
+	golangsdk.Result
+}
+
+// ExtractErr is used to determine whether the request succeeded or failed.
+func (r CancelResult) ExtractErr() error {
+	var s struct {
+		IsSuccess bool   `json:"is_success"`
+		Message   string `json:"message"`
+	}
+	if err := r.ExtractInto(&s); err != nil {
+		return err
+	}
+	if !s.IsSuccess {
+		return fmt.Errorf("failed to cancel job: %s", s.Message)
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const jobID = "8ecb0777-9c70-4529-9935-29ea0946039c"
+
+const expectedSubmitRequest = `
+{
+  "sql": "select * from tb1 limit 10",
+  "currentdb": "db1",
+  "queue_name": "queue1"
+}`
+
+var submitResponse = fmt.Sprintf(`
+{
+  "is_success": true,
+  "message": "",
+  "job_id": "%s",
+  "job_type": "QUERY",
+  "job_mode": "async"
+}`, jobID)
+
+var statusResponse = fmt.Sprintf(`
+{
+  "is_success": true,
+  "message": "",
+  "job_id": "%s",
+  "job_type": "QUERY",
+  "job_mode": "async",
+  "queue_name": "queue1",
+  "owner": "tenant1",
+  "start_time": 1579426195000,
+  "duration": 1220,
+  "status": "FINISHED",
+  "result_count": 10,
+  "database_name": "db1",
+  "statement": "select * from tb1 limit 10"
+}`, jobID)
+
+var listResponse = fmt.Sprintf(`
+{
+  "is_success": true,
+  "message": "",
+  "job_count": 1,
+  "jobs": [
+    {
+      "job_id": "%s",
+      "job_type": "QUERY",
+      "queue_name": "queue1",
+      "start_time": 1579426195000,
+      "status": "FINISHED"
+    }
+  ]
+}`, jobID)
+
+// HandleSubmitSuccessfully creates an HTTP handler at `/jobs/submit-job` on the
+// test handler mux that tests job submission.
+func HandleSubmitSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/jobs/submit-job", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, expectedSubmitRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, submitResponse)
+	})
+}
+
+// HandleGetStatusSuccessfully creates an HTTP handler at `/jobs/{id}/status` on the
+// test handler mux that responds with a finished job.
+func HandleGetStatusSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/jobs/%s/status", jobID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, statusResponse)
+	})
+}
+
+// HandleListSuccessfully creates an HTTP handler at `/jobs` on the
+// test handler mux that checks the time range filter and responds with a single job.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/jobs", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"job-type":   "QUERY",
+			"queue_name": "queue1",
+			"start":      "1579426195000",
+			"end":        "1579512595000",
+			"page-size":  "10",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, listResponse)
+	})
+}
@@ -0,0 +1,52 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dli/v1/sqljobs"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestSubmit(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleSubmitSuccessfully(t)
+
+	job, err := sqljobs.Submit(fake.ServiceClient(), sqljobs.SubmitOpts{
+		SQL:       "select * from tb1 limit 10",
+		CurrentDB: "db1",
+		QueueName: "queue1",
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, jobID, job.JobID)
+	th.AssertEquals(t, "async", job.JobMode)
+}
+
+func TestWaitForJob(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetStatusSuccessfully(t)
+
+	status, err := sqljobs.WaitForJob(fake.ServiceClient(), jobID, 10)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, sqljobs.StatusFinished, status.Status)
+	th.AssertEquals(t, int64(10), status.ResultCount)
+}
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	jobs, err := sqljobs.List(fake.ServiceClient(), sqljobs.ListOpts{
+		JobType:   "QUERY",
+		QueueName: "queue1",
+		Start:     1579426195000,
+		End:       1579512595000,
+		PageSize:  10,
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(jobs))
+	th.AssertEquals(t, jobID, jobs[0].JobID)
+}
@@ -0,0 +1,25 @@
+package sqljobs
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+const rootPath = "jobs"
+
+func rootURL(client *golangsdk.ServiceClient) string {
+	return client.ServiceURL(rootPath)
+}
+
+func submitURL(client *golangsdk.ServiceClient) string {
+	return client.ServiceURL(rootPath, "submit-job")
+}
+
+func resourceURL(client *golangsdk.ServiceClient, id string) string {
+	return client.ServiceURL(rootPath, id)
+}
+
+func statusURL(client *golangsdk.ServiceClient, id string) string {
+	return client.ServiceURL(rootPath, id, "status")
+}
+
+func previewURL(client *golangsdk.ServiceClient, id string) string {
+	return client.ServiceURL(rootPath, id, "preview")
+}
@@ -0,0 +1,20 @@
+/*
+Package tables manages the tables of Data Lake Insight (DLI) databases.
+
+Example to Create an OBS Table
+
+	err := tables.Create(dliClient, "my_db", tables.CreateOpts{
+		Name:         "events",
+		DataLocation: tables.LocationOBS,
+		DataType:     "csv",
+		DataPath:     "obs://my-bucket/events",
+		Columns: []tables.Column{
+			{Name: "id", Type: "bigint"},
+			{Name: "payload", Type: "string"},
+		},
+	}).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package tables
@@ -0,0 +1,100 @@
+package tables
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// Data locations.
+const (
+	LocationDLI = "DLI"
+	LocationOBS = "OBS"
+)
+
+// Column is a table column.
+type Column struct {
+	Name string `json:"column_name" required:"true"`
+	// Type is the column data type, e.g. "string", "int", "bigint", "double", "timestamp".
+	Type              string `json:"type" required:"true"`
+	Description       string `json:"description,omitempty"`
+	IsPartitionColumn bool   `json:"is_partition_column,omitempty"`
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the Create request.
+type CreateOptsBuilder interface {
+	ToTableCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts contains the options to create a table.
+type CreateOpts struct {
+	Name string `json:"table_name" required:"true"`
+	// DataLocation is either LocationDLI or LocationOBS.
+	DataLocation string   `json:"data_location" required:"true"`
+	Description  string   `json:"description,omitempty"`
+	Columns      []Column `json:"columns" required:"true"`
+	// DataType is the format of OBS tables, e.g. "csv", "parquet", "orc", "json", "avro".
+	DataType string `json:"data_type,omitempty"`
+	// DataPath is the OBS path of OBS tables, e.g. "obs://bucket/path".
+	DataPath string `json:"data_path,omitempty"`
+	// The following options apply to CSV OBS tables only.
+	WithColumnHeader *bool  `json:"with_column_header,omitempty"`
+	Delimiter        string `json:"delimiter,omitempty"`
+	QuoteChar        string `json:"quote_char,omitempty"`
+	EscapeChar       string `json:"escape_char,omitempty"`
+	DateFormat       string `json:"date_format,omitempty"`
+	TimestampFormat  string `json:"timestamp_format,omitempty"`
+}
+
+// ToTableCreateMap builds a create request body from CreateOpts.
+func (opts CreateOpts) ToTableCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Create creates a table in the database.
+func Create(client *golangsdk.ServiceClient, database string, opts CreateOptsBuilder) (r ActionResult) {
+	b, err := opts.ToTableCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(rootURL(client, database), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Get retrieves the details of a table.
+func Get(client *golangsdk.ServiceClient, database, name string) (r GetResult) {
+	_, r.Err = client.Get(resourceURL(client, database, name), &r.Body, nil)
+	return
+}
+
+// ListOpts allows to filter the listed tables.
+type ListOpts struct {
+	Keyword string `q:"keyword"`
+	// WithDetail includes the columns and storage details of the tables.
+	WithDetail bool `q:"with-detail"`
+	// TableType is "MANAGED_TABLE", "EXTERNAL_TABLE" or "VIRTUAL_VIEW".
+	TableType string `q:"table-type"`
+	PageSize  int    `q:"page-size"`
+	// CurrentPage is the page number, starting from 1.
+	CurrentPage int `q:"current-page"`
+}
+
+// List retrieves the tables of the database.
+func List(client *golangsdk.ServiceClient, database string, opts ListOpts) (r ListResult) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Get(rootURL(client, database)+q.String(), &r.Body, nil)
+	return
+}
+
+// Delete deletes a table.
+func Delete(client *golangsdk.ServiceClient, database, name string) (r ActionResult) {
+	_, r.Err = client.DeleteWithResponse(resourceURL(client, database, name), &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
@@ -0,0 +1,89 @@
+package tables
+
+import (
+	"fmt"
+
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// Table represents a DLI table.
+type Table struct {
+	Name             string   `json:"table_name"`
+	CreateTime       int64    `json:"create_time"`
+	DataType         string   `json:"data_type"`
+	DataLocation     string   `json:"data_location"`
+	LastAccessTime   int64    `json:"last_access_time"`
+	Location         string   `json:"location"`
+	Owner            string   `json:"owner"`
+	TableType        string   `json:"table_type"`
+	TableSize        int64    `json:"table_size"`
+	Columns          []Column `json:"columns"`
+	PartitionColumns []string `json:"partition_columns"`
+}
+
+// TableDetail is the detailed description of a table.
+type TableDetail struct {
+	Columns           []Column            `json:"columns"`
+	TableType         string              `json:"table_type"`
+	DataType          string              `json:"data_type"`
+	DataLocation      string              `json:"data_location"`
+	StorageProperties []map[string]string `json:"storage_properties"`
+	TableComment      string              `json:"table_comment"`
+	CreateTableSQL    string              `json:"create_table_sql"`
+}
+
+// Response is the generic response of the DLI operations.
+type Response struct {
+	IsSuccess bool   `json:"is_success"`
+	Message   string `json:"message"`
+	// JobID is the ID of the asynchronous job, if any.
+	JobID string `json:"job_id"`
+}
+
+// GetResult is the result of a Get request.
+type GetResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as a TableDetail.
+func (r GetResult) Extract() (*TableDetail, error) {
+	var s TableDetail
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// ListResult is the result of a List request.
+type ListResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as a slice of Table.
+func (r ListResult) Extract() ([]Table, error) {
+	var s []Table
+	err := r.ExtractIntoSlicePtr(&s, "tables")
+	return s, err
+}
+
+// ActionResult is the result of Create and Delete requests.
+type ActionResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as a Response.
+func (r ActionResult) Extract() (*Response, error) {
+	var s Response
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// ExtractErr is used to determine whether the request succeeded or failed.
+func (r ActionResult) ExtractErr() error {
+	s, err := r.Extract()
+	if err != nil {
+		return err
+	}
+	if !s.IsSuccess {
+		return fmt.Errorf("request failed: %s", s.Message)
+	}
+	return nil
+}
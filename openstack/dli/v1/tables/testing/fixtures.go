@@ -0,0 +1,77 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const expectedCreateRequest = `
+{
+  "table_name": "tb1",
+  "data_location": "DLI",
+  "columns": [
+    {
+      "column_name": "id",
+      "type": "int"
+    },
+    {
+      "column_name": "name",
+      "type": "string",
+      "description": "user name"
+    }
+  ]
+}`
+
+const listResponse = `
+{
+  "is_success": true,
+  "message": "",
+  "table_count": 1,
+  "tables": [
+    {
+      "table_name": "tb1",
+      "data_location": "DLI",
+      "owner": "tenant1",
+      "table_type": "MANAGED",
+      "table_size": 1024
+    }
+  ]
+}`
+
+const successResponse = `
+{
+  "is_success": true,
+  "message": ""
+}`
+
+// HandleCreateSuccessfully creates an HTTP handler at `/databases/db1/tables` on the
+// test handler mux that tests table creation.
+func HandleCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/databases/db1/tables", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, expectedCreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, successResponse)
+	})
+}
+
+// HandleListSuccessfully creates an HTTP handler at `/databases/db1/tables` on the
+// test handler mux that responds with a single table.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/databases/db1/tables", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"keyword": "tb", "page-size": "10", "current-page": "1"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, listResponse)
+	})
+}
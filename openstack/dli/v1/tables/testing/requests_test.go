@@ -0,0 +1,41 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dli/v1/tables"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSuccessfully(t)
+
+	opts := tables.CreateOpts{
+		Name:         "tb1",
+		DataLocation: "DLI",
+		Columns: []tables.Column{
+			{Name: "id", Type: "int"},
+			{Name: "name", Type: "string", Description: "user name"},
+		},
+	}
+	th.AssertNoErr(t, tables.Create(fake.ServiceClient(), "db1", opts).ExtractErr())
+}
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	list, err := tables.List(fake.ServiceClient(), "db1", tables.ListOpts{
+		Keyword:     "tb",
+		PageSize:    10,
+		CurrentPage: 1,
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(list))
+	th.AssertEquals(t, "tb1", list[0].Name)
+	th.AssertEquals(t, int64(1024), list[0].TableSize)
+}
@@ -0,0 +1,11 @@
+package tables
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+func rootURL(client *golangsdk.ServiceClient, database string) string {
+	return client.ServiceURL("databases", database, "tables")
+}
+
+func resourceURL(client *golangsdk.ServiceClient, database, name string) string {
+	return client.ServiceURL("databases", database, "tables", name)
+}
@@ -0,0 +1,99 @@
+package groups
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToGroupListQuery() (string, error)
+}
+
+// ListOpts allows filtering the consumer groups.
+type ListOpts struct {
+	// Consumer group name to search for
+	Group  string `q:"group"`
+	Offset int    `q:"offset"`
+	Limit  int    `q:"limit"`
+}
+
+// ToGroupListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToGroupListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), err
+}
+
+// List returns the consumer groups of the instance.
+func List(client *golangsdk.ServiceClient, instanceID string, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(client, instanceID)
+	if opts != nil {
+		query, err := opts.ToGroupListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return GroupPage{pagination.SinglePageBase(r)}
+	})
+}
+
+// Delete deletes the consumer groups of the instance by IDs in a batch.
+func Delete(client *golangsdk.ServiceClient, instanceID string, groupIDs []string) (r DeleteResult) {
+	b := map[string]interface{}{
+		"group_ids": groupIDs,
+	}
+	_, r.Err = client.Post(deleteURL(client, instanceID), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// ResetOffsetOptsBuilder is used for resetting consumer offsets.
+// any struct providing the parameters should implement this interface
+type ResetOffsetOptsBuilder interface {
+	ToResetOffsetMap() (map[string]interface{}, error)
+}
+
+// ResetOffsetOpts is a struct that contains all the parameters of the offset reset.
+// Either MessageOffset or Timestamp must be set.
+type ResetOffsetOpts struct {
+	// Name of the topic.
+	Topic string `json:"topic" required:"true"`
+
+	// Partition number, -1 resets all the partitions.
+	Partition int `json:"partition"`
+
+	// Target offset, resets to the earliest message when lower than it
+	// and to the latest message when greater than it.
+	MessageOffset *int64 `json:"message_offset,omitempty"`
+
+	// Target time in milliseconds, the offset is reset to the first message after it.
+	Timestamp *int64 `json:"timestamp,omitempty"`
+}
+
+// ToResetOffsetMap is used for type convert
+func (opts ResetOffsetOpts) ToResetOffsetMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// ResetOffset resets the consumer offset of the group.
+// The group must have no active consumers.
+func ResetOffset(client *golangsdk.ServiceClient, instanceID, group string, opts ResetOffsetOptsBuilder) (r ResetOffsetResult) {
+	b, err := opts.ToResetOffsetMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Post(resetOffsetURL(client, instanceID, group), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
@@ -0,0 +1,65 @@
+package groups
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
+)
+
+// Group response
+type Group struct {
+	GroupID string `json:"group_id"`
+	// Group state: `Dead`, `Empty`, `PreparingRebalance`, `CompletingRebalance` or `Stable`
+	State         string `json:"state"`
+	CoordinatorID int    `json:"coordinator_id"`
+	Description   string `json:"group_desc"`
+	// Number of the accumulated messages
+	Lag       int64 `json:"lag"`
+	CreatedAt int64 `json:"created_at"`
+}
+
+type GroupPage struct {
+	pagination.SinglePageBase
+}
+
+func (r GroupPage) IsEmpty() (bool, error) {
+	groups, err := ExtractGroups(r)
+	if err != nil {
+		return false, err
+	}
+	return len(groups) == 0, nil
+}
+
+// ExtractGroups is a function that takes a List page and returns the consumer groups.
+func ExtractGroups(r pagination.Page) ([]Group, error) {
+	var s []Group
+	err := (r.(GroupPage)).ExtractIntoSlicePtr(&s, "groups")
+	return s, err
+}
+
+// DeleteResponse contains the groups failed to be deleted.
+type DeleteResponse struct {
+	FailedGroups []FailedGroup `json:"failed_groups"`
+	Total        int           `json:"total"`
+}
+
+type FailedGroup struct {
+	GroupID string `json:"group_id"`
+	Reason  string `json:"error_message"`
+}
+
+// DeleteResult is a struct which contains the result of deletion
+type DeleteResult struct {
+	golangsdk.Result
+}
+
+// Extract from DeleteResult
+func (r DeleteResult) Extract() (*DeleteResponse, error) {
+	var s DeleteResponse
+	err := r.Result.ExtractInto(&s)
+	return &s, err
+}
+
+// ResetOffsetResult is a struct from which can get the result of reset offset method
+type ResetOffsetResult struct {
+	golangsdk.ErrResult
+}
@@ -0,0 +1,67 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const instanceID = "8959ab1c-7n1a-yyb1-a05t-93dfc361b32d"
+
+const listResponse = `
+{
+  "total": 1,
+  "groups": [
+    {
+      "group_id": "test-group",
+      "state": "Empty",
+      "coordinator_id": 2,
+      "group_desc": "",
+      "lag": 10,
+      "created_at": 1665400000000
+    }
+  ]
+}
+`
+
+// HandleGroupsSuccessfully creates an HTTP handler at `/instances/{instance_id}/groups` on the test
+// handler mux that responds to a GET request with listResponse.
+func HandleGroupsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/instances/%s/groups", instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"group": "test"})
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, listResponse)
+	})
+}
+
+// HandleDeleteSuccessfully creates an HTTP handler at
+// `/instances/{instance_id}/groups/batch-delete` on the test handler mux that responds to a POST
+// request.
+func HandleDeleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/instances/%s/groups/batch-delete", instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `{"group_ids": ["test-group"]}`)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, `{"failed_groups": [], "total": 0}`)
+	})
+}
+
+// HandleResetOffsetSuccessfully creates an HTTP handler at
+// `/instances/{instance_id}/management/groups/test-group/reset-message-offset` on the test handler
+// mux that responds to a POST request.
+func HandleResetOffsetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/instances/%s/management/groups/test-group/reset-message-offset", instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `{"topic": "test-topic", "partition": 0, "message_offset": 5}`)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
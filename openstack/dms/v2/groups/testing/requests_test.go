@@ -0,0 +1,47 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dms/v2/groups"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGroupsSuccessfully(t)
+
+	pages, err := groups.List(fake.ServiceClient(), instanceID, groups.ListOpts{Group: "test"}).AllPages()
+	th.AssertNoErr(t, err)
+	list, err := groups.ExtractGroups(pages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(list))
+	th.AssertEquals(t, "test-group", list[0].GroupID)
+	th.AssertEquals(t, int64(10), list[0].Lag)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteSuccessfully(t)
+
+	result, err := groups.Delete(fake.ServiceClient(), instanceID, []string{"test-group"}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 0, len(result.FailedGroups))
+}
+
+func TestResetOffset(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleResetOffsetSuccessfully(t)
+
+	offset := int64(5)
+	opts := groups.ResetOffsetOpts{
+		Topic:         "test-topic",
+		Partition:     0,
+		MessageOffset: &offset,
+	}
+	th.AssertNoErr(t, groups.ResetOffset(fake.ServiceClient(), instanceID, "test-group", opts).ExtractErr())
+}
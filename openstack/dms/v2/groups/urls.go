@@ -0,0 +1,20 @@
+package groups
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+const resourcePath = "instances"
+
+// rootURL will build the url of listing
+func rootURL(client *golangsdk.ServiceClient, instanceID string) string {
+	return client.ServiceURL(resourcePath, instanceID, "groups")
+}
+
+// deleteURL will build the url of batch deletion
+func deleteURL(client *golangsdk.ServiceClient, instanceID string) string {
+	return client.ServiceURL(resourcePath, instanceID, "groups", "batch-delete")
+}
+
+// resetOffsetURL will build the url of consumer offset reset
+func resetOffsetURL(client *golangsdk.ServiceClient, instanceID, group string) string {
+	return client.ServiceURL(resourcePath, instanceID, "management", "groups", group, "reset-message-offset")
+}
@@ -0,0 +1,248 @@
+package instances
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
+)
+
+// CreateOptsBuilder is used for creating instance parameters.
+// any struct providing the parameters should implement this interface
+type CreateOptsBuilder interface {
+	ToInstanceCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts is a struct that contains all the parameters.
+type CreateOpts struct {
+	// Indicates the name of an instance.
+	// An instance name starts with a letter,
+	// consists of 4 to 64 characters, and supports
+	// only letters, digits, hyphens (-) and underscores (_).
+	Name string `json:"name" required:"true"`
+
+	// Indicates the description of an instance.
+	// It is a character string containing not more than 1024 characters.
+	Description string `json:"description,omitempty"`
+
+	// Indicates a message engine, which is `kafka`.
+	Engine string `json:"engine" required:"true"`
+
+	// Indicates the version of a message engine, e.g. `2.3.0` or `2.7`.
+	EngineVersion string `json:"engine_version" required:"true"`
+
+	// Indicates the baseline bandwidth of a Kafka instance, e.g. `100MB`, `300MB`, `600MB` or `1200MB`.
+	Specification string `json:"specification" required:"true"`
+
+	// Indicates the message storage space in GB.
+	StorageSpace int `json:"storage_space" required:"true"`
+
+	// Indicates the maximum number of partitions in a Kafka instance.
+	PartitionNum int `json:"partition_num" required:"true"`
+
+	// Indicates the number of brokers, for the broker-based flavors.
+	BrokerNum int `json:"broker_num,omitempty"`
+
+	// Indicates the username for the SASL access, mandatory when SslEnable is true.
+	// A username consists of 4 to 64 characters
+	// and supports only letters, digits, and hyphens (-).
+	AccessUser string `json:"access_user,omitempty"`
+
+	// Indicates the password for the SASL access, mandatory when SslEnable is true.
+	Password string `json:"password,omitempty"`
+
+	// Indicates the ID of a VPC.
+	VpcID string `json:"vpc_id" required:"true"`
+
+	// Indicates the ID of a security group.
+	SecurityGroupID string `json:"security_group_id" required:"true"`
+
+	// Indicates the ID of a subnet.
+	SubnetID string `json:"subnet_id" required:"true"`
+
+	// Indicates the IDs of the AZs.
+	// One or at least three AZs are supported.
+	AvailableZones []string `json:"available_zones" required:"true"`
+
+	// Indicates a product ID, which defines the flavor of the instance.
+	ProductID string `json:"product_id" required:"true"`
+
+	// Indicates the username for logging in to the Kafka Manager.
+	KafkaManagerUser string `json:"kafka_manager_user" required:"true"`
+
+	// Indicates the password for logging in to the Kafka Manager.
+	KafkaManagerPassword string `json:"kafka_manager_password" required:"true"`
+
+	// Indicates the time at which a maintenance time window starts.
+	// Format: HH:mm:ss
+	MaintainBegin string `json:"maintain_begin,omitempty"`
+
+	// Indicates the time at which a maintenance time window ends.
+	// Format: HH:mm:ss
+	MaintainEnd string `json:"maintain_end,omitempty"`
+
+	// Indicates whether to enable public access for the instance.
+	EnablePublicIp bool `json:"enable_publicip,omitempty"`
+
+	// Indicates the public network bandwidth. Unit: Mbit/s
+	PublicBandwidth int `json:"public_bandwidth,omitempty"`
+
+	// Indicates the IDs of the EIPs bound to the instance, comma separated.
+	PublicIpID string `json:"publicip_id,omitempty"`
+
+	// Indicates whether to enable SASL_SSL access.
+	SslEnable bool `json:"ssl_enable,omitempty"`
+
+	// Indicates the SASL authentication mechanisms: `PLAIN` and/or `SCRAM-SHA-512`.
+	SaslEnabledMechanisms []string `json:"sasl_enabled_mechanisms,omitempty"`
+
+	// Indicates the action to be taken when the memory usage reaches the disk capacity threshold.
+	// Options:
+	// produce_reject: New messages cannot be created.
+	// time_base: The earliest messages are deleted.
+	RetentionPolicy string `json:"retention_policy,omitempty"`
+
+	// Indicates whether to enable automatic topic creation.
+	EnableAutoTopic *bool `json:"enable_auto_topic,omitempty"`
+
+	// Indicates the storage I/O specification, e.g. `dms.physical.storage.high` or `dms.physical.storage.ultra`.
+	StorageSpecCode string `json:"storage_spec_code" required:"true"`
+}
+
+// ToInstanceCreateMap is used for type convert
+func (opts CreateOpts) ToInstanceCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Create an instance with given parameters.
+func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToInstanceCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Post(rootURL(client), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete an instance by id
+func Delete(client *golangsdk.ServiceClient, id string) (r DeleteResult) {
+	_, r.Err = client.Delete(resourceURL(client, id), &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// Get a instance with detailed information by id
+func Get(client *golangsdk.ServiceClient, id string) (r GetResult) {
+	_, r.Err = client.Get(resourceURL(client, id), &r.Body, nil)
+	return
+}
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToInstanceListQuery() (string, error)
+}
+
+// ListOpts allows filtering the instances.
+type ListOpts struct {
+	// Indicates a message engine, which is `kafka`.
+	Engine     string `q:"engine"`
+	InstanceID string `q:"instance_id"`
+	Name       string `q:"name"`
+	Status     string `q:"status"`
+	// Whether to return the instances that failed to be created: `true` or `false`
+	IncludeFailure string `q:"include_failure"`
+	// Whether the name is matched exactly: `true` or `false`
+	ExactMatchName string `q:"exact_match_name"`
+	Offset         int    `q:"offset"`
+	Limit          int    `q:"limit"`
+}
+
+// ToInstanceListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToInstanceListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), err
+}
+
+// List returns the instances matching the filter.
+func List(client *golangsdk.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(client)
+	if opts != nil {
+		query, err := opts.ToInstanceListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return InstancePage{pagination.SinglePageBase(r)}
+	})
+}
+
+// ResetPasswordOptsBuilder is an interface which can build the map paramter of reset password function
+type ResetPasswordOptsBuilder interface {
+	ToPasswordResetMap() (map[string]interface{}, error)
+}
+
+// ResetPasswordOpts is a struct which represents the parameters of reset password function
+type ResetPasswordOpts struct {
+	// New password of the SASL user.
+	NewPassword string `json:"new_password" required:"true"`
+}
+
+// ToPasswordResetMap is used for type convert
+func (opts ResetPasswordOpts) ToPasswordResetMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// ResetPassword resets the password of the instance, available for the SASL_SSL instances only.
+func ResetPassword(client *golangsdk.ServiceClient, id string, opts ResetPasswordOptsBuilder) (r ResetPasswordResult) {
+	b, err := opts.ToPasswordResetMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Post(passwordURL(client, id), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// CrossVpcOptsBuilder is an interface which can build the map paramter of cross-VPC modification function
+type CrossVpcOptsBuilder interface {
+	ToCrossVpcMap() (map[string]interface{}, error)
+}
+
+// CrossVpcOpts is a struct which represents the parameters of cross-VPC modification function
+type CrossVpcOpts struct {
+	// Maps the private IP of each broker to the advertised IP or domain name,
+	// e.g. `{"192.168.0.53": "kafka-1.example.com"}`.
+	Contents map[string]string `json:"advertised_ip_contents" required:"true"`
+}
+
+// ToCrossVpcMap is used for type convert
+func (opts CrossVpcOpts) ToCrossVpcMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// UpdateCrossVpc modifies the advertised IP addresses of the brokers for cross-VPC access.
+func UpdateCrossVpc(client *golangsdk.ServiceClient, id string, opts CrossVpcOptsBuilder) (r CrossVpcResult) {
+	b, err := opts.ToCrossVpcMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Post(crossVpcURL(client, id), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
@@ -0,0 +1,139 @@
+package instances
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
+)
+
+// CreateResult is a struct that contains all the return parameters of creation
+type CreateResult struct {
+	golangsdk.Result
+}
+
+// Extract returns the ID of the created instance
+func (r CreateResult) Extract() (string, error) {
+	var s struct {
+		InstanceID string `json:"instance_id"`
+	}
+	err := r.Result.ExtractInto(&s)
+	return s.InstanceID, err
+}
+
+// DeleteResult is a struct which contains the result of deletion
+type DeleteResult struct {
+	golangsdk.ErrResult
+}
+
+// Instance response
+type Instance struct {
+	Name          string `json:"name"`
+	Description   string `json:"description"`
+	Engine        string `json:"engine"`
+	EngineVersion string `json:"engine_version"`
+	Specification string `json:"specification"`
+	// Message storage space in GB
+	StorageSpace     int    `json:"storage_space"`
+	UsedStorageSpace int    `json:"used_storage_space"`
+	PartitionNum     string `json:"partition_num"`
+	BrokerNum        int    `json:"broker_num"`
+	// Comma separated addresses of the brokers
+	ConnectAddress string `json:"connect_address"`
+	Port           int    `json:"port"`
+	// Instance status: `CREATING`, `RUNNING`, `RESTARTING`, `CREATEFAILED`, `ERROR`, etc.
+	Status           string   `json:"status"`
+	InstanceID       string   `json:"instance_id"`
+	ResourceSpecCode string   `json:"resource_spec_code"`
+	Type             string   `json:"type"`
+	VpcID            string   `json:"vpc_id"`
+	VpcName          string   `json:"vpc_name"`
+	SubnetID         string   `json:"subnet_id"`
+	SecurityGroupID  string   `json:"security_group_id"`
+	ProductID        string   `json:"product_id"`
+	AvailableZones   []string `json:"available_zones"`
+	CreatedAt        string   `json:"created_at"`
+	UserID           string   `json:"user_id"`
+	UserName         string   `json:"user_name"`
+	AccessUser       string   `json:"access_user"`
+	KafkaManagerUser string   `json:"kafka_manager_user"`
+	MaintainBegin    string   `json:"maintain_begin"`
+	MaintainEnd      string   `json:"maintain_end"`
+	EnablePublicIp   bool     `json:"enable_publicip"`
+	PublicBandwidth  int      `json:"public_bandwidth"`
+	// Comma separated public addresses of the brokers
+	PublicConnectAddress  string   `json:"public_connect_address"`
+	SslEnable             bool     `json:"ssl_enable"`
+	SaslEnabledMechanisms []string `json:"sasl_enabled_mechanisms"`
+	EnableAutoTopic       bool     `json:"enable_auto_topic"`
+	RetentionPolicy       string   `json:"retention_policy"`
+	StorageSpecCode       string   `json:"storage_spec_code"`
+	TotalStorageSpace     int      `json:"total_storage_space"`
+	// Cross-VPC access information as a JSON string
+	CrossVpcInfo string `json:"cross_vpc_info"`
+}
+
+// GetResult contains the body of getting detailed
+type GetResult struct {
+	golangsdk.Result
+}
+
+// Extract from GetResult
+func (r GetResult) Extract() (*Instance, error) {
+	var s Instance
+	err := r.Result.ExtractInto(&s)
+	return &s, err
+}
+
+type ListResponse struct {
+	Instances  []Instance `json:"instances"`
+	TotalCount int        `json:"instance_num"`
+}
+
+type InstancePage struct {
+	pagination.SinglePageBase
+}
+
+func (r InstancePage) IsEmpty() (bool, error) {
+	data, err := ExtractInstances(r)
+	if err != nil {
+		return false, err
+	}
+	return len(data.Instances) == 0, err
+}
+
+// ExtractInstances is a function that takes a List page and returns the instances' information.
+func ExtractInstances(r pagination.Page) (ListResponse, error) {
+	var s ListResponse
+	err := (r.(InstancePage)).ExtractInto(&s)
+	return s, err
+}
+
+// ResetPasswordResult is a struct from which can get the result of reset password method
+type ResetPasswordResult struct {
+	golangsdk.ErrResult
+}
+
+// CrossVpc response
+type CrossVpc struct {
+	// Whether the modification succeeded
+	Success bool `json:"success"`
+	// Modification results per broker
+	Results []CrossVpcResultItem `json:"results"`
+}
+
+type CrossVpcResultItem struct {
+	AdvertisedIP string `json:"advertised_ip"`
+	Success      bool   `json:"success"`
+	IP           string `json:"ip"`
+}
+
+// CrossVpcResult is a struct from which can get the result of cross-VPC modification method
+type CrossVpcResult struct {
+	golangsdk.Result
+}
+
+// Extract from CrossVpcResult
+func (r CrossVpcResult) Extract() (*CrossVpc, error) {
+	var s CrossVpc
+	err := r.Result.ExtractInto(&s)
+	return &s, err
+}
@@ -0,0 +1,151 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const instanceID = "8959ab1c-7n1a-yyb1-a05t-93dfc361b32d"
+
+const expectedCreateRequest = `
+{
+  "name": "kafka-test",
+  "engine": "kafka",
+  "engine_version": "2.7",
+  "specification": "100MB",
+  "storage_space": 600,
+  "partition_num": 300,
+  "access_user": "root",
+  "password": "Qwerty123!",
+  "vpc_id": "1e93f86e-13af-46c8-97d6-d40fa62b76c2",
+  "security_group_id": "0aaa0033-bf7f-4c41-a6c2-18cd04cad2c8",
+  "subnet_id": "b5fa806c-35e7-4299-b659-b39398dd4718",
+  "available_zones": ["eu-de-01", "eu-de-02", "eu-de-03"],
+  "product_id": "00300-30308-0--0",
+  "kafka_manager_user": "manager",
+  "kafka_manager_password": "Qwerty123!",
+  "ssl_enable": true,
+  "sasl_enabled_mechanisms": ["SCRAM-SHA-512"],
+  "storage_spec_code": "dms.physical.storage.high"
+}
+`
+
+const getResponse = `
+{
+  "name": "kafka-test",
+  "engine": "kafka",
+  "engine_version": "2.7",
+  "specification": "100MB",
+  "storage_space": 492,
+  "partition_num": "300",
+  "broker_num": 3,
+  "connect_address": "192.168.0.100,192.168.0.61,192.168.0.72",
+  "port": 9093,
+  "status": "RUNNING",
+  "instance_id": "8959ab1c-7n1a-yyb1-a05t-93dfc361b32d",
+  "resource_spec_code": "dms.instance.kafka.cluster.c3.mini",
+  "vpc_id": "1e93f86e-13af-46c8-97d6-d40fa62b76c2",
+  "subnet_id": "b5fa806c-35e7-4299-b659-b39398dd4718",
+  "security_group_id": "0aaa0033-bf7f-4c41-a6c2-18cd04cad2c8",
+  "product_id": "00300-30308-0--0",
+  "available_zones": ["eu-de-01", "eu-de-02", "eu-de-03"],
+  "created_at": "1585618587087",
+  "access_user": "root",
+  "kafka_manager_user": "manager",
+  "ssl_enable": true,
+  "sasl_enabled_mechanisms": ["SCRAM-SHA-512"],
+  "storage_spec_code": "dms.physical.storage.high",
+  "total_storage_space": 600
+}
+`
+
+var listResponse = fmt.Sprintf(`{"instances": [%s], "instance_num": 1}`, getResponse)
+
+const expectedCrossVpcRequest = `
+{
+  "advertised_ip_contents": {
+    "192.168.0.100": "kafka-1.example.com",
+    "192.168.0.61": "kafka-2.example.com",
+    "192.168.0.72": "kafka-3.example.com"
+  }
+}
+`
+
+const crossVpcResponse = `
+{
+  "success": true,
+  "results": [
+    {"advertised_ip": "kafka-1.example.com", "success": true, "ip": "192.168.0.100"},
+    {"advertised_ip": "kafka-2.example.com", "success": true, "ip": "192.168.0.61"},
+    {"advertised_ip": "kafka-3.example.com", "success": true, "ip": "192.168.0.72"}
+  ]
+}
+`
+
+// HandleInstancesSuccessfully creates an HTTP handler at `/instances` on the test handler mux that
+// responds to POST and GET requests.
+func HandleInstancesSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/instances", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+		switch r.Method {
+		case "POST":
+			th.TestJSONRequest(t, r, expectedCreateRequest)
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprintf(w, `{"instance_id": "%s"}`, instanceID)
+		case "GET":
+			th.TestFormValues(t, r, map[string]string{"engine": "kafka", "name": "kafka-test"})
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprint(w, listResponse)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+}
+
+// HandleInstanceSuccessfully creates an HTTP handler at `/instances/{instance_id}` on the test
+// handler mux that responds to GET and DELETE requests.
+func HandleInstanceSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/instances/%s", instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		switch r.Method {
+		case "GET":
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprint(w, getResponse)
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+}
+
+// HandlePasswordSuccessfully creates an HTTP handler at `/instances/{instance_id}/password` on the
+// test handler mux that responds to a POST request.
+func HandlePasswordSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/instances/%s/password", instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `{"new_password": "Qwerty456!"}`)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandleCrossVpcSuccessfully creates an HTTP handler at `/instances/{instance_id}/crossvpc/modify`
+// on the test handler mux that responds to a POST request with crossVpcResponse.
+func HandleCrossVpcSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/instances/%s/crossvpc/modify", instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, expectedCrossVpcRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, crossVpcResponse)
+	})
+}
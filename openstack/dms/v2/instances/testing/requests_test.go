@@ -0,0 +1,103 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dms/v2/instances"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleInstancesSuccessfully(t)
+
+	opts := instances.CreateOpts{
+		Name:                  "kafka-test",
+		Engine:                "kafka",
+		EngineVersion:         "2.7",
+		Specification:         "100MB",
+		StorageSpace:          600,
+		PartitionNum:          300,
+		AccessUser:            "root",
+		Password:              "Qwerty123!",
+		VpcID:                 "1e93f86e-13af-46c8-97d6-d40fa62b76c2",
+		SecurityGroupID:       "0aaa0033-bf7f-4c41-a6c2-18cd04cad2c8",
+		SubnetID:              "b5fa806c-35e7-4299-b659-b39398dd4718",
+		AvailableZones:        []string{"eu-de-01", "eu-de-02", "eu-de-03"},
+		ProductID:             "00300-30308-0--0",
+		KafkaManagerUser:      "manager",
+		KafkaManagerPassword:  "Qwerty123!",
+		SslEnable:             true,
+		SaslEnabledMechanisms: []string{"SCRAM-SHA-512"},
+		StorageSpecCode:       "dms.physical.storage.high",
+	}
+	id, err := instances.Create(fake.ServiceClient(), opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, instanceID, id)
+}
+
+func TestGetAndWait(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleInstanceSuccessfully(t)
+
+	instance, err := instances.Get(fake.ServiceClient(), instanceID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "RUNNING", instance.Status)
+	th.AssertEquals(t, 3, instance.BrokerNum)
+	th.AssertEquals(t, 9093, instance.Port)
+	th.AssertDeepEquals(t, []string{"SCRAM-SHA-512"}, instance.SaslEnabledMechanisms)
+
+	th.AssertNoErr(t, instances.WaitForStatus(fake.ServiceClient(), instanceID, "RUNNING", 10))
+}
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleInstancesSuccessfully(t)
+
+	pages, err := instances.List(fake.ServiceClient(), instances.ListOpts{Engine: "kafka", Name: "kafka-test"}).AllPages()
+	th.AssertNoErr(t, err)
+	list, err := instances.ExtractInstances(pages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, list.TotalCount)
+	th.AssertEquals(t, instanceID, list.Instances[0].InstanceID)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleInstanceSuccessfully(t)
+
+	th.AssertNoErr(t, instances.Delete(fake.ServiceClient(), instanceID).ExtractErr())
+}
+
+func TestResetPassword(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandlePasswordSuccessfully(t)
+
+	opts := instances.ResetPasswordOpts{NewPassword: "Qwerty456!"}
+	th.AssertNoErr(t, instances.ResetPassword(fake.ServiceClient(), instanceID, opts).ExtractErr())
+}
+
+func TestUpdateCrossVpc(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCrossVpcSuccessfully(t)
+
+	opts := instances.CrossVpcOpts{
+		Contents: map[string]string{
+			"192.168.0.100": "kafka-1.example.com",
+			"192.168.0.61":  "kafka-2.example.com",
+			"192.168.0.72":  "kafka-3.example.com",
+		},
+	}
+	result, err := instances.UpdateCrossVpc(fake.ServiceClient(), instanceID, opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, result.Success)
+	th.AssertEquals(t, 3, len(result.Results))
+	th.AssertEquals(t, "192.168.0.100", result.Results[0].IP)
+}
@@ -0,0 +1,26 @@
+package instances
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+// endpoint/instances
+const resourcePath = "instances"
+
+// rootURL will build the url of creation and listing
+func rootURL(client *golangsdk.ServiceClient) string {
+	return client.ServiceURL(resourcePath)
+}
+
+// resourceURL will build the url of get and deletion
+func resourceURL(client *golangsdk.ServiceClient, id string) string {
+	return client.ServiceURL(resourcePath, id)
+}
+
+// passwordURL will build the password reset url
+func passwordURL(client *golangsdk.ServiceClient, id string) string {
+	return client.ServiceURL(resourcePath, id, "password")
+}
+
+// crossVpcURL will build the cross-VPC access modification url
+func crossVpcURL(client *golangsdk.ServiceClient, id string) string {
+	return client.ServiceURL(resourcePath, id, "crossvpc", "modify")
+}
@@ -0,0 +1,21 @@
+package quotas
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/structs"
+)
+
+type GetResult struct {
+	golangsdk.Result
+}
+
+// Extract is a function that accepts a result and extracts DMS resource quotas.
+func (r GetResult) Extract() ([]structs.QuotaResource, error) {
+	var s struct {
+		Quotas struct {
+			Resources []structs.QuotaResource `json:"resources"`
+		} `json:"quotas"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Quotas.Resources, err
+}
@@ -0,0 +1,39 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const getResponse = `
+{
+  "quotas": {
+    "resources": [
+      {
+        "type": "kafka",
+        "quota": 100,
+        "used": 4,
+        "min": 1,
+        "max": 100,
+        "unit": null
+      }
+    ]
+  }
+}`
+
+// HandleGetSuccessfully creates an HTTP handler at `/quotas/dms` on the test handler mux that
+// responds to a GET request with getResponse.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/quotas/dms", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, getResponse)
+	})
+}
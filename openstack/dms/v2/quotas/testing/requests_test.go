@@ -0,0 +1,22 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dms/v2/quotas"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	resources, err := quotas.Get(fake.ServiceClient()).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(resources))
+	th.AssertEquals(t, "kafka", resources[0].Type)
+	th.AssertEquals(t, 4, resources[0].Used)
+	th.AssertEquals(t, 100, resources[0].Quota)
+}
@@ -0,0 +1,130 @@
+package topics
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// CreateOptsBuilder is used for creating topic parameters.
+// any struct providing the parameters should implement this interface
+type CreateOptsBuilder interface {
+	ToTopicCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts is a struct that contains all the parameters.
+type CreateOpts struct {
+	// Name of the topic.
+	// A topic name consists of 3 to 200 characters and supports
+	// only letters, digits, periods (.), hyphens (-) and underscores (_).
+	Name string `json:"id" required:"true"`
+
+	// Number of the replicas. Range: 1–3.
+	Replication int `json:"replication,omitempty"`
+
+	// Number of the partitions. Range: 1–100.
+	Partition int `json:"partition,omitempty"`
+
+	// Retention time of the messages in hours. Range: 1–720, default: 72.
+	RetentionTime int `json:"retention_time,omitempty"`
+
+	// Whether to enable synchronous replication.
+	SyncReplication bool `json:"sync_replication,omitempty"`
+
+	// Whether to enable synchronous flushing.
+	SyncMessageFlush bool `json:"sync_message_flush,omitempty"`
+
+	// Description of the topic.
+	Description string `json:"topic_desc,omitempty"`
+}
+
+// ToTopicCreateMap is used for type convert
+func (opts CreateOpts) ToTopicCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Create a topic in the instance with given parameters.
+func Create(client *golangsdk.ServiceClient, instanceID string, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToTopicCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Post(rootURL(client, instanceID), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// UpdateOptsBuilder is used for updating topic parameters.
+// any struct providing the parameters should implement this interface
+type UpdateOptsBuilder interface {
+	ToTopicUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts is a struct that contains the topics to modify.
+type UpdateOpts struct {
+	Topics []UpdateItem `json:"topics" required:"true"`
+}
+
+// UpdateItem contains the new parameters of a topic.
+type UpdateItem struct {
+	// Name of the topic.
+	Name string `json:"id" required:"true"`
+
+	// Retention time of the messages in hours. Range: 1–720.
+	RetentionTime *int `json:"retention_time,omitempty"`
+
+	// Whether to enable synchronous replication.
+	SyncReplication *bool `json:"sync_replication,omitempty"`
+
+	// Whether to enable synchronous flushing.
+	SyncMessageFlush *bool `json:"sync_message_flush,omitempty"`
+
+	// New number of the partitions, which can only be increased.
+	NewPartitionNumbers int `json:"new_partition_numbers,omitempty"`
+
+	// Description of the topic.
+	Description *string `json:"topic_desc,omitempty"`
+}
+
+// ToTopicUpdateMap is used for type convert
+func (opts UpdateOpts) ToTopicUpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Update modifies the topics of the instance.
+func Update(client *golangsdk.ServiceClient, instanceID string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToTopicUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Put(rootURL(client, instanceID), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// Get the details of the topic partitions by name.
+func Get(client *golangsdk.ServiceClient, instanceID, name string) (r GetResult) {
+	_, r.Err = client.Get(resourceURL(client, instanceID, name), &r.Body, nil)
+	return
+}
+
+// List returns all the topics of the instance.
+func List(client *golangsdk.ServiceClient, instanceID string) (r ListResult) {
+	_, r.Err = client.Get(rootURL(client, instanceID), &r.Body, nil)
+	return
+}
+
+// Delete deletes the topics of the instance by names in a batch.
+func Delete(client *golangsdk.ServiceClient, instanceID string, names []string) (r DeleteResult) {
+	b := map[string]interface{}{
+		"topics": names,
+	}
+	_, r.Err = client.Post(deleteURL(client, instanceID), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
@@ -0,0 +1,117 @@
+package topics
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// CreateResult is a struct that contains all the return parameters of creation
+type CreateResult struct {
+	golangsdk.Result
+}
+
+// Extract returns the name of the created topic
+func (r CreateResult) Extract() (string, error) {
+	var s struct {
+		ID string `json:"id"`
+	}
+	err := r.Result.ExtractInto(&s)
+	return s.ID, err
+}
+
+// UpdateResult is a struct from which can get the result of update method
+type UpdateResult struct {
+	golangsdk.ErrResult
+}
+
+// Topic response
+type Topic struct {
+	Name             string `json:"name"`
+	Replication      int    `json:"replication"`
+	Partition        int    `json:"partition"`
+	RetentionTime    int    `json:"retention_time"`
+	SyncReplication  bool   `json:"sync_replication"`
+	SyncMessageFlush bool   `json:"sync_message_flush"`
+	Description      string `json:"topic_desc"`
+	// Topic type: 0 for common and 1 for system topics
+	TopicType int `json:"topic_type"`
+}
+
+// ListResponse contains the topics of the instance and the partition quota.
+type ListResponse struct {
+	Total              int     `json:"total"`
+	Size               int     `json:"size"`
+	RemainPartitions   int     `json:"remain_partitions"`
+	MaxPartitions      int     `json:"max_partitions"`
+	TopicMaxPartitions int     `json:"topic_max_partitions"`
+	Topics             []Topic `json:"topics"`
+}
+
+// ListResult is a struct from which can get the result of list method
+type ListResult struct {
+	golangsdk.Result
+}
+
+// Extract from ListResult
+func (r ListResult) Extract() (*ListResponse, error) {
+	var s ListResponse
+	err := r.Result.ExtractInto(&s)
+	return &s, err
+}
+
+// TopicDetail contains the partitions of the topic.
+type TopicDetail struct {
+	Name            string      `json:"topic"`
+	Partitions      []Partition `json:"partitions"`
+	GroupSubscribed []string    `json:"group_subscribed"`
+}
+
+type Partition struct {
+	Partition int `json:"partition"`
+	Leader    int `json:"leader"`
+	// Log end offset
+	Leo int `json:"leo"`
+	// High watermark
+	Hw int `json:"hw"`
+	// Log start offset
+	Lso                 int       `json:"lso"`
+	LastUpdateTimestamp int64     `json:"last_update_timestamp"`
+	Replicas            []Replica `json:"replicas"`
+}
+
+type Replica struct {
+	Broker int  `json:"broker"`
+	Leader bool `json:"leader"`
+	InSync bool `json:"in_sync"`
+	Size   int  `json:"size"`
+	Lag    int  `json:"lag"`
+}
+
+// GetResult contains the body of getting detailed
+type GetResult struct {
+	golangsdk.Result
+}
+
+// Extract from GetResult
+func (r GetResult) Extract() (*TopicDetail, error) {
+	var s TopicDetail
+	err := r.Result.ExtractInto(&s)
+	return &s, err
+}
+
+// DeleteResponse is the deletion result per topic.
+type DeleteResponse struct {
+	Name    string `json:"id"`
+	Success bool   `json:"success"`
+}
+
+// DeleteResult is a struct which contains the result of deletion
+type DeleteResult struct {
+	golangsdk.Result
+}
+
+// Extract from DeleteResult
+func (r DeleteResult) Extract() ([]DeleteResponse, error) {
+	var s []DeleteResponse
+	err := r.ExtractIntoSlicePtr(&s, "topics")
+	return s, err
+}
@@ -0,0 +1,132 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const instanceID = "8959ab1c-7n1a-yyb1-a05t-93dfc361b32d"
+
+const expectedCreateRequest = `
+{
+  "id": "test-topic",
+  "replication": 3,
+  "partition": 3,
+  "retention_time": 48,
+  "sync_replication": true
+}
+`
+
+const expectedUpdateRequest = `
+{
+  "topics": [
+    {
+      "id": "test-topic",
+      "retention_time": 24,
+      "new_partition_numbers": 6
+    }
+  ]
+}
+`
+
+const listResponse = `
+{
+  "total": 1,
+  "size": 10,
+  "remain_partitions": 294,
+  "max_partitions": 300,
+  "topic_max_partitions": 100,
+  "topics": [
+    {
+      "name": "test-topic",
+      "replication": 3,
+      "partition": 6,
+      "retention_time": 24,
+      "sync_replication": true,
+      "sync_message_flush": false,
+      "topic_type": 0
+    }
+  ]
+}
+`
+
+const getResponse = `
+{
+  "topic": "test-topic",
+  "partitions": [
+    {
+      "partition": 0,
+      "leader": 1,
+      "leo": 10,
+      "hw": 10,
+      "lso": 0,
+      "last_update_timestamp": 1665400000000,
+      "replicas": [
+        {"broker": 1, "leader": true, "in_sync": true, "size": 512, "lag": 0}
+      ]
+    }
+  ],
+  "group_subscribed": ["test-group"]
+}
+`
+
+const deleteResponse = `
+{
+  "topics": [
+    {"id": "test-topic", "success": true}
+  ]
+}
+`
+
+// HandleTopicsSuccessfully creates an HTTP handler at `/instances/{instance_id}/topics` on the test
+// handler mux that responds to POST, PUT and GET requests.
+func HandleTopicsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/instances/%s/topics", instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		switch r.Method {
+		case "POST":
+			th.TestJSONRequest(t, r, expectedCreateRequest)
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprint(w, `{"id": "test-topic"}`)
+		case "PUT":
+			th.TestJSONRequest(t, r, expectedUpdateRequest)
+			w.WriteHeader(http.StatusNoContent)
+		case "GET":
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprint(w, listResponse)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+}
+
+// HandleTopicSuccessfully creates an HTTP handler at `/instances/{instance_id}/topics/test-topic`
+// on the test handler mux that responds to a GET request with getResponse.
+func HandleTopicSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/instances/%s/topics/test-topic", instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, getResponse)
+	})
+}
+
+// HandleDeleteSuccessfully creates an HTTP handler at `/instances/{instance_id}/topics/delete` on
+// the test handler mux that responds to a POST request with deleteResponse.
+func HandleDeleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/instances/%s/topics/delete", instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `{"topics": ["test-topic"]}`)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, deleteResponse)
+	})
+}
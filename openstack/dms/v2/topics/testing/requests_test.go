@@ -0,0 +1,75 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dms/v2/topics"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleTopicsSuccessfully(t)
+
+	opts := topics.CreateOpts{
+		Name:            "test-topic",
+		Replication:     3,
+		Partition:       3,
+		RetentionTime:   48,
+		SyncReplication: true,
+	}
+	name, err := topics.Create(fake.ServiceClient(), instanceID, opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "test-topic", name)
+}
+
+func TestUpdateAndList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleTopicsSuccessfully(t)
+
+	retention := 24
+	opts := topics.UpdateOpts{
+		Topics: []topics.UpdateItem{
+			{
+				Name:                "test-topic",
+				RetentionTime:       &retention,
+				NewPartitionNumbers: 6,
+			},
+		},
+	}
+	th.AssertNoErr(t, topics.Update(fake.ServiceClient(), instanceID, opts).ExtractErr())
+
+	list, err := topics.List(fake.ServiceClient(), instanceID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 294, list.RemainPartitions)
+	th.AssertEquals(t, 1, len(list.Topics))
+	th.AssertEquals(t, 6, list.Topics[0].Partition)
+	th.AssertEquals(t, 24, list.Topics[0].RetentionTime)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleTopicSuccessfully(t)
+
+	topic, err := topics.Get(fake.ServiceClient(), instanceID, "test-topic").Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "test-topic", topic.Name)
+	th.AssertEquals(t, 1, len(topic.Partitions))
+	th.AssertEquals(t, true, topic.Partitions[0].Replicas[0].Leader)
+	th.AssertDeepEquals(t, []string{"test-group"}, topic.GroupSubscribed)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteSuccessfully(t)
+
+	result, err := topics.Delete(fake.ServiceClient(), instanceID, []string{"test-topic"}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(result))
+	th.AssertEquals(t, true, result[0].Success)
+}
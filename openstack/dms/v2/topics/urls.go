@@ -0,0 +1,23 @@
+package topics
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+const (
+	resourcePath = "instances"
+	topicPath    = "topics"
+)
+
+// rootURL will build the url of creation, update and listing
+func rootURL(client *golangsdk.ServiceClient, instanceID string) string {
+	return client.ServiceURL(resourcePath, instanceID, topicPath)
+}
+
+// resourceURL will build the url of get
+func resourceURL(client *golangsdk.ServiceClient, instanceID, name string) string {
+	return client.ServiceURL(resourcePath, instanceID, topicPath, name)
+}
+
+// deleteURL will build the url of batch deletion
+func deleteURL(client *golangsdk.ServiceClient, instanceID string) string {
+	return client.ServiceURL(resourcePath, instanceID, topicPath, "delete")
+}
@@ -0,0 +1,149 @@
+package users
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// CreateOptsBuilder is used for creating user parameters.
+// any struct providing the parameters should implement this interface
+type CreateOptsBuilder interface {
+	ToUserCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts is a struct that contains all the parameters of the SASL user.
+type CreateOpts struct {
+	// Name of the user.
+	// A username consists of 4 to 64 characters
+	// and supports only letters, digits, hyphens (-) and underscores (_).
+	Name string `json:"user_name" required:"true"`
+
+	// Password of the user.
+	Password string `json:"user_passwd" required:"true"`
+}
+
+// ToUserCreateMap is used for type convert
+func (opts CreateOpts) ToUserCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Create a SASL user in the instance with given parameters.
+func Create(client *golangsdk.ServiceClient, instanceID string, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToUserCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Post(rootURL(client, instanceID), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// List returns the SASL users of the instance.
+func List(client *golangsdk.ServiceClient, instanceID string) (r ListResult) {
+	_, r.Err = client.Get(rootURL(client, instanceID), &r.Body, nil)
+	return
+}
+
+// Delete deletes the SASL users of the instance by names in a batch.
+func Delete(client *golangsdk.ServiceClient, instanceID string, names []string) (r DeleteResult) {
+	b := map[string]interface{}{
+		"action": "delete",
+		"users":  names,
+	}
+	_, r.Err = client.Put(rootURL(client, instanceID), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// ResetPasswordOptsBuilder is an interface which can build the map paramter of reset password function
+type ResetPasswordOptsBuilder interface {
+	ToPasswordResetMap() (map[string]interface{}, error)
+}
+
+// ResetPasswordOpts is a struct which represents the parameters of reset password function
+type ResetPasswordOpts struct {
+	// New password of the user.
+	NewPassword string `json:"new_password" required:"true"`
+}
+
+// ToPasswordResetMap is used for type convert
+func (opts ResetPasswordOpts) ToPasswordResetMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// ResetPassword resets the password of the SASL user.
+func ResetPassword(client *golangsdk.ServiceClient, instanceID, name string, opts ResetPasswordOptsBuilder) (r ResetPasswordResult) {
+	b, err := opts.ToPasswordResetMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Put(resourceURL(client, instanceID, name), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// Supported access policies of the users to the topics.
+const (
+	PolicyAll       = "all"
+	PolicyPublish   = "pub"
+	PolicySubscribe = "sub"
+)
+
+// PoliciesOptsBuilder is used for granting topic permissions.
+// any struct providing the parameters should implement this interface
+type PoliciesOptsBuilder interface {
+	ToPoliciesMap() (map[string]interface{}, error)
+}
+
+// PoliciesOpts is a struct that contains the permissions per topic.
+type PoliciesOpts struct {
+	Topics []TopicPolicies `json:"topics" required:"true"`
+}
+
+// TopicPolicies contains the permissions of the users to the topic.
+// The given policies replace the existing ones.
+type TopicPolicies struct {
+	// Name of the topic.
+	Name string `json:"name" required:"true"`
+	// Permissions of the users, an empty list revokes all of them.
+	Policies []Policy `json:"policies"`
+}
+
+// Policy is a permission of the user to the topic.
+type Policy struct {
+	// Name of the user.
+	UserName string `json:"user_name" required:"true"`
+	// Access policy: `all`, `pub` or `sub`.
+	AccessPolicy string `json:"access_policy" required:"true"`
+}
+
+// ToPoliciesMap is used for type convert
+func (opts PoliciesOpts) ToPoliciesMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// UpdatePolicies grants the users permissions to the topics.
+func UpdatePolicies(client *golangsdk.ServiceClient, instanceID string, opts PoliciesOptsBuilder) (r UpdatePoliciesResult) {
+	b, err := opts.ToPoliciesMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Post(policiesURL(client, instanceID), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// GetPolicies returns the permissions of the users to the topic.
+func GetPolicies(client *golangsdk.ServiceClient, instanceID, topic string) (r GetPoliciesResult) {
+	_, r.Err = client.Get(topicPolicyURL(client, instanceID, topic), &r.Body, nil)
+	return
+}
@@ -0,0 +1,74 @@
+package users
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// CreateResult is a struct which contains the result of creation
+type CreateResult struct {
+	golangsdk.ErrResult
+}
+
+// User response
+type User struct {
+	Name string `json:"user_name"`
+	Role string `json:"role"`
+	// Whether the user is the default one created with the instance
+	DefaultApp  bool  `json:"default_app"`
+	CreatedTime int64 `json:"created_time"`
+}
+
+// ListResult is a struct from which can get the result of list method
+type ListResult struct {
+	golangsdk.Result
+}
+
+// Extract from ListResult
+func (r ListResult) Extract() ([]User, error) {
+	var s []User
+	err := r.ExtractIntoSlicePtr(&s, "users")
+	return s, err
+}
+
+// DeleteResult is a struct which contains the result of deletion
+type DeleteResult struct {
+	golangsdk.ErrResult
+}
+
+// ResetPasswordResult is a struct from which can get the result of reset password method
+type ResetPasswordResult struct {
+	golangsdk.ErrResult
+}
+
+// UpdatePoliciesResult is a struct from which can get the result of update policies method
+type UpdatePoliciesResult struct {
+	golangsdk.ErrResult
+}
+
+// TopicPolicy contains the permissions of the users to the topic.
+type TopicPolicy struct {
+	Name string `json:"name"`
+	// Topic type: 0 for common and 1 for system topics
+	TopicType int             `json:"topic_type"`
+	Policies  []GrantedPolicy `json:"policies"`
+}
+
+// GrantedPolicy is a permission of the user to the topic.
+type GrantedPolicy struct {
+	// Whether the user created the topic
+	Owner        bool   `json:"owner"`
+	UserName     string `json:"user_name"`
+	AccessPolicy string `json:"access_policy"`
+}
+
+// GetPoliciesResult is a struct from which can get the result of get policies method
+type GetPoliciesResult struct {
+	golangsdk.Result
+}
+
+// Extract from GetPoliciesResult
+func (r GetPoliciesResult) Extract() (*TopicPolicy, error) {
+	var s TopicPolicy
+	err := r.Result.ExtractInto(&s)
+	return &s, err
+}
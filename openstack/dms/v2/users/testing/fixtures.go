@@ -0,0 +1,97 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const instanceID = "8959ab1c-7n1a-yyb1-a05t-93dfc361b32d"
+
+const listResponse = `
+{
+  "users": [
+    {"user_name": "root", "role": "", "default_app": true, "created_time": 1665400000000},
+    {"user_name": "test-user", "role": "", "default_app": false, "created_time": 1665500000000}
+  ]
+}
+`
+
+const expectedPoliciesRequest = `
+{
+  "topics": [
+    {
+      "name": "test-topic",
+      "policies": [
+        {"user_name": "test-user", "access_policy": "pub"}
+      ]
+    }
+  ]
+}
+`
+
+const getPoliciesResponse = `
+{
+  "name": "test-topic",
+  "topic_type": 0,
+  "policies": [
+    {"owner": false, "user_name": "test-user", "access_policy": "pub"}
+  ]
+}
+`
+
+// HandleUsersSuccessfully creates an HTTP handler at `/instances/{instance_id}/users` on the test
+// handler mux that responds to POST, PUT and GET requests.
+func HandleUsersSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/instances/%s/users", instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		switch r.Method {
+		case "POST":
+			th.TestJSONRequest(t, r, `{"user_name": "test-user", "user_passwd": "Qwerty123!"}`)
+			w.WriteHeader(http.StatusNoContent)
+		case "PUT":
+			th.TestJSONRequest(t, r, `{"action": "delete", "users": ["test-user"]}`)
+			w.WriteHeader(http.StatusNoContent)
+		case "GET":
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprint(w, listResponse)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+}
+
+// HandlePasswordSuccessfully creates an HTTP handler at `/instances/{instance_id}/users/test-user`
+// on the test handler mux that responds to a PUT request.
+func HandlePasswordSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/instances/%s/users/test-user", instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `{"new_password": "Qwerty456!"}`)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandlePoliciesSuccessfully creates HTTP handlers at
+// `/instances/{instance_id}/topics/accesspolicy` and
+// `/instances/{instance_id}/topics/test-topic/accesspolicy` on the test handler mux.
+func HandlePoliciesSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/instances/%s/topics/accesspolicy", instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, expectedPoliciesRequest)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	th.Mux.HandleFunc(fmt.Sprintf("/instances/%s/topics/test-topic/accesspolicy", instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, getPoliciesResponse)
+	})
+}
@@ -0,0 +1,61 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dms/v2/users"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestLifecycle(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUsersSuccessfully(t)
+
+	opts := users.CreateOpts{
+		Name:     "test-user",
+		Password: "Qwerty123!",
+	}
+	th.AssertNoErr(t, users.Create(fake.ServiceClient(), instanceID, opts).ExtractErr())
+
+	list, err := users.List(fake.ServiceClient(), instanceID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, len(list))
+	th.AssertEquals(t, true, list[0].DefaultApp)
+	th.AssertEquals(t, "test-user", list[1].Name)
+
+	th.AssertNoErr(t, users.Delete(fake.ServiceClient(), instanceID, []string{"test-user"}).ExtractErr())
+}
+
+func TestResetPassword(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandlePasswordSuccessfully(t)
+
+	opts := users.ResetPasswordOpts{NewPassword: "Qwerty456!"}
+	th.AssertNoErr(t, users.ResetPassword(fake.ServiceClient(), instanceID, "test-user", opts).ExtractErr())
+}
+
+func TestPolicies(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandlePoliciesSuccessfully(t)
+
+	opts := users.PoliciesOpts{
+		Topics: []users.TopicPolicies{
+			{
+				Name: "test-topic",
+				Policies: []users.Policy{
+					{UserName: "test-user", AccessPolicy: users.PolicyPublish},
+				},
+			},
+		},
+	}
+	th.AssertNoErr(t, users.UpdatePolicies(fake.ServiceClient(), instanceID, opts).ExtractErr())
+
+	policies, err := users.GetPolicies(fake.ServiceClient(), instanceID, "test-topic").Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(policies.Policies))
+	th.AssertEquals(t, users.PolicyPublish, policies.Policies[0].AccessPolicy)
+}
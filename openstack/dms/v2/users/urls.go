@@ -0,0 +1,25 @@
+package users
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+const resourcePath = "instances"
+
+// rootURL will build the url of creation, deletion and listing
+func rootURL(client *golangsdk.ServiceClient, instanceID string) string {
+	return client.ServiceURL(resourcePath, instanceID, "users")
+}
+
+// resourceURL will build the url of password reset
+func resourceURL(client *golangsdk.ServiceClient, instanceID, name string) string {
+	return client.ServiceURL(resourcePath, instanceID, "users", name)
+}
+
+// policiesURL will build the url of granting permissions
+func policiesURL(client *golangsdk.ServiceClient, instanceID string) string {
+	return client.ServiceURL(resourcePath, instanceID, "topics", "accesspolicy")
+}
+
+// topicPolicyURL will build the url of getting permissions to the topic
+func topicPolicyURL(client *golangsdk.ServiceClient, instanceID, topic string) string {
+	return client.ServiceURL(resourcePath, instanceID, "topics", topic, "accesspolicy")
+}
@@ -100,6 +100,9 @@ type CreateOpts struct {
 	Router *RouterOpts `json:"router,omitempty"`
 
 	Tags []tags.ResourceTag `json:"tags,omitempty"`
+
+	// EnterpriseProjectID is the ID of the enterprise project the zone is created in.
+	EnterpriseProjectID string `json:"enterprise_project_id,omitempty"`
 }
 
 // ToZoneCreateMap formats an CreateOpts structure into a request body.
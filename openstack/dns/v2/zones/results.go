@@ -110,6 +110,9 @@ type Zone struct {
 
 	// Routers associate with the Zone
 	Routers []RouterResult `json:"routers"`
+
+	// EnterpriseProjectID is the ID of the enterprise project the zone belongs to.
+	EnterpriseProjectID string `json:"enterprise_project_id"`
 }
 
 type RouterResult struct {
@@ -0,0 +1,89 @@
+package pools
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
+)
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToPoolCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts contains all the values needed to create a new dedicated storage pool.
+type CreateOpts struct {
+	// Name of the storage pool
+	Name string `json:"name" required:"true"`
+	// AZ in which the storage pool is created
+	AvailabilityZone string `json:"availability_zone" required:"true"`
+	// Disk type of the storage pool, e.g. `SAS` or `SSD`
+	Type string `json:"type" required:"true"`
+	// Capacity of the storage pool, in TB
+	Capacity int `json:"capacity" required:"true"`
+}
+
+// ToPoolCreateMap builds a create request body from CreateOpts.
+func (opts CreateOpts) ToPoolCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "pool")
+}
+
+// Create will create a new dedicated storage pool based on the values in CreateOpts.
+func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToPoolCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(rootURL(client), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return
+}
+
+// Get retrieves a particular dedicated storage pool based on its unique ID.
+func Get(client *golangsdk.ServiceClient, id string) (r GetResult) {
+	_, r.Err = client.Get(resourceURL(client, id), &r.Body, nil)
+	return
+}
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToPoolListQuery() (string, error)
+}
+
+// ListOpts allows filtering of the dedicated storage pool list.
+type ListOpts struct {
+	Name             string `q:"name"`
+	Status           string `q:"status"`
+	AvailabilityZone string `q:"availability_zone"`
+	Limit            int    `q:"limit"`
+	Offset           int    `q:"offset"`
+}
+
+// ToPoolListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToPoolListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), nil
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// dedicated storage pools together with their capacity usage.
+func List(client *golangsdk.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(client)
+	if opts != nil {
+		q, err := opts.ToPoolListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += q
+	}
+
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return PoolPage{pagination.SinglePageBase(r)}
+	})
+}
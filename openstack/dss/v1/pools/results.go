@@ -0,0 +1,85 @@
+package pools
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
+)
+
+// Pool represents a dedicated storage pool.
+type Pool struct {
+	// Storage pool ID
+	ID string `json:"id"`
+	// Storage pool name
+	Name string `json:"name"`
+	// Storage pool status
+	Status string `json:"status"`
+	// ID of the project the storage pool belongs to
+	ProjectID string `json:"project_id"`
+	// AZ of the storage pool
+	AvailabilityZone string `json:"availability_zone"`
+	// Disk type of the storage pool
+	Type string `json:"type"`
+	// Total capacity of the storage pool, in TB
+	Capacity float64 `json:"capacity"`
+	// Capacity allocated to volumes, in GB
+	AllocatedCapacity float64 `json:"allocated_capacity"`
+	// Capacity provisioned to volumes, in GB
+	ProvisionedCapacity float64 `json:"provisioned_capacity"`
+	// Oversubscription ratio of the storage pool
+	MaxOversubscriptionRatio string `json:"max_oversubscription_ratio"`
+	// Creation time
+	CreatedAt string `json:"created_at"`
+}
+
+// CreateResponse contains the identifiers returned by a pool creation request.
+type CreateResponse struct {
+	// ID of the order placed for the storage pool
+	OrderID string `json:"order_id"`
+	// ID of the storage pool
+	PoolID string `json:"pool_id"`
+}
+
+// CreateResult represents the result of a create operation. Call its Extract
+// method to interpret it as a CreateResponse.
+type CreateResult struct {
+	golangsdk.Result
+}
+
+// Extract is a function that accepts a result and extracts a CreateResponse.
+func (r CreateResult) Extract() (*CreateResponse, error) {
+	s := new(CreateResponse)
+	err := r.ExtractInto(s)
+	return s, err
+}
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as a Pool.
+type GetResult struct {
+	golangsdk.Result
+}
+
+// Extract is a function that accepts a result and extracts a Pool.
+func (r GetResult) Extract() (*Pool, error) {
+	s := new(Pool)
+	err := r.ExtractIntoStructPtr(s, "pool")
+	return s, err
+}
+
+// PoolPage is a struct which can do the page function
+type PoolPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty determines whether or not a PoolPage is empty.
+func (r PoolPage) IsEmpty() (bool, error) {
+	pools, err := ExtractPools(r)
+	return len(pools) == 0, err
+}
+
+// ExtractPools interprets the results of a single page from
+// a List() API call, producing a slice of []Pool structures.
+func ExtractPools(r pagination.Page) ([]Pool, error) {
+	var s []Pool
+	err := (r.(PoolPage)).ExtractIntoSlicePtr(&s, "pools")
+	return s, err
+}
@@ -0,0 +1,88 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const poolID = "c5a4e1b6-0a2f-4d0e-9a41-a1d1b6b0c7a3"
+
+const expectedCreateRequest = `
+{
+  "pool": {
+    "name": "dss-pool",
+    "availability_zone": "eu-de-01",
+    "type": "SSD",
+    "capacity": 100
+  }
+}`
+
+var createResponse = fmt.Sprintf(`
+{
+  "order_id": "CS2001011200ABCDE",
+  "pool_id": "%s"
+}`, poolID)
+
+var poolBody = fmt.Sprintf(`
+{
+  "id": "%s",
+  "name": "dss-pool",
+  "status": "available",
+  "project_id": "17fbda95add24720a4038ba4b1c705ed",
+  "availability_zone": "eu-de-01",
+  "type": "SSD",
+  "capacity": 100,
+  "allocated_capacity": 2048,
+  "provisioned_capacity": 4096,
+  "max_oversubscription_ratio": "1.5",
+  "created_at": "2020-06-12T09:41:12.432563"
+}`, poolID)
+
+var getResponse = fmt.Sprintf(`{"pool": %s}`, poolBody)
+
+var listResponse = fmt.Sprintf(`{"pools": [%s], "count": 1}`, poolBody)
+
+// HandleCreateSuccessfully creates an HTTP handler at `/pools` on the test handler mux that
+// responds to a POST request with createResponse.
+func HandleCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/pools", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, expectedCreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, createResponse)
+	})
+}
+
+// HandleGetSuccessfully creates an HTTP handler at `/pools/{pool_id}` on the test handler mux that
+// responds to a GET request with getResponse.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/pools/%s", poolID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, getResponse)
+	})
+}
+
+// HandleListSuccessfully creates an HTTP handler at `/pools` on the test handler mux that responds
+// to a GET request with listResponse.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/pools", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"availability_zone": "eu-de-01"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, listResponse)
+	})
+}
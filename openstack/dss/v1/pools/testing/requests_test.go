@@ -0,0 +1,53 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dss/v1/pools"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSuccessfully(t)
+
+	opts := pools.CreateOpts{
+		Name:             "dss-pool",
+		AvailabilityZone: "eu-de-01",
+		Type:             "SSD",
+		Capacity:         100,
+	}
+	resp, err := pools.Create(fake.ServiceClient(), opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, poolID, resp.PoolID)
+	th.AssertEquals(t, "CS2001011200ABCDE", resp.OrderID)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	pool, err := pools.Get(fake.ServiceClient(), poolID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, poolID, pool.ID)
+	th.AssertEquals(t, "SSD", pool.Type)
+	th.AssertEquals(t, 100.0, pool.Capacity)
+	th.AssertEquals(t, 2048.0, pool.AllocatedCapacity)
+}
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	pages, err := pools.List(fake.ServiceClient(), pools.ListOpts{AvailabilityZone: "eu-de-01"}).AllPages()
+	th.AssertNoErr(t, err)
+	list, err := pools.ExtractPools(pages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(list))
+	th.AssertEquals(t, poolID, list[0].ID)
+	th.AssertEquals(t, 4096.0, list[0].ProvisionedCapacity)
+}
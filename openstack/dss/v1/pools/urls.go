@@ -0,0 +1,11 @@
+package pools
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+func rootURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("pools")
+}
+
+func resourceURL(c *golangsdk.ServiceClient, id string) string {
+	return c.ServiceURL("pools", id)
+}
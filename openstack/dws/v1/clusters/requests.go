@@ -0,0 +1,179 @@
+package clusters
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// CreateOptsBuilder is used for creating cluster parameters.
+// any struct providing the parameters should implement this interface
+type CreateOptsBuilder interface {
+	ToClusterCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts is a struct that contains all the parameters.
+type CreateOpts struct {
+	// Cluster name, which must be unique.
+	// The cluster name contains 4 to 64 characters, which must start with a letter.
+	// Only letters, digits, hyphens (-), and underscores (_) are allowed.
+	Name string `json:"name" required:"true"`
+
+	// Node type, see flavors.List for the available ones.
+	NodeType string `json:"node_type" required:"true"`
+
+	// Number of the cluster nodes. Range: 3–256.
+	NumberOfNode int `json:"number_of_node" required:"true"`
+
+	// Subnet ID, which is used for configuring the cluster network.
+	SubnetID string `json:"subnet_id" required:"true"`
+
+	// ID of the security group.
+	SecurityGroupID string `json:"security_group_id" required:"true"`
+
+	// VPC ID, which is used for configuring the cluster network.
+	VpcID string `json:"vpc_id" required:"true"`
+
+	// AZ of the cluster.
+	AvailabilityZone string `json:"availability_zone,omitempty"`
+
+	// Service port of the cluster. Range: 8000–30000, default: 8000.
+	Port int `json:"port,omitempty"`
+
+	// Administrator username for logging in to the cluster.
+	UserName string `json:"user_name" required:"true"`
+
+	// Administrator password for logging in to the cluster.
+	UserPwd string `json:"user_pwd" required:"true"`
+
+	// Public IP address, the public endpoint is not available when empty.
+	PublicIp *PublicIp `json:"public_ip,omitempty"`
+}
+
+// PublicIp defines the public endpoint of the cluster.
+type PublicIp struct {
+	// Binding type of the EIP: `auto_assign`, `not_use` or `bind_existing`.
+	PublicBindType string `json:"public_bind_type" required:"true"`
+
+	// EIP ID, mandatory for `bind_existing` type.
+	EipID string `json:"eip_id,omitempty"`
+}
+
+// ToClusterCreateMap is used for type convert
+func (opts CreateOpts) ToClusterCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "cluster")
+}
+
+// Create a cluster with given parameters.
+func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToClusterCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Post(rootURL(client), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// DeleteOptsBuilder is used for deleting cluster parameters.
+// any struct providing the parameters should implement this interface
+type DeleteOptsBuilder interface {
+	ToClusterDeleteMap() (map[string]interface{}, error)
+}
+
+// DeleteOpts is a struct that contains all the parameters of deletion.
+type DeleteOpts struct {
+	// Number of the latest manual snapshots to retain.
+	KeepLastManualSnapshot *int `json:"keep_last_manual_snapshot" required:"true"`
+}
+
+// ToClusterDeleteMap is used for type convert
+func (opts DeleteOpts) ToClusterDeleteMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Delete a cluster by id
+func Delete(client *golangsdk.ServiceClient, id string, opts DeleteOptsBuilder) (r DeleteResult) {
+	b, err := opts.ToClusterDeleteMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.DeleteWithBody(resourceURL(client, id), b, &golangsdk.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+// Get a cluster with detailed information by id
+func Get(client *golangsdk.ServiceClient, id string) (r GetResult) {
+	_, r.Err = client.Get(resourceURL(client, id), &r.Body, nil)
+	return
+}
+
+// List returns all the clusters of the project.
+func List(client *golangsdk.ServiceClient) (r ListResult) {
+	_, r.Err = client.Get(rootURL(client), &r.Body, nil)
+	return
+}
+
+// Restart restarts the cluster.
+func Restart(client *golangsdk.ServiceClient, id string) (r ActionResult) {
+	b := map[string]interface{}{
+		"restart": map[string]interface{}{},
+	}
+	_, r.Err = client.Post(restartURL(client, id), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+// ResizeOptsBuilder is used for resizing cluster parameters.
+// any struct providing the parameters should implement this interface
+type ResizeOptsBuilder interface {
+	ToClusterResizeMap() (map[string]interface{}, error)
+}
+
+// ResizeOpts is a struct that contains all the parameters of scaling out.
+type ResizeOpts struct {
+	// Number of the nodes to add.
+	Count int `json:"count" required:"true"`
+}
+
+// ToClusterResizeMap is used for type convert
+func (opts ResizeOpts) ToClusterResizeMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "scale_out")
+}
+
+// Resize scales out the cluster by adding nodes.
+// Use WaitForAvailable to wait for the scaling to finish.
+func Resize(client *golangsdk.ServiceClient, id string, opts ResizeOptsBuilder) (r ActionResult) {
+	b, err := opts.ToClusterResizeMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Post(resizeURL(client, id), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+// BindEip binds the EIP to the cluster as the public endpoint.
+func BindEip(client *golangsdk.ServiceClient, id, eipID string) (r ActionResult) {
+	_, r.Err = client.Post(eipURL(client, id, eipID), nil, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return
+}
+
+// UnbindEip unbinds the EIP from the cluster.
+func UnbindEip(client *golangsdk.ServiceClient, id, eipID string) (r ActionResult) {
+	_, r.Err = client.Delete(eipURL(client, id, eipID), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return
+}
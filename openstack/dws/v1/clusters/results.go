@@ -0,0 +1,108 @@
+package clusters
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// CreateResult is a struct that contains all the return parameters of creation
+type CreateResult struct {
+	golangsdk.Result
+}
+
+// Extract returns the ID of the created cluster
+func (r CreateResult) Extract() (string, error) {
+	var s struct {
+		ID string `json:"id"`
+	}
+	err := r.ExtractIntoStructPtr(&s, "cluster")
+	return s.ID, err
+}
+
+// DeleteResult is a struct which contains the result of deletion
+type DeleteResult struct {
+	golangsdk.ErrResult
+}
+
+// ActionResult is a struct which contains the result of the cluster actions
+type ActionResult struct {
+	golangsdk.ErrResult
+}
+
+// Cluster response
+type Cluster struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// Cluster status: `CREATING`, `AVAILABLE`, `UNAVAILABLE` or `CREATION FAILED`
+	Status string `json:"status"`
+	// Cluster version
+	Version string `json:"version"`
+	Updated string `json:"updated"`
+	Created string `json:"created"`
+	Port    int    `json:"port"`
+	// Private network endpoints
+	Endpoints []Endpoint `json:"endpoints"`
+	Nodes     []Node     `json:"nodes"`
+	UserName  string     `json:"user_name"`
+	// Number of the nodes
+	NumberOfNode     int      `json:"number_of_node"`
+	RecentEvent      int      `json:"recent_event"`
+	AvailabilityZone string   `json:"availability_zone"`
+	NodeType         string   `json:"node_type"`
+	VpcID            string   `json:"vpc_id"`
+	SubnetID         string   `json:"subnet_id"`
+	SecurityGroupID  string   `json:"security_group_id"`
+	PublicIp         PublicIp `json:"public_ip"`
+	// Public network endpoints
+	PublicEndpoints []PublicEndpoint `json:"public_endpoints"`
+	// Ongoing actions, e.g. `{"GROWING": "50%"}`
+	ActionProgress map[string]string `json:"action_progress"`
+	// Sub-status of the available cluster, e.g. `NORMAL` or `READONLY`
+	SubStatus string `json:"sub_status"`
+	// Task status of the cluster, e.g. `RESTARTING` or `GROWING`
+	TaskStatus    string       `json:"task_status"`
+	FailedReasons FailedReason `json:"failed_reasons"`
+}
+
+type Endpoint struct {
+	ConnectInfo string `json:"connect_info"`
+	JdbcUrl     string `json:"jdbc_url"`
+}
+
+type PublicEndpoint struct {
+	PublicConnectInfo string `json:"public_connect_info"`
+	JdbcUrl           string `json:"jdbc_url"`
+}
+
+type Node struct {
+	ID     string `json:"id"`
+	Status string `json:"status"`
+}
+
+type FailedReason struct {
+	ErrorCode string `json:"error_code"`
+	ErrorMsg  string `json:"error_msg"`
+}
+
+// GetResult contains the body of getting detailed
+type GetResult struct {
+	golangsdk.Result
+}
+
+// Extract from GetResult
+func (r GetResult) Extract() (*Cluster, error) {
+	s := new(Cluster)
+	err := r.ExtractIntoStructPtr(s, "cluster")
+	return s, err
+}
+
+// ListResult is a struct from which can get the result of list method
+type ListResult struct {
+	golangsdk.Result
+}
+
+// Extract from ListResult
+func (r ListResult) Extract() ([]Cluster, error) {
+	var s []Cluster
+	err := r.ExtractIntoSlicePtr(&s, "clusters")
+	return s, err
+}
@@ -0,0 +1,143 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const (
+	clusterID = "7d85f602-a948-4a30-afd4-e84f47471c15"
+	eipID     = "8c36a1e6-1a52-4c32-a4b8-3a7f5e3f6d8e"
+)
+
+const expectedCreateRequest = `
+{
+  "cluster": {
+    "name": "dws-test",
+    "node_type": "dws.m3.xlarge",
+    "number_of_node": 3,
+    "subnet_id": "374eca02-cfc4-4de7-8ab5-dbebf7d9a720",
+    "security_group_id": "dc3ec145-9029-4b39-b5a3-ace5a01f772b",
+    "vpc_id": "85b20d7e-9eb7-4b2a-98f3-3c8843ea3574",
+    "availability_zone": "eu-de-01",
+    "port": 8000,
+    "user_name": "dbadmin",
+    "user_pwd": "Qwerty123!",
+    "public_ip": {
+      "public_bind_type": "auto_assign"
+    }
+  }
+}
+`
+
+const clusterBody = `
+{
+  "id": "7d85f602-a948-4a30-afd4-e84f47471c15",
+  "name": "dws-test",
+  "status": "AVAILABLE",
+  "version": "8.1.1",
+  "updated": "2022-10-10T08:20:46",
+  "created": "2022-10-10T08:10:46",
+  "port": 8000,
+  "endpoints": [
+    {
+      "connect_info": "dws-test.dws.otc.t-systems.com:8000",
+      "jdbc_url": "jdbc:postgresql://dws-test.dws.otc.t-systems.com:8000/<YOUR_DATABASE_NAME>"
+    }
+  ],
+  "nodes": [
+    {"id": "acaf62a4-41b4-4106-ba1d-1f3c7a4a6b1c", "status": "200"}
+  ],
+  "user_name": "dbadmin",
+  "number_of_node": 3,
+  "recent_event": 6,
+  "availability_zone": "eu-de-01",
+  "node_type": "dws.m3.xlarge",
+  "vpc_id": "85b20d7e-9eb7-4b2a-98f3-3c8843ea3574",
+  "subnet_id": "374eca02-cfc4-4de7-8ab5-dbebf7d9a720",
+  "security_group_id": "dc3ec145-9029-4b39-b5a3-ace5a01f772b",
+  "public_ip": {
+    "public_bind_type": "auto_assign",
+    "eip_id": "8c36a1e6-1a52-4c32-a4b8-3a7f5e3f6d8e"
+  },
+  "public_endpoints": [
+    {
+      "public_connect_info": "80.158.0.1:8000",
+      "jdbc_url": "jdbc:postgresql://80.158.0.1:8000/<YOUR_DATABASE_NAME>"
+    }
+  ],
+  "action_progress": {},
+  "sub_status": "NORMAL",
+  "task_status": ""
+}
+`
+
+var getResponse = fmt.Sprintf(`{"cluster": %s}`, clusterBody)
+
+// HandleClustersSuccessfully creates an HTTP handler at `/clusters` on the test handler mux that
+// responds to POST and GET requests.
+func HandleClustersSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/clusters", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+		switch r.Method {
+		case "POST":
+			th.TestJSONRequest(t, r, expectedCreateRequest)
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprintf(w, `{"cluster": {"id": "%s"}}`, clusterID)
+		case "GET":
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprintf(w, `{"clusters": [%s]}`, clusterBody)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+}
+
+// HandleClusterSuccessfully creates an HTTP handler at `/clusters/{cluster_id}` on the test handler
+// mux that responds to GET and DELETE requests.
+func HandleClusterSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/clusters/%s", clusterID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		switch r.Method {
+		case "GET":
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprint(w, getResponse)
+		case "DELETE":
+			th.TestJSONRequest(t, r, `{"keep_last_manual_snapshot": 0}`)
+			w.WriteHeader(http.StatusAccepted)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+}
+
+// HandleActionSuccessfully creates an HTTP handler at `/clusters/{cluster_id}/{action}` on the test
+// handler mux that responds to a POST request.
+func HandleActionSuccessfully(t *testing.T, action, expectedRequest string) {
+	th.Mux.HandleFunc(fmt.Sprintf("/clusters/%s/%s", clusterID, action), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, expectedRequest)
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
+
+// HandleEipSuccessfully creates an HTTP handler at `/clusters/{cluster_id}/eips/{eip_id}` on the
+// test handler mux that responds to a POST request.
+func HandleEipSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/clusters/%s/eips/%s", clusterID, eipID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		switch r.Method {
+		case "POST", "DELETE":
+			w.WriteHeader(http.StatusOK)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+}
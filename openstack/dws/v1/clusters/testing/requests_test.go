@@ -0,0 +1,96 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dws/v1/clusters"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleClustersSuccessfully(t)
+
+	opts := clusters.CreateOpts{
+		Name:             "dws-test",
+		NodeType:         "dws.m3.xlarge",
+		NumberOfNode:     3,
+		SubnetID:         "374eca02-cfc4-4de7-8ab5-dbebf7d9a720",
+		SecurityGroupID:  "dc3ec145-9029-4b39-b5a3-ace5a01f772b",
+		VpcID:            "85b20d7e-9eb7-4b2a-98f3-3c8843ea3574",
+		AvailabilityZone: "eu-de-01",
+		Port:             8000,
+		UserName:         "dbadmin",
+		UserPwd:          "Qwerty123!",
+		PublicIp: &clusters.PublicIp{
+			PublicBindType: "auto_assign",
+		},
+	}
+	id, err := clusters.Create(fake.ServiceClient(), opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, clusterID, id)
+}
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleClustersSuccessfully(t)
+
+	list, err := clusters.List(fake.ServiceClient()).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(list))
+	th.AssertEquals(t, clusterID, list[0].ID)
+}
+
+func TestGetAndWait(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleClusterSuccessfully(t)
+
+	cluster, err := clusters.Get(fake.ServiceClient(), clusterID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "AVAILABLE", cluster.Status)
+	th.AssertEquals(t, 3, cluster.NumberOfNode)
+	th.AssertEquals(t, eipID, cluster.PublicIp.EipID)
+	th.AssertEquals(t, "80.158.0.1:8000", cluster.PublicEndpoints[0].PublicConnectInfo)
+
+	th.AssertNoErr(t, clusters.WaitForStatus(fake.ServiceClient(), clusterID, "AVAILABLE", 10))
+	th.AssertNoErr(t, clusters.WaitForAvailable(fake.ServiceClient(), clusterID, 10))
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleClusterSuccessfully(t)
+
+	keep := 0
+	err := clusters.Delete(fake.ServiceClient(), clusterID, clusters.DeleteOpts{KeepLastManualSnapshot: &keep}).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestRestart(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleActionSuccessfully(t, "restart", `{"restart": {}}`)
+
+	th.AssertNoErr(t, clusters.Restart(fake.ServiceClient(), clusterID).ExtractErr())
+}
+
+func TestResize(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleActionSuccessfully(t, "resize", `{"scale_out": {"count": 3}}`)
+
+	th.AssertNoErr(t, clusters.Resize(fake.ServiceClient(), clusterID, clusters.ResizeOpts{Count: 3}).ExtractErr())
+}
+
+func TestEip(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleEipSuccessfully(t)
+
+	th.AssertNoErr(t, clusters.BindEip(fake.ServiceClient(), clusterID, eipID).ExtractErr())
+	th.AssertNoErr(t, clusters.UnbindEip(fake.ServiceClient(), clusterID, eipID).ExtractErr())
+}
@@ -0,0 +1,32 @@
+package clusters
+
+import (
+	"strings"
+
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+const resourcePath = "clusters"
+
+// rootURL will build the url of creation and listing
+func rootURL(client *golangsdk.ServiceClient) string {
+	return client.ServiceURL(resourcePath)
+}
+
+// resourceURL will build the url of get and deletion
+func resourceURL(client *golangsdk.ServiceClient, id string) string {
+	return client.ServiceURL(resourcePath, id)
+}
+
+func restartURL(client *golangsdk.ServiceClient, id string) string {
+	return client.ServiceURL(resourcePath, id, "restart")
+}
+
+func resizeURL(client *golangsdk.ServiceClient, id string) string {
+	return client.ServiceURL(resourcePath, id, "resize")
+}
+
+// eipURL will build the url of EIP binding, which is available in v2 API only
+func eipURL(client *golangsdk.ServiceClient, id, eipID string) string {
+	return strings.Replace(client.ServiceURL(resourcePath, id, "eips", eipID), "/v1.0/", "/v2/", 1)
+}
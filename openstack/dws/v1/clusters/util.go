@@ -0,0 +1,51 @@
+package clusters
+
+import (
+	"fmt"
+
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// WaitForStatus waits until the cluster reaches the status, e.g. `AVAILABLE`.
+// It fails immediately when the cluster turns to `CREATION FAILED`.
+func WaitForStatus(client *golangsdk.ServiceClient, id, status string, secs int) error {
+	return golangsdk.WaitFor(secs, func() (bool, error) {
+		cluster, err := Get(client, id).Extract()
+		if err != nil {
+			return false, err
+		}
+		switch cluster.Status {
+		case status:
+			return true, nil
+		case "CREATION FAILED":
+			return false, fmt.Errorf("DWS cluster %s creation failed: %s", id, cluster.FailedReasons.ErrorMsg)
+		}
+		return false, nil
+	})
+}
+
+// WaitForAvailable waits until the cluster is `AVAILABLE` and has no ongoing
+// actions, e.g. after restart or resize.
+func WaitForAvailable(client *golangsdk.ServiceClient, id string, secs int) error {
+	return golangsdk.WaitFor(secs, func() (bool, error) {
+		cluster, err := Get(client, id).Extract()
+		if err != nil {
+			return false, err
+		}
+		return cluster.Status == "AVAILABLE" && cluster.TaskStatus == "" && len(cluster.ActionProgress) == 0, nil
+	})
+}
+
+// WaitForDeleted waits until the cluster is not found.
+func WaitForDeleted(client *golangsdk.ServiceClient, id string, secs int) error {
+	return golangsdk.WaitFor(secs, func() (bool, error) {
+		_, err := Get(client, id).Extract()
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return true, nil
+			}
+			return false, err
+		}
+		return false, nil
+	})
+}
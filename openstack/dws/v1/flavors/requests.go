@@ -0,0 +1,11 @@
+package flavors
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// List returns the node types available for the clusters.
+func List(client *golangsdk.ServiceClient) (r ListResult) {
+	_, r.Err = client.Get(listURL(client), &r.Body, nil)
+	return
+}
@@ -0,0 +1,32 @@
+package flavors
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// NodeType response
+type NodeType struct {
+	ID string `json:"id"`
+	// Name of the node type, e.g. `dws.m3.xlarge`
+	SpecName string `json:"spec_name"`
+	// Hardware details: `vCPU`, `mem` and `SSD` sizes
+	Detail []Detail `json:"detail"`
+}
+
+type Detail struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+	Unit  string `json:"unit"`
+}
+
+// ListResult is a struct from which can get the result of list method
+type ListResult struct {
+	golangsdk.Result
+}
+
+// Extract from ListResult
+func (r ListResult) Extract() ([]NodeType, error) {
+	var s []NodeType
+	err := r.ExtractIntoSlicePtr(&s, "node_types")
+	return s, err
+}
@@ -0,0 +1,38 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const listResponse = `
+{
+  "node_types": [
+    {
+      "spec_name": "dws.m3.xlarge",
+      "id": "ebe532d6-665f-40e6-a4d4-3c51545b6a67",
+      "detail": [
+        {"type": "vCPU", "value": "4"},
+        {"type": "mem", "value": "32", "unit": "GB"},
+        {"type": "SSD", "value": "160", "unit": "GB"}
+      ]
+    }
+  ]
+}
+`
+
+// HandleListSuccessfully creates an HTTP handler at `/node-types` on the test handler mux that
+// responds to a GET request with listResponse.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/node-types", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, listResponse)
+	})
+}
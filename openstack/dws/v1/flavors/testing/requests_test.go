@@ -0,0 +1,22 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/dws/v1/flavors"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	nodeTypes, err := flavors.List(fake.ServiceClient()).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(nodeTypes))
+	th.AssertEquals(t, "dws.m3.xlarge", nodeTypes[0].SpecName)
+	th.AssertEquals(t, 3, len(nodeTypes[0].Detail))
+	th.AssertEquals(t, "GB", nodeTypes[0].Detail[1].Unit)
+}
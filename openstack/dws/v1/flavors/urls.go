@@ -0,0 +1,12 @@
+package flavors
+
+import (
+	"strings"
+
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// listURL will build the url of node types listing, which is available in v2 API only
+func listURL(client *golangsdk.ServiceClient) string {
+	return strings.Replace(client.ServiceURL("node-types"), "/v1.0/", "/v2/", 1)
+}
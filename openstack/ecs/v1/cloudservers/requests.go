@@ -197,6 +197,9 @@ type ServerExtendParam struct {
 
 	// SupportAutoRecovery specifies whether automatic recovery is enabled on the ECS.
 	SupportAutoRecovery string `json:"support_auto_recovery,omitempty"`
+
+	// EnterpriseProjectID is the ID of the enterprise project the ECS is created in.
+	EnterpriseProjectID string `json:"enterprise_project_id,omitempty"`
 }
 
 type MetaData struct {
@@ -1,7 +1,9 @@
 package cloudservers
 
 import (
+	"context"
 	"fmt"
+	"time"
 
 	"github.com/opentelekomcloud/gophertelekomcloud"
 )
@@ -80,24 +82,39 @@ func (r JobResult) ExtractJobStatus() (*JobStatus, error) {
 }
 
 func WaitForJobSuccess(client *golangsdk.ServiceClient, secs int, jobID string) error {
-	return golangsdk.WaitFor(secs, func() (bool, error) {
+	return WaitForJobSuccessWithContext(context.Background(), client, secs, jobID)
+}
+
+// WaitForJobSuccessWithContext waits for the job to succeed, aborting the polling
+// and any in-flight request as soon as ctx is done.
+func WaitForJobSuccessWithContext(ctx context.Context, client *golangsdk.ServiceClient, secs int, jobID string) error {
+	conf := golangsdk.StateChangeConf{
+		Target:  []string{"SUCCESS"},
+		Refresh: JobStateRefreshFunc(client.WithContext(ctx), jobID),
+		Timeout: time.Duration(secs) * time.Second,
+		Delay:   time.Second,
+	}
+	_, err := conf.WaitForStateContext(ctx)
+	return err
+}
+
+// JobStateRefreshFunc returns a golangsdk.StateRefreshFunc reporting the status of the job.
+// A failed job is reported as an error.
+func JobStateRefreshFunc(client *golangsdk.ServiceClient, jobID string) golangsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
 		job := new(JobStatus)
 		_, err := client.Get(jobURL(client, jobID), &job, nil)
 		if err != nil {
-			return false, err
-		}
-
-		if job.Status == "SUCCESS" {
-			return true, nil
+			return nil, "", err
 		}
 
 		if job.Status == "FAIL" {
 			err = fmt.Errorf("Job failed with code %s: %s.\n", job.ErrorCode, job.FailReason)
-			return false, err
+			return job, job.Status, err
 		}
 
-		return false, nil
-	})
+		return job, job.Status, nil
+	}
 }
 
 func GetJobEntity(client *golangsdk.ServiceClient, jobID string, label string) (interface{}, error) {
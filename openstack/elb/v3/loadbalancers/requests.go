@@ -111,6 +111,9 @@ type CreateOpts struct {
 
 	// IP Target Enable.
 	IpTargetEnable *bool `json:"ip_target_enable,omitempty"`
+
+	// The ID of the enterprise project the Loadbalancer is created in.
+	EnterpriseProjectID string `json:"enterprise_project_id,omitempty"`
 }
 
 type BandwidthRef struct {
@@ -77,6 +77,9 @@ type LoadBalancer struct {
 	// Availability Zone List.
 	AvailabilityZoneList []string `json:"availability_zone_list"`
 
+	// The ID of the enterprise project the Loadbalancer belongs to.
+	EnterpriseProjectID string `json:"enterprise_project_id"`
+
 	// L4 Flavor ID.
 	L4FlavorID string `json:"l4_flavor_id"`
 
@@ -0,0 +1,48 @@
+/*
+Package enterpriseprojects manages enterprise projects of the Enterprise Project Service (EPS).
+
+Resources are created in an enterprise project through the EnterpriseProjectID
+create option of DNS zones, ECS servers, ELB v3 load balancers, VPCs, NAT gateways,
+DCS and DDS instances and SFS Turbo shares. CCE clusters take it as the
+`enterpriseProjectId` extend parameter and SFS shares as the
+`#sys_enterprise_project_id` metadata key. Neutron security groups have no
+enterprise project support; move them with Migrate instead.
+
+Example to Create an Enterprise Project and Move a Server Into It
+
+	project, err := enterpriseprojects.Create(epsClient, enterpriseprojects.CreateOpts{
+		Name:        "production",
+		Description: "production resources",
+	}).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	err = enterpriseprojects.Migrate(epsClient, project.ID, enterpriseprojects.MigrateOpts{
+		ProjectID:    projectID,
+		RegionID:     "eu-de",
+		ResourceType: "ecs",
+		ResourceID:   serverID,
+	}).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to List Servers of an Enterprise Project
+
+	resources, err := enterpriseprojects.FilterResources(epsClient, project.ID, enterpriseprojects.FilterResourcesOpts{
+		Projects:      []string{projectID},
+		ResourceTypes: []string{"ecs"},
+	}).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Disable an Enterprise Project
+
+	err := enterpriseprojects.Disable(epsClient, project.ID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package enterpriseprojects
@@ -0,0 +1,246 @@
+package enterpriseprojects
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+const (
+	// StatusEnabled is the status of an enabled enterprise project.
+	StatusEnabled = 1
+	// StatusDisabled is the status of a disabled enterprise project.
+	StatusDisabled = 2
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to
+// the List request.
+type ListOptsBuilder interface {
+	ToEnterpriseProjectListQuery() (string, error)
+}
+
+// ListOpts allows to filter the List results.
+type ListOpts struct {
+	ID      string `q:"id"`
+	Name    string `q:"name"`
+	Status  int    `q:"status"`
+	Offset  int    `q:"offset"`
+	Limit   int    `q:"limit"`
+	SortKey string `q:"sort_key"`
+	SortDir string `q:"sort_dir"`
+}
+
+// ToEnterpriseProjectListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToEnterpriseProjectListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), nil
+}
+
+// List returns the enterprise projects of the domain.
+func List(client *golangsdk.ServiceClient, opts ListOptsBuilder) (r ListResult) {
+	url := rootURL(client)
+	if opts != nil {
+		query, err := opts.ToEnterpriseProjectListQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += query
+	}
+	_, r.Err = client.Get(url, &r.Body, nil)
+	return
+}
+
+// Get retrieves details of an enterprise project.
+func Get(client *golangsdk.ServiceClient, id string) (r GetResult) {
+	_, r.Err = client.Get(resourceURL(client, id), &r.Body, nil)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to
+// the Create request.
+type CreateOptsBuilder interface {
+	ToEnterpriseProjectCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts contains the options to create an enterprise project.
+type CreateOpts struct {
+	// Name of the enterprise project, "default" is reserved.
+	Name string `json:"name" required:"true"`
+
+	// Description of the enterprise project.
+	Description string `json:"description,omitempty"`
+}
+
+// ToEnterpriseProjectCreateMap builds a create request body from CreateOpts.
+func (opts CreateOpts) ToEnterpriseProjectCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Create creates an enterprise project.
+func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToEnterpriseProjectCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(rootURL(client), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to
+// the Update request.
+type UpdateOptsBuilder interface {
+	ToEnterpriseProjectUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts contains the options to update an enterprise project.
+type UpdateOpts struct {
+	// Name of the enterprise project.
+	Name string `json:"name" required:"true"`
+
+	// Description of the enterprise project.
+	Description *string `json:"description,omitempty"`
+}
+
+// ToEnterpriseProjectUpdateMap builds an update request body from UpdateOpts.
+func (opts UpdateOpts) ToEnterpriseProjectUpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Update updates an enterprise project.
+func Update(client *golangsdk.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToEnterpriseProjectUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(resourceURL(client, id), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+func action(client *golangsdk.ServiceClient, id, action string) (r ActionResult) {
+	b := map[string]interface{}{"action": action}
+	_, r.Err = client.Post(actionURL(client, id), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// Enable enables a disabled enterprise project.
+func Enable(client *golangsdk.ServiceClient, id string) (r ActionResult) {
+	return action(client, id, "enable")
+}
+
+// Disable disables an enterprise project. Enterprise projects can't be deleted,
+// disabling is the only way to retire them.
+func Disable(client *golangsdk.ServiceClient, id string) (r ActionResult) {
+	return action(client, id, "disable")
+}
+
+// MigrateOptsBuilder allows extensions to add additional parameters to
+// the Migrate request.
+type MigrateOptsBuilder interface {
+	ToMigrateMap() (map[string]interface{}, error)
+}
+
+// MigrateOpts contains the options to migrate a resource into an enterprise project.
+type MigrateOpts struct {
+	// ProjectID is the ID of the project the resource belongs to.
+	// Required for region-level resources.
+	ProjectID string `json:"project_id,omitempty"`
+
+	// RegionID is the ID of the region the resource belongs to.
+	RegionID string `json:"region_id,omitempty"`
+
+	// ResourceType is the type of the resource, e.g. "ecs", "disk", "vpc", "eip".
+	ResourceType string `json:"resource_type" required:"true"`
+
+	// ResourceID is the ID of the resource.
+	ResourceID string `json:"resource_id" required:"true"`
+
+	// Associated specifies whether to migrate the associated resources, e.g. disks and EIPs of an ECS.
+	Associated *bool `json:"associated,omitempty"`
+}
+
+// ToMigrateMap builds a migration request body from MigrateOpts.
+func (opts MigrateOpts) ToMigrateMap() (map[string]interface{}, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+	b["action"] = "bind"
+	return b, nil
+}
+
+// Migrate moves a resource into the enterprise project.
+func Migrate(client *golangsdk.ServiceClient, id string, opts MigrateOptsBuilder) (r ActionResult) {
+	b, err := opts.ToMigrateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(migrateURL(client, id), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// Match is a resource filter key/value pair, "resource_name" is the only supported key.
+type Match struct {
+	Key   string `json:"key" required:"true"`
+	Value string `json:"value" required:"true"`
+}
+
+// FilterResourcesOptsBuilder allows extensions to add additional parameters to
+// the FilterResources request.
+type FilterResourcesOptsBuilder interface {
+	ToFilterResourcesMap() (map[string]interface{}, error)
+}
+
+// FilterResourcesOpts contains the options to query the resources of an enterprise project.
+type FilterResourcesOpts struct {
+	// Projects are the IDs of the projects to query.
+	Projects []string `json:"projects,omitempty"`
+
+	// ResourceTypes are the types of the resources to query.
+	ResourceTypes []string `json:"resource_types" required:"true"`
+
+	// Offset is the index position of the query.
+	Offset int `json:"offset,omitempty"`
+
+	// Limit is the number of records to return, 1 to 1000.
+	Limit int `json:"limit,omitempty"`
+
+	// Matches are the resource filters.
+	Matches []Match `json:"matches,omitempty"`
+}
+
+// ToFilterResourcesMap builds a filter request body from FilterResourcesOpts.
+func (opts FilterResourcesOpts) ToFilterResourcesMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// FilterResources queries the resources of the enterprise project.
+func FilterResources(client *golangsdk.ServiceClient, id string, opts FilterResourcesOptsBuilder) (r FilterResourcesResult) {
+	b, err := opts.ToFilterResourcesMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(filterResourcesURL(client, id), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// GetQuotas retrieves the enterprise project quota.
+func GetQuotas(client *golangsdk.ServiceClient) (r QuotaResult) {
+	_, r.Err = client.Get(quotasURL(client), &r.Body, nil)
+	return
+}
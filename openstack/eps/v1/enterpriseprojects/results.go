@@ -0,0 +1,124 @@
+package enterpriseprojects
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// EnterpriseProject represents an enterprise project.
+type EnterpriseProject struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	// Status is either StatusEnabled or StatusDisabled.
+	Status    int    `json:"status"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+type commonResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as an EnterpriseProject.
+func (r commonResult) Extract() (*EnterpriseProject, error) {
+	var s struct {
+		EnterpriseProject *EnterpriseProject `json:"enterprise_project"`
+	}
+	err := r.ExtractInto(&s)
+	return s.EnterpriseProject, err
+}
+
+// CreateResult is the result of a Create request.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult is the result of a Get request.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult is the result of an Update request.
+type UpdateResult struct {
+	commonResult
+}
+
+// ActionResult is the result of Enable, Disable and Migrate requests.
+// Call its ExtractErr method to determine if the request succeeded or failed.
+type ActionResult struct {
+	golangsdk.ErrResult
+}
+
+// ListResult is the result of a List request.
+type ListResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as a slice of EnterpriseProject.
+func (r ListResult) Extract() ([]EnterpriseProject, error) {
+	var s struct {
+		EnterpriseProjects []EnterpriseProject `json:"enterprise_projects"`
+	}
+	err := r.ExtractInto(&s)
+	return s.EnterpriseProjects, err
+}
+
+// Resource is a resource belonging to an enterprise project.
+type Resource struct {
+	EnterpriseProjectID string `json:"enterprise_project_id"`
+	ProjectID           string `json:"project_id"`
+	ProjectName         string `json:"project_name"`
+	ResourceType        string `json:"resource_type"`
+	ResourceID          string `json:"resource_id"`
+	ResourceName        string `json:"resource_name"`
+}
+
+// ResourceError is an error returned for a project that couldn't be queried.
+type ResourceError struct {
+	ProjectID    string `json:"project_id"`
+	ResourceType string `json:"resource_type"`
+	ErrorCode    string `json:"error_code"`
+	ErrorMsg     string `json:"error_msg"`
+}
+
+// FilteredResources is the response of a FilterResources request.
+type FilteredResources struct {
+	Resources  []Resource      `json:"resources"`
+	Errors     []ResourceError `json:"errors"`
+	TotalCount int             `json:"total_count"`
+}
+
+// FilterResourcesResult is the result of a FilterResources request.
+type FilterResourcesResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as FilteredResources.
+func (r FilterResourcesResult) Extract() (*FilteredResources, error) {
+	var s FilteredResources
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// Quota is the enterprise project quota.
+type Quota struct {
+	Type  string `json:"type"`
+	Quota int    `json:"quota"`
+	Used  int    `json:"used"`
+}
+
+// QuotaResult is the result of a GetQuotas request.
+type QuotaResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as a slice of Quota.
+func (r QuotaResult) Extract() ([]Quota, error) {
+	var s struct {
+		Quotas struct {
+			Resources []Quota `json:"resources"`
+		} `json:"quotas"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Quotas.Resources, err
+}
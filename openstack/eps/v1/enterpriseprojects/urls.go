@@ -0,0 +1,29 @@
+package enterpriseprojects
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+const rootPath = "enterprise-projects"
+
+func rootURL(client *golangsdk.ServiceClient) string {
+	return client.ServiceURL(rootPath)
+}
+
+func resourceURL(client *golangsdk.ServiceClient, id string) string {
+	return client.ServiceURL(rootPath, id)
+}
+
+func actionURL(client *golangsdk.ServiceClient, id string) string {
+	return client.ServiceURL(rootPath, id, "action")
+}
+
+func migrateURL(client *golangsdk.ServiceClient, id string) string {
+	return client.ServiceURL(rootPath, id, "resources-migrate")
+}
+
+func filterResourcesURL(client *golangsdk.ServiceClient, id string) string {
+	return client.ServiceURL(rootPath, id, "resources", "filter")
+}
+
+func quotasURL(client *golangsdk.ServiceClient) string {
+	return client.ServiceURL(rootPath, "quotas")
+}
@@ -0,0 +1,11 @@
+package quotas
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// Get retrieves EVS quotas of the project together with the quota usage.
+func Get(client *golangsdk.ServiceClient) (r GetResult) {
+	_, r.Err = client.Get(getURL(client)+"?usage=True", &r.Body, nil)
+	return
+}
@@ -0,0 +1,77 @@
+package quotas
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// QuotaDetail contains usage details of a single quota.
+type QuotaDetail struct {
+	// the number of used resources
+	InUse int `json:"in_use"`
+	// the maximum number of resources, -1 means unlimited
+	Limit int `json:"limit"`
+	// the number of reserved resources
+	Reserved int `json:"reserved"`
+}
+
+// QuotaSet contains EVS quotas of the project.
+type QuotaSet struct {
+	// the project ID
+	ID string `json:"id"`
+	// the number of EVS disks
+	Volumes QuotaDetail `json:"volumes"`
+	// the number of snapshots
+	Snapshots QuotaDetail `json:"snapshots"`
+	// the total size (GB) of EVS disks and snapshots
+	Gigabytes QuotaDetail `json:"gigabytes"`
+	// the number of backups
+	Backups QuotaDetail `json:"backups"`
+	// the total size (GB) of backups
+	BackupGigabytes QuotaDetail `json:"backup_gigabytes"`
+	// the size (GB) of a single EVS disk
+	PerVolumeGigabytes QuotaDetail `json:"per_volume_gigabytes"`
+	// VolumeTypes contains quotas of specific volume types, e.g. `volumes_SSD` or `gigabytes_SAS`
+	VolumeTypes map[string]QuotaDetail `json:"-"`
+}
+
+func (r *QuotaSet) UnmarshalJSON(b []byte) error {
+	type tmp QuotaSet
+	var s tmp
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*r = QuotaSet(s)
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	r.VolumeTypes = make(map[string]QuotaDetail)
+	for key, value := range raw {
+		if !strings.HasPrefix(key, "volumes_") && !strings.HasPrefix(key, "snapshots_") && !strings.HasPrefix(key, "gigabytes_") {
+			continue
+		}
+		detail := QuotaDetail{}
+		if err := json.Unmarshal(value, &detail); err != nil {
+			return err
+		}
+		r.VolumeTypes[key] = detail
+	}
+	return nil
+}
+
+type GetResult struct {
+	golangsdk.Result
+}
+
+// Extract is a function that accepts a result and extracts a QuotaSet.
+func (r GetResult) Extract() (*QuotaSet, error) {
+	var s struct {
+		QuotaSet *QuotaSet `json:"quota_set"`
+	}
+	err := r.ExtractInto(&s)
+	return s.QuotaSet, err
+}
@@ -0,0 +1,71 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const getResponse = `
+{
+  "quota_set": {
+    "id": "cd631140887d4b6e9c786b67a6dd4c02",
+    "volumes": {
+      "in_use": 2,
+      "limit": 150,
+      "reserved": 0
+    },
+    "snapshots": {
+      "in_use": 1,
+      "limit": 150,
+      "reserved": 0
+    },
+    "gigabytes": {
+      "in_use": 80,
+      "limit": 48000,
+      "reserved": 0
+    },
+    "backups": {
+      "in_use": 0,
+      "limit": 1000,
+      "reserved": 0
+    },
+    "backup_gigabytes": {
+      "in_use": 0,
+      "limit": 1024000,
+      "reserved": 0
+    },
+    "per_volume_gigabytes": {
+      "in_use": 0,
+      "limit": 32768,
+      "reserved": 0
+    },
+    "volumes_SSD": {
+      "in_use": 1,
+      "limit": -1,
+      "reserved": 0
+    },
+    "gigabytes_SSD": {
+      "in_use": 40,
+      "limit": -1,
+      "reserved": 0
+    }
+  }
+}`
+
+// HandleGetSuccessfully creates an HTTP handler at `/cloudvolumes/quotas` on the test handler mux
+// that responds to a GET request with getResponse.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/cloudvolumes/quotas", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"usage": "True"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, getResponse)
+	})
+}
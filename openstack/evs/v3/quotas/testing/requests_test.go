@@ -0,0 +1,24 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/evs/v3/quotas"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	quota, err := quotas.Get(fake.ServiceClient()).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "cd631140887d4b6e9c786b67a6dd4c02", quota.ID)
+	th.AssertDeepEquals(t, quotas.QuotaDetail{InUse: 2, Limit: 150}, quota.Volumes)
+	th.AssertEquals(t, 48000, quota.Gigabytes.Limit)
+	th.AssertEquals(t, 32768, quota.PerVolumeGigabytes.Limit)
+	th.AssertEquals(t, 2, len(quota.VolumeTypes))
+	th.AssertDeepEquals(t, quotas.QuotaDetail{InUse: 40, Limit: -1}, quota.VolumeTypes["gigabytes_SSD"])
+}
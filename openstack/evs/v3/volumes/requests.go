@@ -2,6 +2,7 @@ package volumes
 
 import (
 	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
 )
 
 // CreateOptsBuilder allows extensions to add additional parameters to the
@@ -42,12 +43,34 @@ type CreateOpts struct {
 	Tags map[string]string `json:"tags,omitempty"`
 	// the enterprise project id
 	EnterpriseProjectID string `json:"enterprise_project_id,omitempty"`
+	// Scheduling options, e.g. the dedicated storage pool to create the volume in
+	SchedulerHints *SchedulerHints `json:"-"`
+}
+
+// SchedulerHints represents a set of scheduling hints that are passed to the
+// EVS scheduler alongside the volume.
+type SchedulerHints struct {
+	// ID of the dedicated storage pool (DSS) the volume is created in
+	DedicatedStorageID string `json:"dedicated_storage_id,omitempty"`
 }
 
 // ToVolumeCreateMap assembles a request body based on the contents of a
 // CreateOpts.
 func (opts CreateOpts) ToVolumeCreateMap() (map[string]interface{}, error) {
-	return golangsdk.BuildRequestBody(opts, "volume")
+	b, err := golangsdk.BuildRequestBody(opts, "volume")
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.SchedulerHints != nil {
+		hints, err := golangsdk.BuildRequestBody(opts.SchedulerHints, "")
+		if err != nil {
+			return nil, err
+		}
+		b["OS-SCH-HNT:scheduler_hints"] = hints
+	}
+
+	return b, nil
 }
 
 // Create will create a new Volume based on the values in CreateOpts.
@@ -69,3 +92,64 @@ func Get(client *golangsdk.ServiceClient, id string) (r GetResult) {
 	_, r.Err = client.Get(getURL(client, id), &r.Body, nil)
 	return
 }
+
+// ListOptsBuilder allows extensions to add additional parameters to the List
+// request.
+type ListOptsBuilder interface {
+	ToVolumeListQuery() (string, error)
+}
+
+// ListOpts holds options for listing Volumes. It is passed to the volumes.List
+// function.
+type ListOpts struct {
+	// Name will filter by the specified volume name.
+	Name string `q:"name"`
+	// Status will filter by the specified status.
+	Status string `q:"status"`
+	// AvailabilityZone will filter by the specified availability zone.
+	AvailabilityZone string `q:"availability_zone"`
+	// VolumeTypeID will filter by the specified volume type ID.
+	VolumeTypeID string `q:"volume_type_id"`
+	// DedicatedStorageID will filter volumes located in the specified dedicated storage pool.
+	DedicatedStorageID string `q:"dedicated_storage_id"`
+	// DedicatedStorageName will filter volumes located in the dedicated storage pool with the specified name.
+	DedicatedStorageName string `q:"dedicated_storage_name"`
+	// Multiattach will filter shared volumes.
+	Multiattach *bool `q:"multiattach"`
+	// EnterpriseProjectID will filter by the specified enterprise project.
+	EnterpriseProjectID string `q:"enterprise_project_id"`
+	// Comma-separated list of sort keys and optional sort directions in the
+	// form of <key>[:<direction>].
+	Sort string `q:"sort"`
+	// Requests a page size of items.
+	Limit int `q:"limit"`
+	// Used in conjunction with limit to return a slice of items.
+	Offset int `q:"offset"`
+	// The ID of the last-seen item.
+	Marker string `q:"marker"`
+}
+
+// ToVolumeListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToVolumeListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), err
+}
+
+// List returns Volumes optionally limited by the conditions provided in ListOpts.
+func List(client *golangsdk.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client)
+	if opts != nil {
+		query, err := opts.ToVolumeListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return VolumePage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
 )
 
 type Attachment struct {
@@ -82,6 +83,10 @@ type Volume struct {
 	WWN string `json:"wwn"`
 	// enterprise project ID bound to the volume
 	EnterpriseProjectID string `json:"enterprise_project_id"`
+	// ID of the dedicated storage pool housing the volume
+	DedicatedStorageID string `json:"dedicated_storage_id"`
+	// Name of the dedicated storage pool housing the volume
+	DedicatedStorageName string `json:"dedicated_storage_name"`
 }
 
 func (r *Volume) UnmarshalJSON(b []byte) error {
@@ -123,3 +128,34 @@ func (r commonResult) ExtractInto(v interface{}) error {
 type GetResult struct {
 	commonResult
 }
+
+// VolumePage is a pagination.pager that is returned from a call to the List function.
+type VolumePage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty returns true if a ListResult contains no Volumes.
+func (r VolumePage) IsEmpty() (bool, error) {
+	volumes, err := ExtractVolumes(r)
+	return len(volumes) == 0, err
+}
+
+// NextPageURL uses the response's embedded link reference to navigate to the
+// next page of results.
+func (r VolumePage) NextPageURL() (string, error) {
+	var s struct {
+		Links []golangsdk.Link `json:"volumes_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return golangsdk.ExtractNextURL(s.Links)
+}
+
+// ExtractVolumes extracts and returns Volumes. It is used while iterating over a volumes.List call.
+func ExtractVolumes(r pagination.Page) ([]Volume, error) {
+	var s []Volume
+	err := r.(VolumePage).Result.ExtractIntoSlicePtr(&s, "volumes")
+	return s, err
+}
@@ -0,0 +1,80 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const (
+	poolID   = "c5a4e1b6-0a2f-4d0e-9a41-a1d1b6b0c7a3"
+	volumeID = "6edbc2f4-1507-44f8-ac0d-eed1d2608d38"
+)
+
+var expectedCreateRequest = fmt.Sprintf(`
+{
+  "volume": {
+    "availability_zone": "eu-de-01",
+    "volume_type": "SSD",
+    "name": "dss-volume",
+    "size": 40
+  },
+  "OS-SCH-HNT:scheduler_hints": {
+    "dedicated_storage_id": "%s"
+  }
+}`, poolID)
+
+const createResponse = `
+{
+  "job_id": "70a599e0-31e7-49b7-b260-868f441e862b"
+}`
+
+var listResponse = fmt.Sprintf(`
+{
+  "volumes": [
+    {
+      "id": "%s",
+      "name": "dss-volume",
+      "status": "available",
+      "size": 40,
+      "availability_zone": "eu-de-01",
+      "volume_type": "SSD",
+      "dedicated_storage_id": "%s",
+      "dedicated_storage_name": "dss-pool",
+      "created_at": "2020-06-12T09:41:12.432563",
+      "updated_at": "2020-06-12T09:42:12.432563"
+    }
+  ],
+  "count": 1
+}`, volumeID, poolID)
+
+// HandleCreateSuccessfully creates an HTTP handler at `/cloudvolumes` on the test handler mux that
+// responds to a POST request with createResponse.
+func HandleCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/cloudvolumes", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, expectedCreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, createResponse)
+	})
+}
+
+// HandleListSuccessfully creates an HTTP handler at `/cloudvolumes/detail` on the test handler mux
+// that responds to a GET request with listResponse.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/cloudvolumes/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"dedicated_storage_id": poolID})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, listResponse)
+	})
+}
@@ -0,0 +1,43 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/evs/v3/volumes"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestCreateInDedicatedStorage(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSuccessfully(t)
+
+	opts := volumes.CreateOpts{
+		AvailabilityZone: "eu-de-01",
+		VolumeType:       "SSD",
+		Name:             "dss-volume",
+		Size:             40,
+		SchedulerHints: &volumes.SchedulerHints{
+			DedicatedStorageID: poolID,
+		},
+	}
+	job, err := volumes.Create(fake.ServiceClient(), opts).ExtractJobResponse()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "70a599e0-31e7-49b7-b260-868f441e862b", job.JobID)
+}
+
+func TestListByDedicatedStorage(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	pages, err := volumes.List(fake.ServiceClient(), volumes.ListOpts{DedicatedStorageID: poolID}).AllPages()
+	th.AssertNoErr(t, err)
+	list, err := volumes.ExtractVolumes(pages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(list))
+	th.AssertEquals(t, volumeID, list[0].ID)
+	th.AssertEquals(t, poolID, list[0].DedicatedStorageID)
+	th.AssertEquals(t, "dss-pool", list[0].DedicatedStorageName)
+}
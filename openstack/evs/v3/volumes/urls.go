@@ -9,3 +9,7 @@ func createURL(c *golangsdk.ServiceClient) string {
 func getURL(c *golangsdk.ServiceClient, id string) string {
 	return c.ServiceURL("os-vendor-volumes", id)
 }
+
+func listURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("cloudvolumes", "detail")
+}
@@ -0,0 +1,91 @@
+/*
+Package functions manages FunctionGraph functions, their versions, aliases and reserved instances.
+
+Example to Create a Function With Inline Code
+
+	code := base64.StdEncoding.EncodeToString([]byte(source))
+	function, err := functions.Create(fgsClient, functions.CreateOpts{
+		FuncName:    "hello",
+		Package:     "default",
+		Runtime:     functions.RuntimePython39,
+		Timeout:     30,
+		Handler:     "index.handler",
+		MemorySize:  128,
+		CodeType:    functions.CodeTypeInline,
+		FuncCode:    &functions.FuncCode{File: code},
+		Environment: map[string]string{"GREETING": "hello"},
+	}).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Create a Function From OBS
+
+	function, err := functions.Create(fgsClient, functions.CreateOpts{
+		FuncName:   "hello",
+		Package:    "default",
+		Runtime:    functions.RuntimeJava11,
+		Timeout:    30,
+		Handler:    "com.example.Handler.handle",
+		MemorySize: 512,
+		CodeType:   functions.CodeTypeOBS,
+		CodeURL:    "https://my-bucket.obs.eu-de.otc.t-systems.com/hello.jar",
+	}).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Publish a Version and Point an Alias to It
+
+	version, err := functions.PublishVersion(fgsClient, function.FuncURN, functions.PublishVersionOpts{
+		Version: "v1",
+	}).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	alias, err := functions.CreateAlias(fgsClient, function.FuncURN, functions.AliasOpts{
+		Name:    "production",
+		Version: version.Version,
+	}).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Reserve Instances for an Alias
+
+	_, err := functions.UpdateReservedInstances(fgsClient, alias.AliasURN, 2).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Invoke a Function Synchronously
+
+	invocation, err := functions.Invoke(fgsClient, alias.AliasURN, functions.InvokeOpts{
+		Event:   map[string]string{"name": "world"},
+		TailLog: true,
+	}).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Invoke a Function Asynchronously and Wait for It
+
+	requestID, err := functions.InvokeAsync(fgsClient, function.FuncURN, event).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	invocation, err := functions.WaitForAsyncInvocation(fgsClient, function.FuncURN, requestID, 300)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Function
+
+	err := functions.Delete(fgsClient, function.FuncURN).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package functions
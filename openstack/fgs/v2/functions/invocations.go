@@ -0,0 +1,126 @@
+package functions
+
+import (
+	"fmt"
+
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// Asynchronous invocation statuses.
+const (
+	InvocationStatusWait    = "WAIT"
+	InvocationStatusRunning = "RUNNING"
+	InvocationStatusSuccess = "SUCCESS"
+	InvocationStatusFail    = "FAIL"
+	InvocationStatusDiscard = "DISCARD"
+)
+
+// InvokeOpts contains the options of a synchronous invocation.
+type InvokeOpts struct {
+	// Event is passed to the function, it's serialized as JSON.
+	Event interface{}
+	// TailLog requests the last 2 KB of the execution log in the response.
+	TailLog bool
+}
+
+// Invoke executes a function synchronously and waits for its result.
+// The URN can be qualified with a version or an alias.
+func Invoke(client *golangsdk.ServiceClient, urn string, opts InvokeOpts) (r InvokeResult) {
+	event := opts.Event
+	if event == nil {
+		event = map[string]interface{}{}
+	}
+	headers := map[string]string{}
+	if opts.TailLog {
+		headers["X-Cff-Log-Type"] = "tail"
+	}
+	_, r.Err = client.Post(invokeURL(client, urn), event, &r.Body, &golangsdk.RequestOpts{
+		OkCodes:     []int{200},
+		MoreHeaders: headers,
+	})
+	return
+}
+
+// InvokeAsync executes a function asynchronously. Use GetAsyncInvocation or
+// WaitForAsyncInvocation with the returned request ID to track the execution.
+func InvokeAsync(client *golangsdk.ServiceClient, urn string, event interface{}) (r InvokeAsyncResult) {
+	if event == nil {
+		event = map[string]interface{}{}
+	}
+	_, r.Err = client.Post(invokeAsyncURL(client, urn), event, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+// ListAsyncInvocationsOpts allows to filter the asynchronous invocations.
+type ListAsyncInvocationsOpts struct {
+	RequestID string `q:"request_id"`
+	// Status is one of the InvocationStatus* constants.
+	Status string `q:"status"`
+	Marker string `q:"marker"`
+	Limit  string `q:"limit"`
+	// QueryBeginTime and QueryEndTime are in the "YYYY-MM-DDTHH:MM:SSZ" format.
+	QueryBeginTime string `q:"query_begin_time"`
+	QueryEndTime   string `q:"query_end_time"`
+}
+
+// ListAsyncInvocations retrieves the asynchronous invocations of a function.
+func ListAsyncInvocations(client *golangsdk.ServiceClient, urn string, opts ListAsyncInvocationsOpts) (r AsyncInvocationsResult) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Get(asyncInvocationsURL(client, urn)+q.String(), &r.Body, nil)
+	return
+}
+
+// GetAsyncInvocation retrieves the status of a single asynchronous invocation.
+func GetAsyncInvocation(client *golangsdk.ServiceClient, urn, requestID string) (*AsyncInvocation, error) {
+	invocations, err := ListAsyncInvocations(client, urn, ListAsyncInvocationsOpts{RequestID: requestID}).Extract()
+	if err != nil {
+		return nil, err
+	}
+	if len(invocations) == 0 {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return &invocations[0], nil
+}
+
+// WaitForAsyncInvocation polls the asynchronous invocation until it finishes.
+// An error is returned if the invocation failed or was discarded.
+func WaitForAsyncInvocation(client *golangsdk.ServiceClient, urn, requestID string, secs int) (*AsyncInvocation, error) {
+	var invocation *AsyncInvocation
+	err := golangsdk.WaitFor(secs, func() (bool, error) {
+		current, err := GetAsyncInvocation(client, urn, requestID)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return false, nil
+			}
+			return false, err
+		}
+		invocation = current
+		switch current.Status {
+		case InvocationStatusSuccess:
+			return true, nil
+		case InvocationStatusFail, InvocationStatusDiscard:
+			return false, fmt.Errorf("invocation %s finished with status %s: %s", requestID, current.Status, current.ErrorMessage)
+		}
+		return false, nil
+	})
+	return invocation, err
+}
+
+// GetStatistics retrieves the execution metrics of a function
+// for the given period in minutes, e.g. "60".
+func GetStatistics(client *golangsdk.ServiceClient, urn, period string) (r StatisticsResult) {
+	_, r.Err = client.Get(statisticsURL(client, urn, period), &r.Body, nil)
+	return
+}
+
+// GetLogConfig retrieves the LTS log group and stream receiving the execution logs of a function.
+func GetLogConfig(client *golangsdk.ServiceClient, urn string) (r LogConfigResult) {
+	_, r.Err = client.Get(logConfigURL(client, urn), &r.Body, nil)
+	return
+}
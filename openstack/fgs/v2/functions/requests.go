@@ -0,0 +1,428 @@
+package functions
+
+import (
+	"encoding/json"
+
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
+)
+
+// Supported function runtimes.
+const (
+	RuntimePython27  = "Python2.7"
+	RuntimePython36  = "Python3.6"
+	RuntimePython39  = "Python3.9"
+	RuntimeGo1x      = "Go1.x"
+	RuntimeJava8     = "Java8"
+	RuntimeJava11    = "Java11"
+	RuntimeNodeJS12  = "Node.js12.13"
+	RuntimeNodeJS14  = "Node.js14.18"
+	RuntimeCSharp31  = "C#(.NET Core 3.1)"
+	RuntimePHP73     = "PHP7.3"
+	RuntimeCustom    = "Custom"
+	RuntimeHTTP      = "http"
+	RuntimeContainer = "Custom Image"
+)
+
+// Supported code types.
+const (
+	// CodeTypeInline is the code edited inline, FuncCode.File is the base64 encoded source.
+	CodeTypeInline = "inline"
+	// CodeTypeZip is a ZIP package, FuncCode.File is the base64 encoded archive.
+	CodeTypeZip = "zip"
+	// CodeTypeJar is a JAR package, FuncCode.File is the base64 encoded archive.
+	CodeTypeJar = "jar"
+	// CodeTypeOBS is a package stored in OBS, CodeURL is the object URL.
+	CodeTypeOBS = "obs"
+)
+
+// FuncCode is the function code.
+type FuncCode struct {
+	// File is the base64 encoded function code or package.
+	File string `json:"file,omitempty"`
+	// Link is the URL of the function code.
+	Link string `json:"link,omitempty"`
+}
+
+// FuncVpc configures the access of the function to a VPC.
+// Accessing a VPC requires an agency (Xrole) with VPC administrator permissions.
+type FuncVpc struct {
+	VpcID    string `json:"vpc_id" required:"true"`
+	SubnetID string `json:"subnet_id" required:"true"`
+}
+
+// StrategyConfig configures the function execution strategy.
+type StrategyConfig struct {
+	// Concurrency is the maximum number of instances, 0 disables the function, -1 removes the limit.
+	Concurrency *int `json:"concurrency,omitempty"`
+}
+
+// ListOptsBuilder allows extensions to add additional parameters to the List request.
+type ListOptsBuilder interface {
+	ToFunctionListQuery() (string, error)
+}
+
+// ListOpts allows to filter the listed functions.
+type ListOpts struct {
+	// PackageName is the name of the function group.
+	PackageName string `q:"package_name"`
+	// Marker is the index to start the query from.
+	Marker string `q:"marker"`
+	// MaxItems is the maximum number of functions per page, 400 at most.
+	MaxItems string `q:"maxitems"`
+}
+
+// ToFunctionListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToFunctionListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), nil
+}
+
+// List returns a Pager which allows you to iterate over the functions of the project.
+func List(client *golangsdk.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(client)
+	if opts != nil {
+		query, err := opts.ToFunctionListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		p := FunctionPage{pagination.MarkerPageBase{PageResult: r}}
+		p.MarkerPageBase.Owner = p
+		return p
+	})
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the Create request.
+type CreateOptsBuilder interface {
+	ToFunctionCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts contains the options to create a function.
+type CreateOpts struct {
+	// FuncName is the function name.
+	FuncName string `json:"func_name" required:"true"`
+	// Package is the function group the function belongs to, "default" is used mostly.
+	Package string `json:"package" required:"true"`
+	// Runtime is one of the Runtime* constants.
+	Runtime string `json:"runtime" required:"true"`
+	// Timeout is the maximum execution duration in seconds, 3 to 900.
+	Timeout int `json:"timeout" required:"true"`
+	// Handler is the function entry point, e.g. "index.handler".
+	Handler string `json:"handler" required:"true"`
+	// MemorySize is the memory in MB, e.g. 128, 256, 512 ... 4096.
+	MemorySize int `json:"memory_size" required:"true"`
+	// CodeType is one of the CodeType* constants.
+	CodeType string `json:"code_type" required:"true"`
+	// CodeURL is the OBS URL of the code package if CodeType is CodeTypeOBS.
+	CodeURL string `json:"code_url,omitempty"`
+	// CodeFilename is the name of the uploaded package if CodeType is CodeTypeZip or CodeTypeJar.
+	CodeFilename string `json:"code_filename,omitempty"`
+	// FuncCode is the function code if CodeType isn't CodeTypeOBS.
+	FuncCode *FuncCode `json:"func_code,omitempty"`
+	// Environment are the environment variables of the function.
+	Environment map[string]string `json:"-"`
+	// EncryptedEnvironment are the environment variables of the function stored encrypted.
+	EncryptedEnvironment map[string]string `json:"-"`
+	// Xrole is the name of the agency used by the function to access other services.
+	Xrole string `json:"xrole,omitempty"`
+	// AppXrole is the name of the agency used by the function code.
+	AppXrole string `json:"app_xrole,omitempty"`
+	// Description of the function.
+	Description string `json:"description,omitempty"`
+	// InitializerHandler is the initializer entry point.
+	InitializerHandler string `json:"initializer_handler,omitempty"`
+	// InitializerTimeout is the maximum initializer duration in seconds, 1 to 300.
+	InitializerTimeout int `json:"initializer_timeout,omitempty"`
+	// EnterpriseProjectID is the ID of the enterprise project the function is created in.
+	EnterpriseProjectID string `json:"enterprise_project_id,omitempty"`
+}
+
+// ToFunctionCreateMap builds a create request body from CreateOpts.
+func (opts CreateOpts) ToFunctionCreateMap() (map[string]interface{}, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+	if err := setEnvironment(b, opts.Environment, opts.EncryptedEnvironment); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// setEnvironment serializes the environment variables into the user_data fields,
+// the API expects them as JSON strings.
+func setEnvironment(b map[string]interface{}, env, encrypted map[string]string) error {
+	if env != nil {
+		data, err := json.Marshal(env)
+		if err != nil {
+			return err
+		}
+		b["user_data"] = string(data)
+	}
+	if encrypted != nil {
+		data, err := json.Marshal(encrypted)
+		if err != nil {
+			return err
+		}
+		b["encrypted_user_data"] = string(data)
+	}
+	return nil
+}
+
+// Create creates a function.
+func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToFunctionCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(rootURL(client), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// GetConfig retrieves the metadata of a function.
+// The URN can be qualified with a version or an alias.
+func GetConfig(client *golangsdk.ServiceClient, urn string) (r GetResult) {
+	_, r.Err = client.Get(configURL(client, urn), &r.Body, nil)
+	return
+}
+
+// UpdateConfigOptsBuilder allows extensions to add additional parameters to the UpdateConfig request.
+type UpdateConfigOptsBuilder interface {
+	ToFunctionUpdateConfigMap() (map[string]interface{}, error)
+}
+
+// UpdateConfigOpts contains the options to update the function metadata.
+type UpdateConfigOpts struct {
+	FuncName             string            `json:"func_name" required:"true"`
+	Runtime              string            `json:"runtime" required:"true"`
+	Timeout              int               `json:"timeout" required:"true"`
+	Handler              string            `json:"handler" required:"true"`
+	MemorySize           int               `json:"memory_size" required:"true"`
+	Environment          map[string]string `json:"-"`
+	EncryptedEnvironment map[string]string `json:"-"`
+	Xrole                string            `json:"xrole,omitempty"`
+	AppXrole             string            `json:"app_xrole,omitempty"`
+	Description          *string           `json:"description,omitempty"`
+	// FuncVpc enables the VPC access of the function.
+	FuncVpc            *FuncVpc        `json:"func_vpc,omitempty"`
+	StrategyConfig     *StrategyConfig `json:"strategy_config,omitempty"`
+	InitializerHandler string          `json:"initializer_handler,omitempty"`
+	InitializerTimeout int             `json:"initializer_timeout,omitempty"`
+}
+
+// ToFunctionUpdateConfigMap builds an update request body from UpdateConfigOpts.
+func (opts UpdateConfigOpts) ToFunctionUpdateConfigMap() (map[string]interface{}, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+	if err := setEnvironment(b, opts.Environment, opts.EncryptedEnvironment); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// UpdateConfig updates the metadata of a function.
+func UpdateConfig(client *golangsdk.ServiceClient, urn string, opts UpdateConfigOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToFunctionUpdateConfigMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(configURL(client, urn), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// GetCode retrieves the code of a function.
+func GetCode(client *golangsdk.ServiceClient, urn string) (r CodeResult) {
+	_, r.Err = client.Get(codeURL(client, urn), &r.Body, nil)
+	return
+}
+
+// UpdateCodeOptsBuilder allows extensions to add additional parameters to the UpdateCode request.
+type UpdateCodeOptsBuilder interface {
+	ToFunctionUpdateCodeMap() (map[string]interface{}, error)
+}
+
+// UpdateCodeOpts contains the options to upload a new function code.
+type UpdateCodeOpts struct {
+	CodeType     string    `json:"code_type" required:"true"`
+	CodeURL      string    `json:"code_url,omitempty"`
+	CodeFilename string    `json:"code_filename,omitempty"`
+	FuncCode     *FuncCode `json:"func_code,omitempty"`
+}
+
+// ToFunctionUpdateCodeMap builds an update request body from UpdateCodeOpts.
+func (opts UpdateCodeOpts) ToFunctionUpdateCodeMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// UpdateCode uploads a new code of a function.
+func UpdateCode(client *golangsdk.ServiceClient, urn string, opts UpdateCodeOptsBuilder) (r CodeResult) {
+	b, err := opts.ToFunctionUpdateCodeMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(codeURL(client, urn), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete deletes a function. If the URN is qualified with a version,
+// only this version is deleted.
+func Delete(client *golangsdk.ServiceClient, urn string) (r DeleteResult) {
+	_, r.Err = client.Delete(resourceURL(client, urn), &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// PublishVersionOptsBuilder allows extensions to add additional parameters to the PublishVersion request.
+type PublishVersionOptsBuilder interface {
+	ToPublishVersionMap() (map[string]interface{}, error)
+}
+
+// PublishVersionOpts contains the options to publish a function version.
+type PublishVersionOpts struct {
+	// Digest is the MD5 of the code, publishing fails if the code has changed.
+	Digest string `json:"digest,omitempty"`
+	// Version is the version name, a timestamp based name is generated if it's empty.
+	Version     string `json:"version,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// ToPublishVersionMap builds a publish request body from PublishVersionOpts.
+func (opts PublishVersionOpts) ToPublishVersionMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// PublishVersion publishes the current function code and configuration as a new version.
+func PublishVersion(client *golangsdk.ServiceClient, urn string, opts PublishVersionOptsBuilder) (r CreateResult) {
+	b, err := opts.ToPublishVersionMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(versionsURL(client, urn), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// ListVersions returns a Pager which allows you to iterate over the versions of a function.
+func ListVersions(client *golangsdk.ServiceClient, urn string) pagination.Pager {
+	return pagination.NewPager(client, versionsURL(client, urn), func(r pagination.PageResult) pagination.Page {
+		p := VersionPage{pagination.MarkerPageBase{PageResult: r}}
+		p.MarkerPageBase.Owner = p
+		return p
+	})
+}
+
+// AliasOptsBuilder allows extensions to add additional parameters to the alias requests.
+type AliasOptsBuilder interface {
+	ToAliasMap() (map[string]interface{}, error)
+}
+
+// AliasOpts contains the options to create or update an alias.
+type AliasOpts struct {
+	// Name of the alias, only used on creation.
+	Name string `json:"name,omitempty"`
+	// Version the alias points to.
+	Version     string `json:"version" required:"true"`
+	Description string `json:"description,omitempty"`
+	// AdditionalVersionWeights routes the given percentage of the traffic to other versions.
+	AdditionalVersionWeights map[string]int `json:"additional_version_weights,omitempty"`
+}
+
+// ToAliasMap builds an alias request body from AliasOpts.
+func (opts AliasOpts) ToAliasMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// CreateAlias creates an alias pointing to a function version.
+func CreateAlias(client *golangsdk.ServiceClient, urn string, opts AliasOptsBuilder) (r AliasResult) {
+	b, err := opts.ToAliasMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(aliasesURL(client, urn), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// GetAlias retrieves an alias of a function.
+func GetAlias(client *golangsdk.ServiceClient, urn, name string) (r AliasResult) {
+	_, r.Err = client.Get(aliasURL(client, urn, name), &r.Body, nil)
+	return
+}
+
+// UpdateAlias updates an alias of a function.
+func UpdateAlias(client *golangsdk.ServiceClient, urn, name string, opts AliasOptsBuilder) (r AliasResult) {
+	b, err := opts.ToAliasMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(aliasURL(client, urn, name), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// DeleteAlias deletes an alias of a function.
+func DeleteAlias(client *golangsdk.ServiceClient, urn, name string) (r DeleteResult) {
+	_, r.Err = client.Delete(aliasURL(client, urn, name), &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// ListAliases retrieves all the aliases of a function.
+func ListAliases(client *golangsdk.ServiceClient, urn string) (r ListAliasesResult) {
+	_, r.Err = client.Get(aliasesURL(client, urn), &r.Body, nil)
+	return
+}
+
+// UpdateReservedInstances sets the number of reserved instances of a function.
+// The URN must be qualified with a version or an alias.
+func UpdateReservedInstances(client *golangsdk.ServiceClient, urn string, count int) (r ReservedInstancesResult) {
+	b := map[string]interface{}{"count": count}
+	_, r.Err = client.Put(reservedInstancesURL(client, urn), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// ListReservedInstancesOpts allows to filter the reserved instances configuration.
+type ListReservedInstancesOpts struct {
+	// URN of the function.
+	URN    string `q:"urn"`
+	Marker string `q:"marker"`
+	Limit  string `q:"limit"`
+}
+
+// ListReservedInstances retrieves the reserved instances configuration of the functions.
+func ListReservedInstances(client *golangsdk.ServiceClient, opts ListReservedInstancesOpts) (r ListReservedInstancesResult) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Get(listReservedInstancesURL(client)+q.String(), &r.Body, nil)
+	return
+}
@@ -0,0 +1,331 @@
+package functions
+
+import (
+	"strconv"
+
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
+)
+
+// Function represents the metadata of a function.
+type Function struct {
+	FuncURN             string         `json:"func_urn"`
+	FuncName            string         `json:"func_name"`
+	DomainID            string         `json:"domain_id"`
+	Namespace           string         `json:"namespace"`
+	ProjectName         string         `json:"project_name"`
+	Package             string         `json:"package"`
+	Runtime             string         `json:"runtime"`
+	Timeout             int            `json:"timeout"`
+	Handler             string         `json:"handler"`
+	MemorySize          int            `json:"memory_size"`
+	CPU                 int            `json:"cpu"`
+	CodeType            string         `json:"code_type"`
+	CodeURL             string         `json:"code_url"`
+	CodeFilename        string         `json:"code_filename"`
+	CodeSize            int            `json:"code_size"`
+	UserData            string         `json:"user_data"`
+	EncryptedUserData   string         `json:"encrypted_user_data"`
+	Digest              string         `json:"digest"`
+	Version             string         `json:"version"`
+	ImageName           string         `json:"image_name"`
+	Xrole               string         `json:"xrole"`
+	AppXrole            string         `json:"app_xrole"`
+	Description         string         `json:"description"`
+	VersionDescription  string         `json:"version_description"`
+	LastModified        string         `json:"last_modified"`
+	FuncVpc             *FuncVpc       `json:"func_vpc"`
+	StrategyConfig      StrategyConfig `json:"strategy_config"`
+	InitializerHandler  string         `json:"initializer_handler"`
+	InitializerTimeout  int            `json:"initializer_timeout"`
+	EnterpriseProjectID string         `json:"enterprise_project_id"`
+}
+
+type commonResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as a Function.
+func (r commonResult) Extract() (*Function, error) {
+	var s Function
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// CreateResult is the result of Create and PublishVersion requests.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult is the result of a GetConfig request.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult is the result of an UpdateConfig request.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult is the result of Delete and DeleteAlias requests.
+// Call its ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	golangsdk.ErrResult
+}
+
+// FunctionPage is a single page of functions.
+type FunctionPage struct {
+	pagination.MarkerPageBase
+}
+
+// IsEmpty returns true if a page contains no functions.
+func (r FunctionPage) IsEmpty() (bool, error) {
+	functions, err := ExtractFunctions(r)
+	return len(functions) == 0, err
+}
+
+// LastMarker returns the marker of the next page.
+func (r FunctionPage) LastMarker() (string, error) {
+	return nextMarker(r.Result)
+}
+
+// ExtractFunctions extracts the functions of a FunctionPage.
+func ExtractFunctions(r pagination.Page) ([]Function, error) {
+	var s []Function
+	err := (r.(FunctionPage)).ExtractIntoSlicePtr(&s, "functions")
+	return s, err
+}
+
+// VersionPage is a single page of function versions.
+type VersionPage struct {
+	pagination.MarkerPageBase
+}
+
+// IsEmpty returns true if a page contains no versions.
+func (r VersionPage) IsEmpty() (bool, error) {
+	versions, err := ExtractVersions(r)
+	return len(versions) == 0, err
+}
+
+// LastMarker returns the marker of the next page.
+func (r VersionPage) LastMarker() (string, error) {
+	return nextMarker(r.Result)
+}
+
+// ExtractVersions extracts the function versions of a VersionPage.
+func ExtractVersions(r pagination.Page) ([]Function, error) {
+	var s []Function
+	err := (r.(VersionPage)).ExtractIntoSlicePtr(&s, "versions")
+	return s, err
+}
+
+func nextMarker(r golangsdk.Result) (string, error) {
+	var s struct {
+		NextMarker int `json:"next_marker"`
+	}
+	if err := r.ExtractInto(&s); err != nil {
+		return "", err
+	}
+	return strconv.Itoa(s.NextMarker), nil
+}
+
+// Code is the code of a function.
+type Code struct {
+	FuncURN      string   `json:"func_urn"`
+	FuncName     string   `json:"func_name"`
+	Runtime      string   `json:"runtime"`
+	CodeType     string   `json:"code_type"`
+	CodeURL      string   `json:"code_url"`
+	CodeFilename string   `json:"code_filename"`
+	CodeSize     int      `json:"code_size"`
+	Digest       string   `json:"digest"`
+	LastModified string   `json:"last_modified"`
+	FuncCode     FuncCode `json:"func_code"`
+}
+
+// CodeResult is the result of GetCode and UpdateCode requests.
+type CodeResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as a Code.
+func (r CodeResult) Extract() (*Code, error) {
+	var s Code
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// Alias is a named pointer to a function version.
+type Alias struct {
+	Name                     string         `json:"name"`
+	Version                  string         `json:"version"`
+	Description              string         `json:"description"`
+	LastModified             string         `json:"last_modified"`
+	AliasURN                 string         `json:"alias_urn"`
+	AdditionalVersionWeights map[string]int `json:"additional_version_weights"`
+}
+
+// AliasResult is the result of CreateAlias, GetAlias and UpdateAlias requests.
+type AliasResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as an Alias.
+func (r AliasResult) Extract() (*Alias, error) {
+	var s Alias
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// ListAliasesResult is the result of a ListAliases request.
+type ListAliasesResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as a slice of Alias.
+func (r ListAliasesResult) Extract() ([]Alias, error) {
+	var s []Alias
+	err := r.ExtractIntoSlicePtr(&s, "")
+	return s, err
+}
+
+// ReservedInstancesResult is the result of an UpdateReservedInstances request.
+type ReservedInstancesResult struct {
+	golangsdk.Result
+}
+
+// Extract returns the number of reserved instances.
+func (r ReservedInstancesResult) Extract() (int, error) {
+	var s struct {
+		Count int `json:"count"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Count, err
+}
+
+// ReservedInstances is the reserved instances configuration of a function.
+type ReservedInstances struct {
+	FuncURN       string `json:"func_urn"`
+	QualifierName string `json:"qualifier_name"`
+	QualifierType string `json:"qualifier_type"`
+	MinCount      int    `json:"min_count"`
+}
+
+// ListReservedInstancesResult is the result of a ListReservedInstances request.
+type ListReservedInstancesResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as a slice of ReservedInstances.
+func (r ListReservedInstancesResult) Extract() ([]ReservedInstances, error) {
+	var s []ReservedInstances
+	err := r.ExtractIntoSlicePtr(&s, "reservedinstances")
+	return s, err
+}
+
+// Invocation is the result of a synchronous invocation.
+type Invocation struct {
+	RequestID string `json:"request_id"`
+	// Result is the value returned by the function.
+	Result string `json:"result"`
+	// Log is the tail of the execution log, only set if InvokeOpts.TailLog is true.
+	Log string `json:"log"`
+	// Status is 0 on success.
+	Status int `json:"status"`
+}
+
+// InvokeResult is the result of an Invoke request.
+type InvokeResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as an Invocation.
+func (r InvokeResult) Extract() (*Invocation, error) {
+	var s Invocation
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// InvokeAsyncResult is the result of an InvokeAsync request.
+type InvokeAsyncResult struct {
+	golangsdk.Result
+}
+
+// Extract returns the request ID of the asynchronous invocation.
+func (r InvokeAsyncResult) Extract() (string, error) {
+	var s struct {
+		RequestID string `json:"request_id"`
+	}
+	err := r.ExtractInto(&s)
+	return s.RequestID, err
+}
+
+// AsyncInvocation is the status of an asynchronous invocation.
+type AsyncInvocation struct {
+	RequestID    string `json:"request_id"`
+	Status       string `json:"status"`
+	ErrorCode    int    `json:"error_code"`
+	ErrorMessage string `json:"error_message"`
+	StartTime    string `json:"start_time"`
+	EndTime      string `json:"end_time"`
+}
+
+// AsyncInvocationsResult is the result of a ListAsyncInvocations request.
+type AsyncInvocationsResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as a slice of AsyncInvocation.
+func (r AsyncInvocationsResult) Extract() ([]AsyncInvocation, error) {
+	var s []AsyncInvocation
+	err := r.ExtractIntoSlicePtr(&s, "invocations")
+	return s, err
+}
+
+// SlaReport is a single metric data point.
+type SlaReport struct {
+	Timestamp int64   `json:"timestamp"`
+	Value     float64 `json:"value"`
+}
+
+// Statistics are the execution metrics of a function.
+type Statistics struct {
+	Count       []SlaReport `json:"count"`
+	Duration    []SlaReport `json:"duration"`
+	FailCount   []SlaReport `json:"fail_count"`
+	MaxDuration []SlaReport `json:"max_duration"`
+	MinDuration []SlaReport `json:"min_duration"`
+	RejectCount []SlaReport `json:"reject_count"`
+}
+
+// StatisticsResult is the result of a GetStatistics request.
+type StatisticsResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as Statistics.
+func (r StatisticsResult) Extract() (*Statistics, error) {
+	var s Statistics
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// LogConfig is the LTS destination of the execution logs of a function.
+type LogConfig struct {
+	GroupID    string `json:"group_id"`
+	GroupName  string `json:"group_name"`
+	StreamID   string `json:"stream_id"`
+	StreamName string `json:"stream_name"`
+}
+
+// LogConfigResult is the result of a GetLogConfig request.
+type LogConfigResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as a LogConfig.
+func (r LogConfigResult) Extract() (*LogConfig, error) {
+	var s LogConfig
+	err := r.ExtractInto(&s)
+	return &s, err
+}
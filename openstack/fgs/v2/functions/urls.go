@@ -0,0 +1,64 @@
+package functions
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+const (
+	rootPath = "fgs"
+	funcPath = "functions"
+)
+
+func rootURL(client *golangsdk.ServiceClient) string {
+	return client.ServiceURL(rootPath, funcPath)
+}
+
+func resourceURL(client *golangsdk.ServiceClient, urn string) string {
+	return client.ServiceURL(rootPath, funcPath, urn)
+}
+
+func configURL(client *golangsdk.ServiceClient, urn string) string {
+	return client.ServiceURL(rootPath, funcPath, urn, "config")
+}
+
+func codeURL(client *golangsdk.ServiceClient, urn string) string {
+	return client.ServiceURL(rootPath, funcPath, urn, "code")
+}
+
+func versionsURL(client *golangsdk.ServiceClient, urn string) string {
+	return client.ServiceURL(rootPath, funcPath, urn, "versions")
+}
+
+func aliasesURL(client *golangsdk.ServiceClient, urn string) string {
+	return client.ServiceURL(rootPath, funcPath, urn, "aliases")
+}
+
+func aliasURL(client *golangsdk.ServiceClient, urn, name string) string {
+	return client.ServiceURL(rootPath, funcPath, urn, "aliases", name)
+}
+
+func reservedInstancesURL(client *golangsdk.ServiceClient, urn string) string {
+	return client.ServiceURL(rootPath, funcPath, urn, "reservedinstances")
+}
+
+func listReservedInstancesURL(client *golangsdk.ServiceClient) string {
+	return client.ServiceURL(rootPath, funcPath, "reservedinstances")
+}
+
+func invokeURL(client *golangsdk.ServiceClient, urn string) string {
+	return client.ServiceURL(rootPath, funcPath, urn, "invocations")
+}
+
+func invokeAsyncURL(client *golangsdk.ServiceClient, urn string) string {
+	return client.ServiceURL(rootPath, funcPath, urn, "invocations-async")
+}
+
+func asyncInvocationsURL(client *golangsdk.ServiceClient, urn string) string {
+	return client.ServiceURL(rootPath, funcPath, urn, "async-invocations")
+}
+
+func statisticsURL(client *golangsdk.ServiceClient, urn, period string) string {
+	return client.ServiceURL(rootPath, funcPath, urn, "statistics", period)
+}
+
+func logConfigURL(client *golangsdk.ServiceClient, urn string) string {
+	return client.ServiceURL(rootPath, funcPath, urn, "lts-log-detail")
+}
@@ -0,0 +1,35 @@
+/*
+Package triggers manages the triggers of FunctionGraph functions.
+
+Example to Create a Timer Trigger
+
+	trigger, err := triggers.Create(fgsClient, function.FuncURN, triggers.CreateOpts{
+		TriggerTypeCode: triggers.TypeTimer,
+		TriggerStatus:   triggers.StatusActive,
+		EventData: triggers.TimerEventData{
+			Name:         "every-5-minutes",
+			ScheduleType: "Rate",
+			Schedule:     "5m",
+		},
+	}).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Disable a Trigger
+
+	_, err := triggers.Update(fgsClient, function.FuncURN, trigger.TriggerTypeCode, trigger.TriggerID, triggers.UpdateOpts{
+		TriggerStatus: triggers.StatusDisabled,
+	}).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Trigger
+
+	err := triggers.Delete(fgsClient, function.FuncURN, trigger.TriggerTypeCode, trigger.TriggerID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package triggers
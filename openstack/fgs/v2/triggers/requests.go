@@ -0,0 +1,191 @@
+package triggers
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// Supported trigger types.
+const (
+	TypeTimer = "TIMER"
+	TypeAPIG  = "APIG"
+	TypeOBS   = "OBS"
+	TypeDMS   = "DMS"
+	TypeSMN   = "SMN"
+	TypeLTS   = "LTS"
+)
+
+// Trigger statuses.
+const (
+	StatusActive   = "ACTIVE"
+	StatusDisabled = "DISABLED"
+)
+
+// CreateOptsBuilder allows extensions to add additional parameters to the Create request.
+type CreateOptsBuilder interface {
+	ToTriggerCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts contains the options to create a trigger.
+type CreateOpts struct {
+	// TriggerTypeCode is one of the Type* constants.
+	TriggerTypeCode string `json:"trigger_type_code" required:"true"`
+	// TriggerStatus is either StatusActive or StatusDisabled.
+	TriggerStatus string `json:"trigger_status,omitempty"`
+	// EventTypeCode is the event type, e.g. "ObjectCreated" for OBS triggers.
+	EventTypeCode string `json:"event_type_code,omitempty"`
+	// EventData is the trigger type specific configuration, see TimerEventData,
+	// OBSEventData, SMNEventData, DMSEventData, LTSEventData and APIGEventData,
+	// or a raw map[string]interface{} for other trigger types.
+	EventData interface{} `json:"event_data" required:"true"`
+}
+
+// TimerEventData configures a TIMER trigger.
+type TimerEventData struct {
+	Name string `json:"name" required:"true"`
+	// ScheduleType is either "Rate" or "Cron".
+	ScheduleType string `json:"schedule_type" required:"true"`
+	// Schedule is a rate like "3m" or a cron expression.
+	Schedule string `json:"schedule" required:"true"`
+	// UserEvent is passed to the function as the event.
+	UserEvent string `json:"user_event,omitempty"`
+}
+
+// OBSEventData configures an OBS trigger.
+type OBSEventData struct {
+	Bucket string `json:"bucket" required:"true"`
+	// Events are the OBS event types, e.g. "ObjectCreated", "ObjectRemoved".
+	Events []string `json:"events" required:"true"`
+	Name   string   `json:"name,omitempty"`
+	Prefix string   `json:"prefix,omitempty"`
+	Suffix string   `json:"suffix,omitempty"`
+}
+
+// SMNEventData configures an SMN trigger.
+type SMNEventData struct {
+	TopicURN string `json:"topic_urn" required:"true"`
+}
+
+// DMSEventData configures a DMS trigger.
+type DMSEventData struct {
+	QueueID       string `json:"queue_id" required:"true"`
+	ConsumerGroup string `json:"consumer_group_id" required:"true"`
+	// PollingInterval is the pull period in seconds.
+	PollingInterval int `json:"polling_interval,omitempty"`
+	// BatchSize is the number of messages pulled at once.
+	BatchSize int `json:"batch_size,omitempty"`
+}
+
+// LTSEventData configures an LTS trigger.
+type LTSEventData struct {
+	LogGroupID string `json:"log_group_id" required:"true"`
+	LogTopicID string `json:"log_topic_id" required:"true"`
+}
+
+// APIGEventData configures an APIG trigger.
+type APIGEventData struct {
+	Name      string `json:"name" required:"true"`
+	GroupID   string `json:"group_id" required:"true"`
+	EnvID     string `json:"env_id" required:"true"`
+	EnvName   string `json:"env_name,omitempty"`
+	Path      string `json:"path" required:"true"`
+	ReqMethod string `json:"req_method" required:"true"`
+	// Protocol is either "HTTP" or "HTTPS".
+	Protocol string `json:"protocol" required:"true"`
+	// Auth is the authentication type, e.g. "IAM", "APP" or "NONE".
+	Auth string `json:"auth" required:"true"`
+	// MatchMode is either "SWA" (prefix) or "NORMAL" (exact).
+	MatchMode string `json:"match_mode" required:"true"`
+	// Type is 1 for public APIs and 2 for private APIs.
+	Type        int    `json:"type" required:"true"`
+	SlDomain    string `json:"sl_domain,omitempty"`
+	BackendType string `json:"backend_type,omitempty"`
+	// Timeout is the backend timeout in milliseconds.
+	Timeout int `json:"func_info_timeout,omitempty"`
+}
+
+// ToTriggerCreateMap builds a create request body from CreateOpts.
+func (opts CreateOpts) ToTriggerCreateMap() (map[string]interface{}, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := opts.EventData.(map[string]interface{}); ok {
+		return b, nil
+	}
+	data, err := golangsdk.BuildRequestBody(opts.EventData, "")
+	if err != nil {
+		return nil, err
+	}
+	b["event_data"] = data
+	return b, nil
+}
+
+// Create creates a trigger of the function.
+func Create(client *golangsdk.ServiceClient, urn string, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToTriggerCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(rootURL(client, urn), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	return
+}
+
+// List retrieves all the triggers of the function.
+func List(client *golangsdk.ServiceClient, urn string) (r ListResult) {
+	_, r.Err = client.Get(rootURL(client, urn), &r.Body, nil)
+	return
+}
+
+// Get retrieves a trigger of the function.
+func Get(client *golangsdk.ServiceClient, urn, typeCode, id string) (r GetResult) {
+	_, r.Err = client.Get(resourceURL(client, urn, typeCode, id), &r.Body, nil)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the Update request.
+type UpdateOptsBuilder interface {
+	ToTriggerUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts contains the options to update a trigger.
+type UpdateOpts struct {
+	// TriggerStatus is either StatusActive or StatusDisabled.
+	TriggerStatus string `json:"trigger_status" required:"true"`
+}
+
+// ToTriggerUpdateMap builds an update request body from UpdateOpts.
+func (opts UpdateOpts) ToTriggerUpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Update updates a trigger of the function.
+func Update(client *golangsdk.ServiceClient, urn, typeCode, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToTriggerUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(resourceURL(client, urn, typeCode, id), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete deletes a trigger of the function.
+func Delete(client *golangsdk.ServiceClient, urn, typeCode, id string) (r DeleteResult) {
+	_, r.Err = client.Delete(resourceURL(client, urn, typeCode, id), &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// DeleteAll deletes all the triggers of the function.
+func DeleteAll(client *golangsdk.ServiceClient, urn string) (r DeleteResult) {
+	_, r.Err = client.Delete(rootURL(client, urn), &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
@@ -0,0 +1,60 @@
+package triggers
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// Trigger represents a function trigger.
+type Trigger struct {
+	TriggerID       string                 `json:"trigger_id"`
+	TriggerTypeCode string                 `json:"trigger_type_code"`
+	TriggerStatus   string                 `json:"trigger_status"`
+	EventTypeCode   string                 `json:"event_type_code"`
+	EventData       map[string]interface{} `json:"event_data"`
+	LastUpdatedTime string                 `json:"last_updated_time"`
+	CreatedTime     string                 `json:"created_time"`
+}
+
+type commonResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as a Trigger.
+func (r commonResult) Extract() (*Trigger, error) {
+	var s Trigger
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// CreateResult is the result of a Create request.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult is the result of a Get request.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult is the result of an Update request.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult is the result of Delete and DeleteAll requests.
+// Call its ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	golangsdk.ErrResult
+}
+
+// ListResult is the result of a List request.
+type ListResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as a slice of Trigger.
+func (r ListResult) Extract() ([]Trigger, error) {
+	var s []Trigger
+	err := r.ExtractIntoSlicePtr(&s, "")
+	return s, err
+}
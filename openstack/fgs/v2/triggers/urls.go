@@ -0,0 +1,16 @@
+package triggers
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+const (
+	rootPath    = "fgs"
+	triggerPath = "triggers"
+)
+
+func rootURL(client *golangsdk.ServiceClient, urn string) string {
+	return client.ServiceURL(rootPath, triggerPath, urn)
+}
+
+func resourceURL(client *golangsdk.ServiceClient, urn, typeCode, id string) string {
+	return client.ServiceURL(rootPath, triggerPath, urn, typeCode, id)
+}
@@ -0,0 +1,151 @@
+package backups
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
+)
+
+var requestOpts = golangsdk.RequestOpts{
+	MoreHeaders: map[string]string{"Content-Type": "application/json"},
+}
+
+// PolicyOptsBuilder allows extensions to add additional parameters to the
+// UpdatePolicy request.
+type PolicyOptsBuilder interface {
+	ToBackupPolicyUpdateMap() (map[string]interface{}, error)
+}
+
+// PolicyOpts contains all the values needed to change the automated backup policy.
+type PolicyOpts struct {
+	BackupPolicy *BackupPolicy `json:"backup_policy" required:"true"`
+}
+
+type BackupPolicy struct {
+	// Number of days to retain the backup files, from 1 to 732
+	KeepDays int `json:"keep_days" required:"true"`
+	// Backup time window in `hh:mm-HH:MM` format, UTC
+	StartTime string `json:"start_time" required:"true"`
+	// Backup cycle as a comma-separated list of the days of the week, e.g. `1,2,3,4,5,6,7`
+	Period string `json:"period" required:"true"`
+}
+
+// ToBackupPolicyUpdateMap builds a request body from PolicyOpts.
+func (opts PolicyOpts) ToBackupPolicyUpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// UpdatePolicy sets the automated backup policy of the instance.
+func UpdatePolicy(client *golangsdk.ServiceClient, instanceID string, opts PolicyOptsBuilder) (r UpdatePolicyResult) {
+	b, err := opts.ToBackupPolicyUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(policyURL(client, instanceID), b, nil, &golangsdk.RequestOpts{
+		OkCodes:     []int{200},
+		MoreHeaders: requestOpts.MoreHeaders,
+	})
+	return
+}
+
+// GetPolicy retrieves the automated backup policy of the instance.
+func GetPolicy(client *golangsdk.ServiceClient, instanceID string) (r GetPolicyResult) {
+	_, r.Err = client.Get(policyURL(client, instanceID), &r.Body, &requestOpts)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToBackupCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts contains all the values needed to create a manual backup.
+type CreateOpts struct {
+	// ID of the instance to back up
+	InstanceID string `json:"instance_id" required:"true"`
+	// Backup name, 4 to 64 characters
+	Name string `json:"name" required:"true"`
+	// Backup description
+	Description string `json:"description,omitempty"`
+}
+
+// ToBackupCreateMap builds a create request body from CreateOpts.
+func (opts CreateOpts) ToBackupCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Create creates a manual backup of the instance.
+func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToBackupCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(baseURL(client), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes:     []int{200, 202},
+		MoreHeaders: requestOpts.MoreHeaders,
+	})
+	return
+}
+
+// Delete deletes a manual backup.
+func Delete(client *golangsdk.ServiceClient, backupID string) (r DeleteResult) {
+	_, r.Err = client.Delete(resourceURL(client, backupID), &golangsdk.RequestOpts{
+		OkCodes:     []int{200, 202},
+		MoreHeaders: requestOpts.MoreHeaders,
+	})
+	return
+}
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToBackupListQuery() (string, error)
+}
+
+// ListOpts allows filtering the backups.
+type ListOpts struct {
+	InstanceID string `q:"instance_id"`
+	BackupID   string `q:"backup_id"`
+	// Backup type: `auto` or `manual`
+	BackupType string `q:"backup_type"`
+	// Query start time in `yyyy-mm-ddThh:mm:ssZ` format
+	BeginTime string `q:"begin_time"`
+	// Query end time in `yyyy-mm-ddThh:mm:ssZ` format
+	EndTime string `q:"end_time"`
+	Offset  int    `q:"offset"`
+	Limit   int    `q:"limit"`
+}
+
+// ToBackupListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToBackupListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), err
+}
+
+// List returns the backups matching the options.
+func List(client *golangsdk.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := baseURL(client)
+	if opts != nil {
+		query, err := opts.ToBackupListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return BackupPage{pagination.SinglePageBase(r)}
+	})
+}
+
+// ListRestoreTimes returns the time ranges the instance can be restored to on the given date.
+// The date is in `yyyy-mm-dd` format, UTC.
+func ListRestoreTimes(client *golangsdk.ServiceClient, instanceID, date string) (r RestoreTimeResult) {
+	_, r.Err = client.Get(restoreTimeURL(client, instanceID)+"?date="+date, &r.Body, &requestOpts)
+	return
+}
@@ -0,0 +1,103 @@
+package backups
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
+)
+
+type BackupPolicyResp struct {
+	KeepDays  int    `json:"keep_days"`
+	StartTime string `json:"start_time"`
+	Period    string `json:"period"`
+}
+
+type Backup struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	InstanceID  string `json:"instance_id"`
+	BeginTime   string `json:"begin_time"`
+	EndTime     string `json:"end_time"`
+	// Backup status: `BUILDING`, `COMPLETED` or `FAILED`
+	Status string `json:"status"`
+	// Backup size in KB
+	Size float64 `json:"size"`
+	// Backup type: `auto` or `manual`
+	Type      string    `json:"type"`
+	Datastore Datastore `json:"datastore"`
+}
+
+type Datastore struct {
+	Type    string `json:"type"`
+	Version string `json:"version"`
+}
+
+type RestoreTime struct {
+	// Start of the time range, UNIX timestamp in milliseconds
+	StartTime int64 `json:"start_time"`
+	// End of the time range, UNIX timestamp in milliseconds
+	EndTime int64 `json:"end_time"`
+}
+
+// UpdatePolicyResult represents the result of a policy update operation.
+type UpdatePolicyResult struct {
+	golangsdk.ErrResult
+}
+
+// GetPolicyResult represents the result of a get policy operation.
+type GetPolicyResult struct {
+	golangsdk.Result
+}
+
+func (r GetPolicyResult) Extract() (*BackupPolicyResp, error) {
+	var policy BackupPolicyResp
+	err := r.ExtractIntoStructPtr(&policy, "backup_policy")
+	return &policy, err
+}
+
+// CreateResult represents the result of a create operation.
+type CreateResult struct {
+	golangsdk.Result
+}
+
+func (r CreateResult) Extract() (*Backup, error) {
+	var backup Backup
+	err := r.ExtractIntoStructPtr(&backup, "backup")
+	return &backup, err
+}
+
+// DeleteResult represents the result of a delete operation.
+type DeleteResult struct {
+	golangsdk.ErrResult
+}
+
+// RestoreTimeResult represents the result of a list restore times operation.
+type RestoreTimeResult struct {
+	golangsdk.Result
+}
+
+func (r RestoreTimeResult) Extract() ([]RestoreTime, error) {
+	var s []RestoreTime
+	err := r.ExtractIntoSlicePtr(&s, "restore_time")
+	return s, err
+}
+
+type BackupPage struct {
+	pagination.SinglePageBase
+}
+
+func (r BackupPage) IsEmpty() (bool, error) {
+	backups, err := ExtractBackups(r)
+	if err != nil {
+		return false, err
+	}
+	return len(backups) == 0, nil
+}
+
+// ExtractBackups interprets the results of a single page from a List() call,
+// producing a slice of Backup entities.
+func ExtractBackups(r pagination.Page) ([]Backup, error) {
+	var s []Backup
+	err := (r.(BackupPage)).ExtractIntoSlicePtr(&s, "backups")
+	return s, err
+}
@@ -0,0 +1,139 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const (
+	instanceID = "3a2e8d5e1f7b4cd5a1c9e7f3b2d4c6a8in14"
+	backupID   = "2f4ddb93b9014b0893d81d2e472f2b5ebr14"
+)
+
+const policyBody = `
+{
+  "backup_policy": {
+    "keep_days": 7,
+    "start_time": "19:00-20:00",
+    "period": "1,2,3,4,5,6,7"
+  }
+}`
+
+const expectedCreateRequest = `
+{
+  "instance_id": "3a2e8d5e1f7b4cd5a1c9e7f3b2d4c6a8in14",
+  "name": "gauss-backup",
+  "description": "manual backup"
+}`
+
+const createResponse = `
+{
+  "backup": {
+    "id": "2f4ddb93b9014b0893d81d2e472f2b5ebr14",
+    "name": "gauss-backup",
+    "description": "manual backup",
+    "instance_id": "3a2e8d5e1f7b4cd5a1c9e7f3b2d4c6a8in14",
+    "begin_time": "2021-03-01T10:00:00+0000",
+    "status": "BUILDING"
+  }
+}`
+
+const listResponse = `
+{
+  "backups": [
+    {
+      "id": "2f4ddb93b9014b0893d81d2e472f2b5ebr14",
+      "name": "gauss-backup",
+      "description": "manual backup",
+      "instance_id": "3a2e8d5e1f7b4cd5a1c9e7f3b2d4c6a8in14",
+      "begin_time": "2021-03-01T10:00:00+0000",
+      "end_time": "2021-03-01T10:05:00+0000",
+      "status": "COMPLETED",
+      "size": 2048.5,
+      "type": "manual",
+      "datastore": {
+        "type": "GaussDB(for openGauss)",
+        "version": "2.3"
+      }
+    }
+  ],
+  "total_count": 1
+}`
+
+const restoreTimeResponse = `
+{
+  "restore_time": [
+    {
+      "start_time": 1614592800000,
+      "end_time": 1614679199000
+    }
+  ]
+}`
+
+// HandlePolicySuccessfully creates an HTTP handler at `/instances/{instance_id}/backups/policy` on
+// the test handler mux that responds to PUT and GET requests.
+func HandlePolicySuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/instances/%s/backups/policy", instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		switch r.Method {
+		case "PUT":
+			th.TestJSONRequest(t, r, policyBody)
+			w.WriteHeader(http.StatusOK)
+		case "GET":
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprint(w, policyBody)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+}
+
+// HandleBackupsSuccessfully creates an HTTP handler at `/backups` on the test handler mux that
+// responds to POST and GET requests.
+func HandleBackupsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/backups", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+		switch r.Method {
+		case "POST":
+			th.TestJSONRequest(t, r, expectedCreateRequest)
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprint(w, createResponse)
+		case "GET":
+			th.TestFormValues(t, r, map[string]string{"instance_id": instanceID})
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprint(w, listResponse)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+}
+
+// HandleDeleteSuccessfully creates an HTTP handler at `/backups/{backup_id}` on the test handler
+// mux that responds to a DELETE request.
+func HandleDeleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/backups/%s", backupID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+// HandleRestoreTimeSuccessfully creates an HTTP handler at `/instances/{instance_id}/restore-time`
+// on the test handler mux that responds to a GET request with restoreTimeResponse.
+func HandleRestoreTimeSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/instances/%s/restore-time", instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"date": "2021-03-01"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, restoreTimeResponse)
+	})
+}
@@ -0,0 +1,75 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/gaussdb/v3/backups"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestPolicy(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandlePolicySuccessfully(t)
+
+	opts := backups.PolicyOpts{
+		BackupPolicy: &backups.BackupPolicy{
+			KeepDays:  7,
+			StartTime: "19:00-20:00",
+			Period:    "1,2,3,4,5,6,7",
+		},
+	}
+	th.AssertNoErr(t, backups.UpdatePolicy(fake.ServiceClient(), instanceID, opts).ExtractErr())
+
+	policy, err := backups.GetPolicy(fake.ServiceClient(), instanceID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "1,2,3,4,5,6,7", policy.Period)
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleBackupsSuccessfully(t)
+
+	opts := backups.CreateOpts{
+		InstanceID:  instanceID,
+		Name:        "gauss-backup",
+		Description: "manual backup",
+	}
+	backup, err := backups.Create(fake.ServiceClient(), opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, backupID, backup.ID)
+	th.AssertEquals(t, "BUILDING", backup.Status)
+}
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleBackupsSuccessfully(t)
+
+	pages, err := backups.List(fake.ServiceClient(), backups.ListOpts{InstanceID: instanceID}).AllPages()
+	th.AssertNoErr(t, err)
+	list, err := backups.ExtractBackups(pages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(list))
+	th.AssertEquals(t, 2048.5, list[0].Size)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteSuccessfully(t)
+
+	th.AssertNoErr(t, backups.Delete(fake.ServiceClient(), backupID).ExtractErr())
+}
+
+func TestListRestoreTimes(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleRestoreTimeSuccessfully(t)
+
+	times, err := backups.ListRestoreTimes(fake.ServiceClient(), instanceID, "2021-03-01").Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, int64(1614592800000), times[0].StartTime)
+}
@@ -0,0 +1,19 @@
+package backups
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+func baseURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("backups")
+}
+
+func resourceURL(c *golangsdk.ServiceClient, backupID string) string {
+	return c.ServiceURL("backups", backupID)
+}
+
+func policyURL(c *golangsdk.ServiceClient, instanceID string) string {
+	return c.ServiceURL("instances", instanceID, "backups", "policy")
+}
+
+func restoreTimeURL(c *golangsdk.ServiceClient, instanceID string) string {
+	return c.ServiceURL("instances", instanceID, "restore-time")
+}
@@ -0,0 +1,55 @@
+package flavors
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToFlavorListQuery() (string, error)
+}
+
+// ListOpts allows filtering the flavors.
+type ListOpts struct {
+	// Engine version, e.g. `2.3`
+	Version string `q:"version"`
+	// Resource specification code
+	SpecCode string `q:"spec_code"`
+	// Deployment mode: `enterprise` or `centralization_standard`
+	HaMode string `q:"ha_mode"`
+	Limit  int    `q:"limit"`
+	Offset int    `q:"offset"`
+}
+
+// ToFlavorListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToFlavorListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), err
+}
+
+// List returns the flavors available in the availability zones of the region.
+func List(client *golangsdk.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := flavorsURL(client)
+	if opts != nil {
+		query, err := opts.ToFlavorListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return FlavorPage{pagination.SinglePageBase(r)}
+	})
+}
+
+// ListDatastores returns the engine versions and the deployment modes they support.
+func ListDatastores(client *golangsdk.ServiceClient) (r DatastoresResult) {
+	_, r.Err = client.Get(datastoresURL(client), &r.Body, nil)
+	return
+}
@@ -0,0 +1,59 @@
+package flavors
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
+)
+
+type Flavor struct {
+	SpecCode string `json:"spec_code"`
+	Vcpus    string `json:"vcpus"`
+	Ram      string `json:"ram"`
+	// Engine version the flavor is available for
+	Version string `json:"version"`
+	// Engine name
+	Name string `json:"name"`
+	// Availability zones where the flavor is offered
+	AvailabilityZone []string `json:"availability_zone"`
+	// Flavor status per availability zone: `normal`, `unsupported` or `sellout`
+	AzStatus map[string]string `json:"az_status"`
+	// Deployment mode the flavor is for
+	GroupType string `json:"group_type"`
+}
+
+type Datastore struct {
+	Version string `json:"version"`
+	// Deployment modes supported by the version
+	SupportedInstModes []string `json:"supported_inst_modes"`
+}
+
+type FlavorPage struct {
+	pagination.SinglePageBase
+}
+
+func (r FlavorPage) IsEmpty() (bool, error) {
+	flavors, err := ExtractFlavors(r)
+	if err != nil {
+		return false, err
+	}
+	return len(flavors) == 0, nil
+}
+
+// ExtractFlavors interprets the results of a single page from a List() call,
+// producing a slice of Flavor entities.
+func ExtractFlavors(r pagination.Page) ([]Flavor, error) {
+	var s []Flavor
+	err := (r.(FlavorPage)).ExtractIntoSlicePtr(&s, "flavors")
+	return s, err
+}
+
+// DatastoresResult represents the result of a list datastores operation.
+type DatastoresResult struct {
+	golangsdk.Result
+}
+
+func (r DatastoresResult) Extract() ([]Datastore, error) {
+	var s []Datastore
+	err := r.ExtractIntoSlicePtr(&s, "datastores")
+	return s, err
+}
@@ -0,0 +1,67 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const listResponse = `
+{
+  "flavors": [
+    {
+      "spec_code": "gaussdb.opengauss.ee.dn.m6.2xlarge.8.in",
+      "vcpus": "8",
+      "ram": "64",
+      "version": "2.3",
+      "name": "GaussDB(for openGauss)",
+      "availability_zone": ["eu-de-01", "eu-de-02"],
+      "az_status": {
+        "eu-de-01": "normal",
+        "eu-de-02": "sellout"
+      },
+      "group_type": "enterprise"
+    }
+  ],
+  "total": 1
+}`
+
+const datastoresResponse = `
+{
+  "datastores": [
+    {
+      "version": "2.3",
+      "supported_inst_modes": ["enterprise", "centralization_standard"]
+    }
+  ]
+}`
+
+// HandleListSuccessfully creates an HTTP handler at `/flavors` on the test handler mux that
+// responds to a GET request with listResponse.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/flavors", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"ha_mode": "enterprise"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, listResponse)
+	})
+}
+
+// HandleDatastoresSuccessfully creates an HTTP handler at `/datastores` on the test handler mux
+// that responds to a GET request with datastoresResponse.
+func HandleDatastoresSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/datastores", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, datastoresResponse)
+	})
+}
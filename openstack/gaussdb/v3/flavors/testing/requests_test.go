@@ -0,0 +1,34 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/gaussdb/v3/flavors"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	pages, err := flavors.List(fake.ServiceClient(), flavors.ListOpts{HaMode: "enterprise"}).AllPages()
+	th.AssertNoErr(t, err)
+	list, err := flavors.ExtractFlavors(pages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(list))
+	th.AssertEquals(t, "sellout", list[0].AzStatus["eu-de-02"])
+	th.AssertDeepEquals(t, []string{"eu-de-01", "eu-de-02"}, list[0].AvailabilityZone)
+}
+
+func TestListDatastores(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDatastoresSuccessfully(t)
+
+	datastores, err := flavors.ListDatastores(fake.ServiceClient()).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "2.3", datastores[0].Version)
+	th.AssertEquals(t, 2, len(datastores[0].SupportedInstModes))
+}
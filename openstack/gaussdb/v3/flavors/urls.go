@@ -0,0 +1,11 @@
+package flavors
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+func flavorsURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("flavors")
+}
+
+func datastoresURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("datastores")
+}
@@ -0,0 +1,184 @@
+package instances
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
+)
+
+var requestOpts = golangsdk.RequestOpts{
+	MoreHeaders: map[string]string{"Content-Type": "application/json"},
+}
+
+// Supported deployment modes.
+const (
+	// ModeDistributed is the distributed (enterprise) edition
+	ModeDistributed = "enterprise"
+	// ModeCentralized is the primary/standby centralized edition
+	ModeCentralized = "centralization_standard"
+)
+
+type CreateOpts struct {
+	Name             string          `json:"name" required:"true"`
+	FlavorRef        string          `json:"flavor_ref" required:"true"`
+	Datastore        *Datastore      `json:"datastore,omitempty"`
+	Ha               *Ha             `json:"ha" required:"true"`
+	Port             string          `json:"port,omitempty"`
+	Password         string          `json:"password" required:"true"`
+	BackupStrategy   *BackupStrategy `json:"backup_strategy,omitempty"`
+	DiskEncryptionId string          `json:"disk_encryption_id,omitempty"`
+	Region           string          `json:"region" required:"true"`
+	// Availability zones of the nodes separated by commas, e.g. `eu-de-01,eu-de-02,eu-de-03`
+	AvailabilityZone string  `json:"availability_zone" required:"true"`
+	VpcId            string  `json:"vpc_id" required:"true"`
+	SubnetId         string  `json:"subnet_id" required:"true"`
+	SecurityGroupId  string  `json:"security_group_id,omitempty"`
+	TimeZone         string  `json:"time_zone,omitempty"`
+	Volume           *Volume `json:"volume" required:"true"`
+	// Number of shards, distributed edition only
+	ShardingNum int `json:"sharding_num,omitempty"`
+	// Number of coordinator nodes, distributed edition only
+	CoordinatorNum int `json:"coordinator_num,omitempty"`
+	// Number of replicas, centralized edition only
+	ReplicaNum int `json:"replica_num,omitempty"`
+	// Restores backup or point in time data to the created instance
+	RestorePoint *RestorePoint `json:"restore_point,omitempty"`
+}
+
+type Datastore struct {
+	// Engine type, `GaussDB(for openGauss)`
+	Type    string `json:"type" required:"true"`
+	Version string `json:"version,omitempty"`
+}
+
+type Ha struct {
+	// Deployment mode: `enterprise` or `centralization_standard`
+	Mode string `json:"mode" required:"true"`
+	// Replication mode, `sync`
+	ReplicationMode string `json:"replication_mode" required:"true"`
+	// Data consistency: `strong` or `eventual`, distributed edition only
+	Consistency string `json:"consistency,omitempty"`
+}
+
+type BackupStrategy struct {
+	// Backup time window in `hh:mm-HH:MM` format, UTC
+	StartTime string `json:"start_time" required:"true"`
+	KeepDays  int    `json:"keep_days,omitempty"`
+}
+
+type Volume struct {
+	// Storage type, e.g. `ULTRAHIGH`
+	Type string `json:"type" required:"true"`
+	// Storage size in GB
+	Size int `json:"size" required:"true"`
+}
+
+type RestorePoint struct {
+	// ID of the instance the backup or the point in time belongs to
+	InstanceId string `json:"instance_id" required:"true"`
+	// Restoration mode: `backup` or `timestamp`
+	Type string `json:"type" required:"true"`
+	// ID of the backup to restore, used when Type is `backup`
+	BackupId string `json:"backup_id,omitempty"`
+	// Point in time to restore to, UNIX timestamp in milliseconds, used when Type is `timestamp`
+	RestoreTime int64 `json:"restore_time,omitempty"`
+}
+
+type CreateBuilder interface {
+	ToInstanceCreateMap() (map[string]interface{}, error)
+}
+
+func (opts CreateOpts) ToInstanceCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Create creates a new instance or restores data to a new instance when RestorePoint is set.
+func Create(client *golangsdk.ServiceClient, opts CreateBuilder) (r CreateResult) {
+	b, err := opts.ToInstanceCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(baseURL(client), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes:     []int{200, 202},
+		MoreHeaders: requestOpts.MoreHeaders,
+	})
+	return
+}
+
+// Delete deletes the instance.
+func Delete(client *golangsdk.ServiceClient, instanceId string) (r JobResult) {
+	_, r.Err = client.Delete(resourceURL(client, instanceId), &golangsdk.RequestOpts{
+		OkCodes:      []int{200, 202},
+		JSONResponse: &r.Body,
+		MoreHeaders:  requestOpts.MoreHeaders,
+	})
+	return
+}
+
+type ListOpts struct {
+	Id            string `q:"id"`
+	Name          string `q:"name"`
+	Type          string `q:"type"`
+	DataStoreType string `q:"datastore_type"`
+	VpcId         string `q:"vpc_id"`
+	SubnetId      string `q:"subnet_id"`
+	Offset        int    `q:"offset"`
+	Limit         int    `q:"limit"`
+}
+
+type ListBuilder interface {
+	ToInstanceListQuery() (string, error)
+}
+
+func (opts ListOpts) ToInstanceListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), err
+}
+
+// List returns the instances matching the options.
+func List(client *golangsdk.ServiceClient, opts ListBuilder) pagination.Pager {
+	url := baseURL(client)
+	if opts != nil {
+		query, err := opts.ToInstanceListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return InstancePage{pagination.SinglePageBase(r)}
+	})
+}
+
+// Restart restarts the instance.
+func Restart(client *golangsdk.ServiceClient, instanceId string) (r JobResult) {
+	_, r.Err = client.Post(actionURL(client, instanceId, "restart"), map[string]interface{}{}, &r.Body, &golangsdk.RequestOpts{
+		OkCodes:     []int{200, 202},
+		MoreHeaders: requestOpts.MoreHeaders,
+	})
+	return
+}
+
+// UpdateName renames the instance.
+func UpdateName(client *golangsdk.ServiceClient, instanceId, name string) (r UpdateResult) {
+	b := map[string]interface{}{"name": name}
+	_, r.Err = client.Put(actionURL(client, instanceId, "name"), b, nil, &golangsdk.RequestOpts{
+		OkCodes:     []int{200},
+		MoreHeaders: requestOpts.MoreHeaders,
+	})
+	return
+}
+
+// ResetPassword resets the password of the instance administrator `root`.
+func ResetPassword(client *golangsdk.ServiceClient, instanceId, password string) (r UpdateResult) {
+	b := map[string]interface{}{"password": password}
+	_, r.Err = client.Post(actionURL(client, instanceId, "password"), b, nil, &golangsdk.RequestOpts{
+		OkCodes:     []int{200},
+		MoreHeaders: requestOpts.MoreHeaders,
+	})
+	return
+}
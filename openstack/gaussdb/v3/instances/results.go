@@ -0,0 +1,130 @@
+package instances
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
+)
+
+type CreateResponse struct {
+	Instance CreatedInstance `json:"instance"`
+	JobId    string          `json:"job_id"`
+}
+
+type CreatedInstance struct {
+	Id               string         `json:"id"`
+	Name             string         `json:"name"`
+	Status           string         `json:"status"`
+	Datastore        Datastore      `json:"datastore"`
+	Ha               Ha             `json:"ha"`
+	Port             string         `json:"port"`
+	BackupStrategy   BackupStrategy `json:"backup_strategy"`
+	FlavorRef        string         `json:"flavor_ref"`
+	Volume           Volume         `json:"volume"`
+	Region           string         `json:"region"`
+	AvailabilityZone string         `json:"availability_zone"`
+	VpcId            string         `json:"vpc_id"`
+	SubnetId         string         `json:"subnet_id"`
+	SecurityGroupId  string         `json:"security_group_id"`
+}
+
+type Instance struct {
+	Id                string         `json:"id"`
+	Name              string         `json:"name"`
+	Status            string         `json:"status"`
+	PrivateIps        []string       `json:"private_ips"`
+	PublicIps         []string       `json:"public_ips"`
+	Port              int            `json:"port"`
+	Type              string         `json:"type"`
+	Ha                Ha             `json:"ha"`
+	Region            string         `json:"region"`
+	Datastore         Datastore      `json:"datastore"`
+	Created           string         `json:"created"`
+	Updated           string         `json:"updated"`
+	DbUserName        string         `json:"db_user_name"`
+	VpcId             string         `json:"vpc_id"`
+	SubnetId          string         `json:"subnet_id"`
+	SecurityGroupId   string         `json:"security_group_id"`
+	FlavorRef         string         `json:"flavor_ref"`
+	FlavorInfo        FlavorInfo     `json:"flavor_info"`
+	Volume            VolumeInfo     `json:"volume"`
+	SwitchStrategy    string         `json:"switch_strategy"`
+	BackupStrategy    BackupStrategy `json:"backup_strategy"`
+	MaintenanceWindow string         `json:"maintenance_window"`
+	Nodes             []Node         `json:"nodes"`
+	DiskEncryptionId  string         `json:"disk_encryption_id"`
+	TimeZone          string         `json:"time_zone"`
+	ShardingNum       int            `json:"sharding_num"`
+	CoordinatorNum    int            `json:"coordinator_num"`
+	ReplicaNum        int            `json:"replica_num"`
+}
+
+type FlavorInfo struct {
+	Vcpus string `json:"vcpus"`
+	Ram   string `json:"ram"`
+}
+
+type VolumeInfo struct {
+	Type string `json:"type"`
+	Size int    `json:"size"`
+	Used string `json:"used"`
+}
+
+type Node struct {
+	Id               string `json:"id"`
+	Name             string `json:"name"`
+	Role             string `json:"role"`
+	Status           string `json:"status"`
+	AvailabilityZone string `json:"availability_zone"`
+}
+
+type ListResponse struct {
+	Instances  []Instance `json:"instances"`
+	TotalCount int        `json:"total_count"`
+}
+
+type JobResponse struct {
+	JobId string `json:"job_id"`
+}
+
+type CreateResult struct {
+	golangsdk.Result
+}
+
+func (r CreateResult) Extract() (*CreateResponse, error) {
+	var response CreateResponse
+	err := r.ExtractInto(&response)
+	return &response, err
+}
+
+// JobResult is the result of an operation which is performed asynchronously.
+type JobResult struct {
+	golangsdk.Result
+}
+
+func (r JobResult) Extract() (*JobResponse, error) {
+	var response JobResponse
+	err := r.ExtractInto(&response)
+	return &response, err
+}
+
+type UpdateResult struct {
+	golangsdk.ErrResult
+}
+
+type InstancePage struct {
+	pagination.SinglePageBase
+}
+
+func (r InstancePage) IsEmpty() (bool, error) {
+	data, err := ExtractInstances(r)
+	if err != nil {
+		return false, err
+	}
+	return len(data.Instances) == 0, err
+}
+
+func ExtractInstances(r pagination.Page) (ListResponse, error) {
+	var s ListResponse
+	err := (r.(InstancePage)).ExtractInto(&s)
+	return s, err
+}
@@ -0,0 +1,193 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const (
+	instanceID = "3a2e8d5e1f7b4cd5a1c9e7f3b2d4c6a8in14"
+	jobID      = "a9b3c7d1-2e4f-4a6b-8c0d-1e2f3a4b5c6d"
+)
+
+const expectedCreateRequest = `
+{
+  "name": "gauss-test",
+  "flavor_ref": "gaussdb.opengauss.ee.dn.m6.2xlarge.8.in",
+  "datastore": {
+    "type": "GaussDB(for openGauss)",
+    "version": "2.3"
+  },
+  "ha": {
+    "mode": "enterprise",
+    "replication_mode": "sync",
+    "consistency": "strong"
+  },
+  "password": "Test@12345678",
+  "region": "eu-de",
+  "availability_zone": "eu-de-01,eu-de-02,eu-de-03",
+  "vpc_id": "3ee5ec6c-8d0c-4bc7-bb7e-0a1bc5a8f5ed",
+  "subnet_id": "c7c8b3d4-2ff0-4d8e-9e4d-7e0bf8f2f8a1",
+  "volume": {
+    "type": "ULTRAHIGH",
+    "size": 160
+  },
+  "sharding_num": 1,
+  "coordinator_num": 1
+}`
+
+var createResponse = fmt.Sprintf(`
+{
+  "instance": {
+    "id": "%s",
+    "name": "gauss-test",
+    "status": "BUILD",
+    "datastore": {
+      "type": "GaussDB(for openGauss)",
+      "version": "2.3"
+    },
+    "ha": {
+      "mode": "enterprise",
+      "replication_mode": "sync",
+      "consistency": "strong"
+    },
+    "port": "8000",
+    "flavor_ref": "gaussdb.opengauss.ee.dn.m6.2xlarge.8.in",
+    "volume": {
+      "type": "ULTRAHIGH",
+      "size": 160
+    },
+    "region": "eu-de",
+    "availability_zone": "eu-de-01,eu-de-02,eu-de-03",
+    "vpc_id": "3ee5ec6c-8d0c-4bc7-bb7e-0a1bc5a8f5ed",
+    "subnet_id": "c7c8b3d4-2ff0-4d8e-9e4d-7e0bf8f2f8a1"
+  },
+  "job_id": "%s"
+}`, instanceID, jobID)
+
+var listResponse = fmt.Sprintf(`
+{
+  "instances": [
+    {
+      "id": "%s",
+      "name": "gauss-test",
+      "status": "ACTIVE",
+      "private_ips": ["192.168.0.10"],
+      "port": 8000,
+      "type": "enterprise",
+      "ha": {
+        "mode": "enterprise",
+        "replication_mode": "sync",
+        "consistency": "strong"
+      },
+      "region": "eu-de",
+      "datastore": {
+        "type": "GaussDB(for openGauss)",
+        "version": "2.3"
+      },
+      "db_user_name": "root",
+      "flavor_info": {
+        "vcpus": "8",
+        "ram": "64"
+      },
+      "volume": {
+        "type": "ULTRAHIGH",
+        "size": 160,
+        "used": "0.2"
+      },
+      "nodes": [
+        {
+          "id": "a1b2c3d4e5f64a7b8c9d0e1f2a3b4c5dno14",
+          "name": "gauss-test_gaussdbv5_cn_1",
+          "role": "master",
+          "status": "ACTIVE",
+          "availability_zone": "eu-de-01"
+        }
+      ],
+      "sharding_num": 1,
+      "coordinator_num": 1
+    }
+  ],
+  "total_count": 1
+}`, instanceID)
+
+var jobResponse = fmt.Sprintf(`{"job_id": "%s"}`, jobID)
+
+var jobStatusResponse = fmt.Sprintf(`
+{
+  "job": {
+    "id": "%s",
+    "name": "CreateGaussDBV5Instance",
+    "status": "Completed",
+    "created": "2021-03-01T10:00:00+0000",
+    "ended": "2021-03-01T10:30:00+0000",
+    "process": "100%%",
+    "fail_reason": ""
+  }
+}`, jobID)
+
+// HandleInstancesSuccessfully creates an HTTP handler at `/instances` on the test handler mux that
+// responds to POST and GET requests.
+func HandleInstancesSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/instances", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+		switch r.Method {
+		case "POST":
+			th.TestJSONRequest(t, r, expectedCreateRequest)
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = fmt.Fprint(w, createResponse)
+		case "GET":
+			th.TestFormValues(t, r, map[string]string{"id": instanceID})
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprint(w, listResponse)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+}
+
+// HandleDeleteSuccessfully creates an HTTP handler at `/instances/{instance_id}` on the test
+// handler mux that responds to a DELETE request with jobResponse.
+func HandleDeleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/instances/%s", instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = fmt.Fprint(w, jobResponse)
+	})
+}
+
+// HandleActionSuccessfully creates an HTTP handler at `/instances/{instance_id}/{action}` on the
+// test handler mux that responds to a request of the given method with the given response.
+func HandleActionSuccessfully(t *testing.T, action, method, expectedRequest, response string) {
+	th.Mux.HandleFunc(fmt.Sprintf("/instances/%s/%s", instanceID, action), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, method)
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, expectedRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, response)
+	})
+}
+
+// HandleGetJobSuccessfully creates an HTTP handler at `/jobs` on the test handler mux that responds
+// to a GET request with jobStatusResponse.
+func HandleGetJobSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/jobs", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"id": jobID})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, jobStatusResponse)
+	})
+}
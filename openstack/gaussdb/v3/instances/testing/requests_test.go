@@ -0,0 +1,97 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/gaussdb/v3/instances"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleInstancesSuccessfully(t)
+	HandleGetJobSuccessfully(t)
+
+	opts := instances.CreateOpts{
+		Name:      "gauss-test",
+		FlavorRef: "gaussdb.opengauss.ee.dn.m6.2xlarge.8.in",
+		Datastore: &instances.Datastore{
+			Type:    "GaussDB(for openGauss)",
+			Version: "2.3",
+		},
+		Ha: &instances.Ha{
+			Mode:            instances.ModeDistributed,
+			ReplicationMode: "sync",
+			Consistency:     "strong",
+		},
+		Password:         "Test@12345678",
+		Region:           "eu-de",
+		AvailabilityZone: "eu-de-01,eu-de-02,eu-de-03",
+		VpcId:            "3ee5ec6c-8d0c-4bc7-bb7e-0a1bc5a8f5ed",
+		SubnetId:         "c7c8b3d4-2ff0-4d8e-9e4d-7e0bf8f2f8a1",
+		Volume: &instances.Volume{
+			Type: "ULTRAHIGH",
+			Size: 160,
+		},
+		ShardingNum:    1,
+		CoordinatorNum: 1,
+	}
+	created, err := instances.Create(fake.ServiceClient(), opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, instanceID, created.Instance.Id)
+	th.AssertEquals(t, "8000", created.Instance.Port)
+	th.AssertNoErr(t, instances.WaitForJobCompleted(fake.ServiceClient(), 10, created.JobId))
+}
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleInstancesSuccessfully(t)
+
+	pages, err := instances.List(fake.ServiceClient(), instances.ListOpts{Id: instanceID}).AllPages()
+	th.AssertNoErr(t, err)
+	list, err := instances.ExtractInstances(pages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, list.TotalCount)
+	th.AssertEquals(t, 8000, list.Instances[0].Port)
+	th.AssertEquals(t, "64", list.Instances[0].FlavorInfo.Ram)
+	th.AssertEquals(t, "master", list.Instances[0].Nodes[0].Role)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteSuccessfully(t)
+
+	job, err := instances.Delete(fake.ServiceClient(), instanceID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, jobID, job.JobId)
+}
+
+func TestRestart(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleActionSuccessfully(t, "restart", "POST", `{}`, jobResponse)
+
+	job, err := instances.Restart(fake.ServiceClient(), instanceID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, jobID, job.JobId)
+}
+
+func TestUpdateName(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleActionSuccessfully(t, "name", "PUT", `{"name": "gauss-new"}`, `{}`)
+
+	th.AssertNoErr(t, instances.UpdateName(fake.ServiceClient(), instanceID, "gauss-new").ExtractErr())
+}
+
+func TestResetPassword(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleActionSuccessfully(t, "password", "POST", `{"password": "Test@87654321"}`, `{}`)
+
+	th.AssertNoErr(t, instances.ResetPassword(fake.ServiceClient(), instanceID, "Test@87654321").ExtractErr())
+}
@@ -0,0 +1,19 @@
+package instances
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+func baseURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("instances")
+}
+
+func resourceURL(c *golangsdk.ServiceClient, instanceId string) string {
+	return c.ServiceURL("instances", instanceId)
+}
+
+func actionURL(c *golangsdk.ServiceClient, instanceId, action string) string {
+	return c.ServiceURL("instances", instanceId, action)
+}
+
+func jobURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("jobs")
+}
@@ -0,0 +1,110 @@
+package baseline
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// ListRiskConfigsOptsBuilder allows extensions to add additional parameters to the
+// ListRiskConfigs request.
+type ListRiskConfigsOptsBuilder interface {
+	ToRiskConfigListQuery() (string, error)
+}
+
+// ListRiskConfigsOpts allows to filter the configuration check results.
+type ListRiskConfigsOpts struct {
+	// Baseline name
+	CheckName string `q:"check_name"`
+	// Risk level: `Security`, `Low`, `Medium` or `High`
+	Severity string `q:"severity"`
+	// Standard type: `cn_standard` or `hw_standard`
+	Standard string `q:"standard"`
+	// Server ID, the results of all servers are returned if empty
+	HostID string `q:"host_id"`
+	// Offset from which the query starts
+	Offset int `q:"offset"`
+	// Number of records on a page
+	Limit int `q:"limit"`
+}
+
+// ToRiskConfigListQuery formats a ListRiskConfigsOpts into a query string.
+func (opts ListRiskConfigsOpts) ToRiskConfigListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), err
+}
+
+// ListRiskConfigs returns a page of the unsafe configuration check results.
+func ListRiskConfigs(client *golangsdk.ServiceClient, opts ListRiskConfigsOptsBuilder) (r RiskConfigsResult) {
+	url := riskConfigsURL(client)
+	if opts != nil {
+		q, err := opts.ToRiskConfigListQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += q
+	}
+	_, r.Err = client.Get(url, &r.Body, nil)
+	return
+}
+
+// ListHostsOptsBuilder allows extensions to add additional parameters to the
+// weak password and password complexity requests.
+type ListHostsOptsBuilder interface {
+	ToBaselineHostsListQuery() (string, error)
+}
+
+// ListHostsOpts allows to filter the per-server check results.
+type ListHostsOpts struct {
+	// Server name
+	HostName string `q:"host_name"`
+	// Server IP address
+	HostIP string `q:"host_ip"`
+	// Server ID
+	HostID string `q:"host_id"`
+	// Offset from which the query starts
+	Offset int `q:"offset"`
+	// Number of records on a page
+	Limit int `q:"limit"`
+}
+
+// ToBaselineHostsListQuery formats a ListHostsOpts into a query string.
+func (opts ListHostsOpts) ToBaselineHostsListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), err
+}
+
+// ListWeakPasswordUsers returns a page of the accounts using weak passwords.
+func ListWeakPasswordUsers(client *golangsdk.ServiceClient, opts ListHostsOptsBuilder) (r WeakPasswordUsersResult) {
+	url := weakPasswordUsersURL(client)
+	if opts != nil {
+		q, err := opts.ToBaselineHostsListQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += q
+	}
+	_, r.Err = client.Get(url, &r.Body, nil)
+	return
+}
+
+// ListPasswordComplexity returns a page of the password complexity policy check results.
+func ListPasswordComplexity(client *golangsdk.ServiceClient, opts ListHostsOptsBuilder) (r PasswordComplexityResult) {
+	url := passwordComplexityURL(client)
+	if opts != nil {
+		q, err := opts.ToBaselineHostsListQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += q
+	}
+	_, r.Err = client.Get(url, &r.Body, nil)
+	return
+}
@@ -0,0 +1,107 @@
+package baseline
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// RiskConfig represents an unsafe configuration check result.
+type RiskConfig struct {
+	// Risk level
+	Severity string `json:"severity"`
+	// Baseline name
+	CheckName string `json:"check_name"`
+	// Baseline type
+	CheckType string `json:"check_type"`
+	// Standard type
+	Standard string `json:"standard"`
+	// Number of check items
+	CheckRuleNum int `json:"check_rule_num"`
+	// Number of failed check items
+	FailedRuleNum int `json:"failed_rule_num"`
+	// Number of affected servers
+	HostNum int `json:"host_num"`
+	// Time of the last scan (unix timestamp in milliseconds)
+	ScanTime int64 `json:"scan_time"`
+	// Baseline description
+	CheckTypeDesc string `json:"check_type_desc"`
+}
+
+// RiskConfigsResult represents the result of a risk configuration list operation.
+type RiskConfigsResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets a RiskConfigsResult as a slice of RiskConfig.
+func (r RiskConfigsResult) Extract() ([]RiskConfig, error) {
+	var s []RiskConfig
+	err := r.ExtractIntoSlicePtr(&s, "data_list")
+	return s, err
+}
+
+// WeakPasswordHost represents a server having accounts with weak passwords.
+type WeakPasswordHost struct {
+	// Server ID
+	HostID string `json:"host_id"`
+	// Server name
+	HostName string `json:"host_name"`
+	// Server IP address
+	HostIP string `json:"host_ip"`
+	// Accounts using weak passwords
+	WeakPwdAccounts []WeakPwdAccount `json:"weak_pwd_accounts"`
+}
+
+// WeakPwdAccount represents an account using a weak password.
+type WeakPwdAccount struct {
+	// Account name
+	UserName string `json:"user_name"`
+	// Account type: `system`, `mysql` or `redis`
+	ServiceType string `json:"service_type"`
+	// Validity period of the weak password, in days
+	Duration int `json:"duration"`
+}
+
+// WeakPasswordUsersResult represents the result of a weak password users list operation.
+type WeakPasswordUsersResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets a WeakPasswordUsersResult as a slice of WeakPasswordHost.
+func (r WeakPasswordUsersResult) Extract() ([]WeakPasswordHost, error) {
+	var s []WeakPasswordHost
+	err := r.ExtractIntoSlicePtr(&s, "data_list")
+	return s, err
+}
+
+// PasswordComplexity represents the password complexity policy check result of a server.
+type PasswordComplexity struct {
+	// Server ID
+	HostID string `json:"host_id"`
+	// Server name
+	HostName string `json:"host_name"`
+	// Server IP address
+	HostIP string `json:"host_ip"`
+	// Whether the minimum password length is met
+	MinLength bool `json:"min_length"`
+	// Whether uppercase letters are required
+	UppercaseLetter bool `json:"uppercase_letter"`
+	// Whether lowercase letters are required
+	LowercaseLetter bool `json:"lowercase_letter"`
+	// Whether digits are required
+	Number bool `json:"number"`
+	// Whether special characters are required
+	SpecialCharacter bool `json:"special_character"`
+	// Suggestions on the policy
+	Suggestion string `json:"suggestion"`
+}
+
+// PasswordComplexityResult represents the result of a password complexity list operation.
+type PasswordComplexityResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets a PasswordComplexityResult as a slice of PasswordComplexity.
+func (r PasswordComplexityResult) Extract() ([]PasswordComplexity, error) {
+	var s []PasswordComplexity
+	err := r.ExtractIntoSlicePtr(&s, "data_list")
+	return s, err
+}
@@ -0,0 +1,15 @@
+package baseline
+
+import golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+func riskConfigsURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("baseline", "risk-configs")
+}
+
+func weakPasswordUsersURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("baseline", "weak-password-users")
+}
+
+func passwordComplexityURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("baseline", "password-complexity")
+}
@@ -0,0 +1,119 @@
+package hostgroups
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToHostGroupCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts contains all the values needed to create a new host group.
+type CreateOpts struct {
+	// Server group name
+	Name string `json:"group_name" required:"true"`
+	// IDs of the servers in the group
+	HostIDs []string `json:"host_id_list" required:"true"`
+}
+
+// ToHostGroupCreateMap builds a create request body from CreateOpts.
+func (opts CreateOpts) ToHostGroupCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Create will create a new host group based on the values in CreateOpts.
+func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToHostGroupCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(rootURL(client), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToHostGroupListQuery() (string, error)
+}
+
+// ListOpts allows to filter the list of host groups.
+type ListOpts struct {
+	// Server group name, fuzzy match is used
+	Name string `q:"group_name"`
+	// Offset from which the query starts
+	Offset int `q:"offset"`
+	// Number of records on a page
+	Limit int `q:"limit"`
+}
+
+// ToHostGroupListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToHostGroupListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), err
+}
+
+// List returns a page of host groups.
+func List(client *golangsdk.ServiceClient, opts ListOptsBuilder) (r ListResult) {
+	url := rootURL(client)
+	if opts != nil {
+		q, err := opts.ToHostGroupListQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += q
+	}
+	_, r.Err = client.Get(url, &r.Body, nil)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToHostGroupUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts contains all the values needed to update a host group.
+type UpdateOpts struct {
+	// Server group ID
+	ID string `json:"group_id" required:"true"`
+	// Server group name
+	Name string `json:"group_name,omitempty"`
+	// IDs of the servers in the group, the list replaces the current one
+	HostIDs []string `json:"host_id_list" required:"true"`
+}
+
+// ToHostGroupUpdateMap builds an update request body from UpdateOpts.
+func (opts UpdateOpts) ToHostGroupUpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Update accepts a UpdateOpts struct and uses the values to update a host group.
+func Update(client *golangsdk.ServiceClient, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToHostGroupUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(rootURL(client), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete will permanently delete the host group with the provided ID.
+func Delete(client *golangsdk.ServiceClient, id string) (r DeleteResult) {
+	_, r.Err = client.Delete(rootURL(client)+"?group_id="+id, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return
+}
@@ -0,0 +1,57 @@
+package hostgroups
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// HostGroup represents a group of the protected servers.
+type HostGroup struct {
+	// Server group ID
+	ID string `json:"group_id"`
+	// Server group name
+	Name string `json:"group_name"`
+	// Number of servers in the group
+	HostNum int `json:"host_num"`
+	// Number of servers with risks
+	RiskHostNum int `json:"risk_host_num"`
+	// Number of unprotected servers
+	UnprotectHostNum int `json:"unprotect_host_num"`
+	// IDs of the servers in the group
+	HostIDs []string `json:"host_id_list"`
+}
+
+// CreateResult represents the result of a create operation.
+type CreateResult struct {
+	golangsdk.ErrResult
+}
+
+// UpdateResult represents the result of an update operation.
+type UpdateResult struct {
+	golangsdk.ErrResult
+}
+
+// DeleteResult represents the result of a delete operation.
+type DeleteResult struct {
+	golangsdk.ErrResult
+}
+
+// ListResult represents the result of a list operation.
+type ListResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets a ListResult as a slice of HostGroup.
+func (r ListResult) Extract() ([]HostGroup, error) {
+	var s []HostGroup
+	err := r.ExtractIntoSlicePtr(&s, "data_list")
+	return s, err
+}
+
+// ExtractTotal returns the total number of the host groups matching the query.
+func (r ListResult) ExtractTotal() (int, error) {
+	var s struct {
+		TotalNum int `json:"total_num"`
+	}
+	err := r.ExtractInto(&s)
+	return s.TotalNum, err
+}
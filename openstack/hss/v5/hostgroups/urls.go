@@ -0,0 +1,7 @@
+package hostgroups
+
+import golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+func rootURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("host-management", "groups")
+}
@@ -0,0 +1,141 @@
+package hosts
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// HSS editions
+const (
+	VersionNull       = "hss.version.null"
+	VersionBasic      = "hss.version.basic"
+	VersionEnterprise = "hss.version.enterprise"
+	VersionPremium    = "hss.version.premium"
+)
+
+// Billing modes
+const (
+	ChargingModePacketCycle = "packet_cycle"
+	ChargingModeOnDemand    = "on_demand"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToHostListQuery() (string, error)
+}
+
+// ListOpts allows to filter the list of servers.
+type ListOpts struct {
+	// Server name
+	HostName string `q:"host_name"`
+	// Server ID
+	HostID string `q:"host_id"`
+	// Server status: `ACTIVE`, `SHUTOFF`, `BUILDING` or `ERROR`
+	HostStatus string `q:"host_status"`
+	// OS type: `Linux` or `Windows`
+	OsType string `q:"os_type"`
+	// Agent status: `installed`, `not_installed`, `online` or `offline`
+	AgentStatus string `q:"agent_status"`
+	// Protection status: `closed` or `opened`
+	ProtectStatus string `q:"protect_status"`
+	// HSS edition
+	Version string `q:"version"`
+	// Billing mode
+	ChargingMode string `q:"charging_mode"`
+	// Server group ID
+	GroupID string `q:"group_id"`
+	// Policy group ID
+	PolicyGroupID string `q:"policy_group_id"`
+	// Private IP address
+	PrivateIP string `q:"private_ip"`
+	// Offset from which the query starts
+	Offset int `q:"offset"`
+	// Number of records on a page
+	Limit int `q:"limit"`
+}
+
+// ToHostListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToHostListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), err
+}
+
+// List returns a page of the servers with their protection status.
+func List(client *golangsdk.ServiceClient, opts ListOptsBuilder) (r ListResult) {
+	url := listURL(client)
+	if opts != nil {
+		q, err := opts.ToHostListQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += q
+	}
+	_, r.Err = client.Get(url, &r.Body, nil)
+	return
+}
+
+// ProtectionOptsBuilder allows extensions to add additional parameters to the
+// SwitchProtection request.
+type ProtectionOptsBuilder interface {
+	ToProtectionMap() (map[string]interface{}, error)
+}
+
+// ProtectionOpts contains the values needed to switch the protection of the servers.
+type ProtectionOpts struct {
+	// HSS edition to enable, VersionNull disables the protection
+	Version string `json:"version" required:"true"`
+	// Billing mode, required when the protection is enabled
+	ChargingMode string `json:"charging_mode,omitempty"`
+	// ID of the HSS quota used for the yearly/monthly billing
+	ResourceID string `json:"resource_id,omitempty"`
+	// IDs of the servers
+	HostIDs []string `json:"host_id_list" required:"true"`
+	// Tags of the on-demand quota
+	Tags []ResourceTag `json:"tags,omitempty"`
+}
+
+// ResourceTag is in key-value format
+type ResourceTag struct {
+	Key   string `json:"key" required:"true"`
+	Value string `json:"value,omitempty"`
+}
+
+// ToProtectionMap builds a request body from ProtectionOpts.
+func (opts ProtectionOpts) ToProtectionMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// SwitchProtection enables or changes the HSS edition of the servers, or disables
+// their protection when VersionNull is used.
+func SwitchProtection(client *golangsdk.ServiceClient, opts ProtectionOptsBuilder) (r ProtectionResult) {
+	b, err := opts.ToProtectionMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(protectionURL(client), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// EnableProtection enables the HSS edition on the servers with on-demand billing.
+func EnableProtection(client *golangsdk.ServiceClient, version string, hostIDs []string) (r ProtectionResult) {
+	return SwitchProtection(client, ProtectionOpts{
+		Version:      version,
+		ChargingMode: ChargingModeOnDemand,
+		HostIDs:      hostIDs,
+	})
+}
+
+// DisableProtection disables the protection of the servers.
+func DisableProtection(client *golangsdk.ServiceClient, hostIDs []string) (r ProtectionResult) {
+	return SwitchProtection(client, ProtectionOpts{
+		Version: VersionNull,
+		HostIDs: hostIDs,
+	})
+}
@@ -0,0 +1,73 @@
+package hosts
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// Host represents a server and its protection status.
+type Host struct {
+	// Server name
+	HostName string `json:"host_name"`
+	// Server ID
+	HostID string `json:"host_id"`
+	// Agent ID
+	AgentID string `json:"agent_id"`
+	// Private IP address
+	PrivateIP string `json:"private_ip"`
+	// Elastic IP address
+	PublicIP string `json:"public_ip"`
+	// OS type
+	OsType string `json:"os_type"`
+	// Server status
+	HostStatus string `json:"host_status"`
+	// Agent status
+	AgentStatus string `json:"agent_status"`
+	// HSS edition
+	Version string `json:"version"`
+	// Protection status
+	ProtectStatus string `json:"protect_status"`
+	// OS image
+	OsImage string `json:"os_image"`
+	// OS version
+	OsVersion string `json:"os_version"`
+	// Billing mode
+	ChargingMode string `json:"charging_mode"`
+	// Server group ID
+	GroupID string `json:"group_id"`
+	// Server group name
+	GroupName string `json:"group_name"`
+	// Policy group ID
+	PolicyGroupID string `json:"policy_group_id"`
+	// Policy group name
+	PolicyGroupName string `json:"policy_group_name"`
+	// Asset importance: `important`, `common` or `test`
+	AssetValue string `json:"asset_value"`
+	// Time when the protection was enabled (unix timestamp in milliseconds)
+	OpenTime int64 `json:"open_time"`
+}
+
+// ListResult represents the result of a list operation.
+type ListResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets a ListResult as a slice of Host.
+func (r ListResult) Extract() ([]Host, error) {
+	var s []Host
+	err := r.ExtractIntoSlicePtr(&s, "data_list")
+	return s, err
+}
+
+// ExtractTotal returns the total number of the servers matching the query.
+func (r ListResult) ExtractTotal() (int, error) {
+	var s struct {
+		TotalNum int `json:"total_num"`
+	}
+	err := r.ExtractInto(&s)
+	return s.TotalNum, err
+}
+
+// ProtectionResult represents the result of a protection switch.
+type ProtectionResult struct {
+	golangsdk.ErrResult
+}
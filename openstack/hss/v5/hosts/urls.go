@@ -0,0 +1,11 @@
+package hosts
+
+import golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+func listURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("host-management", "hosts")
+}
+
+func protectionURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("host-management", "protection")
+}
@@ -0,0 +1,81 @@
+package policies
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// ListGroups request.
+type ListOptsBuilder interface {
+	ToPolicyGroupListQuery() (string, error)
+}
+
+// ListOpts allows to filter the list of policy groups.
+type ListOpts struct {
+	// Policy group name
+	Name string `q:"group_name"`
+	// Policy group ID
+	ID string `q:"group_id"`
+	// Offset from which the query starts
+	Offset int `q:"offset"`
+	// Number of records on a page
+	Limit int `q:"limit"`
+}
+
+// ToPolicyGroupListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToPolicyGroupListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), err
+}
+
+// ListGroups returns a page of policy groups.
+func ListGroups(client *golangsdk.ServiceClient, opts ListOptsBuilder) (r ListResult) {
+	url := groupsURL(client)
+	if opts != nil {
+		q, err := opts.ToPolicyGroupListQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += q
+	}
+	_, r.Err = client.Get(url, &r.Body, nil)
+	return
+}
+
+// DeployOptsBuilder allows extensions to add additional parameters to the
+// Deploy request.
+type DeployOptsBuilder interface {
+	ToPolicyDeployMap() (map[string]interface{}, error)
+}
+
+// DeployOpts contains the values needed to apply a policy group to the servers.
+type DeployOpts struct {
+	// ID of the policy group to apply
+	TargetPolicyGroupID string `json:"target_policy_group_id" required:"true"`
+	// Whether the policy group is applied to all the servers
+	OperateAll bool `json:"operate_all,omitempty"`
+	// IDs of the servers, required if OperateAll is false
+	HostIDs []string `json:"host_id_list,omitempty"`
+}
+
+// ToPolicyDeployMap builds a request body from DeployOpts.
+func (opts DeployOpts) ToPolicyDeployMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Deploy binds the policy group to the servers.
+func Deploy(client *golangsdk.ServiceClient, opts DeployOptsBuilder) (r DeployResult) {
+	b, err := opts.ToPolicyDeployMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(deployURL(client), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
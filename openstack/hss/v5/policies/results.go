@@ -0,0 +1,42 @@
+package policies
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// PolicyGroup represents a group of protection policies applied to the servers.
+type PolicyGroup struct {
+	// Policy group ID
+	ID string `json:"group_id"`
+	// Policy group name
+	Name string `json:"group_name"`
+	// Policy group description
+	Description string `json:"description"`
+	// Whether the policy group can be deleted
+	Deletable bool `json:"deletable"`
+	// Number of servers the policy group is applied to
+	HostNum int `json:"host_num"`
+	// Whether the policy group is the default one
+	DefaultGroup bool `json:"default_group"`
+	// Supported OS: `Linux` or `Windows`
+	SupportOs string `json:"support_os"`
+	// Supported HSS edition
+	SupportVersion string `json:"support_version"`
+}
+
+// ListResult represents the result of a list operation.
+type ListResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets a ListResult as a slice of PolicyGroup.
+func (r ListResult) Extract() ([]PolicyGroup, error) {
+	var s []PolicyGroup
+	err := r.ExtractIntoSlicePtr(&s, "data_list")
+	return s, err
+}
+
+// DeployResult represents the result of a deploy operation.
+type DeployResult struct {
+	golangsdk.ErrResult
+}
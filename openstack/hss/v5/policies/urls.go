@@ -0,0 +1,11 @@
+package policies
+
+import golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+func groupsURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("policy", "groups")
+}
+
+func deployURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("policy", "deploy")
+}
@@ -0,0 +1,109 @@
+package vulnerabilities
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// Vulnerability types
+const (
+	TypeLinux   = "linux_vul"
+	TypeWindows = "windows_vul"
+	TypeWeb     = "web_cms"
+	TypeApp     = "app_vul"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToVulnerabilityListQuery() (string, error)
+}
+
+// ListOpts allows to filter the list of vulnerabilities.
+type ListOpts struct {
+	// Vulnerability type
+	Type string `q:"type"`
+	// Vulnerability ID
+	VulID string `q:"vul_id"`
+	// Vulnerability name
+	VulName string `q:"vul_name"`
+	// Fix priority: `Critical`, `High`, `Medium` or `Low`
+	RepairPriority string `q:"repair_priority"`
+	// Handling status: `unhandled` or `handled`
+	HandleStatus string `q:"handle_status"`
+	// Offset from which the query starts
+	Offset int `q:"offset"`
+	// Number of records on a page
+	Limit int `q:"limit"`
+}
+
+// ToVulnerabilityListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToVulnerabilityListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), err
+}
+
+// List returns a page of the vulnerabilities detected on the servers.
+func List(client *golangsdk.ServiceClient, opts ListOptsBuilder) (r ListResult) {
+	url := listURL(client)
+	if opts != nil {
+		q, err := opts.ToVulnerabilityListQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += q
+	}
+	_, r.Err = client.Get(url, &r.Body, nil)
+	return
+}
+
+// ListHostsOptsBuilder allows extensions to add additional parameters to the
+// ListHosts request.
+type ListHostsOptsBuilder interface {
+	ToVulnerabilityHostsListQuery() (string, error)
+}
+
+// ListHostsOpts allows to filter the list of servers affected by vulnerabilities.
+type ListHostsOpts struct {
+	// Vulnerability type
+	Type string `q:"type"`
+	// Server name
+	HostName string `q:"host_name"`
+	// Server IP address
+	HostIP string `q:"host_ip"`
+	// Server group name
+	GroupName string `q:"group_name"`
+	// Fix priority
+	RepairPriority string `q:"repair_priority"`
+	// Offset from which the query starts
+	Offset int `q:"offset"`
+	// Number of records on a page
+	Limit int `q:"limit"`
+}
+
+// ToVulnerabilityHostsListQuery formats a ListHostsOpts into a query string.
+func (opts ListHostsOpts) ToVulnerabilityHostsListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), err
+}
+
+// ListHosts returns a page of the servers with their vulnerability statistics.
+func ListHosts(client *golangsdk.ServiceClient, opts ListHostsOptsBuilder) (r ListHostsResult) {
+	url := hostsURL(client)
+	if opts != nil {
+		q, err := opts.ToVulnerabilityHostsListQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += q
+	}
+	_, r.Err = client.Get(url, &r.Body, nil)
+	return
+}
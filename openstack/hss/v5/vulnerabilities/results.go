@@ -0,0 +1,96 @@
+package vulnerabilities
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// Vulnerability represents a vulnerability detected on the servers.
+type Vulnerability struct {
+	// Vulnerability ID
+	VulID string `json:"vul_id"`
+	// Vulnerability name
+	VulName string `json:"vul_name"`
+	// Vulnerability label
+	Label []string `json:"label_list"`
+	// Fix priority
+	RepairNecessity string `json:"repair_necessity"`
+	// Time of the last scan (unix timestamp in milliseconds)
+	ScanTime int64 `json:"scan_time"`
+	// Vulnerability type
+	Type string `json:"type"`
+	// Number of unfixed servers
+	UnhandleHostNum int `json:"unhandle_host_num"`
+	// Number of fixed servers
+	FixedNum int `json:"fixed_num"`
+	// Number of ignored servers
+	IgnoredNum int `json:"ignored_num"`
+	// Vulnerability description
+	Description string `json:"description"`
+	// Fix command line
+	RepairCmd string `json:"repair_cmd"`
+	// Fix solution
+	SolutionDetail string `json:"solution_detail"`
+	// Vulnerability URL
+	URL string `json:"url"`
+}
+
+// ListResult represents the result of a list operation.
+type ListResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets a ListResult as a slice of Vulnerability.
+func (r ListResult) Extract() ([]Vulnerability, error) {
+	var s []Vulnerability
+	err := r.ExtractIntoSlicePtr(&s, "data_list")
+	return s, err
+}
+
+// ExtractTotal returns the total number of the vulnerabilities matching the query.
+func (r ListResult) ExtractTotal() (int, error) {
+	var s struct {
+		TotalNum int `json:"total_num"`
+	}
+	err := r.ExtractInto(&s)
+	return s.TotalNum, err
+}
+
+// VulnerableHost represents the vulnerability statistics of a server.
+type VulnerableHost struct {
+	// Server ID
+	HostID string `json:"host_id"`
+	// Server name
+	HostName string `json:"host_name"`
+	// Server IP address
+	HostIP string `json:"host_ip"`
+	// Number of vulnerabilities to fix
+	VulNum int `json:"vul_num"`
+	// Number of vulnerabilities per fix priority
+	RepairPriorityList []RepairPriority `json:"repair_priority_list"`
+	// Server group name
+	GroupName string `json:"group_name"`
+	// OS image
+	OsImage string `json:"os_image"`
+	// Time of the last scan (unix timestamp in milliseconds)
+	ScanTime int64 `json:"scan_time"`
+}
+
+// RepairPriority contains the number of vulnerabilities of a fix priority.
+type RepairPriority struct {
+	// Fix priority
+	RepairPriority string `json:"repair_priority"`
+	// Number of vulnerabilities
+	HostNum int `json:"host_num"`
+}
+
+// ListHostsResult represents the result of a hosts list operation.
+type ListHostsResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets a ListHostsResult as a slice of VulnerableHost.
+func (r ListHostsResult) Extract() ([]VulnerableHost, error) {
+	var s []VulnerableHost
+	err := r.ExtractIntoSlicePtr(&s, "data_list")
+	return s, err
+}
@@ -0,0 +1,11 @@
+package vulnerabilities
+
+import golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+
+func listURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("vulnerability", "vulnerabilities")
+}
+
+func hostsURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("vulnerability", "hosts")
+}
@@ -4,11 +4,21 @@ import (
 	"github.com/opentelekomcloud/gophertelekomcloud"
 )
 
+const (
+	// DurationForever means the agency never expires.
+	DurationForever = "FOREVER"
+	// DurationOneDay means the agency expires one day after creation.
+	DurationOneDay = "ONEDAY"
+)
+
 type CreateOpts struct {
 	Name            string `json:"name" required:"true"`
 	DomainID        string `json:"domain_id" required:"true"`
 	DelegatedDomain string `json:"trust_domain_name" required:"true"`
 	Description     string `json:"description,omitempty"`
+	// Duration is the validity period of the agency, either DurationForever,
+	// DurationOneDay or the number of days as a string.
+	Duration string `json:"duration,omitempty"`
 }
 
 type CreateOptsBuilder interface {
@@ -33,6 +43,7 @@ func Create(c *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult)
 type UpdateOpts struct {
 	DelegatedDomain string `json:"trust_domain_name,omitempty"`
 	Description     string `json:"description,omitempty"`
+	Duration        string `json:"duration,omitempty"`
 }
 
 type UpdateOptsBuilder interface {
@@ -60,6 +71,39 @@ func Get(c *golangsdk.ServiceClient, id string) (r GetResult) {
 	return
 }
 
+type ListOpts struct {
+	DomainID          string `q:"domain_id,required"`
+	Name              string `q:"name"`
+	DelegatedDomainID string `q:"trust_domain_id"`
+}
+
+type ListOptsBuilder interface {
+	ToAgencyListQuery() (string, error)
+}
+
+func (opts ListOpts) ToAgencyListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), nil
+}
+
+func List(c *golangsdk.ServiceClient, opts ListOptsBuilder) (r ListResult) {
+	url := rootURL(c)
+	if opts != nil {
+		query, err := opts.ToAgencyListQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += query
+	}
+
+	_, r.Err = c.Get(url, &r.Body, nil)
+	return
+}
+
 func Delete(c *golangsdk.ServiceClient, id string) (r ErrResult) {
 	_, r.Err = c.Delete(resourceURL(c, id), nil)
 	return
@@ -98,3 +142,44 @@ func ListRolesAttachedOnDomain(c *golangsdk.ServiceClient, agencyID, domainID st
 	_, r.Err = c.Get(listRolesURL(c, "domains", domainID, agencyID), &r.Body, nil)
 	return
 }
+
+func CheckRoleOnProject(c *golangsdk.ServiceClient, agencyID, projectID, roleID string) (bool, error) {
+	return checkRole(c, roleURL(c, "projects", projectID, agencyID, roleID))
+}
+
+func CheckRoleOnDomain(c *golangsdk.ServiceClient, agencyID, domainID, roleID string) (bool, error) {
+	return checkRole(c, roleURL(c, "domains", domainID, agencyID, roleID))
+}
+
+// AttachRoleToAllProjects grants a role to the agency on all projects of the domain,
+// including the projects created later.
+func AttachRoleToAllProjects(c *golangsdk.ServiceClient, agencyID, domainID, roleID string) (r ErrResult) {
+	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{204}}
+	_, r.Err = c.Put(inheritedRoleURL(c, domainID, agencyID, roleID), nil, nil, reqOpt)
+	return
+}
+
+func DetachRoleFromAllProjects(c *golangsdk.ServiceClient, agencyID, domainID, roleID string) (r ErrResult) {
+	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{204}}
+	_, r.Err = c.Delete(inheritedRoleURL(c, domainID, agencyID, roleID), reqOpt)
+	return
+}
+
+func CheckRoleOnAllProjects(c *golangsdk.ServiceClient, agencyID, domainID, roleID string) (bool, error) {
+	return checkRole(c, inheritedRoleURL(c, domainID, agencyID, roleID))
+}
+
+func ListRolesAttachedOnAllProjects(c *golangsdk.ServiceClient, agencyID, domainID string) (r ListRolesResult) {
+	_, r.Err = c.Get(listInheritedRolesURL(c, domainID, agencyID), &r.Body, nil)
+	return
+}
+
+func checkRole(c *golangsdk.ServiceClient, url string) (bool, error) {
+	resp, err := c.Request("HEAD", url, &golangsdk.RequestOpts{
+		OkCodes: []int{204, 404},
+	})
+	if err != nil {
+		return false, err
+	}
+	return resp.StatusCode == 204, nil
+}
@@ -41,6 +41,18 @@ type UpdateResult struct {
 	commonResult
 }
 
+type ListResult struct {
+	golangsdk.Result
+}
+
+func (r ListResult) ExtractAgencies() ([]Agency, error) {
+	var s struct {
+		Agencies []Agency `json:"agencies"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Agencies, err
+}
+
 type ErrResult struct {
 	golangsdk.ErrResult
 }
@@ -1,10 +1,15 @@
 package agency
 
-import "github.com/opentelekomcloud/gophertelekomcloud"
+import (
+	"strings"
+
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
 
 const (
 	rootPath     = "OS-AGENCY"
 	resourcePath = "agencies"
+	inheritPath  = "OS-INHERIT"
 )
 
 func rootURL(c *golangsdk.ServiceClient) string {
@@ -22,3 +27,13 @@ func roleURL(c *golangsdk.ServiceClient, resource, resourceID, agencyID, roleID
 func listRolesURL(c *golangsdk.ServiceClient, resource, resourceID, agencyID string) string {
 	return c.ServiceURL(rootPath, resource, resourceID, resourcePath, agencyID, "roles")
 }
+
+func inheritedRoleURL(c *golangsdk.ServiceClient, domainID, agencyID, roleID string) string {
+	url := c.ServiceURL(inheritPath, "domains", domainID, resourcePath, agencyID, "roles", roleID, "inherited_to_projects")
+	return strings.Replace(url, "/v3/", "/v3.0/", 1)
+}
+
+func listInheritedRolesURL(c *golangsdk.ServiceClient, domainID, agencyID string) string {
+	url := c.ServiceURL(inheritPath, "domains", domainID, resourcePath, agencyID, "roles", "inherited_to_projects")
+	return strings.Replace(url, "/v3/", "/v3.0/", 1)
+}
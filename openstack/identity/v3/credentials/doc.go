@@ -0,0 +1,38 @@
+/*
+Package credentials manages permanent access keys (AK/SK) of IAM users and
+obtains temporary access keys.
+
+Example to Rotate an Access Key of a User
+
+	newCredential, err := credentials.Create(identityClient, credentials.CreateOpts{
+		UserID:      userID,
+		Description: "rotated key",
+	}).Extract()
+	if err != nil {
+		panic(err)
+	}
+	// SecretKey is returned only once, store it right away
+	fmt.Println(newCredential.AccessKey, newCredential.SecretKey)
+
+	_, err = credentials.Update(identityClient, oldAccessKey, credentials.UpdateOpts{
+		Status: string(credentials.StatusInactive),
+	}).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	err = credentials.Delete(identityClient, oldAccessKey).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to List Access Keys of a User
+
+	allCredentials, err := credentials.List(identityClient, credentials.ListOpts{
+		UserID: userID,
+	}).Extract()
+	if err != nil {
+		panic(err)
+	}
+*/
+package credentials
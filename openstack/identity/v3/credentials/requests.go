@@ -8,6 +8,11 @@ import (
 
 type Status string
 
+const (
+	StatusActive   Status = "active"
+	StatusInactive Status = "inactive"
+)
+
 const parentElement = "credential"
 
 type ListOptsBuilder interface {
@@ -15,7 +20,7 @@ type ListOptsBuilder interface {
 }
 
 type ListOpts struct {
-	UserID string `json:"user_id,omitempty"`
+	UserID string `q:"user_id"`
 }
 
 func (opts ListOpts) ToCredentialListQuery() (string, error) {
@@ -27,12 +32,16 @@ func (opts ListOpts) ToCredentialListQuery() (string, error) {
 }
 
 func List(client *golangsdk.ServiceClient, opts ListOptsBuilder) (l ListResult) {
-	q, err := opts.ToCredentialListQuery()
-	if err != nil {
-		l.Err = err
-		return
+	url := listURL(client)
+	if opts != nil {
+		q, err := opts.ToCredentialListQuery()
+		if err != nil {
+			l.Err = err
+			return
+		}
+		url += q
 	}
-	_, l.Err = client.Get(listURL(client)+q, &l.Body, nil)
+	_, l.Err = client.Get(url, &l.Body, nil)
 	return
 }
 
@@ -46,8 +55,8 @@ type CreateOptsBuilder interface {
 }
 
 type CreateOpts struct {
-	UserID      string `json:"user_id"`
-	Description string `json:"description"`
+	UserID      string `json:"user_id" required:"true"`
+	Description string `json:"description,omitempty"`
 }
 
 func (opts CreateOpts) ToCredentialCreateMap() (map[string]interface{}, error) {
@@ -60,7 +69,9 @@ func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateRe
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(createURL(client), &b, &r.Body, nil)
+	_, r.Err = client.Post(createURL(client), &b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{201},
+	})
 	return
 }
 
@@ -90,7 +101,9 @@ func Update(client *golangsdk.ServiceClient, credentialID string, opts UpdateOpt
 }
 
 func Delete(client *golangsdk.ServiceClient, credentialID string) (r DeleteResult) {
-	_, r.Err = client.Delete(deleteURL(client, credentialID), nil)
+	_, r.Err = client.Delete(deleteURL(client, credentialID), &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
 	return
 }
 
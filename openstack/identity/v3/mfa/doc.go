@@ -0,0 +1,25 @@
+/*
+Package mfa manages virtual MFA devices of IAM users in the OTC Identity Service.
+
+Example to Create and Bind a Virtual MFA Device
+
+	device, err := mfa.Create(identityClient, mfa.CreateOpts{
+		Name:   "my-device",
+		UserID: userID,
+	}).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	// configure the authenticator with device.Base32StringSeed, then
+	err = mfa.Bind(identityClient, mfa.BindOpts{
+		UserID:                   userID,
+		SerialNumber:             device.SerialNumber,
+		AuthenticationCodeFirst:  "123456",
+		AuthenticationCodeSecond: "654321",
+	}).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package mfa
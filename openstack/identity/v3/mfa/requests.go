@@ -0,0 +1,138 @@
+package mfa
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// List retrieves the virtual MFA devices of the domain.
+func List(client *golangsdk.ServiceClient) (r ListResult) {
+	_, r.Err = client.Get(listURL(client), &r.Body, nil)
+	return
+}
+
+// Get retrieves the virtual MFA device of a user.
+func Get(client *golangsdk.ServiceClient, userID string) (r GetResult) {
+	_, r.Err = client.Get(getURL(client, userID), &r.Body, nil)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to
+// the Create request.
+type CreateOptsBuilder interface {
+	ToVirtualDeviceCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts provides options used to create a virtual MFA device.
+type CreateOpts struct {
+	// Name is the device name.
+	Name string `json:"name" required:"true"`
+
+	// UserID is the ID of the user for whom the device is created.
+	UserID string `json:"user_id" required:"true"`
+}
+
+// ToVirtualDeviceCreateMap formats a CreateOpts into a create request.
+func (opts CreateOpts) ToVirtualDeviceCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "virtual_mfa_device")
+}
+
+// Create creates a virtual MFA device. The returned seed must be used to
+// configure the authenticator application before the device is bound.
+func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToVirtualDeviceCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(createURL(client), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+// DeleteOpts provides options used to delete a virtual MFA device.
+type DeleteOpts struct {
+	UserID       string `q:"user_id,required"`
+	SerialNumber string `q:"serial_number,required"`
+}
+
+// Delete deletes a virtual MFA device.
+func Delete(client *golangsdk.ServiceClient, opts DeleteOpts) (r DeleteResult) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Delete(deleteURL(client)+q.String(), &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// BindOptsBuilder allows extensions to add additional parameters to
+// the Bind request.
+type BindOptsBuilder interface {
+	ToBindMap() (map[string]interface{}, error)
+}
+
+// BindOpts provides options used to bind a virtual MFA device to a user.
+type BindOpts struct {
+	UserID       string `json:"user_id" required:"true"`
+	SerialNumber string `json:"serial_number" required:"true"`
+
+	// AuthenticationCodeFirst and AuthenticationCodeSecond are two consecutive
+	// verification codes generated by the device.
+	AuthenticationCodeFirst  string `json:"authentication_code_first" required:"true"`
+	AuthenticationCodeSecond string `json:"authentication_code_second" required:"true"`
+}
+
+// ToBindMap formats a BindOpts into a request body.
+func (opts BindOpts) ToBindMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Bind binds a virtual MFA device to a user.
+func Bind(client *golangsdk.ServiceClient, opts BindOptsBuilder) (r ErrResult) {
+	b, err := opts.ToBindMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(bindURL(client), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return
+}
+
+// UnbindOptsBuilder allows extensions to add additional parameters to
+// the Unbind request.
+type UnbindOptsBuilder interface {
+	ToUnbindMap() (map[string]interface{}, error)
+}
+
+// UnbindOpts provides options used to unbind a virtual MFA device from a user.
+type UnbindOpts struct {
+	UserID       string `json:"user_id" required:"true"`
+	SerialNumber string `json:"serial_number" required:"true"`
+
+	// AuthenticationCode is a verification code generated by the device.
+	AuthenticationCode string `json:"authentication_code" required:"true"`
+}
+
+// ToUnbindMap formats an UnbindOpts into a request body.
+func (opts UnbindOpts) ToUnbindMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Unbind unbinds a virtual MFA device from a user.
+func Unbind(client *golangsdk.ServiceClient, opts UnbindOptsBuilder) (r ErrResult) {
+	b, err := opts.ToUnbindMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(unbindURL(client), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return
+}
@@ -0,0 +1,69 @@
+package mfa
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// VirtualDevice represents a virtual MFA device.
+type VirtualDevice struct {
+	// UserID is the ID of the user the device belongs to.
+	UserID string `json:"user_id"`
+
+	// SerialNumber is the serial number of the device.
+	SerialNumber string `json:"serial_number"`
+
+	// Base32StringSeed is the key seed, returned only on creation.
+	Base32StringSeed string `json:"base32_string_seed,omitempty"`
+}
+
+type deviceResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets a result as a VirtualDevice.
+func (r deviceResult) Extract() (*VirtualDevice, error) {
+	var s struct {
+		Device *VirtualDevice `json:"virtual_mfa_device"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Device, err
+}
+
+// GetResult is the response from a Get operation. Call its Extract method
+// to interpret it as a VirtualDevice.
+type GetResult struct {
+	deviceResult
+}
+
+// CreateResult is the response from a Create operation. Call its Extract method
+// to interpret it as a VirtualDevice.
+type CreateResult struct {
+	deviceResult
+}
+
+// ListResult is the response from a List operation. Call its Extract method
+// to interpret it as a slice of VirtualDevice.
+type ListResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets a ListResult as a slice of VirtualDevice.
+func (r ListResult) Extract() ([]VirtualDevice, error) {
+	var s struct {
+		Devices []VirtualDevice `json:"virtual_mfa_devices"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Devices, err
+}
+
+// DeleteResult is the response from a Delete operation. Call its ExtractErr to
+// determine if the request succeeded or failed.
+type DeleteResult struct {
+	golangsdk.ErrResult
+}
+
+// ErrResult is the response from Bind and Unbind operations. Call its ExtractErr to
+// determine if the request succeeded or failed.
+type ErrResult struct {
+	golangsdk.ErrResult
+}
@@ -0,0 +1,40 @@
+package mfa
+
+import (
+	"strings"
+
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+const (
+	rootPath          = "OS-MFA"
+	virtualDevicePath = "virtual-mfa-devices"
+)
+
+func v30(url string) string {
+	return strings.Replace(url, "/v3/", "/v3.0/", 1)
+}
+
+func listURL(client *golangsdk.ServiceClient) string {
+	return v30(client.ServiceURL(rootPath, virtualDevicePath))
+}
+
+func getURL(client *golangsdk.ServiceClient, userID string) string {
+	return v30(client.ServiceURL(rootPath, "users", userID, "virtual-mfa-device"))
+}
+
+func createURL(client *golangsdk.ServiceClient) string {
+	return v30(client.ServiceURL(rootPath, virtualDevicePath))
+}
+
+func deleteURL(client *golangsdk.ServiceClient) string {
+	return v30(client.ServiceURL(rootPath, virtualDevicePath))
+}
+
+func bindURL(client *golangsdk.ServiceClient) string {
+	return v30(client.ServiceURL(rootPath, "mfa-devices", "bind"))
+}
+
+func unbindURL(client *golangsdk.ServiceClient) string {
+	return v30(client.ServiceURL(rootPath, "mfa-devices", "unbind"))
+}
@@ -0,0 +1,48 @@
+/*
+Package policies manages custom policies (custom roles) in the OTC Identity Service.
+
+Example to Create a Custom Policy
+
+	document, err := policies.NewBuilder().
+		Allow("obs:bucket:ListAllMyBuckets", "obs:bucket:ListBucket").
+		Allow("obs:object:GetObject").
+		OnResources("OBS:*:*:object:my-bucket/*").
+		Deny("ecs:*:delete*").
+		When("StringStartWith", "g:ProjectName", "eu-de").
+		Build()
+	if err != nil {
+		panic(err)
+	}
+
+	createOpts := policies.CreateOpts{
+		DisplayName: "obs-readonly",
+		Type:        policies.TypeGlobal,
+		Description: "Read-only access to my-bucket",
+		Policy:      document,
+	}
+
+	policy, err := policies.Create(identityClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to List Custom Policies
+
+	allPages, err := policies.List(identityClient, nil).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allPolicies, err := policies.ExtractPolicies(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Custom Policy
+
+	err := policies.Delete(identityClient, policyID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package policies
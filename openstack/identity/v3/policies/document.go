@@ -0,0 +1,185 @@
+package policies
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// DocumentVersion is the only policy document version supported for custom policies.
+	DocumentVersion = "1.1"
+
+	EffectAllow = "Allow"
+	EffectDeny  = "Deny"
+)
+
+// Document is the JSON policy document of a custom policy.
+type Document struct {
+	// Version is the policy version, must be DocumentVersion.
+	Version string `json:"Version"`
+
+	// Statement contains the permissions granted or denied by the policy.
+	Statement []Statement `json:"Statement"`
+}
+
+// Condition maps a condition operator to the condition keys and their values, e.g.
+//
+//	Condition{"StringStartWith": {"g:ProjectName": {"eu-de"}}}
+type Condition map[string]map[string][]string
+
+// Statement is a single permission entry of a policy document.
+type Statement struct {
+	// Effect is either EffectAllow or EffectDeny.
+	Effect string `json:"Effect"`
+
+	// Action is a list of operations in the "service:resource:action" format,
+	// e.g. "obs:bucket:ListBucket". Wildcards (*) are allowed.
+	Action []string `json:"Action"`
+
+	// Condition restricts when the statement takes effect.
+	Condition Condition `json:"Condition,omitempty"`
+
+	// Resource is a list of resources in the "service:region:account:type:name" format,
+	// e.g. "OBS:*:*:bucket:my-bucket". Only some services support resource-level permissions.
+	Resource []string `json:"Resource,omitempty"`
+}
+
+// Validate checks the document against the OTC custom policy format.
+func (d Document) Validate() error {
+	if d.Version != DocumentVersion {
+		return fmt.Errorf("policy version must be %q, got %q", DocumentVersion, d.Version)
+	}
+	if len(d.Statement) == 0 {
+		return fmt.Errorf("policy must contain at least one statement")
+	}
+	for i, s := range d.Statement {
+		if err := s.Validate(); err != nil {
+			return fmt.Errorf("invalid statement #%d: %s", i, err)
+		}
+	}
+	return nil
+}
+
+// Validate checks the statement against the OTC custom policy format.
+func (s Statement) Validate() error {
+	if s.Effect != EffectAllow && s.Effect != EffectDeny {
+		return fmt.Errorf("effect must be %q or %q, got %q", EffectAllow, EffectDeny, s.Effect)
+	}
+	if len(s.Action) == 0 {
+		return fmt.Errorf("at least one action must be provided")
+	}
+	for _, action := range s.Action {
+		if err := ValidateAction(action); err != nil {
+			return err
+		}
+	}
+	for _, resource := range s.Resource {
+		if err := ValidateResource(resource); err != nil {
+			return err
+		}
+	}
+	for operator, keys := range s.Condition {
+		if operator == "" || len(keys) == 0 {
+			return fmt.Errorf("condition operator %q must have at least one key", operator)
+		}
+		for key, values := range keys {
+			if key == "" || len(values) == 0 {
+				return fmt.Errorf("condition key %q of operator %q must have at least one value", key, operator)
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateAction checks that the action has the "service:resource:action" format.
+func ValidateAction(action string) error {
+	parts := strings.Split(action, ":")
+	if len(parts) != 3 {
+		return fmt.Errorf("action %q must have the service:resource:action format", action)
+	}
+	for _, part := range parts {
+		if part == "" {
+			return fmt.Errorf("action %q contains an empty segment", action)
+		}
+	}
+	return nil
+}
+
+// ValidateResource checks that the resource has the "service:region:account:type:name" format.
+func ValidateResource(resource string) error {
+	parts := strings.SplitN(resource, ":", 5)
+	if len(parts) != 5 {
+		return fmt.Errorf("resource %q must have the service:region:account:type:name format", resource)
+	}
+	if parts[0] == "" || parts[3] == "" || parts[4] == "" {
+		return fmt.Errorf("resource %q must contain service, type and name", resource)
+	}
+	return nil
+}
+
+// Builder helps to compose a policy Document statement by statement.
+type Builder struct {
+	document Document
+}
+
+// NewBuilder returns a Builder for a document of DocumentVersion.
+func NewBuilder() *Builder {
+	return &Builder{document: Document{Version: DocumentVersion}}
+}
+
+// Allow adds a statement allowing the given actions.
+func (b *Builder) Allow(actions ...string) *Builder {
+	return b.addStatement(EffectAllow, actions)
+}
+
+// Deny adds a statement denying the given actions.
+func (b *Builder) Deny(actions ...string) *Builder {
+	return b.addStatement(EffectDeny, actions)
+}
+
+// OnResources restricts the last added statement to the given resources.
+func (b *Builder) OnResources(resources ...string) *Builder {
+	if s := b.last(); s != nil {
+		s.Resource = append(s.Resource, resources...)
+	}
+	return b
+}
+
+// When adds a condition to the last added statement.
+func (b *Builder) When(operator, key string, values ...string) *Builder {
+	s := b.last()
+	if s == nil {
+		return b
+	}
+	if s.Condition == nil {
+		s.Condition = Condition{}
+	}
+	if s.Condition[operator] == nil {
+		s.Condition[operator] = map[string][]string{}
+	}
+	s.Condition[operator][key] = append(s.Condition[operator][key], values...)
+	return b
+}
+
+// Build validates and returns the composed Document.
+func (b *Builder) Build() (Document, error) {
+	if err := b.document.Validate(); err != nil {
+		return Document{}, err
+	}
+	return b.document, nil
+}
+
+func (b *Builder) addStatement(effect string, actions []string) *Builder {
+	b.document.Statement = append(b.document.Statement, Statement{
+		Effect: effect,
+		Action: actions,
+	})
+	return b
+}
+
+func (b *Builder) last() *Statement {
+	if len(b.document.Statement) == 0 {
+		return nil
+	}
+	return &b.document.Statement[len(b.document.Statement)-1]
+}
@@ -0,0 +1,141 @@
+package policies
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
+)
+
+const (
+	// TypeGlobal is a policy applied to global services.
+	TypeGlobal = "AX"
+	// TypeProject is a policy applied to project-level services.
+	TypeProject = "XA"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to
+// the List request.
+type ListOptsBuilder interface {
+	ToPolicyListQuery() (string, error)
+}
+
+// ListOpts provides options to filter the List results.
+type ListOpts struct {
+	// Page is the page number for pagination query, starting from 1.
+	Page int `q:"page"`
+
+	// PerPage is the number of records per page, from 1 to 300.
+	PerPage int `q:"per_page"`
+}
+
+// ToPolicyListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToPolicyListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), nil
+}
+
+// List enumerates the custom policies of the domain.
+func List(client *golangsdk.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(client)
+	if opts != nil {
+		query, err := opts.ToPolicyListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return PolicyPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves details on a single custom policy, by ID.
+func Get(client *golangsdk.ServiceClient, id string) (r GetResult) {
+	_, r.Err = client.Get(resourceURL(client, id), &r.Body, nil)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to
+// the Create request.
+type CreateOptsBuilder interface {
+	ToPolicyCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts provides options used to create a custom policy.
+type CreateOpts struct {
+	// DisplayName is the name of the custom policy.
+	DisplayName string `json:"display_name" required:"true"`
+
+	// Type is the display mode of the policy, either TypeGlobal or TypeProject.
+	Type string `json:"type" required:"true"`
+
+	// Description is a description of the custom policy.
+	Description string `json:"description,omitempty"`
+
+	// DescriptionCn is a description of the custom policy in Chinese.
+	DescriptionCn string `json:"description_cn,omitempty"`
+
+	// Policy is the content of the custom policy.
+	Policy Document `json:"policy" required:"true"`
+}
+
+// ToPolicyCreateMap validates the policy document and formats a CreateOpts
+// into a create request.
+func (opts CreateOpts) ToPolicyCreateMap() (map[string]interface{}, error) {
+	if err := opts.Policy.Validate(); err != nil {
+		return nil, err
+	}
+	return golangsdk.BuildRequestBody(opts, "role")
+}
+
+// Create creates a new custom policy.
+func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToPolicyCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(rootURL(client), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to
+// the Update request.
+type UpdateOptsBuilder interface {
+	ToPolicyUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts provides options for updating a custom policy.
+// The API requires the complete policy definition to be sent.
+type UpdateOpts CreateOpts
+
+// ToPolicyUpdateMap validates the policy document and formats an UpdateOpts
+// into an update request.
+func (opts UpdateOpts) ToPolicyUpdateMap() (map[string]interface{}, error) {
+	return CreateOpts(opts).ToPolicyCreateMap()
+}
+
+// Update updates an existing custom policy.
+func Update(client *golangsdk.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToPolicyUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Patch(resourceURL(client, id), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete deletes a custom policy.
+func Delete(client *golangsdk.ServiceClient, id string) (r DeleteResult) {
+	_, r.Err = client.Delete(resourceURL(client, id), &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
@@ -0,0 +1,116 @@
+package policies
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
+)
+
+// Policy represents a custom IAM policy.
+type Policy struct {
+	// ID is the unique ID of the policy.
+	ID string `json:"id"`
+
+	// Name is the system name of the policy.
+	Name string `json:"name"`
+
+	// DisplayName is the display name of the policy.
+	DisplayName string `json:"display_name"`
+
+	// Type is the display mode of the policy.
+	Type string `json:"type"`
+
+	// Description is a description of the policy.
+	Description string `json:"description"`
+
+	// DescriptionCn is a description of the policy in Chinese.
+	DescriptionCn string `json:"description_cn"`
+
+	// DomainID is the ID of the domain the policy belongs to.
+	DomainID string `json:"domain_id"`
+
+	// Catalog is the service catalog of the policy.
+	Catalog string `json:"catalog"`
+
+	// Flag is the flag of the policy.
+	Flag string `json:"flag"`
+
+	// Policy is the content of the policy.
+	Policy Document `json:"policy"`
+
+	// Links contains referencing links to the policy.
+	Links map[string]interface{} `json:"links"`
+
+	// CreatedTime is the time when the policy was created.
+	CreatedTime string `json:"created_time"`
+
+	// UpdatedTime is the time when the policy was last updated.
+	UpdatedTime string `json:"updated_time"`
+}
+
+type policyResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets any policy result as a Policy.
+func (r policyResult) Extract() (*Policy, error) {
+	var s struct {
+		Role *Policy `json:"role"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Role, err
+}
+
+// GetResult is the response from a Get operation. Call its Extract method
+// to interpret it as a Policy.
+type GetResult struct {
+	policyResult
+}
+
+// CreateResult is the response from a Create operation. Call its Extract method
+// to interpret it as a Policy.
+type CreateResult struct {
+	policyResult
+}
+
+// UpdateResult is the response from an Update operation. Call its Extract
+// method to interpret it as a Policy.
+type UpdateResult struct {
+	policyResult
+}
+
+// DeleteResult is the response from a Delete operation. Call its ExtractErr to
+// determine if the request succeeded or failed.
+type DeleteResult struct {
+	golangsdk.ErrResult
+}
+
+// PolicyPage is a single page of Policy results.
+type PolicyPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty determines whether or not a PolicyPage contains any results.
+func (r PolicyPage) IsEmpty() (bool, error) {
+	policies, err := ExtractPolicies(r)
+	return len(policies) == 0, err
+}
+
+// NextPageURL extracts the "next" link from the links section of the result.
+func (r PolicyPage) NextPageURL() (string, error) {
+	var s struct {
+		Links struct {
+			Next string `json:"next"`
+		} `json:"links"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Links.Next, err
+}
+
+// ExtractPolicies returns a slice of Policies contained in a single page of results.
+func ExtractPolicies(r pagination.Page) ([]Policy, error) {
+	var s struct {
+		Roles []Policy `json:"roles"`
+	}
+	err := (r.(PolicyPage)).ExtractInto(&s)
+	return s.Roles, err
+}
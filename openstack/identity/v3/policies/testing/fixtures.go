@@ -0,0 +1,163 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/identity/v3/policies"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+// CreateRequest provides the input to a Create request.
+const CreateRequest = `
+{
+    "role": {
+        "display_name": "obs-readonly",
+        "type": "AX",
+        "description": "Read-only access",
+        "policy": {
+            "Version": "1.1",
+            "Statement": [
+                {
+                    "Effect": "Allow",
+                    "Action": ["obs:object:GetObject"],
+                    "Resource": ["OBS:*:*:object:my-bucket/*"],
+                    "Condition": {
+                        "StringStartWith": {
+                            "g:ProjectName": ["eu-de"]
+                        }
+                    }
+                }
+            ]
+        }
+    }
+}
+`
+
+const policyOutput = `
+        {
+            "id": "93879fd90f1046f69e6e0b31c94d2615",
+            "name": "custom_d78_obs",
+            "display_name": "obs-readonly",
+            "type": "AX",
+            "description": "Read-only access",
+            "domain_id": "d78cbac186b744899480f25bd022f468",
+            "catalog": "CUSTOMED",
+            "flag": "",
+            "links": {
+                "self": "https://iam.example.com/v3/roles/93879fd90f1046f69e6e0b31c94d2615"
+            },
+            "created_time": "1579229246886",
+            "updated_time": "1579229246886",
+            "policy": {
+                "Version": "1.1",
+                "Statement": [
+                    {
+                        "Effect": "Allow",
+                        "Action": ["obs:object:GetObject"],
+                        "Resource": ["OBS:*:*:object:my-bucket/*"],
+                        "Condition": {
+                            "StringStartWith": {
+                                "g:ProjectName": ["eu-de"]
+                            }
+                        }
+                    }
+                ]
+            }
+        }`
+
+// GetOutput provides a Get result.
+const GetOutput = `
+{
+    "role": ` + policyOutput + `
+}
+`
+
+// ListOutput provides a List result.
+const ListOutput = `
+{
+    "links": {
+        "self": "https://iam.example.com/v3.0/OS-ROLE/roles",
+        "next": null,
+        "previous": null
+    },
+    "roles": [` + policyOutput + `
+    ],
+    "total_number": 1
+}
+`
+
+var ExpectedPolicy = policies.Policy{
+	ID:          "93879fd90f1046f69e6e0b31c94d2615",
+	Name:        "custom_d78_obs",
+	DisplayName: "obs-readonly",
+	Type:        "AX",
+	Description: "Read-only access",
+	DomainID:    "d78cbac186b744899480f25bd022f468",
+	Catalog:     "CUSTOMED",
+	Links: map[string]interface{}{
+		"self": "https://iam.example.com/v3/roles/93879fd90f1046f69e6e0b31c94d2615",
+	},
+	CreatedTime: "1579229246886",
+	UpdatedTime: "1579229246886",
+	Policy: policies.Document{
+		Version: "1.1",
+		Statement: []policies.Statement{
+			{
+				Effect:   "Allow",
+				Action:   []string{"obs:object:GetObject"},
+				Resource: []string{"OBS:*:*:object:my-bucket/*"},
+				Condition: policies.Condition{
+					"StringStartWith": {
+						"g:ProjectName": {"eu-de"},
+					},
+				},
+			},
+		},
+	},
+}
+
+func HandleListPoliciesSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-ROLE/roles", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, ListOutput)
+	})
+}
+
+func HandleCreatePolicySuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-ROLE/roles", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprint(w, GetOutput)
+	})
+}
+
+func HandlePolicySuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-ROLE/roles/93879fd90f1046f69e6e0b31c94d2615", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		switch r.Method {
+		case "GET":
+		case "PATCH":
+			th.TestJSONRequest(t, r, CreateRequest)
+		case "DELETE":
+			w.WriteHeader(http.StatusOK)
+			return
+		default:
+			t.Errorf("Unexpected method: %s", r.Method)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, GetOutput)
+	})
+}
@@ -0,0 +1,100 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/identity/v3/policies"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	"github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func testDocument(t *testing.T) policies.Document {
+	document, err := policies.NewBuilder().
+		Allow("obs:object:GetObject").
+		OnResources("OBS:*:*:object:my-bucket/*").
+		When("StringStartWith", "g:ProjectName", "eu-de").
+		Build()
+	th.AssertNoErr(t, err)
+	return document
+}
+
+func TestListPolicies(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListPoliciesSuccessfully(t)
+
+	allPages, err := policies.List(client.ServiceClient(), nil).AllPages()
+	th.AssertNoErr(t, err)
+	actual, err := policies.ExtractPolicies(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []policies.Policy{ExpectedPolicy}, actual)
+}
+
+func TestCreatePolicy(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreatePolicySuccessfully(t)
+
+	actual, err := policies.Create(client.ServiceClient(), policies.CreateOpts{
+		DisplayName: "obs-readonly",
+		Type:        policies.TypeGlobal,
+		Description: "Read-only access",
+		Policy:      testDocument(t),
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedPolicy, *actual)
+}
+
+func TestGetUpdateDeletePolicy(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandlePolicySuccessfully(t)
+
+	id := "93879fd90f1046f69e6e0b31c94d2615"
+	actual, err := policies.Get(client.ServiceClient(), id).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedPolicy, *actual)
+
+	actual, err = policies.Update(client.ServiceClient(), id, policies.UpdateOpts{
+		DisplayName: "obs-readonly",
+		Type:        policies.TypeGlobal,
+		Description: "Read-only access",
+		Policy:      testDocument(t),
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedPolicy, *actual)
+
+	th.AssertNoErr(t, policies.Delete(client.ServiceClient(), id).ExtractErr())
+}
+
+func TestDocumentValidation(t *testing.T) {
+	_, err := policies.NewBuilder().Build()
+	th.AssertEquals(t, true, err != nil)
+
+	_, err = policies.NewBuilder().Allow("obs:GetObject").Build()
+	th.AssertEquals(t, true, err != nil)
+
+	_, err = policies.NewBuilder().Allow("obs:object:GetObject").OnResources("OBS:bucket").Build()
+	th.AssertEquals(t, true, err != nil)
+
+	err = policies.Document{
+		Version:   "1.0",
+		Statement: []policies.Statement{{Effect: policies.EffectAllow, Action: []string{"ecs:*:*"}}},
+	}.Validate()
+	th.AssertEquals(t, true, err != nil)
+
+	_, err = policies.Create(client.ServiceClient(), policies.CreateOpts{
+		DisplayName: "invalid",
+		Type:        policies.TypeProject,
+		Policy:      policies.Document{Version: policies.DocumentVersion},
+	}).Extract()
+	th.AssertEquals(t, true, err != nil)
+
+	document, err := policies.NewBuilder().
+		Allow("ecs:*:list*", "ecs:*:get*").
+		Deny("ecs:cloudServers:delete").
+		Build()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, len(document.Statement))
+	th.AssertEquals(t, policies.EffectDeny, document.Statement[1].Effect)
+}
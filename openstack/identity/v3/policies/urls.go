@@ -0,0 +1,22 @@
+package policies
+
+import (
+	"strings"
+
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+const (
+	rootPath     = "OS-ROLE"
+	resourcePath = "roles"
+)
+
+func rootURL(client *golangsdk.ServiceClient) string {
+	url := client.ServiceURL(rootPath, resourcePath)
+	return strings.Replace(url, "/v3/", "/v3.0/", 1)
+}
+
+func resourceURL(client *golangsdk.ServiceClient, id string) string {
+	url := client.ServiceURL(rootPath, resourcePath, id)
+	return strings.Replace(url, "/v3/", "/v3.0/", 1)
+}
@@ -54,5 +54,26 @@ Example to Delete a Project
 	if err != nil {
 		panic(err)
 	}
+
+Example to Suspend a Project
+
+	err := projects.Suspend(identityClient, projectID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to List Projects of a Region
+
+	allPages, err := projects.ListAvailable(identityClient).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allProjects, err := projects.ExtractProjects(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	regionProjects := projects.FilterByRegion(allProjects, "eu-de")
 */
 package projects
@@ -1,6 +1,8 @@
 package projects
 
 import (
+	"strings"
+
 	"github.com/opentelekomcloud/gophertelekomcloud"
 	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
 )
@@ -21,6 +23,18 @@ type ListOpts struct {
 
 	// ParentID filters the response by projects of a given parent project.
 	ParentID string `q:"parent_id"`
+
+	// Enabled filters the response by enabled projects.
+	Enabled *bool `q:"enabled"`
+
+	// IsDomain filters the response by projects acting as a domain.
+	IsDomain *bool `q:"is_domain"`
+
+	// Page is the page number for pagination query, starting from 1.
+	Page int `q:"page"`
+
+	// PerPage is the number of records per page, from 1 to 5000.
+	PerPage int `q:"per_page"`
 }
 
 // ToProjectListQuery formats a ListOpts into a query string.
@@ -47,6 +61,25 @@ func List(client *golangsdk.ServiceClient, opts ListOptsBuilder) pagination.Page
 	})
 }
 
+// ListAvailable enumerates the Projects accessible to the user of the current token.
+func ListAvailable(client *golangsdk.ServiceClient) pagination.Pager {
+	return pagination.NewPager(client, listAvailableURL(client), func(r pagination.PageResult) pagination.Page {
+		return ProjectPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// FilterByRegion returns the projects belonging to the given region, i.e. the
+// region project itself (e.g. "eu-de") and its subprojects (e.g. "eu-de_project").
+func FilterByRegion(projects []Project, region string) []Project {
+	var filtered []Project
+	for _, p := range projects {
+		if p.Name == region || strings.HasPrefix(p.Name, region+"_") {
+			filtered = append(filtered, p)
+		}
+	}
+	return filtered
+}
+
 // Get retrieves details on a single project, by ID.
 func Get(client *golangsdk.ServiceClient, id string) (r GetResult) {
 	_, r.Err = client.Get(getURL(client, id), &r.Body, nil)
@@ -130,3 +163,54 @@ func Update(client *golangsdk.ServiceClient, id string, opts UpdateOptsBuilder)
 	})
 	return
 }
+
+const (
+	StatusNormal    = "normal"
+	StatusSuspended = "suspended"
+)
+
+// GetDetails retrieves details on a single project including its status, by ID.
+func GetDetails(client *golangsdk.ServiceClient, id string) (r GetResult) {
+	_, r.Err = client.Get(extendedURL(client, id), &r.Body, nil)
+	return
+}
+
+// SetStatusOptsBuilder allows extensions to add additional parameters to
+// the SetStatus request.
+type SetStatusOptsBuilder interface {
+	ToProjectStatusMap() (map[string]interface{}, error)
+}
+
+// SetStatusOpts represents parameters to set the status of a project.
+type SetStatusOpts struct {
+	// Status is either StatusNormal or StatusSuspended.
+	Status string `json:"status" required:"true"`
+}
+
+// ToProjectStatusMap formats a SetStatusOpts into a request body.
+func (opts SetStatusOpts) ToProjectStatusMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "project")
+}
+
+// SetStatus sets the status of a project.
+func SetStatus(client *golangsdk.ServiceClient, id string, opts SetStatusOptsBuilder) (r SetStatusResult) {
+	b, err := opts.ToProjectStatusMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(extendedURL(client, id), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// Suspend suspends a project.
+func Suspend(client *golangsdk.ServiceClient, id string) (r SetStatusResult) {
+	return SetStatus(client, id, SetStatusOpts{Status: StatusSuspended})
+}
+
+// Resume sets the status of a suspended project back to normal.
+func Resume(client *golangsdk.ServiceClient, id string) (r SetStatusResult) {
+	return SetStatus(client, id, SetStatusOpts{Status: StatusNormal})
+}
@@ -27,6 +27,12 @@ type DeleteResult struct {
 	golangsdk.ErrResult
 }
 
+// SetStatusResult is the result of a SetStatus request. Call its ExtractErr method to
+// determine if the request succeeded or failed.
+type SetStatusResult struct {
+	golangsdk.ErrResult
+}
+
 // UpdateResult is the result of an Update request. Call its Extract method to
 // interpret it as a Project.
 type UpdateResult struct {
@@ -55,6 +61,12 @@ type Project struct {
 
 	// ParentID is the parent_id of the project.
 	ParentID string `json:"parent_id"`
+
+	// Status is the status of the project, returned by GetDetails only.
+	Status string `json:"status,omitempty"`
+
+	// SuspendReason is the reason why the project was suspended, returned by GetDetails only.
+	SuspendReason string `json:"suspend_reason,omitempty"`
 }
 
 // ProjectPage is a single page of Project results.
@@ -190,3 +190,37 @@ func HandleUpdateProjectSuccessfully(t *testing.T) {
 		_, _ = fmt.Fprint(w, UpdateOutput)
 	})
 }
+
+// SuspendRequest provides the input to a SetStatus request.
+const SuspendRequest = `
+{
+  "project": {
+    "status": "suspended"
+  }
+}
+`
+
+// HandleSuspendProjectSuccessfully creates an HTTP handler at `/projects/1234` on the
+// test handler mux that tests project suspension.
+func HandleSuspendProjectSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/projects/1234", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, SuspendRequest)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandleListAvailableProjectsSuccessfully creates an HTTP handler at `/auth/projects` on the
+// test handler mux that responds with a list of two tenants.
+func HandleListAvailableProjectsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/auth/projects", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, ListOutput)
+	})
+}
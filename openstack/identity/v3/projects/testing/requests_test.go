@@ -77,3 +77,35 @@ func TestUpdateProject(t *testing.T) {
 	th.AssertNoErr(t, err)
 	th.CheckDeepEquals(t, UpdatedRedTeam, *actual)
 }
+
+func TestSuspendProject(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleSuspendProjectSuccessfully(t)
+
+	err := projects.Suspend(client.ServiceClient(), "1234").ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestListAvailableProjects(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListAvailableProjectsSuccessfully(t)
+
+	allPages, err := projects.ListAvailable(client.ServiceClient()).AllPages()
+	th.AssertNoErr(t, err)
+	actual, err := projects.ExtractProjects(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedProjectSlice, actual)
+}
+
+func TestFilterByRegion(t *testing.T) {
+	all := []projects.Project{
+		{Name: "eu-de"},
+		{Name: "eu-de_dev"},
+		{Name: "eu-nl"},
+		{Name: "eu-nl_eu-de"},
+	}
+	actual := projects.FilterByRegion(all, "eu-de")
+	th.CheckDeepEquals(t, []projects.Project{{Name: "eu-de"}, {Name: "eu-de_dev"}}, actual)
+}
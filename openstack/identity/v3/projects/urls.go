@@ -1,6 +1,10 @@
 package projects
 
-import "github.com/opentelekomcloud/gophertelekomcloud"
+import (
+	"strings"
+
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
 
 func listURL(client *golangsdk.ServiceClient) string {
 	return client.ServiceURL("projects")
@@ -21,3 +25,12 @@ func deleteURL(client *golangsdk.ServiceClient, projectID string) string {
 func updateURL(client *golangsdk.ServiceClient, projectID string) string {
 	return client.ServiceURL("projects", projectID)
 }
+
+func listAvailableURL(client *golangsdk.ServiceClient) string {
+	return client.ServiceURL("auth", "projects")
+}
+
+func extendedURL(client *golangsdk.ServiceClient, projectID string) string {
+	url := client.ServiceURL("projects", projectID)
+	return strings.Replace(url, "/v3/", "/v3-ext/", 1)
+}
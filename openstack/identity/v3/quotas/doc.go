@@ -0,0 +1,17 @@
+/*
+Package quotas retrieves the IAM resource quotas of domains and projects.
+IAM quotas are read-only, service quotas of a project are managed by the
+compute and blockstorage quotasets packages.
+
+Example to Get Project Quota
+
+	resources, err := quotas.GetProjectQuota(identityClient, projectID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	for _, resource := range resources {
+		fmt.Printf("%s: %d of %d used\n", resource.Type, resource.Used, resource.Quota)
+	}
+*/
+package quotas
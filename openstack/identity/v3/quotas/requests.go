@@ -0,0 +1,29 @@
+package quotas
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// GetProjectQuota retrieves the IAM quotas of a project.
+func GetProjectQuota(client *golangsdk.ServiceClient, projectID string) (r GetResult) {
+	_, r.Err = client.Get(getURL(client, "projects", projectID), &r.Body, nil)
+	return
+}
+
+// GetDomainQuotaOpts allows to filter the quota types returned by GetDomainQuota.
+type GetDomainQuotaOpts struct {
+	// Type is the quota type, one of: user, group, idp, agency, policy,
+	// assigment_group_mp, assigment_agency_mp, assigment_group_ep, assigment_user_ep, mapping.
+	Type string `q:"type"`
+}
+
+// GetDomainQuota retrieves the IAM quotas of a domain.
+func GetDomainQuota(client *golangsdk.ServiceClient, domainID string, opts GetDomainQuotaOpts) (r GetResult) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Get(getURL(client, "domains", domainID)+q.String(), &r.Body, nil)
+	return
+}
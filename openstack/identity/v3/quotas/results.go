@@ -0,0 +1,40 @@
+package quotas
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// Resource is the quota information of a single resource type.
+type Resource struct {
+	// Type is the quota type, e.g. "project".
+	Type string `json:"type"`
+
+	// Quota is the current quota.
+	Quota int `json:"quota"`
+
+	// Used is the used quota.
+	Used int `json:"used"`
+
+	// Max is the maximum quota.
+	Max int `json:"max"`
+
+	// Min is the minimum quota.
+	Min int `json:"min"`
+}
+
+// GetResult is the response from a GetProjectQuota or GetDomainQuota operation.
+// Call its Extract method to interpret it as a slice of Resource.
+type GetResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets a GetResult as a slice of Resource.
+func (r GetResult) Extract() ([]Resource, error) {
+	var s struct {
+		Quotas struct {
+			Resources []Resource `json:"resources"`
+		} `json:"quotas"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Quotas.Resources, err
+}
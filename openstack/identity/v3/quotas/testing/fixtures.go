@@ -0,0 +1,52 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/identity/v3/quotas"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	"github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const GetOutput = `
+{
+    "quotas": {
+        "resources": [
+            {
+                "max": 50,
+                "min": 0,
+                "quota": 10,
+                "type": "project",
+                "used": 4
+            }
+        ]
+    }
+}
+`
+
+var ExpectedResources = []quotas.Resource{
+	{
+		Type:  "project",
+		Quota: 10,
+		Used:  4,
+		Max:   50,
+	},
+}
+
+func HandleGetQuotaSuccessfully(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, GetOutput)
+	}
+	th.Mux.HandleFunc("/OS-QUOTA/projects/1234", handler)
+	th.Mux.HandleFunc("/OS-QUOTA/domains/default", func(w http.ResponseWriter, r *http.Request) {
+		th.TestFormValues(t, r, map[string]string{"type": "project"})
+		handler(w, r)
+	})
+}
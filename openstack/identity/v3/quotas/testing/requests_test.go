@@ -0,0 +1,23 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/identity/v3/quotas"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	"github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestGetQuota(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetQuotaSuccessfully(t)
+
+	actual, err := quotas.GetProjectQuota(client.ServiceClient(), "1234").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedResources, actual)
+
+	actual, err = quotas.GetDomainQuota(client.ServiceClient(), "default", quotas.GetDomainQuotaOpts{Type: "project"}).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedResources, actual)
+}
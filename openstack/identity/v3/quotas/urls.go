@@ -0,0 +1,14 @@
+package quotas
+
+import (
+	"strings"
+
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+const rootPath = "OS-QUOTA"
+
+func getURL(client *golangsdk.ServiceClient, resource, id string) string {
+	url := client.ServiceURL(rootPath, resource, id)
+	return strings.Replace(url, "/v3/", "/v3.0/", 1)
+}
@@ -108,5 +108,25 @@ Example to Unassign a Role From a User in a Project
 	if err != nil {
 		panic(err)
 	}
+
+Example to Assign a Role to a Group on All Projects of a Domain
+
+	err := roles.AssignInherited(identityClient, roleID, roles.InheritedAssignOpts{
+		DomainID: domainID,
+		GroupID:  groupID,
+	}).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Assign a Role to a Group on an Enterprise Project
+
+	err := roles.AssignOnEnterpriseProject(identityClient, roleID, roles.EnterpriseProjectOpts{
+		EnterpriseProjectID: "0",
+		GroupID:             groupID,
+	}).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
 */
 package roles
@@ -323,3 +323,153 @@ func Unassign(client *golangsdk.ServiceClient, roleID string, opts UnassignOpts)
 	})
 	return
 }
+
+// CheckAssignment checks whether a role is assigned to a user/group on a project/domain.
+func CheckAssignment(client *golangsdk.ServiceClient, roleID string, opts AssignOpts) (bool, error) {
+	// Check xor conditions
+	_, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return false, err
+	}
+
+	targetType, targetID, actorType, actorID, _ := ListAssignmentsOpts{
+		GroupID:        opts.GroupID,
+		ScopeDomainID:  opts.DomainID,
+		ScopeProjectID: opts.ProjectID,
+		UserID:         opts.UserID,
+	}.extractAssignment()
+
+	resp, err := client.Request("HEAD", assignURL(client, targetType, targetID, actorType, actorID, roleID), &golangsdk.RequestOpts{
+		OkCodes: []int{204, 404},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return resp.StatusCode == 204, nil
+}
+
+// InheritedAssignOpts provides options to assign a role to a group
+// on all projects of a domain.
+type InheritedAssignOpts struct {
+	// DomainID is the ID of a domain to assign a role on
+	DomainID string `required:"true"`
+
+	// GroupID is the ID of a group to assign a role
+	GroupID string `required:"true"`
+}
+
+// AssignInherited assigns a role to a group on all projects
+// (including the ones created later) of a domain.
+func AssignInherited(client *golangsdk.ServiceClient, roleID string, opts InheritedAssignOpts) (r AssignmentResult) {
+	_, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Put(inheritedAssignURL(client, opts.DomainID, opts.GroupID, roleID), nil, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// CheckInherited checks whether a role is assigned to a group on all projects of a domain.
+func CheckInherited(client *golangsdk.ServiceClient, roleID string, opts InheritedAssignOpts) (bool, error) {
+	_, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return false, err
+	}
+
+	resp, err := client.Request("HEAD", inheritedAssignURL(client, opts.DomainID, opts.GroupID, roleID), &golangsdk.RequestOpts{
+		OkCodes: []int{204, 404},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return resp.StatusCode == 204, nil
+}
+
+// UnassignInherited removes a role assigned to a group on all projects of a domain.
+func UnassignInherited(client *golangsdk.ServiceClient, roleID string, opts InheritedAssignOpts) (r UnassignmentResult) {
+	_, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Delete(inheritedAssignURL(client, opts.DomainID, opts.GroupID, roleID), &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// EnterpriseProjectOpts provides options to manage role assignments
+// on an enterprise project.
+type EnterpriseProjectOpts struct {
+	// EnterpriseProjectID is the ID of an enterprise project to assign a role on
+	EnterpriseProjectID string `required:"true"`
+
+	// UserID is the ID of a user to assign a role
+	// Note: exactly one of UserID or GroupID must be provided
+	UserID string `xor:"GroupID"`
+
+	// GroupID is the ID of a group to assign a role
+	// Note: exactly one of UserID or GroupID must be provided
+	GroupID string `xor:"UserID"`
+}
+
+func (opts EnterpriseProjectOpts) extractActor() (string, string, error) {
+	// Check required and xor conditions
+	_, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return "", "", err
+	}
+
+	if opts.UserID != "" {
+		return "users", opts.UserID, nil
+	}
+	return "groups", opts.GroupID, nil
+}
+
+// AssignOnEnterpriseProject assigns a role to a user/group on an enterprise project.
+func AssignOnEnterpriseProject(client *golangsdk.ServiceClient, roleID string, opts EnterpriseProjectOpts) (r AssignmentResult) {
+	actorType, actorID, err := opts.extractActor()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Put(enterpriseProjectAssignURL(client, opts.EnterpriseProjectID, actorType, actorID, roleID), nil, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// UnassignOnEnterpriseProject removes a role assigned to a user/group on an enterprise project.
+func UnassignOnEnterpriseProject(client *golangsdk.ServiceClient, roleID string, opts EnterpriseProjectOpts) (r UnassignmentResult) {
+	actorType, actorID, err := opts.extractActor()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Delete(enterpriseProjectAssignURL(client, opts.EnterpriseProjectID, actorType, actorID, roleID), &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// ListOnEnterpriseProject enumerates the roles assigned to a user/group on an enterprise project.
+func ListOnEnterpriseProject(client *golangsdk.ServiceClient, opts EnterpriseProjectOpts) pagination.Pager {
+	actorType, actorID, err := opts.extractActor()
+	if err != nil {
+		return pagination.Pager{Err: err}
+	}
+
+	url := enterpriseProjectRolesURL(client, opts.EnterpriseProjectID, actorType, actorID)
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return RoleAssignmentPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
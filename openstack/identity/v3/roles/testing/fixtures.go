@@ -373,3 +373,88 @@ func HandleListRoleAssignmentsSuccessfully(t *testing.T) {
 		_, _ = fmt.Fprint(w, ListAssignmentOutput)
 	})
 }
+
+func HandleCheckAssignmentNotFound(t *testing.T) {
+	th.Mux.HandleFunc("/projects/{project_id}/groups/{group_id}/roles/{role_id}", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "HEAD")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNotFound)
+	})
+}
+
+func HandleInheritedAssignSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-INHERIT/domains/{domain_id}/groups/{group_id}/roles/{role_id}/inherited_to_projects", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		switch r.Method {
+		case "PUT", "HEAD", "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected method: %s", r.Method)
+		}
+	})
+}
+
+const ListEnterpriseProjectRolesOutput = `
+{
+    "roles": [
+        {
+            "catalog": "BASE",
+            "description": "Tenant Guest",
+            "display_name": "Tenant Guest",
+            "id": "9fe2ff9ee4384b1894a90878d3e92bab",
+            "name": "readonly",
+            "type": "AX",
+            "policy": {
+                "Version": "1.0",
+                "Statement": [
+                    {
+                        "Action": ["*:*:get*"],
+                        "Effect": "Allow"
+                    }
+                ]
+            }
+        }
+    ]
+}
+`
+
+var ExpectedEnterpriseProjectRoles = []roles.RoleAssignment{
+	{
+		Catalog:     "BASE",
+		Description: "Tenant Guest",
+		DisplayName: "Tenant Guest",
+		ID:          "9fe2ff9ee4384b1894a90878d3e92bab",
+		Name:        "readonly",
+		Type:        "AX",
+		Policy: roles.Policy{
+			Version: "1.0",
+			Statement: []roles.Statement{
+				{
+					Action: []string{"*:*:get*"},
+					Effect: "Allow",
+				},
+			},
+		},
+	},
+}
+
+func HandleEnterpriseProjectSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-PERMISSION/enterprise-projects/{ep_id}/groups/{group_id}/roles/{role_id}", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		switch r.Method {
+		case "PUT", "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected method: %s", r.Method)
+		}
+	})
+
+	th.Mux.HandleFunc("/OS-PERMISSION/enterprise-projects/{ep_id}/users/{user_id}/roles", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, ListEnterpriseProjectRolesOutput)
+	})
+}
@@ -155,3 +155,67 @@ func TestUnassign(t *testing.T) {
 	}).ExtractErr()
 	th.AssertNoErr(t, err)
 }
+
+func TestInheritedAssignment(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleInheritedAssignSuccessfully(t)
+
+	opts := roles.InheritedAssignOpts{
+		DomainID: "{domain_id}",
+		GroupID:  "{group_id}",
+	}
+
+	err := roles.AssignInherited(client.ServiceClient(), "{role_id}", opts).ExtractErr()
+	th.AssertNoErr(t, err)
+
+	ok, err := roles.CheckInherited(client.ServiceClient(), "{role_id}", opts)
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, true, ok)
+
+	err = roles.UnassignInherited(client.ServiceClient(), "{role_id}", opts).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestCheckAssignment(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCheckAssignmentNotFound(t)
+
+	ok, err := roles.CheckAssignment(client.ServiceClient(), "{role_id}", roles.AssignOpts{
+		GroupID:   "{group_id}",
+		ProjectID: "{project_id}",
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, false, ok)
+}
+
+func TestEnterpriseProjectAssignment(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleEnterpriseProjectSuccessfully(t)
+
+	opts := roles.EnterpriseProjectOpts{
+		EnterpriseProjectID: "{ep_id}",
+		GroupID:             "{group_id}",
+	}
+	err := roles.AssignOnEnterpriseProject(client.ServiceClient(), "{role_id}", opts).ExtractErr()
+	th.AssertNoErr(t, err)
+
+	err = roles.UnassignOnEnterpriseProject(client.ServiceClient(), "{role_id}", opts).ExtractErr()
+	th.AssertNoErr(t, err)
+
+	allPages, err := roles.ListOnEnterpriseProject(client.ServiceClient(), roles.EnterpriseProjectOpts{
+		EnterpriseProjectID: "{ep_id}",
+		UserID:              "{user_id}",
+	}).AllPages()
+	th.AssertNoErr(t, err)
+	actual, err := roles.ExtractRoleAssignments(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedEnterpriseProjectRoles, actual)
+
+	err = roles.AssignOnEnterpriseProject(client.ServiceClient(), "{role_id}", roles.EnterpriseProjectOpts{
+		EnterpriseProjectID: "{ep_id}",
+	}).ExtractErr()
+	th.AssertEquals(t, true, err != nil)
+}
@@ -1,9 +1,17 @@
 package roles
 
-import "github.com/opentelekomcloud/gophertelekomcloud"
+import (
+	"strings"
+
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
 
 const (
-	rolePath = "roles"
+	rolePath              = "roles"
+	inheritPath           = "OS-INHERIT"
+	inheritedPath         = "inherited_to_projects"
+	permissionPath        = "OS-PERMISSION"
+	enterpriseProjectPath = "enterprise-projects"
 )
 
 func listURL(client *golangsdk.ServiceClient) string {
@@ -33,3 +41,17 @@ func listAssignmentsURL(client *golangsdk.ServiceClient, targetType, targetID, a
 func assignURL(client *golangsdk.ServiceClient, targetType, targetID, actorType, actorID, roleID string) string {
 	return client.ServiceURL(targetType, targetID, actorType, actorID, rolePath, roleID)
 }
+
+func inheritedAssignURL(client *golangsdk.ServiceClient, domainID, groupID, roleID string) string {
+	return client.ServiceURL(inheritPath, "domains", domainID, "groups", groupID, rolePath, roleID, inheritedPath)
+}
+
+func enterpriseProjectRolesURL(client *golangsdk.ServiceClient, enterpriseProjectID, actorType, actorID string) string {
+	url := client.ServiceURL(permissionPath, enterpriseProjectPath, enterpriseProjectID, actorType, actorID, rolePath)
+	return strings.Replace(url, "/v3/", "/v3.0/", 1)
+}
+
+func enterpriseProjectAssignURL(client *golangsdk.ServiceClient, enterpriseProjectID, actorType, actorID, roleID string) string {
+	url := client.ServiceURL(permissionPath, enterpriseProjectPath, enterpriseProjectID, actorType, actorID, rolePath, roleID)
+	return strings.Replace(url, "/v3/", "/v3.0/", 1)
+}
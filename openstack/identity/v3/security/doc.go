@@ -0,0 +1,39 @@
+/*
+Package security manages the account security settings of a domain in the OTC
+Identity Service: password policy, login authentication policy, operation
+protection policy and console/API access control lists.
+
+Example to Enforce a Password Policy
+
+	minLength := 12
+	combination := 3
+	policy, err := security.UpdatePasswordPolicy(identityClient, domainID, security.PasswordPolicyOpts{
+		MinimumPasswordLength:   &minLength,
+		PasswordCharCombination: &combination,
+	}).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Enable Operation Protection
+
+	enabled := true
+	_, err := security.UpdateProtectPolicy(identityClient, domainID, security.ProtectPolicyOpts{
+		OperationProtection: &enabled,
+	}).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Restrict Console Access to a Network
+
+	acl, err := security.UpdateConsoleACLPolicy(identityClient, domainID, security.ACLPolicyOpts{
+		AllowAddressNetmasks: []security.AllowAddressNetmask{
+			{AddressNetmask: "192.168.0.0/24", Description: "office"},
+		},
+	}).Extract()
+	if err != nil {
+		panic(err)
+	}
+*/
+package security
@@ -0,0 +1,242 @@
+package security
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// PasswordPolicyOptsBuilder allows extensions to add additional parameters to
+// the UpdatePasswordPolicy request.
+type PasswordPolicyOptsBuilder interface {
+	ToPasswordPolicyMap() (map[string]interface{}, error)
+}
+
+// PasswordPolicyOpts provides options to modify the password policy of a domain.
+type PasswordPolicyOpts struct {
+	// MaximumConsecutiveIdenticalChars is the maximum number of times that a character
+	// is allowed to consecutively present in a password, 0 means no limit.
+	MaximumConsecutiveIdenticalChars *int `json:"maximum_consecutive_identical_chars,omitempty"`
+
+	// MinimumPasswordAge is the minimum period (minutes) after which users are allowed
+	// to make a password change, 0 means no limit.
+	MinimumPasswordAge *int `json:"minimum_password_age,omitempty"`
+
+	// MinimumPasswordLength is the minimum number of characters that a password must contain.
+	MinimumPasswordLength *int `json:"minimum_password_length,omitempty"`
+
+	// NumberOfRecentPasswordsDisallowed is the number of previously used passwords
+	// that are not allowed.
+	NumberOfRecentPasswordsDisallowed *int `json:"number_of_recent_passwords_disallowed,omitempty"`
+
+	// PasswordNotUsernameOrInvert indicates whether the password can be the username
+	// or the username spelled backwards.
+	PasswordNotUsernameOrInvert *bool `json:"password_not_username_or_invert,omitempty"`
+
+	// PasswordValidityPeriod is the password validity period (days), 0 means no limit.
+	PasswordValidityPeriod *int `json:"password_validity_period,omitempty"`
+
+	// PasswordCharCombination is the minimum number of character types that a password must contain.
+	PasswordCharCombination *int `json:"password_char_combination,omitempty"`
+}
+
+// ToPasswordPolicyMap formats a PasswordPolicyOpts into a request body.
+func (opts PasswordPolicyOpts) ToPasswordPolicyMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "password_policy")
+}
+
+// GetPasswordPolicy retrieves the password policy of a domain.
+func GetPasswordPolicy(client *golangsdk.ServiceClient, domainID string) (r PasswordPolicyResult) {
+	_, r.Err = client.Get(policyURL(client, domainID, passwordPolicyPath), &r.Body, nil)
+	return
+}
+
+// UpdatePasswordPolicy modifies the password policy of a domain.
+func UpdatePasswordPolicy(client *golangsdk.ServiceClient, domainID string, opts PasswordPolicyOptsBuilder) (r PasswordPolicyResult) {
+	b, err := opts.ToPasswordPolicyMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(policyURL(client, domainID, passwordPolicyPath), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// LoginPolicyOptsBuilder allows extensions to add additional parameters to
+// the UpdateLoginPolicy request.
+type LoginPolicyOptsBuilder interface {
+	ToLoginPolicyMap() (map[string]interface{}, error)
+}
+
+// LoginPolicyOpts provides options to modify the login authentication policy of a domain.
+type LoginPolicyOpts struct {
+	// AccountValidityPeriod is the validity period (days) to disable users
+	// if they have not logged in within the period, 0 means no limit.
+	AccountValidityPeriod *int `json:"account_validity_period,omitempty"`
+
+	// CustomInfoForLogin is a custom information that will be displayed upon successful login.
+	CustomInfoForLogin *string `json:"custom_info_for_login,omitempty"`
+
+	// LockoutDuration is the duration (minutes) to lock users out.
+	LockoutDuration *int `json:"lockout_duration,omitempty"`
+
+	// LoginFailedTimes is the number of unsuccessful login attempts to lock users out.
+	LoginFailedTimes *int `json:"login_failed_times,omitempty"`
+
+	// PeriodWithLoginFailures is the period (minutes) to count the number of unsuccessful login attempts.
+	PeriodWithLoginFailures *int `json:"period_with_login_failures,omitempty"`
+
+	// SessionTimeout is the session timeout (minutes) that will apply if you or users
+	// created using your account do not perform any operations within a specific period.
+	SessionTimeout *int `json:"session_timeout,omitempty"`
+
+	// ShowRecentLoginInfo indicates whether to display last login information upon successful login.
+	ShowRecentLoginInfo *bool `json:"show_recent_login_info,omitempty"`
+}
+
+// ToLoginPolicyMap formats a LoginPolicyOpts into a request body.
+func (opts LoginPolicyOpts) ToLoginPolicyMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "login_policy")
+}
+
+// GetLoginPolicy retrieves the login authentication policy of a domain.
+func GetLoginPolicy(client *golangsdk.ServiceClient, domainID string) (r LoginPolicyResult) {
+	_, r.Err = client.Get(policyURL(client, domainID, loginPolicyPath), &r.Body, nil)
+	return
+}
+
+// UpdateLoginPolicy modifies the login authentication policy of a domain.
+func UpdateLoginPolicy(client *golangsdk.ServiceClient, domainID string, opts LoginPolicyOptsBuilder) (r LoginPolicyResult) {
+	b, err := opts.ToLoginPolicyMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(policyURL(client, domainID, loginPolicyPath), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// ProtectPolicyOptsBuilder allows extensions to add additional parameters to
+// the UpdateProtectPolicy request.
+type ProtectPolicyOptsBuilder interface {
+	ToProtectPolicyMap() (map[string]interface{}, error)
+}
+
+// AllowUser specifies which attributes IAM users are allowed to modify by themselves.
+type AllowUser struct {
+	ManageAccessKey *bool `json:"manage_accesskey,omitempty"`
+	ManageEmail     *bool `json:"manage_email,omitempty"`
+	ManageMobile    *bool `json:"manage_mobile,omitempty"`
+	ManagePassword  *bool `json:"manage_password,omitempty"`
+}
+
+// ProtectPolicyOpts provides options to modify the operation protection policy of a domain.
+type ProtectPolicyOpts struct {
+	// OperationProtection indicates whether operation protection is enabled.
+	OperationProtection *bool `json:"operation_protection" required:"true"`
+
+	// AllowUser specifies which attributes IAM users are allowed to modify.
+	AllowUser *AllowUser `json:"allow_user,omitempty"`
+}
+
+// ToProtectPolicyMap formats a ProtectPolicyOpts into a request body.
+func (opts ProtectPolicyOpts) ToProtectPolicyMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "protect_policy")
+}
+
+// GetProtectPolicy retrieves the operation protection policy of a domain.
+func GetProtectPolicy(client *golangsdk.ServiceClient, domainID string) (r ProtectPolicyResult) {
+	_, r.Err = client.Get(policyURL(client, domainID, protectPolicyPath), &r.Body, nil)
+	return
+}
+
+// UpdateProtectPolicy modifies the operation protection policy of a domain.
+func UpdateProtectPolicy(client *golangsdk.ServiceClient, domainID string, opts ProtectPolicyOptsBuilder) (r ProtectPolicyResult) {
+	b, err := opts.ToProtectPolicyMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(policyURL(client, domainID, protectPolicyPath), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// ACLPolicyOptsBuilder allows extensions to add additional parameters to
+// the UpdateConsoleACLPolicy and UpdateAPIACLPolicy requests.
+type ACLPolicyOptsBuilder interface {
+	ToACLPolicyMap(parent string) (map[string]interface{}, error)
+}
+
+// AllowAddressNetmask is an IPv4 CIDR block allowed to access.
+type AllowAddressNetmask struct {
+	AddressNetmask string `json:"address_netmask" required:"true"`
+	Description    string `json:"description,omitempty"`
+}
+
+// AllowIPRange is an IP address range allowed to access.
+type AllowIPRange struct {
+	IPRange     string `json:"ip_range" required:"true"`
+	Description string `json:"description,omitempty"`
+}
+
+// ACLPolicyOpts provides options to modify the console or API access control policy of a domain.
+// Empty lists allow access from all IP addresses.
+type ACLPolicyOpts struct {
+	// AllowAddressNetmasks contains the IPv4 CIDR blocks, up to 200 entries.
+	AllowAddressNetmasks []AllowAddressNetmask `json:"allow_address_netmasks"`
+
+	// AllowIPRanges contains the IP address ranges, up to 200 entries.
+	AllowIPRanges []AllowIPRange `json:"allow_ip_ranges"`
+}
+
+// ToACLPolicyMap formats an ACLPolicyOpts into a request body.
+func (opts ACLPolicyOpts) ToACLPolicyMap(parent string) (map[string]interface{}, error) {
+	if opts.AllowAddressNetmasks == nil {
+		opts.AllowAddressNetmasks = []AllowAddressNetmask{}
+	}
+	if opts.AllowIPRanges == nil {
+		opts.AllowIPRanges = []AllowIPRange{}
+	}
+	return golangsdk.BuildRequestBody(opts, parent)
+}
+
+// GetConsoleACLPolicy retrieves the console access control policy of a domain.
+func GetConsoleACLPolicy(client *golangsdk.ServiceClient, domainID string) (r ACLPolicyResult) {
+	r.parent = consoleACLPolicy
+	_, r.Err = client.Get(policyURL(client, domainID, consoleACLPolicyPath), &r.Body, nil)
+	return
+}
+
+// UpdateConsoleACLPolicy modifies the console access control policy of a domain.
+func UpdateConsoleACLPolicy(client *golangsdk.ServiceClient, domainID string, opts ACLPolicyOptsBuilder) (r ACLPolicyResult) {
+	return updateACLPolicy(client, policyURL(client, domainID, consoleACLPolicyPath), consoleACLPolicy, opts)
+}
+
+// GetAPIACLPolicy retrieves the API access control policy of a domain.
+func GetAPIACLPolicy(client *golangsdk.ServiceClient, domainID string) (r ACLPolicyResult) {
+	r.parent = apiACLPolicy
+	_, r.Err = client.Get(policyURL(client, domainID, apiACLPolicyPath), &r.Body, nil)
+	return
+}
+
+// UpdateAPIACLPolicy modifies the API access control policy of a domain.
+func UpdateAPIACLPolicy(client *golangsdk.ServiceClient, domainID string, opts ACLPolicyOptsBuilder) (r ACLPolicyResult) {
+	return updateACLPolicy(client, policyURL(client, domainID, apiACLPolicyPath), apiACLPolicy, opts)
+}
+
+func updateACLPolicy(client *golangsdk.ServiceClient, url, parent string, opts ACLPolicyOptsBuilder) (r ACLPolicyResult) {
+	r.parent = parent
+	b, err := opts.ToACLPolicyMap(parent)
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(url, b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
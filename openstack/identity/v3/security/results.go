@@ -0,0 +1,116 @@
+package security
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+const (
+	consoleACLPolicy = "console_acl_policy"
+	apiACLPolicy     = "api_acl_policy"
+)
+
+// PasswordPolicy is the password policy of a domain.
+type PasswordPolicy struct {
+	MaximumConsecutiveIdenticalChars  int    `json:"maximum_consecutive_identical_chars"`
+	MaximumPasswordLength             int    `json:"maximum_password_length"`
+	MinimumPasswordAge                int    `json:"minimum_password_age"`
+	MinimumPasswordLength             int    `json:"minimum_password_length"`
+	NumberOfRecentPasswordsDisallowed int    `json:"number_of_recent_passwords_disallowed"`
+	PasswordNotUsernameOrInvert       bool   `json:"password_not_username_or_invert"`
+	PasswordRequirements              string `json:"password_requirements"`
+	PasswordValidityPeriod            int    `json:"password_validity_period"`
+	PasswordCharCombination           int    `json:"password_char_combination"`
+}
+
+// PasswordPolicyResult is the response from a GetPasswordPolicy or UpdatePasswordPolicy
+// operation. Call its Extract method to interpret it as a PasswordPolicy.
+type PasswordPolicyResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets a PasswordPolicyResult as a PasswordPolicy.
+func (r PasswordPolicyResult) Extract() (*PasswordPolicy, error) {
+	var s struct {
+		PasswordPolicy *PasswordPolicy `json:"password_policy"`
+	}
+	err := r.ExtractInto(&s)
+	return s.PasswordPolicy, err
+}
+
+// LoginPolicy is the login authentication policy of a domain.
+type LoginPolicy struct {
+	AccountValidityPeriod   int    `json:"account_validity_period"`
+	CustomInfoForLogin      string `json:"custom_info_for_login"`
+	LockoutDuration         int    `json:"lockout_duration"`
+	LoginFailedTimes        int    `json:"login_failed_times"`
+	PeriodWithLoginFailures int    `json:"period_with_login_failures"`
+	SessionTimeout          int    `json:"session_timeout"`
+	ShowRecentLoginInfo     bool   `json:"show_recent_login_info"`
+}
+
+// LoginPolicyResult is the response from a GetLoginPolicy or UpdateLoginPolicy
+// operation. Call its Extract method to interpret it as a LoginPolicy.
+type LoginPolicyResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets a LoginPolicyResult as a LoginPolicy.
+func (r LoginPolicyResult) Extract() (*LoginPolicy, error) {
+	var s struct {
+		LoginPolicy *LoginPolicy `json:"login_policy"`
+	}
+	err := r.ExtractInto(&s)
+	return s.LoginPolicy, err
+}
+
+// AllowUserPolicy specifies which attributes IAM users are allowed to modify by themselves.
+type AllowUserPolicy struct {
+	ManageAccessKey bool `json:"manage_accesskey"`
+	ManageEmail     bool `json:"manage_email"`
+	ManageMobile    bool `json:"manage_mobile"`
+	ManagePassword  bool `json:"manage_password"`
+}
+
+// ProtectPolicy is the operation protection policy of a domain.
+type ProtectPolicy struct {
+	OperationProtection bool            `json:"operation_protection"`
+	AllowUser           AllowUserPolicy `json:"allow_user"`
+}
+
+// ProtectPolicyResult is the response from a GetProtectPolicy or UpdateProtectPolicy
+// operation. Call its Extract method to interpret it as a ProtectPolicy.
+type ProtectPolicyResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets a ProtectPolicyResult as a ProtectPolicy.
+func (r ProtectPolicyResult) Extract() (*ProtectPolicy, error) {
+	var s struct {
+		ProtectPolicy *ProtectPolicy `json:"protect_policy"`
+	}
+	err := r.ExtractInto(&s)
+	return s.ProtectPolicy, err
+}
+
+// ACLPolicy is the console or API access control policy of a domain.
+type ACLPolicy struct {
+	AllowAddressNetmasks []AllowAddressNetmask `json:"allow_address_netmasks"`
+	AllowIPRanges        []AllowIPRange        `json:"allow_ip_ranges"`
+}
+
+// ACLPolicyResult is the response from an ACL policy operation.
+// Call its Extract method to interpret it as an ACLPolicy.
+type ACLPolicyResult struct {
+	golangsdk.Result
+	parent string
+}
+
+// Extract interprets an ACLPolicyResult as an ACLPolicy.
+func (r ACLPolicyResult) Extract() (*ACLPolicy, error) {
+	var s map[string]*ACLPolicy
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return nil, err
+	}
+	return s[r.parent], nil
+}
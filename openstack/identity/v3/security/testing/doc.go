@@ -0,0 +1,2 @@
+// security unit tests
+package testing
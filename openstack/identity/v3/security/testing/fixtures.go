@@ -0,0 +1,156 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/identity/v3/security"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const domainID = "d78cbac186b744899480f25bd022f468"
+
+const UpdatePasswordPolicyRequest = `
+{
+    "password_policy": {
+        "minimum_password_length": 12,
+        "password_char_combination": 3,
+        "password_not_username_or_invert": true
+    }
+}
+`
+
+const PasswordPolicyOutput = `
+{
+    "password_policy": {
+        "maximum_consecutive_identical_chars": 0,
+        "maximum_password_length": 32,
+        "minimum_password_age": 0,
+        "minimum_password_length": 12,
+        "number_of_recent_passwords_disallowed": 1,
+        "password_not_username_or_invert": true,
+        "password_requirements": "A password must contain at least three of the following character types.",
+        "password_validity_period": 0,
+        "password_char_combination": 3
+    }
+}
+`
+
+var ExpectedPasswordPolicy = security.PasswordPolicy{
+	MaximumPasswordLength:             32,
+	MinimumPasswordLength:             12,
+	NumberOfRecentPasswordsDisallowed: 1,
+	PasswordNotUsernameOrInvert:       true,
+	PasswordRequirements:              "A password must contain at least three of the following character types.",
+	PasswordCharCombination:           3,
+}
+
+const UpdateProtectPolicyRequest = `
+{
+    "protect_policy": {
+        "operation_protection": true,
+        "allow_user": {
+            "manage_password": false
+        }
+    }
+}
+`
+
+const ProtectPolicyOutput = `
+{
+    "protect_policy": {
+        "operation_protection": true,
+        "allow_user": {
+            "manage_accesskey": true,
+            "manage_email": true,
+            "manage_mobile": true,
+            "manage_password": false
+        }
+    }
+}
+`
+
+var ExpectedProtectPolicy = security.ProtectPolicy{
+	OperationProtection: true,
+	AllowUser: security.AllowUserPolicy{
+		ManageAccessKey: true,
+		ManageEmail:     true,
+		ManageMobile:    true,
+	},
+}
+
+const UpdateConsoleACLRequest = `
+{
+    "console_acl_policy": {
+        "allow_address_netmasks": [
+            {
+                "address_netmask": "192.168.0.0/24",
+                "description": "office"
+            }
+        ],
+        "allow_ip_ranges": []
+    }
+}
+`
+
+const ConsoleACLOutput = `
+{
+    "console_acl_policy": {
+        "allow_address_netmasks": [
+            {
+                "address_netmask": "192.168.0.0/24",
+                "description": "office"
+            }
+        ],
+        "allow_ip_ranges": [
+            {
+                "ip_range": "0.0.0.0-255.255.255.255",
+                "description": ""
+            }
+        ]
+    }
+}
+`
+
+var ExpectedConsoleACL = security.ACLPolicy{
+	AllowAddressNetmasks: []security.AllowAddressNetmask{
+		{AddressNetmask: "192.168.0.0/24", Description: "office"},
+	},
+	AllowIPRanges: []security.AllowIPRange{
+		{IPRange: "0.0.0.0-255.255.255.255"},
+	},
+}
+
+// HandlePolicySuccessfully creates an HTTP handler at
+// `/OS-SECURITYPOLICY/domains/{domain_id}/{path}` on the test handler mux that responds to GET and
+// PUT requests.
+func HandlePolicySuccessfully(t *testing.T, path, request, output string) {
+	th.Mux.HandleFunc("/OS-SECURITYPOLICY/domains/"+domainID+"/"+path, func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		switch r.Method {
+		case "GET":
+		case "PUT":
+			th.TestJSONRequest(t, r, request)
+		default:
+			t.Errorf("Unexpected method: %s", r.Method)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, output)
+	})
+}
+
+func HandlePasswordPolicySuccessfully(t *testing.T) {
+	HandlePolicySuccessfully(t, "password-policy", UpdatePasswordPolicyRequest, PasswordPolicyOutput)
+}
+
+func HandleProtectPolicySuccessfully(t *testing.T) {
+	HandlePolicySuccessfully(t, "protect-policy", UpdateProtectPolicyRequest, ProtectPolicyOutput)
+}
+
+func HandleConsoleACLPolicySuccessfully(t *testing.T) {
+	HandlePolicySuccessfully(t, "console-acl-policy", UpdateConsoleACLRequest, ConsoleACLOutput)
+}
@@ -0,0 +1,68 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/identity/v3/security"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	"github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestPasswordPolicy(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandlePasswordPolicySuccessfully(t)
+
+	minLength := 12
+	combination := 3
+	iTrue := true
+	actual, err := security.UpdatePasswordPolicy(client.ServiceClient(), domainID, security.PasswordPolicyOpts{
+		MinimumPasswordLength:       &minLength,
+		PasswordCharCombination:     &combination,
+		PasswordNotUsernameOrInvert: &iTrue,
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedPasswordPolicy, *actual)
+
+	actual, err = security.GetPasswordPolicy(client.ServiceClient(), domainID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedPasswordPolicy, *actual)
+}
+
+func TestProtectPolicy(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleProtectPolicySuccessfully(t)
+
+	iTrue := true
+	iFalse := false
+	actual, err := security.UpdateProtectPolicy(client.ServiceClient(), domainID, security.ProtectPolicyOpts{
+		OperationProtection: &iTrue,
+		AllowUser: &security.AllowUser{
+			ManagePassword: &iFalse,
+		},
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedProtectPolicy, *actual)
+
+	_, err = security.UpdateProtectPolicy(client.ServiceClient(), domainID, security.ProtectPolicyOpts{}).Extract()
+	th.AssertEquals(t, true, err != nil)
+}
+
+func TestConsoleACLPolicy(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleConsoleACLPolicySuccessfully(t)
+
+	actual, err := security.UpdateConsoleACLPolicy(client.ServiceClient(), domainID, security.ACLPolicyOpts{
+		AllowAddressNetmasks: []security.AllowAddressNetmask{
+			{AddressNetmask: "192.168.0.0/24", Description: "office"},
+		},
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedConsoleACL, *actual)
+
+	actual, err = security.GetConsoleACLPolicy(client.ServiceClient(), domainID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedConsoleACL, *actual)
+}
@@ -0,0 +1,22 @@
+package security
+
+import (
+	"strings"
+
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+const (
+	rootPath = "OS-SECURITYPOLICY"
+
+	passwordPolicyPath   = "password-policy"
+	loginPolicyPath      = "login-policy"
+	protectPolicyPath    = "protect-policy"
+	consoleACLPolicyPath = "console-acl-policy"
+	apiACLPolicyPath     = "api-acl-policy"
+)
+
+func policyURL(client *golangsdk.ServiceClient, domainID, policy string) string {
+	url := client.ServiceURL(rootPath, "domains", domainID, policy)
+	return strings.Replace(url, "/v3/", "/v3.0/", 1)
+}
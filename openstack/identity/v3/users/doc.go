@@ -119,5 +119,37 @@ Example to List Users in a Group
 		fmt.Printf("%+v\n", user)
 	}
 
+Example to Create a User with OTC-specific attributes
+
+	createOpts := users.CreateExtendedOpts{
+		Name:       "user_name",
+		DomainID:   "1789d1",
+		Email:      "user@example.com",
+		AccessMode: users.AccessModeProgrammatic,
+	}
+
+	user, err := users.CreateExtended(identityClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Enable Login Protection for a User
+
+	enabled := true
+	err := users.UpdateLoginProtection(identityClient, userID, users.LoginProtectionOpts{
+		Enabled:            &enabled,
+		VerificationMethod: users.VerificationMethodEmail,
+	}).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Check Whether a User Belongs to a Group
+
+	ok, err := users.IsInGroup(identityClient, groupID, userID)
+	if err != nil {
+		panic(err)
+	}
+
 */
 package users
@@ -117,6 +117,80 @@ func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateRe
 	return
 }
 
+const (
+	// AccessModeDefault allows both programmatic and management console access.
+	AccessModeDefault = "default"
+	// AccessModeProgrammatic allows programmatic access only.
+	AccessModeProgrammatic = "programmatic"
+	// AccessModeConsole allows management console access only.
+	AccessModeConsole = "console"
+)
+
+// CreateExtendedOpts provides options used to create a user with OTC-specific
+// attributes (e-mail address, mobile number, access mode).
+type CreateExtendedOpts struct {
+	// Name is the name of the new user.
+	Name string `json:"name" required:"true"`
+
+	// DomainID is the ID of the domain the user belongs to.
+	DomainID string `json:"domain_id" required:"true"`
+
+	// Password is the password of the new user.
+	Password string `json:"password,omitempty"`
+
+	// Enabled sets the user status to enabled or disabled.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Description is a description of the user.
+	Description string `json:"description,omitempty"`
+
+	// Email is the email of the user
+	Email string `json:"email,omitempty"`
+
+	// AreaCode is country code
+	AreaCode string `json:"areacode,omitempty"`
+
+	// Phone is mobile number, which can contain a maximum of 32 digits.
+	// The mobile number must be used together with a country code.
+	Phone string `json:"phone,omitempty"`
+
+	// Whether password reset is required at first login
+	PwdResetRequired *bool `json:"pwd_status,omitempty"`
+
+	// AccessMode is the access type of the user, see AccessMode* constants.
+	AccessMode string `json:"access_mode,omitempty"`
+
+	// XUserType is Type of the IAM user in the external system.
+	XUserType string `json:"xuser_type,omitempty"`
+
+	// XUserID is ID of the IAM user in the external system.
+	XUserID string `json:"xuser_id,omitempty"`
+}
+
+// ToUserCreateMap formats a CreateExtendedOpts into a create request.
+func (opts CreateExtendedOpts) ToUserCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "user")
+}
+
+// CreateExtended creates a new User using the OTC v3.0 API.
+func CreateExtended(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToUserCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(createExtendedURL(client), &b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+// GetExtended retrieves details on a single user, including OTC-specific attributes.
+func GetExtended(client *golangsdk.ServiceClient, id string) (r GetResult) {
+	_, r.Err = client.Get(getExtendedURL(client, id), &r.Body, nil)
+	return
+}
+
 // UpdateOptsBuilder allows extensions to add additional parameters to
 // the Update request.
 type UpdateOptsBuilder interface {
@@ -211,6 +285,9 @@ type ExtendedUpdateOpts struct {
 
 	// XUserID is ID of the IAM user in the external system.
 	XUserID string `json:"xuser_id,omitempty"`
+
+	// AccessMode is the access type of the user, see AccessMode* constants.
+	AccessMode string `json:"access_mode,omitempty"`
 }
 
 func (opts ExtendedUpdateOpts) ToUserUpdateMap() (map[string]interface{}, error) {
@@ -291,3 +368,66 @@ func SendWelcomeEmail(client *golangsdk.ServiceClient, userID string) (r Welcome
 	})
 	return
 }
+
+// IsInGroup checks whether a user belongs to the group.
+func IsInGroup(client *golangsdk.ServiceClient, groupID string, userID string) (bool, error) {
+	resp, err := client.Request("HEAD", membershipURL(client, groupID, userID), &golangsdk.RequestOpts{
+		OkCodes: []int{204, 404},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return resp.StatusCode == 204, nil
+}
+
+const (
+	VerificationMethodSMS   = "sms"
+	VerificationMethodEmail = "email"
+	VerificationMethodVMFA  = "vmfa"
+)
+
+// LoginProtectionOptsBuilder allows extensions to add additional parameters to
+// the UpdateLoginProtection request.
+type LoginProtectionOptsBuilder interface {
+	ToLoginProtectionMap() (map[string]interface{}, error)
+}
+
+// LoginProtectionOpts provides options for the user login protection configuration.
+type LoginProtectionOpts struct {
+	// Enabled specifies whether login protection is enabled.
+	Enabled *bool `json:"enabled" required:"true"`
+
+	// VerificationMethod is the login verification method, see VerificationMethod* constants.
+	VerificationMethod string `json:"verification_method" required:"true"`
+}
+
+// ToLoginProtectionMap formats a LoginProtectionOpts into a request body.
+func (opts LoginProtectionOpts) ToLoginProtectionMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "login_protect")
+}
+
+// UpdateLoginProtection modifies the login protection configuration of a user.
+func UpdateLoginProtection(client *golangsdk.ServiceClient, userID string, opts LoginProtectionOptsBuilder) (r LoginProtectionUpdateResult) {
+	b, err := opts.ToLoginProtectionMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(loginProtectURL(client, userID), &b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return
+}
+
+// GetLoginProtection retrieves the login protection configuration of a user.
+func GetLoginProtection(client *golangsdk.ServiceClient, userID string) (r LoginProtectionResult) {
+	_, r.Err = client.Get(loginProtectURL(client, userID), &r.Body, nil)
+	return
+}
+
+// ListLoginProtections retrieves the login protection configurations of all users in the domain.
+func ListLoginProtections(client *golangsdk.ServiceClient) (r LoginProtectionListResult) {
+	_, r.Err = client.Get(listLoginProtectsURL(client), &r.Body, nil)
+	return
+}
@@ -52,6 +52,15 @@ type User struct {
 
 	// XUserID is ID of the IAM user in the external system.
 	XUserID string `json:"xuser_id,omitempty"`
+
+	// AccessMode is the access type of the user.
+	AccessMode string `json:"access_mode,omitempty"`
+
+	// IsDomainOwner indicates whether the user is the domain administrator.
+	IsDomainOwner bool `json:"is_domain_owner,omitempty"`
+
+	// LastLoginTime is the time when the user last logged in.
+	LastLoginTime string `json:"last_login_time,omitempty"`
 }
 
 func (r *User) UnmarshalJSON(b []byte) error {
@@ -156,3 +165,51 @@ type AddMembershipResult struct {
 type WelcomeResult struct {
 	golangsdk.ErrResult
 }
+
+// LoginProtection represents the login protection configuration of a user.
+type LoginProtection struct {
+	// UserID is the ID of the user.
+	UserID string `json:"user_id"`
+
+	// Enabled indicates whether login protection is enabled.
+	Enabled bool `json:"enabled"`
+
+	// VerificationMethod is the login verification method.
+	VerificationMethod string `json:"verification_method"`
+}
+
+// LoginProtectionResult is the response from a GetLoginProtection operation.
+// Call its Extract method to interpret it as a LoginProtection.
+type LoginProtectionResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets a LoginProtectionResult as a LoginProtection.
+func (r LoginProtectionResult) Extract() (*LoginProtection, error) {
+	var s struct {
+		LoginProtect *LoginProtection `json:"login_protect"`
+	}
+	err := r.ExtractInto(&s)
+	return s.LoginProtect, err
+}
+
+// LoginProtectionListResult is the response from a ListLoginProtections operation.
+// Call its Extract method to interpret it as a slice of LoginProtection.
+type LoginProtectionListResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets a LoginProtectionListResult as a slice of LoginProtection.
+func (r LoginProtectionListResult) Extract() ([]LoginProtection, error) {
+	var s struct {
+		LoginProtects []LoginProtection `json:"login_protects"`
+	}
+	err := r.ExtractInto(&s)
+	return s.LoginProtects, err
+}
+
+// LoginProtectionUpdateResult is the response from an UpdateLoginProtection operation.
+// Call its ExtractErr to determine if the request succeeded or failed.
+type LoginProtectionUpdateResult struct {
+	golangsdk.ErrResult
+}
@@ -137,6 +137,68 @@ const UpdateExtendedOutput = `
 }
 `
 
+// CreateExtendedRequest provides the input to a CreateExtended request.
+const CreateExtendedRequest = `
+{
+    "user": {
+        "name": "jsmith",
+        "domain_id": "1789d1",
+        "email": "email@generic.otc",
+        "pwd_status": true,
+        "access_mode": "console"
+    }
+}
+`
+
+// CreateExtendedOutput provides a CreateExtended result.
+const CreateExtendedOutput = `
+{
+    "user": {
+        "domain_id": "1789d1",
+        "enabled": true,
+        "id": "9fe1d3",
+        "name": "jsmith",
+        "pwd_status": true,
+        "email": "email@generic.otc",
+        "access_mode": "console"
+    }
+}
+`
+
+// LoginProtectRequest provides the input to an UpdateLoginProtection request.
+const LoginProtectRequest = `
+{
+    "login_protect": {
+        "enabled": true,
+        "verification_method": "email"
+    }
+}
+`
+
+// GetLoginProtectOutput provides a GetLoginProtection result.
+const GetLoginProtectOutput = `
+{
+    "login_protect": {
+        "user_id": "9fe1d3",
+        "enabled": true,
+        "verification_method": "email"
+    }
+}
+`
+
+// ListLoginProtectsOutput provides a ListLoginProtections result.
+const ListLoginProtectsOutput = `
+{
+    "login_protects": [
+        {
+            "user_id": "9fe1d3",
+            "enabled": true,
+            "verification_method": "email"
+        }
+    ]
+}
+`
+
 // ListGroupsOutput provides a ListGroups result.
 const ListGroupsOutput = `
 {
@@ -249,6 +311,22 @@ var ThirdUserUpdated = users.User{
 }
 
 // ExpectedUsersSlice is the slice of users expected to be returned from ListOutput.
+var ExtendedUser = users.User{
+	DomainID:         "1789d1",
+	Enabled:          true,
+	ID:               "9fe1d3",
+	Name:             "jsmith",
+	PwdResetRequired: true,
+	Email:            "email@generic.otc",
+	AccessMode:       "console",
+}
+
+var ExpectedLoginProtection = users.LoginProtection{
+	UserID:             "9fe1d3",
+	Enabled:            true,
+	VerificationMethod: "email",
+}
+
 var ExpectedUsersSlice = []users.User{FirstUser, SecondUser}
 
 var FirstGroup = groups.Group{
@@ -429,3 +507,57 @@ func HandleListInGroupSuccessfully(t *testing.T) {
 		_, _ = fmt.Fprint(w, ListOutput)
 	})
 }
+
+// HandleCreateExtendedUserSuccessfully creates an HTTP handler at `/OS-USER/users` on the
+// test handler mux that tests extended user creation.
+func HandleCreateExtendedUserSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-USER/users", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, CreateExtendedRequest)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprint(w, CreateExtendedOutput)
+	})
+}
+
+// HandleIsInGroupSuccessfully creates an HTTP handler at /groups/{groupID}/users/{userID}
+// on the test handler mux that checks group membership
+func HandleIsInGroupSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/groups/ea167b/users/9fe1d3", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "HEAD")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandleLoginProtectionSuccessfully creates HTTP handlers at /OS-USER/users/{userID}/login-protect
+// and /OS-USER/login-protects on the test handler mux that test login protection management
+func HandleLoginProtectionSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-USER/users/9fe1d3/login-protect", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		switch r.Method {
+		case "PUT":
+			th.TestJSONRequest(t, r, LoginProtectRequest)
+			w.WriteHeader(http.StatusOK)
+		case "GET":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprint(w, GetLoginProtectOutput)
+		default:
+			t.Errorf("Unexpected method: %s", r.Method)
+		}
+	})
+
+	th.Mux.HandleFunc("/OS-USER/login-protects", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, ListLoginProtectsOutput)
+	})
+}
@@ -169,3 +169,53 @@ func TestListInGroup(t *testing.T) {
 	th.AssertNoErr(t, err)
 	th.CheckDeepEquals(t, ExpectedUsersSlice, actual)
 }
+
+func TestCreateExtendedUser(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateExtendedUserSuccessfully(t)
+
+	iTrue := true
+	createOpts := users.CreateExtendedOpts{
+		Name:             "jsmith",
+		DomainID:         "1789d1",
+		Email:            "email@generic.otc",
+		PwdResetRequired: &iTrue,
+		AccessMode:       users.AccessModeConsole,
+	}
+
+	actual, err := users.CreateExtended(client.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExtendedUser, *actual)
+}
+
+func TestIsInGroup(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleIsInGroupSuccessfully(t)
+
+	ok, err := users.IsInGroup(client.ServiceClient(), "ea167b", "9fe1d3")
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, true, ok)
+}
+
+func TestLoginProtection(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleLoginProtectionSuccessfully(t)
+
+	iTrue := true
+	err := users.UpdateLoginProtection(client.ServiceClient(), "9fe1d3", users.LoginProtectionOpts{
+		Enabled:            &iTrue,
+		VerificationMethod: users.VerificationMethodEmail,
+	}).ExtractErr()
+	th.AssertNoErr(t, err)
+
+	actual, err := users.GetLoginProtection(client.ServiceClient(), "9fe1d3").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedLoginProtection, *actual)
+
+	list, err := users.ListLoginProtections(client.ServiceClient()).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []users.LoginProtection{ExpectedLoginProtection}, list)
+}
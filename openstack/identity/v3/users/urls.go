@@ -12,6 +12,8 @@ const (
 	groupsPath        = "groups"
 	projectPath       = "projects"
 	welcomePath       = "welcome"
+	loginProtectPath  = "login-protect"
+	loginProtectsPath = "login-protects"
 )
 
 func listURL(client *golangsdk.ServiceClient) string {
@@ -35,6 +37,26 @@ func updateExtendedURL(client *golangsdk.ServiceClient, userID string) string {
 	return strings.Replace(url, "/v3/", "/v3.0/", 1)
 }
 
+func createExtendedURL(client *golangsdk.ServiceClient) string {
+	url := client.ServiceURL(openstackUserPath, rootPath)
+	return strings.Replace(url, "/v3/", "/v3.0/", 1)
+}
+
+func getExtendedURL(client *golangsdk.ServiceClient, userID string) string {
+	url := client.ServiceURL(openstackUserPath, rootPath, userID)
+	return strings.Replace(url, "/v3/", "/v3.0/", 1)
+}
+
+func loginProtectURL(client *golangsdk.ServiceClient, userID string) string {
+	url := client.ServiceURL(openstackUserPath, rootPath, userID, loginProtectPath)
+	return strings.Replace(url, "/v3/", "/v3.0/", 1)
+}
+
+func listLoginProtectsURL(client *golangsdk.ServiceClient) string {
+	url := client.ServiceURL(openstackUserPath, loginProtectsPath)
+	return strings.Replace(url, "/v3/", "/v3.0/", 1)
+}
+
 func welcomeExtendedURL(client *golangsdk.ServiceClient, userID string) string {
 	url := client.ServiceURL(openstackUserPath, rootPath, userID, welcomePath)
 	return strings.Replace(url, "/v3/", "/v3.0/", 1)
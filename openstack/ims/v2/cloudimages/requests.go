@@ -144,6 +144,8 @@ type CreateByServerOpts struct {
 	MaxRam int `json:"max_ram,omitempty"`
 	// the minimum memory of the image in the unit of MB
 	MinRam int `json:"min_ram,omitempty"`
+	// the enterprise project that the image belongs to
+	EnterpriseProjectID string `json:"enterprise_project_id,omitempty"`
 }
 
 // CreateOpts represents options used to create an image.
@@ -196,6 +198,58 @@ type CreateDataImageByOBSOpts struct {
 	CmkId string `json:"cmk_id,omitempty"`
 }
 
+// QuickImportOpts represents options used to quickly import an image file from OBS.
+// Only ZVHD2 and RAW image files can be quickly imported.
+type QuickImportOpts struct {
+	// the name of the image
+	Name string `json:"name" required:"true"`
+	// Description of image
+	Description string `json:"description,omitempty"`
+	// the OS version, required for system disk images
+	OsVersion string `json:"os_version,omitempty"`
+	// the URL of the external image file in the OBS bucket, format is <bucket name>:<file name>
+	ImageUrl string `json:"image_url" required:"true"`
+	// the minimum size of the disk in the unit of GB
+	MinDisk int `json:"min_disk" required:"true"`
+	// image label "key.value"
+	Tags []string `json:"tags,omitempty"`
+	// One or more tag key and value pairs to associate with the image
+	ImageTags []ImageTag `json:"image_tags,omitempty"`
+	// the image type, the value can be ECS, BMS or DataImage
+	Type string `json:"type,omitempty"`
+	// the image architecture, the value can be x86 or arm
+	Architecture string `json:"architecture,omitempty"`
+	// the enterprise project that the image belongs to
+	EnterpriseProjectID string `json:"enterprise_project_id,omitempty"`
+}
+
+// CreateWholeImageOpts represents options used to create a full-ECS image
+// (system and data disks) from an ECS or a CBR backup.
+type CreateWholeImageOpts struct {
+	// the name of the full-ECS image
+	Name string `json:"name" required:"true"`
+	// Description of the image
+	Description string `json:"description,omitempty"`
+	// ID of the ECS to create the image from, mutually exclusive with BackupID
+	InstanceId string `json:"instance_id,omitempty"`
+	// ID of the CBR or CSBS backup to create the image from, mutually exclusive with InstanceId
+	BackupID string `json:"backup_id,omitempty"`
+	// ID of the CBR vault used to store the image, required when creating from ECS
+	VaultID string `json:"vault_id,omitempty"`
+	// the backup type the image is created with, the value can be CBR or CSBS
+	WholeImageType string `json:"whole_image_type,omitempty"`
+	// image label "key.value"
+	Tags []string `json:"tags,omitempty"`
+	// One or more tag key and value pairs to associate with the image
+	ImageTags []ImageTag `json:"image_tags,omitempty"`
+	// the maximum memory of the image in the unit of MB
+	MaxRam int `json:"max_ram,omitempty"`
+	// the minimum memory of the image in the unit of MB
+	MinRam int `json:"min_ram,omitempty"`
+	// the enterprise project that the image belongs to
+	EnterpriseProjectID string `json:"enterprise_project_id,omitempty"`
+}
+
 type DataImage struct {
 	// the data disk image name
 	Name string `json:"name" required:"true"`
@@ -222,6 +276,17 @@ func (opts CreateByOBSOpts) ToImageCreateMap() (map[string]interface{}, error) {
 	return golangsdk.BuildRequestBody(opts, "")
 }
 
+func (opts QuickImportOpts) ToImageCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+func (opts CreateWholeImageOpts) ToImageCreateMap() (map[string]interface{}, error) {
+	if (opts.InstanceId == "") == (opts.BackupID == "") {
+		return nil, fmt.Errorf("exactly one of InstanceId and BackupID must be set")
+	}
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
 func (opts CreateDataImageByServerOpts) ToImageCreateMap() (map[string]interface{}, error) {
 	return golangsdk.BuildRequestBody(opts, "")
 }
@@ -277,3 +342,233 @@ func CreateDataImageByOBS(client *golangsdk.ServiceClient, opts CreateOptsBuilde
 	_, r.Err = client.Post(createDataImageURL(client), b, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
 	return
 }
+
+// ImportImageQuick implements quick import of the image file from OBS.
+func ImportImageQuick(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r JobResult) {
+	b, err := opts.ToImageCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Post(quickImportURL(client), b, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	return
+}
+
+// MembersOptsBuilder allows extensions to add parameters to the batch members requests.
+type MembersOptsBuilder interface {
+	ToImageMembersMap() (map[string]interface{}, error)
+}
+
+// MembersOpts represents options used to share images with projects or stop sharing them.
+type MembersOpts struct {
+	// IDs of the images to be shared
+	Images []string `json:"images" required:"true"`
+	// IDs of the projects images are shared with
+	Projects []string `json:"projects" required:"true"`
+}
+
+// ToImageMembersMap assembles a request body based on the contents of a MembersOpts.
+func (opts MembersOpts) ToImageMembersMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// AddMembers shares images with multiple projects in a batch.
+func AddMembers(client *golangsdk.ServiceClient, opts MembersOptsBuilder) (r JobResult) {
+	b, err := opts.ToImageMembersMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Post(membersURL(client), b, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	return
+}
+
+// DeleteMembers stops sharing images with multiple projects in a batch.
+func DeleteMembers(client *golangsdk.ServiceClient, opts MembersOptsBuilder) (r JobResult) {
+	b, err := opts.ToImageMembersMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Request("DELETE", membersURL(client), &golangsdk.RequestOpts{
+		JSONBody:     b,
+		JSONResponse: &r.Body,
+		OkCodes:      []int{200},
+	})
+	return
+}
+
+// Possible image member statuses
+const (
+	MemberStatusAccepted = "accepted"
+	MemberStatusRejected = "rejected"
+)
+
+// UpdateMembersOptsBuilder allows extensions to add parameters to the UpdateMembers request.
+type UpdateMembersOptsBuilder interface {
+	ToImageMembersUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateMembersOpts represents options used to accept or reject images shared with the project.
+type UpdateMembersOpts struct {
+	// IDs of the shared images
+	Images []string `json:"images" required:"true"`
+	// ID of the project receiving the shared images
+	ProjectID string `json:"project_id" required:"true"`
+	// the member status, the value can be accepted or rejected
+	Status string `json:"status" required:"true"`
+	// ID of the CBR vault to store a full-ECS image shared with the project
+	VaultID string `json:"vault_id,omitempty"`
+}
+
+// ToImageMembersUpdateMap assembles a request body based on the contents of a UpdateMembersOpts.
+func (opts UpdateMembersOpts) ToImageMembersUpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// UpdateMembers accepts or rejects images shared with the project in a batch.
+func UpdateMembers(client *golangsdk.ServiceClient, opts UpdateMembersOptsBuilder) (r JobResult) {
+	b, err := opts.ToImageMembersUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Put(membersURL(client), b, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	return
+}
+
+// Supported formats of the exported image file
+const (
+	FileFormatQCOW2 = "qcow2"
+	FileFormatVMDK  = "vmdk"
+	FileFormatVHD   = "vhd"
+	FileFormatZVHD  = "zvhd"
+	FileFormatZVHD2 = "zvhd2"
+)
+
+// ExportOptsBuilder allows extensions to add parameters to the Export request.
+type ExportOptsBuilder interface {
+	ToImageExportMap() (map[string]interface{}, error)
+}
+
+// ExportOpts represents options used to export an image to OBS.
+type ExportOpts struct {
+	// the destination of the image file, format is <bucket name>:<file name>
+	BucketURL string `json:"bucket_url" required:"true"`
+	// the format of the exported image file, the value can be qcow2, vhd, zvhd, zvhd2 or vmdk,
+	// required unless quick export is used
+	FileFormat string `json:"file_format,omitempty"`
+	// whether to use quick export, the file is exported in the original format
+	IsQuickExport *bool `json:"is_quick_export,omitempty"`
+}
+
+// ToImageExportMap assembles a request body based on the contents of a ExportOpts.
+func (opts ExportOpts) ToImageExportMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Export exports a private image to the OBS bucket.
+func Export(client *golangsdk.ServiceClient, imageID string, opts ExportOptsBuilder) (r JobResult) {
+	b, err := opts.ToImageExportMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Post(exportURL(client, imageID), b, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	return
+}
+
+// CopyOptsBuilder allows extensions to add parameters to the Copy request.
+type CopyOptsBuilder interface {
+	ToImageCopyMap() (map[string]interface{}, error)
+}
+
+// CopyOpts represents options used to copy an image within a region.
+type CopyOpts struct {
+	// the name of the copied image
+	Name string `json:"name" required:"true"`
+	// Description of the copied image
+	Description string `json:"description,omitempty"`
+	// the master key used for encrypting the copied image
+	CmkId string `json:"cmk_id,omitempty"`
+	// the enterprise project that the copied image belongs to
+	EnterpriseProjectID string `json:"enterprise_project_id,omitempty"`
+}
+
+// ToImageCopyMap assembles a request body based on the contents of a CopyOpts.
+func (opts CopyOpts) ToImageCopyMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Copy copies a private image within the region.
+func Copy(client *golangsdk.ServiceClient, imageID string, opts CopyOptsBuilder) (r JobResult) {
+	b, err := opts.ToImageCopyMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Post(copyURL(client, imageID), b, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	return
+}
+
+// CrossRegionCopyOptsBuilder allows extensions to add parameters to the CrossRegionCopy request.
+type CrossRegionCopyOptsBuilder interface {
+	ToImageCrossRegionCopyMap() (map[string]interface{}, error)
+}
+
+// CrossRegionCopyOpts represents options used to copy an image to another region.
+type CrossRegionCopyOpts struct {
+	// the name of the copied image
+	Name string `json:"name" required:"true"`
+	// Description of the copied image
+	Description string `json:"description,omitempty"`
+	// the destination region, e.g. eu-nl
+	Region string `json:"region" required:"true"`
+	// the destination project name, e.g. eu-nl
+	ProjectName string `json:"project_name" required:"true"`
+	// the agency allowing IMS to copy the image to the destination region
+	AgencyName string `json:"agency_name" required:"true"`
+	// the CBR vault in the destination region, required for full-ECS images
+	VaultID string `json:"vault_id,omitempty"`
+}
+
+// ToImageCrossRegionCopyMap assembles a request body based on the contents of a CrossRegionCopyOpts.
+func (opts CrossRegionCopyOpts) ToImageCrossRegionCopyMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// CrossRegionCopy copies a private image to another region.
+func CrossRegionCopy(client *golangsdk.ServiceClient, imageID string, opts CrossRegionCopyOptsBuilder) (r JobResult) {
+	b, err := opts.ToImageCrossRegionCopyMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Post(crossRegionCopyURL(client, imageID), b, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	return
+}
+
+// CreateWholeImage implements full-ECS image creation from an ECS or a backup.
+func CreateWholeImage(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r JobResult) {
+	b, err := opts.ToImageCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Post(createWholeImageURL(client), b, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	return
+}
+
+// GetQuota retrieves the image quota of the project.
+func GetQuota(client *golangsdk.ServiceClient) (r QuotaResult) {
+	_, r.Err = client.Get(quotaURL(client), &r.Body, nil)
+	return
+}
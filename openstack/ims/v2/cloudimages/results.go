@@ -139,3 +139,32 @@ func ExtractImages(r pagination.Page) ([]Image, error) {
 	err := (r.(ImagePage)).ExtractInto(&s)
 	return s.Images, err
 }
+
+// Quota contains the image quota details.
+type Quota struct {
+	// the resource type, e.g. image
+	Type string `json:"type"`
+	// the number of used resources
+	Used int `json:"used"`
+	// the quota of the resource
+	Quota int `json:"quota"`
+	// the minimum quota value
+	Min int `json:"min"`
+	// the maximum quota value
+	Max int `json:"max"`
+}
+
+type QuotaResult struct {
+	golangsdk.Result
+}
+
+// Extract is a function that accepts a result and extracts image quotas.
+func (r QuotaResult) Extract() ([]Quota, error) {
+	var s struct {
+		Quotas struct {
+			Resources []Quota `json:"resources"`
+		} `json:"quotas"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Quotas.Resources, err
+}
@@ -42,13 +42,15 @@ func (r JobResult) ExtractJobStatus() (*JobStatus, error) {
 	return job, err
 }
 
+// GetJob retrieves the status of the asynchronous IMS job.
+func GetJob(client *golangsdk.ServiceClient, jobID string) (r JobResult) {
+	_, r.Err = client.Get(jobURL(client, jobID), &r.Body, nil)
+	return
+}
+
 func WaitForJobSuccess(client *golangsdk.ServiceClient, secs int, jobID string) error {
-	jobClient := *client
-	// v1/{project_id}/jobs/{job_id}
-	jobClient.ResourceBase = jobClient.Endpoint + "v1/" + jobClient.ProjectID + "/"
 	return golangsdk.WaitFor(secs, func() (bool, error) {
-		job := new(JobStatus)
-		_, err := jobClient.Get(jobClient.ServiceURL("jobs", jobID), &job, nil)
+		job, err := GetJob(client, jobID).ExtractJobStatus()
 		if err != nil {
 			return false, err
 		}
@@ -70,11 +72,7 @@ func GetJobEntity(client *golangsdk.ServiceClient, jobId string, label string) (
 		return nil, fmt.Errorf("Unsupported label %s in GetJobEntity.", label)
 	}
 
-	jobClient := *client
-	// v1/{project_id}/jobs/{job_id}
-	jobClient.ResourceBase = jobClient.Endpoint + "v1/" + jobClient.ProjectID + "/"
-	job := new(JobStatus)
-	_, err := jobClient.Get(jobClient.ServiceURL("jobs", jobId), &job, nil)
+	job, err := GetJob(client, jobId).ExtractJobStatus()
 	if err != nil {
 		return nil, err
 	}
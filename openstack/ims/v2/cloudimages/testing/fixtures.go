@@ -0,0 +1,195 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const (
+	projectID = "5dd3c0b24cdc4d31952c49589182a89d"
+	jobID     = "ff8080814dbd65d7014dbe0d84db0013"
+	imageID   = "4bcd1a8f-3a82-4ac9-9da5-8b1b2a4e3a04"
+)
+
+const expectedCreateByServerRequest = `
+{
+  "name": "ims_test",
+  "description": "image from ECS",
+  "instance_id": "877a2cda-ba63-4e1e-b95f-e67e48b6129a",
+  "image_tags": [
+    {
+      "key": "key1",
+      "value": "value1"
+    }
+  ],
+  "max_ram": 1024,
+  "min_ram": 512
+}`
+
+const jobResponse = `
+{
+  "job_id": "ff8080814dbd65d7014dbe0d84db0013"
+}`
+
+var jobStatusResponse = fmt.Sprintf(`
+{
+  "status": "SUCCESS",
+  "entities": {
+    "image_id": "%s"
+  },
+  "job_id": "%s",
+  "job_type": "createImageByInstance",
+  "begin_time": "1533094286342",
+  "end_time": "1533094422213",
+  "error_code": null,
+  "fail_reason": null
+}`, imageID, jobID)
+
+func serviceClient() *golangsdk.ServiceClient {
+	client := fake.ServiceClient()
+	client.ProjectID = projectID
+	return client
+}
+
+// HandleGetJobSuccessfully creates an HTTP handler at `/v1/{project_id}/jobs/{job_id}` on the test
+// handler mux that responds to a GET request with jobStatusResponse.
+func HandleGetJobSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/v1/%s/jobs/%s", projectID, jobID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, jobStatusResponse)
+	})
+}
+
+const expectedCreateByOBSRequest = `
+{
+  "name": "ims_obs_test",
+  "os_version": "Ubuntu 20.04 server 64bit",
+  "image_url": "ims-bucket:image.qcow2",
+  "min_disk": 40,
+  "is_config": true,
+  "type": "ECS"
+}`
+
+const expectedQuickImportRequest = `
+{
+  "name": "ims_quick_import",
+  "os_version": "Ubuntu 20.04 server 64bit",
+  "image_url": "ims-bucket:image.zvhd2",
+  "min_disk": 40,
+  "type": "ECS",
+  "architecture": "x86"
+}`
+
+// HandleCreateImageSuccessfully configures the test server to respond to a POST request at the
+// given path with jobResponse.
+func HandleCreateImageSuccessfully(t *testing.T, url, expectedRequest string) {
+	th.Mux.HandleFunc(url, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, expectedRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, jobResponse)
+	})
+}
+
+const expectedMembersRequest = `
+{
+  "images": [
+    "d164b5df-1bc3-4c3f-893e-3e471fd16e64",
+    "0b680482-da2c-4ec2-b3c2-f3af4b5b8a2c"
+  ],
+  "projects": [
+    "edc89b490d7d4392898e19b2deb34797"
+  ]
+}`
+
+const expectedUpdateMembersRequest = `
+{
+  "images": [
+    "d164b5df-1bc3-4c3f-893e-3e471fd16e64"
+  ],
+  "project_id": "edc89b490d7d4392898e19b2deb34797",
+  "status": "accepted"
+}`
+
+// HandleMembersSuccessfully creates an HTTP handler at `/v1/cloudimages/members` on the test
+// handler mux that responds to a request of the given method with jobResponse.
+func HandleMembersSuccessfully(t *testing.T, method, expectedRequest string) {
+	th.Mux.HandleFunc("/v1/cloudimages/members", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, method)
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, expectedRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, jobResponse)
+	})
+}
+
+const expectedExportRequest = `
+{
+  "bucket_url": "ims-export:image.qcow2",
+  "file_format": "qcow2"
+}`
+
+const expectedCopyRequest = `
+{
+  "name": "ims_copy",
+  "description": "in-region copy",
+  "cmk_id": "bfc40ee8-fe8b-4e40-9de4-0b9bd06ea2a0"
+}`
+
+const expectedCrossRegionCopyRequest = `
+{
+  "name": "ims_copy_nl",
+  "region": "eu-nl",
+  "project_name": "eu-nl",
+  "agency_name": "ims_admin_agency"
+}`
+
+const expectedCreateWholeImageRequest = `
+{
+  "name": "ims_whole_image",
+  "description": "whole image from CBR backup",
+  "backup_id": "e2c5e1e4-0a46-4a0a-9e6a-8f4a2b0c7d23",
+  "whole_image_type": "CBR"
+}`
+
+const quotaResponse = `
+{
+  "quotas": {
+    "resources": [
+      {
+        "type": "image",
+        "used": 7,
+        "quota": 100,
+        "min": 1,
+        "max": 1000
+      }
+    ]
+  }
+}`
+
+// HandleGetQuotaSuccessfully creates an HTTP handler at `/v1/cloudimages/quota` on the test handler
+// mux that responds to a GET request with quotaResponse.
+func HandleGetQuotaSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/v1/cloudimages/quota", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, quotaResponse)
+	})
+}
@@ -0,0 +1,221 @@
+package testing
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/ims/v2/cloudimages"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestCreateImageByServer(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateImageSuccessfully(t, "/cloudimages/action", expectedCreateByServerRequest)
+
+	opts := cloudimages.CreateByServerOpts{
+		Name:        "ims_test",
+		Description: "image from ECS",
+		InstanceId:  "877a2cda-ba63-4e1e-b95f-e67e48b6129a",
+		ImageTags: []cloudimages.ImageTag{
+			{Key: "key1", Value: "value1"},
+		},
+		MaxRam: 1024,
+		MinRam: 512,
+	}
+	job, err := cloudimages.CreateImageByServer(serviceClient(), opts).ExtractJobResponse()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, jobID, job.JobID)
+}
+
+func TestGetJob(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetJobSuccessfully(t)
+
+	job, err := cloudimages.GetJob(serviceClient(), jobID).ExtractJobStatus()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "SUCCESS", job.Status)
+	th.AssertEquals(t, imageID, job.Entities.ImageID)
+}
+
+func TestWaitForJobAndGetImageID(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetJobSuccessfully(t)
+
+	client := serviceClient()
+	th.AssertNoErr(t, cloudimages.WaitForJobSuccess(client, 10, jobID))
+
+	id, err := cloudimages.GetJobEntity(client, jobID, "image_id")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, imageID, id)
+}
+
+func TestCreateImageByOBS(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateImageSuccessfully(t, "/cloudimages/action", expectedCreateByOBSRequest)
+
+	opts := cloudimages.CreateByOBSOpts{
+		Name:      "ims_obs_test",
+		OsVersion: "Ubuntu 20.04 server 64bit",
+		ImageUrl:  "ims-bucket:image.qcow2",
+		MinDisk:   40,
+		IsConfig:  true,
+		Type:      "ECS",
+	}
+	job, err := cloudimages.CreateImageByOBS(serviceClient(), opts).ExtractJobResponse()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, jobID, job.JobID)
+}
+
+func TestImportImageQuick(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateImageSuccessfully(t, "/cloudimages/quickimport/action", expectedQuickImportRequest)
+
+	opts := cloudimages.QuickImportOpts{
+		Name:         "ims_quick_import",
+		OsVersion:    "Ubuntu 20.04 server 64bit",
+		ImageUrl:     "ims-bucket:image.zvhd2",
+		MinDisk:      40,
+		Type:         "ECS",
+		Architecture: "x86",
+	}
+	job, err := cloudimages.ImportImageQuick(serviceClient(), opts).ExtractJobResponse()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, jobID, job.JobID)
+}
+
+var membersOpts = cloudimages.MembersOpts{
+	Images: []string{
+		"d164b5df-1bc3-4c3f-893e-3e471fd16e64",
+		"0b680482-da2c-4ec2-b3c2-f3af4b5b8a2c",
+	},
+	Projects: []string{"edc89b490d7d4392898e19b2deb34797"},
+}
+
+func TestAddMembers(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleMembersSuccessfully(t, "POST", expectedMembersRequest)
+
+	job, err := cloudimages.AddMembers(serviceClient(), membersOpts).ExtractJobResponse()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, jobID, job.JobID)
+}
+
+func TestDeleteMembers(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleMembersSuccessfully(t, "DELETE", expectedMembersRequest)
+
+	job, err := cloudimages.DeleteMembers(serviceClient(), membersOpts).ExtractJobResponse()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, jobID, job.JobID)
+}
+
+func TestUpdateMembers(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleMembersSuccessfully(t, "PUT", expectedUpdateMembersRequest)
+
+	opts := cloudimages.UpdateMembersOpts{
+		Images:    []string{"d164b5df-1bc3-4c3f-893e-3e471fd16e64"},
+		ProjectID: "edc89b490d7d4392898e19b2deb34797",
+		Status:    cloudimages.MemberStatusAccepted,
+	}
+	job, err := cloudimages.UpdateMembers(serviceClient(), opts).ExtractJobResponse()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, jobID, job.JobID)
+}
+
+func TestExport(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateImageSuccessfully(t, fmt.Sprintf("/v1/cloudimages/%s/file", imageID), expectedExportRequest)
+
+	opts := cloudimages.ExportOpts{
+		BucketURL:  "ims-export:image.qcow2",
+		FileFormat: cloudimages.FileFormatQCOW2,
+	}
+	job, err := cloudimages.Export(serviceClient(), imageID, opts).ExtractJobResponse()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, jobID, job.JobID)
+}
+
+func TestCopy(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateImageSuccessfully(t, fmt.Sprintf("/v1/cloudimages/%s/copy", imageID), expectedCopyRequest)
+
+	opts := cloudimages.CopyOpts{
+		Name:        "ims_copy",
+		Description: "in-region copy",
+		CmkId:       "bfc40ee8-fe8b-4e40-9de4-0b9bd06ea2a0",
+	}
+	job, err := cloudimages.Copy(serviceClient(), imageID, opts).ExtractJobResponse()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, jobID, job.JobID)
+}
+
+func TestCrossRegionCopy(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateImageSuccessfully(t, fmt.Sprintf("/v1/cloudimages/%s/cross_region_copy", imageID), expectedCrossRegionCopyRequest)
+
+	opts := cloudimages.CrossRegionCopyOpts{
+		Name:        "ims_copy_nl",
+		Region:      "eu-nl",
+		ProjectName: "eu-nl",
+		AgencyName:  "ims_admin_agency",
+	}
+	job, err := cloudimages.CrossRegionCopy(serviceClient(), imageID, opts).ExtractJobResponse()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, jobID, job.JobID)
+}
+
+func TestCreateWholeImage(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateImageSuccessfully(t, "/v1/cloudimages/wholeimages/action", expectedCreateWholeImageRequest)
+
+	opts := cloudimages.CreateWholeImageOpts{
+		Name:           "ims_whole_image",
+		Description:    "whole image from CBR backup",
+		BackupID:       "e2c5e1e4-0a46-4a0a-9e6a-8f4a2b0c7d23",
+		WholeImageType: "CBR",
+	}
+	job, err := cloudimages.CreateWholeImage(serviceClient(), opts).ExtractJobResponse()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, jobID, job.JobID)
+}
+
+func TestCreateWholeImageSourceValidation(t *testing.T) {
+	_, err := cloudimages.CreateWholeImageOpts{Name: "ims_whole_image"}.ToImageCreateMap()
+	if err == nil {
+		t.Fatal("expected error when neither instance nor backup is set")
+	}
+
+	_, err = cloudimages.CreateWholeImageOpts{
+		Name:       "ims_whole_image",
+		InstanceId: "877a2cda-ba63-4e1e-b95f-e67e48b6129a",
+		BackupID:   "e2c5e1e4-0a46-4a0a-9e6a-8f4a2b0c7d23",
+	}.ToImageCreateMap()
+	if err == nil {
+		t.Fatal("expected error when both instance and backup are set")
+	}
+}
+
+func TestGetQuota(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetQuotaSuccessfully(t)
+
+	quotas, err := cloudimages.GetQuota(serviceClient()).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []cloudimages.Quota{
+		{Type: "image", Used: 7, Quota: 100, Min: 1, Max: 1000},
+	}, quotas)
+}
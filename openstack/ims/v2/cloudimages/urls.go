@@ -21,6 +21,45 @@ func createDataImageURL(c *golangsdk.ServiceClient) string {
 	return c.ServiceURL("cloudimages/dataimages/action")
 }
 
+func quickImportURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("cloudimages/quickimport/action")
+}
+
+// jobs API is available only as `v1/{project_id}/jobs/{job_id}`
+func jobURL(c *golangsdk.ServiceClient, jobID string) string {
+	return c.Endpoint + "v1/" + c.ProjectID + "/jobs/" + jobID
+}
+
+// batch members API is available only as `v1/cloudimages/members`
+func membersURL(c *golangsdk.ServiceClient) string {
+	return c.Endpoint + "v1/cloudimages/members"
+}
+
+// export API is available only as `v1/cloudimages/{image_id}/file`
+func exportURL(c *golangsdk.ServiceClient, imageID string) string {
+	return c.Endpoint + "v1/cloudimages/" + imageID + "/file"
+}
+
+// copy API is available only as `v1/cloudimages/{image_id}/copy`
+func copyURL(c *golangsdk.ServiceClient, imageID string) string {
+	return c.Endpoint + "v1/cloudimages/" + imageID + "/copy"
+}
+
+// cross-region copy API is available only as `v1/cloudimages/{image_id}/cross_region_copy`
+func crossRegionCopyURL(c *golangsdk.ServiceClient, imageID string) string {
+	return c.Endpoint + "v1/cloudimages/" + imageID + "/cross_region_copy"
+}
+
+// whole image API is available only as `v1/cloudimages/wholeimages/action`
+func createWholeImageURL(c *golangsdk.ServiceClient) string {
+	return c.Endpoint + "v1/cloudimages/wholeimages/action"
+}
+
+// quota API is available only as `v1/cloudimages/quota`
+func quotaURL(c *golangsdk.ServiceClient) string {
+	return c.Endpoint + "v1/cloudimages/quota"
+}
+
 // builds next page full url based on current url
 func nextPageURL(serviceURL, requestedNext string) (string, error) {
 	base, err := utils.BaseEndpoint(serviceURL)
@@ -2,6 +2,25 @@ package grants
 
 import golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
 
+// Operations that can be granted on a CMK
+const (
+	OperationCreateDatakey                 = "create-datakey"
+	OperationCreateDatakeyWithoutPlaintext = "create-datakey-without-plaintext"
+	OperationEncryptDatakey                = "encrypt-datakey"
+	OperationDecryptDatakey                = "decrypt-datakey"
+	OperationDescribeKey                   = "describe-key"
+	OperationCreateGrant                   = "create-grant"
+	OperationRetireGrant                   = "retire-grant"
+	OperationEncryptData                   = "encrypt-data"
+	OperationDecryptData                   = "decrypt-data"
+)
+
+// Types of the grantee principal
+const (
+	GranteeTypeUser   = "user"
+	GranteeTypeDomain = "domain"
+)
+
 type CreateOptsBuilder interface {
 	ToGrantCreateMap() (map[string]interface{}, error)
 }
@@ -13,6 +32,9 @@ type CreateOpts struct {
 	// Indicates the ID of the authorized user.
 	// The value is between 1 to 64 bytes and meets the regular expression "^[a-zA-Z0-9]{1,64}$".
 	GranteePrincipal string `json:"grantee_principal" required:"true"`
+	// Type of the authorized principal: `user` (default) or `domain`
+	// for granting the key usage to another account
+	GranteePrincipalType string `json:"grantee_principal_type,omitempty"`
 	// Permissions that can be granted
 	Operations []string `json:"operations" required:"true"`
 	// Name of a grant which can be 1 to 255 characters in
@@ -108,3 +130,80 @@ func List(client *golangsdk.ServiceClient, opts ListOptsBuilder) (r ListResult)
 	})
 	return
 }
+
+// ListAll will return all Grants on a CMK, following the markers of the
+// truncated responses.
+func ListAll(client *golangsdk.ServiceClient, opts ListOpts) ([]Grant, error) {
+	var all []Grant
+	for {
+		page, err := List(client, opts).Extract()
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, page.Grants...)
+		if page.Truncated != "true" || page.NextMarker == "" {
+			return all, nil
+		}
+		opts.Marker = page.NextMarker
+	}
+}
+
+type RetireOptsBuilder interface {
+	ToGrantRetireMap() (map[string]interface{}, error)
+}
+
+type RetireOpts struct {
+	// 36-byte ID of a CMK
+	KeyID string `json:"key_id" required:"true"`
+	// 64-byte ID of a grant
+	GrantID string `json:"grant_id" required:"true"`
+	// Sequence represents 36-byte serial number of a request message
+	Sequence string `json:"sequence,omitempty"`
+}
+
+// ToGrantRetireMap assembles a request body based on the contents of a
+// RetireOpts.
+func (opts RetireOpts) ToGrantRetireMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Retire will retire the Grant by its retiring principal. To extract result
+// call the ExtractErr method on the DeleteResult.
+func Retire(client *golangsdk.ServiceClient, opts RetireOptsBuilder) (r DeleteResult) {
+	b, err := opts.ToGrantRetireMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(retireURL(client), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+type ListRetirableOptsBuilder interface {
+	ToGrantListRetirableMap() (map[string]interface{}, error)
+}
+
+type ListRetirableOpts struct {
+	Limit    string `json:"limit,omitempty"`
+	Marker   string `json:"marker,omitempty"`
+	Sequence string `json:"sequence,omitempty"`
+}
+
+func (opts ListRetirableOpts) ToGrantListRetirableMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// ListRetirable will return a collection of Grants the current user can retire.
+func ListRetirable(client *golangsdk.ServiceClient, opts ListRetirableOptsBuilder) (r ListResult) {
+	b, err := opts.ToGrantListRetirableMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(listRetirableURL(client), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
@@ -17,6 +17,7 @@ type Grant struct {
 	KeyID             string   `json:"key_id"`
 	GrantID           string   `json:"grant_id"`
 	GranteePrincipal  string   `json:"grantee_principal"`
+	GranteeType       string   `json:"grantee_principal_type"`
 	Operations        []string `json:"operations"`
 	IssuingPrincipal  string   `json:"issuing_principal"`
 	CreationDate      string   `json:"creation_date"`
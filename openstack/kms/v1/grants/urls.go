@@ -17,3 +17,11 @@ func deleteURL(c *golangsdk.ServiceClient) string {
 func listURL(c *golangsdk.ServiceClient) string {
 	return c.ServiceURL(resourcePath, "list-grants")
 }
+
+func retireURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL(resourcePath, "retire-grant")
+}
+
+func listRetirableURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL(resourcePath, "list-retirable-grants")
+}
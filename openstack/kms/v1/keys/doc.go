@@ -1,4 +1,44 @@
 // Package keys provides information and interaction with keys in the
 // Key Management Service service.  The customer master keys (CMKs) used
 // to encrypt data encryption keys (DEKs)
+//
+// Key lifecycle covers the creation (with KMS generated or imported key
+// material), enabling and disabling, scheduled deletion and its cancellation,
+// alias and description updates and the key rotation settings.
+//
+// Example to import key material into a CMK
+//
+//	key, err := keys.Create(client, keys.CreateOpts{
+//		KeyAlias: "external-key",
+//		Origin:   "external",
+//	}).ExtractKeyInfo()
+//	if err != nil {
+//		panic(err)
+//	}
+//
+//	params, err := keys.GetImportParams(client, keys.GetImportParamsOpts{
+//		KeyID:             key.KeyID,
+//		WrappingAlgorithm: "RSAES_OAEP_SHA_256",
+//	}).Extract()
+//	if err != nil {
+//		panic(err)
+//	}
+//
+//	// encrypt the key material with params.PublicKey
+//
+//	err = keys.ImportMaterial(client, keys.ImportMaterialOpts{
+//		KeyID:                key.KeyID,
+//		ImportToken:          params.ImportToken,
+//		EncryptedKeyMaterial: encryptedMaterial,
+//	}).ExtractErr()
+//	if err != nil {
+//		panic(err)
+//	}
+//
+// Tags of a CMK are managed with the common tags package using the `kms`
+// resource type:
+//
+//	err := tags.Create(client, "kms", keyID, []tags.ResourceTag{
+//		{Key: "env", Value: "test"},
+//	}).ExtractErr()
 package keys
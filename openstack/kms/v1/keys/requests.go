@@ -14,6 +14,11 @@ type CreateOpts struct {
 	Realm string `json:"realm,omitempty"`
 	// Purpose of a CMK (The default value is Encrypt_Decrypt)
 	KeyUsage string `json:"key_usage,omitempty"`
+	// Origin of a CMK: `kms` (default) or `external` for the key material
+	// imported by the user
+	Origin string `json:"origin,omitempty"`
+	// 36-byte serial number of a request message
+	Sequence string `json:"sequence,omitempty"`
 }
 
 type DeleteOpts struct {
@@ -213,7 +218,7 @@ func DataEncryptGetWithoutPlaintext(client *golangsdk.ServiceClient, opts DataEn
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(dataEncryptURL(client), b, &r.Body, &golangsdk.RequestOpts{
+	_, r.Err = client.Post(dataEncryptWithoutPlaintextURL(client), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
 	return
@@ -270,3 +275,287 @@ func ListAllKeys(client *golangsdk.ServiceClient, opts ListOptsBuilder) (r ListR
 	})
 	return
 }
+
+// CancelDelete cancels the scheduled deletion of the key with the provided ID.
+func CancelDelete(client *golangsdk.ServiceClient, id string) (r ExtractUpdateKeyStateResult) {
+	b := map[string]interface{}{"key_id": id}
+	_, r.Err = client.Post(cancelDeleteURL(client), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// EnableKeyRotation enables the rotation of the key with the provided ID.
+func EnableKeyRotation(client *golangsdk.ServiceClient, id string) (r RotationResult) {
+	b := map[string]interface{}{"key_id": id}
+	_, r.Err = client.Post(enableKeyRotationURL(client), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// DisableKeyRotation disables the rotation of the key with the provided ID.
+func DisableKeyRotation(client *golangsdk.ServiceClient, id string) (r RotationResult) {
+	b := map[string]interface{}{"key_id": id}
+	_, r.Err = client.Post(disableKeyRotationURL(client), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+type RotationIntervalOptsBuilder interface {
+	ToKeyRotationIntervalMap() (map[string]interface{}, error)
+}
+
+type RotationIntervalOpts struct {
+	// ID of a CMK
+	KeyID string `json:"key_id" required:"true"`
+	// Rotation interval in days (The value ranges from 30 to 365.)
+	Interval int `json:"rotation_interval" required:"true"`
+	// 36-byte serial number of a request message
+	Sequence string `json:"sequence,omitempty"`
+}
+
+// ToKeyRotationIntervalMap assembles a request body based on the contents of a
+// RotationIntervalOpts.
+func (opts RotationIntervalOpts) ToKeyRotationIntervalMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// UpdateKeyRotationInterval changes the rotation interval of the key.
+func UpdateKeyRotationInterval(client *golangsdk.ServiceClient, opts RotationIntervalOptsBuilder) (r RotationResult) {
+	b, err := opts.ToKeyRotationIntervalMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(updateKeyRotationIntervalURL(client), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// GetKeyRotationStatus retrieves the rotation status of the key with the provided ID.
+func GetKeyRotationStatus(client *golangsdk.ServiceClient, id string) (r GetRotationStatusResult) {
+	b := map[string]interface{}{"key_id": id}
+	_, r.Err = client.Post(getKeyRotationStatusURL(client), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+type GetImportParamsOptsBuilder interface {
+	ToImportParamsMap() (map[string]interface{}, error)
+}
+
+type GetImportParamsOpts struct {
+	// ID of a CMK created with the `external` origin
+	KeyID string `json:"key_id" required:"true"`
+	// Algorithm for encrypting the key material:
+	// `RSAES_PKCS1_V1_5`, `RSAES_OAEP_SHA_1` or `RSAES_OAEP_SHA_256`
+	WrappingAlgorithm string `json:"wrapping_algorithm" required:"true"`
+	// 36-byte serial number of a request message
+	Sequence string `json:"sequence,omitempty"`
+}
+
+// ToImportParamsMap assembles a request body based on the contents of a
+// GetImportParamsOpts.
+func (opts GetImportParamsOpts) ToImportParamsMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// GetImportParams retrieves the public key and the import token required to
+// import the key material.
+func GetImportParams(client *golangsdk.ServiceClient, opts GetImportParamsOptsBuilder) (r ImportParamsResult) {
+	b, err := opts.ToImportParamsMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(getImportParamsURL(client), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+type ImportMaterialOptsBuilder interface {
+	ToImportMaterialMap() (map[string]interface{}, error)
+}
+
+type ImportMaterialOpts struct {
+	// ID of a CMK created with the `external` origin
+	KeyID string `json:"key_id" required:"true"`
+	// Import token returned by GetImportParams
+	ImportToken string `json:"import_token" required:"true"`
+	// Key material encrypted with the public key returned by GetImportParams,
+	// encoded in Base64
+	EncryptedKeyMaterial string `json:"encrypted_key_material" required:"true"`
+	// Expiration time (time stamp in seconds) of the key material.
+	// The key material never expires if empty.
+	ExpirationTime string `json:"expiration_time,omitempty"`
+	// 36-byte serial number of a request message
+	Sequence string `json:"sequence,omitempty"`
+}
+
+// ToImportMaterialMap assembles a request body based on the contents of a
+// ImportMaterialOpts.
+func (opts ImportMaterialOpts) ToImportMaterialMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// ImportMaterial imports the key material into the key.
+func ImportMaterial(client *golangsdk.ServiceClient, opts ImportMaterialOptsBuilder) (r ImportMaterialResult) {
+	b, err := opts.ToImportMaterialMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(importMaterialURL(client), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// DeleteMaterial deletes the imported key material of the key with the provided ID.
+func DeleteMaterial(client *golangsdk.ServiceClient, id string) (r ImportMaterialResult) {
+	b := map[string]interface{}{"key_id": id}
+	_, r.Err = client.Post(deleteMaterialURL(client), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+type EncryptDataOptsBuilder interface {
+	ToEncryptDataMap() (map[string]interface{}, error)
+}
+
+type EncryptDataOpts struct {
+	// ID of a CMK
+	KeyID string `json:"key_id" required:"true"`
+	// Plaintext data to be encrypted, up to 4096 bytes
+	PlainText string `json:"plain_text" required:"true"`
+	// Key-value pairs used for the additional authenticated data
+	EncryptionContext map[string]string `json:"encryption_context,omitempty"`
+	// 36-byte serial number of a request message
+	Sequence string `json:"sequence,omitempty"`
+}
+
+// ToEncryptDataMap assembles a request body based on the contents of a
+// EncryptDataOpts.
+func (opts EncryptDataOpts) ToEncryptDataMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// EncryptData encrypts the plaintext data with the CMK. To extract the
+// ciphertext, call the ExtractEncryptData method on the EncryptDataResult.
+func EncryptData(client *golangsdk.ServiceClient, opts EncryptDataOptsBuilder) (r EncryptDataResult) {
+	b, err := opts.ToEncryptDataMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(encryptDataURL(client), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+type DecryptDataOptsBuilder interface {
+	ToDecryptDataMap() (map[string]interface{}, error)
+}
+
+type DecryptDataOpts struct {
+	// Ciphertext returned by EncryptData
+	CipherText string `json:"cipher_text" required:"true"`
+	// Key-value pairs used for the additional authenticated data
+	EncryptionContext map[string]string `json:"encryption_context,omitempty"`
+	// 36-byte serial number of a request message
+	Sequence string `json:"sequence,omitempty"`
+}
+
+// ToDecryptDataMap assembles a request body based on the contents of a
+// DecryptDataOpts.
+func (opts DecryptDataOpts) ToDecryptDataMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// DecryptData decrypts the ciphertext returned by EncryptData. To extract the
+// plaintext, call the ExtractDecryptData method on the DecryptDataResult.
+func DecryptData(client *golangsdk.ServiceClient, opts DecryptDataOptsBuilder) (r DecryptDataResult) {
+	b, err := opts.ToDecryptDataMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(decryptDataURL(client), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+type DecryptDEKOptsBuilder interface {
+	ToDecryptDEKMap() (map[string]interface{}, error)
+}
+
+type DecryptDEKOpts struct {
+	// ID of a CMK
+	KeyID string `json:"key_id" required:"true"`
+	// Both the ciphertext of a DEK and the metadata (120 bytes) are expressed
+	// as a hexadecimal character string.
+	CipherText string `json:"cipher_text" required:"true"`
+	// Number of bytes of the DEK ciphertext (The value is 64.)
+	DataKeyCipherLength string `json:"datakey_cipher_length" required:"true"`
+	// Key-value pairs used for the additional authenticated data
+	EncryptionContext map[string]string `json:"encryption_context,omitempty"`
+	// 36-byte serial number of a request message
+	Sequence string `json:"sequence,omitempty"`
+}
+
+// ToDecryptDEKMap assembles a request body based on the contents of a
+// DecryptDEKOpts.
+func (opts DecryptDEKOpts) ToDecryptDEKMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// DecryptDEKGet decrypts the DEK ciphertext with the CMK.
+func DecryptDEKGet(client *golangsdk.ServiceClient, opts DecryptDEKOptsBuilder) (r DecryptDEKResult) {
+	b, err := opts.ToDecryptDEKMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(decryptDEKURL(client), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+type GenRandomOptsBuilder interface {
+	ToGenRandomMap() (map[string]interface{}, error)
+}
+
+type GenRandomOpts struct {
+	// Bit length of a random number (The value is 512.)
+	RandomDataLength string `json:"random_data_length" required:"true"`
+	// 36-byte serial number of a request message
+	Sequence string `json:"sequence,omitempty"`
+}
+
+// ToGenRandomMap assembles a request body based on the contents of a
+// GenRandomOpts.
+func (opts GenRandomOpts) ToGenRandomMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// GenRandom generates a random number of the given bit length.
+func GenRandom(client *golangsdk.ServiceClient, opts GenRandomOptsBuilder) (r GenRandomResult) {
+	b, err := opts.ToGenRandomMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(genRandomURL(client), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
@@ -33,8 +33,11 @@ type Key struct {
 	// Expiration time
 	ExpirationTime string `json:"expiration_time"`
 	// Origin of a CMK. The default value is kms. The following values
-	// are enumerated: kms indicates that the CMK material is generated by KMS.
+	// are enumerated: kms indicates that the CMK material is generated by KMS,
+	// external indicates that the CMK material is imported by the user.
 	Origin string `json:"origin"`
+	// Expiration time (time stamp) of the imported key material
+	KeyMaterialExpireTime string `json:"key_material_expire_time"`
 }
 
 type ListKey struct {
@@ -173,3 +176,152 @@ func ExtractKeys(r pagination.Page) ([]Key, error) {
 	}
 	return s.Keys, nil
 }
+
+func (r commonResult) ExtractKeyState() (*UpdateKeyState, error) {
+	var s *UpdateKeyState
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+type RotationStatus struct {
+	// Key rotation status
+	Enabled bool `json:"key_rotation_enabled"`
+	// Rotation interval in days
+	Interval int `json:"rotation_interval"`
+	// Last key rotation time (time stamp)
+	LastRotationTime string `json:"last_rotation_time"`
+	// Number of key rotations
+	NumberOfRotations int `json:"number_of_rotations"`
+}
+
+type ImportParams struct {
+	// Current ID of a CMK
+	KeyID string `json:"key_id"`
+	// Token for importing the key material
+	ImportToken string `json:"import_token"`
+	// Expiration time (time stamp) of the import token
+	ExpirationTime int64 `json:"expiration_time"`
+	// Public key for encrypting the key material, encoded in Base64
+	PublicKey string `json:"public_key"`
+}
+
+// RotationResult contains the error from a key rotation request.
+type RotationResult struct {
+	golangsdk.ErrResult
+}
+
+// GetRotationStatusResult contains the response body and error from a GetKeyRotationStatus request.
+type GetRotationStatusResult struct {
+	golangsdk.Result
+}
+
+func (r GetRotationStatusResult) Extract() (*RotationStatus, error) {
+	var s *RotationStatus
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// ImportParamsResult contains the response body and error from a GetImportParams request.
+type ImportParamsResult struct {
+	golangsdk.Result
+}
+
+func (r ImportParamsResult) Extract() (*ImportParams, error) {
+	var s *ImportParams
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// ImportMaterialResult contains the error from a key material import or deletion request.
+type ImportMaterialResult struct {
+	golangsdk.ErrResult
+}
+
+type EncryptedData struct {
+	// Current ID of a CMK
+	KeyID string `json:"key_id"`
+	// Ciphertext data in Base64 format
+	CipherText string `json:"cipher_text"`
+}
+
+type DecryptedData struct {
+	// Current ID of a CMK
+	KeyID string `json:"key_id"`
+	// Plaintext data
+	PlainText string `json:"plain_text"`
+}
+
+type DecryptDEK struct {
+	// Plaintext of a DEK expressed as a hexadecimal character string
+	DataKey string `json:"data_key"`
+	// Number of bytes of the DEK plaintext
+	DataKeyLength string `json:"datakey_length"`
+	// SHA-256 hash value of the DEK plaintext
+	DataKeyDgst string `json:"datakey_dgst"`
+}
+
+type Random struct {
+	// Random number expressed as a hexadecimal character string
+	RandomData string `json:"random_data"`
+}
+
+type EncryptDataResult struct {
+	commonResult
+}
+
+type DecryptDataResult struct {
+	commonResult
+}
+
+type DecryptDEKResult struct {
+	commonResult
+}
+
+type GenRandomResult struct {
+	commonResult
+}
+
+func (r commonResult) ExtractEncryptData() (*EncryptedData, error) {
+	var s *EncryptedData
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (r commonResult) ExtractDecryptData() (*DecryptedData, error) {
+	var s *DecryptedData
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (r commonResult) ExtractDecryptDEK() (*DecryptDEK, error) {
+	var s *DecryptDEK
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (r commonResult) ExtractRandom() (*Random, error) {
+	var s *Random
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
@@ -45,3 +45,55 @@ func disableKeyURL(c *golangsdk.ServiceClient) string {
 func listURL(c *golangsdk.ServiceClient) string {
 	return c.ServiceURL(resourcePath, "list-keys")
 }
+
+func cancelDeleteURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL(resourcePath, "cancel-key-deletion")
+}
+
+func enableKeyRotationURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL(resourcePath, "enable-key-rotation")
+}
+
+func disableKeyRotationURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL(resourcePath, "disable-key-rotation")
+}
+
+func updateKeyRotationIntervalURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL(resourcePath, "update-key-rotation-interval")
+}
+
+func getKeyRotationStatusURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL(resourcePath, "get-key-rotation-status")
+}
+
+func getImportParamsURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL(resourcePath, "get-parameters-for-import")
+}
+
+func importMaterialURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL(resourcePath, "import-key-material")
+}
+
+func deleteMaterialURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL(resourcePath, "delete-imported-key-material")
+}
+
+func dataEncryptWithoutPlaintextURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL(resourcePath, "create-datakey-without-plaintext")
+}
+
+func decryptDEKURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL(resourcePath, "decrypt-datakey")
+}
+
+func encryptDataURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL(resourcePath, "encrypt-data")
+}
+
+func decryptDataURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL(resourcePath, "decrypt-data")
+}
+
+func genRandomURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL(resourcePath, "gen-random")
+}
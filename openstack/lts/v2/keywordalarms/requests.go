@@ -0,0 +1,145 @@
+// Package keywordalarms manages the LTS keyword alarm rules.
+// These APIs are rooted at `v2`, the client has to be created with openstack.NewLTSV2Client.
+package keywordalarms
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+// KeywordsRequest is a single keyword search of the alarm rule.
+type KeywordsRequest struct {
+	LogGroupID  string `json:"log_group_id" required:"true"`
+	LogStreamID string `json:"log_stream_id" required:"true"`
+	// Specifies the search keywords, e.g. `error AND timeout`.
+	Keywords string `json:"keywords" required:"true"`
+	// Specifies the comparison of the matched logs number: `>`, `<`, `>=` or `<=`.
+	Condition string `json:"condition" required:"true"`
+	// Specifies the compared number of matched logs.
+	Number int `json:"number" required:"true"`
+	// Specifies the search time range.
+	SearchTimeRange int `json:"search_time_range" required:"true"`
+	// Specifies the search time range unit: `minute` or `hour`.
+	SearchTimeRangeUnit string `json:"search_time_range_unit" required:"true"`
+}
+
+// Frequency is the alarm rule check schedule.
+type Frequency struct {
+	// Specifies the schedule type: `CRON`, `HOURLY`, `DAILY`, `WEEKLY` or `FIXED_RATE`.
+	Type          string `json:"type" required:"true"`
+	CronExpr      string `json:"cron_expr,omitempty"`
+	HourOfDay     int    `json:"hour_of_day,omitempty"`
+	DayOfWeek     int    `json:"day_of_week,omitempty"`
+	FixedRate     int    `json:"fixed_rate,omitempty"`
+	FixedRateUnit string `json:"fixed_rate_unit,omitempty"`
+}
+
+// NotificationRule is the alarm notification configuration.
+type NotificationRule struct {
+	TemplateName string  `json:"template_name" required:"true"`
+	Topics       []Topic `json:"topics" required:"true"`
+	// Specifies the notification language: `zh-cn` or `en-us`.
+	Language string `json:"language,omitempty"`
+	TimeZone string `json:"timezone,omitempty"`
+	UserName string `json:"user_name,omitempty"`
+}
+
+// Topic is the SMN topic receiving the alarm notification.
+type Topic struct {
+	Name       string `json:"name" required:"true"`
+	TopicURN   string `json:"topic_urn" required:"true"`
+	Display    string `json:"display_name,omitempty"`
+	PushPolicy int    `json:"push_policy,omitempty"`
+}
+
+// CreateOptsBuilder is used for creating keyword alarm rule parameters.
+type CreateOptsBuilder interface {
+	ToKeywordAlarmCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts is a struct that contains all the parameters.
+type CreateOpts struct {
+	Name             string            `json:"keywords_alarm_rule_name" required:"true"`
+	Description      string            `json:"keywords_alarm_rule_description,omitempty"`
+	KeywordsRequests []KeywordsRequest `json:"keywords_requests" required:"true"`
+	Frequency        Frequency         `json:"frequency" required:"true"`
+	// Specifies the alarm severity: `Info`, `Minor`, `Major` or `Critical`.
+	AlarmLevel string `json:"keywords_alarm_level" required:"true"`
+	// Specifies whether the alarm notification is sent.
+	AlarmSend            bool              `json:"keywords_alarm_send"`
+	DomainID             string            `json:"domain_id" required:"true"`
+	NotificationSaveRule *NotificationRule `json:"notification_save_rule,omitempty"`
+	// Specifies the number of checks matching the condition before the alarm is triggered.
+	TriggerConditionCount int `json:"trigger_condition_count,omitempty"`
+	// Specifies the number of checks evaluated for the trigger condition.
+	TriggerConditionFrequency int `json:"trigger_condition_frequency,omitempty"`
+	// Specifies whether the recovery notification is sent.
+	WhetherRecoveryPolicy bool `json:"whether_recovery_policy,omitempty"`
+	// Specifies the number of checks not matching the condition before the alarm is cleared.
+	RecoveryPolicy int `json:"recovery_policy,omitempty"`
+}
+
+// ToKeywordAlarmCreateMap is used for type convert
+func (ops CreateOpts) ToKeywordAlarmCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(ops, "")
+}
+
+// Create a keyword alarm rule with given parameters.
+func Create(client *golangsdk.ServiceClient, ops CreateOptsBuilder) (r CreateResult) {
+	b, err := ops.ToKeywordAlarmCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Post(rootURL(client), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	return
+}
+
+// List keyword alarm rules of the project.
+func List(client *golangsdk.ServiceClient) (r ListResult) {
+	_, r.Err = client.Get(rootURL(client), &r.Body, nil)
+	return
+}
+
+// UpdateOptsBuilder is used for updating keyword alarm rule parameters.
+type UpdateOptsBuilder interface {
+	ToKeywordAlarmUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts is a struct that contains all the parameters, the whole rule is replaced.
+type UpdateOpts struct {
+	ID string `json:"keywords_alarm_rule_id" required:"true"`
+	CreateOpts
+}
+
+// ToKeywordAlarmUpdateMap is used for type convert
+func (ops UpdateOpts) ToKeywordAlarmUpdateMap() (map[string]interface{}, error) {
+	b, err := ops.CreateOpts.ToKeywordAlarmCreateMap()
+	if err != nil {
+		return nil, err
+	}
+	b["keywords_alarm_rule_id"] = ops.ID
+	return b, nil
+}
+
+// Update a keyword alarm rule with given parameters.
+func Update(client *golangsdk.ServiceClient, ops UpdateOptsBuilder) (r UpdateResult) {
+	b, err := ops.ToKeywordAlarmUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Put(rootURL(client), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete a keyword alarm rule by id
+func Delete(client *golangsdk.ServiceClient, id string) (r DeleteResult) {
+	_, r.Err = client.Delete(resourceURL(client, id), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return
+}
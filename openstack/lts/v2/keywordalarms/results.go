@@ -0,0 +1,61 @@
+package keywordalarms
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+// KeywordAlarmRule is the keyword alarm rule response
+type KeywordAlarmRule struct {
+	ID                        string            `json:"keywords_alarm_rule_id"`
+	Name                      string            `json:"keywords_alarm_rule_name"`
+	Description               string            `json:"keywords_alarm_rule_description"`
+	KeywordsRequests          []KeywordsRequest `json:"keywords_requests"`
+	Frequency                 Frequency         `json:"frequency"`
+	AlarmLevel                string            `json:"keywords_alarm_level"`
+	AlarmSend                 bool              `json:"keywords_alarm_send"`
+	DomainID                  string            `json:"domain_id"`
+	CreateTime                int64             `json:"create_time"`
+	UpdateTime                int64             `json:"update_time"`
+	Topics                    []Topic           `json:"topics"`
+	TriggerConditionCount     int               `json:"trigger_condition_count"`
+	TriggerConditionFrequency int               `json:"trigger_condition_frequency"`
+	WhetherRecoveryPolicy     bool              `json:"whether_recovery_policy"`
+	RecoveryPolicy            int               `json:"recovery_policy"`
+	Status                    string            `json:"status"`
+}
+
+type commonResult struct {
+	golangsdk.Result
+}
+
+// Extract from the result
+func (r commonResult) Extract() (*KeywordAlarmRule, error) {
+	s := new(KeywordAlarmRule)
+	err := r.Result.ExtractInto(s)
+	return s, err
+}
+
+// CreateResult is a struct that contains all the return parameters of creation
+type CreateResult struct {
+	commonResult
+}
+
+// UpdateResult is a struct that contains all the return parameters of update
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult is a struct which contains the result of deletion
+type DeleteResult struct {
+	golangsdk.ErrResult
+}
+
+// ListResult contains the body of listing
+type ListResult struct {
+	golangsdk.Result
+}
+
+// Extract from ListResult
+func (r ListResult) Extract() ([]KeywordAlarmRule, error) {
+	var s []KeywordAlarmRule
+	err := r.Result.ExtractIntoSlicePtr(&s, "keywords_alarm_rules")
+	return s, err
+}
@@ -0,0 +1,82 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const (
+	ruleID   = "2b6bd1e2-3df8-4a51-9b3c-5d8f9e2a1c4b"
+	groupID  = "b33a0dc5-fd7a-4d4b-8d33-57e6a1d1c9a0"
+	streamID = "2f1a9d4b-0e4c-4a1e-9a5b-3d1e0c7f6b8a"
+	domainID = "0a2b4c6d8e0f4a1b3c5d7e9f1a3b5c7d"
+)
+
+var ruleBody = fmt.Sprintf(`
+  "keywords_alarm_rule_name": "lts-alarm",
+  "keywords_requests": [
+    {
+      "log_group_id": "%s",
+      "log_stream_id": "%s",
+      "keywords": "error",
+      "condition": ">=",
+      "number": 10,
+      "search_time_range": 5,
+      "search_time_range_unit": "minute"
+    }
+  ],
+  "frequency": {
+    "type": "FIXED_RATE",
+    "fixed_rate": 5,
+    "fixed_rate_unit": "minute"
+  },
+  "keywords_alarm_level": "%%s",
+  "keywords_alarm_send": false,
+  "domain_id": "%s"`, groupID, streamID, domainID)
+
+var expectedCreateRequest = fmt.Sprintf("{%s}", fmt.Sprintf(ruleBody, "Minor"))
+
+var expectedUpdateRequest = fmt.Sprintf(`{"keywords_alarm_rule_id": "%s", %s}`, ruleID, fmt.Sprintf(ruleBody, "Major"))
+
+var ruleResponse = fmt.Sprintf(`{"keywords_alarm_rule_id": "%s", "create_time": 1630491126000, "status": "RUNNING", %s}`,
+	ruleID, ruleBody)
+
+var listResponse = fmt.Sprintf(`{"keywords_alarm_rules": [%s]}`, fmt.Sprintf(ruleResponse, "Minor"))
+
+// HandleKeywordAlarmsSuccessfully creates an HTTP handler at `/lts/alarms/keywords-alarm-rule` on
+// the test handler mux that responds to POST, GET and PUT requests.
+func HandleKeywordAlarmsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/lts/alarms/keywords-alarm-rule", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+		switch r.Method {
+		case "POST":
+			th.TestJSONRequest(t, r, expectedCreateRequest)
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprintf(w, ruleResponse, "Minor")
+		case "GET":
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprint(w, listResponse)
+		case "PUT":
+			th.TestJSONRequest(t, r, expectedUpdateRequest)
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprintf(w, ruleResponse, "Major")
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+}
+
+// HandleDeleteSuccessfully creates an HTTP handler at `/lts/alarms/keywords-alarm-rule/{rule_id}`
+// on the test handler mux that responds to a DELETE request.
+func HandleDeleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/lts/alarms/keywords-alarm-rule/%s", ruleID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusOK)
+	})
+}
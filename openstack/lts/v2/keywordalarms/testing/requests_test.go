@@ -0,0 +1,78 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/lts/v2/keywordalarms"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func createOpts(level string) keywordalarms.CreateOpts {
+	return keywordalarms.CreateOpts{
+		Name: "lts-alarm",
+		KeywordsRequests: []keywordalarms.KeywordsRequest{
+			{
+				LogGroupID:          groupID,
+				LogStreamID:         streamID,
+				Keywords:            "error",
+				Condition:           ">=",
+				Number:              10,
+				SearchTimeRange:     5,
+				SearchTimeRangeUnit: "minute",
+			},
+		},
+		Frequency: keywordalarms.Frequency{
+			Type:          "FIXED_RATE",
+			FixedRate:     5,
+			FixedRateUnit: "minute",
+		},
+		AlarmLevel: level,
+		DomainID:   domainID,
+	}
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleKeywordAlarmsSuccessfully(t)
+
+	rule, err := keywordalarms.Create(fake.ServiceClient(), createOpts("Minor")).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, ruleID, rule.ID)
+	th.AssertEquals(t, "Minor", rule.AlarmLevel)
+	th.AssertEquals(t, "error", rule.KeywordsRequests[0].Keywords)
+}
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleKeywordAlarmsSuccessfully(t)
+
+	rules, err := keywordalarms.List(fake.ServiceClient()).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(rules))
+	th.AssertEquals(t, "RUNNING", rules[0].Status)
+	th.AssertEquals(t, "FIXED_RATE", rules[0].Frequency.Type)
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleKeywordAlarmsSuccessfully(t)
+
+	rule, err := keywordalarms.Update(fake.ServiceClient(), keywordalarms.UpdateOpts{
+		ID:         ruleID,
+		CreateOpts: createOpts("Major"),
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "Major", rule.AlarmLevel)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteSuccessfully(t)
+
+	th.AssertNoErr(t, keywordalarms.Delete(fake.ServiceClient(), ruleID).ExtractErr())
+}
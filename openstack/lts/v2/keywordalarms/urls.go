@@ -0,0 +1,15 @@
+package keywordalarms
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+const rootPath = "lts/alarms/keywords-alarm-rule"
+
+// rootURL will build the url of create, list and update
+func rootURL(client *golangsdk.ServiceClient) string {
+	return client.ServiceURL(rootPath)
+}
+
+// resourceURL will build the url of deletion
+func resourceURL(client *golangsdk.ServiceClient, id string) string {
+	return client.ServiceURL(rootPath, id)
+}
@@ -1,6 +1,9 @@
 package loggroups
 
-import "github.com/opentelekomcloud/gophertelekomcloud"
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/tags"
+)
 
 // CreateOptsBuilder is used for creating log group parameters.
 type CreateOptsBuilder interface {
@@ -12,8 +15,11 @@ type CreateOpts struct {
 	// Specifies the log group name.
 	LogGroupName string `json:"log_group_name" required:"true"`
 
-	// Specifies the log expiration time. The value is fixed to 7 days.
-	TTL int `json:"ttl_in_daysion,omitempty"`
+	// Specifies the log expiration time in days, from 1 to 30, 7 by default.
+	TTL int `json:"ttl_in_days,omitempty"`
+
+	// Specifies the log group tags.
+	Tags []tags.ResourceTag `json:"tags,omitempty"`
 }
 
 // ToLogGroupsCreateMap is used for type convert
@@ -47,3 +53,42 @@ func Get(client *golangsdk.ServiceClient, id string) (r GetResult) {
 	_, r.Err = client.Get(getURL(client, id), &r.Body, nil)
 	return
 }
+
+// List all log groups of the project
+func List(client *golangsdk.ServiceClient) (r ListResult) {
+	_, r.Err = client.Get(listURL(client), &r.Body, nil)
+	return
+}
+
+// UpdateOptsBuilder is used for updating log group parameters.
+type UpdateOptsBuilder interface {
+	ToLogGroupsUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts is a struct that contains all the parameters.
+type UpdateOpts struct {
+	// Specifies the log expiration time in days, from 1 to 30.
+	TTL int `json:"ttl_in_days" required:"true"`
+
+	// Specifies the log group tags, existing tags are replaced.
+	Tags []tags.ResourceTag `json:"tags,omitempty"`
+}
+
+// ToLogGroupsUpdateMap is used for type convert
+func (ops UpdateOpts) ToLogGroupsUpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(ops, "")
+}
+
+// Update a log group with given parameters.
+func Update(client *golangsdk.ServiceClient, id string, ops UpdateOptsBuilder) (r UpdateResult) {
+	b, err := ops.ToLogGroupsUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Put(updateURL(client, id), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
@@ -30,6 +30,8 @@ type LogGroup struct {
 	Name         string `json:"log_group_name"`
 	CreationTime int64  `json:"creation_time"`
 	TTLinDays    int    `json:"ttl_in_days"`
+	// Tags of the log group
+	Tags map[string]string `json:"tag"`
 }
 
 // GetResult contains the body of getting detailed
@@ -43,3 +45,20 @@ func (r GetResult) Extract() (*LogGroup, error) {
 	err := r.Result.ExtractInto(s)
 	return s, err
 }
+
+// ListResult contains the body of listing
+type ListResult struct {
+	golangsdk.Result
+}
+
+// Extract from ListResult
+func (r ListResult) Extract() ([]LogGroup, error) {
+	var s []LogGroup
+	err := r.Result.ExtractIntoSlicePtr(&s, "log_groups")
+	return s, err
+}
+
+// UpdateResult contains the body of update
+type UpdateResult struct {
+	GetResult
+}
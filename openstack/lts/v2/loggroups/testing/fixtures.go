@@ -0,0 +1,99 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const groupID = "b33a0dc5-fd7a-4d4b-8d33-57e6a1d1c9a0"
+
+const expectedCreateRequest = `
+{
+  "log_group_name": "lts-group",
+  "ttl_in_days": 7,
+  "tags": [
+    {
+      "key": "env",
+      "value": "test"
+    }
+  ]
+}`
+
+var createResponse = fmt.Sprintf(`
+{
+  "log_group_id": "%s"
+}`, groupID)
+
+var getResponse = fmt.Sprintf(`
+{
+  "log_group_id": "%s",
+  "log_group_name": "lts-group",
+  "creation_time": 1630489219469,
+  "ttl_in_days": 14,
+  "tag": {
+    "env": "prod"
+  }
+}`, groupID)
+
+var listResponse = fmt.Sprintf(`
+{
+  "log_groups": [%s]
+}`, getResponse)
+
+const expectedUpdateRequest = `
+{
+  "ttl_in_days": 14,
+  "tags": [
+    {
+      "key": "env",
+      "value": "prod"
+    }
+  ]
+}`
+
+// HandleLogGroupsSuccessfully creates an HTTP handler at `/log-groups` on the test handler mux that
+// responds to POST and GET requests.
+func HandleLogGroupsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/log-groups", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+		switch r.Method {
+		case "POST":
+			th.TestJSONRequest(t, r, expectedCreateRequest)
+			w.WriteHeader(http.StatusCreated)
+			_, _ = fmt.Fprint(w, createResponse)
+		case "GET":
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprint(w, listResponse)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+}
+
+// HandleLogGroupSuccessfully creates an HTTP handler at `/log-groups/{group_id}` on the test
+// handler mux that responds to GET, PUT and DELETE requests.
+func HandleLogGroupSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/log-groups/%s", groupID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		switch r.Method {
+		case "GET":
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprint(w, getResponse)
+		case "PUT":
+			th.TestJSONRequest(t, r, expectedUpdateRequest)
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprint(w, getResponse)
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+}
@@ -0,0 +1,58 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/tags"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/lts/v2/loggroups"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleLogGroupsSuccessfully(t)
+
+	created, err := loggroups.Create(fake.ServiceClient(), loggroups.CreateOpts{
+		LogGroupName: "lts-group",
+		TTL:          7,
+		Tags:         []tags.ResourceTag{{Key: "env", Value: "test"}},
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, groupID, created.ID)
+}
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleLogGroupsSuccessfully(t)
+
+	groups, err := loggroups.List(fake.ServiceClient()).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(groups))
+	th.AssertEquals(t, groupID, groups[0].ID)
+	th.AssertEquals(t, "prod", groups[0].Tags["env"])
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleLogGroupSuccessfully(t)
+
+	group, err := loggroups.Update(fake.ServiceClient(), groupID, loggroups.UpdateOpts{
+		TTL:  14,
+		Tags: []tags.ResourceTag{{Key: "env", Value: "prod"}},
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 14, group.TTLinDays)
+	th.AssertEquals(t, int64(1630489219469), group.CreationTime)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleLogGroupSuccessfully(t)
+
+	th.AssertNoErr(t, loggroups.Delete(fake.ServiceClient(), groupID).ExtractErr())
+}
@@ -18,3 +18,13 @@ func deleteURL(client *golangsdk.ServiceClient, id string) string {
 func getURL(client *golangsdk.ServiceClient, id string) string {
 	return client.ServiceURL(rootPath, id)
 }
+
+// listURL will build the url of listing
+func listURL(client *golangsdk.ServiceClient) string {
+	return client.ServiceURL(rootPath)
+}
+
+// updateURL will build the url of update
+func updateURL(client *golangsdk.ServiceClient, id string) string {
+	return client.ServiceURL(rootPath, id)
+}
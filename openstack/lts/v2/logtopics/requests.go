@@ -9,8 +9,11 @@ type CreateOptsBuilder interface {
 
 // CreateOpts is a struct that contains all the parameters.
 type CreateOpts struct {
-	// Specifies the log group name.
+	// Specifies the log topic name.
 	LogTopicName string `json:"log_topic_name" required:"true"`
+
+	// Specifies the log expiration time in days, the log group TTL is used if not set.
+	TTL int `json:"ttl_in_days,omitempty"`
 }
 
 // ToLogTopicsCreateMap is used for type convert
@@ -44,3 +47,9 @@ func Get(client *golangsdk.ServiceClient, groupId string, id string) (r GetResul
 	_, r.Err = client.Get(getURL(client, groupId, id), &r.Body, nil)
 	return
 }
+
+// List all log topics of the log group
+func List(client *golangsdk.ServiceClient, groupId string) (r ListResult) {
+	_, r.Err = client.Get(listURL(client, groupId), &r.Body, nil)
+	return
+}
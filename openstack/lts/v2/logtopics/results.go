@@ -30,6 +30,7 @@ type LogTopic struct {
 	Name         string `json:"log_topic_name"`
 	CreationTime int64  `json:"creation_time"`
 	IndexEnabled bool   `json:"index_enabled"`
+	TTLinDays    int    `json:"ttl_in_days"`
 }
 
 // GetResult contains the body of getting detailed
@@ -43,3 +44,15 @@ func (r GetResult) Extract() (*LogTopic, error) {
 	err := r.Result.ExtractInto(s)
 	return s, err
 }
+
+// ListResult contains the body of listing
+type ListResult struct {
+	golangsdk.Result
+}
+
+// Extract from ListResult
+func (r ListResult) Extract() ([]LogTopic, error) {
+	var s []LogTopic
+	err := r.Result.ExtractIntoSlicePtr(&s, "log_topics")
+	return s, err
+}
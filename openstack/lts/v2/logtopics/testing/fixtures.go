@@ -0,0 +1,40 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const (
+	groupID = "b33a0dc5-fd7a-4d4b-8d33-57e6a1d1c9a0"
+	topicID = "2f1a9d4b-0e4c-4a1e-9a5b-3d1e0c7f6b8a"
+)
+
+var listResponse = fmt.Sprintf(`
+{
+  "log_topics": [
+    {
+      "log_topic_id": "%s",
+      "log_topic_name": "lts-topic",
+      "creation_time": 1630489358345,
+      "index_enabled": true,
+      "ttl_in_days": 7
+    }
+  ]
+}`, topicID)
+
+// HandleListSuccessfully creates an HTTP handler at `/log-groups/{group_id}/log-topics` on the test
+// handler mux that responds to a GET request with listResponse.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/log-groups/%s/log-topics", groupID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, listResponse)
+	})
+}
@@ -0,0 +1,22 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/lts/v2/logtopics"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	topics, err := logtopics.List(fake.ServiceClient(), groupID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(topics))
+	th.AssertEquals(t, topicID, topics[0].ID)
+	th.AssertEquals(t, "lts-topic", topics[0].Name)
+	th.AssertEquals(t, 7, topics[0].TTLinDays)
+}
@@ -21,3 +21,8 @@ func deleteURL(client *golangsdk.ServiceClient, groupId string, id string) strin
 func getURL(client *golangsdk.ServiceClient, groupId string, id string) string {
 	return client.ServiceURL(rootPath, groupId, resourcePath, id)
 }
+
+// listURL will build the url of listing
+func listURL(client *golangsdk.ServiceClient, groupId string) string {
+	return client.ServiceURL(rootPath, groupId, resourcePath)
+}
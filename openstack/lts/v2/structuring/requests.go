@@ -0,0 +1,115 @@
+// Package structuring manages the LTS log structuring templates.
+// These APIs are rooted at `v2`, the client has to be created with openstack.NewLTSV2Client.
+package structuring
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+// FieldOpts describes a single field extracted from the log.
+type FieldOpts struct {
+	// Specifies the field name in the sample log.
+	FieldName string `json:"fieldName" required:"true"`
+	// Specifies the field type: `string`, `long` or `float`.
+	Type string `json:"type" required:"true"`
+	// Specifies the field name shown in LTS, FieldName is used if not set.
+	UserDefinedName string `json:"user_defined_name,omitempty"`
+	// Specifies the field sample content.
+	Content     string `json:"content,omitempty"`
+	Description string `json:"description,omitempty"`
+	// Specifies whether the field is used for quick analysis.
+	IsAnalysis *bool `json:"is_analysis,omitempty"`
+}
+
+// CreateOptsBuilder is used for creating structuring rule parameters.
+type CreateOptsBuilder interface {
+	ToStructuringCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts is a struct that contains all the parameters of the structuring rule.
+type CreateOpts struct {
+	LogGroupID  string `json:"log_group_id" required:"true"`
+	LogStreamID string `json:"log_stream_id" required:"true"`
+	ProjectID   string `json:"project_id" required:"true"`
+	// Specifies the template type: `built_in` for system templates or `custom`.
+	TemplateType string `json:"template_type" required:"true"`
+	// Specifies the system template name, e.g. `ELB` or `VPC`, or the custom template name.
+	TemplateName string `json:"template_name,omitempty"`
+	// Specifies the custom template ID.
+	TemplateID string `json:"template_id,omitempty"`
+	// Specifies the structuring method: `regex`, `json`, `split` or `nginx`.
+	StructType string `json:"struct_type,omitempty"`
+	// Specifies the sample log the fields are extracted from.
+	DemoLog string `json:"demo_log,omitempty"`
+	// Specifies the regular expression for `regex` structuring.
+	RegexRules string `json:"regex_rules,omitempty"`
+	// Specifies the delimiter for `split` structuring.
+	Tokenizer string `json:"tokenizer,omitempty"`
+	// Specifies the log format configuration for `nginx` structuring.
+	LogFormat string `json:"log_format,omitempty"`
+	// Specifies the fields extracted from the log.
+	DemoFields []FieldOpts `json:"demo_fields,omitempty"`
+	// Specifies the tag fields.
+	TagFields []FieldOpts `json:"tag_fields,omitempty"`
+	// Specifies whether quick analysis is enabled for the fields.
+	QuickAnalysis *bool `json:"quick_analysis,omitempty"`
+}
+
+// ToStructuringCreateMap is used for type convert
+func (ops CreateOpts) ToStructuringCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(ops, "")
+}
+
+// Create a structuring rule of the log stream.
+func Create(client *golangsdk.ServiceClient, ops CreateOptsBuilder) (r CreateResult) {
+	b, err := ops.ToStructuringCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Post(rootURL(client), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	return
+}
+
+// Update a structuring rule of the log stream.
+func Update(client *golangsdk.ServiceClient, ops CreateOptsBuilder) (r UpdateResult) {
+	b, err := ops.ToStructuringCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Put(rootURL(client), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	return
+}
+
+// GetOpts identifies the log stream of the structuring rule.
+type GetOpts struct {
+	LogGroupID  string `q:"log_group_id,required"`
+	LogStreamID string `q:"log_stream_id,required"`
+}
+
+// Get the structuring rule of the log stream.
+func Get(client *golangsdk.ServiceClient, opts GetOpts) (r GetResult) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Get(rootURL(client)+q.String(), &r.Body, nil)
+	return
+}
+
+// Delete the structuring rule by id.
+func Delete(client *golangsdk.ServiceClient, id string) (r DeleteResult) {
+	b := map[string]interface{}{
+		"id": id,
+	}
+	_, r.Err = client.DeleteWithBody(rootURL(client), b, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return
+}
@@ -0,0 +1,56 @@
+package structuring
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+// Field is a single field extracted from the log.
+type Field struct {
+	FieldName       string `json:"fieldName"`
+	Type            string `json:"type"`
+	UserDefinedName string `json:"user_defined_name"`
+	Content         string `json:"content"`
+	Description     string `json:"description"`
+	IsAnalysis      bool   `json:"is_analysis"`
+}
+
+// StructConfig is the structuring rule of the log stream.
+type StructConfig struct {
+	ID           string  `json:"id"`
+	LogGroupID   string  `json:"log_group_id"`
+	LogStreamID  string  `json:"log_stream_id"`
+	ProjectID    string  `json:"project_id"`
+	TemplateName string  `json:"template_name"`
+	StructType   string  `json:"struct_type"`
+	DemoLog      string  `json:"demo_log"`
+	RegexRules   string  `json:"regex_rules"`
+	Tokenizer    string  `json:"tokenizer"`
+	LogFormat    string  `json:"log_format"`
+	DemoFields   []Field `json:"demo_fields"`
+	TagFields    []Field `json:"tag_fields"`
+}
+
+// CreateResult is a struct that contains all the return parameters of creation
+type CreateResult struct {
+	golangsdk.ErrResult
+}
+
+// UpdateResult is a struct that contains all the return parameters of update
+type UpdateResult struct {
+	golangsdk.ErrResult
+}
+
+// DeleteResult is a struct which contains the result of deletion
+type DeleteResult struct {
+	golangsdk.ErrResult
+}
+
+// GetResult contains the body of getting detailed
+type GetResult struct {
+	golangsdk.Result
+}
+
+// Extract from GetResult
+func (r GetResult) Extract() (*StructConfig, error) {
+	s := new(StructConfig)
+	err := r.Result.ExtractInto(s)
+	return s, err
+}
@@ -0,0 +1,81 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const (
+	ruleID    = "5a0a1ef4-1ad9-4bff-a3e1-2c6a3b0f7e9d"
+	groupID   = "b33a0dc5-fd7a-4d4b-8d33-57e6a1d1c9a0"
+	streamID  = "2f1a9d4b-0e4c-4a1e-9a5b-3d1e0c7f6b8a"
+	projectID = "5045c215010c440d91b2f7dca1164a8f"
+)
+
+var expectedCreateRequest = fmt.Sprintf(`
+{
+  "log_group_id": "%s",
+  "log_stream_id": "%s",
+  "project_id": "%s",
+  "template_type": "custom",
+  "struct_type": "split",
+  "demo_log": "2021-09-01 level=error",
+  "tokenizer": " ",
+  "demo_fields": [
+    {
+      "fieldName": "field1",
+      "type": "string",
+      "user_defined_name": "date"
+    }
+  ]
+}`, groupID, streamID, projectID)
+
+var getResponse = fmt.Sprintf(`
+{
+  "id": "%s",
+  "log_group_id": "%s",
+  "log_stream_id": "%s",
+  "project_id": "%s",
+  "struct_type": "split",
+  "demo_log": "2021-09-01 level=error",
+  "tokenizer": " ",
+  "demo_fields": [
+    {
+      "fieldName": "field1",
+      "type": "string",
+      "user_defined_name": "date",
+      "content": "2021-09-01",
+      "is_analysis": true
+    }
+  ],
+  "tag_fields": []
+}`, ruleID, groupID, streamID, projectID)
+
+var expectedDeleteRequest = fmt.Sprintf(`{"id": "%s"}`, ruleID)
+
+// HandleStructuringSuccessfully creates an HTTP handler at `/lts/struct/template` on the test
+// handler mux that responds to POST, GET and DELETE requests.
+func HandleStructuringSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/lts/struct/template", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		switch r.Method {
+		case "POST", "PUT":
+			th.TestJSONRequest(t, r, expectedCreateRequest)
+			w.WriteHeader(http.StatusCreated)
+		case "GET":
+			th.TestFormValues(t, r, map[string]string{"log_group_id": groupID, "log_stream_id": streamID})
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprint(w, getResponse)
+		case "DELETE":
+			th.TestJSONRequest(t, r, expectedDeleteRequest)
+			w.WriteHeader(http.StatusOK)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+}
@@ -0,0 +1,66 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/lts/v2/structuring"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+var createOpts = structuring.CreateOpts{
+	LogGroupID:   groupID,
+	LogStreamID:  streamID,
+	ProjectID:    projectID,
+	TemplateType: "custom",
+	StructType:   "split",
+	DemoLog:      "2021-09-01 level=error",
+	Tokenizer:    " ",
+	DemoFields: []structuring.FieldOpts{
+		{
+			FieldName:       "field1",
+			Type:            "string",
+			UserDefinedName: "date",
+		},
+	},
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleStructuringSuccessfully(t)
+
+	th.AssertNoErr(t, structuring.Create(fake.ServiceClient(), createOpts).ExtractErr())
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleStructuringSuccessfully(t)
+
+	th.AssertNoErr(t, structuring.Update(fake.ServiceClient(), createOpts).ExtractErr())
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleStructuringSuccessfully(t)
+
+	rule, err := structuring.Get(fake.ServiceClient(), structuring.GetOpts{
+		LogGroupID:  groupID,
+		LogStreamID: streamID,
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, ruleID, rule.ID)
+	th.AssertEquals(t, "split", rule.StructType)
+	th.AssertEquals(t, 1, len(rule.DemoFields))
+	th.AssertEquals(t, true, rule.DemoFields[0].IsAnalysis)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleStructuringSuccessfully(t)
+
+	th.AssertNoErr(t, structuring.Delete(fake.ServiceClient(), ruleID).ExtractErr())
+}
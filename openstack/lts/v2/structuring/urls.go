@@ -0,0 +1,10 @@
+package structuring
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+const rootPath = "lts/struct/template"
+
+// rootURL will build the url of structuring rule operations
+func rootURL(client *golangsdk.ServiceClient) string {
+	return client.ServiceURL(rootPath)
+}
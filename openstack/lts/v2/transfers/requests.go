@@ -0,0 +1,157 @@
+// Package transfers manages the LTS log transfers.
+// These APIs are rooted at `v2`, the client has to be created with openstack.NewLTSV2Client.
+package transfers
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+// LogStream identifies a log stream which logs are transferred.
+type LogStream struct {
+	LogStreamID string `json:"log_stream_id" required:"true"`
+}
+
+// TransferDetail contains the destination specific transfer settings.
+type TransferDetail struct {
+	// Specifies the transfer period for OBS, 2, 5 or 30 for `min` and 1, 2, 3, 6, 8 or 12 for `hour`.
+	ObsPeriod int `json:"obs_period,omitempty"`
+	// Specifies the transfer period unit for OBS: `min` or `hour`.
+	ObsPeriodUnit string `json:"obs_period_unit,omitempty"`
+	// Specifies the OBS bucket name.
+	ObsBucketName string `json:"obs_bucket_name,omitempty"`
+	// Specifies the custom directory prefix of the transferred files.
+	ObsDirPrefixName string `json:"obs_dir_pre_fix_name,omitempty"`
+	// Specifies the file name prefix of the transferred files.
+	ObsPrefixName string `json:"obs_prefix_name,omitempty"`
+	// Specifies whether the bucket is encrypted.
+	ObsEncryptedEnable bool   `json:"obs_encrypted_enable,omitempty"`
+	ObsEncryptedID     string `json:"obs_encrypted_id,omitempty"`
+	// Specifies the time zone of the transfer directory, e.g. `UTC+01:00`.
+	ObsTimeZone   string `json:"obs_time_zone,omitempty"`
+	ObsTimeZoneID string `json:"obs_time_zone_id,omitempty"`
+	// Specifies the DMS Kafka instance ID.
+	KafkaID string `json:"kafka_id,omitempty"`
+	// Specifies the DMS Kafka topic.
+	KafkaTopic string `json:"kafka_topic,omitempty"`
+}
+
+// TransferInfo contains the transfer settings.
+type TransferInfo struct {
+	// Specifies the transfer destination: `OBS` or `DMS`.
+	LogTransferType string `json:"log_transfer_type" required:"true"`
+	// Specifies the transfer mode: `cycle` for OBS or `realTime` for DMS.
+	LogTransferMode string `json:"log_transfer_mode" required:"true"`
+	// Specifies the log format: `RAW` or `JSON`.
+	LogStorageFormat string `json:"log_storage_format" required:"true"`
+	// Specifies the transfer status: `ENABLE` or `DISABLE`.
+	LogTransferStatus string         `json:"log_transfer_status" required:"true"`
+	LogTransferDetail TransferDetail `json:"log_transfer_detail" required:"true"`
+}
+
+// CreateOptsBuilder is used for creating log transfer parameters.
+type CreateOptsBuilder interface {
+	ToTransferCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts is a struct that contains all the parameters.
+type CreateOpts struct {
+	// Specifies the log group ID.
+	LogGroupID string `json:"log_group_id" required:"true"`
+	// Specifies the log streams of the group to be transferred.
+	LogStreams []LogStream `json:"log_streams" required:"true"`
+	// Specifies the transfer settings.
+	LogTransferInfo TransferInfo `json:"log_transfer_info" required:"true"`
+}
+
+// ToTransferCreateMap is used for type convert
+func (ops CreateOpts) ToTransferCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(ops, "")
+}
+
+// Create a log transfer with given parameters.
+func Create(client *golangsdk.ServiceClient, ops CreateOptsBuilder) (r CreateResult) {
+	b, err := ops.ToTransferCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Post(rootURL(client), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	return
+}
+
+// ListOptsBuilder is used for log transfer filters.
+type ListOptsBuilder interface {
+	ToTransferListQuery() (string, error)
+}
+
+// ListOpts is a struct that contains all the filters.
+type ListOpts struct {
+	LogTransferType string `q:"log_transfer_type"`
+	LogGroupName    string `q:"log_group_name"`
+	LogStreamName   string `q:"log_stream_name"`
+}
+
+// ToTransferListQuery is used for type convert
+func (ops ListOpts) ToTransferListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(ops)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), err
+}
+
+// List log transfers of the project.
+func List(client *golangsdk.ServiceClient, ops ListOptsBuilder) (r ListResult) {
+	url := rootURL(client)
+	if ops != nil {
+		q, err := ops.ToTransferListQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += q
+	}
+	_, r.Err = client.Get(url, &r.Body, nil)
+	return
+}
+
+// UpdateOptsBuilder is used for updating log transfer parameters.
+type UpdateOptsBuilder interface {
+	ToTransferUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts is a struct that contains all the parameters.
+type UpdateOpts struct {
+	// Specifies the log transfer ID.
+	LogTransferID string `json:"log_transfer_id" required:"true"`
+	// Specifies the new transfer settings.
+	LogTransferInfo TransferInfo `json:"log_transfer_info" required:"true"`
+}
+
+// ToTransferUpdateMap is used for type convert
+func (ops UpdateOpts) ToTransferUpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(ops, "")
+}
+
+// Update a log transfer with given parameters.
+func Update(client *golangsdk.ServiceClient, ops UpdateOptsBuilder) (r UpdateResult) {
+	b, err := ops.ToTransferUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Put(rootURL(client), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete a log transfer by id
+func Delete(client *golangsdk.ServiceClient, id string) (r DeleteResult) {
+	_, r.Err = client.Delete(deleteURL(client, id), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return
+}
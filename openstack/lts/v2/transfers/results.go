@@ -0,0 +1,81 @@
+package transfers
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+// StreamInfo is a transferred log stream.
+type StreamInfo struct {
+	LogStreamID   string `json:"log_stream_id"`
+	LogStreamName string `json:"log_stream_name"`
+}
+
+// TransferDetailResp contains the destination specific transfer settings.
+type TransferDetailResp struct {
+	ObsPeriod          int    `json:"obs_period"`
+	ObsPeriodUnit      string `json:"obs_period_unit"`
+	ObsBucketName      string `json:"obs_bucket_name"`
+	ObsTransferPath    string `json:"obs_transfer_path"`
+	ObsDirPrefixName   string `json:"obs_dir_pre_fix_name"`
+	ObsPrefixName      string `json:"obs_prefix_name"`
+	ObsEncryptedEnable bool   `json:"obs_encrypted_enable"`
+	ObsEncryptedID     string `json:"obs_encrypted_id"`
+	ObsTimeZone        string `json:"obs_time_zone"`
+	ObsTimeZoneID      string `json:"obs_time_zone_id"`
+	KafkaID            string `json:"kafka_id"`
+	KafkaTopic         string `json:"kafka_topic"`
+}
+
+// TransferInfoResp contains the transfer settings.
+type TransferInfoResp struct {
+	LogTransferType   string             `json:"log_transfer_type"`
+	LogTransferMode   string             `json:"log_transfer_mode"`
+	LogStorageFormat  string             `json:"log_storage_format"`
+	LogTransferStatus string             `json:"log_transfer_status"`
+	LogTransferDetail TransferDetailResp `json:"log_transfer_detail"`
+}
+
+// Transfer is the log transfer response
+type Transfer struct {
+	LogTransferID   string           `json:"log_transfer_id"`
+	LogGroupID      string           `json:"log_group_id"`
+	LogGroupName    string           `json:"log_group_name"`
+	LogStreams      []StreamInfo     `json:"log_streams"`
+	LogTransferInfo TransferInfoResp `json:"log_transfer_info"`
+}
+
+type commonResult struct {
+	golangsdk.Result
+}
+
+// Extract from the result
+func (r commonResult) Extract() (*Transfer, error) {
+	s := new(Transfer)
+	err := r.Result.ExtractInto(s)
+	return s, err
+}
+
+// CreateResult is a struct that contains all the return parameters of creation
+type CreateResult struct {
+	commonResult
+}
+
+// UpdateResult is a struct that contains all the return parameters of update
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult is a struct which contains the result of deletion
+type DeleteResult struct {
+	golangsdk.ErrResult
+}
+
+// ListResult contains the body of listing
+type ListResult struct {
+	golangsdk.Result
+}
+
+// Extract from ListResult
+func (r ListResult) Extract() ([]Transfer, error) {
+	var s []Transfer
+	err := r.Result.ExtractIntoSlicePtr(&s, "log_transfers")
+	return s, err
+}
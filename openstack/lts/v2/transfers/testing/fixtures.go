@@ -0,0 +1,113 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const (
+	transferID = "ddced2a6-2b95-4bb4-a7f3-0b0d7a2b2a3c"
+	groupID    = "b33a0dc5-fd7a-4d4b-8d33-57e6a1d1c9a0"
+	streamID   = "2f1a9d4b-0e4c-4a1e-9a5b-3d1e0c7f6b8a"
+)
+
+var expectedCreateRequest = fmt.Sprintf(`
+{
+  "log_group_id": "%s",
+  "log_streams": [
+    {
+      "log_stream_id": "%s"
+    }
+  ],
+  "log_transfer_info": {
+    "log_transfer_type": "OBS",
+    "log_transfer_mode": "cycle",
+    "log_storage_format": "RAW",
+    "log_transfer_status": "ENABLE",
+    "log_transfer_detail": {
+      "obs_period": 3,
+      "obs_period_unit": "hour",
+      "obs_bucket_name": "lts-bucket"
+    }
+  }
+}`, groupID, streamID)
+
+var transferResponse = fmt.Sprintf(`
+{
+  "log_transfer_id": "%s",
+  "log_group_id": "%s",
+  "log_group_name": "lts-group",
+  "log_streams": [
+    {
+      "log_stream_id": "%s",
+      "log_stream_name": "lts-topic"
+    }
+  ],
+  "log_transfer_info": {
+    "log_transfer_type": "OBS",
+    "log_transfer_mode": "cycle",
+    "log_storage_format": "RAW",
+    "log_transfer_status": "%%s",
+    "log_transfer_detail": {
+      "obs_period": 3,
+      "obs_period_unit": "hour",
+      "obs_bucket_name": "lts-bucket",
+      "obs_transfer_path": "/lts-bucket/LogTanks/eu-de/"
+    }
+  }
+}`, transferID, groupID, streamID)
+
+var listResponse = fmt.Sprintf(`
+{
+  "log_transfers": [%s]
+}`, fmt.Sprintf(transferResponse, "ENABLE"))
+
+var expectedUpdateRequest = fmt.Sprintf(`
+{
+  "log_transfer_id": "%s",
+  "log_transfer_info": {
+    "log_transfer_type": "OBS",
+    "log_transfer_mode": "cycle",
+    "log_storage_format": "RAW",
+    "log_transfer_status": "DISABLE",
+    "log_transfer_detail": {
+      "obs_period": 3,
+      "obs_period_unit": "hour",
+      "obs_bucket_name": "lts-bucket"
+    }
+  }
+}`, transferID)
+
+// HandleTransfersSuccessfully creates an HTTP handler at `/transfers` on the test handler mux that
+// responds to POST, GET, PUT and DELETE requests.
+func HandleTransfersSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/transfers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		switch r.Method {
+		case "POST":
+			th.TestJSONRequest(t, r, expectedCreateRequest)
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprintf(w, transferResponse, "ENABLE")
+		case "GET":
+			th.TestFormValues(t, r, map[string]string{"log_group_name": "lts-group"})
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprint(w, listResponse)
+		case "PUT":
+			th.TestJSONRequest(t, r, expectedUpdateRequest)
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprintf(w, transferResponse, "DISABLE")
+		case "DELETE":
+			th.TestFormValues(t, r, map[string]string{"log_transfer_id": transferID})
+			w.WriteHeader(http.StatusOK)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+}
@@ -0,0 +1,71 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/lts/v2/transfers"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func transferInfo(status string) transfers.TransferInfo {
+	return transfers.TransferInfo{
+		LogTransferType:   "OBS",
+		LogTransferMode:   "cycle",
+		LogStorageFormat:  "RAW",
+		LogTransferStatus: status,
+		LogTransferDetail: transfers.TransferDetail{
+			ObsPeriod:     3,
+			ObsPeriodUnit: "hour",
+			ObsBucketName: "lts-bucket",
+		},
+	}
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleTransfersSuccessfully(t)
+
+	transfer, err := transfers.Create(fake.ServiceClient(), transfers.CreateOpts{
+		LogGroupID:      groupID,
+		LogStreams:      []transfers.LogStream{{LogStreamID: streamID}},
+		LogTransferInfo: transferInfo("ENABLE"),
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, transferID, transfer.LogTransferID)
+	th.AssertEquals(t, "lts-topic", transfer.LogStreams[0].LogStreamName)
+	th.AssertEquals(t, "/lts-bucket/LogTanks/eu-de/", transfer.LogTransferInfo.LogTransferDetail.ObsTransferPath)
+}
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleTransfersSuccessfully(t)
+
+	list, err := transfers.List(fake.ServiceClient(), transfers.ListOpts{LogGroupName: "lts-group"}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(list))
+	th.AssertEquals(t, transferID, list[0].LogTransferID)
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleTransfersSuccessfully(t)
+
+	transfer, err := transfers.Update(fake.ServiceClient(), transfers.UpdateOpts{
+		LogTransferID:   transferID,
+		LogTransferInfo: transferInfo("DISABLE"),
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "DISABLE", transfer.LogTransferInfo.LogTransferStatus)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleTransfersSuccessfully(t)
+
+	th.AssertNoErr(t, transfers.Delete(fake.ServiceClient(), transferID).ExtractErr())
+}
@@ -0,0 +1,20 @@
+package transfers
+
+import (
+	"net/url"
+
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+const rootPath = "transfers"
+
+// rootURL will build the url of create, list and update
+func rootURL(client *golangsdk.ServiceClient) string {
+	return client.ServiceURL(rootPath)
+}
+
+// deleteURL will build the url of delete
+func deleteURL(client *golangsdk.ServiceClient, id string) string {
+	q := url.Values{"log_transfer_id": []string{id}}
+	return rootURL(client) + "?" + q.Encode()
+}
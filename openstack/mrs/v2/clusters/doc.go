@@ -0,0 +1,65 @@
+/*
+Package clusters manages MRS clusters with the v2 API.
+
+Example to Create an Analysis Cluster
+
+	id, err := clusters.Create(mrsClient, clusters.CreateOpts{
+		ClusterVersion:       "MRS 3.1.0",
+		ClusterName:          "analysis",
+		ClusterType:          clusters.TypeAnalysis,
+		Region:               "eu-de",
+		VpcName:              "my-vpc",
+		SubnetName:           "my-subnet",
+		Components:           []string{"Hadoop", "Spark2x", "Hive"},
+		AvailabilityZone:     "eu-de-01",
+		SafeMode:             "SIMPLE",
+		ManagerAdminPassword: password,
+		LoginMode:            "KEYPAIR",
+		NodeKeypairName:      "my-keypair",
+		NodeGroups: []clusters.NodeGroupOpts{
+			{
+				GroupName:  clusters.NodeGroupMaster,
+				NodeNum:    2,
+				NodeSize:   "c4.2xlarge.4.linux.mrs",
+				RootVolume: &clusters.Volume{Type: "SAS", Size: 480},
+			},
+			{
+				GroupName:  clusters.NodeGroupCore,
+				NodeNum:    3,
+				NodeSize:   "c4.2xlarge.4.linux.mrs",
+				RootVolume: &clusters.Volume{Type: "SAS", Size: 480},
+			},
+		},
+	}).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	err = clusters.WaitForStatus(mrsClient, id, clusters.StatusRunning, 3600)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Scale Out the Core Nodes
+
+	err := clusters.ScaleOut(mrsClient, id, clusters.ScaleOutOpts{
+		NodeGroupName: clusters.NodeGroupCore,
+		NodeCount:     2,
+	}).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Terminate a Cluster
+
+	err := clusters.Delete(mrsClient, id).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+	err = clusters.WaitForDeleted(mrsClient, id, 1800)
+	if err != nil {
+		panic(err)
+	}
+*/
+package clusters
@@ -0,0 +1,242 @@
+package clusters
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/tags"
+)
+
+// Cluster types.
+const (
+	TypeAnalysis  = "ANALYSIS"
+	TypeStreaming = "STREAMING"
+	TypeMixed     = "MIXED"
+	TypeCustom    = "CUSTOM"
+)
+
+// Node group names of the non-custom clusters.
+const (
+	NodeGroupMaster        = "master_node_default_group"
+	NodeGroupCore          = "core_node_analysis_group"
+	NodeGroupStreamingCore = "core_node_streaming_group"
+	NodeGroupTask          = "task_node_analysis_group"
+	NodeGroupStreamingTask = "task_node_streaming_group"
+)
+
+// Volume is a node disk.
+type Volume struct {
+	// Type is "SATA", "SAS" or "SSD".
+	Type string `json:"type" required:"true"`
+	// Size in GB.
+	Size int `json:"size" required:"true"`
+}
+
+// NodeGroupOpts describes a group of cluster nodes.
+type NodeGroupOpts struct {
+	// GroupName is one of the NodeGroup* constants, or any name for custom clusters.
+	GroupName string `json:"group_name" required:"true"`
+	NodeNum   int    `json:"node_num" required:"true"`
+	// NodeSize is the ECS flavor of the nodes.
+	NodeSize        string  `json:"node_size" required:"true"`
+	RootVolume      *Volume `json:"root_volume" required:"true"`
+	DataVolume      *Volume `json:"data_volume,omitempty"`
+	DataVolumeCount *int    `json:"data_volume_count,omitempty"`
+	// AssignedRoles are the component roles deployed on the nodes, custom clusters only.
+	AssignedRoles []string `json:"assigned_roles,omitempty"`
+}
+
+// BootstrapScriptOpts is a script executed on the cluster nodes.
+type BootstrapScriptOpts struct {
+	Name string `json:"name" required:"true"`
+	// URI is the OBS or local path of the script.
+	URI        string `json:"uri" required:"true"`
+	Parameters string `json:"parameters,omitempty"`
+	// Nodes are the names of the node groups the script is executed on.
+	Nodes        []string `json:"nodes" required:"true"`
+	ActiveMaster *bool    `json:"active_master,omitempty"`
+	// FailAction is either "continue" or "errorout".
+	FailAction string `json:"fail_action" required:"true"`
+	// BeforeComponentStart executes the script before the components are started.
+	BeforeComponentStart *bool `json:"before_component_start,omitempty"`
+	// ActionStages are e.g. "BEFORE_COMPONENT_FIRST_START", "AFTER_SCALE_OUT".
+	ActionStages []string `json:"action_stages,omitempty"`
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the Create request.
+type CreateOptsBuilder interface {
+	ToClusterCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts contains the options to create a cluster.
+type CreateOpts struct {
+	// ClusterVersion is e.g. "MRS 3.1.0".
+	ClusterVersion string `json:"cluster_version" required:"true"`
+	ClusterName    string `json:"cluster_name" required:"true"`
+	// ClusterType is one of the Type* constants.
+	ClusterType string `json:"cluster_type" required:"true"`
+	Region      string `json:"region" required:"true"`
+	VpcName     string `json:"vpc_name" required:"true"`
+	SubnetID    string `json:"subnet_id,omitempty"`
+	SubnetName  string `json:"subnet_name" required:"true"`
+	// Components are the components to install, e.g. "Hadoop", "Spark2x", "Hive", "Flink".
+	Components       []string `json:"-" required:"true"`
+	AvailabilityZone string   `json:"availability_zone" required:"true"`
+	SecurityGroupsID string   `json:"security_groups_id,omitempty"`
+	// SafeMode is either "KERBEROS" or "SIMPLE".
+	SafeMode             string `json:"safe_mode" required:"true"`
+	ManagerAdminPassword string `json:"manager_admin_password" required:"true"`
+	// LoginMode is either "PASSWORD" or "KEYPAIR".
+	LoginMode           string                `json:"login_mode" required:"true"`
+	NodeRootPassword    string                `json:"node_root_password,omitempty"`
+	NodeKeypairName     string                `json:"node_keypair_name,omitempty"`
+	EipAddress          string                `json:"eip_address,omitempty"`
+	EipID               string                `json:"eip_id,omitempty"`
+	MrsEcsDefaultAgency string                `json:"mrs_ecs_default_agency,omitempty"`
+	LogCollection       *int                  `json:"log_collection,omitempty"`
+	NodeGroups          []NodeGroupOpts       `json:"node_groups" required:"true"`
+	BootstrapScripts    []BootstrapScriptOpts `json:"bootstrap_scripts,omitempty"`
+	Tags                []tags.ResourceTag    `json:"tags,omitempty"`
+	EnterpriseProjectID string                `json:"enterprise_project_id,omitempty"`
+}
+
+// ToClusterCreateMap builds a create request body from CreateOpts.
+func (opts CreateOpts) ToClusterCreateMap() (map[string]interface{}, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+	b["components"] = strings.Join(opts.Components, ",")
+	b["charge_info"] = map[string]interface{}{"charge_mode": "postPaid"}
+	return b, nil
+}
+
+// Create creates a cluster. Creation is asynchronous, use WaitForStatus
+// to wait until the cluster is running.
+func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToClusterCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(rootURL(client), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Get retrieves the details of a cluster.
+func Get(client *golangsdk.ServiceClient, id string) (r GetResult) {
+	_, r.Err = client.Get(getURL(client, id), &r.Body, &golangsdk.RequestOpts{
+		OkCodes:     []int{200},
+		MoreHeaders: openstack.StdRequestOpts().MoreHeaders,
+	})
+	return
+}
+
+// Delete terminates a cluster, use WaitForDeleted to wait until the termination is finished.
+func Delete(client *golangsdk.ServiceClient, id string) (r DeleteResult) {
+	_, r.Err = client.Delete(deleteURL(client, id), &golangsdk.RequestOpts{
+		OkCodes:     []int{204},
+		MoreHeaders: openstack.StdRequestOpts().MoreHeaders,
+	})
+	return
+}
+
+// ScaleOutOptsBuilder allows extensions to add additional parameters to the ScaleOut request.
+type ScaleOutOptsBuilder interface {
+	ToScaleOutMap() (map[string]interface{}, error)
+}
+
+// ScaleOutOpts contains the options to add nodes to a node group.
+type ScaleOutOpts struct {
+	NodeGroupName string `json:"node_group_name" required:"true"`
+	// NodeCount is the number of nodes to add.
+	NodeCount            int   `json:"node_count" required:"true"`
+	SkipBootstrapScripts *bool `json:"skip_bootstrap_scripts,omitempty"`
+	// ScaleWithoutStart doesn't start the components on the new nodes.
+	ScaleWithoutStart *bool `json:"scale_without_start,omitempty"`
+}
+
+// ToScaleOutMap builds a request body from ScaleOutOpts.
+func (opts ScaleOutOpts) ToScaleOutMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// ScaleOut adds nodes to a node group of the cluster.
+func ScaleOut(client *golangsdk.ServiceClient, id string, opts ScaleOutOptsBuilder) (r ScaleResult) {
+	b, err := opts.ToScaleOutMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(expandURL(client, id), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// ScaleInOptsBuilder allows extensions to add additional parameters to the ScaleIn request.
+type ScaleInOptsBuilder interface {
+	ToScaleInMap() (map[string]interface{}, error)
+}
+
+// ScaleInOpts contains the options to remove nodes from a node group.
+type ScaleInOpts struct {
+	NodeGroupName string `json:"node_group_name" required:"true"`
+	// NodeCount is the number of nodes to remove, ignored if ResourceIDs are set.
+	NodeCount int `json:"node_count,omitempty"`
+	// ResourceIDs are the IDs of the nodes to remove.
+	ResourceIDs []string `json:"resource_ids,omitempty"`
+}
+
+// ToScaleInMap builds a request body from ScaleInOpts.
+func (opts ScaleInOpts) ToScaleInMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// ScaleIn removes nodes from a node group of the cluster.
+func ScaleIn(client *golangsdk.ServiceClient, id string, opts ScaleInOptsBuilder) (r ScaleResult) {
+	b, err := opts.ToScaleInMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(shrinkURL(client, id), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// WaitForStatus waits for the cluster to reach the given status, e.g. StatusRunning.
+func WaitForStatus(client *golangsdk.ServiceClient, id, status string, secs int) error {
+	return golangsdk.WaitFor(secs, func() (bool, error) {
+		cluster, err := Get(client, id).Extract()
+		if err != nil {
+			return false, err
+		}
+		if cluster.ClusterState == status {
+			return true, nil
+		}
+		if cluster.ClusterState == StatusFailed || cluster.ClusterState == StatusAbnormal {
+			return false, fmt.Errorf("cluster %s is %s: %s", id, cluster.ClusterState, cluster.ErrorInfo)
+		}
+		return false, nil
+	})
+}
+
+// WaitForDeleted waits for the cluster to be terminated.
+func WaitForDeleted(client *golangsdk.ServiceClient, id string, secs int) error {
+	return golangsdk.WaitFor(secs, func() (bool, error) {
+		cluster, err := Get(client, id).Extract()
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return true, nil
+			}
+			return false, err
+		}
+		return cluster.ClusterState == StatusTerminated, nil
+	})
+}
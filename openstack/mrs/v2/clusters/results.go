@@ -0,0 +1,108 @@
+package clusters
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// Cluster statuses.
+const (
+	StatusStarting    = "starting"
+	StatusRunning     = "running"
+	StatusScalingOut  = "scaling-out"
+	StatusScalingIn   = "scaling-in"
+	StatusAbnormal    = "abnormal"
+	StatusTerminating = "terminating"
+	StatusTerminated  = "terminated"
+	StatusFailed      = "failed"
+)
+
+// Component is a component installed in the cluster.
+type Component struct {
+	ComponentID      string `json:"componentId"`
+	ComponentName    string `json:"componentName"`
+	ComponentVersion string `json:"componentVersion"`
+	ComponentDesc    string `json:"componentDesc"`
+}
+
+// NodeGroup is a group of cluster nodes.
+type NodeGroup struct {
+	GroupName       string `json:"groupName"`
+	NodeNum         int    `json:"nodeNum"`
+	NodeSize        string `json:"nodeSize"`
+	NodeSpecID      string `json:"nodeSpecId"`
+	RootVolumeSize  int    `json:"rootVolumeSize"`
+	RootVolumeType  string `json:"rootVolumeType"`
+	DataVolumeType  string `json:"dataVolumeType"`
+	DataVolumeSize  int    `json:"dataVolumeSize"`
+	DataVolumeCount int    `json:"dataVolumeCount"`
+}
+
+// Cluster represents an MRS cluster.
+type Cluster struct {
+	ClusterID        string      `json:"clusterId"`
+	ClusterName      string      `json:"clusterName"`
+	ClusterState     string      `json:"clusterState"`
+	ClusterVersion   string      `json:"clusterVersion"`
+	ClusterType      int         `json:"clusterType"`
+	TotalNodeNum     string      `json:"totalNodeNum"`
+	MasterNodeNum    string      `json:"masterNodeNum"`
+	CoreNodeNum      string      `json:"coreNodeNum"`
+	CreateAt         string      `json:"createAt"`
+	UpdateAt         string      `json:"updateAt"`
+	Vpc              string      `json:"vpc"`
+	VpcID            string      `json:"vpcId"`
+	SubnetID         string      `json:"subnetId"`
+	SubnetName       string      `json:"subnetName"`
+	AzName           string      `json:"azName"`
+	SafeMode         int         `json:"safeMode"`
+	ExternalIp       string      `json:"externalIp"`
+	InternalIp       string      `json:"internalIp"`
+	MasterNodeIp     string      `json:"masterNodeIp"`
+	SecurityGroupsID string      `json:"securityGroupsId"`
+	StageDesc        string      `json:"stageDesc"`
+	ErrorInfo        string      `json:"errorInfo"`
+	Scale            string      `json:"scale"`
+	ComponentList    []Component `json:"componentList"`
+	NodeGroups       []NodeGroup `json:"nodeGroups"`
+}
+
+// CreateResult is the result of a Create request.
+type CreateResult struct {
+	golangsdk.Result
+}
+
+// Extract returns the ID of the created cluster.
+func (r CreateResult) Extract() (string, error) {
+	var s struct {
+		ClusterID string `json:"cluster_id"`
+	}
+	err := r.ExtractInto(&s)
+	return s.ClusterID, err
+}
+
+// GetResult is the result of a Get request.
+type GetResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as a Cluster.
+func (r GetResult) Extract() (*Cluster, error) {
+	s := new(Cluster)
+	err := r.ExtractIntoStructPtr(s, "cluster")
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// DeleteResult is the result of a Delete request.
+// Call its ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	golangsdk.ErrResult
+}
+
+// ScaleResult is the result of ScaleOut and ScaleIn requests.
+// Call its ExtractErr method to determine if the request succeeded or failed.
+type ScaleResult struct {
+	golangsdk.ErrResult
+}
@@ -0,0 +1,32 @@
+package clusters
+
+import (
+	"strings"
+
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+func rootURL(client *golangsdk.ServiceClient) string {
+	return client.ServiceURL("clusters")
+}
+
+func expandURL(client *golangsdk.ServiceClient, id string) string {
+	return client.ServiceURL("clusters", id, "expand")
+}
+
+func shrinkURL(client *golangsdk.ServiceClient, id string) string {
+	return client.ServiceURL("clusters", id, "shrink")
+}
+
+// Cluster details and termination are only available in the v1.1 API.
+func v11URL(client *golangsdk.ServiceClient, parts ...string) string {
+	return strings.Replace(client.ServiceURL(parts...), "/v2/", "/v1.1/", 1)
+}
+
+func getURL(client *golangsdk.ServiceClient, id string) string {
+	return v11URL(client, "cluster_infos", id)
+}
+
+func deleteURL(client *golangsdk.ServiceClient, id string) string {
+	return v11URL(client, "clusters", id)
+}
@@ -0,0 +1,46 @@
+/*
+Package jobs submits and manages jobs of MRS clusters with the v2 API.
+
+Example to Submit a Spark Job
+
+	job, err := jobs.Submit(mrsClient, clusterID, jobs.SubmitOpts{
+		JobType: jobs.TypeSparkSubmit,
+		JobName: "spark-pi",
+		Arguments: []string{
+			"--class", "org.apache.spark.examples.SparkPi",
+			"obs://my-bucket/spark-examples.jar", "10",
+		},
+	}).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	err = jobs.WaitForJob(mrsClient, clusterID, job.JobID, 1800)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Submit a Hive SQL Job
+
+	job, err := jobs.Submit(mrsClient, clusterID, jobs.SubmitOpts{
+		JobType:   jobs.TypeHiveSql,
+		JobName:   "hive-select",
+		Arguments: []string{"SELECT 1"},
+	}).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Kill and Delete a Job
+
+	err := jobs.Kill(mrsClient, clusterID, jobID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+	err = jobs.Delete(mrsClient, clusterID, []string{jobID}).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package jobs
@@ -0,0 +1,144 @@
+package jobs
+
+import (
+	"fmt"
+
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// Job types.
+const (
+	TypeMapReduce   = "MapReduce"
+	TypeSparkSubmit = "SparkSubmit"
+	TypeHiveScript  = "HiveScript"
+	TypeHiveSql     = "HiveSql"
+	TypeSparkSql    = "SparkSql"
+	TypeSparkScript = "SparkScript"
+	TypeFlink       = "Flink"
+	TypeDistCp      = "DistCp"
+)
+
+// SubmitOptsBuilder allows extensions to add additional parameters to the Submit request.
+type SubmitOptsBuilder interface {
+	ToJobSubmitMap() (map[string]interface{}, error)
+}
+
+// SubmitOpts contains the options to submit a job to a cluster.
+type SubmitOpts struct {
+	// JobType is one of the Type* constants.
+	JobType string `json:"job_type" required:"true"`
+	JobName string `json:"job_name" required:"true"`
+	// Arguments are the program parameters, e.g.
+	// []string{"--class", "org.apache.spark.examples.SparkPi", "obs://bucket/spark-examples.jar", "10"}.
+	Arguments []string `json:"arguments,omitempty"`
+	// Properties are the program system parameters, e.g. {"fs.obs.endpoint": "obs.eu-de.otc.t-systems.com"}.
+	Properties map[string]string `json:"properties,omitempty"`
+}
+
+// ToJobSubmitMap builds a request body from SubmitOpts.
+func (opts SubmitOpts) ToJobSubmitMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Submit submits a job to the cluster, use WaitForJob to wait until it's finished.
+func Submit(client *golangsdk.ServiceClient, clusterID string, opts SubmitOptsBuilder) (r SubmitResult) {
+	b, err := opts.ToJobSubmitMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(rootURL(client, clusterID), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Get retrieves the details of a job.
+func Get(client *golangsdk.ServiceClient, clusterID, jobID string) (r GetResult) {
+	_, r.Err = client.Get(resourceURL(client, clusterID, jobID), &r.Body, nil)
+	return
+}
+
+// ListOptsBuilder allows extensions to add additional parameters to the List request.
+type ListOptsBuilder interface {
+	ToJobListQuery() (string, error)
+}
+
+// ListOpts allows to filter the jobs of a cluster.
+type ListOpts struct {
+	JobName  string `q:"job_name"`
+	JobID    string `q:"job_id"`
+	User     string `q:"user"`
+	JobType  string `q:"job_type"`
+	JobState string `q:"job_state"`
+	// JobResult is either "SUCCEEDED", "FAILED", "KILLED" or "UNDEFINED".
+	JobResult string `q:"job_result"`
+	Limit     int    `q:"limit"`
+	Offset    int    `q:"offset"`
+	// SortBy is either "asc" or "desc".
+	SortBy string `q:"sort_by"`
+	// SubmittedTimeBegin and SubmittedTimeEnd are UTC timestamps in milliseconds.
+	SubmittedTimeBegin int `q:"submitted_time_begin"`
+	SubmittedTimeEnd   int `q:"submitted_time_end"`
+}
+
+// ToJobListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToJobListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), err
+}
+
+// List returns the jobs of a cluster.
+func List(client *golangsdk.ServiceClient, clusterID string, opts ListOptsBuilder) (r ListResult) {
+	url := rootURL(client, clusterID)
+	if opts != nil {
+		query, err := opts.ToJobListQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += query
+	}
+	_, r.Err = client.Get(url, &r.Body, nil)
+	return
+}
+
+// Kill terminates a running job.
+func Kill(client *golangsdk.ServiceClient, clusterID, jobID string) (r KillResult) {
+	_, r.Err = client.Post(killURL(client, clusterID, jobID), map[string]interface{}{}, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete deletes the given jobs from the cluster.
+func Delete(client *golangsdk.ServiceClient, clusterID string, jobIDs []string) (r DeleteResult) {
+	b := map[string]interface{}{"job_id_list": jobIDs}
+	_, r.Err = client.Post(batchDeleteURL(client, clusterID), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// WaitForJob waits for the job to finish and returns an error if it hasn't succeeded.
+func WaitForJob(client *golangsdk.ServiceClient, clusterID, jobID string, secs int) error {
+	return golangsdk.WaitFor(secs, func() (bool, error) {
+		job, err := Get(client, clusterID, jobID).Extract()
+		if err != nil {
+			return false, err
+		}
+		switch job.JobState {
+		case StateFinished:
+			if job.JobResult == ResultFailed || job.JobResult == ResultKilled {
+				return false, fmt.Errorf("job %s finished with %s: %s", jobID, job.JobResult, job.DiagnosticMessage)
+			}
+			return true, nil
+		case StateFailed, StateKilled:
+			return false, fmt.Errorf("job %s is %s: %s", jobID, job.JobState, job.DiagnosticMessage)
+		}
+		return false, nil
+	})
+}
@@ -0,0 +1,107 @@
+package jobs
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// Job states.
+const (
+	StateNew       = "NEW"
+	StateNewSaving = "NEW_SAVING"
+	StateSubmitted = "SUBMITTED"
+	StateAccepted  = "ACCEPTED"
+	StateRunning   = "RUNNING"
+	StateFinished  = "FINISHED"
+	StateFailed    = "FAILED"
+	StateKilled    = "KILLED"
+)
+
+// Final job results.
+const (
+	ResultSucceeded = "SUCCEEDED"
+	ResultFailed    = "FAILED"
+	ResultKilled    = "KILLED"
+	ResultUndefined = "UNDEFINED"
+)
+
+// SubmitResponse is the response of a job submission.
+type SubmitResponse struct {
+	JobID string `json:"job_id"`
+	State string `json:"state"`
+}
+
+// Job represents an MRS job execution.
+type Job struct {
+	JobID             string  `json:"job_id"`
+	User              string  `json:"user"`
+	JobName           string  `json:"job_name"`
+	JobResult         string  `json:"job_result"`
+	JobState          string  `json:"job_state"`
+	JobProgress       float64 `json:"job_progress"`
+	JobType           string  `json:"job_type"`
+	StartedTime       int64   `json:"started_time"`
+	SubmittedTime     int64   `json:"submitted_time"`
+	FinishedTime      int64   `json:"finished_time"`
+	ElapsedTime       int64   `json:"elapsed_time"`
+	Arguments         string  `json:"arguments"`
+	Properties        string  `json:"properties"`
+	LauncherID        string  `json:"launcher_id"`
+	AppID             string  `json:"app_id"`
+	TrackingURL       string  `json:"tracking_url"`
+	Queue             string  `json:"queue"`
+	DiagnosticMessage string  `json:"diagnostic_message"`
+}
+
+// SubmitResult is the result of a Submit request.
+type SubmitResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as a SubmitResponse.
+func (r SubmitResult) Extract() (*SubmitResponse, error) {
+	s := new(SubmitResponse)
+	err := r.ExtractIntoStructPtr(s, "job_submit_result")
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// GetResult is the result of a Get request.
+type GetResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as a Job.
+func (r GetResult) Extract() (*Job, error) {
+	s := new(Job)
+	err := r.ExtractIntoStructPtr(s, "job_detail")
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// ListResult is the result of a List request.
+type ListResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as a slice of Job.
+func (r ListResult) Extract() ([]Job, error) {
+	var s []Job
+	err := r.ExtractIntoSlicePtr(&s, "job_list")
+	return s, err
+}
+
+// KillResult is the result of a Kill request.
+// Call its ExtractErr method to determine if the request succeeded or failed.
+type KillResult struct {
+	golangsdk.ErrResult
+}
+
+// DeleteResult is the result of a Delete request.
+// Call its ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	golangsdk.ErrResult
+}
@@ -0,0 +1,19 @@
+package jobs
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+func rootURL(client *golangsdk.ServiceClient, clusterID string) string {
+	return client.ServiceURL("clusters", clusterID, "job-executions")
+}
+
+func resourceURL(client *golangsdk.ServiceClient, clusterID, jobID string) string {
+	return client.ServiceURL("clusters", clusterID, "job-executions", jobID)
+}
+
+func killURL(client *golangsdk.ServiceClient, clusterID, jobID string) string {
+	return client.ServiceURL("clusters", clusterID, "job-executions", jobID, "kill")
+}
+
+func batchDeleteURL(client *golangsdk.ServiceClient, clusterID string) string {
+	return client.ServiceURL("clusters", clusterID, "job-executions", "batch-delete")
+}
@@ -0,0 +1,42 @@
+package eips
+
+import (
+	"time"
+
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// StateRefreshFunc returns a golangsdk.StateRefreshFunc reporting the status of the EIP,
+// or "DELETED" once it doesn't exist anymore.
+func StateRefreshFunc(c *golangsdk.ServiceClient, id string) golangsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		eip, err := Get(c, id).Extract()
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return eip, "DELETED", nil
+			}
+			return nil, "", err
+		}
+		return eip, eip.Status, nil
+	}
+}
+
+// WaitForStatus will continually poll an EIP until it successfully
+// transitions to a specified status. It will do this for at most the number
+// of seconds specified.
+func WaitForStatus(c *golangsdk.ServiceClient, id, status string, secs int) error {
+	conf := golangsdk.StateChangeConf{
+		Target:  []string{status},
+		Refresh: StateRefreshFunc(c, id),
+		Timeout: time.Duration(secs) * time.Second,
+		Delay:   time.Second,
+	}
+	_, err := conf.WaitForState()
+	return err
+}
+
+// WaitForDeleted will continually poll an EIP until it is deleted.
+// It will do this for at most the number of seconds specified.
+func WaitForDeleted(c *golangsdk.ServiceClient, id string, secs int) error {
+	return WaitForStatus(c, id, "DELETED", secs)
+}
@@ -0,0 +1,36 @@
+package quotas
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+// Quota types.
+const (
+	TypeVPC               = "vpc"
+	TypeSubnet            = "subnet"
+	TypeSecurityGroup     = "securityGroup"
+	TypeSecurityGroupRule = "securityGroupRule"
+	TypePublicIP          = "publicIp"
+	TypeVPN               = "vpn"
+	TypeVPCPeer           = "vpcPeer"
+	TypeFirewall          = "firewall"
+	TypeShareBandwidth    = "shareBandwidth"
+	TypeShareBandwidthIP  = "shareBandwidthIP"
+	TypeLoadBalancer      = "loadbalancer"
+	TypeListener          = "listener"
+)
+
+// GetOpts allows to filter the quota types.
+type GetOpts struct {
+	// Type is one of the Type* constants.
+	Type string `q:"type"`
+}
+
+// Get retrieves VPC quotas of the project together with their usage.
+func Get(client *golangsdk.ServiceClient, opts GetOpts) (r GetResult) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Get(getURL(client)+q.String(), &r.Body, nil)
+	return
+}
@@ -0,0 +1,32 @@
+package quotas
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// Resource is a single VPC resource quota.
+type Resource struct {
+	// Type is one of the Type* constants.
+	Type string `json:"type"`
+	// Used is the number of used resources.
+	Used int `json:"used"`
+	// Quota is the maximum number of resources, -1 means unlimited.
+	Quota int `json:"quota"`
+	// Min is the minimum quota value.
+	Min int `json:"min"`
+}
+
+type GetResult struct {
+	golangsdk.Result
+}
+
+// Extract is a function that accepts a result and extracts VPC resource quotas.
+func (r GetResult) Extract() ([]Resource, error) {
+	var s struct {
+		Quotas struct {
+			Resources []Resource `json:"resources"`
+		} `json:"quotas"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Quotas.Resources, err
+}
@@ -0,0 +1,43 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v1/common"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+const getResponse = `
+{
+  "quotas": {
+    "resources": [
+      {
+        "type": "vpc",
+        "used": 4,
+        "quota": 150,
+        "min": 0
+      },
+      {
+        "type": "publicIp",
+        "used": 2,
+        "quota": 50,
+        "min": 0
+      }
+    ]
+  }
+}`
+
+// HandleGetSuccessfully creates an HTTP handler at `/v1/{project_id}/quotas` on
+// the test handler mux that responds to a GET request with getResponse.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/v1/85636478b0bd8e67e89469c7749d4127/quotas", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, getResponse)
+	})
+}
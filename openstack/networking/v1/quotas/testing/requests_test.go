@@ -0,0 +1,22 @@
+package testing
+
+import (
+	"testing"
+
+	fake "github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v1/common"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v1/quotas"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	resources, err := quotas.Get(fake.ServiceClient(), quotas.GetOpts{}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, len(resources))
+	th.AssertEquals(t, quotas.TypePublicIP, resources[1].Type)
+	th.AssertEquals(t, 2, resources[1].Used)
+	th.AssertEquals(t, 50, resources[1].Quota)
+}
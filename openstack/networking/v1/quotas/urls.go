@@ -0,0 +1,7 @@
+package quotas
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+func getURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL(c.ProjectID, "quotas")
+}
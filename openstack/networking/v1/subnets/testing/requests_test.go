@@ -296,3 +296,17 @@ func TestDeleteSubnet(t *testing.T) {
 	res := subnets.Delete(fake.ServiceClient(), "8f794f06-2275-4d82-9f5a-6d68fbe21a75", "83e3bddc-b9ed-4614-a0dc-8a997095a86c")
 	th.AssertNoErr(t, res.Err)
 }
+
+func TestWaitForDeletedSubnet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v1/85636478b0bd8e67e89469c7749d4127/subnets/83e3bddc-b9ed-4614-a0dc-8a997095a86c", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	err := subnets.WaitForDeleted(fake.ServiceClient(), "83e3bddc-b9ed-4614-a0dc-8a997095a86c", 5)
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,53 @@
+package subnets
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// StateRefreshFunc returns a golangsdk.StateRefreshFunc reporting the status of the subnet,
+// or "DELETED" once it doesn't exist anymore.
+func StateRefreshFunc(c *golangsdk.ServiceClient, id string) golangsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		subnet, err := Get(c, id).Extract()
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return subnet, "DELETED", nil
+			}
+			return nil, "", err
+		}
+		if subnet.Status == "ERROR" {
+			return subnet, subnet.Status, fmt.Errorf("subnet status: '%s'", subnet.Status)
+		}
+		return subnet, subnet.Status, nil
+	}
+}
+
+// WaitForActive will continually poll a subnet until it becomes active.
+// It will do this for at most the number of seconds specified.
+func WaitForActive(c *golangsdk.ServiceClient, id string, secs int) error {
+	conf := golangsdk.StateChangeConf{
+		Pending: []string{"UNKNOWN"},
+		Target:  []string{"ACTIVE"},
+		Refresh: StateRefreshFunc(c, id),
+		Timeout: time.Duration(secs) * time.Second,
+		Delay:   time.Second,
+	}
+	_, err := conf.WaitForState()
+	return err
+}
+
+// WaitForDeleted will continually poll a subnet until it is deleted.
+// It will do this for at most the number of seconds specified.
+func WaitForDeleted(c *golangsdk.ServiceClient, id string, secs int) error {
+	conf := golangsdk.StateChangeConf{
+		Target:  []string{"DELETED"},
+		Refresh: StateRefreshFunc(c, id),
+		Timeout: time.Duration(secs) * time.Second,
+		Delay:   time.Second,
+	}
+	_, err := conf.WaitForState()
+	return err
+}
@@ -110,6 +110,9 @@ type CreateOptsBuilder interface {
 type CreateOpts struct {
 	Name string `json:"name,omitempty"`
 	CIDR string `json:"cidr,omitempty"`
+
+	// EnterpriseProjectID is the ID of the enterprise project the vpc is created in.
+	EnterpriseProjectID string `json:"enterprise_project_id,omitempty"`
 }
 
 // ToVpcCreateMap builds a create request body from CreateOpts.
@@ -38,6 +38,9 @@ type Vpc struct {
 
 	// Provides informaion about shared snat
 	EnableSharedSnat bool `json:"enable_shared_snat"`
+
+	// EnterpriseProjectID is the ID of the enterprise project the vpc belongs to.
+	EnterpriseProjectID string `json:"enterprise_project_id"`
 }
 
 // VpcPage is the page returned by a pager when traversing over a
@@ -20,6 +20,9 @@ type CreateOpts struct {
 	RouterID          string `json:"router_id" required:"true"`
 	InternalNetworkID string `json:"internal_network_id" required:"true"`
 	TenantID          string `json:"tenant_id,omitempty"`
+
+	// EnterpriseProjectID is the ID of the enterprise project the nat gateway is created in.
+	EnterpriseProjectID string `json:"enterprise_project_id,omitempty"`
 }
 
 type ListOpts struct {
@@ -16,6 +16,8 @@ type NatGateway struct {
 	Spec              string `json:"spec"`
 	Status            string `json:"status"`
 	AdminStateUp      bool   `json:"admin_state_up"`
+
+	EnterpriseProjectID string `json:"enterprise_project_id"`
 }
 
 // GetResult is a return struct of get method
@@ -201,7 +201,12 @@ var (
 		"location":                      true,
 		"date":                          true,
 		"etag":                          true,
+		"range":                         true,
 		"host":                          true,
+		"if-modified-since":             true,
+		"if-unmodified-since":           true,
+		"if-match":                      true,
+		"if-none-match":                 true,
 		"last-modified":                 true,
 		"content-range":                 true,
 		"x-reserved":                    true,
@@ -517,7 +517,7 @@ func ConvertCompleteMultipartUploadInputToXml(input CompleteMultipartUploadInput
 func parseSseHeader(responseHeaders map[string][]string) (sseHeader ISseHeader) {
 	if ret, ok := responseHeaders[HEADER_SSEC_ENCRYPTION]; ok {
 		sseCHeader := SseCHeader{Encryption: ret[0]}
-		if ret, ok = responseHeaders[HEADER_SSEC_KEY_MD5]; ok {
+		if ret, ok = responseHeaders[strings.ToLower(HEADER_SSEC_KEY_MD5)]; ok {
 			sseCHeader.KeyMD5 = ret[0]
 		}
 		sseHeader = sseCHeader
@@ -0,0 +1,74 @@
+/*
+Package obs provides access to the Object Storage Service (OBS): buckets, objects,
+multipart uploads and server-side encryption.
+
+Example to Create a Client
+
+	// the provider client has to be authenticated with AK/SK or temporary AK/SK
+	client, err := openstack.NewOBSClient(provider, golangsdk.EndpointOpts{
+		Region: "eu-de",
+	}, obs.WithSignature(obs.SignatureObs))
+	if err != nil {
+		panic(err)
+	}
+
+Example to Create a Bucket
+
+	_, err := client.CreateBucket(&obs.CreateBucketInput{
+		Bucket: "my-bucket",
+	})
+	if err != nil {
+		panic(err)
+	}
+
+Example to Put an Object encrypted with a KMS key
+
+	input := &obs.PutObjectInput{}
+	input.Bucket = "my-bucket"
+	input.Key = "my-object"
+	input.SseHeader = obs.SseKmsHeader{
+		Key: "kms-key-id",
+	}
+	input.Body = strings.NewReader("content")
+	_, err := client.PutObject(input)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Upload a File in parallel parts, resuming an interrupted upload
+
+	input := &obs.UploadFileInput{
+		UploadFile:       "/path/to/file",
+		PartSize:         obs.DEFAULT_PART_SIZE,
+		TaskNum:          4,
+		EnableCheckpoint: true,
+	}
+	input.Bucket = "my-bucket"
+	input.Key = "my-object"
+	_, err := client.UploadFile(input)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Generate a temporary download URL
+
+	signedURL, err := client.Presign(&obs.PresignInput{
+		Bucket:  "my-bucket",
+		Key:     "my-object",
+		Expires: 1 * time.Hour,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete an Object
+
+	_, err := client.DeleteObject(&obs.DeleteObjectInput{
+		Bucket: "my-bucket",
+		Key:    "my-object",
+	})
+	if err != nil {
+		panic(err)
+	}
+*/
+package obs
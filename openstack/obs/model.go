@@ -973,6 +973,19 @@ type CreateSignedUrlInput struct {
 	QueryParams map[string]string
 }
 
+// PresignInput is the input of Presign.
+//
+// Method is HttpMethodGet if not set, Expires is 5 minutes if not set. If ContentType is set,
+// it is signed into the URL and uploads have to send the same Content-Type header.
+type PresignInput struct {
+	Method      HttpMethodType
+	Bucket      string
+	Key         string
+	VersionId   string
+	ContentType string
+	Expires     time.Duration
+}
+
 type CreateSignedUrlOutput struct {
 	SignedUrl                  string
 	ActualSignedRequestHeaders http.Header
@@ -57,6 +57,49 @@ func (obsClient ObsClient) CreateSignedUrl(input *CreateSignedUrlInput) (output
 	return
 }
 
+// Presign generates a temporary signed URL to download (GET) or upload (PUT) an object,
+// which can be handed out to clients without AK/SK credentials.
+func (obsClient ObsClient) Presign(input *PresignInput) (string, error) {
+	if input == nil {
+		return "", errors.New("PresignInput is nil")
+	}
+	if input.Bucket == "" || input.Key == "" {
+		return "", errors.New("Bucket and Key are required")
+	}
+
+	method := input.Method
+	if method == "" {
+		method = HttpMethodGet
+	}
+	if method != HttpMethodGet && method != HttpMethodPut {
+		return "", fmt.Errorf("method %s can't be presigned, only GET and PUT are supported", method)
+	}
+
+	expires := 300
+	if input.Expires > 0 {
+		expires = int((input.Expires + time.Second - 1) / time.Second)
+	}
+
+	signedURLInput := &CreateSignedUrlInput{
+		Method:  method,
+		Bucket:  input.Bucket,
+		Key:     input.Key,
+		Expires: expires,
+	}
+	if input.VersionId != "" {
+		signedURLInput.QueryParams = map[string]string{PARAM_VERSION_ID: input.VersionId}
+	}
+	if input.ContentType != "" {
+		signedURLInput.Headers = map[string]string{HEADER_CONTENT_TYPE_CAML: input.ContentType}
+	}
+
+	output, err := obsClient.CreateSignedUrl(signedURLInput)
+	if err != nil {
+		return "", err
+	}
+	return output.SignedUrl, nil
+}
+
 func (obsClient ObsClient) CreateBrowserBasedSignature(input *CreateBrowserBasedSignatureInput) (output *CreateBrowserBasedSignatureOutput, err error) {
 	if input == nil {
 		return nil, errors.New("CreateBrowserBasedSignatureInput is nil")
@@ -0,0 +1,219 @@
+package testing
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/obs"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+const (
+	bucketName = "test-bucket"
+	objectKey  = "test-object"
+	uploadID   = "00000174A6E4C3C4B9B1E1D3B7E9E7A2"
+)
+
+var initiateMultipartUploadResponse = fmt.Sprintf(`<?xml version="1.0" encoding="UTF-8"?>
+<InitiateMultipartUploadResult xmlns="http://obs.otc.t-systems.com/doc/2015-06-30/">
+  <Bucket>%s</Bucket>
+  <Key>%s</Key>
+  <UploadId>%s</UploadId>
+</InitiateMultipartUploadResult>`, bucketName, objectKey, uploadID)
+
+var completeMultipartUploadResponse = fmt.Sprintf(`<?xml version="1.0" encoding="UTF-8"?>
+<CompleteMultipartUploadResult xmlns="http://obs.otc.t-systems.com/doc/2015-06-30/">
+  <Location>/%[1]s/%[2]s</Location>
+  <Bucket>%[1]s</Bucket>
+  <Key>%[2]s</Key>
+  <ETag>"03f814825e5a691489b947a2e120b2d3-3"</ETag>
+</CompleteMultipartUploadResult>`, bucketName, objectKey)
+
+// FakeMultipartServer records uploaded parts and fails every part number
+// listed in failures once.
+type FakeMultipartServer struct {
+	sync.Mutex
+	parts    map[string][]byte
+	failures map[string]bool
+	aborted  bool
+}
+
+func obsClient(t *testing.T) *obs.ObsClient {
+	client, err := obs.New("access", "secret", th.Endpoint(), obs.WithPathStyle(true), obs.WithMaxRetryCount(0))
+	th.AssertNoErr(t, err)
+	return client
+}
+
+// HandleMultipartUpload creates an HTTP handler at `/{bucket_name}/{object_key}` on the test handler mux
+// that serves the multipart upload requests, the returned server records the uploaded parts.
+func HandleMultipartUpload(t *testing.T, failures ...string) *FakeMultipartServer {
+	srv := &FakeMultipartServer{
+		parts:    make(map[string][]byte),
+		failures: make(map[string]bool),
+	}
+	for _, part := range failures {
+		srv.failures[part] = true
+	}
+
+	th.Mux.HandleFunc(fmt.Sprintf("/%s/%s", bucketName, objectKey), func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		switch {
+		case r.Method == http.MethodPost && query.Get("uploadId") == "":
+			w.Header().Add("Content-Type", "application/xml")
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprint(w, initiateMultipartUploadResponse)
+		case r.Method == http.MethodPut:
+			th.AssertEquals(t, uploadID, query.Get("uploadId"))
+			partNumber := query.Get("partNumber")
+			body, err := ioutil.ReadAll(r.Body)
+			th.AssertNoErr(t, err)
+
+			srv.Lock()
+			defer srv.Unlock()
+			if srv.failures[partNumber] {
+				delete(srv.failures, partNumber)
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			srv.parts[partNumber] = body
+			w.Header().Add("ETag", fmt.Sprintf(`"etag-%s"`, partNumber))
+			w.WriteHeader(http.StatusOK)
+		case r.Method == http.MethodPost:
+			th.AssertEquals(t, uploadID, query.Get("uploadId"))
+			w.Header().Add("Content-Type", "application/xml")
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprint(w, completeMultipartUploadResponse)
+		case r.Method == http.MethodDelete:
+			srv.Lock()
+			srv.aborted = true
+			srv.Unlock()
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+		}
+	})
+	return srv
+}
+
+// with the default (S3 compatible) signature WARM storage class is sent as STANDARD_IA
+const expectedLifecycleRequest = `<LifecycleConfiguration><Rule><ID>rule1</ID><Prefix>logs/</Prefix><Status>Enabled</Status><Transition><Days>30</Days><StorageClass>STANDARD_IA</StorageClass></Transition><Expiration><Days>365</Days></Expiration><NoncurrentVersionExpiration><NoncurrentDays>60</NoncurrentDays></NoncurrentVersionExpiration></Rule></LifecycleConfiguration>`
+
+const getLifecycleResponse = `<?xml version="1.0" encoding="UTF-8"?>
+<LifecycleConfiguration xmlns="http://obs.otc.t-systems.com/doc/2015-06-30/">
+  <Rule>
+    <ID>rule1</ID>
+    <Prefix>logs/</Prefix>
+    <Status>Enabled</Status>
+    <Transition>
+      <Days>30</Days>
+      <StorageClass>WARM</StorageClass>
+    </Transition>
+    <Expiration>
+      <Days>365</Days>
+    </Expiration>
+    <NoncurrentVersionExpiration>
+      <NoncurrentDays>60</NoncurrentDays>
+    </NoncurrentVersionExpiration>
+  </Rule>
+</LifecycleConfiguration>`
+
+const getVersioningResponse = `<?xml version="1.0" encoding="UTF-8"?>
+<VersioningConfiguration xmlns="http://obs.otc.t-systems.com/doc/2015-06-30/">
+  <Status>Enabled</Status>
+</VersioningConfiguration>`
+
+const getCorsResponse = `<?xml version="1.0" encoding="UTF-8"?>
+<CORSConfiguration xmlns="http://obs.otc.t-systems.com/doc/2015-06-30/">
+  <CORSRule>
+    <ID>cors1</ID>
+    <AllowedOrigin>https://example.com</AllowedOrigin>
+    <AllowedMethod>GET</AllowedMethod>
+    <AllowedMethod>PUT</AllowedMethod>
+    <AllowedHeader>*</AllowedHeader>
+    <MaxAgeSeconds>100</MaxAgeSeconds>
+    <ExposeHeader>ETag</ExposeHeader>
+  </CORSRule>
+</CORSConfiguration>`
+
+const getWebsiteResponse = `<?xml version="1.0" encoding="UTF-8"?>
+<WebsiteConfiguration xmlns="http://obs.otc.t-systems.com/doc/2015-06-30/">
+  <IndexDocument>
+    <Suffix>index.html</Suffix>
+  </IndexDocument>
+  <ErrorDocument>
+    <Key>error.html</Key>
+  </ErrorDocument>
+</WebsiteConfiguration>`
+
+const bucketPolicy = `{"Statement":[{"Sid":"read","Effect":"Allow","Principal":{"ID":["*"]},"Action":["GetObject"],"Resource":["test-bucket/*"]}]}`
+
+// HandleBucketConfigSuccessfully serves the bucket sub-resource (e.g. `lifecycle`) and
+// returns the request body of the last PUT request.
+func HandleBucketConfigSuccessfully(t *testing.T, subResource, response string) *string {
+	var body string
+	th.Mux.HandleFunc("/"+bucketName, func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := r.URL.Query()[subResource]; !ok {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+			return
+		}
+		switch r.Method {
+		case http.MethodPut:
+			raw, err := ioutil.ReadAll(r.Body)
+			th.AssertNoErr(t, err)
+			body = string(raw)
+			w.WriteHeader(http.StatusOK)
+		case http.MethodGet:
+			w.Header().Add("Content-Type", "application/xml")
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprint(w, response)
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+		}
+	})
+	return &body
+}
+
+// HandleRangedDownload serves content supporting Range requests and fails
+// every range listed in failures once.
+func HandleRangedDownload(t *testing.T, content []byte, etag string, failures ...string) {
+	failed := make(map[string]bool)
+	for _, r := range failures {
+		failed[r] = true
+	}
+	var lock sync.Mutex
+
+	th.Mux.HandleFunc(fmt.Sprintf("/%s/%s", bucketName, objectKey), func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("ETag", etag)
+		switch r.Method {
+		case http.MethodHead:
+			w.Header().Add("Content-Length", strconv.Itoa(len(content)))
+			w.WriteHeader(http.StatusOK)
+		case http.MethodGet:
+			th.TestHeader(t, r, "If-Match", etag)
+			rng := r.Header.Get("Range")
+			lock.Lock()
+			fail := failed[rng]
+			delete(failed, rng)
+			lock.Unlock()
+			if fail {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+
+			var start, end int
+			_, err := fmt.Sscanf(rng, "bytes=%d-%d", &start, &end)
+			th.AssertNoErr(t, err)
+			w.Header().Add("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, end, len(content)))
+			w.WriteHeader(http.StatusPartialContent)
+			_, _ = w.Write(content[start : end+1])
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+		}
+	})
+}
@@ -0,0 +1,490 @@
+package testing
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/obs"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func createTestFile(t *testing.T, size int) (string, []byte) {
+	dir, err := ioutil.TempDir("", "obs-upload")
+	th.AssertNoErr(t, err)
+	data := bytes.Repeat([]byte("0123456789"), size/10)
+	path := filepath.Join(dir, "upload.bin")
+	th.AssertNoErr(t, ioutil.WriteFile(path, data, 0600))
+	return path, data
+}
+
+func TestUploadFile(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	srv := HandleMultipartUpload(t, "2")
+	path, data := createTestFile(t, 250*1024)
+	defer os.RemoveAll(filepath.Dir(path))
+
+	output, err := obsClient(t).UploadFile(&obs.UploadFileInput{
+		ObjectOperationInput: obs.ObjectOperationInput{
+			Bucket: bucketName,
+			Key:    objectKey,
+		},
+		UploadFile: path,
+		PartSize:   obs.MIN_PART_SIZE,
+		TaskNum:    3,
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, bucketName, output.Bucket)
+	th.AssertEquals(t, objectKey, output.Key)
+
+	th.AssertEquals(t, 3, len(srv.parts))
+	uploaded := append(append(srv.parts["1"], srv.parts["2"]...), srv.parts["3"]...)
+	th.AssertDeepEquals(t, data, uploaded)
+	th.AssertEquals(t, false, srv.aborted)
+}
+
+func TestUploadFileResume(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	srv := HandleMultipartUpload(t, "3")
+	path, data := createTestFile(t, 250*1024)
+	defer os.RemoveAll(filepath.Dir(path))
+
+	input := func() *obs.UploadFileInput {
+		return &obs.UploadFileInput{
+			ObjectOperationInput: obs.ObjectOperationInput{
+				Bucket: bucketName,
+				Key:    objectKey,
+			},
+			UploadFile:       path,
+			PartSize:         obs.MIN_PART_SIZE,
+			PartRetryCount:   -1,
+			EnableCheckpoint: true,
+		}
+	}
+
+	_, err := obsClient(t).UploadFile(input())
+	if err == nil {
+		t.Fatal("expected upload to fail")
+	}
+	th.AssertEquals(t, false, srv.aborted)
+
+	checkpoint := &obs.UploadCheckpoint{}
+	raw, err := ioutil.ReadFile(path + ".uploadfile_record")
+	th.AssertNoErr(t, err)
+	th.AssertNoErr(t, xml.Unmarshal(raw, checkpoint))
+	th.AssertEquals(t, uploadID, checkpoint.UploadId)
+	th.AssertEquals(t, true, checkpoint.UploadParts[0].IsCompleted)
+	th.AssertEquals(t, false, checkpoint.UploadParts[2].IsCompleted)
+
+	srv.parts = make(map[string][]byte)
+	_, err = obsClient(t).UploadFile(input())
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 1, len(srv.parts))
+	th.AssertDeepEquals(t, data[2*obs.MIN_PART_SIZE:], srv.parts["3"])
+
+	_, err = os.Stat(path + ".uploadfile_record")
+	th.AssertEquals(t, true, os.IsNotExist(err))
+}
+
+func TestBucketLifecycleConfiguration(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	body := HandleBucketConfigSuccessfully(t, "lifecycle", getLifecycleResponse)
+	client := obsClient(t)
+
+	_, err := client.SetBucketLifecycleConfiguration(&obs.SetBucketLifecycleConfigurationInput{
+		Bucket: bucketName,
+		BucketLifecyleConfiguration: obs.BucketLifecyleConfiguration{
+			LifecycleRules: []obs.LifecycleRule{
+				{
+					ID:     "rule1",
+					Prefix: "logs/",
+					Status: obs.RuleStatusEnabled,
+					Transitions: []obs.Transition{
+						{Days: 30, StorageClass: obs.StorageClassWarm},
+					},
+					Expiration:                  obs.Expiration{Days: 365},
+					NoncurrentVersionExpiration: obs.NoncurrentVersionExpiration{NoncurrentDays: 60},
+				},
+			},
+		},
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, expectedLifecycleRequest, *body)
+
+	lifecycle, err := client.GetBucketLifecycleConfiguration(bucketName)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(lifecycle.LifecycleRules))
+	rule := lifecycle.LifecycleRules[0]
+	th.AssertEquals(t, "rule1", rule.ID)
+	th.AssertEquals(t, obs.RuleStatusEnabled, rule.Status)
+	th.AssertEquals(t, 30, rule.Transitions[0].Days)
+	th.AssertEquals(t, obs.StorageClassWarm, rule.Transitions[0].StorageClass)
+	th.AssertEquals(t, 365, rule.Expiration.Days)
+	th.AssertEquals(t, 60, rule.NoncurrentVersionExpiration.NoncurrentDays)
+
+	_, err = client.DeleteBucketLifecycleConfiguration(bucketName)
+	th.AssertNoErr(t, err)
+}
+
+func TestBucketVersioning(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	body := HandleBucketConfigSuccessfully(t, "versioning", getVersioningResponse)
+	client := obsClient(t)
+
+	_, err := client.SetBucketVersioning(&obs.SetBucketVersioningInput{
+		Bucket: bucketName,
+		BucketVersioningConfiguration: obs.BucketVersioningConfiguration{
+			Status: obs.VersioningStatusEnabled,
+		},
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, strings.Contains(*body, "<Status>Enabled</Status>"))
+
+	versioning, err := client.GetBucketVersioning(bucketName)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, obs.VersioningStatusEnabled, versioning.Status)
+}
+
+func TestBucketPolicy(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	body := HandleBucketConfigSuccessfully(t, "policy", bucketPolicy)
+	client := obsClient(t)
+
+	_, err := client.SetBucketPolicy(&obs.SetBucketPolicyInput{
+		Bucket: bucketName,
+		Policy: bucketPolicy,
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, bucketPolicy, *body)
+
+	policy, err := client.GetBucketPolicy(bucketName)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, bucketPolicy, policy.Policy)
+
+	_, err = client.DeleteBucketPolicy(bucketName)
+	th.AssertNoErr(t, err)
+}
+
+func TestBucketCors(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	body := HandleBucketConfigSuccessfully(t, "cors", getCorsResponse)
+	client := obsClient(t)
+
+	_, err := client.SetBucketCors(&obs.SetBucketCorsInput{
+		Bucket: bucketName,
+		BucketCors: obs.BucketCors{
+			CorsRules: []obs.CorsRule{
+				{
+					ID:            "cors1",
+					AllowedOrigin: []string{"https://example.com"},
+					AllowedMethod: []string{"GET", "PUT"},
+					AllowedHeader: []string{"*"},
+					MaxAgeSeconds: 100,
+					ExposeHeader:  []string{"ETag"},
+				},
+			},
+		},
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, strings.Contains(*body, "<AllowedOrigin>https://example.com</AllowedOrigin>"))
+
+	cors, err := client.GetBucketCors(bucketName)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(cors.CorsRules))
+	th.AssertDeepEquals(t, []string{"GET", "PUT"}, cors.CorsRules[0].AllowedMethod)
+	th.AssertEquals(t, 100, cors.CorsRules[0].MaxAgeSeconds)
+
+	_, err = client.DeleteBucketCors(bucketName)
+	th.AssertNoErr(t, err)
+}
+
+func TestBucketWebsiteConfiguration(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	body := HandleBucketConfigSuccessfully(t, "website", getWebsiteResponse)
+	client := obsClient(t)
+
+	_, err := client.SetBucketWebsiteConfiguration(&obs.SetBucketWebsiteConfigurationInput{
+		Bucket: bucketName,
+		BucketWebsiteConfiguration: obs.BucketWebsiteConfiguration{
+			IndexDocument: obs.IndexDocument{Suffix: "index.html"},
+			ErrorDocument: obs.ErrorDocument{Key: "error.html"},
+		},
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "<WebsiteConfiguration><IndexDocument><Suffix>index.html</Suffix></IndexDocument><ErrorDocument><Key>error.html</Key></ErrorDocument></WebsiteConfiguration>", *body)
+
+	website, err := client.GetBucketWebsiteConfiguration(bucketName)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "index.html", website.IndexDocument.Suffix)
+	th.AssertEquals(t, "error.html", website.ErrorDocument.Key)
+
+	_, err = client.DeleteBucketWebsiteConfiguration(bucketName)
+	th.AssertNoErr(t, err)
+}
+
+func TestPutObjectSseC(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	key := []byte("0123456789abcdef0123456789abcdef")
+	encodedKey := base64.StdEncoding.EncodeToString(key)
+	keyMD5 := md5.Sum(key)
+	encodedKeyMD5 := base64.StdEncoding.EncodeToString(keyMD5[:])
+
+	th.Mux.HandleFunc(fmt.Sprintf("/%s/%s", bucketName, objectKey), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "x-obs-server-side-encryption-customer-algorithm", "AES256")
+		th.TestHeader(t, r, "x-obs-server-side-encryption-customer-key", encodedKey)
+		th.TestHeader(t, r, "x-obs-server-side-encryption-customer-key-MD5", encodedKeyMD5)
+
+		w.Header().Add("x-obs-server-side-encryption-customer-algorithm", "AES256")
+		w.Header().Add("x-obs-server-side-encryption-customer-key-MD5", encodedKeyMD5)
+		w.WriteHeader(http.StatusOK)
+	})
+
+	client, err := obs.New("access", "secret", th.Endpoint(), obs.WithPathStyle(true), obs.WithSignature(obs.SignatureObs))
+	th.AssertNoErr(t, err)
+
+	output, err := client.PutObject(&obs.PutObjectInput{
+		PutObjectBasicInput: obs.PutObjectBasicInput{
+			ObjectOperationInput: obs.ObjectOperationInput{
+				Bucket:    bucketName,
+				Key:       objectKey,
+				SseHeader: obs.SseCHeader{Key: encodedKey},
+			},
+		},
+		Body: strings.NewReader("data"),
+	})
+	th.AssertNoErr(t, err)
+
+	sseHeader, ok := output.SseHeader.(obs.SseCHeader)
+	th.AssertEquals(t, true, ok)
+	th.AssertEquals(t, encodedKeyMD5, sseHeader.KeyMD5)
+}
+
+func TestPutObjectSseKmsWithSecurityToken(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc(fmt.Sprintf("/%s/%s", bucketName, objectKey), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "x-obs-server-side-encryption", "kms")
+		th.TestHeader(t, r, "x-obs-server-side-encryption-kms-key-id", "kms-key-id")
+		th.TestHeader(t, r, "x-obs-security-token", "security-token")
+		th.AssertEquals(t, true, strings.HasPrefix(r.Header.Get("Authorization"), "OBS temp-access:"))
+
+		w.Header().Add("x-obs-server-side-encryption", "kms")
+		w.Header().Add("x-obs-server-side-encryption-kms-key-id", "kms-key-id")
+		w.WriteHeader(http.StatusOK)
+	})
+
+	client, err := obs.New("temp-access", "temp-secret", th.Endpoint(),
+		obs.WithPathStyle(true), obs.WithSignature(obs.SignatureObs), obs.WithSecurityToken("security-token"),
+	)
+	th.AssertNoErr(t, err)
+
+	output, err := client.PutObject(&obs.PutObjectInput{
+		PutObjectBasicInput: obs.PutObjectBasicInput{
+			ObjectOperationInput: obs.ObjectOperationInput{
+				Bucket:    bucketName,
+				Key:       objectKey,
+				SseHeader: obs.SseKmsHeader{Key: "kms-key-id"},
+			},
+		},
+		Body: strings.NewReader("data"),
+	})
+	th.AssertNoErr(t, err)
+
+	sseHeader, ok := output.SseHeader.(obs.SseKmsHeader)
+	th.AssertEquals(t, true, ok)
+	th.AssertEquals(t, "kms-key-id", sseHeader.Key)
+}
+
+func TestDownloadObject(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	content := bytes.Repeat([]byte("0123456789"), 1001)
+	sum := md5.Sum(content)
+	etag := fmt.Sprintf(`"%x"`, sum)
+	HandleRangedDownload(t, content, etag, "bytes=2048-3071")
+
+	buf := &bytes.Buffer{}
+	output, err := obsClient(t).DownloadObject(&obs.DownloadObjectInput{
+		GetObjectMetadataInput: obs.GetObjectMetadataInput{
+			Bucket: bucketName,
+			Key:    objectKey,
+		},
+		PartSize:       1024,
+		TaskNum:        4,
+		VerifyChecksum: true,
+	}, buf)
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, content, buf.Bytes())
+	th.AssertEquals(t, fmt.Sprintf("%x", sum), output.ContentMD5)
+	th.AssertEquals(t, int64(len(content)), output.ContentLength)
+}
+
+func TestDownloadObjectSingleByteParts(t *testing.T) {
+	// the last part of both objects is a single byte long
+	for _, content := range [][]byte{[]byte("0"), []byte("0123456789a")} {
+		th.SetupHTTP()
+		sum := md5.Sum(content)
+		etag := fmt.Sprintf(`"%x"`, sum)
+		HandleRangedDownload(t, content, etag)
+
+		buf := &bytes.Buffer{}
+		_, err := obsClient(t).DownloadObject(&obs.DownloadObjectInput{
+			GetObjectMetadataInput: obs.GetObjectMetadataInput{
+				Bucket: bucketName,
+				Key:    objectKey,
+			},
+			PartSize:       5,
+			TaskNum:        2,
+			VerifyChecksum: true,
+		}, buf)
+		th.AssertNoErr(t, err)
+		th.AssertDeepEquals(t, content, buf.Bytes())
+		th.TeardownHTTP()
+	}
+}
+
+func TestDownloadObjectChecksumMismatch(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	content := bytes.Repeat([]byte("0123456789"), 100)
+	HandleRangedDownload(t, content, `"d41d8cd98f00b204e9800998ecf8427e"`)
+
+	_, err := obsClient(t).DownloadObject(&obs.DownloadObjectInput{
+		GetObjectMetadataInput: obs.GetObjectMetadataInput{
+			Bucket: bucketName,
+			Key:    objectKey,
+		},
+		PartSize:       300,
+		VerifyChecksum: true,
+	}, ioutil.Discard)
+	if err == nil {
+		t.Fatal("expected checksum mismatch error")
+	}
+}
+
+func TestDownloadObjectFailedPart(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	content := bytes.Repeat([]byte("0123456789"), 100)
+	HandleRangedDownload(t, content, `"etag"`, "bytes=500-999")
+
+	_, err := obsClient(t).DownloadObject(&obs.DownloadObjectInput{
+		GetObjectMetadataInput: obs.GetObjectMetadataInput{
+			Bucket: bucketName,
+			Key:    objectKey,
+		},
+		PartSize:       500,
+		TaskNum:        2,
+		PartRetryCount: -1,
+	}, ioutil.Discard)
+	if err == nil {
+		t.Fatal("expected download to fail")
+	}
+}
+
+func TestPresignGetObject(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc(fmt.Sprintf("/%s/%s", bucketName, objectKey), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestFormValues(t, r, map[string]string{
+			"AWSAccessKeyId": "access",
+			"Expires":        r.URL.Query().Get("Expires"),
+			"Signature":      expectedSignature("GET", "", r.URL.Query().Get("Expires")),
+		})
+		_, _ = fmt.Fprint(w, "content")
+	})
+
+	signedURL, err := obsClient(t).Presign(&obs.PresignInput{
+		Bucket:  bucketName,
+		Key:     objectKey,
+		Expires: 10 * time.Minute,
+	})
+	th.AssertNoErr(t, err)
+
+	u, err := url.Parse(signedURL)
+	th.AssertNoErr(t, err)
+	expires, err := strconv.ParseInt(u.Query().Get("Expires"), 10, 64)
+	th.AssertNoErr(t, err)
+	if delta := expires - time.Now().Add(10*time.Minute).Unix(); delta < -5 || delta > 5 {
+		t.Fatalf("Unexpected expiry %d", expires)
+	}
+
+	resp, err := http.Get(signedURL)
+	th.AssertNoErr(t, err)
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "content", string(body))
+}
+
+func TestPresignPutObject(t *testing.T) {
+	signedURL, err := obsClient(t).Presign(&obs.PresignInput{
+		Method:      obs.HttpMethodPut,
+		Bucket:      bucketName,
+		Key:         objectKey,
+		ContentType: "text/plain",
+	})
+	th.AssertNoErr(t, err)
+
+	u, err := url.Parse(signedURL)
+	th.AssertNoErr(t, err)
+	expires := u.Query().Get("Expires")
+	th.AssertEquals(t, expectedSignature("PUT", "text/plain", expires), u.Query().Get("Signature"))
+}
+
+func TestPresignUnsupportedMethod(t *testing.T) {
+	_, err := obsClient(t).Presign(&obs.PresignInput{
+		Method: obs.HttpMethodDelete,
+		Bucket: bucketName,
+		Key:    objectKey,
+	})
+	if err == nil {
+		t.Fatalf("Expected to receive error")
+	}
+}
+
+// expectedSignature computes the V2 query string signature of the test object.
+func expectedSignature(method, contentType, expires string) string {
+	stringToSign := fmt.Sprintf("%s\n\n%s\n%s\n/%s/%s", method, contentType, expires, bucketName, objectKey)
+	mac := hmac.New(sha1.New, []byte("secret"))
+	_, _ = mac.Write([]byte(stringToSign))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,561 @@
+package obs
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+	"sync"
+)
+
+const (
+	MIN_PART_SIZE            int64 = 100 * 1024
+	MAX_PART_SIZE            int64 = 5 * 1024 * 1024 * 1024
+	DEFAULT_PART_SIZE        int64 = 9 * 1024 * 1024
+	MAX_PART_NUM                   = 10000
+	DEFAULT_TASK_NUM               = 1
+	DEFAULT_PART_RETRY_COUNT       = 3
+
+	uploadCheckpointSuffix = ".uploadfile_record"
+)
+
+// UploadFileInput is the input of UploadFile.
+//
+// PartSize is adjusted to [MIN_PART_SIZE, MAX_PART_SIZE] and grown if the file
+// does not fit into MAX_PART_NUM parts. TaskNum limits the number of parts
+// uploaded concurrently. Each failed part is retried PartRetryCount times
+// (DEFAULT_PART_RETRY_COUNT if not set, no retries if negative).
+//
+// If EnableCheckpoint is set, the upload state is persisted to CheckpointFile
+// (UploadFile + ".uploadfile_record" by default) after every uploaded part,
+// so an interrupted upload can be resumed by calling UploadFile again with the same input.
+type UploadFileInput struct {
+	ObjectOperationInput
+	ContentType      string
+	UploadFile       string
+	PartSize         int64
+	TaskNum          int
+	PartRetryCount   int
+	EnableCheckpoint bool
+	CheckpointFile   string
+}
+
+// UploadCheckpoint is the persisted state of the resumable upload.
+type UploadCheckpoint struct {
+	XMLName     xml.Name         `xml:"UploadFileCheckpoint"`
+	Bucket      string           `xml:"Bucket"`
+	Key         string           `xml:"Key"`
+	UploadId    string           `xml:"UploadId,omitempty"`
+	UploadFile  string           `xml:"FileUrl"`
+	FileInfo    FileStatus       `xml:"FileInfo"`
+	UploadParts []UploadPartInfo `xml:"UploadParts>UploadPart"`
+}
+
+type FileStatus struct {
+	XMLName      xml.Name `xml:"FileInfo"`
+	LastModified int64    `xml:"LastModified"`
+	Size         int64    `xml:"Size"`
+}
+
+type UploadPartInfo struct {
+	XMLName     xml.Name `xml:"UploadPart"`
+	PartNumber  int      `xml:"PartNumber"`
+	Etag        string   `xml:"Etag"`
+	PartSize    int64    `xml:"PartSize"`
+	Offset      int64    `xml:"Offset"`
+	IsCompleted bool     `xml:"IsCompleted"`
+}
+
+func (cp *UploadCheckpoint) isValid(input *UploadFileInput, fileStat os.FileInfo) bool {
+	if cp.Bucket != input.Bucket || cp.Key != input.Key || cp.UploadFile != input.UploadFile {
+		return false
+	}
+	if cp.FileInfo.Size != fileStat.Size() || cp.FileInfo.LastModified != fileStat.ModTime().Unix() {
+		return false
+	}
+	if cp.UploadId == "" || len(cp.UploadParts) == 0 {
+		return false
+	}
+	var offset int64
+	for i, part := range cp.UploadParts {
+		if part.PartNumber != i+1 || part.Offset != offset {
+			return false
+		}
+		offset += part.PartSize
+	}
+	return offset == fileStat.Size()
+}
+
+func loadCheckpointFile(checkpointFile string, result interface{}) error {
+	data, err := ioutil.ReadFile(checkpointFile)
+	if err != nil {
+		return err
+	}
+	if len(data) == 0 {
+		return errors.New("checkpoint file is empty")
+	}
+	return xml.Unmarshal(data, result)
+}
+
+func updateCheckpointFile(fc interface{}, checkpointFilePath string) error {
+	data, err := xml.Marshal(fc)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(checkpointFilePath, data, 0640)
+}
+
+func sliceFile(fileSize, partSize int64) []UploadPartInfo {
+	if fileSize == 0 {
+		return []UploadPartInfo{{PartNumber: 1}}
+	}
+
+	if fileSize/partSize >= MAX_PART_NUM {
+		partSize = fileSize / MAX_PART_NUM
+		if fileSize%MAX_PART_NUM != 0 {
+			partSize++
+		}
+	}
+
+	partCount := fileSize / partSize
+	if fileSize%partSize != 0 {
+		partCount++
+	}
+
+	parts := make([]UploadPartInfo, 0, partCount)
+	for i := int64(0); i < partCount; i++ {
+		size := partSize
+		if i == partCount-1 && fileSize%partSize != 0 {
+			size = fileSize % partSize
+		}
+		parts = append(parts, UploadPartInfo{
+			PartNumber: int(i) + 1,
+			PartSize:   size,
+			Offset:     i * partSize,
+		})
+	}
+	return parts
+}
+
+// UploadFile uploads a local file as multipart upload, with concurrently uploaded
+// parts, retry of failed parts and optional resume from a checkpoint file.
+func (obsClient ObsClient) UploadFile(input *UploadFileInput) (output *CompleteMultipartUploadOutput, err error) {
+	if input == nil {
+		return nil, errors.New("UploadFileInput is nil")
+	}
+	if input.UploadFile == "" {
+		return nil, errors.New("UploadFile is empty")
+	}
+
+	if input.EnableCheckpoint && input.CheckpointFile == "" {
+		input.CheckpointFile = input.UploadFile + uploadCheckpointSuffix
+	}
+	if input.TaskNum <= 0 {
+		input.TaskNum = DEFAULT_TASK_NUM
+	}
+	if input.PartRetryCount == 0 {
+		input.PartRetryCount = DEFAULT_PART_RETRY_COUNT
+	}
+	switch {
+	case input.PartSize <= 0:
+		input.PartSize = DEFAULT_PART_SIZE
+	case input.PartSize < MIN_PART_SIZE:
+		input.PartSize = MIN_PART_SIZE
+	case input.PartSize > MAX_PART_SIZE:
+		input.PartSize = MAX_PART_SIZE
+	}
+
+	return obsClient.resumeUpload(input)
+}
+
+func (obsClient ObsClient) resumeUpload(input *UploadFileInput) (output *CompleteMultipartUploadOutput, err error) {
+	fileStat, err := os.Stat(input.UploadFile)
+	if err != nil {
+		return nil, err
+	}
+	if fileStat.IsDir() {
+		return nil, fmt.Errorf("UploadFile %s is a directory", input.UploadFile)
+	}
+
+	cp := &UploadCheckpoint{}
+	needPrepare := true
+	if input.EnableCheckpoint {
+		if err := loadCheckpointFile(input.CheckpointFile, cp); err == nil {
+			if cp.isValid(input, fileStat) {
+				needPrepare = false
+			} else {
+				doLog(LEVEL_WARN, "Checkpoint file %s is invalid, the upload will be restarted", input.CheckpointFile)
+				obsClient.abortUpload(input.Bucket, input.Key, cp.UploadId)
+				if err := os.Remove(input.CheckpointFile); err != nil {
+					doLog(LEVEL_WARN, "Failed to remove checkpoint file %s: %v", input.CheckpointFile, err)
+				}
+				cp = &UploadCheckpoint{}
+			}
+		}
+	}
+
+	if needPrepare {
+		if err := obsClient.prepareUpload(cp, input, fileStat); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := obsClient.uploadParts(cp, input); err != nil {
+		if !input.EnableCheckpoint {
+			obsClient.abortUpload(input.Bucket, input.Key, cp.UploadId)
+		}
+		return nil, err
+	}
+
+	parts := make([]Part, 0, len(cp.UploadParts))
+	for _, part := range cp.UploadParts {
+		parts = append(parts, Part{PartNumber: part.PartNumber, ETag: part.Etag})
+	}
+	output, err = obsClient.CompleteMultipartUpload(&CompleteMultipartUploadInput{
+		Bucket:   input.Bucket,
+		Key:      input.Key,
+		UploadId: cp.UploadId,
+		Parts:    parts,
+	})
+	if err != nil {
+		if !input.EnableCheckpoint {
+			obsClient.abortUpload(input.Bucket, input.Key, cp.UploadId)
+		}
+		return nil, err
+	}
+
+	if input.EnableCheckpoint {
+		if err := os.Remove(input.CheckpointFile); err != nil {
+			doLog(LEVEL_WARN, "Failed to remove checkpoint file %s: %v", input.CheckpointFile, err)
+		}
+	}
+	return output, nil
+}
+
+func (obsClient ObsClient) prepareUpload(cp *UploadCheckpoint, input *UploadFileInput, fileStat os.FileInfo) error {
+	initOutput, err := obsClient.InitiateMultipartUpload(&InitiateMultipartUploadInput{
+		ObjectOperationInput: input.ObjectOperationInput,
+		ContentType:          input.ContentType,
+	})
+	if err != nil {
+		return err
+	}
+
+	cp.Bucket = input.Bucket
+	cp.Key = input.Key
+	cp.UploadFile = input.UploadFile
+	cp.UploadId = initOutput.UploadId
+	cp.FileInfo = FileStatus{
+		Size:         fileStat.Size(),
+		LastModified: fileStat.ModTime().Unix(),
+	}
+	cp.UploadParts = sliceFile(fileStat.Size(), input.PartSize)
+
+	if input.EnableCheckpoint {
+		if err := updateCheckpointFile(cp, input.CheckpointFile); err != nil {
+			doLog(LEVEL_WARN, "Failed to write checkpoint file %s: %v", input.CheckpointFile, err)
+		}
+	}
+	return nil
+}
+
+func (obsClient ObsClient) uploadParts(cp *UploadCheckpoint, input *UploadFileInput) error {
+	pending := make(chan int, len(cp.UploadParts))
+	for i, part := range cp.UploadParts {
+		if !part.IsCompleted {
+			pending <- i
+		}
+	}
+	close(pending)
+
+	var (
+		wg       sync.WaitGroup
+		lock     sync.Mutex
+		failed   int
+		firstErr error
+	)
+	for w := 0; w < input.TaskNum; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range pending {
+				etag, err := obsClient.uploadPartWithRetry(cp.UploadId, cp.UploadParts[i], input)
+
+				lock.Lock()
+				if err != nil {
+					failed++
+					if firstErr == nil {
+						firstErr = err
+					}
+				} else {
+					cp.UploadParts[i].Etag = etag
+					cp.UploadParts[i].IsCompleted = true
+					if input.EnableCheckpoint {
+						if err := updateCheckpointFile(cp, input.CheckpointFile); err != nil {
+							doLog(LEVEL_WARN, "Failed to update checkpoint file %s: %v", input.CheckpointFile, err)
+						}
+					}
+				}
+				lock.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if failed > 0 {
+		return fmt.Errorf("failed to upload %d part(s) of %s: %w", failed, input.UploadFile, firstErr)
+	}
+	return nil
+}
+
+func (obsClient ObsClient) uploadPartWithRetry(uploadID string, part UploadPartInfo, input *UploadFileInput) (string, error) {
+	partInput := &UploadPartInput{
+		Bucket:     input.Bucket,
+		Key:        input.Key,
+		PartNumber: part.PartNumber,
+		UploadId:   uploadID,
+		SourceFile: input.UploadFile,
+		Offset:     part.Offset,
+		PartSize:   part.PartSize,
+	}
+	if sseCHeader, ok := input.SseHeader.(SseCHeader); ok {
+		partInput.SseHeader = sseCHeader
+	}
+
+	retries := input.PartRetryCount
+	if retries < 0 {
+		retries = 0
+	}
+
+	var err error
+	for attempt := 0; attempt <= retries; attempt++ {
+		var output *UploadPartOutput
+		output, err = obsClient.UploadPart(partInput)
+		if err == nil {
+			return output.ETag, nil
+		}
+		doLog(LEVEL_WARN, "Failed to upload part %d of %s (attempt %d): %v", part.PartNumber, input.UploadFile, attempt+1, err)
+	}
+	return "", err
+}
+
+func (obsClient ObsClient) abortUpload(bucket, key, uploadID string) {
+	if uploadID == "" {
+		return
+	}
+	_, err := obsClient.AbortMultipartUpload(&AbortMultipartUploadInput{
+		Bucket:   bucket,
+		Key:      key,
+		UploadId: uploadID,
+	})
+	if err != nil {
+		doLog(LEVEL_WARN, "Failed to abort multipart upload %s: %v", uploadID, err)
+	}
+}
+
+// DownloadObjectInput is the input of DownloadObject.
+//
+// The object is fetched with ranged GET requests of PartSize bytes
+// (DEFAULT_PART_SIZE if not set), up to TaskNum of them running concurrently.
+// At most TaskNum parts are kept in memory, as parts are written to the sink in order.
+// Each failed range request is retried PartRetryCount times
+// (DEFAULT_PART_RETRY_COUNT if not set, no retries if negative).
+//
+// If VerifyChecksum is set, MD5 of the downloaded content is compared with the
+// object ETag. This is only possible for objects not uploaded as multipart
+// and not encrypted with SSE-KMS or SSE-C.
+type DownloadObjectInput struct {
+	GetObjectMetadataInput
+	PartSize       int64
+	TaskNum        int
+	PartRetryCount int
+	VerifyChecksum bool
+}
+
+type DownloadObjectOutput struct {
+	GetObjectMetadataOutput
+	// ContentMD5 is hex encoded MD5 of the downloaded content
+	ContentMD5 string
+}
+
+type downloadPart struct {
+	offset int64
+	size   int64
+}
+
+type downloadPartResult struct {
+	data []byte
+	err  error
+}
+
+func sliceObject(objectSize, partSize int64) []downloadPart {
+	parts := make([]downloadPart, 0, objectSize/partSize+1)
+	for offset := int64(0); offset < objectSize; offset += partSize {
+		size := partSize
+		if offset+size > objectSize {
+			size = objectSize - offset
+		}
+		parts = append(parts, downloadPart{offset: offset, size: size})
+	}
+	// single byte range can't be requested, so it's merged into the previous one
+	if n := len(parts); n > 1 && parts[n-1].size == 1 {
+		parts[n-2].size++
+		parts = parts[:n-1]
+	}
+	return parts
+}
+
+// DownloadObject streams object content to w using parallel ranged GET requests,
+// without buffering the whole object in memory.
+func (obsClient ObsClient) DownloadObject(input *DownloadObjectInput, w io.Writer) (output *DownloadObjectOutput, err error) {
+	if input == nil {
+		return nil, errors.New("DownloadObjectInput is nil")
+	}
+	if w == nil {
+		return nil, errors.New("writer is nil")
+	}
+
+	if input.TaskNum <= 0 {
+		input.TaskNum = DEFAULT_TASK_NUM
+	}
+	if input.PartRetryCount == 0 {
+		input.PartRetryCount = DEFAULT_PART_RETRY_COUNT
+	}
+	if input.PartSize <= 0 {
+		input.PartSize = DEFAULT_PART_SIZE
+	}
+
+	metadata, err := obsClient.GetObjectMetadata(&input.GetObjectMetadataInput)
+	if err != nil {
+		return nil, err
+	}
+
+	hash := md5.New()
+	sink := io.MultiWriter(w, hash)
+	if err := obsClient.downloadParts(input, metadata, sink); err != nil {
+		return nil, err
+	}
+
+	output = &DownloadObjectOutput{
+		GetObjectMetadataOutput: *metadata,
+		ContentMD5:              hex.EncodeToString(hash.Sum(nil)),
+	}
+	if input.VerifyChecksum {
+		etag := strings.Trim(metadata.ETag, `"`)
+		if strings.Contains(etag, "-") {
+			return nil, fmt.Errorf("checksum of multipart object %s can't be verified", input.Key)
+		}
+		if !strings.EqualFold(etag, output.ContentMD5) {
+			return nil, fmt.Errorf("checksum mismatch for object %s: expected %s, got %s", input.Key, etag, output.ContentMD5)
+		}
+	}
+	return output, nil
+}
+
+func (obsClient ObsClient) downloadParts(input *DownloadObjectInput, metadata *GetObjectMetadataOutput, sink io.Writer) error {
+	parts := sliceObject(metadata.ContentLength, input.PartSize)
+	if len(parts) == 0 {
+		return nil
+	}
+
+	results := make([]chan downloadPartResult, len(parts))
+	for i := range results {
+		results[i] = make(chan downloadPartResult, 1)
+	}
+
+	// slots limits number of parts being downloaded or waiting to be written
+	slots := make(chan struct{}, input.TaskNum)
+	done := make(chan struct{})
+	defer close(done)
+
+	go func() {
+		for i, part := range parts {
+			select {
+			case slots <- struct{}{}:
+			case <-done:
+				return
+			}
+			go func(i int, part downloadPart) {
+				data, err := obsClient.downloadPartWithRetry(input, metadata.ETag, part)
+				results[i] <- downloadPartResult{data: data, err: err}
+			}(i, part)
+		}
+	}()
+
+	for i := range parts {
+		result := <-results[i]
+		if result.err != nil {
+			return result.err
+		}
+		if _, err := sink.Write(result.data); err != nil {
+			return err
+		}
+		<-slots
+	}
+	return nil
+}
+
+func (obsClient ObsClient) downloadPartWithRetry(input *DownloadObjectInput, etag string, part downloadPart) ([]byte, error) {
+	retries := input.PartRetryCount
+	if retries < 0 {
+		retries = 0
+	}
+
+	var err error
+	for attempt := 0; attempt <= retries; attempt++ {
+		var data []byte
+		data, err = obsClient.downloadPart(input, etag, part)
+		if err == nil {
+			return data, nil
+		}
+		doLog(LEVEL_WARN, "Failed to download range %d-%d of %s (attempt %d): %v",
+			part.offset, part.offset+part.size-1, input.Key, attempt+1, err)
+	}
+	return nil, err
+}
+
+// rangeObjectInput requests a byte range of an object. Unlike GetObjectInput, it sets
+// the Range header for single byte ranges too.
+type rangeObjectInput struct {
+	GetObjectInput
+}
+
+func (input rangeObjectInput) trans(isObs bool) (params map[string]string, headers map[string][]string, data interface{}, err error) {
+	params, headers, data, err = input.GetObjectInput.trans(isObs)
+	if err != nil {
+		return
+	}
+	headers[HEADER_RANGE] = []string{fmt.Sprintf("bytes=%d-%d", input.RangeStart, input.RangeEnd)}
+	return
+}
+
+func (obsClient ObsClient) downloadPart(input *DownloadObjectInput, etag string, part downloadPart) ([]byte, error) {
+	getObjectInput := rangeObjectInput{GetObjectInput{
+		GetObjectMetadataInput: input.GetObjectMetadataInput,
+		IfMatch:                etag,
+		RangeStart:             part.offset,
+		RangeEnd:               part.offset + part.size - 1,
+	}}
+	output := &GetObjectOutput{}
+	err := obsClient.doActionWithBucketAndKey("GetObject", HTTP_GET, input.Bucket, input.Key, getObjectInput, output)
+	if err != nil {
+		return nil, err
+	}
+	ParseGetObjectOutput(output)
+	defer output.Body.Close()
+
+	buf := bytes.NewBuffer(make([]byte, 0, part.size))
+	if _, err := io.Copy(buf, output.Body); err != nil {
+		return nil, err
+	}
+	if int64(buf.Len()) != part.size {
+		return nil, fmt.Errorf("unexpected size of range %d-%d: %d", getObjectInput.RangeStart, getObjectInput.RangeEnd, buf.Len())
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,39 @@
+package quotacenter
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack"
+)
+
+// NewClients creates the clients of all services supported by List.
+// Services missing in the service catalog are left nil and skipped by List.
+func NewClients(provider *golangsdk.ProviderClient, eo golangsdk.EndpointOpts) (*Clients, error) {
+	clients := new(Clients)
+	constructors := []struct {
+		client *(*golangsdk.ServiceClient)
+		create func(*golangsdk.ProviderClient, golangsdk.EndpointOpts) (*golangsdk.ServiceClient, error)
+	}{
+		{&clients.Compute, openstack.NewComputeV2},
+		{&clients.EVS, openstack.NewBlockStorageV3},
+		{&clients.VPC, openstack.NewNetworkV1},
+		{&clients.ELB, func(p *golangsdk.ProviderClient, eo golangsdk.EndpointOpts) (*golangsdk.ServiceClient, error) {
+			return openstack.NewElbV1(p, eo, "elb")
+		}},
+		{&clients.RDS, openstack.NewRDSV3},
+		{&clients.IMS, openstack.NewImageServiceV2},
+		{&clients.DDS, openstack.NewDDSServiceV3},
+		{&clients.DCS, openstack.NewDCSServiceV2},
+		{&clients.DMS, openstack.NewDMSServiceV2},
+	}
+	for _, c := range constructors {
+		client, err := c.create(provider, eo)
+		if err != nil {
+			if _, ok := err.(*golangsdk.ErrEndpointNotFound); ok {
+				continue
+			}
+			return nil, err
+		}
+		*c.client = client
+	}
+	return clients, nil
+}
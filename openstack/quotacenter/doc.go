@@ -0,0 +1,25 @@
+/*
+Package quotacenter queries the quotas of several services concurrently and
+returns them in a single normalized format.
+
+Example to Check the Capacity Before Creating Servers
+
+	clients, err := quotacenter.NewClients(provider, golangsdk.EndpointOpts{Region: "eu-de"})
+	if err != nil {
+		panic(err)
+	}
+
+	quotas, err := quotacenter.List(*clients)
+	if err != nil {
+		// quotas still contains the quotas of the services without errors
+		if _, ok := err.(quotacenter.ServiceErrors); !ok {
+			panic(err)
+		}
+	}
+
+	instances, ok := quotacenter.Find(quotas, quotacenter.ServiceECS, "instances")
+	if ok && instances.Available() < 3 {
+		panic("not enough instances quota")
+	}
+*/
+package quotacenter
@@ -0,0 +1,276 @@
+package quotacenter
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/structs"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/compute/v2/extensions/limits"
+	dcs "github.com/opentelekomcloud/gophertelekomcloud/openstack/dcs/v2/quotas"
+	dds "github.com/opentelekomcloud/gophertelekomcloud/openstack/dds/v3/quotas"
+	dms "github.com/opentelekomcloud/gophertelekomcloud/openstack/dms/v2/quotas"
+	evs "github.com/opentelekomcloud/gophertelekomcloud/openstack/evs/v3/quotas"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/ims/v2/cloudimages"
+	vpc "github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v1/quotas"
+	elb "github.com/opentelekomcloud/gophertelekomcloud/openstack/networking/v2/extensions/elb/quotas"
+	rds "github.com/opentelekomcloud/gophertelekomcloud/openstack/rds/v3/quotas"
+)
+
+// Service names used in Quota.Service.
+const (
+	ServiceECS = "ecs"
+	ServiceEVS = "evs"
+	ServiceVPC = "vpc"
+	ServiceEIP = "eip"
+	ServiceELB = "elb"
+	ServiceRDS = "rds"
+	ServiceIMS = "ims"
+	ServiceDDS = "dds"
+	ServiceDCS = "dcs"
+	ServiceDMS = "dms"
+)
+
+// Unlimited is the Limit of quotas without a limit.
+const Unlimited = -1
+
+// Quota is a normalized resource quota of a service.
+type Quota struct {
+	// Service is one of the Service* constants.
+	Service string
+	// Resource is the resource type as named by the service, e.g. "instances" or "publicIp".
+	Resource string
+	// Used is the number of used resources.
+	Used int
+	// Limit is the maximum number of resources, Unlimited means no limit.
+	Limit int
+}
+
+// Available returns the number of resources that can still be created.
+func (q Quota) Available() int {
+	if q.Limit == Unlimited {
+		return int(^uint(0) >> 1)
+	}
+	if q.Used >= q.Limit {
+		return 0
+	}
+	return q.Limit - q.Used
+}
+
+// Clients contains the service clients used to query the quotas.
+// Services with a nil client are skipped.
+type Clients struct {
+	// Compute is a client created with openstack.NewComputeV2.
+	Compute *golangsdk.ServiceClient
+	// EVS is a client created with openstack.NewBlockStorageV3.
+	EVS *golangsdk.ServiceClient
+	// VPC is a client created with openstack.NewNetworkV1, it provides both the VPC and the EIP quotas.
+	VPC *golangsdk.ServiceClient
+	// ELB is a client created with openstack.NewElbV1(..., "elb").
+	ELB *golangsdk.ServiceClient
+	// RDS is a client created with openstack.NewRDSV3.
+	RDS *golangsdk.ServiceClient
+	// IMS is a client created with openstack.NewImageServiceV2.
+	IMS *golangsdk.ServiceClient
+	// DDS is a client created with openstack.NewDDSServiceV3.
+	DDS *golangsdk.ServiceClient
+	// DCS is a client created with openstack.NewDCSServiceV2.
+	DCS *golangsdk.ServiceClient
+	// DMS is a client created with openstack.NewDMSServiceV2.
+	DMS *golangsdk.ServiceClient
+}
+
+// ServiceErrors contains the errors of the services whose quotas couldn't be retrieved.
+type ServiceErrors map[string]error
+
+func (e ServiceErrors) Error() string {
+	services := make([]string, 0, len(e))
+	for service := range e {
+		services = append(services, service)
+	}
+	sort.Strings(services)
+
+	messages := make([]string, len(services))
+	for i, service := range services {
+		messages[i] = fmt.Sprintf("%s: %s", service, e[service])
+	}
+	return "failed to get quotas of " + strings.Join(messages, "; ")
+}
+
+type fetcher func(client *golangsdk.ServiceClient) ([]Quota, error)
+
+// List queries the quotas of all services with a client concurrently.
+// If some of the services fail, the quotas of the other services are returned
+// together with a ServiceErrors error.
+func List(clients Clients) ([]Quota, error) {
+	fetchers := []struct {
+		service string
+		client  *golangsdk.ServiceClient
+		fetch   fetcher
+	}{
+		{ServiceECS, clients.Compute, ecsQuotas},
+		{ServiceEVS, clients.EVS, evsQuotas},
+		{ServiceVPC, clients.VPC, vpcQuotas},
+		{ServiceELB, clients.ELB, elbQuotas},
+		{ServiceRDS, clients.RDS, structsFetcher(ServiceRDS, func(c *golangsdk.ServiceClient) ([]structs.QuotaResource, error) {
+			return rds.Get(c).Extract()
+		})},
+		{ServiceIMS, clients.IMS, imsQuotas},
+		{ServiceDDS, clients.DDS, structsFetcher(ServiceDDS, func(c *golangsdk.ServiceClient) ([]structs.QuotaResource, error) {
+			return dds.Get(c).Extract()
+		})},
+		{ServiceDCS, clients.DCS, structsFetcher(ServiceDCS, func(c *golangsdk.ServiceClient) ([]structs.QuotaResource, error) {
+			return dcs.Get(c).Extract()
+		})},
+		{ServiceDMS, clients.DMS, structsFetcher(ServiceDMS, func(c *golangsdk.ServiceClient) ([]structs.QuotaResource, error) {
+			return dms.Get(c).Extract()
+		})},
+	}
+
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		quotas []Quota
+		errs   = ServiceErrors{}
+	)
+	for _, f := range fetchers {
+		if f.client == nil {
+			continue
+		}
+		wg.Add(1)
+		go func(service string, client *golangsdk.ServiceClient, fetch fetcher) {
+			defer wg.Done()
+			result, err := fetch(client)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs[service] = err
+				return
+			}
+			quotas = append(quotas, result...)
+		}(f.service, f.client, f.fetch)
+	}
+	wg.Wait()
+
+	sort.Slice(quotas, func(i, j int) bool {
+		if quotas[i].Service != quotas[j].Service {
+			return quotas[i].Service < quotas[j].Service
+		}
+		return quotas[i].Resource < quotas[j].Resource
+	})
+
+	if len(errs) > 0 {
+		return quotas, errs
+	}
+	return quotas, nil
+}
+
+// Find returns the quota of the given service resource.
+func Find(quotas []Quota, service, resource string) (*Quota, bool) {
+	for i := range quotas {
+		if quotas[i].Service == service && quotas[i].Resource == resource {
+			return &quotas[i], true
+		}
+	}
+	return nil, false
+}
+
+func ecsQuotas(client *golangsdk.ServiceClient) ([]Quota, error) {
+	l, err := limits.Get(client, nil).Extract()
+	if err != nil {
+		return nil, err
+	}
+	a := l.Absolute
+	return []Quota{
+		{ServiceECS, "instances", a.TotalInstancesUsed, a.MaxTotalInstances},
+		{ServiceECS, "cores", a.TotalCoresUsed, a.MaxTotalCores},
+		{ServiceECS, "ram", a.TotalRAMUsed, a.MaxTotalRAMSize},
+		{ServiceECS, "server_groups", a.TotalServerGroupsUsed, a.MaxServerGroups},
+	}, nil
+}
+
+func evsQuotas(client *golangsdk.ServiceClient) ([]Quota, error) {
+	set, err := evs.Get(client).Extract()
+	if err != nil {
+		return nil, err
+	}
+	quotas := []Quota{
+		{ServiceEVS, "volumes", set.Volumes.InUse, set.Volumes.Limit},
+		{ServiceEVS, "snapshots", set.Snapshots.InUse, set.Snapshots.Limit},
+		{ServiceEVS, "gigabytes", set.Gigabytes.InUse, set.Gigabytes.Limit},
+		{ServiceEVS, "backups", set.Backups.InUse, set.Backups.Limit},
+		{ServiceEVS, "backup_gigabytes", set.BackupGigabytes.InUse, set.BackupGigabytes.Limit},
+	}
+	for resource, detail := range set.VolumeTypes {
+		quotas = append(quotas, Quota{ServiceEVS, resource, detail.InUse, detail.Limit})
+	}
+	return quotas, nil
+}
+
+// eipResources are the VPC quota types reported under ServiceEIP.
+var eipResources = map[string]bool{
+	vpc.TypePublicIP:         true,
+	vpc.TypeShareBandwidth:   true,
+	vpc.TypeShareBandwidthIP: true,
+}
+
+func vpcQuotas(client *golangsdk.ServiceClient) ([]Quota, error) {
+	resources, err := vpc.Get(client, vpc.GetOpts{}).Extract()
+	if err != nil {
+		return nil, err
+	}
+	quotas := make([]Quota, len(resources))
+	for i, r := range resources {
+		service := ServiceVPC
+		if eipResources[r.Type] {
+			service = ServiceEIP
+		}
+		quotas[i] = Quota{service, r.Type, r.Used, r.Quota}
+	}
+	return quotas, nil
+}
+
+func elbQuotas(client *golangsdk.ServiceClient) ([]Quota, error) {
+	resources, err := elb.Get(client).Extract()
+	if err != nil {
+		return nil, err
+	}
+	quotas := make([]Quota, len(resources))
+	for i, r := range resources {
+		quotas[i] = Quota{ServiceELB, r.Type, r.Used, r.Quota}
+	}
+	return quotas, nil
+}
+
+func imsQuotas(client *golangsdk.ServiceClient) ([]Quota, error) {
+	resources, err := cloudimages.GetQuota(client).Extract()
+	if err != nil {
+		return nil, err
+	}
+	quotas := make([]Quota, len(resources))
+	for i, r := range resources {
+		quotas[i] = Quota{ServiceIMS, r.Type, r.Used, r.Quota}
+	}
+	return quotas, nil
+}
+
+func structsFetcher(service string, get func(*golangsdk.ServiceClient) ([]structs.QuotaResource, error)) fetcher {
+	return func(client *golangsdk.ServiceClient) ([]Quota, error) {
+		resources, err := get(client)
+		if err != nil {
+			return nil, err
+		}
+		quotas := make([]Quota, len(resources))
+		for i, r := range resources {
+			resource := r.Type
+			if r.Mode != "" {
+				resource += "." + r.Mode
+			}
+			quotas[i] = Quota{service, resource, r.Used, r.Quota}
+		}
+		return quotas, nil
+	}
+}
@@ -0,0 +1,80 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const projectID = "85636478b0bd8e67e89469c7749d4127"
+
+const vpcResponse = `
+{
+  "quotas": {
+    "resources": [
+      {
+        "type": "vpc",
+        "used": 4,
+        "quota": 150,
+        "min": 0
+      },
+      {
+        "type": "publicIp",
+        "used": 50,
+        "quota": 50,
+        "min": 0
+      }
+    ]
+  }
+}`
+
+const rdsResponse = `
+{
+  "quotas": {
+    "resources": [
+      {
+        "type": "instance",
+        "used": 2,
+        "quota": -1
+      }
+    ]
+  }
+}`
+
+// serviceClient returns a client of a fake service served under the given prefix.
+func serviceClient(prefix string) *golangsdk.ServiceClient {
+	client := fake.ServiceClient()
+	client.ProjectID = projectID
+	client.ResourceBase = client.Endpoint + prefix + "/"
+	return client
+}
+
+// HandleQuotasSuccessfully creates HTTP handlers at `/vpc/{project_id}/quotas`,
+// `/rds/project-quotas` and `/dcs/quota` on the test handler mux.
+func HandleQuotasSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/vpc/%s/quotas", projectID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, vpcResponse)
+	})
+
+	th.Mux.HandleFunc("/rds/project-quotas", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, rdsResponse)
+	})
+
+	th.Mux.HandleFunc("/dcs/quota", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+}
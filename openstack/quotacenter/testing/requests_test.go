@@ -0,0 +1,57 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/quotacenter"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleQuotasSuccessfully(t)
+
+	quotas, err := quotacenter.List(quotacenter.Clients{
+		VPC: serviceClient("vpc"),
+		RDS: serviceClient("rds"),
+	})
+	th.AssertNoErr(t, err)
+
+	expected := []quotacenter.Quota{
+		{Service: quotacenter.ServiceEIP, Resource: "publicIp", Used: 50, Limit: 50},
+		{Service: quotacenter.ServiceRDS, Resource: "instance", Used: 2, Limit: quotacenter.Unlimited},
+		{Service: quotacenter.ServiceVPC, Resource: "vpc", Used: 4, Limit: 150},
+	}
+	th.CheckDeepEquals(t, expected, quotas)
+
+	eip, ok := quotacenter.Find(quotas, quotacenter.ServiceEIP, "publicIp")
+	th.AssertEquals(t, true, ok)
+	th.AssertEquals(t, 0, eip.Available())
+
+	vpc, ok := quotacenter.Find(quotas, quotacenter.ServiceVPC, "vpc")
+	th.AssertEquals(t, true, ok)
+	th.AssertEquals(t, 146, vpc.Available())
+
+	_, ok = quotacenter.Find(quotas, quotacenter.ServiceECS, "instances")
+	th.AssertEquals(t, false, ok)
+}
+
+func TestListPartialFailure(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleQuotasSuccessfully(t)
+
+	quotas, err := quotacenter.List(quotacenter.Clients{
+		VPC: serviceClient("vpc"),
+		DCS: serviceClient("dcs"),
+	})
+	th.AssertEquals(t, 2, len(quotas))
+
+	errs, ok := err.(quotacenter.ServiceErrors)
+	th.AssertEquals(t, true, ok)
+	th.AssertEquals(t, 1, len(errs))
+	if errs[quotacenter.ServiceDCS] == nil {
+		t.Fatalf("expected an error of the %s service", quotacenter.ServiceDCS)
+	}
+}
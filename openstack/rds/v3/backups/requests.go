@@ -46,6 +46,14 @@ func Update(c *golangsdk.ServiceClient, id string, opts UpdateOptsBuilder) (r Up
 	return
 }
 
+// GetPolicy retrieves the automated backup policy of the instance.
+func GetPolicy(c *golangsdk.ServiceClient, instanceID string) (r GetPolicyResult) {
+	_, r.Err = c.Get(resourceURL(c, instanceID), &r.Body, &golangsdk.RequestOpts{
+		MoreHeaders: RequestOpts.MoreHeaders,
+	})
+	return
+}
+
 type CreateOptsBuilder interface {
 	ToBackupCreateMap() (map[string]interface{}, error)
 }
@@ -151,6 +159,8 @@ type RestorePoint struct {
 	Type        RestoreType `json:"type" required:"true"`
 	BackupID    string      `json:"backup_id,omitempty"`
 	RestoreTime int         `json:"restore_time,omitempty"`
+	// Databases to restore and their new names, supported by SQL Server only
+	DatabaseName map[string]string `json:"database_name,omitempty"`
 }
 
 type RestoreToNewOpts struct {
@@ -189,3 +199,203 @@ func RestoreToNew(c *golangsdk.ServiceClient, opts RestoreToNewOptsBuilder) (r R
 	})
 	return
 }
+
+type Target struct {
+	InstanceID string `json:"instance_id" required:"true"`
+}
+
+type RestoreToExistingOpts struct {
+	Source RestorePoint `json:"source" required:"true"`
+	Target Target       `json:"target" required:"true"`
+}
+
+type RestoreToExistingOptsBuilder interface {
+	ToExistingRestoreMap() (map[string]interface{}, error)
+}
+
+func (opts RestoreToExistingOpts) ToExistingRestoreMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// RestoreToExisting restores the data of a backup or a point in time to an existing instance.
+// The data of the target instance is overwritten.
+func RestoreToExisting(c *golangsdk.ServiceClient, opts RestoreToExistingOptsBuilder) (r RestoreJobResult) {
+	b, err := opts.ToExistingRestoreMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Post(recoveryURL(c), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes:     []int{200, 202},
+		MoreHeaders: RequestOpts.MoreHeaders,
+	})
+	return
+}
+
+type RestoreTable struct {
+	OldName string `json:"oldName" required:"true"`
+	NewName string `json:"newName" required:"true"`
+}
+
+type RestoreDatabaseTables struct {
+	Database string         `json:"database" required:"true"`
+	Tables   []RestoreTable `json:"tables" required:"true"`
+}
+
+type RestoreTablesOpts struct {
+	// Point in time to restore the tables to, in milliseconds since epoch
+	RestoreTime   int64                   `json:"restoreTime" required:"true"`
+	RestoreTables []RestoreDatabaseTables `json:"restoreTables" required:"true"`
+}
+
+type RestoreTablesOptsBuilder interface {
+	ToTablesRestoreMap() (map[string]interface{}, error)
+}
+
+func (opts RestoreTablesOpts) ToTablesRestoreMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// RestoreTables restores the given MySQL tables of the instance to a point in time.
+// The restored tables are created next to the original ones using the new names.
+func RestoreTables(c *golangsdk.ServiceClient, instanceID string, opts RestoreTablesOptsBuilder) (r RestoreTablesResult) {
+	b, err := opts.ToTablesRestoreMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Post(restoreTablesURL(c, instanceID), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes:     []int{200, 202},
+		MoreHeaders: RequestOpts.MoreHeaders,
+	})
+	return
+}
+
+type ListRestoreTimesOptsBuilder interface {
+	ToRestoreTimesListQuery() (string, error)
+}
+
+type ListRestoreTimesOpts struct {
+	// Date to query the restore time ranges for, in `yyyy-mm-dd` format
+	Date string `q:"date"`
+}
+
+func (opts ListRestoreTimesOpts) ToRestoreTimesListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), nil
+}
+
+// ListRestoreTimes returns the time ranges the instance can be restored to.
+func ListRestoreTimes(c *golangsdk.ServiceClient, instanceID string, opts ListRestoreTimesOptsBuilder) (r RestoreTimesResult) {
+	url := restoreTimeURL(c, instanceID)
+	if opts != nil {
+		q, err := opts.ToRestoreTimesListQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += q
+	}
+	_, r.Err = c.Get(url, &r.Body, &golangsdk.RequestOpts{
+		MoreHeaders: RequestOpts.MoreHeaders,
+	})
+	return
+}
+
+// OffsitePolicyOptsBuilder allows extensions to add additional parameters to the
+// UpdateOffsitePolicy request.
+type OffsitePolicyOptsBuilder interface {
+	ToOffsitePolicyUpdateMap() (map[string]interface{}, error)
+}
+
+// OffsitePolicyOpts contains all the values needed to change the cross-region backup policy.
+type OffsitePolicyOpts struct {
+	// Backup type: `auto` for full backups, `incremental` for incremental ones or `all` for both
+	BackupType string `json:"backup_type" required:"true"`
+	// Number of days to retain the cross-region backups, from 1 to 1825. 0 disables cross-region backups.
+	KeepDays *int `json:"keep_days" required:"true"`
+	// Region to store the backups in, mandatory when enabling cross-region backups
+	DestinationRegion string `json:"destination_region,omitempty"`
+	// Project ID in the destination region, mandatory when enabling cross-region backups
+	DestinationProjectID string `json:"destination_project_id,omitempty"`
+}
+
+// ToOffsitePolicyUpdateMap builds a request body from OffsitePolicyOpts.
+func (opts OffsitePolicyOpts) ToOffsitePolicyUpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "policy_para")
+}
+
+// UpdateOffsitePolicy enables, changes or disables the cross-region backups of the instance.
+func UpdateOffsitePolicy(c *golangsdk.ServiceClient, instanceID string, opts OffsitePolicyOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToOffsitePolicyUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Put(offsitePolicyURL(c, instanceID), b, nil, &golangsdk.RequestOpts{
+		OkCodes:     []int{200},
+		MoreHeaders: RequestOpts.MoreHeaders,
+	})
+	return
+}
+
+// GetOffsitePolicy retrieves the cross-region backup policies of the instance.
+func GetOffsitePolicy(c *golangsdk.ServiceClient, instanceID string) (r GetOffsitePolicyResult) {
+	_, r.Err = c.Get(offsitePolicyURL(c, instanceID), &r.Body, &golangsdk.RequestOpts{
+		MoreHeaders: RequestOpts.MoreHeaders,
+	})
+	return
+}
+
+type ListOffsiteOpts struct {
+	InstanceID string `q:"instance_id,required"`
+	BackupID   string `q:"backup_id"`
+	BackupType string `q:"backup_type"`
+	BeginTime  string `q:"begin_time"`
+	EndTime    string `q:"end_time"`
+	Offset     int    `q:"offset"`
+	Limit      int    `q:"limit"`
+}
+
+func (opts ListOffsiteOpts) ToBackupListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), nil
+}
+
+// ListOffsite returns the cross-region backups of the instance.
+func ListOffsite(c *golangsdk.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := offsiteBackupsURL(c)
+	if opts != nil {
+		q, err := opts.ToBackupListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += q
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return BackupPage{SinglePageBase: pagination.SinglePageBase(r)}
+	})
+}
+
+// ListOffsiteRestoreTimes returns the time ranges the instance can be restored to from the cross-region backups.
+func ListOffsiteRestoreTimes(c *golangsdk.ServiceClient, instanceID string, opts ListRestoreTimesOptsBuilder) (r RestoreTimesResult) {
+	url := offsiteRestoreTimeURL(c, instanceID)
+	if opts != nil {
+		q, err := opts.ToRestoreTimesListQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += q
+	}
+	_, r.Err = c.Get(url, &r.Body, &golangsdk.RequestOpts{
+		MoreHeaders: RequestOpts.MoreHeaders,
+	})
+	return
+}
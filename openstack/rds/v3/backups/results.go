@@ -72,3 +72,92 @@ type DeleteResult struct {
 type RestoreResult struct {
 	instances.CreateResult
 }
+
+type BackupPolicy struct {
+	KeepDays  int    `json:"keep_days"`
+	StartTime string `json:"start_time"`
+	Period    string `json:"period"`
+}
+
+type GetPolicyResult struct {
+	golangsdk.Result
+}
+
+func (r GetPolicyResult) Extract() (*BackupPolicy, error) {
+	policy := new(BackupPolicy)
+	err := r.ExtractIntoStructPtr(policy, "backup_policy")
+	if err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+type RestoreJobResult struct {
+	golangsdk.Result
+}
+
+// Extract returns the ID of the restoration job, use it with instances.WaitForJobCompleted.
+func (r RestoreJobResult) Extract() (string, error) {
+	var s struct {
+		JobID string `json:"job_id"`
+	}
+	err := r.ExtractInto(&s)
+	return s.JobID, err
+}
+
+type RestoreTablesResponse struct {
+	JobID string `json:"jobId"`
+}
+
+type RestoreTablesResult struct {
+	golangsdk.Result
+}
+
+func (r RestoreTablesResult) Extract() (*RestoreTablesResponse, error) {
+	resp := new(RestoreTablesResponse)
+	err := r.ExtractInto(resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// RestoreTime is a time range the instance can be restored to, in milliseconds since epoch.
+type RestoreTime struct {
+	StartTime int64 `json:"start_time"`
+	EndTime   int64 `json:"end_time"`
+}
+
+type RestoreTimesResult struct {
+	golangsdk.Result
+}
+
+func (r RestoreTimesResult) Extract() ([]RestoreTime, error) {
+	var times []RestoreTime
+	err := r.ExtractIntoSlicePtr(&times, "restore_time")
+	if err != nil {
+		return nil, err
+	}
+	return times, nil
+}
+
+// OffsitePolicy is a cross-region backup policy of the instance.
+type OffsitePolicy struct {
+	BackupType           string `json:"backup_type"`
+	KeepDays             int    `json:"keep_days"`
+	DestinationRegion    string `json:"destination_region"`
+	DestinationProjectID string `json:"destination_project_id"`
+}
+
+type GetOffsitePolicyResult struct {
+	golangsdk.Result
+}
+
+func (r GetOffsitePolicyResult) Extract() ([]OffsitePolicy, error) {
+	var policies []OffsitePolicy
+	err := r.ExtractIntoSlicePtr(&policies, "policy_para")
+	if err != nil {
+		return nil, err
+	}
+	return policies, nil
+}
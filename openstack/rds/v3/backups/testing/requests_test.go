@@ -128,3 +128,242 @@ func TestRestoreRequest(t *testing.T) {
 	th.AssertNoErr(t, err)
 	tools.PrintResource(t, backup)
 }
+
+func TestGetPolicy(t *testing.T) {
+	th.SetupHTTP()
+	t.Cleanup(func() {
+		th.TeardownHTTP()
+	})
+	th.Mux.HandleFunc("/instances/d8e6ca5a624745bcb546a227aa3ae1cfin01/backups/policy", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, `{"backup_policy": {"keep_days": 7, "start_time": "19:00-20:00", "period": "1,2,3"}}`)
+	})
+
+	policy, err := backups.GetPolicy(client.ServiceClient(), "d8e6ca5a624745bcb546a227aa3ae1cfin01").Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 7, policy.KeepDays)
+	th.AssertEquals(t, "19:00-20:00", policy.StartTime)
+	th.AssertEquals(t, "1,2,3", policy.Period)
+}
+
+const expectedRestoreToExistingRequest = `
+{
+  "source": {
+    "instance_id": "d8e6ca5a624745bcb546a227aa3ae1cfin01",
+    "type": "timestamp",
+    "restore_time": 1532001446987
+  },
+  "target": {
+    "instance_id": "d8e6ca5a624745bcb546a227aa3ae1cfin01"
+  }
+}
+`
+
+func TestRestoreToExisting(t *testing.T) {
+	th.SetupHTTP()
+	t.Cleanup(func() {
+		th.TeardownHTTP()
+	})
+	th.Mux.HandleFunc("/instances/recovery", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, expectedRestoreToExistingRequest)
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, `{"job_id": "ff80808157127d9301571bf8160c001d"}`)
+	})
+
+	opts := backups.RestoreToExistingOpts{
+		Source: backups.RestorePoint{
+			InstanceID:  "d8e6ca5a624745bcb546a227aa3ae1cfin01",
+			Type:        backups.TypeTimestamp,
+			RestoreTime: 1532001446987,
+		},
+		Target: backups.Target{InstanceID: "d8e6ca5a624745bcb546a227aa3ae1cfin01"},
+	}
+	jobID, err := backups.RestoreToExisting(client.ServiceClient(), opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "ff80808157127d9301571bf8160c001d", jobID)
+}
+
+const expectedRestoreTablesRequest = `
+{
+  "restoreTime": 1583720991000,
+  "restoreTables": [
+    {
+      "database": "restoreDatabase",
+      "tables": [
+        {
+          "oldName": "srcTable",
+          "newName": "srcTable_restored"
+        }
+      ]
+    }
+  ]
+}
+`
+
+func TestRestoreTables(t *testing.T) {
+	th.SetupHTTP()
+	t.Cleanup(func() {
+		th.TeardownHTTP()
+	})
+	th.Mux.HandleFunc("/instances/d8e6ca5a624745bcb546a227aa3ae1cfin01/restore/tables", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, expectedRestoreTablesRequest)
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, `{"jobId": "ff80808157127d9301571bf8160c001d"}`)
+	})
+
+	opts := backups.RestoreTablesOpts{
+		RestoreTime: 1583720991000,
+		RestoreTables: []backups.RestoreDatabaseTables{
+			{
+				Database: "restoreDatabase",
+				Tables: []backups.RestoreTable{
+					{OldName: "srcTable", NewName: "srcTable_restored"},
+				},
+			},
+		},
+	}
+	job, err := backups.RestoreTables(client.ServiceClient(), "d8e6ca5a624745bcb546a227aa3ae1cfin01", opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "ff80808157127d9301571bf8160c001d", job.JobID)
+}
+
+func TestListRestoreTimes(t *testing.T) {
+	th.SetupHTTP()
+	t.Cleanup(func() {
+		th.TeardownHTTP()
+	})
+	th.Mux.HandleFunc("/instances/d8e6ca5a624745bcb546a227aa3ae1cfin01/restore-time", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{"date": "2020-12-26"})
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, `{"restore_time": [{"start_time": 1532001446987, "end_time": 1532742139000}]}`)
+	})
+
+	times, err := backups.ListRestoreTimes(client.ServiceClient(), "d8e6ca5a624745bcb546a227aa3ae1cfin01",
+		backups.ListRestoreTimesOpts{Date: "2020-12-26"}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(times))
+	th.AssertEquals(t, int64(1532001446987), times[0].StartTime)
+	th.AssertEquals(t, int64(1532742139000), times[0].EndTime)
+}
+
+const expectedOffsitePolicyRequest = `
+{
+  "policy_para": {
+    "backup_type": "all",
+    "keep_days": 7,
+    "destination_region": "eu-nl",
+    "destination_project_id": "054b61972980d4552f0bc00ac8d3f5cd"
+  }
+}
+`
+
+func TestOffsitePolicy(t *testing.T) {
+	th.SetupHTTP()
+	t.Cleanup(func() {
+		th.TeardownHTTP()
+	})
+	th.Mux.HandleFunc("/instances/d8e6ca5a624745bcb546a227aa3ae1cfin01/backups/offsite-policy", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		switch r.Method {
+		case "PUT":
+			th.TestJSONRequest(t, r, expectedOffsitePolicyRequest)
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprint(w, `{}`)
+		case "GET":
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprint(w, `{"policy_para": [{"backup_type": "auto", "keep_days": 7, "destination_region": "eu-nl", "destination_project_id": "054b61972980d4552f0bc00ac8d3f5cd"}]}`)
+		}
+	})
+
+	keepDays := 7
+	opts := backups.OffsitePolicyOpts{
+		BackupType:           "all",
+		KeepDays:             &keepDays,
+		DestinationRegion:    "eu-nl",
+		DestinationProjectID: "054b61972980d4552f0bc00ac8d3f5cd",
+	}
+	err := backups.UpdateOffsitePolicy(client.ServiceClient(), "d8e6ca5a624745bcb546a227aa3ae1cfin01", opts).ExtractErr()
+	th.AssertNoErr(t, err)
+
+	policies, err := backups.GetOffsitePolicy(client.ServiceClient(), "d8e6ca5a624745bcb546a227aa3ae1cfin01").Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(policies))
+	th.AssertEquals(t, "eu-nl", policies[0].DestinationRegion)
+	th.AssertEquals(t, 7, policies[0].KeepDays)
+}
+
+func TestListOffsite(t *testing.T) {
+	th.SetupHTTP()
+	t.Cleanup(func() {
+		th.TeardownHTTP()
+	})
+	th.Mux.HandleFunc("/offsite-backups", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{"instance_id": "d8e6ca5a624745bcb546a227aa3ae1cfin01"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, `
+{
+  "backups": [
+    {
+      "id": "43e4feaab48f11e89039fa163ebaa7e4br01",
+      "instance_id": "d8e6ca5a624745bcb546a227aa3ae1cfin01",
+      "name": "xxxx.xxx",
+      "type": "auto",
+      "begin_time": "2018-08-06T12:41:14+0800",
+      "end_time": "2018-08-06T12:43:14+0800",
+      "status": "COMPLETED",
+      "datastore": {"type": "MySQL", "version": "5.7"}
+    }
+  ],
+  "total_count": 1
+}`)
+	})
+
+	pages, err := backups.ListOffsite(client.ServiceClient(), backups.ListOffsiteOpts{
+		InstanceID: "d8e6ca5a624745bcb546a227aa3ae1cfin01",
+	}).AllPages()
+	th.AssertNoErr(t, err)
+	list, err := backups.ExtractBackups(pages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(list))
+	th.AssertEquals(t, backups.StatusCompleted, list[0].Status)
+}
+
+func TestListOffsiteRestoreTimes(t *testing.T) {
+	th.SetupHTTP()
+	t.Cleanup(func() {
+		th.TeardownHTTP()
+	})
+	th.Mux.HandleFunc("/instances/d8e6ca5a624745bcb546a227aa3ae1cfin01/offsite-restore-time", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{"date": "2020-12-26"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, `{"restore_time": [{"start_time": 1532001446987, "end_time": 1532742139000}]}`)
+	})
+
+	times, err := backups.ListOffsiteRestoreTimes(client.ServiceClient(), "d8e6ca5a624745bcb546a227aa3ae1cfin01",
+		backups.ListRestoreTimesOpts{Date: "2020-12-26"}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(times))
+	th.AssertEquals(t, int64(1532001446987), times[0].StartTime)
+}
@@ -13,3 +13,27 @@ func backupURL(c *golangsdk.ServiceClient, id string) string {
 func resourceURL(c *golangsdk.ServiceClient, id string) string {
 	return c.ServiceURL("instances", id, "backups/policy")
 }
+
+func recoveryURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("instances", "recovery")
+}
+
+func restoreTablesURL(c *golangsdk.ServiceClient, instanceID string) string {
+	return c.ServiceURL("instances", instanceID, "restore", "tables")
+}
+
+func restoreTimeURL(c *golangsdk.ServiceClient, instanceID string) string {
+	return c.ServiceURL("instances", instanceID, "restore-time")
+}
+
+func offsitePolicyURL(c *golangsdk.ServiceClient, instanceID string) string {
+	return c.ServiceURL("instances", instanceID, "backups", "offsite-policy")
+}
+
+func offsiteBackupsURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("offsite-backups")
+}
+
+func offsiteRestoreTimeURL(c *golangsdk.ServiceClient, instanceID string) string {
+	return c.ServiceURL("instances", instanceID, "offsite-restore-time")
+}
@@ -139,3 +139,53 @@ func Apply(client *golangsdk.ServiceClient, id string, opts ApplyOptsBuilder) (r
 	})
 	return
 }
+
+// CompareOptsBuilder allows extensions to add additional parameters to the
+// Compare request.
+type CompareOptsBuilder interface {
+	ToConfigCompareMap() (map[string]interface{}, error)
+}
+
+// CompareOpts contains the IDs of the parameter templates to compare.
+type CompareOpts struct {
+	// Specifies the ID of the source parameter template.
+	SourceID string `json:"source_id" required:"true"`
+	// Specifies the ID of the parameter template to compare with.
+	TargetID string `json:"target_id" required:"true"`
+}
+
+// ToConfigCompareMap builds a compare request body from CompareOpts.
+func (opts CompareOpts) ToConfigCompareMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Compare is used to get the parameters which differ between two parameter templates
+// of the same database engine.
+func Compare(client *golangsdk.ServiceClient, opts CompareOptsBuilder) (r CompareResult) {
+	b, err := opts.ToConfigCompareMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(compareURL(client), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes:     []int{200},
+		MoreHeaders: openstack.StdRequestOpts().MoreHeaders,
+	})
+	return
+}
+
+// RestartRequired reports whether setting the given values on an instance using
+// the configuration requires the instance to be restarted to take effect.
+// The configuration is expected to be retrieved by GetForInstance.
+func RestartRequired(config *Configuration, values map[string]string) bool {
+	for _, param := range config.Parameters {
+		value, ok := values[param.Name]
+		if !ok || value == param.Value {
+			continue
+		}
+		if param.RestartRequired {
+			return true
+		}
+	}
+	return false
+}
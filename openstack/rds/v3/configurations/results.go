@@ -147,3 +147,27 @@ func (r ApplyResult) Extract() (*ApplyConfiguration, error) {
 type ApplyResult struct {
 	golangsdk.Result
 }
+
+type Difference struct {
+	// Indicates the parameter name.
+	Name string `json:"parameter_name"`
+	// Indicates the parameter value in the source parameter template.
+	SourceValue string `json:"source_value"`
+	// Indicates the parameter value in the target parameter template.
+	TargetValue string `json:"target_value"`
+}
+
+// Extract is a function that accepts a result and extracts the parameter differences.
+func (r CompareResult) Extract() ([]Difference, error) {
+	var a struct {
+		Differences []Difference `json:"differences"`
+	}
+	err := r.Result.ExtractInto(&a)
+	return a.Differences, err
+}
+
+// CompareResult represents the result of a compare operation. Call its Extract
+// method to interpret it as a list of Differences.
+type CompareResult struct {
+	golangsdk.Result
+}
@@ -0,0 +1,89 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const (
+	sourceID   = "463b4b58-d0e8-4e2b-9560-5dea4552fde9pr01"
+	targetID   = "cf49bbd9-8a0e-4a0e-9d7e-2b8f1b2b7e4dpr01"
+	instanceID = "dsfae23fsfdsae3435in01"
+)
+
+const expectedCompareRequest = `
+{
+  "source_id": "463b4b58-d0e8-4e2b-9560-5dea4552fde9pr01",
+  "target_id": "cf49bbd9-8a0e-4a0e-9d7e-2b8f1b2b7e4dpr01"
+}`
+
+const compareResponse = `
+{
+  "differences": [
+    {
+      "parameter_name": "max_connections",
+      "source_value": "10",
+      "target_value": "100"
+    }
+  ]
+}`
+
+const instanceConfigResponse = `
+{
+  "datastore_version_name": "8.0",
+  "datastore_name": "mysql",
+  "created": "2020-06-12T09:41:12+0000",
+  "updated": "2020-06-12T09:41:12+0000",
+  "configuration_parameters": [
+    {
+      "name": "max_connections",
+      "value": "10",
+      "restart_required": true,
+      "readonly": false,
+      "value_range": "10-100000",
+      "type": "integer",
+      "description": "Maximum number of connections."
+    },
+    {
+      "name": "autocommit",
+      "value": "ON",
+      "restart_required": false,
+      "readonly": false,
+      "value_range": "ON|OFF",
+      "type": "boolean",
+      "description": "Autocommit mode."
+    }
+  ]
+}`
+
+// HandleCompareSuccessfully creates an HTTP handler at `/configurations/comparison` on the test
+// handler mux that responds to a POST request with compareResponse.
+func HandleCompareSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/configurations/comparison", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, expectedCompareRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, compareResponse)
+	})
+}
+
+// HandleGetForInstanceSuccessfully creates an HTTP handler at
+// `/instances/{instance_id}/configurations` on the test handler mux that responds to a GET request
+// with instanceConfigResponse.
+func HandleGetForInstanceSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/instances/%s/configurations", instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, instanceConfigResponse)
+	})
+}
@@ -0,0 +1,40 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/rds/v3/configurations"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestCompare(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCompareSuccessfully(t)
+
+	opts := configurations.CompareOpts{SourceID: sourceID, TargetID: targetID}
+	diff, err := configurations.Compare(fake.ServiceClient(), opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(diff))
+	th.AssertEquals(t, "max_connections", diff[0].Name)
+	th.AssertEquals(t, "10", diff[0].SourceValue)
+	th.AssertEquals(t, "100", diff[0].TargetValue)
+}
+
+func TestRestartRequired(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetForInstanceSuccessfully(t)
+
+	config, err := configurations.GetForInstance(fake.ServiceClient(), instanceID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, len(config.Parameters))
+
+	th.AssertEquals(t, false, configurations.RestartRequired(config, map[string]string{"autocommit": "OFF"}))
+	th.AssertEquals(t, false, configurations.RestartRequired(config, map[string]string{"max_connections": "10"}))
+	th.AssertEquals(t, true, configurations.RestartRequired(config, map[string]string{
+		"autocommit":      "OFF",
+		"max_connections": "37",
+	}))
+}
@@ -17,3 +17,7 @@ func applyURL(c *golangsdk.ServiceClient, id string) string {
 func instanceConfigURL(c *golangsdk.ServiceClient, instanceID string) string {
 	return c.ServiceURL("instances", instanceID, "configurations")
 }
+
+func compareURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("configurations", "comparison")
+}
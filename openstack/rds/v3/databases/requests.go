@@ -0,0 +1,162 @@
+package databases
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
+)
+
+var RequestOpts = golangsdk.RequestOpts{
+	MoreHeaders: map[string]string{"Content-Type": "application/json", "X-Language": "en-us"},
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToDatabaseCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts contains all the values needed to create a new database.
+type CreateOpts struct {
+	// Database name
+	Name string `json:"name" required:"true"`
+	// Character set, e.g. `utf8` for MySQL or `UTF8` for PostgreSQL
+	CharacterSet string `json:"character_set,omitempty"`
+	// Database owner, PostgreSQL only
+	Owner string `json:"owner,omitempty"`
+	// Database template, PostgreSQL only
+	Template string `json:"template,omitempty"`
+	// Collation, PostgreSQL only
+	LcCollate string `json:"lc_collate,omitempty"`
+	// Character classification, PostgreSQL only
+	LcCtype string `json:"lc_ctype,omitempty"`
+}
+
+// ToDatabaseCreateMap builds a create request body from CreateOpts.
+func (opts CreateOpts) ToDatabaseCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Create will create a new database in the instance based on the values in CreateOpts.
+func Create(client *golangsdk.ServiceClient, instanceID string, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToDatabaseCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(createURL(client, instanceID), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes:     []int{200, 202},
+		MoreHeaders: RequestOpts.MoreHeaders,
+	})
+	return
+}
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToDatabaseListQuery() (string, error)
+}
+
+// ListOpts contains the paging parameters of the database list.
+type ListOpts struct {
+	// Number of the first page to fetch, starting from 1
+	Page int `q:"page,required"`
+	// Number of records per page, from 1 to 100
+	Limit int `q:"limit,required"`
+}
+
+// ToDatabaseListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToDatabaseListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), nil
+}
+
+// List returns a Pager which allows you to iterate over the databases of the instance,
+// starting from opts.Page and fetching opts.Limit databases per page.
+func List(client *golangsdk.ServiceClient, instanceID string, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client, instanceID)
+	if opts != nil {
+		q, err := opts.ToDatabaseListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += q
+	}
+
+	pager := pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return DatabasePage{pagination.LinkedPageBase{PageResult: r}}
+	})
+	pager.Headers = RequestOpts.MoreHeaders
+	return pager
+}
+
+// Delete will permanently delete a database of the instance.
+func Delete(client *golangsdk.ServiceClient, instanceID, dbName string) (r DeleteResult) {
+	_, r.Err = client.Delete(deleteURL(client, instanceID, dbName), &golangsdk.RequestOpts{
+		OkCodes:      []int{200, 202},
+		JSONResponse: &r.Body,
+		MoreHeaders:  RequestOpts.MoreHeaders,
+	})
+	return
+}
+
+// UserPrivilege describes the access a database user is granted.
+type UserPrivilege struct {
+	// User name
+	Name string `json:"name" required:"true"`
+	// Whether the user gets read-only access, ignored on revoke
+	Readonly bool `json:"readonly"`
+	// Schema the privilege applies to, PostgreSQL only
+	SchemaName string `json:"schema_name,omitempty"`
+}
+
+// PrivilegeOptsBuilder allows extensions to add additional parameters to the
+// GrantPrivileges and RevokePrivileges requests.
+type PrivilegeOptsBuilder interface {
+	ToPrivilegeMap() (map[string]interface{}, error)
+}
+
+// PrivilegeOpts contains the users which are granted or revoked access to a database.
+type PrivilegeOpts struct {
+	// Database name
+	DbName string `json:"db_name" required:"true"`
+	// Users to grant or revoke the privileges
+	Users []UserPrivilege `json:"users" required:"true"`
+}
+
+// ToPrivilegeMap builds a request body from PrivilegeOpts.
+func (opts PrivilegeOpts) ToPrivilegeMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// GrantPrivileges grants the users access to the database.
+func GrantPrivileges(client *golangsdk.ServiceClient, instanceID string, opts PrivilegeOptsBuilder) (r PrivilegeResult) {
+	b, err := opts.ToPrivilegeMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(privilegeURL(client, instanceID), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes:     []int{200, 202},
+		MoreHeaders: RequestOpts.MoreHeaders,
+	})
+	return
+}
+
+// RevokePrivileges revokes the access of the users to the database.
+func RevokePrivileges(client *golangsdk.ServiceClient, instanceID string, opts PrivilegeOptsBuilder) (r PrivilegeResult) {
+	b, err := opts.ToPrivilegeMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Request("DELETE", privilegeURL(client, instanceID), &golangsdk.RequestOpts{
+		JSONBody:     b,
+		JSONResponse: &r.Body,
+		OkCodes:      []int{200, 202},
+		MoreHeaders:  RequestOpts.MoreHeaders,
+	})
+	return
+}
@@ -0,0 +1,67 @@
+package databases
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
+)
+
+type Database struct {
+	// Database name
+	Name string `json:"name"`
+	// Character set
+	CharacterSet string `json:"character_set"`
+	// Database owner, PostgreSQL only
+	Owner string `json:"owner"`
+	// Collation, PostgreSQL only
+	LcCollate string `json:"lc_collate"`
+	// Character classification, PostgreSQL only
+	LcCtype string `json:"lc_ctype"`
+}
+
+// CreateResult represents the result of a create operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type CreateResult struct {
+	golangsdk.ErrResult
+}
+
+// DeleteResult represents the result of a delete operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	golangsdk.ErrResult
+}
+
+// PrivilegeResult represents the result of a grant or revoke operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type PrivilegeResult struct {
+	golangsdk.ErrResult
+}
+
+type DatabasePage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL advances the `page` query parameter until all total_count databases are fetched.
+func (r DatabasePage) NextPageURL() (string, error) {
+	var s struct {
+		TotalCount int `json:"total_count"`
+	}
+	if err := r.ExtractInto(&s); err != nil {
+		return "", err
+	}
+
+	return r.WrapNextPageNumberURL(s.TotalCount)
+}
+
+// IsEmpty determines whether or not a DatabasePage is empty.
+func (r DatabasePage) IsEmpty() (bool, error) {
+	databases, err := ExtractDatabases(r)
+	return len(databases) == 0, err
+}
+
+// ExtractDatabases interprets the results of a single page from
+// a List() API call, producing a slice of []Database structures.
+func ExtractDatabases(r pagination.Page) ([]Database, error) {
+	var s []Database
+	err := (r.(DatabasePage)).ExtractIntoSlicePtr(&s, "databases")
+	return s, err
+}
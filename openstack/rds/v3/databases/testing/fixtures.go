@@ -0,0 +1,126 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const instanceID = "dsfae23fsfdsae3435in01"
+
+const expectedCreateRequest = `
+{
+  "name": "rds-test",
+  "character_set": "utf8"
+}`
+
+const expectedPrivilegeRequest = `
+{
+  "db_name": "rds-test",
+  "users": [
+    {
+      "name": "rds-user",
+      "readonly": true
+    }
+  ]
+}`
+
+const listResponse = `
+{
+  "databases": [
+    {
+      "name": "rds-test",
+      "character_set": "utf8"
+    }
+  ],
+  "total_count": 1
+}`
+
+const listPageResponse = `
+{
+  "databases": [
+    {
+      "name": "%s",
+      "character_set": "utf8"
+    }
+  ],
+  "total_count": 2
+}`
+
+const successResponse = `{"resp": "successful"}`
+
+// HandleCreateSuccessfully creates an HTTP handler at `/instances/{instance_id}/database` on the
+// test handler mux that responds to a POST request with successResponse.
+func HandleCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/instances/%s/database", instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, expectedCreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = fmt.Fprint(w, successResponse)
+	})
+}
+
+// HandleListSuccessfully creates an HTTP handler at `/instances/{instance_id}/database/detail` on
+// the test handler mux that responds to a GET request with listResponse.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/instances/%s/database/detail", instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"page": "1", "limit": "10"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, listResponse)
+	})
+}
+
+// HandleListPagesSuccessfully creates an HTTP handler at `/instances/{instance_id}/database/detail`
+// on the test handler mux that responds to a GET request with listPageResponse.
+func HandleListPagesSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/instances/%s/database/detail", instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		switch page := r.URL.Query().Get("page"); page {
+		case "1", "2":
+			_, _ = fmt.Fprintf(w, listPageResponse, "rds-test-"+page)
+		default:
+			t.Errorf("unexpected page: %s", page)
+		}
+	})
+}
+
+// HandleDeleteSuccessfully creates an HTTP handler at `/instances/{instance_id}/database/rds-test`
+// on the test handler mux that responds to a DELETE request with successResponse.
+func HandleDeleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/instances/%s/database/rds-test", instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = fmt.Fprint(w, successResponse)
+	})
+}
+
+// HandlePrivilegeSuccessfully creates an HTTP handler at `/instances/{instance_id}/db_privilege` on
+// the test handler mux that responds to a request of the given method with successResponse.
+func HandlePrivilegeSuccessfully(t *testing.T, method string) {
+	th.Mux.HandleFunc(fmt.Sprintf("/instances/%s/db_privilege", instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, method)
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, expectedPrivilegeRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, successResponse)
+	})
+}
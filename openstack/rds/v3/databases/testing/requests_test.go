@@ -0,0 +1,77 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/rds/v3/databases"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+var privilegeOpts = databases.PrivilegeOpts{
+	DbName: "rds-test",
+	Users: []databases.UserPrivilege{
+		{Name: "rds-user", Readonly: true},
+	},
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSuccessfully(t)
+
+	opts := databases.CreateOpts{Name: "rds-test", CharacterSet: "utf8"}
+	th.AssertNoErr(t, databases.Create(fake.ServiceClient(), instanceID, opts).ExtractErr())
+}
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	pages, err := databases.List(fake.ServiceClient(), instanceID, databases.ListOpts{Page: 1, Limit: 10}).AllPages()
+	th.AssertNoErr(t, err)
+	list, err := databases.ExtractDatabases(pages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(list))
+	th.AssertEquals(t, "rds-test", list[0].Name)
+	th.AssertEquals(t, "utf8", list[0].CharacterSet)
+}
+
+func TestListAllPages(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListPagesSuccessfully(t)
+
+	pages, err := databases.List(fake.ServiceClient(), instanceID, databases.ListOpts{Page: 1, Limit: 1}).AllPages()
+	th.AssertNoErr(t, err)
+	list, err := databases.ExtractDatabases(pages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, len(list))
+	th.AssertEquals(t, "rds-test-1", list[0].Name)
+	th.AssertEquals(t, "rds-test-2", list[1].Name)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteSuccessfully(t)
+
+	th.AssertNoErr(t, databases.Delete(fake.ServiceClient(), instanceID, "rds-test").ExtractErr())
+}
+
+func TestGrantPrivileges(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandlePrivilegeSuccessfully(t, "POST")
+
+	th.AssertNoErr(t, databases.GrantPrivileges(fake.ServiceClient(), instanceID, privilegeOpts).ExtractErr())
+}
+
+func TestRevokePrivileges(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandlePrivilegeSuccessfully(t, "DELETE")
+
+	th.AssertNoErr(t, databases.RevokePrivileges(fake.ServiceClient(), instanceID, privilegeOpts).ExtractErr())
+}
@@ -0,0 +1,19 @@
+package databases
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+func createURL(c *golangsdk.ServiceClient, instanceID string) string {
+	return c.ServiceURL("instances", instanceID, "database")
+}
+
+func listURL(c *golangsdk.ServiceClient, instanceID string) string {
+	return c.ServiceURL("instances", instanceID, "database", "detail")
+}
+
+func deleteURL(c *golangsdk.ServiceClient, instanceID, dbName string) string {
+	return c.ServiceURL("instances", instanceID, "database", dbName)
+}
+
+func privilegeURL(c *golangsdk.ServiceClient, instanceID string) string {
+	return c.ServiceURL("instances", instanceID, "db_privilege")
+}
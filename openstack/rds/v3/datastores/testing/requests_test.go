@@ -0,0 +1,45 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/rds/v3/datastores"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const listResponse = `
+{
+  "dataStores": [
+    {
+      "id": "87620726-6699-46e1-9a4f-9e3dbb6a4cf5",
+      "name": "5.7"
+    },
+    {
+      "id": "e8a8b8cc-63f8-4fb5-8d4a-24c502317a61",
+      "name": "8.0"
+    }
+  ]
+}`
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	th.Mux.HandleFunc("/datastores/MySQL", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, listResponse)
+	})
+
+	pages, err := datastores.List(fake.ServiceClient(), "MySQL").AllPages()
+	th.AssertNoErr(t, err)
+	list, err := datastores.ExtractDataStores(pages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, len(list.DataStores))
+	th.AssertEquals(t, "8.0", list.DataStores[1].Name)
+}
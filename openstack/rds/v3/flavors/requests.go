@@ -39,3 +39,39 @@ func List(client *golangsdk.ServiceClient, opts ListOptsBuilder, dbName string)
 	pagerRDS.Headers = map[string]string{"Content-Type": "application/json"}
 	return pagerRDS
 }
+
+type StorageTypeOpts struct {
+	VersionName string `q:"version_name"`
+	// Instance mode, `single`, `ha` or `replica`
+	HaMode string `q:"ha_mode"`
+}
+
+type StorageTypeOptsBuilder interface {
+	ToStorageTypeQuery() (string, error)
+}
+
+func (opts StorageTypeOpts) ToStorageTypeQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), err
+}
+
+// ListStorageTypes returns the storage types available for the DB engine version.
+func ListStorageTypes(client *golangsdk.ServiceClient, opts StorageTypeOptsBuilder, dbName string) (r StorageTypesResult) {
+	url := storageTypeURL(client, dbName)
+	if opts != nil {
+		query, err := opts.ToStorageTypeQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += query
+	}
+
+	_, r.Err = client.Get(url, &r.Body, &golangsdk.RequestOpts{
+		MoreHeaders: map[string]string{"Content-Type": "application/json"},
+	})
+	return
+}
@@ -1,15 +1,19 @@
 package flavors
 
 import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
 	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
 )
 
 type Flavor struct {
+	ID           string            `json:"id"`
 	VCPUs        string            `json:"vcpus"`
 	RAM          int               `json:"ram"`
 	SpecCode     string            `json:"spec_code"`
 	InstanceMode string            `json:"instance_mode"`
+	VersionName  []string          `json:"version_name"`
 	AzStatus     map[string]string `json:"az_status"`
+	AzDesc       map[string]string `json:"az_desc"`
 }
 
 type DbFlavorsPage struct {
@@ -32,3 +36,23 @@ func ExtractDbFlavors(r pagination.Page) ([]Flavor, error) {
 	}
 	return s, nil
 }
+
+type StorageType struct {
+	// Storage type, e.g. `COMMON`, `ULTRAHIGH` or `CLOUDSSD`
+	Name string `json:"name"`
+	// Status of the storage type in each AZ, `normal` or `unsupported`
+	AzStatus map[string]string `json:"az_status"`
+}
+
+type StorageTypesResult struct {
+	golangsdk.Result
+}
+
+func (r StorageTypesResult) Extract() ([]StorageType, error) {
+	var s []StorageType
+	err := r.ExtractIntoSlicePtr(&s, "storage_type")
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
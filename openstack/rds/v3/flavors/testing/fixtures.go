@@ -0,0 +1,91 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const listResponse = `
+{
+  "flavors": [
+    {
+      "id": "5b3c2b2e-4f2c-4c8b-9a57-2b6d9ff0c3b1",
+      "vcpus": "1",
+      "ram": 2,
+      "spec_code": "rds.mysql.c2.medium",
+      "instance_mode": "single",
+      "version_name": ["5.7", "8.0"],
+      "az_status": {
+        "eu-de-01": "normal",
+        "eu-de-02": "unsupported"
+      },
+      "az_desc": {
+        "eu-de-01": "eu-de-01"
+      }
+    },
+    {
+      "id": "d1e6f6a1-1e43-4a3d-9d3b-5d6c8ba4bb2e",
+      "vcpus": "1",
+      "ram": 2,
+      "spec_code": "rds.mysql.c2.medium.ha",
+      "instance_mode": "ha",
+      "version_name": ["5.7", "8.0"],
+      "az_status": {
+        "eu-de-01": "normal",
+        "eu-de-02": "normal"
+      }
+    }
+  ]
+}`
+
+const storageTypesResponse = `
+{
+  "storage_type": [
+    {
+      "name": "COMMON",
+      "az_status": {
+        "eu-de-01": "normal",
+        "eu-de-02": "normal"
+      }
+    },
+    {
+      "name": "ULTRAHIGH",
+      "az_status": {
+        "eu-de-01": "normal",
+        "eu-de-02": "unsupported"
+      }
+    }
+  ]
+}`
+
+// HandleListSuccessfully creates an HTTP handler at `/flavors/MySQL` on the test handler mux that
+// responds to a GET request with listResponse.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/flavors/MySQL", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"version_name": "8.0"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, listResponse)
+	})
+}
+
+// HandleListStorageTypesSuccessfully creates an HTTP handler at `/storage-type/MySQL` on the test
+// handler mux that responds to a GET request with storageTypesResponse.
+func HandleListStorageTypesSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/storage-type/MySQL", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"version_name": "8.0", "ha_mode": "ha"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, storageTypesResponse)
+	})
+}
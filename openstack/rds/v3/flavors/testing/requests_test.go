@@ -0,0 +1,37 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/rds/v3/flavors"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	pages, err := flavors.List(fake.ServiceClient(), flavors.ListOpts{VersionName: "8.0"}, "MySQL").AllPages()
+	th.AssertNoErr(t, err)
+	list, err := flavors.ExtractDbFlavors(pages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, len(list))
+	th.AssertEquals(t, "ha", list[1].InstanceMode)
+	th.AssertDeepEquals(t, []string{"5.7", "8.0"}, list[1].VersionName)
+	th.AssertEquals(t, "unsupported", list[0].AzStatus["eu-de-02"])
+}
+
+func TestListStorageTypes(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListStorageTypesSuccessfully(t)
+
+	opts := flavors.StorageTypeOpts{VersionName: "8.0", HaMode: "ha"}
+	types, err := flavors.ListStorageTypes(fake.ServiceClient(), opts, "MySQL").Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, len(types))
+	th.AssertEquals(t, "ULTRAHIGH", types[1].Name)
+	th.AssertEquals(t, "unsupported", types[1].AzStatus["eu-de-02"])
+}
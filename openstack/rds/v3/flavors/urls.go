@@ -7,3 +7,7 @@ const rootPath = "flavors"
 func listURL(client *golangsdk.ServiceClient, dbName string) string {
 	return client.ServiceURL(rootPath, dbName)
 }
+
+func storageTypeURL(client *golangsdk.ServiceClient, dbName string) string {
+	return client.ServiceURL("storage-type", dbName)
+}
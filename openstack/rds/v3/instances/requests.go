@@ -6,24 +6,26 @@ import (
 )
 
 type CreateRdsOpts struct {
-	Name                string          `json:"name"  required:"true"`
-	Datastore           *Datastore      `json:"datastore" required:"true"`
-	Ha                  *Ha             `json:"ha,omitempty"`
-	ConfigurationId     string          `json:"configuration_id,omitempty"`
-	Port                string          `json:"port,omitempty"`
-	Password            string          `json:"password" required:"true"`
-	BackupStrategy      *BackupStrategy `json:"backup_strategy,omitempty"`
-	EnterpriseProjectId string          `json:"enterprise_project_id,omitempty"`
-	DiskEncryptionId    string          `json:"disk_encryption_id,omitempty"`
-	FlavorRef           string          `json:"flavor_ref" required:"true"`
-	Volume              *Volume         `json:"volume" required:"true"`
-	Region              string          `json:"region" required:"true"`
-	AvailabilityZone    string          `json:"availability_zone" required:"true"`
-	VpcId               string          `json:"vpc_id" required:"true"`
-	SubnetId            string          `json:"subnet_id" required:"true"`
-	SecurityGroupId     string          `json:"security_group_id" required:"true"`
-	ChargeInfo          *ChargeInfo     `json:"charge_info,omitempty"`
-	TimeZone            string          `json:"time_zone,omitempty"`
+	Name                string             `json:"name"  required:"true"`
+	Datastore           *Datastore         `json:"datastore" required:"true"`
+	Ha                  *Ha                `json:"ha,omitempty"`
+	ConfigurationId     string             `json:"configuration_id,omitempty"`
+	Port                string             `json:"port,omitempty"`
+	Password            string             `json:"password" required:"true"`
+	BackupStrategy      *BackupStrategy    `json:"backup_strategy,omitempty"`
+	EnterpriseProjectId string             `json:"enterprise_project_id,omitempty"`
+	DiskEncryptionId    string             `json:"disk_encryption_id,omitempty"`
+	FlavorRef           string             `json:"flavor_ref" required:"true"`
+	Volume              *Volume            `json:"volume" required:"true"`
+	Region              string             `json:"region" required:"true"`
+	AvailabilityZone    string             `json:"availability_zone" required:"true"`
+	VpcId               string             `json:"vpc_id" required:"true"`
+	SubnetId            string             `json:"subnet_id" required:"true"`
+	SecurityGroupId     string             `json:"security_group_id" required:"true"`
+	ChargeInfo          *ChargeInfo        `json:"charge_info,omitempty"`
+	TimeZone            string             `json:"time_zone,omitempty"`
+	DataVip             string             `json:"data_vip,omitempty"`
+	UnchangeableParam   *UnchangeableParam `json:"unchangeable_param,omitempty"`
 }
 
 type CreateReplicaOpts struct {
@@ -58,6 +60,11 @@ type Volume struct {
 	Size int    `json:"size,omitempty"`
 }
 
+// UnchangeableParam contains the MySQL parameters which can't be changed after the instance is created.
+type UnchangeableParam struct {
+	LowerCaseTableNames string `json:"lower_case_table_names,omitempty"`
+}
+
 type ChargeInfo struct {
 	ChargeMode  string `json:"charge_mode" required:"true"`
 	PeriodType  string `json:"period_type,omitempty"`
@@ -163,7 +170,7 @@ func Restart(client *golangsdk.ServiceClient, opts RestartRdsInstanceBuilder, in
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(restartURL(client, instanceId), b, &r.Body, &golangsdk.RequestOpts{
+	_, r.Err = client.Post(actionURL(client, instanceId), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{202},
 	})
 	return
@@ -239,7 +246,42 @@ func SingleToHa(client *golangsdk.ServiceClient, opts SingleToRdsHaBuilder, inst
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(singletohaURL(client, instanceId), b, &r.Body, &golangsdk.RequestOpts{
+	_, r.Err = client.Post(actionURL(client, instanceId), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{202},
+	})
+
+	return
+}
+
+type PromoteReplicaOpts struct {
+	PromoteToPrimary *PromoteToPrimary `json:"promote_to_primary" required:"true"`
+}
+
+type PromoteToPrimary struct {
+	// Whether to promote the replica even when its replication delay is not zero
+	Force bool `json:"force,omitempty"`
+}
+
+type PromoteReplicaBuilder interface {
+	ToPromoteReplicaMap() (map[string]interface{}, error)
+}
+
+func (opts PromoteReplicaOpts) ToPromoteReplicaMap() (map[string]interface{}, error) {
+	b, err := golangsdk.BuildRequestBody(&opts, "")
+	if err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// PromoteReplica detaches a read replica from its primary instance and turns it into a standalone instance.
+func PromoteReplica(client *golangsdk.ServiceClient, opts PromoteReplicaBuilder, instanceId string) (r PromoteReplicaResult) {
+	b, err := opts.ToPromoteReplicaMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(actionURL(client, instanceId), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{202},
 	})
 
@@ -248,6 +290,8 @@ func SingleToHa(client *golangsdk.ServiceClient, opts SingleToRdsHaBuilder, inst
 
 type SpecCode struct {
 	Speccode string `json:"spec_code" required:"true"`
+	// Whether the order is paid automatically, for yearly/monthly instances only
+	IsAutoPay bool `json:"is_auto_pay,omitempty"`
 }
 
 type ResizeFlavorOpts struct {
@@ -272,7 +316,7 @@ func Resize(client *golangsdk.ServiceClient, opts ResizeFlavorBuilder, instanceI
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(resizeURL(client, instanceId), b, &r.Body, &golangsdk.RequestOpts{
+	_, r.Err = client.Post(actionURL(client, instanceId), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{202},
 	})
 
@@ -285,6 +329,8 @@ type EnlargeVolumeRdsOpts struct {
 
 type EnlargeVolumeSize struct {
 	Size int `json:"size" required:"true"`
+	// Whether the order is paid automatically, for yearly/monthly instances only
+	IsAutoPay bool `json:"is_auto_pay,omitempty"`
 }
 
 type EnlargeVolumeBuilder interface {
@@ -305,13 +351,51 @@ func EnlargeVolume(client *golangsdk.ServiceClient, opts EnlargeVolumeBuilder, i
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(enlargeURL(client, instanceId), b, &r.Body, &golangsdk.RequestOpts{
+	_, r.Err = client.Post(actionURL(client, instanceId), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{202},
 	})
 
 	return
 }
 
+type AutoExpansionOpts struct {
+	// Whether storage autoscaling is enabled
+	SwitchOption *bool `json:"switch_option" required:"true"`
+	// Upper limit of the storage autoscaling in GB, required when autoscaling is enabled
+	LimitSize int `json:"limit_size,omitempty"`
+	// Free storage space percentage which triggers the autoscaling: 10, 15 or 20
+	TriggerThreshold int `json:"trigger_threshold,omitempty"`
+}
+
+type AutoExpansionBuilder interface {
+	ToAutoExpansionMap() (map[string]interface{}, error)
+}
+
+func (opts AutoExpansionOpts) ToAutoExpansionMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// UpdateAutoExpansion configures the storage autoscaling policy of the instance.
+func UpdateAutoExpansion(client *golangsdk.ServiceClient, opts AutoExpansionBuilder, instanceID string) (r UpdateAutoExpansionResult) {
+	b, err := opts.ToAutoExpansionMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(autoExpansionURL(client, instanceID), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// GetAutoExpansion returns the storage autoscaling policy of the instance.
+func GetAutoExpansion(client *golangsdk.ServiceClient, instanceID string) (r GetAutoExpansionResult) {
+	_, r.Err = client.Get(autoExpansionURL(client, instanceID), &r.Body, &golangsdk.RequestOpts{
+		MoreHeaders: map[string]string{"Content-Type": "application/json"},
+	})
+	return
+}
+
 type DbErrorlogOpts struct {
 	StartDate string `q:"start_date"`
 	EndDate   string `q:"end_date"`
@@ -358,6 +442,8 @@ type DbSlowLogOpts struct {
 	Offset    string `q:"offset"`
 	Limit     string `q:"limit"`
 	Level     string `q:"level"`
+	// Statement type, e.g. `SELECT`, `INSERT`, `UPDATE`, `DELETE` or `CREATE`
+	Type string `q:"type"`
 }
 
 type DbSlowLogBuilder interface {
@@ -384,7 +470,7 @@ func ListSlowLog(client *golangsdk.ServiceClient, opts DbSlowLogBuilder, instanc
 	}
 
 	pageRdsList := pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
-		return ErrorLogPage{pagination.SinglePageBase(r)}
+		return SlowLogPage{pagination.SinglePageBase(r)}
 	})
 
 	rdsheader := map[string]string{"Content-Type": "application/json"}
@@ -392,6 +478,207 @@ func ListSlowLog(client *golangsdk.ServiceClient, opts DbSlowLogBuilder, instanc
 	return pageRdsList
 }
 
+type SlowLogStatisticsOpts struct {
+	StartDate string `q:"start_date,required"`
+	EndDate   string `q:"end_date,required"`
+	CurPage   int    `q:"cur_page,required"`
+	PerPage   int    `q:"per_page,required"`
+	// Statement type, e.g. `SELECT`, `INSERT`, `UPDATE`, `DELETE` or `CREATE`
+	Type string `q:"type,required"`
+	// Sort order by execution count, `executeTime` sorts by execution time instead
+	Sort string `q:"sort"`
+}
+
+type SlowLogStatisticsBuilder interface {
+	ToSlowLogStatisticsQuery() (string, error)
+}
+
+func (opts SlowLogStatisticsOpts) ToSlowLogStatisticsQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), err
+}
+
+// ListSlowLogStatistics returns the slow queries of the instance aggregated by statement.
+func ListSlowLogStatistics(client *golangsdk.ServiceClient, opts SlowLogStatisticsBuilder, instanceID string) (r SlowLogStatisticsResult) {
+	query, err := opts.ToSlowLogStatisticsQuery()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Get(slowlogStatisticsURL(client, instanceID)+query, &r.Body, &golangsdk.RequestOpts{
+		MoreHeaders: map[string]string{"Content-Type": "application/json"},
+	})
+	return
+}
+
+type SlowLogDownloadOpts struct {
+	// Name of the file to download, all files are returned if empty
+	FileName string `json:"file_name,omitempty"`
+}
+
+type SlowLogDownloadBuilder interface {
+	ToSlowLogDownloadMap() (map[string]interface{}, error)
+}
+
+func (opts SlowLogDownloadOpts) ToSlowLogDownloadMap() (map[string]interface{}, error) {
+	b, err := golangsdk.BuildRequestBody(&opts, "")
+	if err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// GetSlowLogDownloadLinks generates download links for the slow log files of a MySQL instance.
+// The links are valid for a limited time only.
+func GetSlowLogDownloadLinks(client *golangsdk.ServiceClient, opts SlowLogDownloadBuilder, instanceID string) (r SlowLogDownloadResult) {
+	b, err := opts.ToSlowLogDownloadMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(slowlogDownloadURL(client, instanceID), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+type UpdatePortOpts struct {
+	// New database port, from 1024 to 65535
+	Port int `json:"port" required:"true"`
+}
+
+type UpdatePortBuilder interface {
+	ToUpdatePortMap() (map[string]interface{}, error)
+}
+
+func (opts UpdatePortOpts) ToUpdatePortMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// UpdatePort changes the database port of the instance. The instance is restarted.
+func UpdatePort(client *golangsdk.ServiceClient, opts UpdatePortBuilder, instanceID string) (r WorkflowResult) {
+	b, err := opts.ToUpdatePortMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(portURL(client, instanceID), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return
+}
+
+type AttachEipOpts struct {
+	// EIP address, required when binding
+	PublicIp string `json:"public_ip,omitempty"`
+	// EIP ID, required when binding
+	PublicIpId string `json:"public_ip_id,omitempty"`
+	// Whether to bind or unbind the EIP
+	IsBind *bool `json:"is_bind" required:"true"`
+}
+
+type AttachEipBuilder interface {
+	ToAttachEipMap() (map[string]interface{}, error)
+}
+
+func (opts AttachEipOpts) ToAttachEipMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// AttachEip binds an EIP to the instance or unbinds it, enabling or disabling public access.
+func AttachEip(client *golangsdk.ServiceClient, opts AttachEipBuilder, instanceID string) (r AttachEipResult) {
+	b, err := opts.ToAttachEipMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(publicIpURL(client, instanceID), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return
+}
+
+type UpdateSecurityGroupOpts struct {
+	SecurityGroupId string `json:"security_group_id" required:"true"`
+}
+
+type UpdateSecurityGroupBuilder interface {
+	ToUpdateSecurityGroupMap() (map[string]interface{}, error)
+}
+
+func (opts UpdateSecurityGroupOpts) ToUpdateSecurityGroupMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// UpdateSecurityGroup changes the security group the instance belongs to.
+func UpdateSecurityGroup(client *golangsdk.ServiceClient, opts UpdateSecurityGroupBuilder, instanceID string) (r WorkflowResult) {
+	b, err := opts.ToUpdateSecurityGroupMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(securityGroupURL(client, instanceID), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return
+}
+
+type UpdateSSLOpts struct {
+	SSLOption *bool `json:"ssl_option" required:"true"`
+}
+
+type UpdateSSLBuilder interface {
+	ToUpdateSSLMap() (map[string]interface{}, error)
+}
+
+func (opts UpdateSSLOpts) ToUpdateSSLMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// UpdateSSL enables or disables SSL for the MySQL instance. The instance is restarted.
+func UpdateSSL(client *golangsdk.ServiceClient, opts UpdateSSLBuilder, instanceID string) (r UpdateSSLResult) {
+	b, err := opts.ToUpdateSSLMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(sslURL(client, instanceID), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return
+}
+
+type UpdateOpsWindowOpts struct {
+	// Start time in UTC, `HH:MM` format with minutes being 00
+	StartTime string `json:"start_time" required:"true"`
+	// End time in UTC, `HH:MM` format with minutes being 00
+	EndTime string `json:"end_time" required:"true"`
+}
+
+type UpdateOpsWindowBuilder interface {
+	ToUpdateOpsWindowMap() (map[string]interface{}, error)
+}
+
+func (opts UpdateOpsWindowOpts) ToUpdateOpsWindowMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// UpdateOpsWindow changes the maintenance window of the instance.
+func UpdateOpsWindow(client *golangsdk.ServiceClient, opts UpdateOpsWindowBuilder, instanceID string) (r UpdateOpsWindowResult) {
+	b, err := opts.ToUpdateOpsWindowMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(opsWindowURL(client, instanceID), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return
+}
+
 type UpdateInstanceConfigurationOptsBuilder interface {
 	ToUpdateInstanceConfigurationMap() (map[string]interface{}, error)
 }
@@ -415,3 +702,115 @@ func UpdateInstanceConfigurationParameters(client *golangsdk.ServiceClient, inst
 	})
 	return
 }
+
+type UpgradeMinorVersionOpts struct {
+	// Whether to upgrade during the maintenance window instead of immediately
+	IsDelayed *bool `json:"is_delayed,omitempty"`
+}
+
+type UpgradeMinorVersionBuilder interface {
+	ToUpgradeMinorVersionMap() (map[string]interface{}, error)
+}
+
+func (opts UpgradeMinorVersionOpts) ToUpgradeMinorVersionMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// UpgradeMinorVersion upgrades the minor version of the DB engine. The instance is restarted.
+func UpgradeMinorVersion(client *golangsdk.ServiceClient, opts UpgradeMinorVersionBuilder, instanceID string) (r UpgradeVersionResult) {
+	b, err := opts.ToUpgradeMinorVersionMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(minorUpgradeURL(client, instanceID), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return
+}
+
+// ListAvailableVersions returns the major versions the PostgreSQL instance can be upgraded to.
+func ListAvailableVersions(client *golangsdk.ServiceClient, instanceID string) (r AvailableVersionsResult) {
+	_, r.Err = client.Get(majorVersionURL(client, instanceID, "available-version"), &r.Body, nil)
+	return
+}
+
+type MajorVersionOpts struct {
+	// Target major version, see ListAvailableVersions
+	TargetVersion string `json:"target_version" required:"true"`
+	// Whether the private IP is switched to the upgraded instance, for major version upgrade only
+	IsChangePrivateIp *bool `json:"is_change_private_ip,omitempty"`
+	// When the statistics are collected: `before_change_private_ip` or `after_change_private_ip`,
+	// for major version upgrade only
+	StatisticsCollectionMode string `json:"statistics_collection_mode,omitempty"`
+}
+
+type MajorVersionBuilder interface {
+	ToMajorVersionMap() (map[string]interface{}, error)
+}
+
+func (opts MajorVersionOpts) ToMajorVersionMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// CheckMajorVersionUpgrade runs the pre-check of the major version upgrade.
+// Use ListUpgradeReports with `upgrade_pre_check` type to get the result.
+func CheckMajorVersionUpgrade(client *golangsdk.ServiceClient, opts MajorVersionBuilder, instanceID string) (r UpgradeCheckResult) {
+	b, err := opts.ToMajorVersionMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(majorVersionURL(client, instanceID, "upgrade-check"), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return
+}
+
+// UpgradeMajorVersion upgrades the major version of the PostgreSQL instance.
+// The pre-check must pass first, see CheckMajorVersionUpgrade.
+func UpgradeMajorVersion(client *golangsdk.ServiceClient, opts MajorVersionBuilder, instanceID string) (r UpgradeVersionResult) {
+	b, err := opts.ToMajorVersionMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(majorVersionURL(client, instanceID, "upgrade"), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return
+}
+
+type ListUpgradeReportsOpts struct {
+	// Report type: `upgrade_pre_check` or `upgrade`
+	ReportType string `q:"report_type,required"`
+	// Start time in `yyyy-mm-ddThh:mm:ssZ` format
+	StartTime string `q:"start_time"`
+	// End time in `yyyy-mm-ddThh:mm:ssZ` format
+	EndTime string `q:"end_time"`
+	Offset  int    `q:"offset"`
+	Limit   int    `q:"limit"`
+}
+
+type ListUpgradeReportsBuilder interface {
+	ToListUpgradeReportsQuery() (string, error)
+}
+
+func (opts ListUpgradeReportsOpts) ToListUpgradeReportsQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), err
+}
+
+// ListUpgradeReports returns the pre-check or upgrade reports of the major version upgrade.
+func ListUpgradeReports(client *golangsdk.ServiceClient, opts ListUpgradeReportsBuilder, instanceID string) (r UpgradeReportsResult) {
+	query, err := opts.ToListUpgradeReportsQuery()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Get(majorVersionURL(client, instanceID, "reports")+query, &r.Body, nil)
+	return
+}
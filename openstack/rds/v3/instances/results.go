@@ -26,6 +26,10 @@ type ResizeFlavorResult struct {
 	commonResult
 }
 
+type PromoteReplicaResult struct {
+	commonResult
+}
+
 type Instance struct {
 	Id                  string         `json:"id"`
 	Name                string         `json:"name"`
@@ -75,6 +79,12 @@ func (r SingleToHaRdsInstanceResult) Extract() (*SingleToHaResponse, error) {
 	return &response, err
 }
 
+func (r PromoteReplicaResult) Extract() (*PromoteReplicaResponse, error) {
+	var response PromoteReplicaResponse
+	err := r.ExtractInto(&response)
+	return &response, err
+}
+
 func (r ResizeFlavorResult) Extract() (*ResizeFlavor, error) {
 	var response ResizeFlavor
 	err := r.ExtractInto(&response)
@@ -91,6 +101,26 @@ func (r EnlargeVolumeResult) Extract() (*EnlargeVolumeResp, error) {
 	return &response, err
 }
 
+type AutoExpansion struct {
+	SwitchOption     bool `json:"switch_option"`
+	LimitSize        int  `json:"limit_size"`
+	TriggerThreshold int  `json:"trigger_threshold"`
+}
+
+type UpdateAutoExpansionResult struct {
+	golangsdk.ErrResult
+}
+
+type GetAutoExpansionResult struct {
+	golangsdk.Result
+}
+
+func (r GetAutoExpansionResult) Extract() (*AutoExpansion, error) {
+	var response AutoExpansion
+	err := r.ExtractInto(&response)
+	return &response, err
+}
+
 type ListRdsResult struct {
 	commonResult
 }
@@ -127,6 +157,7 @@ type RdsInstanceResponse struct {
 	DiskEncryptionId    string            `json:"disk_encryption_id"`
 	EnterpriseProjectId string            `json:"enterprise_project_id"`
 	TimeZone            string            `json:"time_zone"`
+	ChargeInfo          ChargeInfo        `json:"charge_info"`
 
 	Tags []tags.ResourceTag `json:"tags"`
 }
@@ -234,6 +265,92 @@ func ExtractSlowLog(r pagination.Page) (SlowLogResp, error) {
 	return s, err
 }
 
+type SlowLogStatistics struct {
+	PageNumber  int                `json:"pageNumber"`
+	PageRecord  int                `json:"pageRecord"`
+	SlowLogList []SlowLogStatistic `json:"slowLogList"`
+	TotalRecord int                `json:"totalRecord"`
+	StartTime   int64              `json:"startTime"`
+	EndTime     int64              `json:"endTime"`
+}
+
+type SlowLogStatistic struct {
+	Count        string `json:"count"`
+	Time         string `json:"time"`
+	LockTime     string `json:"lockTime"`
+	RowsSent     string `json:"rowsSent"`
+	RowsExamined string `json:"rowsExamined"`
+	Database     string `json:"database"`
+	Users        string `json:"users"`
+	QuerySample  string `json:"querySample"`
+	Type         string `json:"type"`
+	ClientIP     string `json:"clientIP"`
+}
+
+type SlowLogStatisticsResult struct {
+	golangsdk.Result
+}
+
+func (r SlowLogStatisticsResult) Extract() (*SlowLogStatistics, error) {
+	var response SlowLogStatistics
+	err := r.ExtractInto(&response)
+	return &response, err
+}
+
+type SlowLogFile struct {
+	ID         string `json:"id"`
+	InstanceID string `json:"instance_id"`
+	FileName   string `json:"file_name"`
+	Status     string `json:"status"`
+	FileSize   string `json:"file_size"`
+	FileLink   string `json:"file_link"`
+	CreateAt   int64  `json:"create_at"`
+	UpdateAt   int64  `json:"update_at"`
+}
+
+type SlowLogDownloadResponse struct {
+	List   []SlowLogFile `json:"list"`
+	Status string        `json:"status"`
+	Count  int           `json:"count"`
+}
+
+type SlowLogDownloadResult struct {
+	golangsdk.Result
+}
+
+func (r SlowLogDownloadResult) Extract() (*SlowLogDownloadResponse, error) {
+	var response SlowLogDownloadResponse
+	err := r.ExtractInto(&response)
+	return &response, err
+}
+
+type WorkflowResponse struct {
+	WorkflowId string `json:"workflowId"`
+}
+
+// WorkflowResult represents the result of an operation which is performed asynchronously.
+type WorkflowResult struct {
+	golangsdk.Result
+}
+
+func (r WorkflowResult) Extract() (*WorkflowResponse, error) {
+	var response WorkflowResponse
+	err := r.ExtractInto(&response)
+	return &response, err
+}
+
+type AttachEipResult struct {
+	golangsdk.ErrResult
+}
+
+type UpdateSSLResult struct {
+	golangsdk.ErrResult
+}
+
+type UpdateOpsWindowResult struct {
+	golangsdk.ErrResult
+}
+
 type UpdateConfigurationResponse struct {
 	RestartRequired bool `json:"restart_required"`
 }
@@ -250,3 +367,64 @@ func (r UpdateConfigurationResult) Extract() (*UpdateConfigurationResponse, erro
 	}
 	return restartRequired, nil
 }
+
+type UpgradeVersionResult struct {
+	golangsdk.Result
+}
+
+func (r UpgradeVersionResult) Extract() (*UpgradeVersionResponse, error) {
+	var response UpgradeVersionResponse
+	err := r.ExtractInto(&response)
+	return &response, err
+}
+
+type AvailableVersionsResult struct {
+	golangsdk.Result
+}
+
+func (r AvailableVersionsResult) Extract() ([]string, error) {
+	var versions []string
+	err := r.ExtractIntoSlicePtr(&versions, "available_versions")
+	return versions, err
+}
+
+type UpgradeCheckResponse struct {
+	ReportId string `json:"report_id"`
+}
+
+type UpgradeCheckResult struct {
+	golangsdk.Result
+}
+
+func (r UpgradeCheckResult) Extract() (*UpgradeCheckResponse, error) {
+	var response UpgradeCheckResponse
+	err := r.ExtractInto(&response)
+	return &response, err
+}
+
+type UpgradeReport struct {
+	Id            string `json:"id"`
+	SourceVersion string `json:"src_database_version"`
+	TargetVersion string `json:"dst_database_version"`
+	// Report status: `running`, `success` or `failed`
+	Status    string `json:"status"`
+	StartTime string `json:"start_time"`
+	EndTime   string `json:"end_time"`
+	// Details of the failed checks
+	Detail string `json:"detail"`
+}
+
+type UpgradeReports struct {
+	Reports    []UpgradeReport `json:"reports"`
+	TotalCount int             `json:"total_count"`
+}
+
+type UpgradeReportsResult struct {
+	golangsdk.Result
+}
+
+func (r UpgradeReportsResult) Extract() (*UpgradeReports, error) {
+	var response UpgradeReports
+	err := r.ExtractInto(&response)
+	return &response, err
+}
@@ -23,10 +23,18 @@ type SingleToHaResponse struct {
 	JobId string `json:"job_id"`
 }
 
+type PromoteReplicaResponse struct {
+	JobId string `json:"job_id"`
+}
+
 type ResizeFlavor struct {
 	JobId string `json:"job_id"`
 }
 
+type UpgradeVersionResponse struct {
+	JobId string `json:"job_id"`
+}
+
 type CreateRds struct {
 	Instance Instance `json:"instance"`
 	JobId    string   `json:"job_id"`
@@ -71,6 +79,9 @@ func WaitForStateAvailable(client *golangsdk.ServiceClient, secs int, instanceID
 			return false, err
 		}
 
+		if len(job.Instances) == 0 {
+			return false, fmt.Errorf("instance %s not found", instanceID)
+		}
 		if job.Instances[0].Status == "ACTIVE" {
 			return true, nil
 		}
@@ -83,6 +94,32 @@ func WaitForStateAvailable(client *golangsdk.ServiceClient, secs int, instanceID
 	})
 }
 
+// WaitForStateDeleted waits until the instance disappears from the instance list.
+func WaitForStateDeleted(client *golangsdk.ServiceClient, secs int, instanceID string) error {
+	jobClient := *client
+	jobClient.ResourceBase = jobClient.Endpoint
+
+	return golangsdk.WaitFor(secs, func() (bool, error) {
+		job := new(golangsdk.JsonRDSInstanceStatus)
+
+		requestOpts := &golangsdk.RequestOpts{MoreHeaders: map[string]string{"Content-Type": "application/json"}}
+		_, err := jobClient.Get(detailsURL(jobClient.ResourceBase, instanceID), job, requestOpts)
+		if err != nil {
+			return false, err
+		}
+
+		if len(job.Instances) == 0 {
+			return true, nil
+		}
+		if job.Instances[0].Status == "FAILED" {
+			err = fmt.Errorf("Job failed %s.\n", job.Instances[0].Status)
+			return false, err
+		}
+		time.Sleep(10 * time.Second)
+		return false, nil
+	})
+}
+
 func jobURL(endpoint string, jobID string) string {
 	return fmt.Sprintf("%sjobs?id=%s", endpoint, jobID)
 }
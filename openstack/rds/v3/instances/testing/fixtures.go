@@ -0,0 +1,485 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const (
+	instanceID = "dsfae23fsfdsae3435in01"
+	jobID      = "dff1d289-4d03-4942-8b9f-463ea07c000d"
+)
+
+const expectedCreateRequest = `
+{
+  "name": "rds-ha",
+  "datastore": {
+    "type": "MySQL",
+    "version": "8.0"
+  },
+  "ha": {
+    "mode": "Ha",
+    "replication_mode": "semisync"
+  },
+  "port": "8635",
+  "password": "Test@12345678",
+  "backup_strategy": {
+    "start_time": "08:15-09:15",
+    "keep_days": 12
+  },
+  "disk_encryption_id": "2gfdsh-844a-4023-a776-fc5c5fb71fb4",
+  "flavor_ref": "rds.mysql.s1.large.ha",
+  "volume": {
+    "type": "ULTRAHIGH",
+    "size": 100
+  },
+  "region": "eu-de",
+  "availability_zone": "eu-de-01,eu-de-02",
+  "vpc_id": "490a4a08-ef4b-44c5-94be-3051ef9e4fce",
+  "subnet_id": "0e2eda62-1d42-4d64-a9d1-4e9aa9cd994f",
+  "security_group_id": "2a1f7fc8-3307-42a7-aa6f-42c8b9b8f8c5",
+  "charge_info": {
+    "charge_mode": "prePaid",
+    "period_type": "month",
+    "period_num": 1,
+    "is_auto_renew": "true",
+    "is_auto_pay": "true"
+  },
+  "data_vip": "192.168.0.147",
+  "unchangeable_param": {
+    "lower_case_table_names": "0"
+  }
+}`
+
+var createResponse = fmt.Sprintf(`
+{
+  "instance": {
+    "id": "%s",
+    "name": "rds-ha",
+    "status": "BUILD",
+    "datastore": {
+      "type": "MySQL",
+      "version": "8.0"
+    },
+    "ha": {
+      "mode": "Ha",
+      "replication_mode": "semisync"
+    },
+    "port": "8635",
+    "flavor_ref": "rds.mysql.s1.large.ha",
+    "volume": {
+      "type": "ULTRAHIGH",
+      "size": 100
+    },
+    "region": "eu-de",
+    "availability_zone": "eu-de-01,eu-de-02",
+    "charge_info": {
+      "charge_mode": "prePaid",
+      "period_type": "month",
+      "period_num": 1
+    }
+  },
+  "job_id": "%s",
+  "order_id": "CS20122919584LQ7K"
+}`, instanceID, jobID)
+
+var listResponse = fmt.Sprintf(`
+{
+  "instances": [
+    {
+      "id": "%s",
+      "name": "rds-ha",
+      "status": "ACTIVE",
+      "private_ips": ["192.168.0.147"],
+      "port": 8635,
+      "type": "Ha",
+      "ha": {
+        "mode": "Ha",
+        "replication_mode": "semisync"
+      },
+      "region": "eu-de",
+      "datastore": {
+        "type": "MySQL",
+        "version": "8.0"
+      },
+      "vpc_id": "490a4a08-ef4b-44c5-94be-3051ef9e4fce",
+      "subnet_id": "0e2eda62-1d42-4d64-a9d1-4e9aa9cd994f",
+      "flavor_ref": "rds.mysql.s1.large.ha",
+      "volume": {
+        "type": "ULTRAHIGH",
+        "size": 100
+      },
+      "charge_info": {
+        "charge_mode": "prePaid"
+      },
+      "nodes": [
+        {
+          "id": "06f1c2ad57604ae89e153e4d27f4e4b8no01",
+          "name": "rds-ha_node0",
+          "role": "master",
+          "status": "ACTIVE",
+          "availability_zone": "eu-de-01"
+        },
+        {
+          "id": "5b1e4e6cdbb44a8bb2d5cdc2b4f4f0b4no01",
+          "name": "rds-ha_node1",
+          "role": "slave",
+          "status": "ACTIVE",
+          "availability_zone": "eu-de-02"
+        }
+      ]
+    }
+  ],
+  "total_count": 1
+}`, instanceID)
+
+const emptyListResponse = `
+{
+  "instances": [],
+  "total_count": 0
+}`
+
+var jobResponse = fmt.Sprintf(`
+{
+  "job_id": "%s"
+}`, jobID)
+
+// HandleCreateSuccessfully creates an HTTP handler at `/instances` on the test handler mux that
+// responds to a POST request with createResponse.
+func HandleCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/instances", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, expectedCreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = fmt.Fprint(w, createResponse)
+	})
+}
+
+// HandleListSuccessfully creates an HTTP handler at `/instances` on the test handler mux that
+// responds to a GET request with the given response.
+func HandleListSuccessfully(t *testing.T, expectedQuery map[string]string, response string) {
+	th.Mux.HandleFunc("/instances", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, expectedQuery)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, response)
+	})
+}
+
+// HandleDeleteSuccessfully creates an HTTP handler at `/instances/{instance_id}` on the test
+// handler mux that responds to a DELETE request with jobResponse.
+func HandleDeleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/instances/%s", instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = fmt.Fprint(w, jobResponse)
+	})
+}
+
+const replicaID = "e6b0e6b8c2c44a0e8e3d4cf5f6c2a1b2in01"
+
+const expectedCreateReplicaRequest = `
+{
+  "name": "rds-replica",
+  "replica_of_id": "dsfae23fsfdsae3435in01",
+  "flavor_ref": "rds.mysql.s1.large.rr",
+  "volume": {
+    "type": "ULTRAHIGH",
+    "size": 100
+  },
+  "availability_zone": "eu-de-01"
+}`
+
+var createReplicaResponse = fmt.Sprintf(`
+{
+  "instance": {
+    "id": "%s",
+    "name": "rds-replica",
+    "status": "BUILD",
+    "flavor_ref": "rds.mysql.s1.large.rr",
+    "volume": {
+      "type": "ULTRAHIGH",
+      "size": 100
+    },
+    "availability_zone": "eu-de-01"
+  },
+  "job_id": "%s"
+}`, replicaID, jobID)
+
+const expectedSingleToHaRequest = `
+{
+  "single_to_ha": {
+    "az_code_new_node": "eu-de-02"
+  }
+}`
+
+const expectedPromoteReplicaRequest = `
+{
+  "promote_to_primary": {
+    "force": true
+  }
+}`
+
+var jobStatusResponse = fmt.Sprintf(`
+{
+  "job": {
+    "id": "%s",
+    "name": "CreateMysqlReadReplica",
+    "status": "Completed",
+    "created": "2018-08-06T10:41:14+0800",
+    "instance": {
+      "id": "%s",
+      "name": "rds-replica"
+    }
+  }
+}`, jobID, replicaID)
+
+// HandleCreateReplicaSuccessfully creates an HTTP handler at `/instances` on the test handler mux
+// that responds to a POST request with createReplicaResponse.
+func HandleCreateReplicaSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/instances", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, expectedCreateReplicaRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = fmt.Fprint(w, createReplicaResponse)
+	})
+}
+
+// HandleActionSuccessfully creates an HTTP handler at `/instances/{id}/action` on the test handler
+// mux that responds to a POST request with jobResponse.
+func HandleActionSuccessfully(t *testing.T, id, expectedRequest string) {
+	th.Mux.HandleFunc(fmt.Sprintf("/instances/%s/action", id), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, expectedRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = fmt.Fprint(w, jobResponse)
+	})
+}
+
+// HandleGetJobSuccessfully creates an HTTP handler at `/jobs` on the test handler mux that responds
+// to a GET request with jobStatusResponse.
+func HandleGetJobSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/jobs", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"id": jobID})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, jobStatusResponse)
+	})
+}
+
+const expectedUpdateConfigurationRequest = `
+{
+  "values": {
+    "max_connections": "37",
+    "autocommit": "OFF"
+  }
+}`
+
+// HandleUpdateConfigurationSuccessfully creates an HTTP handler at
+// `/instances/{instance_id}/configurations` on the test handler mux that responds to a PUT request.
+func HandleUpdateConfigurationSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/instances/%s/configurations", instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, expectedUpdateConfigurationRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, `{"restart_required": true}`)
+	})
+}
+
+const errorLogResponse = `
+{
+  "error_log_list": [
+    {
+      "time": "2018-12-04T14:24:42",
+      "level": "ERROR",
+      "content": "Slave I/O for channel '': error connecting to master"
+    }
+  ],
+  "total_record": 1
+}`
+
+const slowLogResponse = `
+{
+  "slow_log_list": [
+    {
+      "count": "1",
+      "time": "1.04899 s",
+      "lock_time": "0.00003 s",
+      "rows_sent": "0",
+      "rows_examined": "0",
+      "database": "mysql",
+      "users": "root",
+      "query_sample": "INSERT INTO time_zone_name (Name, Time_zone_id) VALUES (N, @time_zone_id);",
+      "type": "INSERT"
+    }
+  ],
+  "total_record": 1
+}`
+
+const slowLogStatisticsResponse = `
+{
+  "pageNumber": 1,
+  "pageRecord": 10,
+  "slowLogList": [
+    {
+      "count": "1 (100.00%)",
+      "time": "1.04899 s",
+      "lockTime": "0.00003 s",
+      "rowsSent": "0",
+      "rowsExamined": "0",
+      "database": "mysql",
+      "users": "root",
+      "querySample": "SELECT SLEEP(N);",
+      "type": "SELECT",
+      "clientIP": "192.168.0.11"
+    }
+  ],
+  "totalRecord": 1,
+  "startTime": 1596211200000,
+  "endTime": 1596297599000
+}`
+
+var slowLogDownloadResponse = fmt.Sprintf(`
+{
+  "list": [
+    {
+      "id": "8d9b6f0a-3a4c-4e5f-8c3b-7fd4a6b0c0e1",
+      "instance_id": "%s",
+      "file_name": "mysql-slow.log",
+      "status": "SUCCESS",
+      "file_size": "1024",
+      "file_link": "https://obs.example.com/mysql-slow.log?signature=abc",
+      "create_at": 1596211200000,
+      "update_at": 1596211260000
+    }
+  ],
+  "status": "FINISH",
+  "count": 1
+}`, instanceID)
+
+// HandleLogSuccessfully creates an HTTP handler at `/instances/{instance_id}/{path}` on the test
+// handler mux that responds to a GET request with the given response.
+func HandleLogSuccessfully(t *testing.T, path string, expectedQuery map[string]string, response string) {
+	th.Mux.HandleFunc(fmt.Sprintf("/instances/%s/%s", instanceID, path), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, expectedQuery)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, response)
+	})
+}
+
+// HandleSlowLogDownloadSuccessfully creates an HTTP handler at
+// `/instances/{instance_id}/slowlog-download` on the test handler mux that responds to a POST
+// request with slowLogDownloadResponse.
+func HandleSlowLogDownloadSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/instances/%s/slowlog-download", instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `{"file_name": "mysql-slow.log"}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, slowLogDownloadResponse)
+	})
+}
+
+// HandleUpdateSuccessfully creates an HTTP handler at `/instances/{instance_id}/{path}` on the test
+// handler mux that responds to a PUT request with the given response.
+func HandleUpdateSuccessfully(t *testing.T, path string, expectedRequest string, response string) {
+	th.Mux.HandleFunc(fmt.Sprintf("/instances/%s/%s", instanceID, path), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, expectedRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, response)
+	})
+}
+
+// HandleGetAutoExpansionSuccessfully creates an HTTP handler at
+// `/instances/{instance_id}/disk-auto-expansion` on the test handler mux that responds to a GET
+// request.
+func HandleGetAutoExpansionSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/instances/%s/disk-auto-expansion", instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, `{"switch_option": true, "limit_size": 4000, "trigger_threshold": 10}`)
+	})
+}
+
+// HandlePostSuccessfully creates an HTTP handler at `/instances/{instance_id}/{path}` on the test
+// handler mux that responds to a POST request with the given response.
+func HandlePostSuccessfully(t *testing.T, path string, expectedRequest string, response string) {
+	th.Mux.HandleFunc(fmt.Sprintf("/instances/%s/%s", instanceID, path), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, expectedRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, response)
+	})
+}
+
+const upgradeReportsResponse = `
+{
+  "reports": [
+    {
+      "id": "a7e6e3b5-61a4-4d4f-8c9e-0e4bb3a5a0f1",
+      "src_database_version": "11",
+      "dst_database_version": "13",
+      "status": "success",
+      "start_time": "2022-10-10T10:00:00+0000",
+      "end_time": "2022-10-10T10:05:00+0000",
+      "detail": ""
+    }
+  ],
+  "total_count": 1
+}`
+
+// HandleMajorVersionGetSuccessfully creates an HTTP handler at
+// `/instances/{instance_id}/major-version/{path}` on the test handler mux that responds to a GET
+// request with the given response.
+func HandleMajorVersionGetSuccessfully(t *testing.T, path string, expectedQuery map[string]string, response string) {
+	th.Mux.HandleFunc(fmt.Sprintf("/instances/%s/major-version/%s", instanceID, path), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, expectedQuery)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, response)
+	})
+}
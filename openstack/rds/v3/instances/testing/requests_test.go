@@ -0,0 +1,443 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/rds/v3/instances"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestCreateHaPrePaid(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSuccessfully(t)
+
+	opts := instances.CreateRdsOpts{
+		Name: "rds-ha",
+		Datastore: &instances.Datastore{
+			Type:    "MySQL",
+			Version: "8.0",
+		},
+		Ha: &instances.Ha{
+			Mode:            "Ha",
+			ReplicationMode: "semisync",
+		},
+		Port:     "8635",
+		Password: "Test@12345678",
+		BackupStrategy: &instances.BackupStrategy{
+			StartTime: "08:15-09:15",
+			KeepDays:  12,
+		},
+		DiskEncryptionId: "2gfdsh-844a-4023-a776-fc5c5fb71fb4",
+		FlavorRef:        "rds.mysql.s1.large.ha",
+		Volume: &instances.Volume{
+			Type: "ULTRAHIGH",
+			Size: 100,
+		},
+		Region:           "eu-de",
+		AvailabilityZone: "eu-de-01,eu-de-02",
+		VpcId:            "490a4a08-ef4b-44c5-94be-3051ef9e4fce",
+		SubnetId:         "0e2eda62-1d42-4d64-a9d1-4e9aa9cd994f",
+		SecurityGroupId:  "2a1f7fc8-3307-42a7-aa6f-42c8b9b8f8c5",
+		ChargeInfo: &instances.ChargeInfo{
+			ChargeMode:  "prePaid",
+			PeriodType:  "month",
+			PeriodNum:   1,
+			IsAutoRenew: "true",
+			IsAutoPay:   "true",
+		},
+		DataVip: "192.168.0.147",
+		UnchangeableParam: &instances.UnchangeableParam{
+			LowerCaseTableNames: "0",
+		},
+	}
+
+	rds, err := instances.Create(fake.ServiceClient(), opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, instanceID, rds.Instance.Id)
+	th.AssertEquals(t, jobID, rds.JobId)
+	th.AssertEquals(t, "CS20122919584LQ7K", rds.OrderId)
+	th.AssertEquals(t, "prePaid", rds.Instance.ChargeInfo.ChargeMode)
+}
+
+func TestListWithFilters(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t, map[string]string{
+		"type":           "Ha",
+		"datastore_type": "MySQL",
+		"vpc_id":         "490a4a08-ef4b-44c5-94be-3051ef9e4fce",
+	}, listResponse)
+
+	opts := instances.ListRdsInstanceOpts{
+		Type:          "Ha",
+		DataStoreType: "MySQL",
+		VpcId:         "490a4a08-ef4b-44c5-94be-3051ef9e4fce",
+	}
+	pages, err := instances.List(fake.ServiceClient(), opts).AllPages()
+	th.AssertNoErr(t, err)
+	rds, err := instances.ExtractRdsInstances(pages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, rds.TotalCount)
+	th.AssertEquals(t, instanceID, rds.Instances[0].Id)
+	th.AssertEquals(t, 2, len(rds.Instances[0].Nodes))
+	th.AssertEquals(t, "prePaid", rds.Instances[0].ChargeInfo.ChargeMode)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteSuccessfully(t)
+
+	job, err := instances.Delete(fake.ServiceClient(), instanceID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, jobID, job.JobId)
+}
+
+func TestWaitForStateAvailable(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t, map[string]string{"id": instanceID}, listResponse)
+
+	th.AssertNoErr(t, instances.WaitForStateAvailable(fake.ServiceClient(), 10, instanceID))
+}
+
+func TestWaitForStateAvailableNotFound(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t, map[string]string{"id": instanceID}, emptyListResponse)
+
+	err := instances.WaitForStateAvailable(fake.ServiceClient(), 10, instanceID)
+	th.AssertEquals(t, true, err != nil)
+}
+
+func TestWaitForStateDeleted(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t, map[string]string{"id": instanceID}, emptyListResponse)
+
+	th.AssertNoErr(t, instances.WaitForStateDeleted(fake.ServiceClient(), 10, instanceID))
+}
+
+func TestCreateReplica(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateReplicaSuccessfully(t)
+
+	opts := instances.CreateReplicaOpts{
+		Name:        "rds-replica",
+		ReplicaOfId: instanceID,
+		FlavorRef:   "rds.mysql.s1.large.rr",
+		Volume: &instances.Volume{
+			Type: "ULTRAHIGH",
+			Size: 100,
+		},
+		AvailabilityZone: "eu-de-01",
+	}
+	replica, err := instances.CreateReplica(fake.ServiceClient(), opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, replicaID, replica.Instance.Id)
+	th.AssertEquals(t, jobID, replica.JobId)
+}
+
+func TestSingleToHa(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleActionSuccessfully(t, instanceID, expectedSingleToHaRequest)
+
+	opts := instances.SingleToHaRdsOpts{
+		SingleToHa: &instances.SingleToHaRds{AzCodeNewNode: "eu-de-02"},
+	}
+	job, err := instances.SingleToHa(fake.ServiceClient(), opts, instanceID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, jobID, job.JobId)
+}
+
+func TestPromoteReplica(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleActionSuccessfully(t, replicaID, expectedPromoteReplicaRequest)
+
+	opts := instances.PromoteReplicaOpts{
+		PromoteToPrimary: &instances.PromoteToPrimary{Force: true},
+	}
+	job, err := instances.PromoteReplica(fake.ServiceClient(), opts, replicaID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, jobID, job.JobId)
+}
+
+func TestWaitForJobCompleted(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetJobSuccessfully(t)
+
+	th.AssertNoErr(t, instances.WaitForJobCompleted(fake.ServiceClient(), 10, jobID))
+}
+
+func TestUpdateInstanceConfigurationParameters(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateConfigurationSuccessfully(t)
+
+	opts := instances.UpdateInstanceConfigurationOpts{Values: map[string]interface{}{
+		"max_connections": "37",
+		"autocommit":      "OFF",
+	}}
+	result, err := instances.UpdateInstanceConfigurationParameters(fake.ServiceClient(), instanceID, opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, result.RestartRequired)
+}
+
+func TestListErrorLog(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleLogSuccessfully(t, "errorlog", map[string]string{
+		"start_date": "2018-08-06T10:41:14+0800",
+		"end_date":   "2018-08-07T10:41:14+0800",
+		"level":      "ERROR",
+	}, errorLogResponse)
+
+	opts := instances.DbErrorlogOpts{
+		StartDate: "2018-08-06T10:41:14+0800",
+		EndDate:   "2018-08-07T10:41:14+0800",
+		Level:     "ERROR",
+	}
+	pages, err := instances.ListErrorLog(fake.ServiceClient(), opts, instanceID).AllPages()
+	th.AssertNoErr(t, err)
+	logs, err := instances.ExtractErrorLog(pages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, logs.TotalRecord)
+	th.AssertEquals(t, "ERROR", logs.ErrorLogList[0].Level)
+}
+
+func TestListSlowLog(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleLogSuccessfully(t, "slowlog", map[string]string{
+		"start_date": "2018-08-06T10:41:14+0800",
+		"end_date":   "2018-08-07T10:41:14+0800",
+		"type":       "INSERT",
+	}, slowLogResponse)
+
+	opts := instances.DbSlowLogOpts{
+		StartDate: "2018-08-06T10:41:14+0800",
+		EndDate:   "2018-08-07T10:41:14+0800",
+		Type:      "INSERT",
+	}
+	pages, err := instances.ListSlowLog(fake.ServiceClient(), opts, instanceID).AllPages()
+	th.AssertNoErr(t, err)
+	logs, err := instances.ExtractSlowLog(pages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, logs.TotalRecord)
+	th.AssertEquals(t, "INSERT", logs.Slowloglist[0].Type)
+}
+
+func TestListSlowLogStatistics(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleLogSuccessfully(t, "slowlog/statistics", map[string]string{
+		"start_date": "2020-08-01T00:00:00+0800",
+		"end_date":   "2020-08-01T23:59:59+0800",
+		"cur_page":   "1",
+		"per_page":   "10",
+		"type":       "SELECT",
+	}, slowLogStatisticsResponse)
+
+	opts := instances.SlowLogStatisticsOpts{
+		StartDate: "2020-08-01T00:00:00+0800",
+		EndDate:   "2020-08-01T23:59:59+0800",
+		CurPage:   1,
+		PerPage:   10,
+		Type:      "SELECT",
+	}
+	stats, err := instances.ListSlowLogStatistics(fake.ServiceClient(), opts, instanceID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, stats.TotalRecord)
+	th.AssertEquals(t, "192.168.0.11", stats.SlowLogList[0].ClientIP)
+	th.AssertEquals(t, int64(1596211200000), stats.StartTime)
+}
+
+func TestGetSlowLogDownloadLinks(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleSlowLogDownloadSuccessfully(t)
+
+	opts := instances.SlowLogDownloadOpts{FileName: "mysql-slow.log"}
+	links, err := instances.GetSlowLogDownloadLinks(fake.ServiceClient(), opts, instanceID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, links.Count)
+	th.AssertEquals(t, "https://obs.example.com/mysql-slow.log?signature=abc", links.List[0].FileLink)
+}
+
+func TestUpdatePort(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateSuccessfully(t, "port", `{"port": 8635}`, `{"workflowId": "2f9a9e6c-6f2b-4cc7-8e9b-4d1c1a3c6a2e"}`)
+
+	resp, err := instances.UpdatePort(fake.ServiceClient(), instances.UpdatePortOpts{Port: 8635}, instanceID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "2f9a9e6c-6f2b-4cc7-8e9b-4d1c1a3c6a2e", resp.WorkflowId)
+}
+
+func TestAttachEip(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateSuccessfully(t, "public-ip", `
+{
+  "public_ip": "80.158.1.1",
+  "public_ip_id": "d5f5b4a1-0c8c-4b3e-9e0a-3b0f7d1e2c4f",
+  "is_bind": true
+}`, `{}`)
+
+	bind := true
+	opts := instances.AttachEipOpts{
+		PublicIp:   "80.158.1.1",
+		PublicIpId: "d5f5b4a1-0c8c-4b3e-9e0a-3b0f7d1e2c4f",
+		IsBind:     &bind,
+	}
+	err := instances.AttachEip(fake.ServiceClient(), opts, instanceID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestUpdateSecurityGroup(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateSuccessfully(t, "security-group", `{"security_group_id": "c4f5b1e2-8d3a-4a8b-9f1e-6a7b8c9d0e1f"}`,
+		`{"workflowId": "2f9a9e6c-6f2b-4cc7-8e9b-4d1c1a3c6a2e"}`)
+
+	opts := instances.UpdateSecurityGroupOpts{SecurityGroupId: "c4f5b1e2-8d3a-4a8b-9f1e-6a7b8c9d0e1f"}
+	resp, err := instances.UpdateSecurityGroup(fake.ServiceClient(), opts, instanceID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "2f9a9e6c-6f2b-4cc7-8e9b-4d1c1a3c6a2e", resp.WorkflowId)
+}
+
+func TestUpdateSSL(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateSuccessfully(t, "ssl", `{"ssl_option": false}`, `{}`)
+
+	ssl := false
+	err := instances.UpdateSSL(fake.ServiceClient(), instances.UpdateSSLOpts{SSLOption: &ssl}, instanceID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestUpdateOpsWindow(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateSuccessfully(t, "ops-window", `{"start_time": "22:00", "end_time": "02:00"}`, `{}`)
+
+	opts := instances.UpdateOpsWindowOpts{StartTime: "22:00", EndTime: "02:00"}
+	err := instances.UpdateOpsWindow(fake.ServiceClient(), opts, instanceID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestResize(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleActionSuccessfully(t, instanceID, `{"resize_flavor": {"spec_code": "rds.pg.c2.large"}}`)
+	HandleGetJobSuccessfully(t)
+
+	opts := instances.ResizeFlavorOpts{
+		ResizeFlavor: &instances.SpecCode{Speccode: "rds.pg.c2.large"},
+	}
+	job, err := instances.Resize(fake.ServiceClient(), opts, instanceID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, jobID, job.JobId)
+	th.AssertNoErr(t, instances.WaitForJobCompleted(fake.ServiceClient(), 10, job.JobId))
+}
+
+func TestEnlargeVolume(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleActionSuccessfully(t, instanceID, `{"enlarge_volume": {"size": 200}}`)
+
+	opts := instances.EnlargeVolumeRdsOpts{
+		EnlargeVolume: &instances.EnlargeVolumeSize{Size: 200},
+	}
+	job, err := instances.EnlargeVolume(fake.ServiceClient(), opts, instanceID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, jobID, job.JobId)
+}
+
+func TestUpdateAutoExpansion(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateSuccessfully(t, "disk-auto-expansion", `{"switch_option": true, "limit_size": 4000, "trigger_threshold": 10}`, ``)
+
+	enabled := true
+	opts := instances.AutoExpansionOpts{
+		SwitchOption:     &enabled,
+		LimitSize:        4000,
+		TriggerThreshold: 10,
+	}
+	err := instances.UpdateAutoExpansion(fake.ServiceClient(), opts, instanceID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestGetAutoExpansion(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetAutoExpansionSuccessfully(t)
+
+	policy, err := instances.GetAutoExpansion(fake.ServiceClient(), instanceID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, policy.SwitchOption)
+	th.AssertEquals(t, 4000, policy.LimitSize)
+	th.AssertEquals(t, 10, policy.TriggerThreshold)
+}
+
+func TestUpgradeMinorVersion(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandlePostSuccessfully(t, "db-upgrade", `{"is_delayed": true}`, jobResponse)
+	HandleGetJobSuccessfully(t)
+
+	delayed := true
+	opts := instances.UpgradeMinorVersionOpts{IsDelayed: &delayed}
+	job, err := instances.UpgradeMinorVersion(fake.ServiceClient(), opts, instanceID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, jobID, job.JobId)
+	th.AssertNoErr(t, instances.WaitForJobCompleted(fake.ServiceClient(), 10, job.JobId))
+}
+
+func TestUpgradeMajorVersion(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleMajorVersionGetSuccessfully(t, "available-version", map[string]string{}, `{"available_versions": ["12", "13"]}`)
+	HandlePostSuccessfully(t, "major-version/upgrade-check", `{"target_version": "13"}`, `{"report_id": "a7e6e3b5-61a4-4d4f-8c9e-0e4bb3a5a0f1"}`)
+	HandleMajorVersionGetSuccessfully(t, "reports", map[string]string{"report_type": "upgrade_pre_check"}, upgradeReportsResponse)
+	HandlePostSuccessfully(t, "major-version/upgrade", `{"target_version": "13", "is_change_private_ip": true}`, jobResponse)
+
+	versions, err := instances.ListAvailableVersions(fake.ServiceClient(), instanceID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []string{"12", "13"}, versions)
+
+	check, err := instances.CheckMajorVersionUpgrade(fake.ServiceClient(), instances.MajorVersionOpts{
+		TargetVersion: "13",
+	}, instanceID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "a7e6e3b5-61a4-4d4f-8c9e-0e4bb3a5a0f1", check.ReportId)
+
+	reports, err := instances.ListUpgradeReports(fake.ServiceClient(), instances.ListUpgradeReportsOpts{
+		ReportType: "upgrade_pre_check",
+	}, instanceID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, reports.TotalCount)
+	th.AssertEquals(t, "success", reports.Reports[0].Status)
+
+	changeIp := true
+	job, err := instances.UpgradeMajorVersion(fake.ServiceClient(), instances.MajorVersionOpts{
+		TargetVersion:     "13",
+		IsChangePrivateIp: &changeIp,
+	}, instanceID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, jobID, job.JobId)
+}
+
+func TestListUpgradeReportsRequired(t *testing.T) {
+	_, err := instances.ListUpgradeReports(fake.ServiceClient(), instances.ListUpgradeReportsOpts{}, instanceID).Extract()
+	if err == nil {
+		t.Fatal("expected error for missing report type")
+	}
+}
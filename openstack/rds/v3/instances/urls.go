@@ -14,20 +14,12 @@ func listURL(c *golangsdk.ServiceClient) string {
 	return c.ServiceURL("instances")
 }
 
-func restartURL(c *golangsdk.ServiceClient, instancesId string) string {
+func actionURL(c *golangsdk.ServiceClient, instancesId string) string {
 	return c.ServiceURL("instances", instancesId, "action")
 }
 
-func singletohaURL(c *golangsdk.ServiceClient, instancesId string) string {
-	return c.ServiceURL("instances", instancesId, "action")
-}
-
-func resizeURL(c *golangsdk.ServiceClient, instancesId string) string {
-	return c.ServiceURL("instances", instancesId, "action")
-}
-
-func enlargeURL(c *golangsdk.ServiceClient, instancesId string) string {
-	return c.ServiceURL("instances", instancesId, "action")
+func autoExpansionURL(c *golangsdk.ServiceClient, instanceID string) string {
+	return c.ServiceURL("instances", instanceID, "disk-auto-expansion")
 }
 
 func listerrorlogURL(c *golangsdk.ServiceClient, instanceID string) string {
@@ -38,6 +30,42 @@ func listslowlogURL(c *golangsdk.ServiceClient, instanceID string) string {
 	return c.ServiceURL("instances", instanceID, "slowlog")
 }
 
+func slowlogStatisticsURL(c *golangsdk.ServiceClient, instanceID string) string {
+	return c.ServiceURL("instances", instanceID, "slowlog", "statistics")
+}
+
+func slowlogDownloadURL(c *golangsdk.ServiceClient, instanceID string) string {
+	return c.ServiceURL("instances", instanceID, "slowlog-download")
+}
+
 func instanceConfigurationURL(c *golangsdk.ServiceClient, instanceID string) string {
 	return c.ServiceURL("instances", instanceID, "configurations")
 }
+
+func portURL(c *golangsdk.ServiceClient, instanceID string) string {
+	return c.ServiceURL("instances", instanceID, "port")
+}
+
+func publicIpURL(c *golangsdk.ServiceClient, instanceID string) string {
+	return c.ServiceURL("instances", instanceID, "public-ip")
+}
+
+func securityGroupURL(c *golangsdk.ServiceClient, instanceID string) string {
+	return c.ServiceURL("instances", instanceID, "security-group")
+}
+
+func sslURL(c *golangsdk.ServiceClient, instanceID string) string {
+	return c.ServiceURL("instances", instanceID, "ssl")
+}
+
+func opsWindowURL(c *golangsdk.ServiceClient, instanceID string) string {
+	return c.ServiceURL("instances", instanceID, "ops-window")
+}
+
+func minorUpgradeURL(c *golangsdk.ServiceClient, instanceID string) string {
+	return c.ServiceURL("instances", instanceID, "db-upgrade")
+}
+
+func majorVersionURL(c *golangsdk.ServiceClient, instanceID string, action string) string {
+	return c.ServiceURL("instances", instanceID, "major-version", action)
+}
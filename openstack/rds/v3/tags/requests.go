@@ -0,0 +1,127 @@
+package tags
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/tags"
+)
+
+var requestOpts = golangsdk.RequestOpts{
+	MoreHeaders: map[string]string{"Content-Type": "application/json"},
+}
+
+// ActionOptsBuilder is an interface from which can build the request of creating/deleting instance tags
+type ActionOptsBuilder interface {
+	ToTagsActionMap() (map[string]interface{}, error)
+}
+
+// ActionOpts is a struct contains the parameters of creating/deleting instance tags
+type ActionOpts struct {
+	Tags   []tags.ResourceTag `json:"tags" required:"true"`
+	Action string             `json:"action" required:"true"`
+}
+
+// ToTagsActionMap build the action request in json format
+func (opts ActionOpts) ToTagsActionMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+func doAction(client *golangsdk.ServiceClient, instanceID string, opts ActionOptsBuilder) (r ActionResult) {
+	b, err := opts.ToTagsActionMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(actionURL(client, instanceID), b, nil, &golangsdk.RequestOpts{
+		OkCodes:     []int{200, 204},
+		MoreHeaders: requestOpts.MoreHeaders,
+	})
+	return
+}
+
+// Create is a method of adding tags to the instance
+func Create(client *golangsdk.ServiceClient, instanceID string, tagList []tags.ResourceTag) (r ActionResult) {
+	opts := ActionOpts{
+		Tags:   tagList,
+		Action: "create",
+	}
+	return doAction(client, instanceID, opts)
+}
+
+// Delete is a method of deleting tags of the instance
+func Delete(client *golangsdk.ServiceClient, instanceID string, tagList []tags.ResourceTag) (r ActionResult) {
+	opts := ActionOpts{
+		Tags:   tagList,
+		Action: "delete",
+	}
+	return doAction(client, instanceID, opts)
+}
+
+// Get is a method of getting the tags of the instance
+func Get(client *golangsdk.ServiceClient, instanceID string) (r GetResult) {
+	_, r.Err = client.Get(getURL(client, instanceID), &r.Body, &requestOpts)
+	return
+}
+
+// List is a method of getting all tags used by instances in the project
+func List(client *golangsdk.ServiceClient) (r ListResult) {
+	_, r.Err = client.Get(listURL(client), &r.Body, &requestOpts)
+	return
+}
+
+// ListInstancesOptsBuilder allows extensions to add additional parameters to the
+// ListInstances request.
+type ListInstancesOptsBuilder interface {
+	ToListInstancesMap() (map[string]interface{}, error)
+}
+
+// ListInstancesOpts contains all the values needed to query instances by tags.
+type ListInstancesOpts struct {
+	// Instances with all of these tags will be returned, up to 10 tags.
+	Tags []Tags `json:"tags,omitempty"`
+	// Instances with any of these tags will be returned.
+	AnyTags []Tags `json:"tags_any,omitempty"`
+	// Instances without all of these tags will be returned.
+	NotTags []Tags `json:"not_tags,omitempty"`
+	// Instances without any of these tags will be returned.
+	NotAnyTags []Tags `json:"not_tags_any,omitempty"`
+	// Fuzzy search on resource_name or exact search on resource_id.
+	Matches []Match `json:"matches,omitempty"`
+	// Number of records to be queried. Not used when Action is `count`.
+	Limit int `json:"limit,omitempty"`
+	// Index position of the query. Not used when Action is `count`.
+	Offset int `json:"offset,omitempty"`
+	// Operation type: `filter` or `count`.
+	Action string `json:"action" required:"true"`
+}
+
+type Tags struct {
+	Key    string   `json:"key" required:"true"`
+	Values []string `json:"values" required:"true"`
+}
+
+type Match struct {
+	// Possible values are `resource_name` and `resource_id`
+	Key   string `json:"key" required:"true"`
+	Value string `json:"value" required:"true"`
+}
+
+// ToListInstancesMap builds a ListInstances request body from ListInstancesOpts.
+func (opts ListInstancesOpts) ToListInstancesMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// ListInstances queries instances filtered by tags. To extract
+// the instances from the response, call the Extract method on the
+// ListInstancesResult.
+func ListInstances(client *golangsdk.ServiceClient, opts ListInstancesOptsBuilder) (r ListInstancesResult) {
+	b, err := opts.ToListInstancesMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(resourceInstancesURL(client), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes:     []int{200},
+		MoreHeaders: requestOpts.MoreHeaders,
+	})
+	return
+}
@@ -0,0 +1,71 @@
+package tags
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/tags"
+)
+
+type ListedTag struct {
+	Key    string   `json:"key"`
+	Values []string `json:"values"`
+}
+
+type Resources struct {
+	// List of matching instances
+	Resources []Resource `json:"resources"`
+	// Total number of matching instances
+	TotalCount int `json:"total_count"`
+}
+
+type Resource struct {
+	ResourceID   string             `json:"resource_id"`
+	ResourceName string             `json:"resource_name"`
+	Tags         []tags.ResourceTag `json:"tags"`
+}
+
+// ActionResult is the action result which is the result of create or delete operations
+type ActionResult struct {
+	golangsdk.ErrResult
+}
+
+// GetResult contains the body of getting instance tags request
+type GetResult struct {
+	golangsdk.Result
+}
+
+// Extract method will parse the result body into ResourceTag slice
+func (r GetResult) Extract() ([]tags.ResourceTag, error) {
+	var s []tags.ResourceTag
+	err := r.ExtractIntoSlicePtr(&s, "tags")
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// ListResult contains the body of getting project tags request
+type ListResult struct {
+	golangsdk.Result
+}
+
+// Extract method will parse the result body into ListedTag slice
+func (r ListResult) Extract() ([]ListedTag, error) {
+	var s []ListedTag
+	err := r.ExtractIntoSlicePtr(&s, "tags")
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// ListInstancesResult contains the body of querying instances by tags request
+type ListInstancesResult struct {
+	golangsdk.Result
+}
+
+// Extract method will parse the result body into Resources struct
+func (r ListInstancesResult) Extract() (*Resources, error) {
+	var response Resources
+	err := r.ExtractInto(&response)
+	return &response, err
+}
@@ -0,0 +1,111 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const instanceID = "dsfae23fsfdsae3435in01"
+
+const expectedCreateRequest = `
+{
+  "action": "create",
+  "tags": [
+    {
+      "key": "env",
+      "value": "prod"
+    }
+  ]
+}`
+
+const getResponse = `
+{
+  "tags": [
+    {
+      "key": "env",
+      "value": "prod"
+    }
+  ]
+}`
+
+const listResponse = `
+{
+  "tags": [
+    {
+      "key": "env",
+      "values": ["prod", "test"]
+    }
+  ]
+}`
+
+const expectedListInstancesRequest = `
+{
+  "action": "filter",
+  "limit": 10,
+  "tags": [
+    {
+      "key": "env",
+      "values": ["prod"]
+    }
+  ]
+}`
+
+const listInstancesResponse = `
+{
+  "resources": [
+    {
+      "resource_id": "dsfae23fsfdsae3435in01",
+      "resource_name": "rds-test",
+      "tags": [
+        {
+          "key": "env",
+          "value": "prod"
+        }
+      ]
+    }
+  ],
+  "total_count": 1
+}`
+
+// HandleActionSuccessfully creates an HTTP handler at `/instances/{instance_id}/tags/action` on the
+// test handler mux that responds to a POST request.
+func HandleActionSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/instances/%s/tags/action", instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, expectedCreateRequest)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandleGetSuccessfully configures the test server to respond to a GET request at the given path
+// with the given response.
+func HandleGetSuccessfully(t *testing.T, path, response string) {
+	th.Mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, response)
+	})
+}
+
+// HandleListInstancesSuccessfully creates an HTTP handler at `/instances/resource_instances/action`
+// on the test handler mux that responds to a POST request with listInstancesResponse.
+func HandleListInstancesSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/instances/resource_instances/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, expectedListInstancesRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, listInstancesResponse)
+	})
+}
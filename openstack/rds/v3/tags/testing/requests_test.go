@@ -0,0 +1,57 @@
+package testing
+
+import (
+	"fmt"
+	"testing"
+
+	common "github.com/opentelekomcloud/gophertelekomcloud/openstack/common/tags"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/rds/v3/tags"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleActionSuccessfully(t)
+
+	err := tags.Create(fake.ServiceClient(), instanceID, []common.ResourceTag{{Key: "env", Value: "prod"}}).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t, fmt.Sprintf("/instances/%s/tags", instanceID), getResponse)
+
+	tagList, err := tags.Get(fake.ServiceClient(), instanceID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []common.ResourceTag{{Key: "env", Value: "prod"}}, tagList)
+}
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t, "/tags", listResponse)
+
+	tagList, err := tags.List(fake.ServiceClient()).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []tags.ListedTag{{Key: "env", Values: []string{"prod", "test"}}}, tagList)
+}
+
+func TestListInstances(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListInstancesSuccessfully(t)
+
+	opts := tags.ListInstancesOpts{
+		Action: "filter",
+		Limit:  10,
+		Tags:   []tags.Tags{{Key: "env", Values: []string{"prod"}}},
+	}
+	resources, err := tags.ListInstances(fake.ServiceClient(), opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, resources.TotalCount)
+	th.AssertEquals(t, instanceID, resources.Resources[0].ResourceID)
+	th.AssertEquals(t, "prod", resources.Resources[0].Tags[0].Value)
+}
@@ -0,0 +1,19 @@
+package tags
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+func actionURL(c *golangsdk.ServiceClient, instanceID string) string {
+	return c.ServiceURL("instances", instanceID, "tags", "action")
+}
+
+func getURL(c *golangsdk.ServiceClient, instanceID string) string {
+	return c.ServiceURL("instances", instanceID, "tags")
+}
+
+func listURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("tags")
+}
+
+func resourceInstancesURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("instances", "resource_instances", "action")
+}
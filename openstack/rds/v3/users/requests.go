@@ -0,0 +1,159 @@
+package users
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
+)
+
+var RequestOpts = golangsdk.RequestOpts{
+	MoreHeaders: map[string]string{"Content-Type": "application/json", "X-Language": "en-us"},
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToUserCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts contains all the values needed to create a new database user.
+type CreateOpts struct {
+	// User name
+	Name string `json:"name" required:"true"`
+	// User password
+	Password string `json:"password" required:"true"`
+}
+
+// ToUserCreateMap builds a create request body from CreateOpts.
+func (opts CreateOpts) ToUserCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Create will create a new database user in the instance based on the values in CreateOpts.
+func Create(client *golangsdk.ServiceClient, instanceID string, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToUserCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(createURL(client, instanceID), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes:     []int{200, 202},
+		MoreHeaders: RequestOpts.MoreHeaders,
+	})
+	return
+}
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToUserListQuery() (string, error)
+}
+
+// ListOpts contains the paging parameters of the user list.
+type ListOpts struct {
+	// Number of the first page to fetch, starting from 1
+	Page int `q:"page,required"`
+	// Number of records per page, from 1 to 100
+	Limit int `q:"limit,required"`
+}
+
+// ToUserListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToUserListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), nil
+}
+
+// List returns a Pager which allows you to iterate over the database users of the instance,
+// starting from opts.Page and fetching opts.Limit users per page.
+func List(client *golangsdk.ServiceClient, instanceID string, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client, instanceID)
+	if opts != nil {
+		q, err := opts.ToUserListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += q
+	}
+
+	pager := pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return UserPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+	pager.Headers = RequestOpts.MoreHeaders
+	return pager
+}
+
+// Delete will permanently delete a database user of the instance.
+func Delete(client *golangsdk.ServiceClient, instanceID, userName string) (r DeleteResult) {
+	_, r.Err = client.Delete(deleteURL(client, instanceID, userName), &golangsdk.RequestOpts{
+		OkCodes:      []int{200, 202},
+		JSONResponse: &r.Body,
+		MoreHeaders:  RequestOpts.MoreHeaders,
+	})
+	return
+}
+
+// ResetPasswordOptsBuilder allows extensions to add additional parameters to the
+// ResetPassword request.
+type ResetPasswordOptsBuilder interface {
+	ToResetPasswordMap() (map[string]interface{}, error)
+}
+
+// ResetPasswordOpts contains the new password of a database user.
+type ResetPasswordOpts struct {
+	// User name
+	Name string `json:"name" required:"true"`
+	// New password
+	Password string `json:"password" required:"true"`
+}
+
+// ToResetPasswordMap builds a reset password request body from ResetPasswordOpts.
+func (opts ResetPasswordOpts) ToResetPasswordMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// ResetPassword changes the password of a database user.
+func ResetPassword(client *golangsdk.ServiceClient, instanceID string, opts ResetPasswordOptsBuilder) (r ResetPasswordResult) {
+	b, err := opts.ToResetPasswordMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(resetPasswordURL(client, instanceID), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes:     []int{200, 202},
+		MoreHeaders: RequestOpts.MoreHeaders,
+	})
+	return
+}
+
+// ResetRootPasswordOptsBuilder allows extensions to add additional parameters to the
+// ResetRootPassword request.
+type ResetRootPasswordOptsBuilder interface {
+	ToResetRootPasswordMap() (map[string]interface{}, error)
+}
+
+// ResetRootPasswordOpts contains the new password of the administrator account.
+type ResetRootPasswordOpts struct {
+	// New password
+	DbUserPassword string `json:"db_user_pwd" required:"true"`
+}
+
+// ToResetRootPasswordMap builds a reset password request body from ResetRootPasswordOpts.
+func (opts ResetRootPasswordOpts) ToResetRootPasswordMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// ResetRootPassword changes the password of the administrator account of the instance.
+func ResetRootPassword(client *golangsdk.ServiceClient, instanceID string, opts ResetRootPasswordOptsBuilder) (r ResetPasswordResult) {
+	b, err := opts.ToResetRootPasswordMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(rootPasswordURL(client, instanceID), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes:     []int{200, 202},
+		MoreHeaders: RequestOpts.MoreHeaders,
+	})
+	return
+}
@@ -0,0 +1,70 @@
+package users
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
+)
+
+type Database struct {
+	// Database name
+	Name string `json:"name"`
+	// Whether the user has read-only access
+	Readonly bool `json:"readonly"`
+}
+
+type User struct {
+	// User name
+	Name string `json:"name"`
+	// Databases the user has access to, MySQL only
+	Databases []Database `json:"databases"`
+	// User attributes, PostgreSQL only
+	Attributes map[string]interface{} `json:"attributes"`
+}
+
+// CreateResult represents the result of a create operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type CreateResult struct {
+	golangsdk.ErrResult
+}
+
+// DeleteResult represents the result of a delete operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	golangsdk.ErrResult
+}
+
+// ResetPasswordResult represents the result of a password reset. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type ResetPasswordResult struct {
+	golangsdk.ErrResult
+}
+
+type UserPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL advances the `page` query parameter until all total_count users are fetched.
+func (r UserPage) NextPageURL() (string, error) {
+	var s struct {
+		TotalCount int `json:"total_count"`
+	}
+	if err := r.ExtractInto(&s); err != nil {
+		return "", err
+	}
+
+	return r.WrapNextPageNumberURL(s.TotalCount)
+}
+
+// IsEmpty determines whether or not a UserPage is empty.
+func (r UserPage) IsEmpty() (bool, error) {
+	users, err := ExtractUsers(r)
+	return len(users) == 0, err
+}
+
+// ExtractUsers interprets the results of a single page from
+// a List() API call, producing a slice of []User structures.
+func ExtractUsers(r pagination.Page) ([]User, error) {
+	var s []User
+	err := (r.(UserPage)).ExtractIntoSlicePtr(&s, "users")
+	return s, err
+}
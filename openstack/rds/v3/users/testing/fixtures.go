@@ -0,0 +1,111 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const instanceID = "dsfae23fsfdsae3435in01"
+
+const expectedCreateRequest = `
+{
+  "name": "rds-user",
+  "password": "Test@12345678"
+}`
+
+const expectedRootPasswordRequest = `
+{
+  "db_user_pwd": "Test@87654321"
+}`
+
+const listResponse = `
+{
+  "users": [
+    {
+      "name": "rds-user",
+      "databases": [
+        {
+          "name": "rds-test",
+          "readonly": true
+        }
+      ]
+    }
+  ],
+  "total_count": 1
+}`
+
+const listPageResponse = `
+{
+  "users": [
+    {
+      "name": "%s",
+      "databases": []
+    }
+  ],
+  "total_count": 2
+}`
+
+const successResponse = `{"resp": "successful"}`
+
+// HandlePostSuccessfully creates an HTTP handler at `/instances/{instance_id}/{path}` on the test
+// handler mux that responds to a POST request with successResponse.
+func HandlePostSuccessfully(t *testing.T, path, expectedRequest string) {
+	th.Mux.HandleFunc(fmt.Sprintf("/instances/%s/%s", instanceID, path), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, expectedRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, successResponse)
+	})
+}
+
+// HandleListSuccessfully creates an HTTP handler at `/instances/{instance_id}/db_user/detail` on
+// the test handler mux that responds to a GET request with listResponse.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/instances/%s/db_user/detail", instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"page": "1", "limit": "10"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, listResponse)
+	})
+}
+
+// HandleListPagesSuccessfully creates an HTTP handler at `/instances/{instance_id}/db_user/detail`
+// on the test handler mux that responds to a GET request with listPageResponse.
+func HandleListPagesSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/instances/%s/db_user/detail", instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		switch page := r.URL.Query().Get("page"); page {
+		case "1", "2":
+			_, _ = fmt.Fprintf(w, listPageResponse, "rds-user-"+page)
+		default:
+			t.Errorf("unexpected page: %s", page)
+		}
+	})
+}
+
+// HandleDeleteSuccessfully creates an HTTP handler at `/instances/{instance_id}/db_user/rds-user`
+// on the test handler mux that responds to a DELETE request with successResponse.
+func HandleDeleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/instances/%s/db_user/rds-user", instanceID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = fmt.Fprint(w, successResponse)
+	})
+}
@@ -0,0 +1,72 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/rds/v3/users"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandlePostSuccessfully(t, "db_user", expectedCreateRequest)
+
+	opts := users.CreateOpts{Name: "rds-user", Password: "Test@12345678"}
+	th.AssertNoErr(t, users.Create(fake.ServiceClient(), instanceID, opts).ExtractErr())
+}
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	pages, err := users.List(fake.ServiceClient(), instanceID, users.ListOpts{Page: 1, Limit: 10}).AllPages()
+	th.AssertNoErr(t, err)
+	list, err := users.ExtractUsers(pages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(list))
+	th.AssertEquals(t, "rds-user", list[0].Name)
+	th.AssertEquals(t, true, list[0].Databases[0].Readonly)
+}
+
+func TestListAllPages(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListPagesSuccessfully(t)
+
+	pages, err := users.List(fake.ServiceClient(), instanceID, users.ListOpts{Page: 1, Limit: 1}).AllPages()
+	th.AssertNoErr(t, err)
+	list, err := users.ExtractUsers(pages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, len(list))
+	th.AssertEquals(t, "rds-user-1", list[0].Name)
+	th.AssertEquals(t, "rds-user-2", list[1].Name)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteSuccessfully(t)
+
+	th.AssertNoErr(t, users.Delete(fake.ServiceClient(), instanceID, "rds-user").ExtractErr())
+}
+
+func TestResetPassword(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandlePostSuccessfully(t, "db_user/resetpwd", expectedCreateRequest)
+
+	opts := users.ResetPasswordOpts{Name: "rds-user", Password: "Test@12345678"}
+	th.AssertNoErr(t, users.ResetPassword(fake.ServiceClient(), instanceID, opts).ExtractErr())
+}
+
+func TestResetRootPassword(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandlePostSuccessfully(t, "password", expectedRootPasswordRequest)
+
+	opts := users.ResetRootPasswordOpts{DbUserPassword: "Test@87654321"}
+	th.AssertNoErr(t, users.ResetRootPassword(fake.ServiceClient(), instanceID, opts).ExtractErr())
+}
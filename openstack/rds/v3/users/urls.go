@@ -0,0 +1,23 @@
+package users
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+func createURL(c *golangsdk.ServiceClient, instanceID string) string {
+	return c.ServiceURL("instances", instanceID, "db_user")
+}
+
+func listURL(c *golangsdk.ServiceClient, instanceID string) string {
+	return c.ServiceURL("instances", instanceID, "db_user", "detail")
+}
+
+func deleteURL(c *golangsdk.ServiceClient, instanceID, userName string) string {
+	return c.ServiceURL("instances", instanceID, "db_user", userName)
+}
+
+func resetPasswordURL(c *golangsdk.ServiceClient, instanceID string) string {
+	return c.ServiceURL("instances", instanceID, "db_user", "resetpwd")
+}
+
+func rootPasswordURL(c *golangsdk.ServiceClient, instanceID string) string {
+	return c.ServiceURL("instances", instanceID, "password")
+}
@@ -0,0 +1,25 @@
+/*
+Package conformance deploys RMS conformance packs, which are sets of policy
+assignments created from a template, and queries their compliance.
+
+Example to Deploy a Built-in Conformance Pack
+
+	pack, err := conformance.Create(rmsClient, conformance.CreateOpts{
+		Name:        "network-best-practices",
+		TemplateKey: "Operational-Best-Practices-for-Networking.tf.json",
+	}).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	err = conformance.WaitForStatus(rmsClient, pack.ID, conformance.StatusCreateSuccessful, 600)
+	if err != nil {
+		panic(err)
+	}
+
+	compliance, err := conformance.GetCompliance(rmsClient, pack.ID).Extract()
+	if err != nil {
+		panic(err)
+	}
+*/
+package conformance
@@ -0,0 +1,149 @@
+package conformance
+
+import (
+	"fmt"
+
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
+)
+
+// Var is a parameter of the conformance pack template.
+type Var struct {
+	Key   string      `json:"var_key" required:"true"`
+	Value interface{} `json:"var_value" required:"true"`
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the Create request.
+type CreateOptsBuilder interface {
+	ToPackCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts contains the options to deploy a conformance pack.
+// Exactly one of TemplateKey, TemplateBody and TemplateURI must be set.
+type CreateOpts struct {
+	Name string `json:"name" required:"true"`
+	// TemplateKey is the name of a built-in conformance pack template.
+	TemplateKey string `json:"template_key,omitempty"`
+	// TemplateBody is the content of a custom template.
+	TemplateBody string `json:"template_body,omitempty"`
+	// TemplateURI is the OBS URL of a custom template.
+	TemplateURI string `json:"template_uri,omitempty"`
+	AgencyName  string `json:"agency_name,omitempty"`
+	Vars        []Var  `json:"vars_structure,omitempty"`
+}
+
+// ToPackCreateMap builds a create request body from CreateOpts.
+func (opts CreateOpts) ToPackCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Create deploys a conformance pack, use WaitForStatus to wait until it's deployed.
+func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r PackResult) {
+	b, err := opts.ToPackCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(rootURL(client), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	return
+}
+
+// Get retrieves a conformance pack.
+func Get(client *golangsdk.ServiceClient, id string) (r PackResult) {
+	_, r.Err = client.Get(resourceURL(client, id), &r.Body, nil)
+	return
+}
+
+// Delete deletes a conformance pack together with its policy assignments.
+func Delete(client *golangsdk.ServiceClient, id string) (r DeleteResult) {
+	_, r.Err = client.Delete(resourceURL(client, id), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202, 204},
+	})
+	return
+}
+
+// ListOptsBuilder allows extensions to add additional parameters to the List request.
+type ListOptsBuilder interface {
+	ToPackListQuery() (string, error)
+}
+
+// ListOpts allows to filter the conformance packs.
+type ListOpts struct {
+	Name   string `q:"conformance_pack_name"`
+	Limit  int    `q:"limit"`
+	Marker string `q:"marker"`
+}
+
+// ToPackListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToPackListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), err
+}
+
+// List returns a Pager over the conformance packs of the domain.
+func List(client *golangsdk.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(client)
+	if opts != nil {
+		query, err := opts.ToPackListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		p := PackPage{pagination.MarkerPageBase{PageResult: r}}
+		p.MarkerPageBase.Owner = p
+		return p
+	})
+}
+
+// GetCompliance retrieves the compliance of each policy assignment of a conformance pack.
+func GetCompliance(client *golangsdk.ServiceClient, id string) (r ComplianceResult) {
+	_, r.Err = client.Get(complianceURL(client, id), &r.Body, nil)
+	return
+}
+
+// GetSummary retrieves the compliance of all conformance packs.
+func GetSummary(client *golangsdk.ServiceClient) (r SummaryResult) {
+	_, r.Err = client.Get(summaryURL(client), &r.Body, nil)
+	return
+}
+
+// WaitForStatus waits for the conformance pack to be deployed, e.g. with StatusCreateSuccessful.
+func WaitForStatus(client *golangsdk.ServiceClient, id, status string, secs int) error {
+	return golangsdk.WaitFor(secs, func() (bool, error) {
+		pack, err := Get(client, id).Extract()
+		if err != nil {
+			return false, err
+		}
+		if pack.Status == status {
+			return true, nil
+		}
+		if pack.Status == StatusCreateFailed || pack.Status == StatusRollbackFailed {
+			return false, fmt.Errorf("conformance pack %s is %s: %s", id, pack.Status, pack.ErrorMessage)
+		}
+		return false, nil
+	})
+}
+
+// WaitForDeleted waits for the conformance pack to be deleted.
+func WaitForDeleted(client *golangsdk.ServiceClient, id string, secs int) error {
+	return golangsdk.WaitFor(secs, func() (bool, error) {
+		pack, err := Get(client, id).Extract()
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return true, nil
+			}
+			return false, err
+		}
+		if pack.Status == StatusDeleteFailed {
+			return false, fmt.Errorf("deletion of conformance pack %s failed: %s", id, pack.ErrorMessage)
+		}
+		return false, nil
+	})
+}
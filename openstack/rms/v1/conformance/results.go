@@ -0,0 +1,130 @@
+package conformance
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
+)
+
+// Conformance pack statuses.
+const (
+	StatusCreateInProgress = "CREATE_IN_PROGRESS"
+	StatusCreateSuccessful = "CREATE_SUCCESSFUL"
+	StatusCreateFailed     = "CREATE_FAILED"
+	StatusDeleteInProgress = "DELETE_IN_PROGRESS"
+	StatusDeleteFailed     = "DELETE_FAILED"
+	StatusRollbackFailed   = "ROLLBACK_FAILED"
+)
+
+// Pack is a deployed conformance pack.
+type Pack struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	StackID      string `json:"stack_id"`
+	StackName    string `json:"stack_name"`
+	DeploymentID string `json:"deployment_id"`
+	Status       string `json:"status"`
+	ErrorMessage string `json:"error_message"`
+	TemplateKey  string `json:"template_key"`
+	TemplateURI  string `json:"template_uri"`
+	AgencyName   string `json:"agency_name"`
+	Vars         []Var  `json:"vars_structure"`
+	CreatedAt    string `json:"created_at"`
+	UpdatedAt    string `json:"updated_at"`
+}
+
+// PackResult is the result of Create and Get requests.
+type PackResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as a Pack.
+func (r PackResult) Extract() (*Pack, error) {
+	s := new(Pack)
+	err := r.ExtractInto(s)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// DeleteResult is the result of a Delete request.
+// Call its ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	golangsdk.ErrResult
+}
+
+// PackPage is a single page of conformance packs.
+type PackPage struct {
+	pagination.MarkerPageBase
+}
+
+// IsEmpty checks whether a PackPage is empty.
+func (r PackPage) IsEmpty() (bool, error) {
+	packs, err := ExtractPacks(r)
+	return len(packs) == 0, err
+}
+
+// LastMarker returns the marker of the next page.
+func (r PackPage) LastMarker() (string, error) {
+	var s struct {
+		PageInfo struct {
+			NextMarker string `json:"next_marker"`
+		} `json:"page_info"`
+	}
+	err := r.ExtractInto(&s)
+	return s.PageInfo.NextMarker, err
+}
+
+// NextPageURL returns an empty URL on the last page, which has no next marker.
+func (r PackPage) NextPageURL() (string, error) {
+	marker, err := r.LastMarker()
+	if err != nil || marker == "" {
+		return "", err
+	}
+	return r.MarkerPageBase.NextPageURL()
+}
+
+// ExtractPacks interprets a page of results as a slice of Pack.
+func ExtractPacks(r pagination.Page) ([]Pack, error) {
+	var s []Pack
+	err := (r.(PackPage)).ExtractIntoSlicePtr(&s, "conformance_packs")
+	return s, err
+}
+
+// Compliance is the compliance of a policy assignment of a conformance pack.
+type Compliance struct {
+	PolicyAssignmentID   string `json:"policy_assignment_id"`
+	PolicyAssignmentName string `json:"policy_assignment_name"`
+	Compliance           string `json:"compliance"`
+}
+
+// ComplianceResult is the result of a GetCompliance request.
+type ComplianceResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as a slice of Compliance.
+func (r ComplianceResult) Extract() ([]Compliance, error) {
+	var s []Compliance
+	err := r.ExtractIntoSlicePtr(&s, "value")
+	return s, err
+}
+
+// Summary is the compliance of a conformance pack.
+type Summary struct {
+	ID         string `json:"conformance_pack_id"`
+	Name       string `json:"conformance_pack_name"`
+	Compliance string `json:"compliance"`
+}
+
+// SummaryResult is the result of a GetSummary request.
+type SummaryResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as a slice of Summary.
+func (r SummaryResult) Extract() ([]Summary, error) {
+	var s []Summary
+	err := r.ExtractIntoSlicePtr(&s, "value")
+	return s, err
+}
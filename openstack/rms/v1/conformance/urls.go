@@ -0,0 +1,21 @@
+package conformance
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+const rootPath = "conformance-packs"
+
+func rootURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL(rootPath)
+}
+
+func resourceURL(c *golangsdk.ServiceClient, id string) string {
+	return c.ServiceURL(rootPath, id)
+}
+
+func complianceURL(c *golangsdk.ServiceClient, id string) string {
+	return c.ServiceURL(rootPath, id, "compliance")
+}
+
+func summaryURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL(rootPath, "compliance", "summary")
+}
@@ -0,0 +1,46 @@
+/*
+Package policies manages the RMS policy assignments and queries the compliance
+of the resources.
+
+Example to Assign a Built-in Policy
+
+	assignment, err := policies.CreateAssignment(rmsClient, policies.AssignmentOpts{
+		Name:               "allowed-flavors",
+		PolicyDefinitionID: definitionID,
+		PolicyFilter: &policies.PolicyFilter{
+			ResourceProvider: "ecs",
+			ResourceType:     "cloudservers",
+		},
+		Parameters: map[string]policies.ParameterValue{
+			"listOfAllowedFlavors": {Value: []string{"s3.medium.1"}},
+		},
+	}).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to List the Non-Compliant Resources
+
+	err := policies.Evaluate(rmsClient, assignment.ID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+	err = policies.WaitForEvaluation(rmsClient, assignment.ID, 600)
+	if err != nil {
+		panic(err)
+	}
+
+	pages, err := policies.ListAssignmentStates(rmsClient, assignment.ID, policies.StatesOpts{
+		ComplianceState: policies.NonCompliant,
+	}).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	states, err := policies.ExtractStates(pages)
+	if err != nil {
+		panic(err)
+	}
+*/
+package policies
@@ -0,0 +1,229 @@
+package policies
+
+import (
+	"fmt"
+
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
+)
+
+// Evaluation periods of periodic policy assignments.
+const (
+	PeriodOneHour         = "One_Hour"
+	PeriodThreeHours      = "Three_Hours"
+	PeriodSixHours        = "Six_Hours"
+	PeriodTwelveHours     = "Twelve_Hours"
+	PeriodTwentyFourHours = "TwentyFour_Hours"
+)
+
+// PolicyFilter selects the resources evaluated by a policy assignment.
+type PolicyFilter struct {
+	RegionID         string `json:"region_id,omitempty"`
+	ResourceProvider string `json:"resource_provider,omitempty"`
+	ResourceType     string `json:"resource_type,omitempty"`
+	ResourceID       string `json:"resource_id,omitempty"`
+	TagKey           string `json:"tag_key,omitempty"`
+	TagValue         string `json:"tag_value,omitempty"`
+}
+
+// ParameterValue is the value of a policy definition parameter.
+type ParameterValue struct {
+	Value interface{} `json:"value"`
+}
+
+// AssignmentOptsBuilder allows extensions to add additional parameters to the
+// CreateAssignment and UpdateAssignment requests.
+type AssignmentOptsBuilder interface {
+	ToAssignmentMap() (map[string]interface{}, error)
+}
+
+// AssignmentOpts contains the options to create or update a policy assignment.
+type AssignmentOpts struct {
+	Name        string `json:"name" required:"true"`
+	Description string `json:"description,omitempty"`
+	// PolicyDefinitionID is the ID of a built-in policy definition.
+	PolicyDefinitionID string `json:"policy_definition_id" required:"true"`
+	// Period makes the assignment evaluated periodically instead of on resource changes,
+	// one of the Period* constants.
+	Period       string                    `json:"period,omitempty"`
+	PolicyFilter *PolicyFilter             `json:"policy_filter,omitempty"`
+	Parameters   map[string]ParameterValue `json:"parameters,omitempty"`
+}
+
+// ToAssignmentMap builds a request body from AssignmentOpts.
+func (opts AssignmentOpts) ToAssignmentMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// CreateAssignment assigns a policy to the resources of the domain.
+func CreateAssignment(client *golangsdk.ServiceClient, opts AssignmentOptsBuilder) (r AssignmentResult) {
+	b, err := opts.ToAssignmentMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(assignmentsURL(client), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// GetAssignment retrieves a policy assignment.
+func GetAssignment(client *golangsdk.ServiceClient, id string) (r AssignmentResult) {
+	_, r.Err = client.Get(assignmentURL(client, id), &r.Body, nil)
+	return
+}
+
+// UpdateAssignment changes a policy assignment.
+func UpdateAssignment(client *golangsdk.ServiceClient, id string, opts AssignmentOptsBuilder) (r AssignmentResult) {
+	b, err := opts.ToAssignmentMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(assignmentURL(client, id), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// DeleteAssignment deletes a policy assignment, it must be disabled first.
+func DeleteAssignment(client *golangsdk.ServiceClient, id string) (r ErrorResult) {
+	_, r.Err = client.Delete(assignmentURL(client, id), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return
+}
+
+// EnableAssignment enables a policy assignment.
+func EnableAssignment(client *golangsdk.ServiceClient, id string) (r ErrorResult) {
+	_, r.Err = client.Post(assignmentActionURL(client, id, "enable"), nil, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return
+}
+
+// DisableAssignment disables a policy assignment.
+func DisableAssignment(client *golangsdk.ServiceClient, id string) (r ErrorResult) {
+	_, r.Err = client.Post(assignmentActionURL(client, id, "disable"), nil, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return
+}
+
+// PageOpts contains the paging options of the list requests.
+type PageOpts struct {
+	Limit  int    `q:"limit"`
+	Marker string `q:"marker"`
+}
+
+// ListAssignments returns a Pager over the policy assignments of the domain.
+func ListAssignments(client *golangsdk.ServiceClient, opts PageOpts) pagination.Pager {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return pagination.Pager{Err: err}
+	}
+	return pagination.NewPager(client, assignmentsURL(client)+q.String(), func(r pagination.PageResult) pagination.Page {
+		p := AssignmentPage{pagination.MarkerPageBase{PageResult: r}}
+		p.MarkerPageBase.Owner = p
+		return p
+	})
+}
+
+// Evaluate starts the evaluation of a policy assignment, use WaitForEvaluation to wait until it's finished.
+func Evaluate(client *golangsdk.ServiceClient, id string) (r ErrorResult) {
+	_, r.Err = client.Post(assignmentActionURL(client, id, "policy-states", "run"), nil, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return
+}
+
+// GetEvaluationState retrieves the state of the last evaluation of a policy assignment.
+func GetEvaluationState(client *golangsdk.ServiceClient, id string) (r EvaluationStateResult) {
+	_, r.Err = client.Get(assignmentActionURL(client, id, "policy-states", "evaluation-state"), &r.Body, nil)
+	return
+}
+
+// WaitForEvaluation waits for the evaluation of a policy assignment to finish.
+func WaitForEvaluation(client *golangsdk.ServiceClient, id string, secs int) error {
+	return golangsdk.WaitFor(secs, func() (bool, error) {
+		state, err := GetEvaluationState(client, id).Extract()
+		if err != nil {
+			return false, err
+		}
+		switch state.State {
+		case EvaluationSucceeded:
+			return true, nil
+		case EvaluationFailed:
+			return false, fmt.Errorf("evaluation of policy assignment %s failed: %s", id, state.ErrorMessage)
+		}
+		return false, nil
+	})
+}
+
+// Compliance states.
+const (
+	Compliant    = "Compliant"
+	NonCompliant = "NonCompliant"
+)
+
+// StatesOptsBuilder allows extensions to add additional parameters to the list states requests.
+type StatesOptsBuilder interface {
+	ToStatesListQuery() (string, error)
+}
+
+// StatesOpts allows to filter the evaluation results.
+type StatesOpts struct {
+	// ComplianceState is either Compliant or NonCompliant.
+	ComplianceState string `q:"compliance_state"`
+	ResourceID      string `q:"resource_id"`
+	ResourceName    string `q:"resource_name"`
+	Limit           int    `q:"limit"`
+	Marker          string `q:"marker"`
+}
+
+// ToStatesListQuery formats a StatesOpts into a query string.
+func (opts StatesOpts) ToStatesListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), err
+}
+
+// ListStates returns a Pager over the evaluation results of all policy assignments.
+func ListStates(client *golangsdk.ServiceClient, opts StatesOptsBuilder) pagination.Pager {
+	return listStates(client, statesURL(client), opts)
+}
+
+// ListAssignmentStates returns a Pager over the evaluation results of a policy assignment.
+func ListAssignmentStates(client *golangsdk.ServiceClient, id string, opts StatesOptsBuilder) pagination.Pager {
+	return listStates(client, assignmentActionURL(client, id, "policy-states"), opts)
+}
+
+func listStates(client *golangsdk.ServiceClient, url string, opts StatesOptsBuilder) pagination.Pager {
+	if opts != nil {
+		query, err := opts.ToStatesListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		p := StatePage{pagination.MarkerPageBase{PageResult: r}}
+		p.MarkerPageBase.Owner = p
+		return p
+	})
+}
+
+// ListDefinitions returns the built-in policy definitions.
+func ListDefinitions(client *golangsdk.ServiceClient) (r ListDefinitionsResult) {
+	_, r.Err = client.Get(definitionsURL(client), &r.Body, nil)
+	return
+}
+
+// GetDefinition retrieves a built-in policy definition.
+func GetDefinition(client *golangsdk.ServiceClient, id string) (r DefinitionResult) {
+	_, r.Err = client.Get(definitionsURL(client, id), &r.Body, nil)
+	return
+}
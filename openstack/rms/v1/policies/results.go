@@ -0,0 +1,204 @@
+package policies
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
+)
+
+// Evaluation states.
+const (
+	EvaluationInProgress = "InProgress"
+	EvaluationSucceeded  = "Succeeded"
+	EvaluationFailed     = "Failed"
+)
+
+// Assignment is a policy assigned to the resources of the domain.
+type Assignment struct {
+	ID                   string                    `json:"id"`
+	Name                 string                    `json:"name"`
+	Description          string                    `json:"description"`
+	PolicyAssignmentType string                    `json:"policy_assignment_type"`
+	PolicyDefinitionID   string                    `json:"policy_definition_id"`
+	PolicyFilter         PolicyFilter              `json:"policy_filter"`
+	Period               string                    `json:"period"`
+	State                string                    `json:"state"`
+	Created              string                    `json:"created"`
+	Updated              string                    `json:"updated"`
+	CreatedBy            string                    `json:"created_by"`
+	Parameters           map[string]ParameterValue `json:"parameters"`
+}
+
+// AssignmentResult is the result of CreateAssignment, GetAssignment and UpdateAssignment requests.
+type AssignmentResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as an Assignment.
+func (r AssignmentResult) Extract() (*Assignment, error) {
+	s := new(Assignment)
+	err := r.ExtractInto(s)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// ErrorResult is the result of requests without response body.
+// Call its ExtractErr method to determine if the request succeeded or failed.
+type ErrorResult struct {
+	golangsdk.ErrResult
+}
+
+// EvaluationState is the state of an evaluation of a policy assignment.
+type EvaluationState struct {
+	PolicyAssignmentID string `json:"policy_assignment_id"`
+	State              string `json:"state"`
+	StartTime          string `json:"start_time"`
+	EndTime            string `json:"end_time"`
+	ErrorMessage       string `json:"error_message"`
+}
+
+// EvaluationStateResult is the result of a GetEvaluationState request.
+type EvaluationStateResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as an EvaluationState.
+func (r EvaluationStateResult) Extract() (*EvaluationState, error) {
+	s := new(EvaluationState)
+	err := r.ExtractInto(s)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// State is the evaluation result of a resource.
+type State struct {
+	DomainID             string `json:"domain_id"`
+	RegionID             string `json:"region_id"`
+	ResourceID           string `json:"resource_id"`
+	ResourceName         string `json:"resource_name"`
+	ResourceProvider     string `json:"resource_provider"`
+	ResourceType         string `json:"resource_type"`
+	TriggerType          string `json:"trigger_type"`
+	ComplianceState      string `json:"compliance_state"`
+	PolicyAssignmentID   string `json:"policy_assignment_id"`
+	PolicyAssignmentName string `json:"policy_assignment_name"`
+	PolicyDefinitionID   string `json:"policy_definition_id"`
+	EvaluationTime       string `json:"evaluation_time"`
+}
+
+// Definition is a built-in policy definition.
+type Definition struct {
+	ID             string                 `json:"id"`
+	Name           string                 `json:"name"`
+	PolicyType     string                 `json:"policy_type"`
+	Description    string                 `json:"description"`
+	PolicyRuleType string                 `json:"policy_rule_type"`
+	PolicyRule     interface{}            `json:"policy_rule"`
+	TriggerType    string                 `json:"trigger_type"`
+	Keywords       []string               `json:"keywords"`
+	Parameters     map[string]interface{} `json:"parameters"`
+}
+
+// DefinitionResult is the result of a GetDefinition request.
+type DefinitionResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as a Definition.
+func (r DefinitionResult) Extract() (*Definition, error) {
+	s := new(Definition)
+	err := r.ExtractInto(s)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// ListDefinitionsResult is the result of a ListDefinitions request.
+type ListDefinitionsResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as a slice of Definition.
+func (r ListDefinitionsResult) Extract() ([]Definition, error) {
+	var s []Definition
+	err := r.ExtractIntoSlicePtr(&s, "value")
+	return s, err
+}
+
+func nextPageURL(p pagination.MarkerPageBase) (string, error) {
+	marker, err := lastMarker(p.PageResult)
+	if err != nil || marker == "" {
+		return "", err
+	}
+	return p.NextPageURL()
+}
+
+func lastMarker(r pagination.PageResult) (string, error) {
+	var s struct {
+		PageInfo struct {
+			NextMarker string `json:"next_marker"`
+		} `json:"page_info"`
+	}
+	err := r.ExtractInto(&s)
+	return s.PageInfo.NextMarker, err
+}
+
+// AssignmentPage is a single page of policy assignments.
+type AssignmentPage struct {
+	pagination.MarkerPageBase
+}
+
+// IsEmpty checks whether an AssignmentPage is empty.
+func (r AssignmentPage) IsEmpty() (bool, error) {
+	assignments, err := ExtractAssignments(r)
+	return len(assignments) == 0, err
+}
+
+// LastMarker returns the marker of the next page.
+func (r AssignmentPage) LastMarker() (string, error) {
+	return lastMarker(r.PageResult)
+}
+
+// NextPageURL returns an empty URL on the last page, which has no next marker.
+func (r AssignmentPage) NextPageURL() (string, error) {
+	return nextPageURL(r.MarkerPageBase)
+}
+
+// ExtractAssignments interprets a page of results as a slice of Assignment.
+func ExtractAssignments(r pagination.Page) ([]Assignment, error) {
+	var s []Assignment
+	err := (r.(AssignmentPage)).ExtractIntoSlicePtr(&s, "value")
+	return s, err
+}
+
+// StatePage is a single page of evaluation results.
+type StatePage struct {
+	pagination.MarkerPageBase
+}
+
+// IsEmpty checks whether a StatePage is empty.
+func (r StatePage) IsEmpty() (bool, error) {
+	states, err := ExtractStates(r)
+	return len(states) == 0, err
+}
+
+// LastMarker returns the marker of the next page.
+func (r StatePage) LastMarker() (string, error) {
+	return lastMarker(r.PageResult)
+}
+
+// NextPageURL returns an empty URL on the last page, which has no next marker.
+func (r StatePage) NextPageURL() (string, error) {
+	return nextPageURL(r.MarkerPageBase)
+}
+
+// ExtractStates interprets a page of results as a slice of State.
+func ExtractStates(r pagination.Page) ([]State, error) {
+	var s []State
+	err := (r.(StatePage)).ExtractIntoSlicePtr(&s, "value")
+	return s, err
+}
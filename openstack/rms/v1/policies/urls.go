@@ -0,0 +1,30 @@
+package policies
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+const assignmentsPath = "policy-assignments"
+
+func assignmentsURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL(assignmentsPath)
+}
+
+func assignmentURL(c *golangsdk.ServiceClient, id string) string {
+	return c.ServiceURL(assignmentsPath, id)
+}
+
+func assignmentActionURL(c *golangsdk.ServiceClient, id string, parts ...string) string {
+	return c.ServiceURL(append([]string{assignmentsPath, id}, parts...)...)
+}
+
+func statesURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("policy-states")
+}
+
+// Policy definitions are not scoped to a domain.
+func definitionsURL(c *golangsdk.ServiceClient, parts ...string) string {
+	url := c.Endpoint + "v1/resource-manager/policy-definitions"
+	for _, part := range parts {
+		url += "/" + part
+	}
+	return url
+}
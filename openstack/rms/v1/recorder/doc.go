@@ -0,0 +1,23 @@
+/*
+Package recorder configures the RMS resource recorder, which tracks the
+changes of the resources and delivers them to SMN or OBS.
+
+Example to Enable the Recorder
+
+	err := recorder.Update(rmsClient, recorder.UpdateOpts{
+		Channel: &recorder.Channel{
+			OBS: &recorder.OBSChannel{
+				BucketName: "rms-snapshots",
+				RegionID:   "eu-de",
+			},
+		},
+		Selector: &recorder.Selector{
+			AllSupported: true,
+		},
+		AgencyName: "rms_tracker_agency",
+	}).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package recorder
@@ -0,0 +1,78 @@
+package recorder
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// SMNChannel sends the resource changes to an SMN topic.
+type SMNChannel struct {
+	RegionID  string `json:"region_id" required:"true"`
+	ProjectID string `json:"project_id" required:"true"`
+	TopicURN  string `json:"topic_urn" required:"true"`
+}
+
+// OBSChannel stores the resource snapshots in an OBS bucket.
+type OBSChannel struct {
+	BucketName   string `json:"bucket_name" required:"true"`
+	BucketPrefix string `json:"bucket_prefix,omitempty"`
+	RegionID     string `json:"region_id" required:"true"`
+}
+
+// Channel is the delivery channel of the recorder, at least one of SMN and OBS must be set.
+type Channel struct {
+	SMN *SMNChannel `json:"smn,omitempty"`
+	OBS *OBSChannel `json:"obs,omitempty"`
+}
+
+// Selector selects the recorded resources.
+type Selector struct {
+	// AllSupported records all supported resource types, ResourceTypes is ignored when set.
+	AllSupported bool `json:"all_supported"`
+	// ResourceTypes are e.g. "ecs.cloudservers", "vpc.vpcs".
+	ResourceTypes []string `json:"resource_types"`
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the Update request.
+type UpdateOptsBuilder interface {
+	ToRecorderUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts contains the configuration of the resource recorder.
+type UpdateOpts struct {
+	Channel  *Channel  `json:"channel" required:"true"`
+	Selector *Selector `json:"selector" required:"true"`
+	// AgencyName is the IAM agency allowing RMS to deliver to the channels.
+	AgencyName string `json:"agency_name" required:"true"`
+}
+
+// ToRecorderUpdateMap builds a request body from UpdateOpts.
+func (opts UpdateOpts) ToRecorderUpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Update enables the resource recorder or changes its configuration.
+func Update(client *golangsdk.ServiceClient, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToRecorderUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(rootURL(client), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Get retrieves the configuration of the resource recorder.
+func Get(client *golangsdk.ServiceClient) (r GetResult) {
+	_, r.Err = client.Get(rootURL(client), &r.Body, nil)
+	return
+}
+
+// Delete disables the resource recorder.
+func Delete(client *golangsdk.ServiceClient) (r DeleteResult) {
+	_, r.Err = client.Delete(rootURL(client), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return
+}
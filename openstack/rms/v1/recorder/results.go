@@ -0,0 +1,39 @@
+package recorder
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// Recorder is the configuration of the resource recorder.
+type Recorder struct {
+	Channel    Channel  `json:"channel"`
+	Selector   Selector `json:"selector"`
+	AgencyName string   `json:"agency_name"`
+}
+
+// GetResult is the result of a Get request.
+type GetResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as a Recorder.
+func (r GetResult) Extract() (*Recorder, error) {
+	s := new(Recorder)
+	err := r.ExtractInto(s)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// UpdateResult is the result of an Update request.
+// Call its ExtractErr method to determine if the request succeeded or failed.
+type UpdateResult struct {
+	golangsdk.ErrResult
+}
+
+// DeleteResult is the result of a Delete request.
+// Call its ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	golangsdk.ErrResult
+}
@@ -0,0 +1,7 @@
+package recorder
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+func rootURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("tracker-config")
+}
@@ -0,0 +1,32 @@
+/*
+Package resources queries the inventory of all resources of the domain recorded by RMS.
+
+Example to List All Servers
+
+	pages, err := resources.List(rmsClient, resources.ListOpts{
+		Type: "ecs.cloudservers",
+	}).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	servers, err := resources.ExtractResources(pages)
+	if err != nil {
+		panic(err)
+	}
+
+Example to List the Resources a Server Depends On
+
+	pages, err := resources.ListRelations(rmsClient, serverID, resources.RelationsOpts{
+		Direction: resources.DirectionOut,
+	}).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	relations, err := resources.ExtractRelations(pages)
+	if err != nil {
+		panic(err)
+	}
+*/
+package resources
@@ -0,0 +1,101 @@
+package resources
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the List request.
+type ListOptsBuilder interface {
+	ToResourceListQuery() (string, error)
+}
+
+// ListOpts allows to filter the resources of all services.
+type ListOpts struct {
+	RegionID string `q:"region_id"`
+	// EnterpriseProjectID filters the resources of an enterprise project.
+	EnterpriseProjectID string `q:"ep_id"`
+	// Type is "provider.type", e.g. "ecs.cloudservers".
+	Type   string `q:"type"`
+	ID     string `q:"id"`
+	Name   string `q:"name"`
+	Limit  int    `q:"limit"`
+	Marker string `q:"marker"`
+	// Tags are "key=value" or "key" filters.
+	Tags []string `q:"tags"`
+}
+
+// ToResourceListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToResourceListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), err
+}
+
+// List returns a Pager over the resources of all services of the domain.
+func List(client *golangsdk.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client)
+	if opts != nil {
+		query, err := opts.ToResourceListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		p := ResourcePage{pagination.MarkerPageBase{PageResult: r}}
+		p.MarkerPageBase.Owner = p
+		return p
+	})
+}
+
+// Get retrieves a single resource, e.g. Get(client, "ecs", "cloudservers", serverID).
+func Get(client *golangsdk.ServiceClient, provider, resourceType, id string) (r GetResult) {
+	_, r.Err = client.Get(getURL(client, provider, resourceType, id), &r.Body, nil)
+	return
+}
+
+// Relation directions.
+const (
+	DirectionIn  = "in"
+	DirectionOut = "out"
+)
+
+// RelationsOptsBuilder allows extensions to add additional parameters to the ListRelations request.
+type RelationsOptsBuilder interface {
+	ToRelationsListQuery() (string, error)
+}
+
+// RelationsOpts allows to filter the relations of a resource.
+type RelationsOpts struct {
+	// Direction is either DirectionIn or DirectionOut.
+	Direction string `q:"direction,required"`
+	Limit     int    `q:"limit"`
+	Marker    string `q:"marker"`
+}
+
+// ToRelationsListQuery formats a RelationsOpts into a query string.
+func (opts RelationsOpts) ToRelationsListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), err
+}
+
+// ListRelations returns a Pager over the relations of a resource.
+func ListRelations(client *golangsdk.ServiceClient, id string, opts RelationsOptsBuilder) pagination.Pager {
+	url := relationsURL(client, id)
+	query, err := opts.ToRelationsListQuery()
+	if err != nil {
+		return pagination.Pager{Err: err}
+	}
+	url += query
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		p := RelationPage{pagination.MarkerPageBase{PageResult: r}}
+		p.MarkerPageBase.Owner = p
+		return p
+	})
+}
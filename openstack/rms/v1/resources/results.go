@@ -0,0 +1,126 @@
+package resources
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
+)
+
+// Resource is a resource recorded by RMS.
+type Resource struct {
+	ID                    string                 `json:"id"`
+	Name                  string                 `json:"name"`
+	Provider              string                 `json:"provider"`
+	Type                  string                 `json:"type"`
+	RegionID              string                 `json:"region_id"`
+	ProjectID             string                 `json:"project_id"`
+	ProjectName           string                 `json:"project_name"`
+	EnterpriseProjectID   string                 `json:"ep_id"`
+	EnterpriseProjectName string                 `json:"ep_name"`
+	Checksum              string                 `json:"checksum"`
+	Created               string                 `json:"created"`
+	Updated               string                 `json:"updated"`
+	ProvisioningState     string                 `json:"provisioning_state"`
+	Tags                  map[string]string      `json:"tags"`
+	Properties            map[string]interface{} `json:"properties"`
+}
+
+// Relation is a relation between two resources, e.g. a server and its volume.
+type Relation struct {
+	RelationType     string `json:"relation_type"`
+	FromResourceType string `json:"from_resource_type"`
+	ToResourceType   string `json:"to_resource_type"`
+	FromResourceID   string `json:"from_resource_id"`
+	ToResourceID     string `json:"to_resource_id"`
+}
+
+type pageInfo struct {
+	CurrentCount int    `json:"current_count"`
+	NextMarker   string `json:"next_marker"`
+}
+
+func lastMarker(r pagination.PageResult) (string, error) {
+	var s struct {
+		PageInfo pageInfo `json:"page_info"`
+	}
+	err := r.ExtractInto(&s)
+	return s.PageInfo.NextMarker, err
+}
+
+func nextPageURL(p pagination.MarkerPageBase) (string, error) {
+	marker, err := lastMarker(p.PageResult)
+	if err != nil || marker == "" {
+		return "", err
+	}
+	return p.NextPageURL()
+}
+
+// ResourcePage is a single page of resources.
+type ResourcePage struct {
+	pagination.MarkerPageBase
+}
+
+// IsEmpty checks whether a ResourcePage is empty.
+func (r ResourcePage) IsEmpty() (bool, error) {
+	resources, err := ExtractResources(r)
+	return len(resources) == 0, err
+}
+
+// LastMarker returns the marker of the next page.
+func (r ResourcePage) LastMarker() (string, error) {
+	return lastMarker(r.PageResult)
+}
+
+// NextPageURL returns an empty URL on the last page, which has no next marker.
+func (r ResourcePage) NextPageURL() (string, error) {
+	return nextPageURL(r.MarkerPageBase)
+}
+
+// ExtractResources interprets a page of results as a slice of Resource.
+func ExtractResources(r pagination.Page) ([]Resource, error) {
+	var s []Resource
+	err := (r.(ResourcePage)).ExtractIntoSlicePtr(&s, "resources")
+	return s, err
+}
+
+// RelationPage is a single page of relations.
+type RelationPage struct {
+	pagination.MarkerPageBase
+}
+
+// IsEmpty checks whether a RelationPage is empty.
+func (r RelationPage) IsEmpty() (bool, error) {
+	relations, err := ExtractRelations(r)
+	return len(relations) == 0, err
+}
+
+// LastMarker returns the marker of the next page.
+func (r RelationPage) LastMarker() (string, error) {
+	return lastMarker(r.PageResult)
+}
+
+// NextPageURL returns an empty URL on the last page, which has no next marker.
+func (r RelationPage) NextPageURL() (string, error) {
+	return nextPageURL(r.MarkerPageBase)
+}
+
+// ExtractRelations interprets a page of results as a slice of Relation.
+func ExtractRelations(r pagination.Page) ([]Relation, error) {
+	var s []Relation
+	err := (r.(RelationPage)).ExtractIntoSlicePtr(&s, "relations")
+	return s, err
+}
+
+// GetResult is the result of a Get request.
+type GetResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as a Resource.
+func (r GetResult) Extract() (*Resource, error) {
+	s := new(Resource)
+	err := r.ExtractInto(s)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
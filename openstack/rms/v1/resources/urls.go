@@ -0,0 +1,15 @@
+package resources
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+func listURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("all-resources")
+}
+
+func getURL(c *golangsdk.ServiceClient, provider, resourceType, id string) string {
+	return c.ServiceURL("provider", provider, "type", resourceType, "resources", id)
+}
+
+func relationsURL(c *golangsdk.ServiceClient, id string) string {
+	return c.ServiceURL("all-resources", id, "relations")
+}
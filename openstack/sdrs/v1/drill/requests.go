@@ -3,6 +3,7 @@ package drill
 import (
 	"github.com/opentelekomcloud/gophertelekomcloud"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack"
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
 )
 
 // CreateOptsBuilder allows extensions to add additional parameters to the
@@ -80,3 +81,44 @@ func Delete(c *golangsdk.ServiceClient, id string) (r JobResult) {
 	_, r.Err = c.DeleteWithResponse(resourceURL(c, id), &r.Body, reqOpt)
 	return
 }
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToDrillListQuery() (string, error)
+}
+
+// ListOpts allows filtering of the dr-drill list.
+type ListOpts struct {
+	GroupID    string `q:"server_group_id"`
+	Name       string `q:"name"`
+	Status     string `q:"status"`
+	DrillVpcID string `q:"drill_vpc_id"`
+	Limit      int    `q:"limit"`
+	Offset     int    `q:"offset"`
+}
+
+// ToDrillListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToDrillListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), nil
+}
+
+// List returns a Pager which allows you to iterate over a collection of dr-drills.
+func List(c *golangsdk.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(c)
+	if opts != nil {
+		q, err := opts.ToDrillListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += q
+	}
+
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return DrillPage{pagination.SinglePageBase(r)}
+	})
+}
@@ -2,6 +2,7 @@ package drill
 
 import (
 	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
 )
 
 type Drill struct {
@@ -52,3 +53,22 @@ type UpdateResult struct {
 type GetResult struct {
 	commonResult
 }
+
+// DrillPage is a struct which can do the page function
+type DrillPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty determines whether or not a DrillPage is empty.
+func (r DrillPage) IsEmpty() (bool, error) {
+	drills, err := ExtractDrills(r)
+	return len(drills) == 0, err
+}
+
+// ExtractDrills interprets the results of a single page from
+// a List() API call, producing a slice of []Drill structures.
+func ExtractDrills(r pagination.Page) ([]Drill, error) {
+	var s []Drill
+	err := (r.(DrillPage)).ExtractIntoSlicePtr(&s, "disaster_recovery_drills")
+	return s, err
+}
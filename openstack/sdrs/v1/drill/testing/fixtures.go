@@ -0,0 +1,48 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const (
+	drillID = "2a2a3b4c-3e2b-4d0a-9a3b-a1b2c3d4e5f6"
+	groupID = "0b0ec0ab-3a44-4d44-b8b7-3b4f8e6d1a5e"
+)
+
+var listResponse = fmt.Sprintf(`
+{
+  "disaster_recovery_drills": [
+    {
+      "id": "%s",
+      "name": "drill",
+      "status": "available",
+      "drill_vpc_id": "8ad5f6f2-d4d8-4d4b-9e54-5a0f0e9e0b38",
+      "server_group_id": "%s",
+      "drill_servers": [
+        {
+          "protected_instance": "b2d6c1a5-1d3b-4d4f-9c7a-f0d0e3a1b2c3",
+          "drill_server_id": "d1e2f3a4-b5c6-4d7e-8f90-a1b2c3d4e5f6"
+        }
+      ]
+    }
+  ]
+}`, drillID, groupID)
+
+// HandleListSuccessfully creates an HTTP handler at `/disaster-recovery-drills` on the test handler
+// mux that responds to a GET request with listResponse.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/disaster-recovery-drills", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"server_group_id": groupID})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, listResponse)
+	})
+}
@@ -0,0 +1,25 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/sdrs/v1/drill"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	pages, err := drill.List(fake.ServiceClient(), drill.ListOpts{GroupID: groupID}).AllPages()
+	th.AssertNoErr(t, err)
+	drills, err := drill.ExtractDrills(pages)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 1, len(drills))
+	th.AssertEquals(t, drillID, drills[0].Id)
+	th.AssertEquals(t, groupID, drills[0].GroupID)
+	th.AssertEquals(t, 1, len(drills[0].Servers))
+}
@@ -3,6 +3,7 @@ package protectiongroups
 import (
 	"github.com/opentelekomcloud/gophertelekomcloud"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack"
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
 )
 
 // CreateOptsBuilder allows extensions to add additional parameters to the
@@ -133,3 +134,106 @@ func Disable(c *golangsdk.ServiceClient, id string) (r JobResult) {
 	_, r.Err = c.Post(actionURL(c, id), b, &r.Body, reqOpt)
 	return
 }
+
+// FailoverOpts contains all the values needed to fail over a Group.
+type FailoverOpts struct {
+	// Empty
+}
+
+// ToGroupFailoverMap builds a failover request body from FailoverOpts.
+func (opts FailoverOpts) ToGroupFailoverMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "failover-server-group")
+}
+
+// Failover will fail over a protection Group to the target AZ after the
+// production site has become unavailable.
+func Failover(c *golangsdk.ServiceClient, id string) (r JobResult) {
+	opts := FailoverOpts{}
+	b, err := opts.ToGroupFailoverMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{200}}
+	_, r.Err = c.Post(actionURL(c, id), b, &r.Body, reqOpt)
+	return
+}
+
+const (
+	PriorityStationSource = "source"
+	PriorityStationTarget = "target"
+)
+
+// ReverseOptsBuilder allows extensions to add additional parameters to the
+// Reverse request.
+type ReverseOptsBuilder interface {
+	ToGroupReverseMap() (map[string]interface{}, error)
+}
+
+// ReverseOpts contains all the values needed to perform a planned failover
+// or failback of a Group.
+type ReverseOpts struct {
+	// The site to which the service is switched, `source` or `target`
+	PriorityStation string `json:"priority_station" required:"true"`
+}
+
+// ToGroupReverseMap builds a reverse request body from ReverseOpts.
+func (opts ReverseOpts) ToGroupReverseMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "reverse-server-group")
+}
+
+// Reverse will perform a planned failover (switchover) of a protection Group.
+// Switching the priority station to `target` fails the Group over, switching
+// it back to `source` fails the Group back.
+func Reverse(c *golangsdk.ServiceClient, id string, opts ReverseOptsBuilder) (r JobResult) {
+	b, err := opts.ToGroupReverseMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{200}}
+	_, r.Err = c.Post(actionURL(c, id), b, &r.Body, reqOpt)
+	return
+}
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToGroupListQuery() (string, error)
+}
+
+// ListOpts allows filtering of the protection Group list.
+type ListOpts struct {
+	Limit            int    `q:"limit"`
+	Offset           int    `q:"offset"`
+	Status           string `q:"status"`
+	Name             string `q:"name"`
+	QueryType        string `q:"query_type"`
+	AvailabilityZone string `q:"availability_zone"`
+}
+
+// ToGroupListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToGroupListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), nil
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// protection Groups.
+func List(c *golangsdk.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(c)
+	if opts != nil {
+		q, err := opts.ToGroupListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += q
+	}
+
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return GroupPage{pagination.SinglePageBase(r)}
+	})
+}
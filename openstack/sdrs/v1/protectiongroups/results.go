@@ -2,6 +2,7 @@ package protectiongroups
 
 import (
 	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
 )
 
 type Group struct {
@@ -21,6 +22,28 @@ type Group struct {
 	SourceVpcID string `json:"source_vpc_id"`
 	// Deployment model
 	DrType string `json:"dr_type"`
+	// Group Status
+	Status string `json:"status"`
+	// The current production site, `source` or `target`
+	PriorityStation string `json:"priority_station"`
+	// Whether protection is enabled
+	ProtectedStatus string `json:"protected_status"`
+	// Replication status
+	ReplicationStatus string `json:"replication_status"`
+	// Health status
+	HealthStatus string `json:"health_status"`
+	// Number of protected instances
+	ProtectedInstanceNum int `json:"protected_instance_num"`
+	// Number of replication pairs
+	ReplicationNum int `json:"replication_num"`
+	// Number of DR drills
+	DrillNum int `json:"disaster_recovery_drill_num"`
+	// ID of the target VPC
+	TargetVpcID string `json:"target_vpc_id"`
+	// Creation time
+	CreatedAt string `json:"created_at"`
+	// Update time
+	UpdatedAt string `json:"updated_at"`
 }
 
 type commonResult struct {
@@ -49,3 +72,22 @@ type UpdateResult struct {
 type GetResult struct {
 	commonResult
 }
+
+// GroupPage is a struct which can do the page function
+type GroupPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty determines whether or not a GroupPage is empty.
+func (r GroupPage) IsEmpty() (bool, error) {
+	groups, err := ExtractGroups(r)
+	return len(groups) == 0, err
+}
+
+// ExtractGroups interprets the results of a single page from
+// a List() API call, producing a slice of []Group structures.
+func ExtractGroups(r pagination.Page) ([]Group, error) {
+	var s []Group
+	err := (r.(GroupPage)).ExtractIntoSlicePtr(&s, "server_groups")
+	return s, err
+}
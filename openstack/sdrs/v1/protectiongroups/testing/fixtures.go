@@ -0,0 +1,88 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const (
+	groupID = "0b0ec0ab-3a44-4d44-b8b7-3b4f8e6d1a5e"
+	jobID   = "0b0ec0ab6a0dd2b1016a0e3d9b4f001f"
+)
+
+const expectedFailoverRequest = `
+{
+  "failover-server-group": {}
+}`
+
+const expectedReverseRequest = `
+{
+  "reverse-server-group": {
+    "priority_station": "target"
+  }
+}`
+
+var jobResponse = fmt.Sprintf(`
+{
+  "job_id": "%s"
+}`, jobID)
+
+var listResponse = fmt.Sprintf(`
+{
+  "server_groups": [
+    {
+      "id": "%s",
+      "name": "sdrs-group",
+      "description": "",
+      "status": "available",
+      "source_availability_zone": "eu-de-02",
+      "target_availability_zone": "eu-de-01",
+      "domain_id": "6d67c7a5-6b6d-4f2e-a1c1-7d0c8e0e5a60",
+      "source_vpc_id": "5b8e0d6c-e4d0-4c11-9b0c-6f2b3f5d6a0e",
+      "target_vpc_id": "5b8e0d6c-e4d0-4c11-9b0c-6f2b3f5d6a0e",
+      "dr_type": "migration",
+      "priority_station": "source",
+      "protected_status": "started",
+      "replication_status": "active",
+      "health_status": "normal",
+      "protected_instance_num": 1,
+      "replication_num": 1,
+      "disaster_recovery_drill_num": 0,
+      "created_at": "2019-05-06 09:34:19.592",
+      "updated_at": "2019-05-06 09:37:33.024"
+    }
+  ],
+  "count": 1
+}`, groupID)
+
+// HandleGroupActionSuccessfully creates an HTTP handler at `/server-groups/{group_id}/action` on
+// the test handler mux that responds to a POST request with jobResponse.
+func HandleGroupActionSuccessfully(t *testing.T, expectedRequest string) {
+	th.Mux.HandleFunc(fmt.Sprintf("/server-groups/%s/action", groupID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, expectedRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, jobResponse)
+	})
+}
+
+// HandleListSuccessfully creates an HTTP handler at `/server-groups` on the test handler mux that
+// responds to a GET request with listResponse.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/server-groups", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"status": "available"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, listResponse)
+	})
+}
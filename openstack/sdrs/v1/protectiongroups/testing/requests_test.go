@@ -0,0 +1,52 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/sdrs/v1/protectiongroups"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestFailover(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGroupActionSuccessfully(t, expectedFailoverRequest)
+
+	job, err := protectiongroups.Failover(fake.ServiceClient(), groupID).ExtractJobResponse()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, jobID, job.JobID)
+}
+
+func TestReverse(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGroupActionSuccessfully(t, expectedReverseRequest)
+
+	opts := protectiongroups.ReverseOpts{PriorityStation: protectiongroups.PriorityStationTarget}
+	job, err := protectiongroups.Reverse(fake.ServiceClient(), groupID, opts).ExtractJobResponse()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, jobID, job.JobID)
+}
+
+func TestReverseRequiresPriorityStation(t *testing.T) {
+	_, err := protectiongroups.ReverseOpts{}.ToGroupReverseMap()
+	th.AssertEquals(t, true, err != nil)
+}
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	pages, err := protectiongroups.List(fake.ServiceClient(), protectiongroups.ListOpts{Status: "available"}).AllPages()
+	th.AssertNoErr(t, err)
+	groups, err := protectiongroups.ExtractGroups(pages)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 1, len(groups))
+	th.AssertEquals(t, groupID, groups[0].Id)
+	th.AssertEquals(t, protectiongroups.PriorityStationSource, groups[0].PriorityStation)
+	th.AssertEquals(t, "started", groups[0].ProtectedStatus)
+	th.AssertEquals(t, 1, groups[0].ProtectedInstanceNum)
+}
@@ -1,8 +1,11 @@
 package replications
 
 import (
+	"strings"
+
 	"github.com/opentelekomcloud/gophertelekomcloud"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack"
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
 )
 
 // CreateOptsBuilder allows extensions to add additional parameters to the
@@ -105,3 +108,53 @@ func Delete(c *golangsdk.ServiceClient, id string, opts DeleteOptsBuilder) (r Jo
 	_, r.Err = c.DeleteWithBodyResp(resourceURL(c, id), b, &r.Body, reqOpt)
 	return
 }
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToReplicationListQuery() (string, error)
+}
+
+// ListOpts allows filtering of the Replication list.
+type ListOpts struct {
+	GroupID string `q:"server_group_id"`
+	// Protection group IDs, sent in the format of ['server_group_id1','server_group_id2']
+	GroupIDs            []string
+	ProtectedInstanceID string `q:"protected_instance_id"`
+	Name                string `q:"name"`
+	Status              string `q:"status"`
+	QueryType           string `q:"query_type"`
+	AvailabilityZone    string `q:"availability_zone"`
+	Limit               int    `q:"limit"`
+	Offset              int    `q:"offset"`
+}
+
+// ToReplicationListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToReplicationListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	if len(opts.GroupIDs) > 0 {
+		params := q.Query()
+		params.Add("server_group_ids", "['"+strings.Join(opts.GroupIDs, "','")+"']")
+		q.RawQuery = params.Encode()
+	}
+	return q.String(), nil
+}
+
+// List returns a Pager which allows you to iterate over a collection of Replications.
+func List(c *golangsdk.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(c)
+	if opts != nil {
+		q, err := opts.ToReplicationListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += q
+	}
+
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return ReplicationPage{pagination.SinglePageBase(r)}
+	})
+}
@@ -2,6 +2,7 @@ package replications
 
 import (
 	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
 )
 
 type Replication struct {
@@ -73,3 +74,22 @@ type UpdateResult struct {
 type GetResult struct {
 	commonResult
 }
+
+// ReplicationPage is a struct which can do the page function
+type ReplicationPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty determines whether or not a ReplicationPage is empty.
+func (r ReplicationPage) IsEmpty() (bool, error) {
+	replications, err := ExtractReplications(r)
+	return len(replications) == 0, err
+}
+
+// ExtractReplications interprets the results of a single page from
+// a List() API call, producing a slice of []Replication structures.
+func ExtractReplications(r pagination.Page) ([]Replication, error) {
+	var s []Replication
+	err := (r.(ReplicationPage)).ExtractIntoSlicePtr(&s, "replications")
+	return s, err
+}
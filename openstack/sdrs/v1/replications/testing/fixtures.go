@@ -0,0 +1,48 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const (
+	replicationID = "5f1e2d3c-4b5a-4978-8a1b-2c3d4e5f6a7b"
+	groupID       = "0b0ec0ab-3a44-4d44-b8b7-3b4f8e6d1a5e"
+	otherGroupID  = "9c8b7a6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d"
+)
+
+var listResponse = fmt.Sprintf(`
+{
+  "replications": [
+    {
+      "id": "%s",
+      "name": "replication",
+      "status": "available",
+      "replication_model": "hypermetro",
+      "server_group_id": "%s",
+      "priority_station": "source"
+    }
+  ]
+}`, replicationID, groupID)
+
+// HandleListSuccessfully creates an HTTP handler at `/replications` on the test handler mux that
+// responds to a GET request with listResponse.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/replications", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"server_group_ids": fmt.Sprintf("['%s','%s']", groupID, otherGroupID),
+			"status":           "available",
+		})
+		th.AssertEquals(t, 1, len(r.URL.Query()["server_group_ids"]))
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, listResponse)
+	})
+}
@@ -0,0 +1,28 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/sdrs/v1/replications"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	opts := replications.ListOpts{
+		GroupIDs: []string{groupID, otherGroupID},
+		Status:   "available",
+	}
+	pages, err := replications.List(fake.ServiceClient(), opts).AllPages()
+	th.AssertNoErr(t, err)
+	list, err := replications.ExtractReplications(pages)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 1, len(list))
+	th.AssertEquals(t, replicationID, list[0].Id)
+	th.AssertEquals(t, groupID, list[0].GroupID)
+}
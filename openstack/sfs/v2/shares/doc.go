@@ -79,5 +79,12 @@ Example to Extend share
 Example to Shrink share
 	shrinksfs:=shares.ShrinkOpts{OSShrink: shares.OSShrinkOpts{NewSize: 8}}
 	shares.Shrink(client,"45a3af18-8ab0-405c-9ead-06c51a415f79",shrinksfs)
+
+Example to Set share metadata
+	metadataOpts := shares.MetadataOpts{Metadata: map[string]string{"project": "my_app"}}
+	metadata, err := shares.SetMetadata(client, "45a3af18-8ab0-405c-9ead-06c51a415f79", metadataOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
 */
 package shares
@@ -157,7 +157,8 @@ type CreateOpts struct {
 	SnapshotID string `json:"snapshot_id,omitempty"`
 	// Determines whether or not the share is public
 	IsPublic bool `json:"is_public,omitempty"`
-	// Key value pairs of user defined metadata
+	// Key value pairs of user defined metadata,
+	// `#sys_enterprise_project_id` sets the enterprise project the share is created in
 	Metadata map[string]string `json:"metadata,omitempty"`
 	// The UUID of the share network to which the share belongs to
 	ShareNetworkID string `json:"share_network_id,omitempty"`
@@ -397,3 +398,63 @@ func Shrink(client *golangsdk.ServiceClient, share_id string, opts ShrinkOptsBui
 	})
 	return
 }
+
+// GetMetadata retrieves metadata of the specified Share.
+// To extract the metadata map, call the Extract method on the MetadataResult.
+func GetMetadata(client *golangsdk.ServiceClient, id string) (r MetadataResult) {
+	_, r.Err = client.Get(metadataURL(client, id), &r.Body, nil)
+	return
+}
+
+// MetadataOptsBuilder allows extensions to add additional parameters to the
+// SetMetadata and UpdateMetadata requests.
+type MetadataOptsBuilder interface {
+	ToShareMetadataMap() (map[string]interface{}, error)
+}
+
+// MetadataOpts contains the key-value pairs to be set as Share metadata.
+type MetadataOpts struct {
+	// Specifies the metadata of the shared file system.
+	Metadata map[string]string `json:"metadata" required:"true"`
+}
+
+// ToShareMetadataMap assembles a request body based on the contents of a
+// MetadataOpts.
+func (opts MetadataOpts) ToShareMetadataMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// SetMetadata adds the given key-value pairs to the Share metadata.
+// Existing keys are overwritten, other keys are preserved.
+func SetMetadata(client *golangsdk.ServiceClient, id string, opts MetadataOptsBuilder) (r MetadataResult) {
+	b, err := opts.ToShareMetadataMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(metadataURL(client, id), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// UpdateMetadata replaces all metadata of the Share with the given key-value pairs.
+func UpdateMetadata(client *golangsdk.ServiceClient, id string, opts MetadataOptsBuilder) (r MetadataResult) {
+	b, err := opts.ToShareMetadataMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(metadataURL(client, id), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// DeleteMetadatum removes the single metadata key from the Share.
+func DeleteMetadatum(client *golangsdk.ServiceClient, id, key string) (r DeleteMetadatumResult) {
+	_, r.Err = client.Delete(metadatumURL(client, id, key), &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
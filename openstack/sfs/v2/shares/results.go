@@ -224,3 +224,22 @@ type ExpandResult struct {
 type ShrinkResult struct {
 	golangsdk.ErrResult
 }
+
+// MetadataResult contains the response body and error from a metadata request.
+type MetadataResult struct {
+	golangsdk.Result
+}
+
+// Extract will get the metadata map from the MetadataResult
+func (r MetadataResult) Extract() (map[string]string, error) {
+	var s struct {
+		Metadata map[string]string `json:"metadata"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Metadata, err
+}
+
+// DeleteMetadatumResult contains the response body and error from a DeleteMetadatum request.
+type DeleteMetadatumResult struct {
+	golangsdk.ErrResult
+}
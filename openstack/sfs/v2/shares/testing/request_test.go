@@ -287,3 +287,101 @@ func TestShrink(t *testing.T) {
 	th.AssertNoErr(t, resp.Err)
 
 }
+
+func TestGetMetadata(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc(shareEndpoint+"/"+shareID+"/metadata", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, `{"metadata": {"project": "my_app", "aim": "doc"}}`)
+	})
+
+	actual, err := shares.GetMetadata(fake.ServiceClient(), shareID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, map[string]string{"project": "my_app", "aim": "doc"}, actual)
+}
+
+func TestSetMetadata(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc(shareEndpoint+"/"+shareID+"/metadata", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `{"metadata": {"aim": "doc"}}`)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, `{"metadata": {"project": "my_app", "aim": "doc"}}`)
+	})
+
+	opts := shares.MetadataOpts{Metadata: map[string]string{"aim": "doc"}}
+	actual, err := shares.SetMetadata(fake.ServiceClient(), shareID, opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, map[string]string{"project": "my_app", "aim": "doc"}, actual)
+}
+
+func TestUpdateMetadata(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc(shareEndpoint+"/"+shareID+"/metadata", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `{"metadata": {"aim": "doc"}}`)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, `{"metadata": {"aim": "doc"}}`)
+	})
+
+	opts := shares.MetadataOpts{Metadata: map[string]string{"aim": "doc"}}
+	actual, err := shares.UpdateMetadata(fake.ServiceClient(), shareID, opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, map[string]string{"aim": "doc"}, actual)
+}
+
+func TestDeleteMetadatum(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc(shareEndpoint+"/"+shareID+"/metadata/aim", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusOK)
+	})
+
+	err := shares.DeleteMetadatum(fake.ServiceClient(), shareID, "aim").ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestWaitForStatus(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	MockGetResponse(t)
+
+	th.AssertNoErr(t, shares.WaitForStatus(client.ServiceClient(), shareID, "available", 10))
+}
+
+func TestWaitForStatusError(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	polls := 0
+	th.Mux.HandleFunc(shareEndpoint+"/"+shareID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		polls++
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, `{"share": {"id": "%s", "status": "extending_error"}}`, shareID)
+	})
+
+	err := shares.WaitForStatus(client.ServiceClient(), shareID, "available", 10)
+	if err == nil {
+		t.Fatal("expected an error for a share in extending_error status")
+	}
+	th.AssertEquals(t, 1, polls)
+}
@@ -28,3 +28,11 @@ func listURL(c *golangsdk.ServiceClient) string {
 func grantAccessURL(c *golangsdk.ServiceClient, id string) string {
 	return c.ServiceURL("shares", id, "action")
 }
+
+func metadataURL(c *golangsdk.ServiceClient, id string) string {
+	return c.ServiceURL("shares", id, "metadata")
+}
+
+func metadatumURL(c *golangsdk.ServiceClient, id, key string) string {
+	return c.ServiceURL("shares", id, "metadata", key)
+}
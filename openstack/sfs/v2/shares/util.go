@@ -0,0 +1,30 @@
+package shares
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// WaitForStatus will continually poll the resource, checking for a particular
+// status. It will do this for the amount of seconds defined and return an
+// error as soon as the share reaches an error or failed status instead.
+func WaitForStatus(c *golangsdk.ServiceClient, id, status string, secs int) error {
+	return golangsdk.WaitFor(secs, func() (bool, error) {
+		current, err := Get(c, id).Extract()
+		if err != nil {
+			return false, err
+		}
+
+		if current.Status == status {
+			return true, nil
+		}
+
+		if strings.Contains(current.Status, "error") || strings.HasSuffix(current.Status, "_failed") {
+			return false, fmt.Errorf("share %s went into status %s while waiting for %s", id, current.Status, status)
+		}
+
+		return false, nil
+	})
+}
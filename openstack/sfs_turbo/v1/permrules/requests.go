@@ -0,0 +1,103 @@
+package permrules
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToPermRuleCreateMap() (map[string]interface{}, error)
+}
+
+// RuleOpts contains the parameters of a single permission rule.
+type RuleOpts struct {
+	// IP address or IP address range of the authorized object, e.g. 192.168.0.0/16.
+	IPCidr string `json:"ip_cidr" required:"true"`
+	// Read/write permission of the authorized object: `rw` (default), `ro` or `none`.
+	RWType string `json:"rw_type,omitempty"`
+	// File system access permission granted to the user of the authorized object:
+	// `no_root_squash` (default), `root_squash` or `all_squash`.
+	UserType string `json:"user_type,omitempty"`
+}
+
+// CreateOpts contains the options for creating permission rules of an SFS Turbo file system.
+// This object is passed to permrules.Create().
+type CreateOpts struct {
+	// Permission rules, up to 64 rules can be added.
+	Rules []RuleOpts `json:"rules" required:"true"`
+}
+
+// ToPermRuleCreateMap assembles a request body based on the contents of a
+// CreateOpts.
+func (opts CreateOpts) ToPermRuleCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Create will create new permission rules based on the values in CreateOpts. To extract
+// the created rules from the response, call the Extract method on the CreateResult.
+func Create(client *golangsdk.ServiceClient, shareID string, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToPermRuleCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(rootURL(client, shareID), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	return
+}
+
+// List will get all permission rules of the SFS Turbo file system.
+func List(client *golangsdk.ServiceClient, shareID string) (r ListResult) {
+	_, r.Err = client.Get(rootURL(client, shareID), &r.Body, nil)
+	return
+}
+
+// Get will get a single permission rule with the given ID.
+func Get(client *golangsdk.ServiceClient, shareID, ruleID string) (r GetResult) {
+	_, r.Err = client.Get(resourceURL(client, shareID, ruleID), &r.Body, nil)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToPermRuleUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts contains the options for updating a permission rule.
+type UpdateOpts struct {
+	// Read/write permission of the authorized object: `rw`, `ro` or `none`.
+	RWType string `json:"rw_type,omitempty"`
+	// File system access permission granted to the user of the authorized object:
+	// `no_root_squash`, `root_squash` or `all_squash`.
+	UserType string `json:"user_type,omitempty"`
+}
+
+// ToPermRuleUpdateMap assembles a request body based on the contents of a
+// UpdateOpts.
+func (opts UpdateOpts) ToPermRuleUpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "rule")
+}
+
+// Update will update the permission rule with the given ID based on the values in UpdateOpts.
+func Update(client *golangsdk.ServiceClient, shareID, ruleID string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToPermRuleUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(resourceURL(client, shareID, ruleID), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete will delete the permission rule with the given ID.
+func Delete(client *golangsdk.ServiceClient, shareID, ruleID string) (r DeleteResult) {
+	_, r.Err = client.Delete(resourceURL(client, shareID, ruleID), &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return
+}
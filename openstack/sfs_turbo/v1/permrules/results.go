@@ -0,0 +1,67 @@
+package permrules
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// PermRule contains the information of an SFS Turbo permission rule
+type PermRule struct {
+	// The ID of the permission rule
+	ID string `json:"id"`
+	// The IP address or IP address range of the authorized object
+	IPCidr string `json:"ip_cidr"`
+	// The read/write permission of the authorized object
+	RWType string `json:"rw_type"`
+	// The file system access permission granted to the user of the authorized object
+	UserType string `json:"user_type"`
+}
+
+type multipleRulesResult struct {
+	golangsdk.Result
+}
+
+// Extract will get the permission rules from the result
+func (r multipleRulesResult) Extract() ([]PermRule, error) {
+	var s []PermRule
+	err := r.ExtractIntoSlicePtr(&s, "rules")
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+type singleRuleResult struct {
+	golangsdk.Result
+}
+
+// Extract will get the permission rule from the result
+func (r singleRuleResult) Extract() (*PermRule, error) {
+	var s PermRule
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// CreateResult contains the response body and error from a Create request.
+type CreateResult struct {
+	multipleRulesResult
+}
+
+// ListResult contains the response body and error from a List request.
+type ListResult struct {
+	multipleRulesResult
+}
+
+// GetResult contains the response body and error from a Get request.
+type GetResult struct {
+	singleRuleResult
+}
+
+// UpdateResult contains the response body and error from an Update request.
+type UpdateResult struct {
+	singleRuleResult
+}
+
+// DeleteResult contains the error from a Delete request.
+type DeleteResult struct {
+	golangsdk.ErrResult
+}
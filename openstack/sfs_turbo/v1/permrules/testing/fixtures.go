@@ -0,0 +1,80 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const (
+	shareID = "8fba8253-c914-439d-ae8b-d5c89091183a"
+	ruleID  = "1131ed520xxxxxxebedb6e57xxxxxxxx"
+)
+
+const expectedCreateRequest = `
+{
+  "rules" : [ {
+    "ip_cidr" : "192.168.5.0/24",
+    "rw_type" : "rw",
+    "user_type" : "no_root_squash"
+  } ]
+}`
+
+const expectedListResponse = `
+{
+  "rules" : [ {
+    "id" : "1131ed520xxxxxxebedb6e57xxxxxxxx",
+    "ip_cidr" : "192.168.5.0/24",
+    "rw_type" : "rw",
+    "user_type" : "no_root_squash"
+  } ]
+}`
+
+const expectedUpdateResponse = `
+{
+  "id" : "1131ed520xxxxxxebedb6e57xxxxxxxx",
+  "ip_cidr" : "192.168.5.0/24",
+  "rw_type" : "ro",
+  "user_type" : "root_squash"
+}`
+
+// HandleCreateSuccessfully creates an HTTP handler at `/sfs-turbo/shares/{share_id}/fs/perm-rules`
+// on the test handler mux that responds to a POST request with expectedListResponse.
+func HandleCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/sfs-turbo/shares/%s/fs/perm-rules", shareID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, expectedCreateRequest)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, expectedListResponse)
+	})
+}
+
+// HandleUpdateSuccessfully creates an HTTP handler at
+// `/sfs-turbo/shares/{share_id}/fs/perm-rules/{rule_id}` on the test handler mux that responds to a
+// PUT request with expectedUpdateResponse.
+func HandleUpdateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/sfs-turbo/shares/%s/fs/perm-rules/%s", shareID, ruleID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `{"rule": {"rw_type": "ro", "user_type": "root_squash"}}`)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, expectedUpdateResponse)
+	})
+}
+
+// HandleDeleteSuccessfully creates an HTTP handler at
+// `/sfs-turbo/shares/{share_id}/fs/perm-rules/{rule_id}` on the test handler mux that responds to a
+// DELETE request.
+func HandleDeleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/sfs-turbo/shares/%s/fs/perm-rules/%s", shareID, ruleID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,49 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/sfs_turbo/v1/permrules"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSuccessfully(t)
+
+	opts := permrules.CreateOpts{
+		Rules: []permrules.RuleOpts{
+			{
+				IPCidr:   "192.168.5.0/24",
+				RWType:   "rw",
+				UserType: "no_root_squash",
+			},
+		},
+	}
+	rules, err := permrules.Create(fake.ServiceClient(), shareID, opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(rules))
+	th.AssertEquals(t, ruleID, rules[0].ID)
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateSuccessfully(t)
+
+	opts := permrules.UpdateOpts{RWType: "ro", UserType: "root_squash"}
+	rule, err := permrules.Update(fake.ServiceClient(), shareID, ruleID, opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "ro", rule.RWType)
+	th.AssertEquals(t, "root_squash", rule.UserType)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteSuccessfully(t)
+
+	th.AssertNoErr(t, permrules.Delete(fake.ServiceClient(), shareID, ruleID).ExtractErr())
+}
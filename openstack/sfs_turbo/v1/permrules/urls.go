@@ -0,0 +1,17 @@
+package permrules
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+const (
+	rootPath     = "sfs-turbo"
+	resourcePath = "shares"
+	rulesPath    = "perm-rules"
+)
+
+func rootURL(c *golangsdk.ServiceClient, shareID string) string {
+	return c.ServiceURL(rootPath, resourcePath, shareID, "fs", rulesPath)
+}
+
+func resourceURL(c *golangsdk.ServiceClient, shareID, ruleID string) string {
+	return c.ServiceURL(rootPath, resourcePath, shareID, "fs", rulesPath, ruleID)
+}
@@ -18,7 +18,7 @@ type CreateOpts struct {
 	Name string `json:"name" required:"true"`
 	// Defines the SFS Turbo file system protocol to use, the valid value is NFS.
 	ShareProto string `json:"share_proto,omitempty"`
-	// ShareType defines the file system type. the valid values are STANDARD and PERFORMANCE.
+	// ShareType defines the file system type. the valid values are STANDARD, PERFORMANCE and HPC.
 	ShareType string `json:"share_type" required:"true"`
 	// Size in GB, range from 500 to 32768.
 	Size int `json:"size" required:"true"`
@@ -42,7 +42,20 @@ type CreateOpts struct {
 
 // Metadata specifies the metadata information
 type Metadata struct {
+	// The ID of the KMS key used for file system encryption
 	CryptKeyID string `json:"crypt_key_id,omitempty"`
+	// The extension type: `bandwidth` for an enhanced file system,
+	// `hpc` for an HPC file system and `hpc_cache` for an HPC cache file system
+	ExpandType string `json:"expand_type,omitempty"`
+	// The bandwidth specification of an HPC file system,
+	// the valid values are 20M, 40M, 125M, 250M, 500M and 1000M.
+	HpcBw string `json:"hpc_bw,omitempty"`
+	// The bandwidth specification of an HPC cache file system, e.g. 2G, 4G or 8G.
+	HpcCacheBw string `json:"hpc_cache_bw,omitempty"`
+	// The ID of the dedicated storage pool
+	DedicatedStorageID string `json:"dedicated_storage_id,omitempty"`
+	// The ID of the dedicated flavor
+	DedicatedFlavor string `json:"dedicated_flavor,omitempty"`
 }
 
 // ToShareCreateMap assembles a request body based on the contents of a
@@ -5,6 +5,33 @@ import (
 	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
 )
 
+const (
+	ShareTypeStandard    = "STANDARD"
+	ShareTypePerformance = "PERFORMANCE"
+	ShareTypeHPC         = "HPC"
+
+	ExpandTypeBandwidth = "bandwidth"
+	ExpandTypeHPC       = "hpc"
+	ExpandTypeHPCCache  = "hpc_cache"
+)
+
+// Status codes of the SFS Turbo file system
+const (
+	StatusCreating       = "100"
+	StatusAvailable      = "200"
+	StatusCreationFailed = "303"
+	StatusFrozen         = "800"
+)
+
+// Sub-status codes of the SFS Turbo file system
+const (
+	SubStatusExpanding       = "121"
+	SubStatusExpandSuccess   = "221"
+	SubStatusExpandFailed    = "321"
+	SubStatusChangeSGSuccess = "232"
+	SubStatusChangeSGFailed  = "332"
+)
+
 // TurboResponse contains the information of creating response
 type TurboResponse struct {
 	ID     string `json:"id"`
@@ -48,8 +75,11 @@ type Turbo struct {
 	SecurityGroupID string `json:"security_group_id"`
 	// The available capacity if the SFS Turbo file system
 	AvailCapacity string `json:"avail_capacity"`
-	// bandwidth is returned for an enhanced file system
+	// bandwidth is returned for an enhanced file system,
+	// hpc or hpc_cache for an HPC file system
 	ExpandType string `json:"expand_type"`
+	// The bandwidth specification of an HPC file system
+	HpcBw string `json:"hpc_bw"`
 	// The ID of the encryption key
 	CryptKeyID string `json:"crypt_key_id"`
 	// The billing mode, 0 indicates pay-per-use, 1 indicates yearly/monthly subscription
@@ -0,0 +1,124 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const shareID = "8fba8253-c914-439d-ae8b-d5c89091183a"
+
+const expectedCreateRequest = `
+{
+  "share" : {
+    "name" : "sfs-turbo-hpc",
+    "share_proto" : "NFS",
+    "share_type" : "HPC",
+    "size" : 3686,
+    "availability_zone" : "eu-de-01",
+    "vpc_id" : "d651ea2b-2b20-4c6d-8bbf-2adcec18dac9",
+    "subnet_id" : "b8884abe-f47b-4917-9f6c-f64825c365db",
+    "security_group_id" : "8c4ebbd0-6edf-4aae-8353-81ce6d06e1f4",
+    "metadata" : {
+      "expand_type" : "hpc",
+      "hpc_bw" : "40M"
+    }
+  }
+}`
+
+const expectedCreateResponse = `
+{
+  "id" : "8fba8253-c914-439d-ae8b-d5c89091183a",
+  "name" : "sfs-turbo-hpc",
+  "status" : "100"
+}`
+
+const expectedGetResponse = `
+{
+  "id" : "8fba8253-c914-439d-ae8b-d5c89091183a",
+  "name" : "sfs-turbo-hpc",
+  "status" : "200",
+  "sub_status" : "221",
+  "share_type" : "HPC",
+  "share_proto" : "NFS",
+  "size" : "3686.00",
+  "availability_zone" : "eu-de-01",
+  "expand_type" : "hpc",
+  "hpc_bw" : "40M"
+}`
+
+// HandleCreateSuccessfully creates an HTTP handler at `/sfs-turbo/shares` on the test handler mux
+// that responds to a POST request with expectedCreateResponse.
+func HandleCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/sfs-turbo/shares", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, expectedCreateRequest)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = fmt.Fprint(w, expectedCreateResponse)
+	})
+}
+
+// HandleGetSuccessfully creates an HTTP handler at `/sfs-turbo/shares/{share_id}` on the test
+// handler mux that responds to a GET request with expectedGetResponse.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/sfs-turbo/shares/"+shareID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, expectedGetResponse)
+	})
+}
+
+const expectedExpandingResponse = `
+{
+  "id" : "8fba8253-c914-439d-ae8b-d5c89091183a",
+  "name" : "sfs-turbo-hpc",
+  "status" : "200",
+  "sub_status" : "121",
+  "size" : "3686.00"
+}`
+
+const expectedExpandedResponse = `
+{
+  "id" : "8fba8253-c914-439d-ae8b-d5c89091183a",
+  "name" : "sfs-turbo-hpc",
+  "status" : "200",
+  "sub_status" : "221",
+  "size" : "4915.00"
+}`
+
+// HandleGetExpandingSuccessfully creates an HTTP handler at `/sfs-turbo/shares/{share_id}` on the
+// test handler mux that responds to the first GET request with expectedExpandingResponse and to
+// the following ones with expectedExpandedResponse.
+func HandleGetExpandingSuccessfully(t *testing.T) {
+	polls := 0
+	th.Mux.HandleFunc("/sfs-turbo/shares/"+shareID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		polls++
+		if polls == 1 {
+			_, _ = fmt.Fprint(w, expectedExpandingResponse)
+			return
+		}
+		_, _ = fmt.Fprint(w, expectedExpandedResponse)
+	})
+}
+
+// HandleExpandSuccessfully creates an HTTP handler at `/sfs-turbo/shares/{share_id}/action` on the
+// test handler mux that responds to a POST request.
+func HandleExpandSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/sfs-turbo/shares/"+shareID+"/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `{"extend": {"new_size": 4915}}`)
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
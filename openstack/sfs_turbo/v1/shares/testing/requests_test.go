@@ -0,0 +1,62 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/sfs_turbo/v1/shares"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestCreateHPC(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSuccessfully(t)
+
+	opts := shares.CreateOpts{
+		Name:             "sfs-turbo-hpc",
+		ShareProto:       "NFS",
+		ShareType:        shares.ShareTypeHPC,
+		Size:             3686,
+		AvailabilityZone: "eu-de-01",
+		VpcID:            "d651ea2b-2b20-4c6d-8bbf-2adcec18dac9",
+		SubnetID:         "b8884abe-f47b-4917-9f6c-f64825c365db",
+		SecurityGroupID:  "8c4ebbd0-6edf-4aae-8353-81ce6d06e1f4",
+		Metadata: shares.Metadata{
+			ExpandType: shares.ExpandTypeHPC,
+			HpcBw:      "40M",
+		},
+	}
+	share, err := shares.Create(fake.ServiceClient(), opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, shareID, share.ID)
+	th.AssertEquals(t, shares.StatusCreating, share.Status)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	share, err := shares.Get(fake.ServiceClient(), shareID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, shares.StatusAvailable, share.Status)
+	th.AssertEquals(t, shares.ExpandTypeHPC, share.ExpandType)
+	th.AssertEquals(t, "40M", share.HpcBw)
+}
+
+func TestExpandAndWait(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleExpandSuccessfully(t)
+	HandleGetExpandingSuccessfully(t)
+
+	opts := shares.ExpandOpts{Extend: shares.ExtendOpts{NewSize: 4915}}
+	th.AssertNoErr(t, shares.Expand(fake.ServiceClient(), shareID, opts).ExtractErr())
+	th.AssertNoErr(t, shares.WaitForExpandSuccess(fake.ServiceClient(), shareID, 10))
+
+	share, err := shares.Get(fake.ServiceClient(), shareID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, shares.SubStatusExpandSuccess, share.SubStatus)
+	th.AssertEquals(t, "4915.00", share.Size)
+}
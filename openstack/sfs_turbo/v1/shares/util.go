@@ -0,0 +1,75 @@
+package shares
+
+import (
+	"fmt"
+
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// WaitForShareAvailable will wait until the SFS Turbo file system becomes available.
+func WaitForShareAvailable(c *golangsdk.ServiceClient, id string, secs int) error {
+	return golangsdk.WaitFor(secs, func() (bool, error) {
+		share, err := Get(c, id).Extract()
+		if err != nil {
+			return false, err
+		}
+		switch share.Status {
+		case StatusAvailable:
+			return true, nil
+		case StatusCreationFailed:
+			return false, fmt.Errorf("SFS Turbo %s creation failed", id)
+		}
+		return false, nil
+	})
+}
+
+// WaitForShareDeleted will wait until the SFS Turbo file system is deleted.
+func WaitForShareDeleted(c *golangsdk.ServiceClient, id string, secs int) error {
+	return golangsdk.WaitFor(secs, func() (bool, error) {
+		_, err := Get(c, id).Extract()
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return true, nil
+			}
+			return false, err
+		}
+		return false, nil
+	})
+}
+
+// WaitForExpandSuccess will wait until the capacity expansion
+// of the SFS Turbo file system is finished. Get the share afterwards
+// to read its new size and sub-status.
+func WaitForExpandSuccess(c *golangsdk.ServiceClient, id string, secs int) error {
+	return golangsdk.WaitFor(secs, func() (bool, error) {
+		share, err := Get(c, id).Extract()
+		if err != nil {
+			return false, err
+		}
+		switch share.SubStatus {
+		case SubStatusExpandSuccess:
+			return true, nil
+		case SubStatusExpandFailed:
+			return false, fmt.Errorf("SFS Turbo %s expansion failed", id)
+		}
+		return false, nil
+	})
+}
+
+// WaitForChangeSGSuccess will wait until the security group change
+// of the SFS Turbo file system is finished.
+func WaitForChangeSGSuccess(c *golangsdk.ServiceClient, id string, secs int) error {
+	return golangsdk.WaitFor(secs, func() (bool, error) {
+		share, err := Get(c, id).Extract()
+		if err != nil {
+			return false, err
+		}
+		switch share.SubStatus {
+		case SubStatusChangeSGSuccess:
+			return true, nil
+		case SubStatusChangeSGFailed:
+			return false, fmt.Errorf("SFS Turbo %s security group change failed", id)
+		}
+		return false, nil
+	})
+}
@@ -0,0 +1,71 @@
+package tags
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/tags"
+)
+
+// serviceType is used for the batch and project-wide tag operations
+// provided by the common tags package.
+const serviceType = "sfs-turbo"
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToTagCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts contains the single tag to be added to an SFS Turbo file system.
+type CreateOpts struct {
+	// Tag key, up to 36 characters.
+	Key string `json:"key" required:"true"`
+	// Tag value, up to 43 characters.
+	Value string `json:"value"`
+}
+
+// ToTagCreateMap assembles a request body based on the contents of a
+// CreateOpts.
+func (opts CreateOpts) ToTagCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "tag")
+}
+
+// Create will add a single tag to the SFS Turbo file system.
+func Create(client *golangsdk.ServiceClient, shareID string, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToTagCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(rootURL(client, shareID), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// Get will get all tags of the SFS Turbo file system.
+func Get(client *golangsdk.ServiceClient, shareID string) tags.GetResult {
+	return tags.Get(client, serviceType, shareID)
+}
+
+// Delete will delete a single tag with the given key from the SFS Turbo file system.
+func Delete(client *golangsdk.ServiceClient, shareID, key string) (r DeleteResult) {
+	_, r.Err = client.Delete(resourceURL(client, shareID, key), &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// BatchCreate will add multiple tags to the SFS Turbo file system.
+func BatchCreate(client *golangsdk.ServiceClient, shareID string, tagList []tags.ResourceTag) tags.ActionResult {
+	return tags.Create(client, serviceType, shareID, tagList)
+}
+
+// BatchDelete will delete multiple tags from the SFS Turbo file system.
+func BatchDelete(client *golangsdk.ServiceClient, shareID string, tagList []tags.ResourceTag) tags.ActionResult {
+	return tags.Delete(client, serviceType, shareID, tagList)
+}
+
+// List will get tags of all SFS Turbo file systems in the project.
+func List(client *golangsdk.ServiceClient) tags.ListResult {
+	return tags.List(client, serviceType)
+}
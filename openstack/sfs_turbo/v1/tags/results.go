@@ -0,0 +1,15 @@
+package tags
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// CreateResult contains the error from a Create request.
+type CreateResult struct {
+	golangsdk.ErrResult
+}
+
+// DeleteResult contains the error from a Delete request.
+type DeleteResult struct {
+	golangsdk.ErrResult
+}
@@ -0,0 +1,70 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/common/tags"
+	sfsTags "github.com/opentelekomcloud/gophertelekomcloud/openstack/sfs_turbo/v1/tags"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const (
+	shareID   = "8fba8253-c914-439d-ae8b-d5c89091183a"
+	projectID = "9b0a2d0aa8dc4d4a9e4a4d1cd2d1e2b2"
+)
+
+// projectClient returns a service client with an endpoint ending with the project ID,
+// the same way the real SFS Turbo endpoint does.
+func projectClient() *golangsdk.ServiceClient {
+	sc := fake.ServiceClient()
+	sc.ProjectID = projectID
+	sc.Endpoint = sc.Endpoint + projectID + "/"
+	return sc
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc(fmt.Sprintf("/sfs-turbo/%s/tags", shareID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `{"tag": {"key": "environment", "value": "test"}}`)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	opts := sfsTags.CreateOpts{Key: "environment", Value: "test"}
+	th.AssertNoErr(t, sfsTags.Create(fake.ServiceClient(), shareID, opts).ExtractErr())
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc(fmt.Sprintf("/sfs-turbo/%s/tags/environment", shareID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	th.AssertNoErr(t, sfsTags.Delete(fake.ServiceClient(), shareID, "environment").ExtractErr())
+}
+
+func TestBatchCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc(fmt.Sprintf("/%s/sfs-turbo/%s/tags/action", projectID, shareID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `{"action": "create", "tags": [{"key": "cost-center", "value": "42"}]}`)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	tagList := []tags.ResourceTag{{Key: "cost-center", Value: "42"}}
+	th.AssertNoErr(t, sfsTags.BatchCreate(projectClient(), shareID, tagList).ExtractErr())
+}
@@ -0,0 +1,16 @@
+package tags
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+const (
+	rootPath     = "sfs-turbo"
+	resourcePath = "tags"
+)
+
+func rootURL(c *golangsdk.ServiceClient, shareID string) string {
+	return c.ServiceURL(rootPath, shareID, resourcePath)
+}
+
+func resourceURL(c *golangsdk.ServiceClient, shareID, key string) string {
+	return c.ServiceURL(rootPath, shareID, resourcePath, key)
+}
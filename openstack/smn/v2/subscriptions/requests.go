@@ -1,10 +1,25 @@
 package subscriptions
 
 import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
 	"github.com/opentelekomcloud/gophertelekomcloud"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack"
 )
 
+// Subscription endpoint protocols
+const (
+	ProtocolEmail         = "email"
+	ProtocolSMS           = "sms"
+	ProtocolHTTP          = "http"
+	ProtocolHTTPS         = "https"
+	ProtocolFunctionGraph = "functionstage"
+)
+
 // CreateOptsBuilder is used for creating subscription parameters.
 // any struct providing the parameters should implement this interface
 type CreateOptsBuilder interface {
@@ -15,7 +30,7 @@ type CreateOptsBuilder interface {
 type CreateOpts struct {
 	// Message endpoint
 	Endpoint string `json:"endpoint" required:"true"`
-	// Protocol of the message endpoint
+	// Protocol of the message endpoint, see Protocol* constants
 	Protocol string `json:"protocol" required:"true"`
 	// Description of the subscription
 	Remark string `json:"remark,omitempty"`
@@ -61,3 +76,110 @@ func ListFromTopic(client *golangsdk.ServiceClient, subscriptionUrn string) (r L
 	_, r.Err = client.Get(listFromTopicURL(client, subscriptionUrn), &r.Body, openstack.StdRequestOpts())
 	return
 }
+
+// UpdateOptsBuilder is used for updating subscription parameters.
+// any struct providing the parameters should implement this interface
+type UpdateOptsBuilder interface {
+	ToSubscriptionUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts is a struct that contains all the parameters.
+type UpdateOpts struct {
+	// Description of the subscription
+	Remark string `json:"remark"`
+}
+
+func (ops UpdateOpts) ToSubscriptionUpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(ops, "")
+}
+
+// Update a subscription description
+func Update(client *golangsdk.ServiceClient, opts UpdateOptsBuilder, topicUrn, subscriptionUrn string) (r UpdateResult) {
+	b, err := opts.ToSubscriptionUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Put(updateURL(client, topicUrn, subscriptionUrn), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes:     []int{200},
+		MoreHeaders: openstack.StdRequestOpts().MoreHeaders,
+	})
+	return
+}
+
+// ConfirmationMessage is the message sent by SMN to HTTP(S) endpoints after the subscription is created
+type ConfirmationMessage struct {
+	Type             string `json:"type"`
+	Signature        string `json:"signature"`
+	TopicUrn         string `json:"topic_urn"`
+	MessageId        string `json:"message_id"`
+	SignatureVersion string `json:"signature_version"`
+	Timestamp        string `json:"timestamp"`
+	SigningCertUrl   string `json:"signing_cert_url"`
+	Message          string `json:"message"`
+	SubscribeUrl     string `json:"subscribe_url"`
+}
+
+// smnDomain is the domain of the SMN endpoints which send the confirmation messages
+const smnDomain = ".otc.t-systems.com"
+
+// checkSMNURL verifies that the URL points to an SMN endpoint over HTTPS,
+// so forged confirmation messages can't make the subscriber request arbitrary URLs
+func checkSMNURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("invalid SMN URL: %w", err)
+	}
+	host := u.Hostname()
+	if u.Scheme != "https" || !strings.HasPrefix(host, "smn.") || !strings.HasSuffix(host, smnDomain) {
+		return fmt.Errorf("%q is not an HTTPS URL of the SMN service", rawURL)
+	}
+	return nil
+}
+
+// ParseConfirmation parses the request body received by HTTP(S) endpoint,
+// an error is returned if it's not a subscription confirmation or if its URLs
+// don't point to the SMN service
+func ParseConfirmation(body []byte) (*ConfirmationMessage, error) {
+	var msg ConfirmationMessage
+	if err := json.Unmarshal(body, &msg); err != nil {
+		return nil, err
+	}
+	if msg.Type != "SubscriptionConfirmation" {
+		return nil, fmt.Errorf("unexpected message type: %q", msg.Type)
+	}
+	if msg.SubscribeUrl == "" {
+		return nil, fmt.Errorf("subscribe_url is missing in the confirmation message")
+	}
+	if err := checkSMNURL(msg.SubscribeUrl); err != nil {
+		return nil, err
+	}
+	if msg.SigningCertUrl != "" {
+		if err := checkSMNURL(msg.SigningCertUrl); err != nil {
+			return nil, err
+		}
+	}
+	return &msg, nil
+}
+
+// Confirm confirms the subscription by visiting the subscribe URL of the confirmation message,
+// http.DefaultClient is used if httpClient is nil.
+// Only HTTPS URLs of the SMN service are visited.
+func Confirm(httpClient *http.Client, msg *ConfirmationMessage) error {
+	if err := checkSMNURL(msg.SubscribeUrl); err != nil {
+		return err
+	}
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Get(msg.SubscribeUrl)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to confirm subscription to %s: %s", msg.TopicUrn, resp.Status)
+	}
+	return nil
+}
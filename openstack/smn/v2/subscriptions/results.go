@@ -34,6 +34,17 @@ type CreateResult struct {
 	golangsdk.Result
 }
 
+type UpdateResult struct {
+	golangsdk.Result
+}
+
+// Extract will get the subscription object out of the UpdateResult object.
+func (r UpdateResult) Extract() (*Subscription, error) {
+	s := new(Subscription)
+	err := r.ExtractIntoStructPtr(s, "")
+	return s, err
+}
+
 type DeleteResult struct {
 	golangsdk.ErrResult
 }
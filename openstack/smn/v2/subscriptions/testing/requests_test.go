@@ -0,0 +1,97 @@
+package testing
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/smn/v2/subscriptions"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+const confirmationBody = `
+{
+  "type": "SubscriptionConfirmation",
+  "signature": "ZxVkDXhQ...",
+  "topic_urn": "urn:smn:eu-de:0970dd7a1300f5672ff2c003c60ae115:test_topic",
+  "message_id": "3dc9a8a5d61c462ab60d31d5bfbd2ba9",
+  "signature_version": "v1",
+  "timestamp": "2021-09-08T08:03:19Z",
+  "signing_cert_url": "https://smn.eu-de.otc.t-systems.com/smn/oauth/cert?certId=9f0a2b3e",
+  "message": "You are invited to subscribe to topic test_topic.",
+  "subscribe_url": "https://smn.eu-de.otc.t-systems.com/smn/subscription/confirm?token=bd7d6a3d"
+}
+`
+
+func TestParseConfirmation(t *testing.T) {
+	msg, err := subscriptions.ParseConfirmation([]byte(confirmationBody))
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "urn:smn:eu-de:0970dd7a1300f5672ff2c003c60ae115:test_topic", msg.TopicUrn)
+	th.AssertEquals(t, "https://smn.eu-de.otc.t-systems.com/smn/subscription/confirm?token=bd7d6a3d", msg.SubscribeUrl)
+	th.AssertEquals(t, "https://smn.eu-de.otc.t-systems.com/smn/oauth/cert?certId=9f0a2b3e", msg.SigningCertUrl)
+}
+
+func TestParseConfirmationInvalid(t *testing.T) {
+	bodies := map[string]string{
+		"not json":          `{`,
+		"notification":      `{"type": "Notification", "subscribe_url": "https://smn.eu-de.otc.t-systems.com/confirm"}`,
+		"no subscribe url":  `{"type": "SubscriptionConfirmation"}`,
+		"http":              `{"type": "SubscriptionConfirmation", "subscribe_url": "http://smn.eu-de.otc.t-systems.com/confirm"}`,
+		"metadata endpoint": `{"type": "SubscriptionConfirmation", "subscribe_url": "https://169.254.169.254/latest/meta-data"}`,
+		"foreign domain":    `{"type": "SubscriptionConfirmation", "subscribe_url": "https://smn.eu-de.otc.t-systems.com.example.org/confirm"}`,
+		"foreign cert url": `{"type": "SubscriptionConfirmation", "subscribe_url": "https://smn.eu-de.otc.t-systems.com/confirm",
+ "signing_cert_url": "https://example.org/cert"}`,
+	}
+	for name, body := range bodies {
+		_, err := subscriptions.ParseConfirmation([]byte(body))
+		if err == nil {
+			t.Errorf("expected error for %s confirmation", name)
+		}
+	}
+}
+
+func TestConfirm(t *testing.T) {
+	confirmed := false
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.AssertEquals(t, "smn.eu-de.otc.t-systems.com", r.Host)
+		th.AssertEquals(t, "/smn/subscription/confirm", r.URL.Path)
+		th.AssertEquals(t, "bd7d6a3d", r.URL.Query().Get("token"))
+		confirmed = true
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	// route the SMN host to the test server
+	client := &http.Client{Transport: &http.Transport{
+		DialContext: func(ctx context.Context, network, _ string) (net.Conn, error) {
+			return (&net.Dialer{}).DialContext(ctx, network, server.Listener.Addr().String())
+		},
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: true}, // nolint
+	}}
+
+	msg, err := subscriptions.ParseConfirmation([]byte(confirmationBody))
+	th.AssertNoErr(t, err)
+	th.AssertNoErr(t, subscriptions.Confirm(client, msg))
+	th.AssertEquals(t, true, confirmed)
+}
+
+func TestConfirmRejectsForeignURL(t *testing.T) {
+	requested := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = true
+	}))
+	defer server.Close()
+
+	err := subscriptions.Confirm(server.Client(), &subscriptions.ConfirmationMessage{
+		Type:         "SubscriptionConfirmation",
+		SubscribeUrl: server.URL + "/confirm",
+	})
+	if err == nil {
+		t.Fatal("expected error for non-SMN subscribe URL")
+	}
+	th.AssertEquals(t, false, requested)
+}
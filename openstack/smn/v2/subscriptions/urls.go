@@ -22,3 +22,7 @@ func listURL(c *golangsdk.ServiceClient) string {
 func listFromTopicURL(c *golangsdk.ServiceClient, topicUrn string) string {
 	return c.ServiceURL(topicsPath, topicUrn, rootPath)
 }
+
+func updateURL(c *golangsdk.ServiceClient, topicUrn, subscriptionUrn string) string {
+	return c.ServiceURL(topicsPath, topicUrn, rootPath, subscriptionUrn)
+}
@@ -0,0 +1,121 @@
+package templates
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack"
+)
+
+// CreateOptsBuilder is used for creating message template parameters.
+// any struct providing the parameters should implement this interface
+type CreateOptsBuilder interface {
+	ToTemplateCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts is a struct that contains all the parameters.
+type CreateOpts struct {
+	// Name of the template, templates for different protocols can share the name
+	Name string `json:"message_template_name" required:"true"`
+	// Protocol of the template: `default`, `email`, `sms`, `functionstage`, `http` or `https`
+	Protocol string `json:"protocol" required:"true"`
+	// Template content, tags are enclosed in braces, e.g. `{instance_id}`
+	Content string `json:"content" required:"true"`
+}
+
+func (ops CreateOpts) ToTemplateCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(ops, "")
+}
+
+// Create a message template with given parameters.
+func Create(client *golangsdk.ServiceClient, ops CreateOptsBuilder) (r CreateResult) {
+	b, err := ops.ToTemplateCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Post(rootURL(client), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes:     []int{201, 200},
+		MoreHeaders: openstack.StdRequestOpts().MoreHeaders,
+	})
+	return
+}
+
+// UpdateOptsBuilder is used for updating message template parameters.
+// any struct providing the parameters should implement this interface
+type UpdateOptsBuilder interface {
+	ToTemplateUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts is a struct that contains all the parameters.
+type UpdateOpts struct {
+	// Template content
+	Content string `json:"content" required:"true"`
+}
+
+func (ops UpdateOpts) ToTemplateUpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(ops, "")
+}
+
+// Update a message template content.
+func Update(client *golangsdk.ServiceClient, ops UpdateOptsBuilder, id string) (r UpdateResult) {
+	b, err := ops.ToTemplateUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Put(resourceURL(client, id), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes:     []int{200},
+		MoreHeaders: openstack.StdRequestOpts().MoreHeaders,
+	})
+	return
+}
+
+// Delete a message template via id
+func Delete(client *golangsdk.ServiceClient, id string) (r DeleteResult) {
+	_, r.Err = client.Delete(resourceURL(client, id), &golangsdk.RequestOpts{
+		OkCodes:     []int{200},
+		MoreHeaders: openstack.StdRequestOpts().MoreHeaders,
+	})
+	return
+}
+
+// Get a message template with the content by id
+func Get(client *golangsdk.ServiceClient, id string) (r GetResult) {
+	_, r.Err = client.Get(resourceURL(client, id), &r.Body, openstack.StdRequestOpts())
+	return
+}
+
+type ListOptsBuilder interface {
+	ToTemplateListQuery() (string, error)
+}
+
+type ListOpts struct {
+	Offset   int    `q:"offset"`
+	Limit    int    `q:"limit"`
+	Name     string `q:"message_template_name"`
+	Protocol string `q:"protocol"`
+}
+
+func (opts ListOpts) ToTemplateListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), nil
+}
+
+// List the message templates
+func List(client *golangsdk.ServiceClient, opts ListOptsBuilder) (r ListResult) {
+	url := rootURL(client)
+	if opts != nil {
+		q, err := opts.ToTemplateListQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += q
+	}
+	_, r.Err = client.Get(url, &r.Body, openstack.StdRequestOpts())
+	return
+}
@@ -0,0 +1,57 @@
+package templates
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+type Template struct {
+	ID         string   `json:"message_template_id"`
+	Name       string   `json:"message_template_name"`
+	Protocol   string   `json:"protocol"`
+	TagNames   []string `json:"tag_names"`
+	CreateTime string   `json:"create_time"`
+	UpdateTime string   `json:"update_time"`
+	Content    string   `json:"content"`
+}
+
+// CreateResult contains the response body and error from a Create request.
+type CreateResult struct {
+	golangsdk.Result
+}
+
+// Extract will get the ID of the created message template.
+func (r CreateResult) Extract() (string, error) {
+	var s struct {
+		ID string `json:"message_template_id"`
+	}
+	err := r.ExtractInto(&s)
+	return s.ID, err
+}
+
+type GetResult struct {
+	golangsdk.Result
+}
+
+func (r GetResult) Extract() (*Template, error) {
+	var s Template
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+type UpdateResult struct {
+	golangsdk.ErrResult
+}
+
+type DeleteResult struct {
+	golangsdk.ErrResult
+}
+
+type ListResult struct {
+	golangsdk.Result
+}
+
+func (r ListResult) Extract() ([]Template, error) {
+	var s []Template
+	err := r.ExtractIntoSlicePtr(&s, "message_templates")
+	return s, err
+}
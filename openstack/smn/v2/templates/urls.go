@@ -0,0 +1,13 @@
+package templates
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+const rootPath = "message_template"
+
+func rootURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL(rootPath)
+}
+
+func resourceURL(c *golangsdk.ServiceClient, id string) string {
+	return c.ServiceURL(rootPath, id)
+}
@@ -1,6 +1,9 @@
 package topics
 
 import (
+	"encoding/json"
+	"fmt"
+
 	"github.com/opentelekomcloud/gophertelekomcloud"
 	"github.com/opentelekomcloud/gophertelekomcloud/openstack"
 )
@@ -92,3 +95,70 @@ func List(client *golangsdk.ServiceClient) (r ListResult) {
 	_, r.Err = client.Get(listURL(client), &r.Body, openstack.StdRequestOpts())
 	return
 }
+
+// PublishOptsBuilder is used for publishing message parameters.
+// any struct providing the parameters should implement this interface
+type PublishOptsBuilder interface {
+	ToTopicPublishMap() (map[string]interface{}, error)
+}
+
+// PublishOpts is a struct that contains all the parameters of message publishing.
+// Exactly one of Message, MessageStructure and MessageTemplateName has to be set.
+type PublishOpts struct {
+	// Message subject, used as email subject
+	Subject string `json:"subject,omitempty"`
+	// Message content
+	Message string `json:"message,omitempty"`
+	// JSON string with the message per protocol, see BuildMessageStructure
+	MessageStructure string `json:"message_structure,omitempty"`
+	// Name of the message template
+	MessageTemplateName string `json:"message_template_name,omitempty"`
+	// Values substituted into the message template variables
+	Tags map[string]string `json:"tags,omitempty"`
+	// Message TTL in seconds, 3600 by default
+	TimeToLive string `json:"time_to_live,omitempty"`
+}
+
+func (ops PublishOpts) ToTopicPublishMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(ops, "")
+}
+
+// BuildMessageStructure builds the MessageStructure value from the messages per protocol,
+// e.g. `default`, `email`, `sms`, `http`, `https` or `functionstage`.
+// The `default` message is required and is sent to protocols without a dedicated message.
+func BuildMessageStructure(messages map[string]string) (string, error) {
+	if _, ok := messages["default"]; !ok {
+		return "", fmt.Errorf("message for the `default` protocol is required")
+	}
+	b, err := json.Marshal(messages)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// Publish a message to the topic subscribers.
+func Publish(client *golangsdk.ServiceClient, ops PublishOptsBuilder, id string) (r PublishResult) {
+	b, err := ops.ToTopicPublishMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Post(publishURL(client, id), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes:     []int{200},
+		MoreHeaders: openstack.StdRequestOpts().MoreHeaders,
+	})
+	return
+}
+
+// PublishTemplate publishes a message built from the message template to the topic subscribers,
+// the template tags are replaced with the given values.
+func PublishTemplate(client *golangsdk.ServiceClient, id, subject, templateName string, tags map[string]string) (r PublishResult) {
+	opts := PublishOpts{
+		Subject:             subject,
+		MessageTemplateName: templateName,
+		Tags:                tags,
+	}
+	return Publish(client, opts, id)
+}
@@ -67,3 +67,19 @@ func (lr ListResult) Extract() ([]TopicGet, error) {
 	err := lr.Result.ExtractInto(&a)
 	return a.Topics, err
 }
+
+type PublishResult struct {
+	golangsdk.Result
+}
+
+type Message struct {
+	RequestId string `json:"request_id"`
+	MessageId string `json:"message_id"`
+}
+
+// Extract will get the published message ID out of the PublishResult object.
+func (r PublishResult) Extract() (*Message, error) {
+	var s Message
+	err := r.ExtractInto(&s)
+	return &s, err
+}
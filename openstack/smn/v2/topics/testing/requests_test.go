@@ -0,0 +1,27 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/smn/v2/topics"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestBuildMessageStructure(t *testing.T) {
+	structure, err := topics.BuildMessageStructure(map[string]string{
+		"default": "default message",
+		"email":   "email message",
+		"sms":     "sms message",
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, `{"default":"default message","email":"email message","sms":"sms message"}`, structure)
+}
+
+func TestBuildMessageStructureWithoutDefault(t *testing.T) {
+	_, err := topics.BuildMessageStructure(map[string]string{
+		"email": "email message",
+	})
+	if err == nil {
+		t.Fatal("expected error without `default` message")
+	}
+}
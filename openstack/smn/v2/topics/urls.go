@@ -21,3 +21,7 @@ func updateURL(c *golangsdk.ServiceClient, id string) string {
 func listURL(c *golangsdk.ServiceClient) string {
 	return c.ServiceURL("topics?offset=0&limit=100")
 }
+
+func publishURL(c *golangsdk.ServiceClient, id string) string {
+	return c.ServiceURL("topics", id, "publish")
+}
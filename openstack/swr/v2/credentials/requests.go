@@ -0,0 +1,54 @@
+package credentials
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+type CreateOptsBuilder interface {
+	ToSecretQuery() (string, error)
+}
+
+type CreateOpts struct {
+	// ProjectName - name of the project, e.g. `eu-de`
+	ProjectName string `q:"projectname"`
+}
+
+func (opts CreateOpts) ToSecretQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), nil
+}
+
+// CreateTemporary generates a temporary docker login credential valid for 24 hours
+func CreateTemporary(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	url := secretURL(client)
+	if opts != nil {
+		q, err := opts.ToSecretQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += q
+	}
+	_, r.Err = client.Post(url, nil, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// LongTerm builds a docker login credential which is valid as long as the given access key is valid.
+// The credential is computed locally, no request is sent.
+func LongTerm(region, accessKey, secretKey string) Credential {
+	mac := hmac.New(sha256.New, []byte(secretKey))
+	mac.Write([]byte(accessKey))
+	return Credential{
+		Username: region + "@" + accessKey,
+		Password: hex.EncodeToString(mac.Sum(nil)),
+	}
+}
@@ -0,0 +1,54 @@
+package credentials
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// Credential - docker login credential
+type Credential struct {
+	Username string
+	Password string
+}
+
+type Auth struct {
+	// Auth - base64 encoded `username:password`
+	Auth string `json:"auth"`
+}
+
+type CreateResult struct {
+	golangsdk.Result
+}
+
+// Extract returns docker config `auths` section: registry address to the credential
+func (r CreateResult) Extract() (map[string]Auth, error) {
+	var s struct {
+		Auths map[string]Auth `json:"auths"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Auths, err
+}
+
+// ExtractCredential returns the credential of the given registry, e.g. `swr.eu-de.otc.t-systems.com`
+func (r CreateResult) ExtractCredential(registry string) (*Credential, error) {
+	auths, err := r.Extract()
+	if err != nil {
+		return nil, err
+	}
+	auth, ok := auths[registry]
+	if !ok {
+		return nil, fmt.Errorf("no credential returned for registry %s", registry)
+	}
+	decoded, err := base64.StdEncoding.DecodeString(auth.Auth)
+	if err != nil {
+		return nil, err
+	}
+	parts := strings.SplitN(string(decoded), ":", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid credential format returned for registry %s", registry)
+	}
+	return &Credential{Username: parts[0], Password: parts[1]}, nil
+}
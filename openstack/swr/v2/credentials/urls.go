@@ -0,0 +1,10 @@
+package credentials
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	v2 "github.com/opentelekomcloud/gophertelekomcloud/openstack/swr/v2"
+)
+
+func secretURL(client *golangsdk.ServiceClient) string {
+	return client.ServiceURL(v2.Base, "utils", "secret")
+}
@@ -0,0 +1,124 @@
+package retentions
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
+)
+
+type CreateOptsBuilder interface {
+	ToRetentionCreateMap() (map[string]interface{}, error)
+}
+
+type CreateOpts struct {
+	// Algorithm - retention policy matching rule, the value is `or`
+	Algorithm string `json:"algorithm"`
+	// Rules - currently only one rule is supported
+	Rules []Rule `json:"rules"`
+}
+
+type Rule struct {
+	// Template - `date_rule` or `tag_rule`
+	Template string `json:"template"`
+	// Params - `{"days": "30"}` for `date_rule`, `{"num": "10"}` for `tag_rule`
+	Params map[string]string `json:"params"`
+	// TagSelectors - image tags excluded from the retention
+	TagSelectors []TagSelector `json:"tag_selectors"`
+}
+
+type TagSelector struct {
+	// Kind - `label` for exact match or `regexp`
+	Kind    string `json:"kind"`
+	Pattern string `json:"pattern"`
+}
+
+func (opts CreateOpts) ToRetentionCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Create new retention policy in the repository
+func Create(client *golangsdk.ServiceClient, org, repo string, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToRetentionCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(listURL(client, org, repo), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+func Get(client *golangsdk.ServiceClient, org, repo string, id int) (r GetResult) {
+	_, r.Err = client.Get(retentionURL(client, org, repo, id), &r.Body, nil)
+	return
+}
+
+func List(client *golangsdk.ServiceClient, org, repo string) (p pagination.Pager) {
+	return pagination.NewPager(client, listURL(client, org, repo), func(r pagination.PageResult) pagination.Page {
+		return RetentionPage{SinglePageBase: pagination.SinglePageBase(r)}
+	})
+}
+
+type UpdateOptsBuilder interface {
+	ToRetentionUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts used for update operations
+// For argument details see CreateOpts
+type UpdateOpts struct {
+	Algorithm string `json:"algorithm"`
+	Rules     []Rule `json:"rules"`
+}
+
+func (opts UpdateOpts) ToRetentionUpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+func Update(client *golangsdk.ServiceClient, org, repo string, id int, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToRetentionUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Patch(retentionURL(client, org, repo, id), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+func Delete(client *golangsdk.ServiceClient, org, repo string, id int) (r DeleteResult) {
+	_, r.Err = client.Delete(retentionURL(client, org, repo, id), nil)
+	return
+}
+
+type HistoryListOptsBuilder interface {
+	ToHistoryListQuery() (string, error)
+}
+
+type HistoryListOpts struct {
+	Offset *int `q:"offset,omitempty"` // offset 0 is a valid value
+	Limit  int  `q:"limit,omitempty"`
+}
+
+func (opts HistoryListOpts) ToHistoryListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), nil
+}
+
+// ListHistories returns the records of images deleted by the retention policies
+func ListHistories(client *golangsdk.ServiceClient, org, repo string, opts HistoryListOptsBuilder) (r HistoriesResult) {
+	url := historiesURL(client, org, repo)
+	if opts != nil {
+		q, err := opts.ToHistoryListQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += q
+	}
+	_, r.Err = client.Get(url, &r.Body, nil)
+	return
+}
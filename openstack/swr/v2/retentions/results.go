@@ -0,0 +1,79 @@
+package retentions
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
+)
+
+type CreateResult struct {
+	golangsdk.Result
+}
+
+// Extract returns ID of the created retention policy
+func (r CreateResult) Extract() (int, error) {
+	var s struct {
+		ID int `json:"id"`
+	}
+	err := r.ExtractInto(&s)
+	return s.ID, err
+}
+
+type GetResult struct {
+	golangsdk.Result
+}
+
+type UpdateResult struct {
+	golangsdk.ErrResult
+}
+
+type DeleteResult struct {
+	golangsdk.ErrResult
+}
+
+type Retention struct {
+	ID        int    `json:"id"`
+	Algorithm string `json:"algorithm"`
+	Rules     []Rule `json:"rules"`
+	Scope     string `json:"scope"`
+}
+
+func (r GetResult) Extract() (*Retention, error) {
+	retention := new(Retention)
+	err := r.ExtractIntoStructPtr(retention, "")
+	return retention, err
+}
+
+type RetentionPage struct {
+	pagination.SinglePageBase
+}
+
+func ExtractRetentions(p pagination.Page) ([]Retention, error) {
+	var retentions []Retention
+	err := p.(RetentionPage).ExtractIntoSlicePtr(&retentions, "")
+	return retentions, err
+}
+
+type History struct {
+	ID           int    `json:"id"`
+	RetentionID  int    `json:"retention_id"`
+	Organization string `json:"namespace"`
+	Repository   string `json:"repo"`
+	Tag          string `json:"tag"`
+	Digest       string `json:"digest"`
+	// RuleType - `date_rule` or `tag_rule`
+	RuleType string `json:"rule_type"`
+	Created  string `json:"created_at"`
+}
+
+type HistoriesResult struct {
+	golangsdk.Result
+}
+
+func (r HistoriesResult) Extract() ([]History, int, error) {
+	var s struct {
+		Histories []History `json:"retention_log"`
+		Total     int       `json:"total"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Histories, s.Total, err
+}
@@ -0,0 +1,22 @@
+package retentions
+
+import (
+	"strconv"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	v2 "github.com/opentelekomcloud/gophertelekomcloud/openstack/swr/v2"
+)
+
+const retentions = "retentions"
+
+func listURL(client *golangsdk.ServiceClient, org, repo string) string {
+	return client.ServiceURL(v2.Base, v2.Namespaces, org, v2.Repos, repo, retentions)
+}
+
+func retentionURL(client *golangsdk.ServiceClient, org, repo string, id int) string {
+	return client.ServiceURL(v2.Base, v2.Namespaces, org, v2.Repos, repo, retentions, strconv.Itoa(id))
+}
+
+func historiesURL(client *golangsdk.ServiceClient, org, repo string) string {
+	return client.ServiceURL(v2.Base, v2.Namespaces, org, v2.Repos, repo, retentions, "histories")
+}
@@ -0,0 +1,69 @@
+package tags
+
+import (
+	"fmt"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
+)
+
+type ListOptsBuilder interface {
+	ToTagListQuery() (string, error)
+}
+
+type ListOpts struct {
+	// Image tag name, fuzzy match is used
+	Tag string `q:"tag"`
+
+	// Sorting by column.
+	// You can set this parameter to `updated_time` and `tag`.
+	// The parameters OrderColumn and OrderType should always be used together.
+	OrderColumn string `q:"order_column"`
+	// Sorting type.
+	// You can set this parameter to `desc` (descending sort) and `asc` (ascending sort).
+	OrderType string `q:"order_type"`
+
+	Offset *int `q:"offset,omitempty"` // offset 0 is a valid value
+	Limit  int  `q:"limit,omitempty"`
+}
+
+const defaultLimit = 25
+
+func (opts ListOpts) ToTagListQuery() (string, error) {
+	if opts.Limit == 0 && opts.Offset != nil {
+		opts.Limit = defaultLimit
+	}
+	if opts.Limit != 0 && opts.Offset == nil {
+		return "", fmt.Errorf("offset has to be defined if the limit is set")
+	}
+	if (opts.OrderColumn != "" && opts.OrderType == "") || (opts.OrderColumn == "" && opts.OrderType != "") {
+		return "", fmt.Errorf("`OrderColumn` and `OrderType` should always be used together")
+	}
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), nil
+}
+
+// List image tags of the repository
+func List(client *golangsdk.ServiceClient, org, repo string, opts ListOptsBuilder) (p pagination.Pager) {
+	url := listURL(client, org, repo)
+	if opts != nil {
+		q, err := opts.ToTagListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += q
+	}
+
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return TagPage{pagination.OffsetPageBase{PageResult: r}}
+	})
+}
+
+// Delete the image with the given tag from the repository
+func Delete(client *golangsdk.ServiceClient, org, repo, tag string) (r DeleteResult) {
+	_, r.Err = client.Delete(tagURL(client, org, repo, tag), nil)
+	return
+}
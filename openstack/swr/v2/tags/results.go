@@ -0,0 +1,44 @@
+package tags
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
+)
+
+type DeleteResult struct {
+	golangsdk.ErrResult
+}
+
+type ImageTag struct {
+	ID           int    `json:"id"`
+	RepositoryID int    `json:"repo_id"`
+	Tag          string `json:"Tag"`
+	ImageID      string `json:"image_id"`
+	Manifest     string `json:"manifest"`
+	Digest       string `json:"digest"`
+	// Schema - docker image format version, `1` or `2`
+	Schema       int    `json:"schema"`
+	Path         string `json:"path"`
+	InternalPath string `json:"internal_path"`
+	Size         int    `json:"size"`
+	IsTrusted    bool   `json:"is_trusted"`
+	Created      string `json:"created"`
+	Updated      string `json:"updated"`
+	Deleted      string `json:"deleted"`
+	DomainID     string `json:"domain_id"`
+	// TagType - `0`: manifest, `1`: manifest list
+	TagType int `json:"tag_type"`
+}
+
+type TagPage struct {
+	pagination.OffsetPageBase
+}
+
+func ExtractTags(p pagination.Page) ([]ImageTag, error) {
+	var tags []ImageTag
+	err := (p.(TagPage)).ExtractIntoSlicePtr(&tags, "")
+	if err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
@@ -0,0 +1,16 @@
+package tags
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	v2 "github.com/opentelekomcloud/gophertelekomcloud/openstack/swr/v2"
+)
+
+const tags = "tags"
+
+func listURL(client *golangsdk.ServiceClient, org, repo string) string {
+	return client.ServiceURL(v2.Base, v2.Namespaces, org, v2.Repos, repo, tags)
+}
+
+func tagURL(client *golangsdk.ServiceClient, org, repo, tag string) string {
+	return client.ServiceURL(v2.Base, v2.Namespaces, org, v2.Repos, repo, tags, tag)
+}
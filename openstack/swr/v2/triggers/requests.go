@@ -0,0 +1,95 @@
+package triggers
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
+)
+
+type CreateOptsBuilder interface {
+	ToTriggerCreateMap() (map[string]interface{}, error)
+}
+
+type CreateOpts struct {
+	// Name of the trigger
+	Name string `json:"name" required:"true"`
+	// Enable - whether to enable the trigger, `true` or `false`
+	Enable string `json:"enable" required:"true"`
+	// Action - currently only `update` is supported
+	Action string `json:"action" required:"true"`
+	// ConditionType - `all` for all tags, `tag` for a specific tag or `regular` for a regular expression
+	ConditionType string `json:"condition_type" required:"true"`
+	// ConditionValue - tag name or regular expression, `.*` for all tags
+	ConditionValue string `json:"condition_value" required:"true"`
+	// AppType - `deployments` or `statefulsets`
+	AppType string `json:"app_type" required:"true"`
+	// Application - name of the application to be updated
+	Application string `json:"application" required:"true"`
+	// ClusterID - ID of the CCE cluster, mandatory for `cce` trigger type
+	ClusterID string `json:"cluster_id,omitempty"`
+	// ClusterName - name of the CCE cluster
+	ClusterName string `json:"cluster_name,omitempty"`
+	// ClusterNamespace - namespace of the application
+	ClusterNamespace string `json:"cluster_ns" required:"true"`
+	// Container - name of the container to be updated, all containers are updated if empty
+	Container string `json:"container,omitempty"`
+	// TriggerMode - `cce` or `cci`
+	TriggerMode string `json:"trigger_mode,omitempty"`
+}
+
+func (opts CreateOpts) ToTriggerCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Create new trigger in the repository
+func Create(client *golangsdk.ServiceClient, org, repo string, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToTriggerCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(listURL(client, org, repo), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+func Get(client *golangsdk.ServiceClient, org, repo, trigger string) (r GetResult) {
+	_, r.Err = client.Get(triggerURL(client, org, repo, trigger), &r.Body, nil)
+	return
+}
+
+func List(client *golangsdk.ServiceClient, org, repo string) (p pagination.Pager) {
+	return pagination.NewPager(client, listURL(client, org, repo), func(r pagination.PageResult) pagination.Page {
+		return TriggerPage{SinglePageBase: pagination.SinglePageBase(r)}
+	})
+}
+
+type UpdateOptsBuilder interface {
+	ToTriggerUpdateMap() (map[string]interface{}, error)
+}
+
+type UpdateOpts struct {
+	// Enable - whether to enable the trigger, `true` or `false`
+	Enable string `json:"enable" required:"true"`
+}
+
+func (opts UpdateOpts) ToTriggerUpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+func Update(client *golangsdk.ServiceClient, org, repo, trigger string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToTriggerUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Patch(triggerURL(client, org, repo, trigger), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+func Delete(client *golangsdk.ServiceClient, org, repo, trigger string) (r DeleteResult) {
+	_, r.Err = client.Delete(triggerURL(client, org, repo, trigger), nil)
+	return
+}
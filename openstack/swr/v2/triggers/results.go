@@ -0,0 +1,73 @@
+package triggers
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
+)
+
+type CreateResult struct {
+	golangsdk.ErrResult
+}
+
+type GetResult struct {
+	golangsdk.Result
+}
+
+type UpdateResult struct {
+	golangsdk.ErrResult
+}
+
+type DeleteResult struct {
+	golangsdk.ErrResult
+}
+
+type Trigger struct {
+	Name             string           `json:"name"`
+	Action           string           `json:"action"`
+	AppType          string           `json:"app_type"`
+	Application      string           `json:"application"`
+	ClusterID        string           `json:"cluster_id"`
+	ClusterName      string           `json:"cluster_name"`
+	ClusterNamespace string           `json:"cluster_ns"`
+	Condition        string           `json:"condition"`
+	Container        string           `json:"container"`
+	Enable           string           `json:"enable"`
+	TriggerMode      string           `json:"trigger_mode"`
+	TriggerType      string           `json:"trigger_type"`
+	CreatorName      string           `json:"creator_name"`
+	Created          string           `json:"created_at"`
+	TriggerHistories []TriggerHistory `json:"trigger_history"`
+}
+
+type TriggerHistory struct {
+	Action           string `json:"action"`
+	AppType          string `json:"app_type"`
+	Application      string `json:"application"`
+	ClusterID        string `json:"cluster_id"`
+	ClusterName      string `json:"cluster_name"`
+	ClusterNamespace string `json:"cluster_ns"`
+	Condition        string `json:"condition"`
+	Container        string `json:"container"`
+	CreatorName      string `json:"creator_name"`
+	Detail           string `json:"detail"`
+	// Result - `success` or `failed`
+	Result  string `json:"result"`
+	Tag     string `json:"tag"`
+	Created string `json:"created_at"`
+}
+
+func (r GetResult) Extract() (*Trigger, error) {
+	trigger := new(Trigger)
+	err := r.ExtractIntoStructPtr(trigger, "")
+	return trigger, err
+}
+
+type TriggerPage struct {
+	pagination.SinglePageBase
+}
+
+func ExtractTriggers(p pagination.Page) ([]Trigger, error) {
+	var triggers []Trigger
+	err := p.(TriggerPage).ExtractIntoSlicePtr(&triggers, "")
+	return triggers, err
+}
@@ -0,0 +1,16 @@
+package triggers
+
+import (
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	v2 "github.com/opentelekomcloud/gophertelekomcloud/openstack/swr/v2"
+)
+
+const triggers = "triggers"
+
+func listURL(client *golangsdk.ServiceClient, org, repo string) string {
+	return client.ServiceURL(v2.Base, v2.Namespaces, org, v2.Repos, repo, triggers)
+}
+
+func triggerURL(client *golangsdk.ServiceClient, org, repo, trigger string) string {
+	return client.ServiceURL(v2.Base, v2.Namespaces, org, v2.Repos, repo, triggers, trigger)
+}
@@ -0,0 +1,69 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/obs"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+const listBucketsResponse = `<?xml version="1.0" encoding="UTF-8"?>
+<ListAllMyBucketsResult xmlns="http://obs.otc.t-systems.com/doc/2015-06-30/">
+  <Owner>
+    <ID>783fc6652cf246c096ea836694f71855</ID>
+  </Owner>
+  <Buckets>
+    <Bucket>
+      <Name>test-bucket</Name>
+      <CreationDate>2021-01-01T00:00:00.000Z</CreationDate>
+      <Location>eu-de</Location>
+    </Bucket>
+  </Buckets>
+</ListAllMyBucketsResult>`
+
+func obsProviderClient(ak, sk string) *golangsdk.ProviderClient {
+	client := &golangsdk.ProviderClient{
+		AKSKAuthOptions: golangsdk.AKSKAuthOptions{
+			AccessKey: ak,
+			SecretKey: sk,
+		},
+	}
+	client.EndpointLocator = func(opts golangsdk.EndpointOpts) (string, error) {
+		return th.Endpoint(), nil
+	}
+	return client
+}
+
+func TestNewOBSClient(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.AssertEquals(t, true, strings.Contains(r.Header.Get("Authorization"), "access"))
+
+		w.Header().Add("Content-Type", "application/xml")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, listBucketsResponse)
+	})
+
+	client, err := openstack.NewOBSClient(obsProviderClient("access", "secret"), golangsdk.EndpointOpts{}, obs.WithPathStyle(true))
+	th.AssertNoErr(t, err)
+
+	output, err := client.ListBuckets(nil)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(output.Buckets))
+	th.AssertEquals(t, "test-bucket", output.Buckets[0].Name)
+}
+
+func TestNewOBSClientWithoutAKSK(t *testing.T) {
+	_, err := openstack.NewOBSClient(obsProviderClient("", ""), golangsdk.EndpointOpts{})
+	if err == nil {
+		t.Fatal("expected error for missing AK/SK")
+	}
+}
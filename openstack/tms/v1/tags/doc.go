@@ -0,0 +1,34 @@
+/*
+Package tags manages the predefined tags of the Tag Management Service (TMS).
+
+Predefined tags are a domain-wide tag taxonomy, which can be used to validate
+the tags of the resources before they are provisioned.
+
+Example to Create Predefined Tags
+
+	err := tags.Create(tmsClient, []tags.Tag{
+		{Key: "environment", Value: "production"},
+		{Key: "environment", Value: "staging"},
+	}).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to List Predefined Tags
+
+	list, err := tags.List(tmsClient, tags.ListOpts{Key: "environment"}).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update a Predefined Tag
+
+	err := tags.Update(tmsClient, tags.UpdateOpts{
+		OldTag: tags.Tag{Key: "environment", Value: "staging"},
+		NewTag: tags.Tag{Key: "environment", Value: "testing"},
+	}).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package tags
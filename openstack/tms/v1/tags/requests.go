@@ -59,3 +59,91 @@ func Get(client *golangsdk.ServiceClient) (r GetResult) {
 	_, r.Err = client.Get(rootURL(client), &r.Body, nil)
 	return
 }
+
+// Create adds the given predefined tags. Up to 20 tags can be created at once.
+func Create(client *golangsdk.ServiceClient, tags []Tag) (r ActionResults) {
+	return BatchAction(client, "", BatchOpts{Tags: tags, Action: ActionCreate})
+}
+
+// Delete removes the given predefined tags. Up to 20 tags can be deleted at once.
+func Delete(client *golangsdk.ServiceClient, tags []Tag) (r ActionResults) {
+	return BatchAction(client, "", BatchOpts{Tags: tags, Action: ActionDelete})
+}
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToTagsListQuery() (string, error)
+}
+
+// ListOpts allows to filter and sort the predefined tags.
+type ListOpts struct {
+	// Key filters by tag key, fuzzy match.
+	Key string `q:"key"`
+	// Value filters by tag value, fuzzy match.
+	Value string `q:"value"`
+	// Limit is the number of tags to return, 1 to 1000.
+	Limit int `q:"limit"`
+	// Marker is the pagination marker returned by the previous query.
+	Marker string `q:"marker"`
+	// OrderField is the field to sort by, one of "update_time", "key" or "value".
+	OrderField string `q:"order_field"`
+	// OrderMethod is the sort direction, "asc" or "desc".
+	OrderMethod string `q:"order_method"`
+}
+
+// ToTagsListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToTagsListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), nil
+}
+
+// List retrieves the predefined tags matching the given options.
+func List(client *golangsdk.ServiceClient, opts ListOptsBuilder) (r ListResult) {
+	url := rootURL(client)
+	if opts != nil {
+		query, err := opts.ToTagsListQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += query
+	}
+	_, r.Err = client.Get(url, &r.Body, nil)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToTagsUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts contains the values needed to replace a predefined tag.
+type UpdateOpts struct {
+	// NewTag is the tag to replace OldTag with.
+	NewTag Tag `json:"new_tag" required:"true"`
+	// OldTag is the existing tag.
+	OldTag Tag `json:"old_tag" required:"true"`
+}
+
+// ToTagsUpdateMap builds an Update request body from UpdateOpts.
+func (opts UpdateOpts) ToTagsUpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Update replaces a predefined tag.
+func Update(client *golangsdk.ServiceClient, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToTagsUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(rootURL(client), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
@@ -17,6 +17,11 @@ type ActionResults struct {
 	commonResult
 }
 
+// ExtractErr is used to determine whether the batch action succeeded or failed.
+func (r ActionResults) ExtractErr() error {
+	return r.Err
+}
+
 type GetResult struct {
 	commonResult
 }
@@ -26,3 +31,36 @@ func (r commonResult) Extract() (*RespTags, error) {
 	err := r.ExtractInto(&response)
 	return &response, err
 }
+
+// PredefinedTag is a predefined tag with its last update time.
+type PredefinedTag struct {
+	Key        string `json:"key"`
+	Value      string `json:"value"`
+	UpdateTime string `json:"update_time"`
+}
+
+// ListTags is the response of a List request.
+type ListTags struct {
+	Tags []PredefinedTag `json:"tags"`
+	// Marker is used to query the next page of tags.
+	Marker string `json:"marker"`
+	// TotalCount is the total number of tags matching the query.
+	TotalCount int `json:"total_count"`
+}
+
+type ListResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result as ListTags.
+func (r ListResult) Extract() (*ListTags, error) {
+	var response ListTags
+	err := r.ExtractInto(&response)
+	return &response, err
+}
+
+// UpdateResult is the result of an Update request.
+// Call its ExtractErr method to determine if the request succeeded or failed.
+type UpdateResult struct {
+	golangsdk.ErrResult
+}
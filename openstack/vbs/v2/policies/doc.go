@@ -37,4 +37,11 @@ Example to disassociate a resource to a Policy
 	disassopts := policies.DisassociateOpts{Resources: []policies.DisassociateResource{{ResourceID: "bdec76de-3cca-46b4-8b71-a333467a1b79"},{ResourceID: "286b8b84-6640-4f6f-acde-2a58e490f371"}}}
 	disassociate,err := policies.Disassociate(client,"5b549fad-c4e5-4d7e-83b9-eea366f27017",disassopts).ExtractResource()
 
+Example to Execute a Policy
+
+	err := policies.Execute(client, "5b549fad-c4e5-4d7e-83b9-eea366f27017").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
 */
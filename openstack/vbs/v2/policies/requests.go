@@ -291,3 +291,12 @@ func Disassociate(client *golangsdk.ServiceClient, policyID string, opts Disasso
 	})
 	return
 }
+
+// Execute will immediately run the specified backup policy.
+// Backups of all volumes associated with the policy are created.
+func Execute(c *golangsdk.ServiceClient, policyID string) (r ExecuteResult) {
+	_, r.Err = c.Post(actionURL(c, policyID), nil, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
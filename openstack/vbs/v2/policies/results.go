@@ -58,6 +58,11 @@ type ResourceResult struct {
 	commonResult
 }
 
+// ExecuteResult represents the result of a policy execution.
+type ExecuteResult struct {
+	golangsdk.ErrResult
+}
+
 // Extract will get the Policy object from the commonResult
 func (r commonResult) Extract() (*Policy, error) {
 	var response Policy
@@ -178,3 +178,17 @@ func TestDisassociateV2Policy(t *testing.T) {
 	expected := Disassociate
 	th.AssertDeepEquals(t, expected, associate)
 }
+
+func TestExecuteV2Policy(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/backuppolicy/ed8b9f73-4415-494d-a54e-5f3373bc353d/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusOK)
+	})
+
+	err := policies.Execute(fake.ServiceClient(), "ed8b9f73-4415-494d-a54e-5f3373bc353d").ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -22,3 +22,7 @@ func associateURL(c *golangsdk.ServiceClient) string {
 func disassociateURL(c *golangsdk.ServiceClient, policyID string) string {
 	return c.ServiceURL(policyResourcePath, policyID, "deleted_resources")
 }
+
+func actionURL(c *golangsdk.ServiceClient, policyID string) string {
+	return c.ServiceURL(backupRootPath, policyID, "action")
+}
@@ -0,0 +1,128 @@
+package certificates
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack"
+)
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToCertCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts contains all the values needed to create a new certificate.
+type CreateOpts struct {
+	// Certificate name
+	Name string `json:"name" required:"true"`
+	// Certificate content in PEM format
+	Content string `json:"content" required:"true"`
+	// Private key in PEM format
+	Key string `json:"key" required:"true"`
+}
+
+// ToCertCreateMap builds a create request body from CreateOpts.
+func (opts CreateOpts) ToCertCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Create will create a new certificate based on the values in CreateOpts.
+func Create(c *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToCertCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{200}}
+	_, r.Err = c.Post(rootURL(c), b, &r.Body, reqOpt)
+	return
+}
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToCertListQuery() (string, error)
+}
+
+// ListOpts allows to filter the list of certificates.
+type ListOpts struct {
+	// Page number
+	Page int `q:"page"`
+	// Number of records on a page
+	PageSize int `q:"pagesize"`
+	// Certificate name
+	Name string `q:"name"`
+	// Certificate expiration status: 0 - not expired, 1 - expired, 2 - expires soon
+	ExpirationStatus *int `q:"exp_status"`
+}
+
+// ToCertListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToCertListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), err
+}
+
+// List returns a page of certificates.
+func List(c *golangsdk.ServiceClient, opts ListOptsBuilder) (r ListResult) {
+	url := rootURL(c)
+	if opts != nil {
+		q, err := opts.ToCertListQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += q
+	}
+	_, r.Err = c.Get(url, &r.Body, openstack.StdRequestOpts())
+	return
+}
+
+// Get retrieves a particular certificate based on its unique ID.
+func Get(c *golangsdk.ServiceClient, id string) (r GetResult) {
+	_, r.Err = c.Get(resourceURL(c, id), &r.Body, openstack.StdRequestOpts())
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToCertUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts contains all the values needed to update a certificate.
+type UpdateOpts struct {
+	// Certificate name
+	Name string `json:"name,omitempty"`
+	// Certificate content in PEM format
+	Content string `json:"content,omitempty"`
+	// Private key in PEM format
+	Key string `json:"key,omitempty"`
+}
+
+// ToCertUpdateMap builds a update request body from UpdateOpts.
+func (opts UpdateOpts) ToCertUpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Update accepts a UpdateOpts struct and uses the values to update a certificate.
+func Update(c *golangsdk.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToCertUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{200}}
+	_, r.Err = c.Put(resourceURL(c, id), b, &r.Body, reqOpt)
+	return
+}
+
+// Delete will permanently delete a particular certificate based on its unique ID.
+func Delete(c *golangsdk.ServiceClient, id string) (r DeleteResult) {
+	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{200},
+		MoreHeaders: openstack.StdRequestOpts().MoreHeaders}
+	_, r.Err = c.Delete(resourceURL(c, id), reqOpt)
+	return
+}
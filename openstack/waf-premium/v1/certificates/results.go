@@ -0,0 +1,78 @@
+package certificates
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+type Certificate struct {
+	// Certificate ID
+	Id string `json:"id"`
+	// Certificate name
+	Name string `json:"name"`
+	// Certificate content
+	Content string `json:"content"`
+	// Expiration time (unix timestamp)
+	ExpireTime int64 `json:"expire_time"`
+	// Certificate expiration status: 0 - not expired, 1 - expired, 2 - expires soon
+	ExpirationStatus int `json:"exp_status"`
+	// Creation time (unix timestamp)
+	Timestamp int64 `json:"timestamp"`
+	// Domains bound to the certificate
+	BindHosts []BindHost `json:"bind_host"`
+}
+
+type BindHost struct {
+	// Domain ID
+	Id string `json:"id"`
+	// Domain name
+	Hostname string `json:"hostname"`
+	// Domain protection mode: `cloud` or `premium`
+	WafType string `json:"waf_type"`
+}
+
+type commonResult struct {
+	golangsdk.Result
+}
+
+// Extract is a function that accepts a result and extracts a certificate.
+func (r commonResult) Extract() (*Certificate, error) {
+	s := new(Certificate)
+	err := r.ExtractIntoStructPtr(s, "")
+	return s, err
+}
+
+// CreateResult represents the result of a create operation. Call its Extract
+// method to interpret it as a Certificate.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as a Certificate.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of a update operation. Call its Extract
+// method to interpret it as a Certificate.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	golangsdk.ErrResult
+}
+
+// ListResult represents the result of a list operation. Call its Extract
+// method to interpret it as a slice of Certificate.
+type ListResult struct {
+	golangsdk.Result
+}
+
+func (r ListResult) Extract() ([]Certificate, error) {
+	var s []Certificate
+	err := r.ExtractIntoSlicePtr(&s, "items")
+	return s, err
+}
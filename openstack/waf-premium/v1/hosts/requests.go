@@ -0,0 +1,208 @@
+package hosts
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack"
+)
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToHostCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts contains all the values needed to onboard a new domain to the dedicated WAF.
+type CreateOpts struct {
+	// Certificate ID, required for HTTPS client protocol
+	CertificateId string `json:"certificateid,omitempty"`
+	// Certificate name, required for HTTPS client protocol
+	CertificateName string `json:"certificatename,omitempty"`
+	// Protected domain name or IP address with port
+	Hostname string `json:"hostname" required:"true"`
+	// Whether a proxy is used in front of the WAF
+	Proxy *bool `json:"proxy" required:"true"`
+	// Policy ID
+	PolicyId string `json:"policyid,omitempty"`
+	// The original server information
+	Server []ServerOpts `json:"server" required:"true"`
+}
+
+type ServerOpts struct {
+	// Protocol type of the client: `HTTP` or `HTTPS`
+	FrontProtocol string `json:"front_protocol" required:"true"`
+	// Protocol used by WAF to forward client requests to the server: `HTTP` or `HTTPS`
+	BackProtocol string `json:"back_protocol" required:"true"`
+	// IP address of the web server that the client accesses
+	Address string `json:"address" required:"true"`
+	// Port number used by the web server
+	Port int `json:"port" required:"true"`
+	// Type of the server address: `ipv4` or `ipv6`
+	Type string `json:"type" required:"true"`
+	// ID of the VPC where the web server is located
+	VpcId string `json:"vpc_id" required:"true"`
+}
+
+// ToHostCreateMap builds a create request body from CreateOpts.
+func (opts CreateOpts) ToHostCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Create will onboard a new domain based on the values in CreateOpts.
+func Create(c *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToHostCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{200}}
+	_, r.Err = c.Post(rootURL(c), b, &r.Body, reqOpt)
+	return
+}
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToHostListQuery() (string, error)
+}
+
+// ListOpts allows to filter the list of protected domains.
+type ListOpts struct {
+	// Page number
+	Page int `q:"page"`
+	// Number of records on a page
+	PageSize int `q:"pagesize"`
+	// Domain name
+	Hostname string `q:"hostname"`
+	// Policy name
+	PolicyName string `q:"policyname"`
+	// Protection status
+	ProtectStatus *int `q:"protect_status"`
+}
+
+// ToHostListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToHostListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), err
+}
+
+// List returns a page of domains protected by the dedicated WAF.
+func List(c *golangsdk.ServiceClient, opts ListOptsBuilder) (r ListResult) {
+	url := rootURL(c)
+	if opts != nil {
+		q, err := opts.ToHostListQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += q
+	}
+	_, r.Err = c.Get(url, &r.Body, openstack.StdRequestOpts())
+	return
+}
+
+// Get retrieves a particular domain based on its unique ID.
+func Get(c *golangsdk.ServiceClient, id string) (r GetResult) {
+	_, r.Err = c.Get(resourceURL(c, id), &r.Body, openstack.StdRequestOpts())
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToHostUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts contains all the values needed to update a domain.
+type UpdateOpts struct {
+	// Whether a proxy is used in front of the WAF
+	Proxy *bool `json:"proxy,omitempty"`
+	// Certificate ID
+	CertificateId string `json:"certificateid,omitempty"`
+	// Certificate name
+	CertificateName string `json:"certificatename,omitempty"`
+	// Minimum TLS version: `TLS v1.0`, `TLS v1.1` or `TLS v1.2`
+	TLS string `json:"tls,omitempty"`
+	// Cipher suite: `cipher_1`, `cipher_2`, `cipher_3`, `cipher_4` or `cipher_default`
+	Cipher string `json:"cipher,omitempty"`
+	// Domain description
+	Description *string `json:"description,omitempty"`
+	// Alarm page configuration
+	BlockPage *BlockPage `json:"block_page,omitempty"`
+	// Traffic identifiers of the source IP
+	TrafficMark *TrafficMark `json:"traffic_mark,omitempty"`
+	// Timeout settings of the connections to the origin server
+	TimeoutConfig *TimeoutConfig `json:"timeout_config,omitempty"`
+}
+
+type BlockPage struct {
+	// Template name: `default`, `custom` or `redirect`
+	Template string `json:"template" required:"true"`
+	// Custom alarm page
+	CustomPage *CustomPage `json:"custom_page,omitempty"`
+	// URL of the redirection page
+	RedirectUrl string `json:"redirect_url,omitempty"`
+}
+
+type CustomPage struct {
+	// Status code
+	StatusCode string `json:"status_code" required:"true"`
+	// Content type of the page: `text/html`, `text/xml` or `application/json`
+	ContentType string `json:"content_type" required:"true"`
+	// Page content
+	Content string `json:"content" required:"true"`
+}
+
+type TrafficMark struct {
+	// HTTP headers of the source IP
+	Sip []string `json:"sip,omitempty"`
+	// Cookie name of the session
+	Cookie string `json:"cookie,omitempty"`
+	// Parameter name of the session
+	Params string `json:"params,omitempty"`
+}
+
+type TimeoutConfig struct {
+	// Timeout for WAF to connect to the origin server, in seconds
+	ConnectTimeout int `json:"connect_timeout,omitempty"`
+	// Timeout for WAF to send requests to the origin server, in seconds
+	SendTimeout int `json:"send_timeout,omitempty"`
+	// Timeout for WAF to receive responses from the origin server, in seconds
+	ReadTimeout int `json:"read_timeout,omitempty"`
+}
+
+// ToHostUpdateMap builds a update request body from UpdateOpts.
+func (opts UpdateOpts) ToHostUpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Update accepts a UpdateOpts struct and uses the values to update a domain.
+func Update(c *golangsdk.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToHostUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{200}}
+	_, r.Err = c.Put(resourceURL(c, id), b, &r.Body, reqOpt)
+	return
+}
+
+// UpdateProtectStatus changes the protection status of a domain:
+// 0 - suspended, 1 - enabled.
+func UpdateProtectStatus(c *golangsdk.ServiceClient, id string, status int) (r ProtectStatusResult) {
+	b := map[string]interface{}{"protect_status": status}
+	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{200}}
+	_, r.Err = c.Put(protectStatusURL(c, id), b, &r.Body, reqOpt)
+	return
+}
+
+// Delete will permanently delete a particular domain based on its unique ID.
+func Delete(c *golangsdk.ServiceClient, id string) (r DeleteResult) {
+	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{200},
+		MoreHeaders: openstack.StdRequestOpts().MoreHeaders}
+	_, r.Err = c.Delete(resourceURL(c, id), reqOpt)
+	return
+}
@@ -0,0 +1,120 @@
+package hosts
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+type Host struct {
+	// Domain ID
+	Id string `json:"id"`
+	// Domain name
+	Hostname string `json:"hostname"`
+	// Client protocol type
+	Protocol string `json:"protocol"`
+	// The original server information
+	Server []Server `json:"server"`
+	// Whether a proxy is used in front of the WAF
+	Proxy bool `json:"proxy"`
+	// Whether the domain is locked
+	Locked int `json:"locked"`
+	// Creation time (unix timestamp)
+	Timestamp int64 `json:"timestamp"`
+	// Policy ID
+	PolicyId string `json:"policyid"`
+	// Protection status: -1 - bypassed, 0 - suspended, 1 - enabled
+	ProtectStatus int `json:"protect_status"`
+	// Access status: 0 - inaccessible, 1 - accessible
+	AccessStatus int `json:"access_status"`
+	// Certificate ID
+	CertificateId string `json:"certificateid"`
+	// Certificate name
+	CertificateName string `json:"certificatename"`
+	// Minimum TLS version
+	TLS string `json:"tls"`
+	// Cipher suite
+	Cipher string `json:"cipher"`
+	// Domain description
+	Description string `json:"description"`
+	// Alarm page configuration
+	BlockPage BlockPage `json:"block_page"`
+	// Traffic identifiers of the source IP
+	TrafficMark TrafficMark `json:"traffic_mark"`
+	// Timeout settings of the connections to the origin server
+	TimeoutConfig TimeoutConfig `json:"timeout_config"`
+}
+
+type Server struct {
+	// Protocol type of the client
+	FrontProtocol string `json:"front_protocol"`
+	// Protocol used by WAF to forward client requests to the server
+	BackProtocol string `json:"back_protocol"`
+	// IP address of the web server that the client accesses
+	Address string `json:"address"`
+	// Port number used by the web server
+	Port int `json:"port"`
+	// Type of the server address
+	Type string `json:"type"`
+	// ID of the VPC where the web server is located
+	VpcId string `json:"vpc_id"`
+}
+
+type commonResult struct {
+	golangsdk.Result
+}
+
+// Extract is a function that accepts a result and extracts a host.
+func (r commonResult) Extract() (*Host, error) {
+	s := new(Host)
+	err := r.ExtractIntoStructPtr(s, "")
+	return s, err
+}
+
+// CreateResult represents the result of a create operation. Call its Extract
+// method to interpret it as a Host.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as a Host.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of a update operation. Call its Extract
+// method to interpret it as a Host.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	golangsdk.ErrResult
+}
+
+// ProtectStatusResult represents the result of a protection status update.
+type ProtectStatusResult struct {
+	golangsdk.Result
+}
+
+// Extract returns the new protection status.
+func (r ProtectStatusResult) Extract() (int, error) {
+	var s struct {
+		ProtectStatus int `json:"protect_status"`
+	}
+	err := r.ExtractInto(&s)
+	return s.ProtectStatus, err
+}
+
+// ListResult represents the result of a list operation. Call its Extract
+// method to interpret it as a slice of Host.
+type ListResult struct {
+	golangsdk.Result
+}
+
+func (r ListResult) Extract() ([]Host, error) {
+	var s []Host
+	err := r.ExtractIntoSlicePtr(&s, "items")
+	return s, err
+}
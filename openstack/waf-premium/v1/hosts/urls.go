@@ -0,0 +1,15 @@
+package hosts
+
+import "github.com/opentelekomcloud/gophertelekomcloud"
+
+func rootURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("premium-waf", "host")
+}
+
+func resourceURL(c *golangsdk.ServiceClient, id string) string {
+	return c.ServiceURL("premium-waf", "host", id)
+}
+
+func protectStatusURL(c *golangsdk.ServiceClient, id string) string {
+	return c.ServiceURL("premium-waf", "host", id, "protect-status")
+}
@@ -0,0 +1,172 @@
+package instances
+
+import (
+	"fmt"
+
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack"
+)
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToInstanceCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts contains all the values needed to create new dedicated WAF instances.
+type CreateOpts struct {
+	// Billing mode, only pay-per-use billing (30) is supported
+	ChargeMode int `json:"chargemode,omitempty"`
+	// Region where the instances are created
+	Region string `json:"region" required:"true"`
+	// AZ where the instances are created
+	AvailabilityZone string `json:"available_zone" required:"true"`
+	// CPU architecture, `x86` is supported
+	Architecture string `json:"arch" required:"true"`
+	// Prefix of the instance names
+	NamePrefix string `json:"instancename" required:"true"`
+	// Specification code of the instance, e.g. `waf.instance.professional`
+	Specification string `json:"specification" required:"true"`
+	// ID of the ECS flavor used by the instance
+	Flavor string `json:"cpu_flavor" required:"true"`
+	// ID of the VPC where the instances are located
+	VpcID string `json:"vpc_id" required:"true"`
+	// ID of the VPC subnet where the instances are located
+	SubnetID string `json:"subnet_id" required:"true"`
+	// IDs of the security groups of the instances
+	SecurityGroupsID []string `json:"security_group" required:"true"`
+	// Number of the instances to create
+	Count int `json:"count" required:"true"`
+	// ID of the instance group (pool) the instances are added to
+	PoolID string `json:"pool_id,omitempty"`
+	// Whether the instances are created in the WAF resource tenant
+	ResTenant *bool `json:"res_tenant,omitempty"`
+}
+
+// ToInstanceCreateMap builds a create request body from CreateOpts.
+func (opts CreateOpts) ToInstanceCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Create will create new dedicated WAF instances based on the values in CreateOpts.
+func Create(c *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToInstanceCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{200}}
+	_, r.Err = c.Post(rootURL(c), b, &r.Body, reqOpt)
+	return
+}
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToInstanceListQuery() (string, error)
+}
+
+// ListOpts allows to filter the list of dedicated WAF instances.
+type ListOpts struct {
+	// Page number
+	Page int `q:"page"`
+	// Number of records on a page
+	PageSize int `q:"pagesize"`
+	// Name of the instances to query
+	InstanceName string `q:"instancename"`
+}
+
+// ToInstanceListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToInstanceListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), err
+}
+
+// List returns a page of dedicated WAF instances.
+func List(c *golangsdk.ServiceClient, opts ListOptsBuilder) (r ListResult) {
+	url := rootURL(c)
+	if opts != nil {
+		q, err := opts.ToInstanceListQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += q
+	}
+	_, r.Err = c.Get(url, &r.Body, openstack.StdRequestOpts())
+	return
+}
+
+// Get retrieves a particular dedicated WAF instance based on its unique ID.
+func Get(c *golangsdk.ServiceClient, id string) (r GetResult) {
+	_, r.Err = c.Get(resourceURL(c, id), &r.Body, openstack.StdRequestOpts())
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToInstanceUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts contains all the values needed to update a dedicated WAF instance.
+type UpdateOpts struct {
+	// Instance name
+	Name string `json:"instancename" required:"true"`
+}
+
+// ToInstanceUpdateMap builds a update request body from UpdateOpts.
+func (opts UpdateOpts) ToInstanceUpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Update accepts a UpdateOpts struct and uses the values to update a dedicated WAF instance.
+func Update(c *golangsdk.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToInstanceUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{200}}
+	_, r.Err = c.Put(resourceURL(c, id), b, &r.Body, reqOpt)
+	return
+}
+
+// Delete will permanently delete a particular dedicated WAF instance based on its unique ID.
+func Delete(c *golangsdk.ServiceClient, id string) (r DeleteResult) {
+	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{200},
+		MoreHeaders: openstack.StdRequestOpts().MoreHeaders}
+	_, r.Err = c.Delete(resourceURL(c, id), reqOpt)
+	return
+}
+
+// WaitForStatus waits for the instance to reach the given run status.
+func WaitForStatus(c *golangsdk.ServiceClient, id string, status int, secs int) error {
+	return golangsdk.WaitFor(secs, func() (bool, error) {
+		instance, err := Get(c, id).Extract()
+		if err != nil {
+			return false, err
+		}
+		if instance.Status == StatusCreationFailed {
+			return false, fmt.Errorf("WAF instance %s creation failed", id)
+		}
+		return instance.Status == status, nil
+	})
+}
+
+// WaitForDeleted waits for the instance to be deleted.
+func WaitForDeleted(c *golangsdk.ServiceClient, id string, secs int) error {
+	return golangsdk.WaitFor(secs, func() (bool, error) {
+		_, err := Get(c, id).Extract()
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return true, nil
+			}
+			return false, err
+		}
+		return false, nil
+	})
+}
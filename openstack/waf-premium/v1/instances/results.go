@@ -0,0 +1,114 @@
+package instances
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+// Run statuses of a dedicated WAF instance
+const (
+	StatusCreating       = 0
+	StatusRunning        = 1
+	StatusDeleting       = 2
+	StatusDeleted        = 3
+	StatusCreationFailed = 4
+	StatusFrozen         = 5
+	StatusAbnormal       = 6
+	StatusUpdating       = 7
+	StatusUpdateFailed   = 8
+)
+
+// CreatedInstance is the short description of the created instance.
+type CreatedInstance struct {
+	// Instance ID
+	Id string `json:"id"`
+	// Instance name
+	Name string `json:"name"`
+}
+
+type Instance struct {
+	// Instance ID
+	Id string `json:"id"`
+	// Instance name
+	Name string `json:"instancename"`
+	// Region where the instance is located
+	Region string `json:"region"`
+	// AZ where the instance is located
+	AvailabilityZone string `json:"zone"`
+	// CPU architecture
+	Architecture string `json:"arch"`
+	// ID of the ECS flavor
+	Flavor string `json:"cpu_flavor"`
+	// ID of the VPC where the instance is located
+	VpcID string `json:"vpc_id"`
+	// ID of the VPC subnet where the instance is located
+	SubnetID string `json:"subnet_id"`
+	// Service plane IP address of the instance
+	ServiceIp string `json:"service_ip"`
+	// IDs of the security groups of the instance
+	SecurityGroupsID []string `json:"security_group_ids"`
+	// Run status of the instance, see the Status* constants
+	Status int `json:"status"`
+	// Access status of the instance: 0 - inaccessible, 1 - accessible
+	AccessStatus int `json:"access_status"`
+	// Whether the instance can be upgraded: 0 - no, 1 - yes
+	Upgradable int `json:"upgradable"`
+	// Specification code of the instance
+	Specification string `json:"specification"`
+	// ID of the ECS hosting the instance
+	ServerId string `json:"server_id"`
+	// Creation time (unix timestamp)
+	CreatedAt int64 `json:"create_time"`
+}
+
+// CreateResult represents the result of a create operation. Call its Extract
+// method to interpret it as a slice of CreatedInstance.
+type CreateResult struct {
+	golangsdk.Result
+}
+
+func (r CreateResult) Extract() ([]CreatedInstance, error) {
+	var s []CreatedInstance
+	err := r.ExtractIntoSlicePtr(&s, "instances")
+	return s, err
+}
+
+type commonResult struct {
+	golangsdk.Result
+}
+
+// Extract is a function that accepts a result and extracts an instance.
+func (r commonResult) Extract() (*Instance, error) {
+	s := new(Instance)
+	err := r.ExtractIntoStructPtr(s, "")
+	return s, err
+}
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as an Instance.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of a update operation. Call its Extract
+// method to interpret it as an Instance.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	golangsdk.ErrResult
+}
+
+// ListResult represents the result of a list operation. Call its Extract
+// method to interpret it as a slice of Instance.
+type ListResult struct {
+	golangsdk.Result
+}
+
+func (r ListResult) Extract() ([]Instance, error) {
+	var s []Instance
+	err := r.ExtractIntoSlicePtr(&s, "items")
+	return s, err
+}
@@ -0,0 +1,74 @@
+package antileakage_rules
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack"
+)
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToAntiLeakageCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts contains all the values needed to create a new information leakage prevention rule.
+type CreateOpts struct {
+	// URL to which the rule applies, e.g. `/admin/*`
+	Url string `json:"url" required:"true"`
+	// Type of the sensitive information: `code` for response codes
+	// or `sensitive` for sensitive data
+	Category string `json:"category" required:"true"`
+	// Values of the leakage, e.g. `404` for `code` or `phone`, `id_card`, `email` for `sensitive`
+	Contents []string `json:"contents" required:"true"`
+	// Rule description
+	Description string `json:"description,omitempty"`
+}
+
+// ToAntiLeakageCreateMap builds a create request body from CreateOpts.
+func (opts CreateOpts) ToAntiLeakageCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Create will create a new information leakage prevention rule based on the values in CreateOpts.
+func Create(c *golangsdk.ServiceClient, policyID string, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToAntiLeakageCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{200}}
+	_, r.Err = c.Post(rootURL(c, policyID), b, &r.Body, reqOpt)
+	return
+}
+
+// Update accepts a CreateOpts struct and uses the values to update a rule.
+func Update(c *golangsdk.ServiceClient, policyID, ruleID string, opts CreateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToAntiLeakageCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{200}}
+	_, r.Err = c.Put(resourceURL(c, policyID, ruleID), b, &r.Body, reqOpt)
+	return
+}
+
+// Get retrieves a particular information leakage prevention rule based on its unique ID.
+func Get(c *golangsdk.ServiceClient, policyID, ruleID string) (r GetResult) {
+	_, r.Err = c.Get(resourceURL(c, policyID, ruleID), &r.Body, openstack.StdRequestOpts())
+	return
+}
+
+// List retrieves information leakage prevention rules.
+func List(c *golangsdk.ServiceClient, policyID string) (r ListResult) {
+	_, r.Err = c.Get(rootURL(c, policyID), &r.Body, openstack.StdRequestOpts())
+	return
+}
+
+// Delete will permanently delete a particular information leakage prevention rule based on its unique ID.
+func Delete(c *golangsdk.ServiceClient, policyID, ruleID string) (r DeleteResult) {
+	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{200, 204},
+		MoreHeaders: openstack.StdRequestOpts().MoreHeaders}
+	_, r.Err = c.Delete(resourceURL(c, policyID, ruleID), reqOpt)
+	return
+}
@@ -0,0 +1,71 @@
+package antileakage_rules
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+type AntiLeakage struct {
+	// Rule ID
+	Id string `json:"id"`
+	// Policy ID
+	PolicyID string `json:"policyid"`
+	// URL to which the rule applies
+	Url string `json:"url"`
+	// Type of the sensitive information
+	Category string `json:"category"`
+	// Values of the leakage
+	Contents []string `json:"contents"`
+	// Rule status: 0 - disabled, 1 - enabled
+	Status int `json:"status"`
+	// Rule description
+	Description string `json:"description"`
+	// Creation time (unix timestamp)
+	Timestamp int64 `json:"timestamp"`
+}
+
+type commonResult struct {
+	golangsdk.Result
+}
+
+// Extract is a function that accepts a result and extracts a information leakage prevention rule.
+func (r commonResult) Extract() (*AntiLeakage, error) {
+	var response AntiLeakage
+	err := r.ExtractInto(&response)
+	return &response, err
+}
+
+// CreateResult represents the result of a create operation. Call its Extract
+// method to interpret it as a AntiLeakage rule.
+type CreateResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of a update operation. Call its Extract
+// method to interpret it as a AntiLeakage rule.
+type UpdateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as a AntiLeakage rule.
+type GetResult struct {
+	commonResult
+}
+
+// ListResult represents the result of a list operation. Call its Extract
+// method to interpret it as a slice of AntiLeakage rules.
+type ListResult struct {
+	commonResult
+}
+
+func (r ListResult) Extract() ([]AntiLeakage, error) {
+	var s []AntiLeakage
+	err := r.ExtractIntoSlicePtr(&s, "items")
+	return s, err
+}
+
+// DeleteResult represents the result of a delete operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	golangsdk.ErrResult
+}
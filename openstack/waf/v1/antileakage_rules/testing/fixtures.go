@@ -0,0 +1,79 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const (
+	policyID = "38ff0cb9a10e4d5293c642bc0350fa6d"
+	ruleID   = "82c4f8e3f41e4e5f9e6b4c2b38d1c7a0"
+)
+
+const expectedCreateRequest = `
+{
+  "url": "/admin/*",
+  "category": "sensitive",
+  "contents": ["phone", "email"]
+}`
+
+var ruleResponse = fmt.Sprintf(`
+{
+  "id": "%s",
+  "policyid": "%s",
+  "url": "/admin/*",
+  "category": "sensitive",
+  "contents": ["phone", "email"],
+  "status": 1,
+  "timestamp": 1636340038062
+}`, ruleID, policyID)
+
+var listResponse = fmt.Sprintf(`
+{
+  "total": 1,
+  "items": [
+    %s
+  ]
+}`, ruleResponse)
+
+// HandleCreateSuccessfully creates an HTTP handler at `/policy/{policy_id}/antileakage` on the
+// test handler mux that responds with the created leakage prevention rule.
+func HandleCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/policy/%s/antileakage", policyID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, expectedCreateRequest)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, ruleResponse)
+	})
+}
+
+// HandleListSuccessfully creates an HTTP handler at `/policy/{policy_id}/antileakage` on the
+// test handler mux that responds with a single leakage prevention rule.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/policy/%s/antileakage", policyID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, listResponse)
+	})
+}
+
+// HandleUpdateRuleStatusSuccessfully creates an HTTP handler at
+// `/policy/{policy_id}/antileakage/{rule_id}/status` on the test handler mux that enables the rule.
+func HandleUpdateRuleStatusSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/policy/%s/antileakage/%s/status", policyID, ruleID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `{"status": 1}`)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, `{"id": "%s", "policyid": "%s", "status": 1}`, ruleID, policyID)
+	})
+}
@@ -0,0 +1,47 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/waf/v1/antileakage_rules"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/waf/v1/policies"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSuccessfully(t)
+
+	rule, err := antileakage_rules.Create(fake.ServiceClient(), policyID, antileakage_rules.CreateOpts{
+		Url:      "/admin/*",
+		Category: "sensitive",
+		Contents: []string{"phone", "email"},
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, ruleID, rule.Id)
+	th.AssertDeepEquals(t, []string{"phone", "email"}, rule.Contents)
+}
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	rules, err := antileakage_rules.List(fake.ServiceClient(), policyID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(rules))
+	th.AssertEquals(t, ruleID, rules[0].Id)
+	th.AssertEquals(t, "sensitive", rules[0].Category)
+	th.AssertEquals(t, 1, rules[0].Status)
+}
+
+func TestUpdateRuleStatus(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateRuleStatusSuccessfully(t)
+
+	err := policies.UpdateRuleStatus(fake.ServiceClient(), policyID, policies.RuleTypeAntiLeakage, ruleID, 1).ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -41,6 +41,18 @@ type GetResult struct {
 	commonResult
 }
 
+// ListResult represents the result of a list operation. Call its Extract
+// method to interpret it as a slice of CcAttack rules.
+type ListResult struct {
+	commonResult
+}
+
+func (r ListResult) Extract() ([]CcAttack, error) {
+	var s []CcAttack
+	err := r.ExtractIntoSlicePtr(&s, "items")
+	return s, err
+}
+
 // DeleteResult represents the result of a delete operation. Call its ExtractErr
 // method to determine if the request succeeded or failed.
 type DeleteResult struct {
@@ -71,6 +71,12 @@ func Get(c *golangsdk.ServiceClient, policyID, ruleID string) (r GetResult) {
 	return
 }
 
+// List retrieves datamasking rules.
+func List(c *golangsdk.ServiceClient, policyID string) (r ListResult) {
+	_, r.Err = c.Get(rootURL(c, policyID), &r.Body, openstack.StdRequestOpts())
+	return
+}
+
 // Delete will permanently delete a particular datamasking rule based on its unique ID.
 func Delete(c *golangsdk.ServiceClient, policyID, ruleID string) (r DeleteResult) {
 	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{204},
@@ -0,0 +1,96 @@
+package geoip_rules
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack"
+)
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToGeoIPCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts contains all the values needed to create a new geolocation access control rule.
+type CreateOpts struct {
+	// Rule name
+	Name string `json:"name,omitempty"`
+	// Geographical location codes separated by `|`, e.g. `BR|CN`
+	GeoIP string `json:"geoip" required:"true"`
+	// Protective action: 0 - block, 1 - allow, 2 - log only
+	White int `json:"white"`
+	// Rule description
+	Description string `json:"description,omitempty"`
+}
+
+// ToGeoIPCreateMap builds a create request body from CreateOpts.
+func (opts CreateOpts) ToGeoIPCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Create will create a new geolocation access control rule based on the values in CreateOpts.
+func Create(c *golangsdk.ServiceClient, policyID string, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToGeoIPCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{200}}
+	_, r.Err = c.Post(rootURL(c, policyID), b, &r.Body, reqOpt)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToGeoIPUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts contains all the values needed to update a geolocation access control rule.
+type UpdateOpts struct {
+	// Rule name
+	Name string `json:"name,omitempty"`
+	// Geographical location codes separated by `|`, e.g. `BR|CN`
+	GeoIP string `json:"geoip" required:"true"`
+	// Protective action: 0 - block, 1 - allow, 2 - log only
+	White *int `json:"white" required:"true"`
+	// Rule description
+	Description *string `json:"description,omitempty"`
+}
+
+// ToGeoIPUpdateMap builds a update request body from UpdateOpts.
+func (opts UpdateOpts) ToGeoIPUpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Update accepts a UpdateOpts struct and uses the values to update a rule.
+func Update(c *golangsdk.ServiceClient, policyID, ruleID string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToGeoIPUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{200}}
+	_, r.Err = c.Put(resourceURL(c, policyID, ruleID), b, &r.Body, reqOpt)
+	return
+}
+
+// Get retrieves a particular geolocation access control rule based on its unique ID.
+func Get(c *golangsdk.ServiceClient, policyID, ruleID string) (r GetResult) {
+	_, r.Err = c.Get(resourceURL(c, policyID, ruleID), &r.Body, openstack.StdRequestOpts())
+	return
+}
+
+// List retrieves geolocation access control rules.
+func List(c *golangsdk.ServiceClient, policyID string) (r ListResult) {
+	_, r.Err = c.Get(rootURL(c, policyID), &r.Body, openstack.StdRequestOpts())
+	return
+}
+
+// Delete will permanently delete a particular geolocation access control rule based on its unique ID.
+func Delete(c *golangsdk.ServiceClient, policyID, ruleID string) (r DeleteResult) {
+	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{200, 204},
+		MoreHeaders: openstack.StdRequestOpts().MoreHeaders}
+	_, r.Err = c.Delete(resourceURL(c, policyID, ruleID), reqOpt)
+	return
+}
@@ -0,0 +1,71 @@
+package geoip_rules
+
+import (
+	"github.com/opentelekomcloud/gophertelekomcloud"
+)
+
+type GeoIP struct {
+	// Rule ID
+	Id string `json:"id"`
+	// Policy ID
+	PolicyID string `json:"policyid"`
+	// Rule name
+	Name string `json:"name"`
+	// Geographical location codes
+	GeoIP string `json:"geoip"`
+	// Protective action: 0 - block, 1 - allow, 2 - log only
+	White int `json:"white"`
+	// Rule status: 0 - disabled, 1 - enabled
+	Status int `json:"status"`
+	// Rule description
+	Description string `json:"description"`
+	// Creation time (unix timestamp)
+	Timestamp int64 `json:"timestamp"`
+}
+
+type commonResult struct {
+	golangsdk.Result
+}
+
+// Extract is a function that accepts a result and extracts a geolocation access control rule.
+func (r commonResult) Extract() (*GeoIP, error) {
+	var response GeoIP
+	err := r.ExtractInto(&response)
+	return &response, err
+}
+
+// CreateResult represents the result of a create operation. Call its Extract
+// method to interpret it as a GeoIP rule.
+type CreateResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of a update operation. Call its Extract
+// method to interpret it as a GeoIP rule.
+type UpdateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as a GeoIP rule.
+type GetResult struct {
+	commonResult
+}
+
+// ListResult represents the result of a list operation. Call its Extract
+// method to interpret it as a slice of GeoIP rules.
+type ListResult struct {
+	commonResult
+}
+
+func (r ListResult) Extract() ([]GeoIP, error) {
+	var s []GeoIP
+	err := r.ExtractIntoSlicePtr(&s, "items")
+	return s, err
+}
+
+// DeleteResult represents the result of a delete operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	golangsdk.ErrResult
+}
@@ -0,0 +1,79 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+const (
+	policyID = "38ff0cb9a10e4d5293c642bc0350fa6d"
+	ruleID   = "06f07f6c229141b9a4a78614751bb687"
+)
+
+const expectedCreateRequest = `
+{
+  "name": "geoip_test",
+  "geoip": "BR|CN",
+  "white": 0
+}`
+
+var ruleResponse = fmt.Sprintf(`
+{
+  "id": "%s",
+  "policyid": "%s",
+  "name": "geoip_test",
+  "geoip": "BR|CN",
+  "white": 0,
+  "status": 1,
+  "timestamp": 1636340038062
+}`, ruleID, policyID)
+
+var listResponse = fmt.Sprintf(`
+{
+  "total": 1,
+  "items": [
+    %s
+  ]
+}`, ruleResponse)
+
+// HandleCreateSuccessfully creates an HTTP handler at `/policy/{policy_id}/geoip` on the
+// test handler mux that responds with the created geolocation rule.
+func HandleCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/policy/%s/geoip", policyID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, expectedCreateRequest)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, ruleResponse)
+	})
+}
+
+// HandleListSuccessfully creates an HTTP handler at `/policy/{policy_id}/geoip` on the
+// test handler mux that responds with a single geolocation rule.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/policy/%s/geoip", policyID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, listResponse)
+	})
+}
+
+// HandleUpdateRuleStatusSuccessfully creates an HTTP handler at
+// `/policy/{policy_id}/geoip/{rule_id}/status` on the test handler mux that disables the rule.
+func HandleUpdateRuleStatusSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/policy/%s/geoip/%s/status", policyID, ruleID), func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `{"status": 0}`)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, `{"id": "%s", "policyid": "%s", "status": 0}`, ruleID, policyID)
+	})
+}
@@ -0,0 +1,48 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/waf/v1/geoip_rules"
+	"github.com/opentelekomcloud/gophertelekomcloud/openstack/waf/v1/policies"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+	fake "github.com/opentelekomcloud/gophertelekomcloud/testhelper/client"
+)
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSuccessfully(t)
+
+	rule, err := geoip_rules.Create(fake.ServiceClient(), policyID, geoip_rules.CreateOpts{
+		Name:  "geoip_test",
+		GeoIP: "BR|CN",
+		White: 0,
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, ruleID, rule.Id)
+	th.AssertEquals(t, "BR|CN", rule.GeoIP)
+	th.AssertEquals(t, 1, rule.Status)
+}
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	rules, err := geoip_rules.List(fake.ServiceClient(), policyID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(rules))
+	th.AssertEquals(t, ruleID, rules[0].Id)
+	th.AssertEquals(t, policyID, rules[0].PolicyID)
+	th.AssertEquals(t, int64(1636340038062), rules[0].Timestamp)
+}
+
+func TestUpdateRuleStatus(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateRuleStatusSuccessfully(t)
+
+	err := policies.UpdateRuleStatus(fake.ServiceClient(), policyID, policies.RuleTypeGeoIP, ruleID, 0).ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -106,6 +106,66 @@ func Get(c *golangsdk.ServiceClient, id string) (r GetResult) {
 	return
 }
 
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToPolicyListQuery() (string, error)
+}
+
+// ListOpts allows to filter the list of policies.
+type ListOpts struct {
+	// Page number
+	Page int `q:"page"`
+	// Number of records on a page
+	PageSize int `q:"pagesize"`
+	// Policy name
+	Name string `q:"name"`
+}
+
+// ToPolicyListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToPolicyListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), err
+}
+
+// List retrieves a page of policies.
+func List(c *golangsdk.ServiceClient, opts ListOptsBuilder) (r ListResult) {
+	url := rootURL(c)
+	if opts != nil {
+		q, err := opts.ToPolicyListQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += q
+	}
+	_, r.Err = c.Get(url, &r.Body, openstack.StdRequestOpts())
+	return
+}
+
+// Rule types of a policy
+const (
+	RuleTypeCc           = "cc"
+	RuleTypePrecise      = "custom"
+	RuleTypeWhiteBlackIP = "whiteblackip"
+	RuleTypeAntiTamper   = "antitamper"
+	RuleTypeGeoIP        = "geoip"
+	RuleTypeAntiLeakage  = "antileakage"
+	RuleTypeIgnore       = "ignore"
+	RuleTypePrivacy      = "privacy"
+)
+
+// UpdateRuleStatus enables (1) or disables (0) a particular rule of the policy.
+func UpdateRuleStatus(c *golangsdk.ServiceClient, policyID, ruleType, ruleID string, status int) (r RuleStatusResult) {
+	b := map[string]interface{}{"status": status}
+	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{200}}
+	_, r.Err = c.Put(ruleStatusURL(c, policyID, ruleType, ruleID), b, nil, reqOpt)
+	return
+}
+
 // Delete will permanently delete a particular policy based on its unique ID.
 func Delete(c *golangsdk.ServiceClient, id string) (r DeleteResult) {
 	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{204},
@@ -55,6 +55,14 @@ type Options struct {
 	Ignore *bool `json:"ignore,omitempty"`
 	// Whether Web Tamper Protection is enabled
 	AntiTamper *bool `json:"antitamper,omitempty"`
+	// Whether Geolocation Access Control is enabled
+	GeoIP *bool `json:"geoip,omitempty"`
+	// Whether Information Leakage Prevention is enabled
+	AntiLeakage *bool `json:"antileakage,omitempty"`
+	// Whether Known Attack Source is enabled
+	FollowedAction *bool `json:"followed_action,omitempty"`
+	// Whether Anti-Crawler protection is enabled
+	BotEnable *bool `json:"bot_enable,omitempty"`
 }
 
 type commonResult struct {
@@ -86,6 +94,23 @@ type GetResult struct {
 	commonResult
 }
 
+// ListResult represents the result of a list operation. Call its Extract
+// method to interpret it as a slice of Policy.
+type ListResult struct {
+	commonResult
+}
+
+func (r ListResult) Extract() ([]Policy, error) {
+	var s []Policy
+	err := r.ExtractIntoSlicePtr(&s, "items")
+	return s, err
+}
+
+// RuleStatusResult represents the result of a rule status update.
+type RuleStatusResult struct {
+	golangsdk.ErrResult
+}
+
 // DeleteResult represents the result of a delete operation. Call its ExtractErr
 // method to determine if the request succeeded or failed.
 type DeleteResult struct {
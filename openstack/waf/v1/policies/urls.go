@@ -13,3 +13,7 @@ func resourceURL(c *golangsdk.ServiceClient, id string) string {
 func hostsURL(c *golangsdk.ServiceClient, id string) string {
 	return c.ServiceURL("policy", id, "hosts")
 }
+
+func ruleStatusURL(c *golangsdk.ServiceClient, id, ruleType, ruleID string) string {
+	return c.ServiceURL("policy", id, ruleType, ruleID, "status")
+}
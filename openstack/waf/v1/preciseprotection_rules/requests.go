@@ -72,6 +72,12 @@ func Get(c *golangsdk.ServiceClient, policyID, ruleID string) (r GetResult) {
 	return
 }
 
+// List retrieves precise protection rules.
+func List(c *golangsdk.ServiceClient, policyID string) (r ListResult) {
+	_, r.Err = c.Get(rootURL(c, policyID), &r.Body, openstack.StdRequestOpts())
+	return
+}
+
 // Delete will permanently delete a particular precise rule based on its unique ID.
 func Delete(c *golangsdk.ServiceClient, policyID, ruleID string) (r DeleteResult) {
 	_, r.Err = c.Delete(resourceURL(c, policyID, ruleID), openstack.StdRequestOpts())
@@ -0,0 +1,124 @@
+package pagination
+
+import (
+	"fmt"
+	"reflect"
+)
+
+var (
+	pageType  = reflect.TypeOf((*Page)(nil)).Elem()
+	errorType = reflect.TypeOf((*error)(nil)).Elem()
+)
+
+// Iterator yields the items of a paginated collection one at a time, fetching
+// the pages of the underlying Pager only when their items are needed. Unlike
+// AllPages, only a single page is held in memory.
+//
+// Use it like bufio.Scanner:
+//
+//	it := pagination.NewIterator(servers.List(client, opts), servers.ExtractServers)
+//	for it.Next() {
+//		server := it.Value().(servers.Server)
+//	}
+//	if err := it.Err(); err != nil {
+//		panic(err)
+//	}
+type Iterator struct {
+	pager   Pager
+	extract reflect.Value
+
+	nextURL string
+	done    bool
+	items   reflect.Value
+	index   int
+	value   interface{}
+	err     error
+}
+
+// NewIterator creates an Iterator over the pager. `extract` is the function extracting
+// the items of a page, with the signature `func(pagination.Page) ([]T, error)`,
+// e.g. servers.ExtractServers.
+func NewIterator(pager Pager, extract interface{}) *Iterator {
+	it := &Iterator{
+		pager:   pager,
+		extract: reflect.ValueOf(extract),
+		nextURL: pager.initialURL,
+		err:     pager.Err,
+	}
+
+	if !validExtractFunc(it.extract) {
+		it.err = fmt.Errorf("expected extract function of type func(pagination.Page) ([]T, error), got %T", extract)
+	}
+	return it
+}
+
+func validExtractFunc(extract reflect.Value) bool {
+	if extract.Kind() != reflect.Func {
+		return false
+	}
+	fn := extract.Type()
+	return fn.NumIn() == 1 && fn.In(0) == pageType &&
+		fn.NumOut() == 2 && fn.Out(0).Kind() == reflect.Slice && fn.Out(1) == errorType
+}
+
+// Next advances the iterator to the next item, fetching the next page if needed.
+// It returns false when there are no more items or an error occurred.
+func (it *Iterator) Next() bool {
+	for !it.items.IsValid() || it.index >= it.items.Len() {
+		if it.done || it.err != nil {
+			return false
+		}
+		if err := it.fetchPage(); err != nil {
+			it.err = err
+			return false
+		}
+	}
+
+	it.value = it.items.Index(it.index).Interface()
+	it.index++
+	return true
+}
+
+// Value returns the current item, which has the element type of the slice
+// returned by the extract function.
+func (it *Iterator) Value() interface{} {
+	return it.value
+}
+
+// Err returns the first error which occurred during the iteration, if any.
+func (it *Iterator) Err() error {
+	return it.err
+}
+
+func (it *Iterator) fetchPage() error {
+	page, err := it.pager.fetchNextPage(it.nextURL)
+	if err != nil {
+		return err
+	}
+
+	empty, err := page.IsEmpty()
+	if err != nil {
+		return err
+	}
+	if empty {
+		it.done = true
+		it.items = reflect.Value{}
+		return nil
+	}
+
+	out := it.extract.Call([]reflect.Value{reflect.ValueOf(page)})
+	if err, _ := out[1].Interface().(error); err != nil {
+		return err
+	}
+	it.items = out[0]
+	it.index = 0
+
+	it.nextURL, err = page.NextPageURL()
+	if err != nil {
+		return err
+	}
+	if it.nextURL == "" {
+		it.done = true
+	}
+	return nil
+}
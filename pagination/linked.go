@@ -3,6 +3,7 @@ package pagination
 import (
 	"fmt"
 	"reflect"
+	"strconv"
 
 	"github.com/opentelekomcloud/gophertelekomcloud"
 )
@@ -105,3 +106,21 @@ func (current LinkedPageBase) WrapNextPageURL(markerID string) (string, error) {
 	current.URL.RawQuery = q.Encode()
 	return current.URL.String(), nil
 }
+
+// WrapNextPageNumberURL increments the `page` query parameter of the current URL,
+// it returns an empty URL once `page` * `limit` covers totalCount items.
+func (current LinkedPageBase) WrapNextPageNumberURL(totalCount int) (string, error) {
+	q := current.URL.Query()
+	page, err := strconv.Atoi(q.Get("page"))
+	if err != nil {
+		return "", nil
+	}
+	limit, err := strconv.Atoi(q.Get("limit"))
+	if err != nil || page*limit >= totalCount {
+		return "", nil
+	}
+
+	q.Set("page", strconv.Itoa(page+1))
+	current.URL.RawQuery = q.Encode()
+	return current.URL.String(), nil
+}
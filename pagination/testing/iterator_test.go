@@ -0,0 +1,72 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/opentelekomcloud/gophertelekomcloud/pagination"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestIteratorLinked(t *testing.T) {
+	pager := createLinked()
+	defer th.TeardownHTTP()
+
+	it := pagination.NewIterator(pager, ExtractLinkedInts)
+
+	var actual []int
+	for it.Next() {
+		actual = append(actual, it.Value().(int))
+	}
+	th.AssertNoErr(t, it.Err())
+	th.CheckDeepEquals(t, []int{1, 2, 3, 4, 5, 6, 7, 8, 9}, actual)
+}
+
+func TestIteratorFetchesPagesOnDemand(t *testing.T) {
+	pager := createLinked()
+	defer th.TeardownHTTP()
+
+	requested := map[string]bool{}
+	counting := pager.WithPageCreator(func(r pagination.PageResult) pagination.Page {
+		requested[r.URL.Path] = true
+		return LinkedPageResult{pagination.LinkedPageBase{PageResult: r}}
+	})
+
+	it := pagination.NewIterator(counting, ExtractLinkedInts)
+	for i := 0; i < 4; i++ {
+		th.AssertEquals(t, true, it.Next())
+	}
+	th.AssertEquals(t, 4, it.Value().(int))
+	th.AssertEquals(t, true, requested["/page2"])
+	th.AssertEquals(t, false, requested["/page3"])
+}
+
+func TestIteratorMarker(t *testing.T) {
+	pager := createMarkerPaged(t)
+	defer th.TeardownHTTP()
+
+	it := pagination.NewIterator(pager, ExtractMarkerStrings)
+
+	var actual []string
+	for it.Next() {
+		actual = append(actual, it.Value().(string))
+	}
+	th.AssertNoErr(t, it.Err())
+	th.CheckDeepEquals(t, []string{"aaa", "bbb", "ccc", "ddd", "eee", "fff", "ggg", "hhh", "iii"}, actual)
+}
+
+func TestIteratorInvalidExtract(t *testing.T) {
+	pager := createLinked()
+	defer th.TeardownHTTP()
+
+	it := pagination.NewIterator(pager, func(r pagination.Page) int { return 0 })
+	th.AssertEquals(t, false, it.Next())
+	if it.Err() == nil {
+		t.Fatalf("Expected to receive error")
+	}
+
+	it = pagination.NewIterator(pager, nil)
+	th.AssertEquals(t, false, it.Next())
+	if it.Err() == nil {
+		t.Fatalf("Expected to receive error")
+	}
+}
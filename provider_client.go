@@ -2,6 +2,7 @@ package golangsdk
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"io/ioutil"
@@ -57,6 +58,16 @@ type ProviderClient struct {
 	// To safely read or write this value, call `Token` or `SetToken`, respectively
 	TokenID string
 
+	// TokenExpiresAt is the expiry time of TokenID, zero if unknown.
+	// NOTE: Aside from within a custom ReauthFunc, this field shouldn't be set by an application.
+	// To safely read or write this value, call `TokenExpiry` or `SetTokenExpiry`, respectively
+	TokenExpiresAt time.Time
+
+	// TokenRenewWindow is the time before TokenExpiresAt in which the token is renewed
+	// with ReauthFunc before issuing a request. If zero, DefaultTokenRenewWindow is used.
+	// Set it to a negative value to renew tokens only after a 401 response.
+	TokenRenewWindow time.Duration
+
 	// ProjectID is the ID of project to which User is authorized.
 	ProjectID string
 
@@ -88,6 +99,24 @@ type ProviderClient struct {
 	// Otherwise, it must have a value
 	AKSKAuthOptions AKSKAuthOptions
 
+	// Context is the context passed to the HTTP requests issued by this client.
+	// It can be overridden for a single request with RequestOpts.Context.
+	// If not set, context.Background() is used.
+	Context context.Context
+
+	// RetryBackoffFunc is the retry policy used for failed requests which don't set
+	// RequestOpts.RetryBackoffFunc. If not set, FixedBackoff with RequestOpts.RetryTimeout is used.
+	RetryBackoffFunc RetryBackoffFunc
+
+	// MaxBackoffRetries is the number of times a request is retried if RequestOpts.RetryCount
+	// is not set. If not set, a request is retried once.
+	MaxBackoffRetries *int
+
+	// Logger, if set, receives a dump of every request and response: method, URL, headers with
+	// tokens, signatures and encryption keys masked, and textual response bodies with passwords,
+	// tokens and secrets masked.
+	Logger Logger
+
 	mut *sync.RWMutex
 
 	reauthmut *reauthlock
@@ -98,6 +127,9 @@ type reauthlock struct {
 	reauthing bool
 }
 
+// DefaultTokenRenewWindow is the default value of ProviderClient.TokenRenewWindow.
+const DefaultTokenRenewWindow = 5 * time.Minute
+
 // AuthenticatedHeaders returns a map of HTTP headers that are common for all
 // authenticated service requests.
 func (client *ProviderClient) AuthenticatedHeaders() (m map[string]string) {
@@ -143,6 +175,74 @@ func (client *ProviderClient) SetToken(t string) {
 	client.TokenID = t
 }
 
+// TokenExpiry safely reads the expiry time of the auth token from the ProviderClient.
+func (client *ProviderClient) TokenExpiry() time.Time {
+	if client.mut != nil {
+		client.mut.RLock()
+		defer client.mut.RUnlock()
+	}
+	return client.TokenExpiresAt
+}
+
+// SetTokenExpiry safely sets the expiry time of the auth token in the ProviderClient.
+func (client *ProviderClient) SetTokenExpiry(t time.Time) {
+	if client.mut != nil {
+		client.mut.Lock()
+		defer client.mut.Unlock()
+	}
+	client.TokenExpiresAt = t
+}
+
+// tokenExpiring returns true if the token is about to expire within the renew window.
+func (client *ProviderClient) tokenExpiring(tokenID string, expiresAt time.Time) bool {
+	window := client.TokenRenewWindow
+	if window == 0 {
+		window = DefaultTokenRenewWindow
+	}
+	if window < 0 || tokenID == "" || expiresAt.IsZero() {
+		return false
+	}
+	return time.Until(expiresAt) < window
+}
+
+// renewTokenIfExpiring re-authenticates proactively if the current token is about to expire,
+// so requests don't fail with 401. Concurrent callers wait for a single re-authentication.
+func (client *ProviderClient) renewTokenIfExpiring() error {
+	if client.ReauthFunc == nil {
+		return nil
+	}
+	if client.reauthmut != nil {
+		client.reauthmut.RLock()
+		reauthing := client.reauthmut.reauthing
+		client.reauthmut.RUnlock()
+		if reauthing {
+			return nil
+		}
+	}
+	if !client.tokenExpiring(client.Token(), client.TokenExpiry()) {
+		return nil
+	}
+
+	if client.mut == nil {
+		return client.ReauthFunc()
+	}
+
+	client.mut.Lock()
+	defer client.mut.Unlock()
+	// the token may have been renewed while waiting for the lock
+	if !client.tokenExpiring(client.TokenID, client.TokenExpiresAt) {
+		return nil
+	}
+	client.reauthmut.Lock()
+	client.reauthmut.reauthing = true
+	client.reauthmut.Unlock()
+	err := client.ReauthFunc()
+	client.reauthmut.Lock()
+	client.reauthmut.reauthing = false
+	client.reauthmut.Unlock()
+	return err
+}
+
 // RequestOpts customizes the behavior of the provider.Request() method.
 type RequestOpts struct {
 	// JSONBody, if provided, will be encoded as JSON and used as the body of the HTTP request. The
@@ -166,10 +266,19 @@ type RequestOpts struct {
 	// This lets resources override default error messages based on the response status code.
 	ErrorContext error
 
-	// RetryCount specifies number of times retriable errors (502, 504) will be retried
+	// RetryCount specifies number of times retriable errors will be retried
 	RetryCount *int
-	// RetryTimeout specifies time before next retry
+	// RetryTimeout specifies time before next retry, if the default FixedBackoff policy is used
 	RetryTimeout *time.Duration
+	// RetryBackoffFunc overrides ProviderClient.RetryBackoffFunc for this request.
+	RetryBackoffFunc RetryBackoffFunc
+
+	// Context, if provided, is attached to the HTTP request and used for its cancellation
+	// and deadline, taking precedence over ProviderClient.Context.
+	Context context.Context
+
+	// retryAttempt is the number of retries already done for this request.
+	retryAttempt int
 }
 
 var applicationJSON = "application/json"
@@ -208,8 +317,16 @@ func (client *ProviderClient) Request(method, url string, options *RequestOpts)
 		body = options.RawBody
 	}
 
+	ctx := options.Context
+	if ctx == nil {
+		ctx = client.Context
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
 	// Construct the http.Request.
-	req, err := http.NewRequest(method, url, body)
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
 	if err != nil {
 		return nil, err
 	}
@@ -234,6 +351,10 @@ func (client *ProviderClient) Request(method, url string, options *RequestOpts)
 		}
 	}
 
+	if err := client.renewTokenIfExpiring(); err != nil {
+		return nil, &ErrUnableToReauthenticate{ErrOriginal: err}
+	}
+
 	// get latest token from client
 	for k, v := range client.AuthenticatedHeaders() {
 		req.Header.Set(k, v)
@@ -258,10 +379,14 @@ func (client *ProviderClient) Request(method, url string, options *RequestOpts)
 	}
 
 	// Issue the request.
+	client.logRequest(req)
 	resp, err := client.HTTPClient.Do(req)
 	if err != nil {
 		return nil, err
 	}
+	if err := client.logResponse(resp); err != nil {
+		return nil, err
+	}
 
 	// Allow default OkCodes if none explicitly set
 	if options.OkCodes == nil {
@@ -270,6 +395,9 @@ func (client *ProviderClient) Request(method, url string, options *RequestOpts)
 
 	if options.RetryCount == nil {
 		defaultRetryLimit := 1
+		if client.MaxBackoffRetries != nil {
+			defaultRetryLimit = *client.MaxBackoffRetries
+		}
 		options.RetryCount = &defaultRetryLimit
 	}
 
@@ -298,6 +426,29 @@ func (client *ProviderClient) Request(method, url string, options *RequestOpts)
 			Body:     body,
 		}
 
+		if *options.RetryCount > 0 {
+			backoff := options.RetryBackoffFunc
+			if backoff == nil {
+				backoff = client.RetryBackoffFunc
+			}
+			if backoff == nil {
+				backoff = FixedBackoff(*options.RetryTimeout)
+			}
+			if delay, retry := backoff(options.retryAttempt, resp); retry {
+				*options.RetryCount -= 1
+				options.retryAttempt++
+				select {
+				case <-ctx.Done():
+					return nil, ctx.Err()
+				case <-time.After(delay):
+				}
+				if err := rewindBody(options); err != nil {
+					return nil, err
+				}
+				return client.Request(method, url, options)
+			}
+		}
+
 		errType := options.ErrorContext
 		switch resp.StatusCode {
 		case http.StatusBadRequest:
@@ -327,13 +478,8 @@ func (client *ProviderClient) Request(method, url string, options *RequestOpts)
 					e.ErrOriginal = respErr
 					return nil, e
 				}
-				if options.RawBody != nil {
-					if seeker, ok := options.RawBody.(io.Seeker); ok {
-						_, e := seeker.Seek(0, 0)
-						if e != nil {
-							return nil, e
-						}
-					}
+				if e := rewindBody(options); e != nil {
+					return nil, e
 				}
 				resp, err = client.Request(method, url, options)
 				if err != nil {
@@ -382,12 +528,6 @@ func (client *ProviderClient) Request(method, url string, options *RequestOpts)
 			if error500er, ok := errType.(Err500er); ok {
 				err = error500er.Error500(respErr)
 			}
-		case http.StatusBadGateway, http.StatusGatewayTimeout: // gateway errors
-			if *options.RetryCount > 0 {
-				*options.RetryCount -= 1
-				time.Sleep(*options.RetryTimeout)
-				return client.Request(method, url, options)
-			}
 		case http.StatusServiceUnavailable:
 			err = ErrDefault503{respErr}
 			if error503er, ok := errType.(Err503er); ok {
@@ -413,6 +553,17 @@ func (client *ProviderClient) Request(method, url string, options *RequestOpts)
 	return resp, nil
 }
 
+// rewindBody seeks the raw request body back to its start, so the request can be issued again.
+func rewindBody(options *RequestOpts) error {
+	if options.RawBody != nil {
+		if seeker, ok := options.RawBody.(io.Seeker); ok {
+			_, err := seeker.Seek(0, 0)
+			return err
+		}
+	}
+	return nil
+}
+
 func defaultOkCodes(method string) []int {
 	switch method {
 	case "GET":
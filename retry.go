@@ -0,0 +1,84 @@
+package golangsdk
+
+import (
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryBackoffFunc decides whether a request which failed with the given response
+// should be retried, and how long to wait before doing so. `attempt` is the number of
+// retries already done for the request, starting at 0.
+//
+// The response body is already consumed and closed when the function is called,
+// only the status code and headers can be inspected.
+type RetryBackoffFunc func(attempt int, resp *http.Response) (delay time.Duration, retry bool)
+
+// FixedBackoff returns a RetryBackoffFunc retrying gateway errors (502, 504) after a fixed delay.
+// This is the policy used when neither RequestOpts nor ProviderClient sets a RetryBackoffFunc.
+func FixedBackoff(delay time.Duration) RetryBackoffFunc {
+	return func(_ int, resp *http.Response) (time.Duration, bool) {
+		switch resp.StatusCode {
+		case http.StatusBadGateway, http.StatusGatewayTimeout:
+			return delay, true
+		}
+		return 0, false
+	}
+}
+
+// ExponentialBackoff returns a RetryBackoffFunc retrying 429, 502, 503 and 504 responses.
+//
+// The delay before retry `n` is a random duration between 0 and base * 2^n, capped at max
+// ("full jitter"). For 429 responses carrying a Retry-After header the delay requested by
+// the server is used instead, still capped at max.
+func ExponentialBackoff(base, max time.Duration) RetryBackoffFunc {
+	return func(attempt int, resp *http.Response) (time.Duration, bool) {
+		switch resp.StatusCode {
+		case http.StatusTooManyRequests:
+			if delay, ok := retryAfter(resp); ok {
+				if delay > max {
+					delay = max
+				}
+				return delay, true
+			}
+		case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		default:
+			return 0, false
+		}
+
+		ceiling := max
+		if attempt < 32 {
+			if d := base << uint(attempt); d > 0 && d < max {
+				ceiling = d
+			}
+		}
+		if ceiling <= 0 {
+			return 0, true
+		}
+		return time.Duration(rand.Int63n(int64(ceiling) + 1)), true
+	}
+}
+
+// retryAfter parses the Retry-After header of the response, which can be set
+// either in seconds or as an HTTP date.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(value); err == nil {
+		if secs < 0 {
+			secs = 0
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		delay := time.Until(date)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+	return 0, false
+}
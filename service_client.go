@@ -1,6 +1,7 @@
 package golangsdk
 
 import (
+	"context"
 	"io"
 	"net/http"
 	"strings"
@@ -28,6 +29,18 @@ type ServiceClient struct {
 
 	// The microversion of the service to use. Set this to use a particular microversion.
 	Microversion string
+
+	// Context, if set, is used for every request issued by this service client
+	// which doesn't provide its own RequestOpts.Context.
+	Context context.Context
+}
+
+// WithContext returns a shallow copy of the service client which issues all its
+// requests with the given context. The copy shares the underlying ProviderClient.
+func (client *ServiceClient) WithContext(ctx context.Context) *ServiceClient {
+	c := *client
+	c.Context = ctx
+	return &c
 }
 
 // ResourceBaseURL returns the base URL of any resources used by this service. It MUST end with a /.
@@ -63,6 +76,15 @@ func (client *ServiceClient) initReqOpts(_ string, JSONBody interface{}, JSONRes
 	}
 }
 
+// Request calls ProviderClient's `Request`, attaching the service client's Context
+// unless the options already carry one.
+func (client *ServiceClient) Request(method, url string, options *RequestOpts) (*http.Response, error) {
+	if options.Context == nil {
+		options.Context = client.Context
+	}
+	return client.ProviderClient.Request(method, url, options)
+}
+
 // Get calls `Request` with the "GET" HTTP verb.
 func (client *ServiceClient) Get(url string, JSONResponse interface{}, opts *RequestOpts) (*http.Response, error) {
 	if opts == nil {
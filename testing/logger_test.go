@@ -0,0 +1,78 @@
+package testing
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strings"
+	"testing"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestSanitizeHeaders(t *testing.T) {
+	headers := http.Header{}
+	headers.Set("X-Auth-Token", "secret-token")
+	headers.Set("Authorization", "SDK-HMAC-SHA256 Access=ak, Signature=sig")
+	headers.Set("Content-Type", "application/json")
+
+	expected := "Authorization: ***\nContent-Type: application/json\nX-Auth-Token: ***\n"
+	th.AssertEquals(t, expected, golangsdk.SanitizeHeaders(headers))
+}
+
+func TestSanitizeHeadersSSECustomerKey(t *testing.T) {
+	headers := http.Header{}
+	headers.Set("X-Obs-Server-Side-Encryption-Customer-Key", "c2VjcmV0LWtleQ==")
+	headers.Set("X-Obs-Server-Side-Encryption-Customer-Key-MD5", "key-md5")
+	headers.Set("X-Obs-Copy-Source-Server-Side-Encryption-Customer-Key", "c291cmNlLWtleQ==")
+	headers.Set("X-Obs-Server-Side-Encryption-Customer-Algorithm", "AES256")
+
+	expected := "X-Obs-Copy-Source-Server-Side-Encryption-Customer-Key: ***\n" +
+		"X-Obs-Server-Side-Encryption-Customer-Algorithm: AES256\n" +
+		"X-Obs-Server-Side-Encryption-Customer-Key: ***\n" +
+		"X-Obs-Server-Side-Encryption-Customer-Key-Md5: ***\n"
+	th.AssertEquals(t, expected, golangsdk.SanitizeHeaders(headers))
+}
+
+func TestSanitizeBody(t *testing.T) {
+	body := `{"server": {"id": "1", "adminPass": "pass\"word"}, "user": {"password": "p1", "new_password": "p2"},` +
+		` "secret_string": "s", "plain_text": "key", "token": "t", "name": "value"}`
+	expected := `{"server": {"id": "1", "adminPass": "***"}, "user": {"password": "***", "new_password": "***"},` +
+		` "secret_string": "***", "plain_text": "***", "token": "***", "name": "value"}`
+	th.AssertEquals(t, expected, golangsdk.SanitizeBody(body))
+}
+
+func TestRequestLogging(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/route", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Subject-Token", "new-token")
+		_, _ = fmt.Fprint(w, `{"name": "value", "adminPass": "secret-password"}`)
+	})
+
+	buf := new(bytes.Buffer)
+	p := &golangsdk.ProviderClient{
+		TokenID: "secret-token",
+		Logger:  log.New(buf, "", 0),
+	}
+	resp, err := p.Request("GET", fmt.Sprintf("%s/route", th.Endpoint()), &golangsdk.RequestOpts{})
+	th.AssertNoErr(t, err)
+
+	body, err := ioutil.ReadAll(resp.Body)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, `{"name": "value", "adminPass": "secret-password"}`, string(body))
+
+	output := buf.String()
+	th.AssertEquals(t, true, strings.Contains(output, fmt.Sprintf("Request: GET %s/route", th.Endpoint())))
+	th.AssertEquals(t, true, strings.Contains(output, "X-Auth-Token: ***"))
+	th.AssertEquals(t, true, strings.Contains(output, "X-Subject-Token: ***"))
+	th.AssertEquals(t, true, strings.Contains(output, `{"name": "value", "adminPass": "***"}`))
+	th.AssertEquals(t, false, strings.Contains(output, "secret-password"))
+	th.AssertEquals(t, false, strings.Contains(output, "secret-token"))
+	th.AssertEquals(t, false, strings.Contains(output, "new-token"))
+}
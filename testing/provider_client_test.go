@@ -1,9 +1,11 @@
 package testing
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -119,3 +121,117 @@ func TestConcurrentReauth(t *testing.T) {
 
 	th.AssertEquals(t, 1, info.numreauths)
 }
+
+func TestRequestWithCancelledContext(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/route", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	p := new(golangsdk.ProviderClient)
+	_, err := p.Request("GET", fmt.Sprintf("%s/route", th.Endpoint()), &golangsdk.RequestOpts{
+		Context: ctx,
+	})
+	if err == nil {
+		t.Fatalf("Expected to receive error")
+	}
+	th.AssertEquals(t, true, strings.Contains(err.Error(), context.Canceled.Error()))
+}
+
+func TestRequestWithContextDeadline(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	done := make(chan struct{})
+	defer close(done)
+	th.Mux.HandleFunc("/route", func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	sc := &golangsdk.ServiceClient{ProviderClient: new(golangsdk.ProviderClient)}
+	_, err := sc.WithContext(ctx).Get(fmt.Sprintf("%s/route", th.Endpoint()), nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err == nil {
+		t.Fatalf("Expected to receive error")
+	}
+	th.AssertEquals(t, true, strings.Contains(err.Error(), context.DeadlineExceeded.Error()))
+}
+
+func TestProactiveTokenRenewal(t *testing.T) {
+	numreauths := 0
+	numconc := 20
+
+	oldTok := client.TokenID
+	newTok := "12345678"
+
+	p := new(golangsdk.ProviderClient)
+	p.UseTokenLock()
+	p.SetToken(oldTok)
+	p.SetTokenExpiry(time.Now().Add(time.Minute))
+	p.ReauthFunc = func() error {
+		time.Sleep(100 * time.Millisecond)
+		numreauths++
+		p.TokenID = newTok
+		p.TokenExpiresAt = time.Now().Add(time.Hour)
+		return nil
+	}
+
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/route", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Auth-Token") != newTok {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	wg := new(sync.WaitGroup)
+	for i := 0; i < numconc; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := p.Request("GET", fmt.Sprintf("%s/route", th.Endpoint()), &golangsdk.RequestOpts{})
+			th.CheckNoErr(t, err)
+		}()
+	}
+	wg.Wait()
+
+	th.AssertEquals(t, 1, numreauths)
+	th.AssertEquals(t, newTok, p.Token())
+}
+
+func TestProactiveTokenRenewalDisabled(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/route", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	p := &golangsdk.ProviderClient{
+		TokenID:          client.TokenID,
+		TokenExpiresAt:   time.Now().Add(time.Minute),
+		TokenRenewWindow: -1,
+		ReauthFunc: func() error {
+			t.Fatalf("Token shouldn't be renewed")
+			return nil
+		},
+	}
+	_, err := p.Request("GET", fmt.Sprintf("%s/route", th.Endpoint()), &golangsdk.RequestOpts{})
+	th.AssertNoErr(t, err)
+}
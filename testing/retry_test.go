@@ -0,0 +1,106 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestFixedBackoff(t *testing.T) {
+	backoff := golangsdk.FixedBackoff(time.Second)
+
+	delay, retry := backoff(0, &http.Response{StatusCode: http.StatusBadGateway})
+	th.AssertEquals(t, true, retry)
+	th.AssertEquals(t, time.Second, delay)
+
+	_, retry = backoff(0, &http.Response{StatusCode: http.StatusTooManyRequests})
+	th.AssertEquals(t, false, retry)
+}
+
+func TestExponentialBackoff(t *testing.T) {
+	backoff := golangsdk.ExponentialBackoff(100*time.Millisecond, time.Second)
+
+	for attempt := 0; attempt < 10; attempt++ {
+		delay, retry := backoff(attempt, &http.Response{StatusCode: http.StatusServiceUnavailable})
+		th.AssertEquals(t, true, retry)
+		ceiling := 100 * time.Millisecond << uint(attempt)
+		if ceiling > time.Second {
+			ceiling = time.Second
+		}
+		if delay < 0 || delay > ceiling {
+			t.Fatalf("Delay %s of attempt %d is out of [0, %s]", delay, attempt, ceiling)
+		}
+	}
+
+	_, retry := backoff(0, &http.Response{StatusCode: http.StatusNotFound})
+	th.AssertEquals(t, false, retry)
+}
+
+func TestExponentialBackoffRetryAfter(t *testing.T) {
+	backoff := golangsdk.ExponentialBackoff(100*time.Millisecond, 5*time.Second)
+
+	resp := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}}
+	resp.Header.Set("Retry-After", "3")
+	delay, retry := backoff(0, resp)
+	th.AssertEquals(t, true, retry)
+	th.AssertEquals(t, 3*time.Second, delay)
+
+	resp.Header.Set("Retry-After", "120")
+	delay, _ = backoff(0, resp)
+	th.AssertEquals(t, 5*time.Second, delay)
+
+	resp.Header.Set("Retry-After", time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat))
+	delay, _ = backoff(0, resp)
+	th.AssertEquals(t, time.Duration(0), delay)
+}
+
+func TestRequestRetryBackoff(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	calls := 0
+	th.Mux.HandleFunc("/route", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	retries := 3
+	p := &golangsdk.ProviderClient{
+		RetryBackoffFunc:  golangsdk.ExponentialBackoff(time.Millisecond, 10*time.Millisecond),
+		MaxBackoffRetries: &retries,
+	}
+	_, err := p.Request("GET", fmt.Sprintf("%s/route", th.Endpoint()), &golangsdk.RequestOpts{})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 3, calls)
+}
+
+func TestRequestRetryBackoffOverride(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	calls := 0
+	th.Mux.HandleFunc("/route", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	p := &golangsdk.ProviderClient{
+		RetryBackoffFunc: golangsdk.ExponentialBackoff(time.Millisecond, 10*time.Millisecond),
+	}
+	_, err := p.Request("GET", fmt.Sprintf("%s/route", th.Endpoint()), &golangsdk.RequestOpts{
+		RetryBackoffFunc: golangsdk.FixedBackoff(time.Millisecond),
+	})
+	if _, ok := err.(golangsdk.ErrDefault429); !ok {
+		t.Fatalf("Expected ErrDefault429, got %T", err)
+	}
+	th.AssertEquals(t, 1, calls)
+}
@@ -1,6 +1,7 @@
 package testing
 
 import (
+	"context"
 	"errors"
 	"os"
 	"path/filepath"
@@ -129,3 +130,13 @@ func TestNormalizePathURL(t *testing.T) {
 	th.CheckEquals(t, expected, result)
 
 }
+
+func TestWaitForContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	err := golangsdk.WaitForContext(ctx, 10, func() (bool, error) {
+		return false, nil
+	})
+	th.AssertEquals(t, context.DeadlineExceeded, err)
+}
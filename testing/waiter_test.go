@@ -0,0 +1,88 @@
+package testing
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	golangsdk "github.com/opentelekomcloud/gophertelekomcloud"
+	th "github.com/opentelekomcloud/gophertelekomcloud/testhelper"
+)
+
+func TestStateChangeConf(t *testing.T) {
+	states := []string{"CREATING", "CREATING", "ACTIVE"}
+	calls := 0
+	conf := golangsdk.StateChangeConf{
+		Pending: []string{"CREATING"},
+		Target:  []string{"ACTIVE"},
+		Refresh: func() (interface{}, string, error) {
+			state := states[calls]
+			calls++
+			return calls, state, nil
+		},
+		Timeout:      time.Second,
+		PollInterval: time.Millisecond,
+	}
+	result, err := conf.WaitForState()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 3, result)
+}
+
+func TestStateChangeConfUnexpectedState(t *testing.T) {
+	conf := golangsdk.StateChangeConf{
+		Pending: []string{"CREATING"},
+		Target:  []string{"ACTIVE"},
+		Refresh: func() (interface{}, string, error) {
+			return nil, "ERROR", nil
+		},
+		PollInterval: time.Millisecond,
+	}
+	_, err := conf.WaitForState()
+	stateErr, ok := err.(golangsdk.ErrUnexpectedState)
+	if !ok {
+		t.Fatalf("Expected ErrUnexpectedState, got %T", err)
+	}
+	th.AssertEquals(t, "ERROR", stateErr.State)
+}
+
+func TestStateChangeConfRefreshError(t *testing.T) {
+	conf := golangsdk.StateChangeConf{
+		Target: []string{"ACTIVE"},
+		Refresh: func() (interface{}, string, error) {
+			return nil, "", errors.New("Error has occurred")
+		},
+	}
+	_, err := conf.WaitForState()
+	th.AssertEquals(t, "Error has occurred", err.Error())
+}
+
+func TestStateChangeConfTimeout(t *testing.T) {
+	conf := golangsdk.StateChangeConf{
+		Target: []string{"ACTIVE"},
+		Refresh: func() (interface{}, string, error) {
+			return nil, "CREATING", nil
+		},
+		Timeout:      50 * time.Millisecond,
+		PollInterval: 10 * time.Millisecond,
+	}
+	_, err := conf.WaitForState()
+	if _, ok := err.(golangsdk.ErrTimeOut); !ok {
+		t.Fatalf("Expected ErrTimeOut, got %T", err)
+	}
+}
+
+func TestStateChangeConfContext(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	conf := golangsdk.StateChangeConf{
+		Target: []string{"ACTIVE"},
+		Refresh: func() (interface{}, string, error) {
+			return nil, "CREATING", nil
+		},
+		PollInterval: 10 * time.Millisecond,
+	}
+	_, err := conf.WaitForStateContext(ctx)
+	th.AssertEquals(t, context.DeadlineExceeded, err)
+}
@@ -1,6 +1,7 @@
 package golangsdk
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 	"path/filepath"
@@ -15,6 +16,12 @@ import (
 // Resource packages will wrap this in a more convenient function that's
 // specific to a certain resource, but it can also be useful on its own.
 func WaitFor(timeout int, predicate func() (bool, error)) error {
+	return WaitForContext(context.Background(), timeout, predicate)
+}
+
+// WaitForContext behaves like WaitFor, but stops waiting and returns the
+// context error as soon as ctx is cancelled or its deadline is exceeded.
+func WaitForContext(ctx context.Context, timeout int, predicate func() (bool, error)) error {
 	type WaitForResult struct {
 		Success bool
 		Error   error
@@ -28,7 +35,11 @@ func WaitFor(timeout int, predicate func() (bool, error)) error {
 			return fmt.Errorf("A timeout occurred")
 		}
 
-		time.Sleep(1 * time.Second)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(1 * time.Second):
+		}
 
 		var result WaitForResult
 		ch := make(chan bool, 1)
@@ -47,6 +58,8 @@ func WaitFor(timeout int, predicate func() (bool, error)) error {
 			if result.Success {
 				return nil
 			}
+		case <-ctx.Done():
+			return ctx.Err()
 		// If the predicate has not finished by the timeout, cancel it.
 		case <-time.After(time.Duration(timeout) * time.Second):
 			return fmt.Errorf("A timeout occurred")
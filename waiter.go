@@ -0,0 +1,89 @@
+package golangsdk
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// StateRefreshFunc returns the current state of the resource being waited for:
+// the resource itself, its state and an error, if the state couldn't be fetched.
+type StateRefreshFunc func() (result interface{}, state string, err error)
+
+// StateChangeConf describes how to wait for a resource to reach one of the Target states.
+//
+// Services implement a StateRefreshFunc for their resource and reuse StateChangeConf
+// instead of writing their own polling loops.
+type StateChangeConf struct {
+	// Pending are the states in which waiting continues. If empty, every state except Target ones
+	// is considered pending. Reaching a state which is neither Pending nor Target is an error.
+	Pending []string
+	// Target are the states the resource is expected to reach.
+	Target []string
+	// Refresh fetches the current state of the resource.
+	Refresh StateRefreshFunc
+	// Timeout is the maximum time to wait. Zero means no timeout.
+	Timeout time.Duration
+	// Delay is the time to wait before the first refresh.
+	Delay time.Duration
+	// PollInterval is the time between refreshes, one second if not set.
+	PollInterval time.Duration
+}
+
+// WaitForState polls the resource until it reaches a Target state and returns its last refresh result.
+func (conf *StateChangeConf) WaitForState() (interface{}, error) {
+	return conf.WaitForStateContext(context.Background())
+}
+
+// WaitForStateContext behaves like WaitForState, but stops waiting and returns the
+// context error as soon as ctx is cancelled or its deadline is exceeded.
+func (conf *StateChangeConf) WaitForStateContext(ctx context.Context) (interface{}, error) {
+	interval := conf.PollInterval
+	if interval <= 0 {
+		interval = time.Second
+	}
+
+	var timeout <-chan time.Time
+	if conf.Timeout > 0 {
+		timer := time.NewTimer(conf.Timeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	wait := conf.Delay
+	lastState := ""
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-timeout:
+			return nil, ErrTimeOut{BaseError{
+				Info: fmt.Sprintf("Timeout after %s waiting for state %v, last state: '%s'", conf.Timeout, conf.Target, lastState),
+			}}
+		case <-time.After(wait):
+		}
+		wait = interval
+
+		result, state, err := conf.Refresh()
+		if err != nil {
+			return result, err
+		}
+		lastState = state
+
+		if contains(conf.Target, state) {
+			return result, nil
+		}
+		if len(conf.Pending) > 0 && !contains(conf.Pending, state) {
+			return result, ErrUnexpectedState{State: state, Expected: conf.Target}
+		}
+	}
+}
+
+func contains(states []string, state string) bool {
+	for _, s := range states {
+		if s == state {
+			return true
+		}
+	}
+	return false
+}